@@ -551,6 +551,7 @@ func New(
 		app.ObserverKeeper,
 		app.AuthorityKeeper,
 	)
+	app.FungibleKeeper.SetRegisteredPrecompiles(precompiles.RegisteredStatefulContracts)
 
 	app.CrosschainKeeper = *crosschainkeeper.NewKeeper(
 		appCodec,
@@ -1097,12 +1098,11 @@ func (app *App) BlockedAddrs() map[string]bool {
 		blockList[addr.String()] = v
 	}
 
-	// Each enabled precompiled stateful contract should be added as a BlockedAddrs.
+	// Each registered precompiled stateful contract should be added as a BlockedAddrs.
 	// That way it's marked as non payable by the bank keeper.
-	for addr, enabled := range precompiles.EnabledStatefulContracts {
-		if enabled {
-			blockList[addr.String()] = enabled
-		}
+	// Contracts are blocked whether they are enabled or not, as their status can be updated on-chain.
+	for _, addr := range precompiles.RegisteredStatefulContracts {
+		blockList[addr.String()] = true
 	}
 
 	return blockList
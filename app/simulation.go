@@ -0,0 +1,181 @@
+package app
+
+import (
+	"fmt"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+var _ rpctypes.Simulator = (*App)(nil)
+
+// evmSimulation executes the calls of eth_simulateV1 with the EVM keeper on a query context,
+// the state changes are kept in the cache of the context and never committed
+type evmSimulation struct {
+	app      *App
+	ctx      sdk.Context
+	proposer sdk.ConsAddress
+	chainID  *big.Int
+
+	// blockGasMeter is the block gas meter of the base block, used when the gas limit isn't overridden
+	blockGasMeter storetypes.GasMeter
+
+	cfg      *statedb.EVMConfig
+	txConfig statedb.TxConfig
+}
+
+// NewSimulation returns a simulation on top of the state of the given block
+func (app *App) NewSimulation(height int64, proposer sdk.ConsAddress, chainID *big.Int) (rpctypes.Simulation, error) {
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create query context at height %d", height)
+	}
+
+	sim := &evmSimulation{
+		app:           app,
+		ctx:           ctx,
+		proposer:      proposer,
+		chainID:       chainID,
+		blockGasMeter: ctx.BlockGasMeter(),
+	}
+
+	if err := sim.StartBlock(rpctypes.SimHeader{Number: ctx.BlockHeight(), Time: ctx.BlockTime()}); err != nil {
+		return nil, err
+	}
+
+	return sim, nil
+}
+
+// StartBlock sets the header of the block of the next calls
+func (s *evmSimulation) StartBlock(header rpctypes.SimHeader) error {
+	s.ctx = s.ctx.WithBlockHeight(header.Number).WithBlockTime(header.Time)
+
+	// the EVM reads the block gas limit from the block gas meter
+	if header.GasLimit != nil {
+		s.ctx = s.ctx.WithBlockGasMeter(storetypes.NewGasMeter(*header.GasLimit))
+	} else {
+		s.ctx = s.ctx.WithBlockGasMeter(s.blockGasMeter)
+	}
+
+	cfg, err := s.app.EvmKeeper.EVMConfig(s.ctx, s.proposer, s.chainID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get EVM config")
+	}
+
+	if header.FeeRecipient != nil {
+		cfg.CoinBase = *header.FeeRecipient
+	}
+	if header.BaseFee != nil {
+		cfg.BaseFee = header.BaseFee
+	}
+
+	s.cfg = cfg
+	s.txConfig = statedb.NewEmptyTxConfig(common.BytesToHash(s.ctx.HeaderHash()))
+
+	return nil
+}
+
+// ApplyStateOverrides overrides the accounts state
+func (s *evmSimulation) ApplyStateOverrides(overrides rpctypes.StateOverride) error {
+	stateDB := statedb.New(s.ctx, s.app.EvmKeeper, s.txConfig)
+
+	for addr, account := range overrides {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+
+		if account.Balance != nil {
+			balance, overflow := uint256.FromBig((*account.Balance).ToInt())
+			if overflow || balance == nil {
+				return fmt.Errorf("invalid balance of account %s", addr.Hex())
+			}
+
+			current := stateDB.GetBalance(addr)
+			switch current.Cmp(balance) {
+			case -1:
+				stateDB.AddBalance(addr, new(uint256.Int).Sub(balance, current))
+			case 1:
+				stateDB.SubBalance(addr, new(uint256.Int).Sub(current, balance))
+			}
+		}
+
+		if account.State != nil {
+			// the given state replaces the whole storage of the account
+			var keys []common.Hash
+			err := stateDB.ForEachStorage(addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			if err != nil {
+				return errors.Wrapf(err, "unable to read storage of account %s", addr.Hex())
+			}
+
+			for _, key := range keys {
+				stateDB.SetState(addr, key, common.Hash{})
+			}
+			for key, value := range *account.State {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return errors.Wrap(stateDB.Commit(), "unable to commit state overrides")
+}
+
+// GetNonce returns the nonce of the account
+func (s *evmSimulation) GetNonce(address common.Address) uint64 {
+	return s.app.EvmKeeper.GetNonce(s.ctx, address)
+}
+
+// Call executes the call and commits its state changes, the sender nonce is incremented
+func (s *evmSimulation) Call(
+	args evmtypes.TransactionArgs,
+	txHash common.Hash,
+	txIndex uint,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	// the gas of the args is already capped
+	msg, err := args.ToMessage(0, s.cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	txConfig := s.txConfig
+	txConfig.TxHash = txHash
+	txConfig.TxIndex = txIndex
+
+	res, err := s.app.EvmKeeper.ApplyMessageWithConfig(s.ctx, msg, nil, true, s.cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// the EVM keeper increments the nonce of contract creations only, the ante handler does it for the other txs
+	stateDB := statedb.New(s.ctx, s.app.EvmKeeper, txConfig)
+	stateDB.SetNonce(msg.From, msg.Nonce+1)
+	if err := stateDB.Commit(); err != nil {
+		return nil, errors.Wrap(err, "unable to increment sender nonce")
+	}
+
+	return res, nil
+}
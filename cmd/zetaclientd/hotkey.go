@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/app"
+	"github.com/zeta-chain/node/zetaclient/authz"
+	"github.com/zeta-chain/node/zetaclient/config"
+	"github.com/zeta-chain/node/zetaclient/keys"
+)
+
+// rotationGasLimit is the gas limit of the hotkey rotation tx
+const rotationGasLimit = 1_000_000
+
+// hotkeyOptions is the struct that holds arguments for the hotkey commands
+type hotkeyOptions struct {
+	password   string
+	newGrantee string
+	oldGrantee string
+}
+
+var hotkeyOpts hotkeyOptions
+
+func setupHotkeyOptions() {
+	f, cfg := HotkeyShowCmd.Flags(), &hotkeyOpts
+	f.StringVar(&cfg.password, "password", "", "the password of the hotkey keyring (file backend)")
+
+	f = HotkeyRotationTxCmd.Flags()
+	f.StringVar(&cfg.newGrantee, "new-grantee", "", "the address of the new hotkey")
+	f.StringVar(&cfg.oldGrantee, "old-grantee", "", "the address of the old hotkey to revoke (optional)")
+}
+
+// HotkeyShow shows the address of the hotkey and the grantee pubkey of the observer
+func HotkeyShow(_ *cobra.Command, _ []string) error {
+	cfg, err := config.Load(globalOpts.ZetacoreHome)
+	if err != nil {
+		return errors.Wrap(err, "unable to load config")
+	}
+
+	kb, granteePubKey, err := keys.GetKeyringKeybase(cfg, hotkeyOpts.password)
+	if err != nil {
+		return errors.Wrap(err, "unable to get keyring")
+	}
+
+	record, err := kb.Key(cfg.AuthzHotkey)
+	if err != nil {
+		return errors.Wrap(err, "unable to get hotkey")
+	}
+
+	address, err := record.GetAddress()
+	if err != nil {
+		return errors.Wrap(err, "unable to get hotkey address")
+	}
+
+	fmt.Printf("hotkey address (%s): %s\n", kb.Backend(), address)
+	fmt.Printf("grantee pubkey: %s\n", granteePubKey)
+
+	return nil
+}
+
+// HotkeyRotationTx prints the unsigned tx rotating the hotkey grants of the operator.
+// The tx must be signed with the operator key and broadcasted, e.g. with "zetacored tx sign" and "zetacored tx broadcast".
+func HotkeyRotationTx(_ *cobra.Command, _ []string) error {
+	cfg, err := config.Load(globalOpts.ZetacoreHome)
+	if err != nil {
+		return errors.Wrap(err, "unable to load config")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(cfg.AuthzGranter)
+	if err != nil {
+		return errors.Wrap(err, "invalid granter address")
+	}
+
+	newGrantee, err := sdk.AccAddressFromBech32(hotkeyOpts.newGrantee)
+	if err != nil {
+		return errors.Wrap(err, "invalid new grantee address")
+	}
+
+	var oldGrantee sdk.AccAddress
+	if hotkeyOpts.oldGrantee != "" {
+		if oldGrantee, err = sdk.AccAddressFromBech32(hotkeyOpts.oldGrantee); err != nil {
+			return errors.Wrap(err, "invalid old grantee address")
+		}
+	}
+
+	msgs, err := authz.HotkeyRotationMsgs(granter, newGrantee, oldGrantee)
+	if err != nil {
+		return errors.Wrap(err, "unable to create rotation messages")
+	}
+
+	txConfig := app.MakeEncodingConfig().TxConfig
+	builder := txConfig.NewTxBuilder()
+	if err = builder.SetMsgs(msgs...); err != nil {
+		return errors.Wrap(err, "unable to set messages")
+	}
+	builder.SetGasLimit(rotationGasLimit)
+
+	tx, err := txConfig.TxJSONEncoder()(builder.GetTx())
+	if err != nil {
+		return errors.Wrap(err, "unable to encode tx")
+	}
+
+	fmt.Println(string(tx))
+
+	return nil
+}
@@ -30,6 +30,8 @@ type initializeConfigOptions struct {
 	TestTSSKeySign      bool
 	KeyringBackend      string
 	RelayerKeyPath      string
+	RemoteSignerURL     string
+	RemoteSignerKeyID   string
 }
 
 var initializeConfigOpts initializeConfigOptions
@@ -43,7 +45,7 @@ func setupInitializeConfigOptions() {
 		usageLogLevel = "log level (0:debug, 1:info, 2:warn, 3:error, 4:fatal, 5:panic)"
 		usageP2PDiag  = "p2p diagnostic ticker (default: 0 means no ticker)"
 		usageTicker   = "config update ticker (default: 0 means no ticker)"
-		usageKeyring  = "keyring backend to use (test, file)"
+		usageKeyring  = "keyring backend to use (test, file, remote)"
 	)
 
 	f.StringVar(&cfg.peer, "peer", "", usagePeer)
@@ -63,6 +65,8 @@ func setupInitializeConfigOptions() {
 	f.BoolVar(&cfg.TestTSSKeySign, "test-tss", false, "set to to true to run a check for TSS keysign on startup")
 	f.StringVar(&cfg.KeyringBackend, "keyring-backend", string(config.KeyringBackendTest), usageKeyring)
 	f.StringVar(&cfg.RelayerKeyPath, "relayer-key-path", "~/.zetacored/relayer-keys", "path to relayer keys")
+	f.StringVar(&cfg.RemoteSignerURL, "remote-signer-url", "", "remote signer URL (remote keyring backend)")
+	f.StringVar(&cfg.RemoteSignerKeyID, "remote-signer-key-id", "", "hotkey id in the remote signer (remote keyring backend)")
 }
 
 // InitializeConfig creates new config for zetaclientd and saves it to the config file.
@@ -97,6 +101,8 @@ func InitializeConfig(_ *cobra.Command, _ []string) error {
 	configData.ConfigUpdateTicker = opts.configUpdateTicker
 	configData.KeyringBackend = config.KeyringBackend(initializeConfigOpts.KeyringBackend)
 	configData.RelayerKeyPath = opts.RelayerKeyPath
+	configData.RemoteSigner.Endpoint = opts.RemoteSignerURL
+	configData.RemoteSigner.KeyID = opts.RemoteSignerKeyID
 	configData.ComplianceConfig = sample.ComplianceConfig()
 
 	// Save config file
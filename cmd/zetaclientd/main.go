@@ -61,6 +61,24 @@ var (
 		Short: "Show relayer address",
 		RunE:  RelayerShowAddress,
 	}
+
+	HotkeyCmd     = &cobra.Command{Use: "hotkey", Short: "Hotkey commands"}
+	HotkeyShowCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show the hotkey address and the grantee pubkey",
+		RunE:  HotkeyShow,
+	}
+	HotkeyRotationTxCmd = &cobra.Command{
+		Use:   "rotation-tx --new-grantee=<address> [--old-grantee=<address>]",
+		Short: "Print the unsigned tx granting the new hotkey and revoking the old one, to sign with the operator key",
+		RunE:  HotkeyRotationTx,
+	}
+
+	VotesCmd = &cobra.Command{
+		Use:   "votes",
+		Short: "Show the history of the votes posted to zetacore",
+		RunE:  ShowVotes,
+	}
 )
 
 // globalOptions defines the global options for all commands.
@@ -82,6 +100,8 @@ func init() {
 	setupGlobalOptions()
 	setupInitializeConfigOptions()
 	setupRelayerOptions()
+	setupVotesOptions()
+	setupHotkeyOptions()
 
 	// Define commands
 	RootCmd.AddCommand(VersionCmd)
@@ -95,6 +115,12 @@ func init() {
 	RootCmd.AddCommand(RelayerCmd)
 	RelayerCmd.AddCommand(RelayerImportKeyCmd)
 	RelayerCmd.AddCommand(RelayerShowAddressCmd)
+
+	RootCmd.AddCommand(HotkeyCmd)
+	HotkeyCmd.AddCommand(HotkeyShowCmd)
+	HotkeyCmd.AddCommand(HotkeyRotationTxCmd)
+
+	RootCmd.AddCommand(VotesCmd)
 }
 
 func main() {
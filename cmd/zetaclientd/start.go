@@ -16,6 +16,7 @@ import (
 	zetaos "github.com/zeta-chain/node/pkg/os"
 	"github.com/zeta-chain/node/pkg/scheduler"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/compliance"
 	"github.com/zeta-chain/node/zetaclient/config"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
 	"github.com/zeta-chain/node/zetaclient/maintenance"
@@ -58,6 +59,13 @@ func Start(_ *cobra.Command, _ []string) error {
 	appContext := zctx.New(cfg, passes.relayerKeys(), logger.Std)
 	ctx := zctx.WithAppContext(context.Background(), appContext)
 
+	// Screen addresses against the restricted addresses file and/or screening service (if configured)
+	screener, err := compliance.NewScreener(ctx, cfg.ComplianceConfig, logger.Compliance)
+	if err != nil {
+		return errors.Wrap(err, "unable to create compliance screener")
+	}
+	ctx = compliance.WithScreener(ctx, screener, cfg.ComplianceConfig.ScreeningFailClosed, logger.Compliance)
+
 	telemetry, err := startTelemetry(ctx, cfg)
 	if err != nil {
 		return errors.Wrap(err, "unable to start telemetry")
@@ -138,6 +146,7 @@ func Start(_ *cobra.Command, _ []string) error {
 		Zetacore:  zetacoreClient,
 		TSS:       tss,
 		DBPath:    dbPath,
+		DBConfig:  cfg.DatabaseConfig,
 		Telemetry: telemetry,
 	}
 
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/zetaclient/config"
+	"github.com/zeta-chain/node/zetaclient/db"
+	"github.com/zeta-chain/node/zetaclient/types"
+)
+
+// votesOptions is the struct that holds arguments for the votes command
+type votesOptions struct {
+	dbPath  string
+	chainID int64
+	nonce   int64
+	ballot  string
+	txHash  string
+	limit   int
+}
+
+var votesOpts votesOptions
+
+// voteView is the printed representation of a vote
+type voteView struct {
+	Time        time.Time `json:"time"`
+	Type        string    `json:"type"`
+	ChainID     int64     `json:"chain_id"`
+	Nonce       uint64    `json:"nonce,omitempty"`
+	BlockHeight uint64    `json:"block_height"`
+	BallotIndex string    `json:"ballot_index"`
+	CctxIndex   string    `json:"cctx_index,omitempty"`
+	TxHash      string    `json:"tx_hash"`
+	CoinType    string    `json:"coin_type"`
+	Amount      string    `json:"amount"`
+	Status      string    `json:"status,omitempty"`
+	ZetaTxHash  string    `json:"zeta_tx_hash"`
+}
+
+func setupVotesOptions() {
+	f, cfg := VotesCmd.Flags(), &votesOpts
+
+	f.StringVar(&cfg.dbPath, "db-path", "", "path to the chain observer databases (default ~/.zetaclient/chainobserver)")
+	f.Int64Var(&cfg.chainID, "chain-id", 0, "filter votes by chain id")
+	f.Int64Var(&cfg.nonce, "nonce", -1, "filter outbound votes by TSS nonce")
+	f.StringVar(&cfg.ballot, "ballot", "", "filter votes by ballot index")
+	f.StringVar(&cfg.txHash, "tx-hash", "", "filter votes by observed tx hash")
+	f.IntVar(&cfg.limit, "limit", 50, "maximum number of votes to show")
+}
+
+// ShowVotes prints the history of the votes posted to zetacore, most recent first
+func ShowVotes(_ *cobra.Command, _ []string) error {
+	dbPath := votesOpts.dbPath
+	if dbPath == "" {
+		resolved, err := config.ResolveDBPath()
+		if err != nil {
+			return errors.Wrap(err, "unable to resolve db path")
+		}
+		dbPath = resolved
+	}
+
+	filter := db.VoteFilter{
+		ChainID:     votesOpts.chainID,
+		BallotIndex: votesOpts.ballot,
+		TxHash:      votesOpts.txHash,
+		Limit:       votesOpts.limit,
+	}
+	if votesOpts.nonce >= 0 {
+		nonce := uint64(votesOpts.nonce)
+		filter.Nonce = &nonce
+	}
+
+	// the chain observer databases are stored in Postgres if configured so
+	var dbConfig config.DatabaseConfig
+	if cfg, err := config.Load(globalOpts.ZetacoreHome); err == nil {
+		dbConfig = cfg.DatabaseConfig
+	}
+
+	var (
+		votes []types.VoteSQLType
+		err   error
+	)
+	if dbConfig.IsPostgres() {
+		votes, err = queryPostgresVotes(dbConfig.PostgresDSN, filter)
+	} else {
+		votes, err = querySqliteVotes(dbPath, filter)
+	}
+	if err != nil {
+		return err
+	}
+
+	views := make([]voteView, 0, len(votes))
+	for _, vote := range votes {
+		views = append(views, voteView{
+			Time:        vote.CreatedAt,
+			Type:        vote.Type,
+			ChainID:     vote.ChainID,
+			Nonce:       vote.Nonce,
+			BlockHeight: vote.BlockHeight,
+			BallotIndex: vote.BallotIndex,
+			CctxIndex:   vote.CctxIndex,
+			TxHash:      vote.TxHash,
+			CoinType:    vote.CoinType,
+			Amount:      vote.Amount,
+			Status:      vote.Status,
+			ZetaTxHash:  vote.ZetaTxHash,
+		})
+	}
+
+	out, err := json.MarshalIndent(views, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal votes")
+	}
+	fmt.Println(string(out))
+
+	return nil
+}
+
+// querySqliteVotes queries the votes from all chain observer database files in the directory
+func querySqliteVotes(dbPath string, filter db.VoteFilter) ([]types.VoteSQLType, error) {
+	entries, err := os.ReadDir(dbPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read db path %s", dbPath)
+	}
+
+	var votes []types.VoteSQLType
+	for _, entry := range entries {
+		if entry.IsDir() || isSqliteSideFile(entry.Name()) {
+			continue
+		}
+
+		database, err := db.NewFromSqlite(dbPath, entry.Name(), false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to open database %s", filepath.Join(dbPath, entry.Name()))
+		}
+
+		chainVotes, err := queryVotesFromDB(database, filter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to query votes from %s", filepath.Join(dbPath, entry.Name()))
+		}
+		votes = append(votes, chainVotes...)
+	}
+
+	return sortVotes(votes, filter.Limit), nil
+}
+
+// queryPostgresVotes queries the votes from all chain observer schemas of the Postgres database
+func queryPostgresVotes(dsn string, filter db.VoteFilter) ([]types.VoteSQLType, error) {
+	schemas, err := db.ListPostgresSchemas(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	var votes []types.VoteSQLType
+	for _, schema := range schemas {
+		database, err := db.NewFromPostgres(db.PostgresConfig{DSN: dsn, Schema: schema}, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to open database schema %s", schema)
+		}
+
+		chainVotes, err := queryVotesFromDB(database, filter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to query votes from schema %s", schema)
+		}
+		votes = append(votes, chainVotes...)
+	}
+
+	return sortVotes(votes, filter.Limit), nil
+}
+
+// sortVotes sorts the votes of all chains most recent first and applies the limit
+func sortVotes(votes []types.VoteSQLType, limit int) []types.VoteSQLType {
+	sort.SliceStable(votes, func(i, j int) bool {
+		return votes[i].CreatedAt.After(votes[j].CreatedAt)
+	})
+
+	if limit > 0 && len(votes) > limit {
+		votes = votes[:limit]
+	}
+
+	return votes
+}
+
+// queryVotesFromDB queries the votes from the database and closes it
+func queryVotesFromDB(database *db.DB, filter db.VoteFilter) ([]types.VoteSQLType, error) {
+	defer database.Close()
+
+	// databases created by older versions don't have vote history
+	if !database.HasVoteHistory() {
+		return nil, nil
+	}
+
+	return database.QueryVotes(filter)
+}
+
+// isSqliteSideFile returns true if the file is a sqlite journal file rather than a database
+func isSqliteSideFile(name string) bool {
+	return strings.HasSuffix(name, "-journal") || strings.HasSuffix(name, "-wal") || strings.HasSuffix(name, "-shm")
+}
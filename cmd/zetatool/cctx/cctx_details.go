@@ -38,6 +38,10 @@ func (c *TrackingDetails) UpdateStatusFromZetacoreCCTX(status crosschaintypes.Cc
 		c.Status = PendingRevert
 	case crosschaintypes.CctxStatus_Aborted:
 		c.Status = Aborted
+	case crosschaintypes.CctxStatus_PendingInboundRateLimit:
+		c.Status = PendingInboundRateLimit
+	case crosschaintypes.CctxStatus_PendingOutboundHeld:
+		c.Status = PendingOutboundHeld
 	default:
 		c.Status = Unknown
 	}
@@ -31,6 +31,11 @@ const (
 	// PendingRevertVoting the revert transaction is confirmed on the outbound chain,
 	//and we are waiting for observers to vote
 	PendingRevertVoting Status = 13
+	// PendingInboundRateLimit the inbound is held by the inbound rate limiter of the inbound chain on zetacore
+	PendingInboundRateLimit Status = 14
+	// PendingOutboundHeld the outbound exceeds the held threshold of the outbound chain,
+	//and we are waiting for the authority to release or reject it
+	PendingOutboundHeld Status = 15
 )
 
 func (s Status) String() string {
@@ -61,6 +66,10 @@ func (s Status) String() string {
 		return "PendingRevertSigning"
 	case PendingOutboundVoting:
 		return "PendingOutboundVoting"
+	case PendingInboundRateLimit:
+		return "PendingInboundRateLimit"
+	case PendingOutboundHeld:
+		return "PendingOutboundHeld"
 	default:
 		return "Unknown"
 	}
@@ -8,6 +8,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gagliardetto/solana-go"
 	solrpc "github.com/gagliardetto/solana-go/rpc"
+	tontools "github.com/tonkeeper/tongo/ton"
 	"github.com/zeta-chain/protocol-contracts/pkg/erc20custody.sol"
 	"github.com/zeta-chain/protocol-contracts/pkg/gatewayevm.sol"
 	"github.com/zeta-chain/protocol-contracts/pkg/zetaconnector.non-eth.sol"
@@ -16,12 +17,16 @@ import (
 	"github.com/zeta-chain/node/cmd/zetatool/context"
 	"github.com/zeta-chain/node/pkg/chains"
 	solanacontracts "github.com/zeta-chain/node/pkg/contracts/solana"
+	suicontracts "github.com/zeta-chain/node/pkg/contracts/sui"
+	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/client"
 	zetaevmclient "github.com/zeta-chain/node/zetaclient/chains/evm/client"
 	"github.com/zeta-chain/node/zetaclient/chains/solana/observer"
 	solanarpc "github.com/zeta-chain/node/zetaclient/chains/solana/rpc"
+	suiclient "github.com/zeta-chain/node/zetaclient/chains/sui/client"
+	"github.com/zeta-chain/node/zetaclient/chains/ton/liteapi"
 	zetaclientConfig "github.com/zeta-chain/node/zetaclient/config"
 )
 
@@ -78,6 +83,28 @@ func (c *TrackingDetails) CheckInbound(ctx *context.Context) error {
 				)
 			}
 		}
+	case inboundChain.IsTONChain():
+		{
+			err = c.tonInboundBallotIdentifier(ctx)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to get inbound ballot for ton chain %d, %w",
+					inboundChain.ChainId,
+					err,
+				)
+			}
+		}
+	case inboundChain.IsSuiChain():
+		{
+			err = c.suiInboundBallotIdentifier(ctx)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to get inbound ballot for sui chain %d, %w",
+					inboundChain.ChainId,
+					err,
+				)
+			}
+		}
 	default:
 		return fmt.Errorf("unsupported chain type %d", inboundChain.ChainId)
 	}
@@ -345,6 +372,91 @@ func (c *TrackingDetails) solanaInboundBallotIdentifier(ctx *context.Context) er
 	return nil
 }
 
+// tonInboundBallotIdentifier gets the inbound ballot identifier for the inbound hash from ton chain
+func (c *TrackingDetails) tonInboundBallotIdentifier(ctx *context.Context) error {
+	var (
+		inboundHash    = ctx.GetInboundHash()
+		inboundChain   = ctx.GetInboundChain()
+		zetacoreClient = ctx.GetZetaCoreClient()
+		zetaChainID    = ctx.GetConfig().ZetaChainID
+		cfg            = ctx.GetConfig()
+		goCtx          = ctx.GetContext()
+	)
+
+	chainParams, err := zetacoreClient.GetChainParamsForChainID(goCtx, inboundChain.ChainId)
+	if err != nil {
+		return fmt.Errorf("failed to get chain params: %w", err)
+	}
+
+	gatewayID, err := tontools.ParseAccountID(chainParams.GatewayAddress)
+	if err != nil {
+		return fmt.Errorf("cannot parse gateway address: %s, err: %w", chainParams.GatewayAddress, err)
+	}
+
+	tonClient, err := liteapi.NewFromSource(goCtx, cfg.TONConfigURL)
+	if err != nil {
+		return fmt.Errorf("error creating lite client: %w", err)
+	}
+
+	cctxIdentifier, err := zetatoolchains.TONBallotIdentifier(
+		ctx,
+		tonClient,
+		toncontracts.NewGateway(gatewayID),
+		inboundHash,
+		inboundChain.ChainId,
+		zetaChainID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get ton ballot identifier: %w", err)
+	}
+
+	// transactions returned by the lite client are final
+	c.CCTXIdentifier = cctxIdentifier
+	c.Status = PendingInboundVoting
+
+	return nil
+}
+
+// suiInboundBallotIdentifier gets the inbound ballot identifier for the inbound hash from sui chain
+func (c *TrackingDetails) suiInboundBallotIdentifier(ctx *context.Context) error {
+	var (
+		inboundHash    = ctx.GetInboundHash()
+		inboundChain   = ctx.GetInboundChain()
+		zetacoreClient = ctx.GetZetaCoreClient()
+		zetaChainID    = ctx.GetConfig().ZetaChainID
+		cfg            = ctx.GetConfig()
+		goCtx          = ctx.GetContext()
+	)
+
+	chainParams, err := zetacoreClient.GetChainParamsForChainID(goCtx, inboundChain.ChainId)
+	if err != nil {
+		return fmt.Errorf("failed to get chain params: %w", err)
+	}
+
+	gateway, err := suicontracts.NewGatewayFromAddress(chainParams.GatewayAddress)
+	if err != nil {
+		return fmt.Errorf("cannot parse gateway address: %s, err: %w", chainParams.GatewayAddress, err)
+	}
+
+	cctxIdentifier, err := zetatoolchains.SuiBallotIdentifier(
+		ctx,
+		suiclient.NewFromEndpoint(cfg.SuiRPC),
+		gateway,
+		inboundHash,
+		inboundChain.ChainId,
+		zetaChainID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get sui ballot identifier: %w", err)
+	}
+
+	// transactions returned by the rpc are final
+	c.CCTXIdentifier = cctxIdentifier
+	c.Status = PendingInboundVoting
+
+	return nil
+}
+
 // zevmInboundBallotIdentifier gets the inbound ballot identifier for the inbound hash from zetachain
 func (c *TrackingDetails) zevmInboundBallotIdentifier(ctx *context.Context) error {
 	var (
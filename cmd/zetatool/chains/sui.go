@@ -0,0 +1,56 @@
+package chains
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/models"
+
+	"github.com/zeta-chain/node/cmd/zetatool/context"
+	"github.com/zeta-chain/node/pkg/contracts/sui"
+	suiclient "github.com/zeta-chain/node/zetaclient/chains/sui/client"
+	suiobserver "github.com/zeta-chain/node/zetaclient/chains/sui/observer"
+)
+
+// SuiBallotIdentifier returns the ballot identifier of the gateway deposit emitted by the Sui tx with the given digest
+func SuiBallotIdentifier(
+	ctx *context.Context,
+	client *suiclient.Client,
+	gateway *sui.Gateway,
+	txDigest string,
+	senderChainID int64,
+	zetacoreChainID int64,
+) (string, error) {
+	tx, err := client.SuiGetTransactionBlock(ctx.GetContext(), models.SuiGetTransactionBlockRequest{
+		Digest:  txDigest,
+		Options: models.SuiTransactionBlockOptions{ShowEvents: true},
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to get transaction block: %w", err)
+	}
+
+	for _, raw := range tx.Events {
+		event, err := gateway.ParseEvent(raw)
+		switch {
+		case errors.Is(err, sui.ErrParseEvent):
+			// not a gateway event
+			continue
+		case err != nil:
+			return "", fmt.Errorf("unable to parse event: %w", err)
+		case !event.IsInbound():
+			continue
+		case event.EventIndex != 0:
+			// observers don't vote on these inbounds either
+			return "", fmt.Errorf("unexpected event index %d for tx %s", event.EventIndex, txDigest)
+		}
+
+		msg, err := suiobserver.BuildInboundVoteMsg(event, tx, senderChainID, zetacoreChainID, "")
+		if err != nil {
+			return "", fmt.Errorf("unable to build inbound vote: %w", err)
+		}
+
+		return msg.Digest(), nil
+	}
+
+	return "", fmt.Errorf("no inbound event found in transaction %s", txDigest)
+}
@@ -0,0 +1,171 @@
+package chains
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/block-vision/sui-go-sdk/models"
+	suisdk "github.com/block-vision/sui-go-sdk/sui"
+	"github.com/stretchr/testify/require"
+
+	zetatoolcontext "github.com/zeta-chain/node/cmd/zetatool/context"
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/contracts/sui"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	suiclient "github.com/zeta-chain/node/zetaclient/chains/sui/client"
+	"github.com/zeta-chain/node/zetaclient/zetacore"
+)
+
+// suiAPIStub returns the given transaction blocks by digest
+type suiAPIStub struct {
+	suisdk.ISuiAPI
+	txs map[string]models.SuiTransactionBlockResponse
+}
+
+func (s suiAPIStub) SuiGetTransactionBlock(
+	_ context.Context,
+	req models.SuiGetTransactionBlockRequest,
+) (models.SuiTransactionBlockResponse, error) {
+	tx, ok := s.txs[req.Digest]
+	if !ok || !req.Options.ShowEvents {
+		return models.SuiTransactionBlockResponse{}, fmt.Errorf("tx %s not found", req.Digest)
+	}
+	return tx, nil
+}
+
+func TestSuiBallotIdentifier(t *testing.T) {
+	const (
+		packageID = "0x5d4b302506645c37ff133b98c4b50a5ae14841659738d6d733d59d0d217a93bf"
+		objectID  = "0x8d97f1cd6ac663735be08d1d2b6d02a159e711586461306ce60a2b7a6a565a9e"
+		usdc      = "0x5d4b302506645c37ff133b98c4b50a5ae14841659738d6d733d59d0d217a93bf::coin::COIN"
+		sender    = "0xd4bbb3b0c6c2a6f0d6fcd1b9dbc23b3c9b0dc7b6d1d5a25e9f1d9e3b6e1a0c7e"
+	)
+
+	gateway := sui.NewGateway(packageID, objectID)
+	receiver := sample.EthAddress()
+
+	event := func(digest, name, seq string, kv map[string]any) models.SuiEventResponse {
+		return models.SuiEventResponse{
+			Id:                models.EventId{TxDigest: digest, EventSeq: seq},
+			PackageId:         packageID,
+			TransactionModule: gateway.Module(),
+			Sender:            sender,
+			Type:              fmt.Sprintf("%s::%s::%s", packageID, gateway.Module(), name),
+			ParsedJson:        kv,
+		}
+	}
+
+	txs := map[string]models.SuiTransactionBlockResponse{
+		"TX_deposit": {
+			Digest:     "TX_deposit",
+			Checkpoint: "10000",
+			Events: []models.SuiEventResponse{
+				event("TX_deposit", "something", "0", map[string]any{}),
+				event("TX_deposit", string(sui.DepositAndCall), "0", map[string]any{
+					"coin_type": usdc,
+					"amount":    "300",
+					"sender":    sender,
+					"receiver":  receiver.String(),
+					"payload":   []any{float64(1), float64(2), float64(3)},
+				}),
+			},
+		},
+		"TX_second_event": {
+			Digest:     "TX_second_event",
+			Checkpoint: "10001",
+			Events: []models.SuiEventResponse{
+				event("TX_second_event", string(sui.Deposit), "1", map[string]any{
+					"coin_type": string(sui.SUI),
+					"amount":    "200",
+					"sender":    sender,
+					"receiver":  receiver.String(),
+				}),
+			},
+		},
+		"TX_no_inbound": {
+			Digest:     "TX_no_inbound",
+			Checkpoint: "10002",
+		},
+	}
+
+	ctx, err := zetatoolcontext.NewContext(context.Background(), chains.SuiMainnet.ChainId, "TX_deposit", "")
+	require.NoError(t, err)
+
+	client := suiclient.New(suiAPIStub{txs: txs})
+
+	t.Run("reconstructs the ballot of the observer vote", func(t *testing.T) {
+		// the vote posted by the observers for the deposit
+		vote := crosschaintypes.NewMsgVoteInbound(
+			sample.AccAddress(),
+			sender,
+			chains.SuiMainnet.ChainId,
+			sender,
+			receiver.String(),
+			chains.ZetaChainMainnet.ChainId,
+			math.NewUint(300),
+			"010203",
+			"TX_deposit",
+			10000,
+			zetacore.PostVoteInboundCallOptionsGasLimit,
+			coin.CoinType_ERC20,
+			usdc,
+			0,
+			crosschaintypes.ProtocolContractVersion_V2,
+			false,
+			crosschaintypes.InboundStatus_SUCCESS,
+			crosschaintypes.ConfirmationMode_SAFE,
+			crosschaintypes.WithCrossChainCall(true),
+		)
+
+		ballot, err := SuiBallotIdentifier(
+			ctx,
+			client,
+			gateway,
+			"TX_deposit",
+			chains.SuiMainnet.ChainId,
+			chains.ZetaChainMainnet.ChainId,
+		)
+		require.NoError(t, err)
+		require.Equal(t, vote.Digest(), ballot)
+	})
+
+	t.Run("fails on unexpected event index", func(t *testing.T) {
+		_, err := SuiBallotIdentifier(
+			ctx,
+			client,
+			gateway,
+			"TX_second_event",
+			chains.SuiMainnet.ChainId,
+			chains.ZetaChainMainnet.ChainId,
+		)
+		require.ErrorContains(t, err, "unexpected event index 1")
+	})
+
+	t.Run("fails without inbound event", func(t *testing.T) {
+		_, err := SuiBallotIdentifier(
+			ctx,
+			client,
+			gateway,
+			"TX_no_inbound",
+			chains.SuiMainnet.ChainId,
+			chains.ZetaChainMainnet.ChainId,
+		)
+		require.ErrorContains(t, err, "no inbound event found")
+	})
+
+	t.Run("fails if tx not found", func(t *testing.T) {
+		_, err := SuiBallotIdentifier(
+			ctx,
+			client,
+			gateway,
+			"TX_unknown",
+			chains.SuiMainnet.ChainId,
+			chains.ZetaChainMainnet.ChainId,
+		)
+		require.ErrorContains(t, err, "unable to get transaction block")
+	})
+}
@@ -0,0 +1,57 @@
+package chains
+
+import (
+	"fmt"
+
+	"github.com/zeta-chain/node/cmd/zetatool/context"
+	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
+	"github.com/zeta-chain/node/zetaclient/chains/ton/liteapi"
+	tonobserver "github.com/zeta-chain/node/zetaclient/chains/ton/observer"
+)
+
+// TONBallotIdentifier returns the ballot identifier of a TON gateway deposit,
+// the inbound hash is the "logicalTime:hash" identifier of the gateway transaction
+func TONBallotIdentifier(
+	ctx *context.Context,
+	client *liteapi.Client,
+	gateway *toncontracts.Gateway,
+	inboundHash string,
+	senderChainID int64,
+	zetacoreChainID int64,
+) (string, error) {
+	goCtx := ctx.GetContext()
+
+	lt, hash, err := liteapi.TransactionHashFromString(inboundHash)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse inbound hash %s: %w", inboundHash, err)
+	}
+
+	raw, err := client.GetTransaction(goCtx, gateway.AccountID(), lt, hash)
+	if err != nil {
+		return "", fmt.Errorf("unable to get transaction: %w", err)
+	}
+
+	tx, err := gateway.ParseTransaction(raw)
+	switch {
+	case err != nil:
+		return "", fmt.Errorf("unable to parse gateway transaction: %w", err)
+	case tx.ExitCode != 0:
+		return "", fmt.Errorf("transaction failed with exit code %d", tx.ExitCode)
+	case tx.IsOutbound():
+		return "", fmt.Errorf("transaction %s is an outbound", inboundHash)
+	case tx.Operation == toncontracts.OpDonate:
+		return "", fmt.Errorf("transaction %s is a donation, no inbound is created", inboundHash)
+	}
+
+	blockHeader, err := client.GetBlockHeader(goCtx, tx.BlockID, 0)
+	if err != nil {
+		return "", fmt.Errorf("unable to get block header %s: %w", tx.BlockID.String(), err)
+	}
+
+	msg, err := tonobserver.BuildInboundVoteMsg(tx, blockHeader.MinRefMcSeqno, senderChainID, zetacoreChainID, "")
+	if err != nil {
+		return "", fmt.Errorf("unable to build inbound vote: %w", err)
+	}
+
+	return msg.Digest(), nil
+}
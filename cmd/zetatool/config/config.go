@@ -30,6 +30,8 @@ func TestnetConfig() *Config {
 		BscRPC:       "https://bsc-testnet-rpc.publicnode.com",
 		PolygonRPC:   "https://polygon-amoy.gateway.tenderly.com",
 		BaseRPC:      "https://base-sepolia-rpc.publicnode.com",
+		TONConfigURL: "https://ton.org/testnet-global.config.json",
+		SuiRPC:       "https://fullnode.testnet.sui.io:443",
 	}
 }
 
@@ -46,6 +48,8 @@ func DevnetConfig() *Config {
 		BscRPC:       "",
 		PolygonRPC:   "",
 		BaseRPC:      "",
+		TONConfigURL: "",
+		SuiRPC:       "",
 	}
 }
 
@@ -62,6 +66,8 @@ func MainnetConfig() *Config {
 		BaseRPC:      "https://base-mainnet.public.blastapi.io",
 		BscRPC:       "https://bsc-mainnet.public.blastapi.io",
 		PolygonRPC:   "https://polygon-bor-rpc.publicnode.com",
+		TONConfigURL: "https://ton.org/global-config.json",
+		SuiRPC:       "https://fullnode.mainnet.sui.io:443",
 	}
 }
 
@@ -76,6 +82,8 @@ func PrivateNetConfig() *Config {
 		BtcHost:      "127.0.0.1:18443",
 		BtcParams:    "regtest",
 		SolanaRPC:    "http://127.0.0.1:8899",
+		TONConfigURL: "http://127.0.0.1:8000/lite-client.json",
+		SuiRPC:       "http://127.0.0.1:9000",
 	}
 }
 
@@ -92,6 +100,9 @@ type Config struct {
 	BscRPC       string `json:"bsc_rpc"`
 	PolygonRPC   string `json:"polygon_rpc"`
 	BaseRPC      string `json:"base_rpc"`
+	// TONConfigURL is the URL (or path) of the TON lite client config
+	TONConfigURL string `json:"ton_config_url"`
+	SuiRPC       string `json:"sui_rpc"`
 }
 
 func (c *Config) Save() error {
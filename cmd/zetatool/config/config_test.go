@@ -25,6 +25,8 @@ func TestRead(t *testing.T) {
 		require.Equal(t, "https://bsc-testnet-rpc.publicnode.com", c.BscRPC)
 		require.Equal(t, "https://polygon-amoy.gateway.tenderly.com", c.PolygonRPC)
 		require.Equal(t, "https://base-sepolia-rpc.publicnode.com", c.BaseRPC)
+		require.Equal(t, "https://ton.org/testnet-global.config.json", c.TONConfigURL)
+		require.Equal(t, "https://fullnode.testnet.sui.io:443", c.SuiRPC)
 	})
 }
 
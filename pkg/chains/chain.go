@@ -110,6 +110,10 @@ func (chain Chain) IsTONChain() bool {
 	return chain.Consensus == Consensus_catchain_consensus
 }
 
+func (chain Chain) IsSuiChain() bool {
+	return chain.Consensus == Consensus_sui_consensus
+}
+
 func (chain Chain) LogFields() map[string]any {
 	return map[string]any{
 		logs.FieldChain:        chain.ChainId,
@@ -1,10 +1,12 @@
 package chains
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
 )
 
 // NonceMarkAmount uses special value to mark current nonce in UTXO
@@ -23,6 +25,22 @@ func StringToHash(chainID int64, hash string, additionalChains []Chain) ([]byte,
 			return nil, err
 		}
 		return hash.CloneBytes(), nil
+	} else if IsSolanaChain(chainID, additionalChains) {
+		hash, err := solana.HashFromBase58(hash)
+		if err != nil {
+			return nil, err
+		}
+		return hash[:], nil
+	} else if IsTONChain(chainID, additionalChains) {
+		// TON block root hash in hex
+		hashBytes, err := hex.DecodeString(hash)
+		if err != nil {
+			return nil, err
+		}
+		if len(hashBytes) != 32 {
+			return nil, fmt.Errorf("invalid ton block hash length %d", len(hashBytes))
+		}
+		return hashBytes, nil
 	}
 	return nil, fmt.Errorf("cannot convert hash to bytes for chain %d", chainID)
 }
@@ -1,10 +1,13 @@
 package chains
 
 import (
+	"bytes"
+	"encoding/hex"
 	"testing"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,7 +32,11 @@ func TestNonceMarkAmount(t *testing.T) {
 func TestStringToHash(t *testing.T) {
 	evmChainId := int64(5)
 	btcChainId := int64(8332)
+	solanaChainId := SolanaMainnet.ChainId
+	tonChainId := TONMainnet.ChainId
 	unknownChainId := int64(3)
+	solanaHash := solana.HashFromBytes(bytes.Repeat([]byte{1}, 32))
+	tonHash := bytes.Repeat([]byte{2}, 32)
 	wrontBtcHash := "00000000000000000002dcaa3853ac587d4cafdd0aa1fff45942ab5798f29afd00000000000000000002dcaa3853ac587d4cafdd0aa1fff45942ab5798f29afd"
 	expectedBtcHash, err := chainhash.NewHashFromStr("00000000000000000002dcaa3853ac587d4cafdd0aa1fff45942ab5798f29afd")
 	require.NoError(t, err)
@@ -50,6 +57,10 @@ func TestStringToHash(t *testing.T) {
 		},
 		{"btc chain", btcChainId, expectedBtcHash.String(), expectedBtcHash.CloneBytes(), false},
 		{"btc chain invalid hash", btcChainId, wrontBtcHash, nil, true},
+		{"solana chain", solanaChainId, solanaHash.String(), solanaHash[:], false},
+		{"solana chain invalid hash", solanaChainId, "0xinvalid", nil, true},
+		{"ton chain", tonChainId, hex.EncodeToString(tonHash), tonHash, false},
+		{"ton chain invalid hash length", tonChainId, hex.EncodeToString(tonHash[:31]), nil, true},
 		{"unknown chain", unknownChainId, "", nil, true},
 	}
 
@@ -32,6 +32,17 @@ const (
 	// The number 890880 comes from CLI command `solana rent 0` and has been verified on devnet gateway program
 	SolanaWalletRentExempt = 1_000_000
 
+	// SolanaComputeUnitLimit is the compute unit limit requested by Solana outbound transactions
+	// It's the default compute budget of a Solana instruction, which is sufficient for all gateway instructions
+	SolanaComputeUnitLimit = 200_000
+
+	// SolanaDefaultPriorityFee is the default priority fee (in micro-lamports per compute unit) of Solana outbounds
+	// It's used as the base of priority fee increase when there is no recent prioritization fee on the network
+	SolanaDefaultPriorityFee = 10_000
+
+	// SolanaMicroLamportsPerLamport is the number of micro-lamports in a lamport
+	SolanaMicroLamportsPerLamport = 1_000_000
+
 	// EVMZeroAddress is the zero address for EVM address format
 	EVMZeroAddress = "0x0000000000000000000000000000000000000000"
 
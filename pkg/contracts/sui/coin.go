@@ -0,0 +1,27 @@
+package sui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// coinTypeRegex matches a Move coin type as it's emitted by the gateway events:
+// "<64 hex chars package address>::<module>::<struct>", optionally with the 0x prefix
+var coinTypeRegex = regexp.MustCompile(`^(0x)?[0-9a-f]{64}::[a-zA-Z_][a-zA-Z0-9_]*::[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// ValidateCoinType checks that the coin type is a valid Move coin type that can be whitelisted.
+// SUI is the gas token of the chain, so it's supported without whitelisting.
+func ValidateCoinType(coinType string) error {
+	switch {
+	case coinType == "":
+		return errors.New("empty coin type")
+	case strings.TrimPrefix(coinType, "0x") == string(SUI):
+		return errors.New("SUI is the gas coin type")
+	case !coinTypeRegex.MatchString(coinType):
+		return errors.Errorf("invalid coin type %q", coinType)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,38 @@
+package sui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCoinType(t *testing.T) {
+	const usdc = "a1ec7fc00a6f40db9693ad1415d0c193ad3906494428cf252621037bd7117e29::usdc::USDC"
+
+	for _, tt := range []struct {
+		name        string
+		coinType    string
+		errContains string
+	}{
+		{name: "valid coin type", coinType: usdc},
+		{name: "valid coin type with underscores", coinType: usdc[:64] + "::my_coin::MY_COIN"},
+		{name: "empty", coinType: "", errContains: "empty coin type"},
+		{name: "valid coin type with 0x prefix", coinType: "0x" + usdc},
+		{name: "gas coin", coinType: string(SUI), errContains: "gas coin type"},
+		{name: "gas coin with 0x prefix", coinType: "0x" + string(SUI), errContains: "gas coin type"},
+		{name: "short address", coinType: "2::usdc::USDC", errContains: "invalid coin type"},
+		{name: "uppercase address", coinType: "A1" + usdc[2:], errContains: "invalid coin type"},
+		{name: "missing struct", coinType: usdc[:64] + "::usdc", errContains: "invalid coin type"},
+		{name: "generic struct", coinType: usdc + "<u8>", errContains: "invalid coin type"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCoinType(tt.coinType)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
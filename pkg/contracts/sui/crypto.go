@@ -0,0 +1,86 @@
+package sui
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+)
+
+// flagSecp256k1 is the signature scheme flag of secp256k1 in Sui
+// See https://docs.sui.io/concepts/cryptography/transaction-auth/signatures
+const flagSecp256k1 = 0x01
+
+// intentTransactionData is the intent prefix of a transaction data message:
+// [scope: TransactionData, version: V0, app_id: Sui]
+var intentTransactionData = []byte{0, 0, 0}
+
+// Digest calculates tx digest (hash) for further signing by TSS.
+// Sui hashes the intent message with Blake2b first;
+// then for ECDSA Secp256k1 signing, SHA256 is used as the internal hash function.
+//
+// See https://docs.sui.io/concepts/cryptography/transaction-auth/signatures#signature-requirements
+func Digest(txBytesBase64 string) ([32]byte, error) {
+	txBytes, err := base64.StdEncoding.DecodeString(txBytesBase64)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "unable to decode tx bytes")
+	}
+
+	message := make([]byte, 0, len(intentTransactionData)+len(txBytes))
+	message = append(message, intentTransactionData...)
+	message = append(message, txBytes...)
+
+	hash := blake2b.Sum256(message)
+
+	return sha256.Sum256(hash[:]), nil
+}
+
+// AddressFromPubKeyECDSA converts ECDSA public key to Sui address.
+// Address is the Blake2b hash of the signature flag followed by the compressed public key.
+//
+// See https://docs.sui.io/concepts/cryptography/transaction-auth/keys-addresses#address-format
+func AddressFromPubKeyECDSA(pk *ecdsa.PublicKey) string {
+	payload := append([]byte{flagSecp256k1}, crypto.CompressPubkey(pk)...)
+	hash := blake2b.Sum256(payload)
+
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// SerializeSignatureECDSA serializes a 65-byte [R, S, V] signature into Sui format
+// flag || R || S || compressed pubkey, encoded as base64.
+// Note that Sui requires S to be normalized (low S).
+func SerializeSignatureECDSA(signature [65]byte, pk *ecdsa.PublicKey) (string, error) {
+	if pk == nil {
+		return "", errors.New("public key is nil")
+	}
+
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:64])
+
+	curveOrder := btcec.S256().N
+	halfOrder := new(big.Int).Rsh(curveOrder, 1)
+
+	if r.Sign() == 0 || s.Sign() == 0 {
+		return "", errors.New("invalid signature")
+	}
+
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(curveOrder, s)
+	}
+
+	const sigLen = 64
+
+	serialized := make([]byte, 0, 1+sigLen+33)
+	serialized = append(serialized, flagSecp256k1)
+	serialized = append(serialized, r.FillBytes(make([]byte, 32))...)
+	serialized = append(serialized, s.FillBytes(make([]byte, 32))...)
+	serialized = append(serialized, crypto.CompressPubkey(pk)...)
+
+	return base64.StdEncoding.EncodeToString(serialized), nil
+}
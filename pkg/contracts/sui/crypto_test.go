@@ -0,0 +1,75 @@
+package sui
+
+import (
+	"crypto/ecdsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrypto(t *testing.T) {
+	t.Run("Digest", func(t *testing.T) {
+		_, err := Digest("not base64!")
+		require.ErrorContains(t, err, "unable to decode tx bytes")
+
+		digestA, err := Digest(base64.StdEncoding.EncodeToString([]byte("tx_a")))
+		require.NoError(t, err)
+
+		digestB, err := Digest(base64.StdEncoding.EncodeToString([]byte("tx_b")))
+		require.NoError(t, err)
+
+		assert.NotEqual(t, digestA, digestB)
+	})
+
+	t.Run("AddressFromPubKeyECDSA", func(t *testing.T) {
+		pk, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		addr := AddressFromPubKeyECDSA(&pk.PublicKey)
+
+		assert.Len(t, addr, 66)
+		assert.Equal(t, "0x", addr[:2])
+	})
+
+	t.Run("SerializeSignatureECDSA", func(t *testing.T) {
+		pk, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		digest, err := Digest(base64.StdEncoding.EncodeToString([]byte("hello")))
+		require.NoError(t, err)
+
+		sig, err := crypto.Sign(digest[:], pk)
+		require.NoError(t, err)
+
+		var sig65 [65]byte
+		copy(sig65[:], sig)
+
+		// make S high to check normalization
+		s := new(big.Int).SetBytes(sig65[32:64])
+		highS := new(big.Int).Sub(btcec.S256().N, s)
+		highS.FillBytes(sig65[32:64])
+
+		serialized, err := SerializeSignatureECDSA(sig65, &pk.PublicKey)
+		require.NoError(t, err)
+
+		raw, err := base64.StdEncoding.DecodeString(serialized)
+		require.NoError(t, err)
+		require.Len(t, raw, 1+64+33)
+
+		assert.Equal(t, byte(flagSecp256k1), raw[0])
+		assert.Equal(t, sig65[:32], raw[1:33])
+		assert.Equal(t, s.FillBytes(make([]byte, 32)), raw[33:65])
+		assert.Equal(t, crypto.CompressPubkey(&pk.PublicKey), raw[65:])
+
+		// signature is valid
+		assert.True(t, crypto.VerifySignature(raw[65:], digest[:], raw[1:65]))
+
+		_, err = SerializeSignatureECDSA(sig65, (*ecdsa.PublicKey)(nil))
+		require.Error(t, err)
+	})
+}
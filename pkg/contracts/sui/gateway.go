@@ -1,6 +1,7 @@
 package sui
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -16,7 +17,11 @@ type EventType string
 
 // Gateway contains the API to read inbounds and sign outbounds to the Sui gateway
 type Gateway struct {
+	// packageID is the package ID of the gateway
 	packageID string
+
+	// objectID is the ID of the shared gateway object that holds the vaults
+	objectID string
 }
 
 // SUI is the coin type for SUI, native gas token
@@ -24,20 +29,72 @@ const SUI CoinType = "0000000000000000000000000000000000000000000000000000000000
 
 // Event types
 const (
-	Deposit        EventType = "DepositEvent"
-	DepositAndCall EventType = "DepositAndCallEvent"
+	Deposit         EventType = "DepositEvent"
+	DepositAndCall  EventType = "DepositAndCallEvent"
+	Withdraw        EventType = "WithdrawEvent"
+	WithdrawAndCall EventType = "WithdrawAndCallEvent"
+	NonceIncrease   EventType = "NonceIncreaseEvent"
+	Whitelist       EventType = "WhitelistEvent"
+)
+
+// Gateway functions
+const (
+	FuncWithdraw        = "withdraw"
+	FuncWithdrawAndCall = "withdraw_and_call"
+	FuncIncreaseNonce   = "increase_nonce"
+	FuncWhitelist       = "whitelist"
 )
 
 const moduleName = "gateway"
 
+// withdrawCapStruct is the struct name of the capability object owned by TSS
+// that authorizes withdrawals from the gateway.
+const withdrawCapStruct = "WithdrawCap"
+
+// whitelistCapStruct is the struct name of the capability object owned by TSS
+// that authorizes whitelisting new coin types in the gateway.
+const whitelistCapStruct = "WhitelistCap"
+
 // ErrParseEvent event parse error
 var ErrParseEvent = errors.New("event parse error")
 
 // NewGateway creates a new Sui gateway
 // Note: packageID is the equivalent for gateway address or program ID on Solana
-// It's what will be set in gateway chain params
-func NewGateway(packageID string) *Gateway {
-	return &Gateway{packageID: packageID}
+// objectID is the ID of the shared gateway object
+func NewGateway(packageID, objectID string) *Gateway {
+	return &Gateway{packageID: packageID, objectID: objectID}
+}
+
+// NewGatewayFromPairID creates a new Sui gateway from the pair ID
+// in the format "$packageID,$gatewayObjectID". It's what will be set in gateway chain params.
+func NewGatewayFromPairID(pair string) (*Gateway, error) {
+	packageID, objectID, err := parsePair(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGateway(packageID, objectID), nil
+}
+
+// NewGatewayFromAddress creates a new Sui gateway from the gateway address of the chain params,
+// either the pair ID or the package ID only (previous format).
+// A gateway without object ID can be used to observe inbounds but not to build outbounds.
+func NewGatewayFromAddress(address string) (*Gateway, error) {
+	if strings.Contains(address, ",") {
+		return NewGatewayFromPairID(address)
+	}
+
+	packageID := strings.TrimSpace(address)
+	if packageID == "" {
+		return nil, errors.Errorf("invalid gateway address %q", address)
+	}
+
+	return NewGateway(packageID, ""), nil
+}
+
+// MakePairID makes a pair ID from package ID and gateway object ID.
+func MakePairID(packageID, objectID string) string {
+	return fmt.Sprintf("%s,%s", packageID, objectID)
 }
 
 // Event represents generic event wrapper
@@ -46,13 +103,17 @@ type Event struct {
 	EventIndex uint64
 	EventType  EventType
 
-	content any
-	inbound bool
+	content  any
+	inbound  bool
+	outbound bool
 }
 
 // IsInbound checks whether event is Inbound.
 func (e *Event) IsInbound() bool { return e.inbound }
 
+// IsOutbound checks whether event is Outbound.
+func (e *Event) IsOutbound() bool { return e.outbound }
+
 // Inbound extract Inbound.
 func (e *Event) Inbound() (Inbound, error) {
 	if !e.inbound {
@@ -62,14 +123,37 @@ func (e *Event) Inbound() (Inbound, error) {
 	return e.content.(Inbound), nil
 }
 
+// Outbound extract Outbound.
+func (e *Event) Outbound() (Outbound, error) {
+	if !e.outbound {
+		return Outbound{}, errors.Errorf("not an outbound (%+v)", e.content)
+	}
+
+	return e.content.(Outbound), nil
+}
+
 func (gw *Gateway) PackageID() string {
 	return gw.packageID
 }
 
+func (gw *Gateway) ObjectID() string {
+	return gw.objectID
+}
+
 func (gw *Gateway) Module() string {
 	return moduleName
 }
 
+// WithdrawCapType returns struct type of the WithdrawCap object.
+func (gw *Gateway) WithdrawCapType() string {
+	return fmt.Sprintf("%s::%s::%s", gw.packageID, moduleName, withdrawCapStruct)
+}
+
+// WhitelistCapType returns struct type of the WhitelistCap object.
+func (gw *Gateway) WhitelistCapType() string {
+	return fmt.Sprintf("%s::%s::%s", gw.packageID, moduleName, whitelistCapStruct)
+}
+
 // ParseEvent parses Event.
 func (gw *Gateway) ParseEvent(event models.SuiEventResponse) (Event, error) {
 	// basic validation
@@ -108,6 +192,7 @@ func (gw *Gateway) ParseEvent(event models.SuiEventResponse) (Event, error) {
 	var (
 		eventType = descriptor.eventType
 		inbound   bool
+		outbound  bool
 		content   any
 	)
 
@@ -116,6 +201,15 @@ func (gw *Gateway) ParseEvent(event models.SuiEventResponse) (Event, error) {
 	case Deposit, DepositAndCall:
 		inbound = true
 		content, err = parseInbound(event, eventType)
+	case Withdraw, WithdrawAndCall:
+		outbound = true
+		content, err = parseOutbound(event, eventType)
+	case NonceIncrease:
+		outbound = true
+		content, err = parseNonceIncrease(event)
+	case Whitelist:
+		outbound = true
+		content, err = parseWhitelist(event)
 	default:
 		return Event{}, errors.Wrapf(ErrParseEvent, "unknown event %q", eventType)
 	}
@@ -129,8 +223,9 @@ func (gw *Gateway) ParseEvent(event models.SuiEventResponse) (Event, error) {
 		EventIndex: eventID,
 		EventType:  eventType,
 
-		content: content,
-		inbound: inbound,
+		content:  content,
+		inbound:  inbound,
+		outbound: outbound,
 	}, nil
 }
 
@@ -153,6 +248,20 @@ func parseEventDescriptor(typeString string) (eventDescriptor, error) {
 	}, nil
 }
 
+func parsePair(pair string) (string, string, error) {
+	parts := strings.Split(pair, ",")
+	if len(parts) != 2 {
+		return "", "", errors.Errorf("invalid pair %q", pair)
+	}
+
+	packageID, objectID := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	if packageID == "" || objectID == "" {
+		return "", "", errors.Errorf("invalid pair %q", pair)
+	}
+
+	return packageID, objectID, nil
+}
+
 func extractStr(kv map[string]any, key string) (string, error) {
 	if _, ok := kv[key]; !ok {
 		return "", errors.Errorf("missing %s", key)
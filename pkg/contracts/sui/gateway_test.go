@@ -14,16 +14,18 @@ import (
 func TestParseEvent(t *testing.T) {
 	// stubs
 	const (
-		packageID = "0x3e9fb7c01ef0d97911ccfec79306d9de2d58daa996bd3469da0f6d640cc443cf"
-		sender    = "0x70386a9a912d9f7a603263abfbd8faae861df0ee5f8e2dbdf731fbd159f10e52"
-		txHash    = "HjxLMxMXNz8YfUc2qT4e4CrogKvGeHRbDW7Arr6ntzqq"
+		packageID       = "0x3e9fb7c01ef0d97911ccfec79306d9de2d58daa996bd3469da0f6d640cc443cf"
+		gatewayObjectID = "0x444fb7c01ef0d97911ccfec79306d9de2d58daa996bd3469da0f6d640cc443aa"
+		sender          = "0x70386a9a912d9f7a603263abfbd8faae861df0ee5f8e2dbdf731fbd159f10e52"
+		txHash          = "HjxLMxMXNz8YfUc2qT4e4CrogKvGeHRbDW7Arr6ntzqq"
+		usdcCoinType    = "a1ec7fc00a6f40db9693ad1415d0c193ad3906494428cf252621037bd7117e29::usdc::USDC"
 	)
 
 	eventType := func(t string) string {
 		return fmt.Sprintf("%s::%s::%s", packageID, moduleName, t)
 	}
 
-	gw := NewGateway(packageID)
+	gw := NewGateway(packageID, gatewayObjectID)
 
 	receiverAlice := sample.EthAddress()
 	receiverBob := sample.EthAddress()
@@ -131,6 +133,114 @@ func TestParseEvent(t *testing.T) {
 				assert.Equal(t, []byte{}, inbound.Payload)
 			},
 		},
+		{
+			name: "withdraw",
+			event: models.SuiEventResponse{
+				Id:        models.EventId{TxDigest: txHash, EventSeq: "0"},
+				PackageId: packageID,
+				Sender:    sender,
+				Type:      eventType("WithdrawEvent"),
+				ParsedJson: map[string]any{
+					"coin_type": string(SUI),
+					"amount":    "300",
+					"sender":    sender,
+					"receiver":  sender,
+					"nonce":     "42",
+				},
+			},
+			assert: func(t *testing.T, raw models.SuiEventResponse, out Event) {
+				assert.Equal(t, Withdraw, out.EventType)
+				assert.False(t, out.IsInbound())
+				assert.True(t, out.IsOutbound())
+
+				outbound, err := out.Outbound()
+				require.NoError(t, err)
+
+				assert.Equal(t, SUI, outbound.CoinType)
+				assert.True(t, math.NewUint(300).Equal(outbound.Amount))
+				assert.Equal(t, sender, outbound.Receiver)
+				assert.Equal(t, uint64(42), outbound.Nonce)
+				assert.False(t, outbound.IsCrossChainCall)
+				assert.False(t, outbound.IsCancelled())
+			},
+		},
+		{
+			name: "withdrawAndCall",
+			event: models.SuiEventResponse{
+				Id:        models.EventId{TxDigest: txHash, EventSeq: "0"},
+				PackageId: packageID,
+				Sender:    sender,
+				Type:      eventType("WithdrawAndCallEvent"),
+				ParsedJson: map[string]any{
+					"coin_type": string(SUI),
+					"amount":    "300",
+					"sender":    sender,
+					"receiver":  sender,
+					"nonce":     "43",
+					"payload":   []any{float64(3), float64(4)},
+				},
+			},
+			assert: func(t *testing.T, raw models.SuiEventResponse, out Event) {
+				assert.Equal(t, WithdrawAndCall, out.EventType)
+				assert.True(t, out.IsOutbound())
+
+				outbound, err := out.Outbound()
+				require.NoError(t, err)
+
+				assert.Equal(t, uint64(43), outbound.Nonce)
+				assert.True(t, outbound.IsCrossChainCall)
+				assert.Equal(t, []byte{3, 4}, outbound.Payload)
+			},
+		},
+		{
+			name: "nonceIncrease",
+			event: models.SuiEventResponse{
+				Id:        models.EventId{TxDigest: txHash, EventSeq: "0"},
+				PackageId: packageID,
+				Sender:    sender,
+				Type:      eventType("NonceIncreaseEvent"),
+				ParsedJson: map[string]any{
+					"sender": sender,
+					"nonce":  "44",
+				},
+			},
+			assert: func(t *testing.T, raw models.SuiEventResponse, out Event) {
+				assert.Equal(t, NonceIncrease, out.EventType)
+				assert.True(t, out.IsOutbound())
+
+				outbound, err := out.Outbound()
+				require.NoError(t, err)
+
+				assert.Equal(t, uint64(44), outbound.Nonce)
+				assert.True(t, outbound.IsCancelled())
+			},
+		},
+		{
+			name: "whitelist",
+			event: models.SuiEventResponse{
+				Id:        models.EventId{TxDigest: txHash, EventSeq: "0"},
+				PackageId: packageID,
+				Sender:    sender,
+				Type:      eventType("WhitelistEvent"),
+				ParsedJson: map[string]any{
+					"coin_type": usdcCoinType,
+					"nonce":     "45",
+				},
+			},
+			assert: func(t *testing.T, raw models.SuiEventResponse, out Event) {
+				assert.Equal(t, Whitelist, out.EventType)
+				assert.True(t, out.IsOutbound())
+
+				outbound, err := out.Outbound()
+				require.NoError(t, err)
+
+				assert.Equal(t, uint64(45), outbound.Nonce)
+				assert.Equal(t, CoinType(usdcCoinType), outbound.CoinType)
+				assert.True(t, outbound.Amount.IsZero())
+				assert.True(t, outbound.IsWhitelist)
+				assert.False(t, outbound.IsCancelled())
+			},
+		},
 		// ERRORS
 		{
 			name: "empty tx hash",
@@ -290,3 +400,46 @@ func TestParseEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestNewGatewayFromPairID(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		gw, err := NewGatewayFromPairID(MakePairID("0x1", "0x2"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "0x1", gw.PackageID())
+		assert.Equal(t, "0x2", gw.ObjectID())
+		assert.Equal(t, "0x1::gateway::WithdrawCap", gw.WithdrawCapType())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, pair := range []string{"", "0x1", "0x1,", ",0x2", "0x1,0x2,0x3"} {
+			_, err := NewGatewayFromPairID(pair)
+			require.Error(t, err, pair)
+		}
+	})
+}
+
+func TestNewGatewayFromAddress(t *testing.T) {
+	t.Run("pair ID", func(t *testing.T) {
+		gw, err := NewGatewayFromAddress(MakePairID("0x1", "0x2"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "0x1", gw.PackageID())
+		assert.Equal(t, "0x2", gw.ObjectID())
+	})
+
+	t.Run("package ID only", func(t *testing.T) {
+		gw, err := NewGatewayFromAddress("0x1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "0x1", gw.PackageID())
+		assert.Empty(t, gw.ObjectID())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, address := range []string{"", " ", "0x1,", ",0x2", "0x1,0x2,0x3"} {
+			_, err := NewGatewayFromAddress(address)
+			require.Error(t, err, address)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package sui
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/pkg/errors"
+)
+
+// Outbound represents data for a Sui outbound,
+// it is parsed from a withdraw/withdrawAndCall/nonceIncrease/whitelist event
+type Outbound struct {
+	CoinType         CoinType
+	Amount           math.Uint
+	Sender           string
+	Receiver         string
+	Nonce            uint64
+	Payload          []byte
+	IsCrossChainCall bool
+	IsWhitelist      bool
+}
+
+// IsCancelled checks whether the outbound was cancelled by only increasing the gateway nonce
+// (e.g. a restricted cctx), no funds are withdrawn in this case.
+func (o *Outbound) IsCancelled() bool {
+	return o.CoinType == "" && o.Amount.IsZero()
+}
+
+// IsGasWithdrawal checks whether the outbound withdraws SUI
+func (o *Outbound) IsGasWithdrawal() bool {
+	return o.CoinType == SUI
+}
+
+func parseOutbound(event models.SuiEventResponse, eventType EventType) (Outbound, error) {
+	parsedJSON := event.ParsedJson
+
+	coinType, err := extractStr(parsedJSON, "coin_type")
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	amountRaw, err := extractStr(parsedJSON, "amount")
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	amount, err := math.ParseUint(amountRaw)
+	if err != nil {
+		return Outbound{}, errors.Wrap(err, "unable to parse amount")
+	}
+
+	sender, err := extractStr(parsedJSON, "sender")
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	receiver, err := extractStr(parsedJSON, "receiver")
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	nonce, err := extractNonce(parsedJSON)
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	var isCrossChainCall bool
+	var payload []byte
+
+	if eventType == WithdrawAndCall {
+		isCrossChainCall = true
+
+		payloadRaw, ok := parsedJSON["payload"].([]any)
+		if !ok {
+			return Outbound{}, errors.New("invalid payload")
+		}
+
+		payload, err = convertPayload(payloadRaw)
+		if err != nil {
+			return Outbound{}, errors.Wrap(err, "unable to convert payload")
+		}
+	}
+
+	return Outbound{
+		CoinType:         CoinType(coinType),
+		Amount:           amount,
+		Sender:           sender,
+		Receiver:         receiver,
+		Nonce:            nonce,
+		Payload:          payload,
+		IsCrossChainCall: isCrossChainCall,
+	}, nil
+}
+
+func parseNonceIncrease(event models.SuiEventResponse) (Outbound, error) {
+	parsedJSON := event.ParsedJson
+
+	sender, err := extractStr(parsedJSON, "sender")
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	nonce, err := extractNonce(parsedJSON)
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	return Outbound{
+		Amount: math.ZeroUint(),
+		Sender: sender,
+		Nonce:  nonce,
+	}, nil
+}
+
+// parseWhitelist parses the event of a whitelisted coin type,
+// the whitelist is an admin outbound that consumes the gateway nonce but withdraws no funds.
+func parseWhitelist(event models.SuiEventResponse) (Outbound, error) {
+	parsedJSON := event.ParsedJson
+
+	coinType, err := extractStr(parsedJSON, "coin_type")
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	nonce, err := extractNonce(parsedJSON)
+	if err != nil {
+		return Outbound{}, err
+	}
+
+	return Outbound{
+		CoinType:    CoinType(coinType),
+		Amount:      math.ZeroUint(),
+		Nonce:       nonce,
+		IsWhitelist: true,
+	}, nil
+}
+
+func extractNonce(kv map[string]any) (uint64, error) {
+	nonceRaw, err := extractStr(kv, "nonce")
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, err := strconv.ParseUint(nonceRaw, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to parse nonce")
+	}
+
+	return nonce, nil
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
@@ -29,6 +30,10 @@ const DefaultMaxTxs = 3000
 // priority to other sender txs and must be partially ordered by both sender-nonce
 // and priority.
 type PriorityNonceMempool struct {
+	// mtx guards the indices, so the mempool can be read (e.g. by the JSON-RPC txpool namespace)
+	// concurrently with the ABCI calls
+	mtx sync.RWMutex
+
 	priorityIndex  *skiplist.SkipList
 	priorityCounts map[int64]int
 	senderIndices  map[string]*skiplist.SkipList
@@ -150,6 +155,9 @@ func NewPriorityMempool(opts ...PriorityNonceMempoolOption) *PriorityNonceMempoo
 // i.e. the next valid transaction for the sender. If no such transaction exists,
 // nil will be returned.
 func (mp *PriorityNonceMempool) NextSenderTx(sender string) sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		return nil
@@ -169,7 +177,10 @@ func (mp *PriorityNonceMempool) NextSenderTx(sender string) sdk.Tx {
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
 func (mp *PriorityNonceMempool) Insert(ctx context.Context, tx sdk.Tx) error {
-	if mp.maxTx > 0 && mp.CountTx() >= mp.maxTx {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if mp.maxTx > 0 && mp.priorityIndex.Len() >= mp.maxTx {
 		return mempool.ErrMempoolTxMaxCapacity
 	} else if mp.maxTx < 0 {
 		return nil
@@ -315,6 +326,9 @@ func (i *PriorityNonceIterator) Tx() sdk.Tx {
 // NOTE: It is not safe to use this iterator while removing transactions from
 // the underlying mempool.
 func (mp *PriorityNonceMempool) Select(_ context.Context, _ [][]byte) mempool.Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
 	if mp.priorityIndex.Len() == 0 {
 		return nil
 	}
@@ -383,9 +397,26 @@ func senderWeight(senderCursor *skiplist.Element) int64 {
 
 // CountTx returns the number of transactions in the mempool.
 func (mp *PriorityNonceMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
 	return mp.priorityIndex.Len()
 }
 
+// Txs returns a snapshot of the transactions in the mempool, ordered by priority.
+// This is a readonly operation, the mempool is not modified.
+func (mp *PriorityNonceMempool) Txs() []sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	txs := make([]sdk.Tx, 0, mp.priorityIndex.Len())
+	for node := mp.priorityIndex.Front(); node != nil; node = node.Next() {
+		txs = append(txs, node.Value.(sdk.Tx))
+	}
+
+	return txs
+}
+
 // Remove removes a transaction from the mempool in O(log n) time, returning an
 // error if unsuccessful.
 func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
@@ -397,6 +428,9 @@ func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
 	sender := sendersWithNonce[0].Sender
 	nonce := sendersWithNonce[0].Nonce
 
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
 	scoreKey := txMeta{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
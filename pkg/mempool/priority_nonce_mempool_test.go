@@ -886,6 +886,36 @@ func TestPriorityNonceMempool_NextSenderTx(t *testing.T) {
 	require.Equal(t, txs[0], tx)
 }
 
+func TestPriorityNonceMempool_Txs(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accA := accounts[0].Address
+	accB := accounts[1].Address
+
+	mp := zetamempool.NewPriorityMempool()
+	require.Empty(t, mp.Txs())
+
+	txs := []testTx{
+		{priority: 20, nonce: 1, address: accA},
+		{priority: 66, nonce: 1, address: accB},
+		{priority: 15, nonce: 2, address: accA},
+	}
+
+	for _, tx := range txs {
+		c := ctx.WithPriority(tx.priority)
+		require.NoError(t, mp.Insert(c, tx))
+	}
+
+	// snapshot is ordered by priority
+	require.Equal(t, []sdk.Tx{txs[1], txs[0], txs[2]}, mp.Txs())
+
+	// snapshot is not affected by the mempool updates
+	snapshot := mp.Txs()
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Len(t, snapshot, 3)
+	require.Equal(t, []sdk.Tx{txs[0], txs[2]}, mp.Txs())
+}
+
 func TestNextSenderTx_TxLimit(t *testing.T) {
 	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
 	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
@@ -16,8 +16,13 @@ import (
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/proofs/bitcoin"
+	"github.com/zeta-chain/node/pkg/proofs/solana"
+	"github.com/zeta-chain/node/pkg/proofs/ton"
 )
 
+// maxTONHeaderLen is the maximum length of the merkle proof of a TON masterchain block header
+const maxTONHeaderLen = 4096
+
 // NewEthereumHeader returns a new HeaderData containing an Ethereum header
 func NewEthereumHeader(header []byte) HeaderData {
 	return HeaderData{
@@ -36,6 +41,24 @@ func NewBitcoinHeader(header []byte) HeaderData {
 	}
 }
 
+// NewTONHeader returns a new HeaderData containing the merkle proof of a TON masterchain block
+func NewTONHeader(header []byte) HeaderData {
+	return HeaderData{
+		Data: &HeaderData_TonHeader{
+			TonHeader: header,
+		},
+	}
+}
+
+// NewSolanaHeader returns a new HeaderData containing a Solana header
+func NewSolanaHeader(header *solana.Header) HeaderData {
+	return HeaderData{
+		Data: &HeaderData_SolanaHeader{
+			SolanaHeader: header,
+		},
+	}
+}
+
 // ParentHash extracts the parent hash from the header
 func (h HeaderData) ParentHash() ([]byte, error) {
 	switch data := h.Data.(type) {
@@ -51,11 +74,37 @@ func (h HeaderData) ParentHash() ([]byte, error) {
 			return nil, err
 		}
 		return header.PrevBlock[:], nil
+	case *HeaderData_TonHeader:
+		header, err := ton.ParseHeader(data.TonHeader)
+		if err != nil {
+			return nil, err
+		}
+		return header.ParentHash(), nil
+	case *HeaderData_SolanaHeader:
+		if data.SolanaHeader == nil {
+			return nil, errors.New("empty solana header")
+		}
+		return data.SolanaHeader.PreviousBlockhash, nil
 	default:
 		return nil, errors.New("unrecognized header type")
 	}
 }
 
+// ParentHeight returns the height of the parent of the block at the given height
+// Solana blocks are produced in slots that can be skipped
+func (h HeaderData) ParentHeight(height int64) (int64, error) {
+	switch data := h.Data.(type) {
+	case *HeaderData_SolanaHeader:
+		if data.SolanaHeader == nil {
+			return 0, errors.New("empty solana header")
+		}
+		// #nosec G115 always in range for a slot
+		return int64(data.SolanaHeader.ParentSlot), nil
+	default:
+		return height - 1, nil
+	}
+}
+
 func (h HeaderData) ValidateTimestamp(zetaTime time.Time) error {
 	switch data := h.Data.(type) {
 	case *HeaderData_EthereumHeader:
@@ -83,6 +132,17 @@ func (h HeaderData) ValidateTimestamp(zetaTime time.Time) error {
 			return fmt.Errorf("block timestamp of %v is too far in the future", header.Timestamp)
 		}
 		return nil
+	case *HeaderData_TonHeader:
+		header, err := ton.ParseHeader(data.TonHeader)
+		if err != nil {
+			return err
+		}
+		return validateTimestamp(time.Unix(int64(header.Info.GenUtime), 0), zetaTime)
+	case *HeaderData_SolanaHeader:
+		if data.SolanaHeader == nil {
+			return errors.New("empty solana header")
+		}
+		return validateTimestamp(time.Unix(data.SolanaHeader.BlockTime, 0), zetaTime)
 	default:
 		return errors.New("cannot validate timestamp for unrecognized header type")
 	}
@@ -95,6 +155,13 @@ func (h HeaderData) Validate(blockHash []byte, chainID int64, height int64) erro
 		return validateEthereumHeader(data.EthereumHeader, blockHash, height)
 	case *HeaderData_BitcoinHeader:
 		return ValidateBitcoinHeader(data.BitcoinHeader, blockHash, chainID)
+	case *HeaderData_TonHeader:
+		return validateTONHeader(data.TonHeader, blockHash, height)
+	case *HeaderData_SolanaHeader:
+		if data.SolanaHeader == nil {
+			return errors.New("empty solana header")
+		}
+		return data.SolanaHeader.Validate(blockHash, height)
 	default:
 		return errors.New("unrecognized header type")
 	}
@@ -124,6 +191,37 @@ func validateEthereumHeader(headerBytes []byte, blockHash []byte, height int64)
 	return nil
 }
 
+// validateTimestamp checks the block timestamp is not too far in the future
+func validateTimestamp(timestamp time.Time, zetaTime time.Time) error {
+	maxTimestamp := zetaTime.Add(time.Second * blockchain.MaxTimeOffsetSeconds)
+	if timestamp.After(maxTimestamp) {
+		return fmt.Errorf("block timestamp of %v is too far in the future", timestamp)
+	}
+	return nil
+}
+
+// validateTONHeader performs a basic validation of the merkle proof of a TON masterchain block
+func validateTONHeader(headerBytes []byte, blockHash []byte, height int64) error {
+	if len(headerBytes) > maxTONHeaderLen {
+		return fmt.Errorf("header too long (%d)", len(headerBytes))
+	}
+	header, err := ton.ParseHeader(headerBytes)
+	if err != nil {
+		return fmt.Errorf("cannot parse header (%s)", err)
+	}
+	if !bytes.Equal(blockHash, header.RootHash) {
+		return fmt.Errorf(
+			"block hash mismatch (%s) vs (%s)",
+			hex.EncodeToString(blockHash),
+			hex.EncodeToString(header.RootHash),
+		)
+	}
+	if height != int64(header.Info.SeqNo) {
+		return fmt.Errorf("height mismatch (%d) vs (%d)", height, header.Info.SeqNo)
+	}
+	return nil
+}
+
 func ValidateBitcoinHeader(headerBytes []byte, blockHash []byte, chainID int64) error {
 	// Deserialize the 80-byte block header
 	if len(headerBytes) != bitcoin.BitcoinBlockHeaderLen {
@@ -15,8 +15,12 @@ import (
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/wire"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	solanago "github.com/gagliardetto/solana-go"
 	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
 
+	"github.com/zeta-chain/node/pkg/proofs/solana"
+	"github.com/zeta-chain/node/pkg/proofs/ton"
 	"github.com/zeta-chain/node/testutil/testdata"
 )
 
@@ -99,6 +103,90 @@ func TestFakeBitcoinHeader(t *testing.T) {
 	}
 }
 
+func TestTONHeader(t *testing.T) {
+	cells, err := boc.DeserializeBoc(testdata.ReadTONMasterchainBlock(t))
+	require.NoError(t, err)
+	root := cells[0]
+	rootHash, err := root.Hash()
+	require.NoError(t, err)
+
+	// keep the block info only
+	headerBytes, err := ton.CreateProof(root, root.Refs()[0])
+	require.NoError(t, err)
+	headerData := NewTONHeader(headerBytes)
+
+	t.Run("should validate header", func(t *testing.T) {
+		require.NoError(t, headerData.Validate(rootHash, 2015140, 17734191))
+
+		parentHash, err := headerData.ParentHash()
+		require.NoError(t, err)
+		require.Len(t, parentHash, 32)
+
+		parentHeight, err := headerData.ParentHeight(17734191)
+		require.NoError(t, err)
+		require.EqualValues(t, 17734190, parentHeight)
+
+		require.NoError(t, headerData.ValidateTimestamp(time.Now()))
+	})
+
+	t.Run("should fail if block hash mismatch", func(t *testing.T) {
+		err := headerData.Validate(parentHashOf(t, headerData), 2015140, 17734191)
+		require.ErrorContains(t, err, "block hash mismatch")
+	})
+
+	t.Run("should fail if height mismatch", func(t *testing.T) {
+		err := headerData.Validate(rootHash, 2015140, 17734192)
+		require.ErrorContains(t, err, "height mismatch")
+	})
+
+	t.Run("should fail if the block is in the future", func(t *testing.T) {
+		err := headerData.ValidateTimestamp(time.Unix(1600000000, 0))
+		require.ErrorContains(t, err, "too far in the future")
+	})
+}
+
+func TestSolanaHeader(t *testing.T) {
+	blockhash := solanago.HashFromBytes(bytes.Repeat([]byte{1}, 32))
+	previousBlockhash := solanago.HashFromBytes(bytes.Repeat([]byte{2}, 32))
+	headerData := NewSolanaHeader(solana.NewHeader(100, 97, blockhash, previousBlockhash, time.Now().Unix()))
+
+	t.Run("should validate header", func(t *testing.T) {
+		require.NoError(t, headerData.Validate(blockhash[:], 901, 100))
+
+		parentHash, err := headerData.ParentHash()
+		require.NoError(t, err)
+		require.Equal(t, previousBlockhash[:], parentHash)
+
+		// slots can be skipped
+		parentHeight, err := headerData.ParentHeight(100)
+		require.NoError(t, err)
+		require.EqualValues(t, 97, parentHeight)
+
+		require.NoError(t, headerData.ValidateTimestamp(time.Now()))
+	})
+
+	t.Run("should fail if block hash mismatch", func(t *testing.T) {
+		err := headerData.Validate(previousBlockhash[:], 901, 100)
+		require.ErrorContains(t, err, "block hash mismatch")
+	})
+
+	t.Run("should fail if the block is in the future", func(t *testing.T) {
+		err := headerData.ValidateTimestamp(time.Now().Add(-24 * time.Hour))
+		require.ErrorContains(t, err, "too far in the future")
+	})
+
+	t.Run("should fail if the header is empty", func(t *testing.T) {
+		err := NewSolanaHeader(nil).Validate(blockhash[:], 901, 100)
+		require.ErrorContains(t, err, "empty solana header")
+	})
+}
+
+func parentHashOf(t *testing.T, headerData HeaderData) []byte {
+	parentHash, err := headerData.ParentHash()
+	require.NoError(t, err)
+	return parentHash
+}
+
 func TestNonExistentHeaderType(t *testing.T) {
 	headerData := HeaderData{}
 
@@ -11,6 +11,8 @@ import (
 
 	"github.com/zeta-chain/node/pkg/proofs/bitcoin"
 	"github.com/zeta-chain/node/pkg/proofs/ethereum"
+	"github.com/zeta-chain/node/pkg/proofs/solana"
+	"github.com/zeta-chain/node/pkg/proofs/ton"
 )
 
 // ErrInvalidProof is a error type for invalid proofs embedding the underlying error
@@ -56,6 +58,33 @@ func NewBitcoinProof(txBytes []byte, path []byte, index uint) *Proof {
 	}
 }
 
+// NewTONProof returns a new Proof containing a TON proof
+func NewTONProof(proof *ton.Proof) *Proof {
+	return &Proof{
+		Proof: &Proof_TonProof{
+			TonProof: proof,
+		},
+	}
+}
+
+// NewSolanaProof returns a new Proof containing a Solana proof
+func NewSolanaProof(proof *solana.Proof) *Proof {
+	return &Proof{
+		Proof: &Proof_SolanaProof{
+			SolanaProof: proof,
+		},
+	}
+}
+
+// VerificationGas returns the gas to consume before verifying the proof
+// it accounts for the proofs whose verification cost is controlled by the submitter
+func (p Proof) VerificationGas() uint64 {
+	if proof, ok := p.Proof.(*Proof_SolanaProof); ok {
+		return proof.SolanaProof.VerificationGas()
+	}
+	return 0
+}
+
 // Verify verifies the proof against the header
 // Returns the verified tx in bytes if the verification is successful
 func (p Proof) Verify(headerData HeaderData, txIndex int) ([]byte, error) {
@@ -93,6 +122,30 @@ func (p Proof) Verify(headerData HeaderData, txIndex int) ([]byte, error) {
 			return nil, NewErrInvalidProof(errors.New("invalid bitcoin proof"))
 		}
 		return proof.BitcoinProof.TxBytes, nil
+	case *Proof_TonProof:
+		tonHeaderBytes := headerData.GetTonHeader()
+		if tonHeaderBytes == nil {
+			return nil, errors.New("can't verify ton proof against non-ton header")
+		}
+		tonHeader, err := ton.ParseHeader(tonHeaderBytes)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := proof.TonProof.Verify(tonHeader.RootHash)
+		if err != nil {
+			return nil, NewErrInvalidProof(err)
+		}
+		return tx, nil
+	case *Proof_SolanaProof:
+		solanaHeader := headerData.GetSolanaHeader()
+		if solanaHeader == nil {
+			return nil, errors.New("can't verify solana proof against non-solana header")
+		}
+		tx, err := proof.SolanaProof.Verify(solanaHeader.Blockhash)
+		if err != nil {
+			return nil, NewErrInvalidProof(err)
+		}
+		return tx, nil
 	default:
 		return nil, errors.New("unrecognized proof type")
 	}
@@ -1,6 +1,7 @@
 package proofs
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -14,10 +15,16 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
 	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
 
 	"github.com/zeta-chain/node/pkg/proofs/bitcoin"
 	"github.com/zeta-chain/node/pkg/proofs/ethereum"
+	"github.com/zeta-chain/node/pkg/proofs/solana"
+	"github.com/zeta-chain/node/pkg/proofs/ton"
 	"github.com/zeta-chain/node/testutil/testdata"
 )
 
@@ -111,6 +118,115 @@ func TestEthereumMerkleProof(t *testing.T) {
 	})
 }
 
+func TestTONProof(t *testing.T) {
+	cells, err := boc.DeserializeBoc(testdata.ReadTONMasterchainBlock(t))
+	require.NoError(t, err)
+	root := cells[0]
+	var block tlb.Block
+	require.NoError(t, tlb.Unmarshal(root, &block))
+	root.ResetCounters()
+
+	headerBytes, err := ton.CreateProof(root, root.Refs()[0])
+	require.NoError(t, err)
+	headerData := NewTONHeader(headerBytes)
+
+	// find the cell of the first transaction of the block
+	txHash := block.AllTransactions()[0].Hash()
+	var txCell *boc.Cell
+	var find func(c *boc.Cell)
+	find = func(c *boc.Cell) {
+		h, err := c.Hash()
+		require.NoError(t, err)
+		if bytes.Equal(h, txHash[:]) {
+			txCell = c
+			return
+		}
+		for _, ref := range c.Refs() {
+			find(ref)
+		}
+	}
+	find(root)
+	require.NotNil(t, txCell)
+	txBytes, err := txCell.ToBoc()
+	require.NoError(t, err)
+
+	masterchainProof, err := ton.CreateProof(root, txCell)
+	require.NoError(t, err)
+	proof := NewTONProof(ton.NewProof(masterchainProof, nil, txBytes))
+
+	t.Run("should verify tx proof", func(t *testing.T) {
+		res, err := proof.Verify(headerData, 0)
+		require.NoError(t, err)
+		require.Equal(t, txBytes, res)
+	})
+
+	t.Run("should fail to verify against a non-ton header", func(t *testing.T) {
+		_, err := proof.Verify(NewBitcoinHeader(make([]byte, 80)), 0)
+		require.ErrorContains(t, err, "non-ton header")
+	})
+
+	t.Run("should fail to verify a proof of another block", func(t *testing.T) {
+		otherBlock, err := ton.CreateProof(root.Refs()[3], root.Refs()[3].Refs()[0])
+		require.NoError(t, err)
+
+		_, err = NewTONProof(ton.NewProof(otherBlock, nil, txBytes)).Verify(headerData, 0)
+		require.Error(t, err)
+		require.True(t, IsErrorInvalidProof(err))
+	})
+}
+
+func TestSolanaProof(t *testing.T) {
+	privKey, err := solanago.NewRandomPrivateKey()
+	require.NoError(t, err)
+	tx, err := solanago.NewTransaction(
+		[]solanago.Instruction{
+			system.NewTransferInstruction(1000, privKey.PublicKey(), solanago.NewWallet().PublicKey()).Build(),
+		},
+		solanago.Hash{},
+		solanago.TransactionPayer(privKey.PublicKey()),
+	)
+	require.NoError(t, err)
+	_, err = tx.Sign(func(solanago.PublicKey) *solanago.PrivateKey { return &privKey })
+	require.NoError(t, err)
+	txBytes, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	startHash := solanago.HashFromBytes(make([]byte, 32))
+	entries := []*solana.Entry{
+		solana.NewEntry(5, []solanago.Signature{tx.Signatures[0]}),
+		solana.NewEntry(10, nil),
+	}
+	blockhash := startHash[:]
+	for _, entry := range entries {
+		blockhash = entry.Hash(blockhash)
+	}
+	headerData := NewSolanaHeader(solana.NewHeader(100, 99, solanago.HashFromBytes(blockhash), startHash, 0))
+	proof := NewSolanaProof(solana.NewProof(txBytes, startHash, entries))
+
+	t.Run("should verify tx proof", func(t *testing.T) {
+		res, err := proof.Verify(headerData, 0)
+		require.NoError(t, err)
+		require.Equal(t, txBytes, res)
+	})
+
+	t.Run("should return the verification gas", func(t *testing.T) {
+		require.EqualValues(t, 15*solana.HashGasCost, proof.VerificationGas())
+		require.Zero(t, NewBitcoinProof(txBytes, nil, 0).VerificationGas())
+	})
+
+	t.Run("should fail to verify against a non-solana header", func(t *testing.T) {
+		_, err := proof.Verify(NewBitcoinHeader(make([]byte, 80)), 0)
+		require.ErrorContains(t, err, "non-solana header")
+	})
+
+	t.Run("should fail to verify against another block", func(t *testing.T) {
+		otherHeader := NewSolanaHeader(solana.NewHeader(100, 99, startHash, startHash, 0))
+		_, err := proof.Verify(otherHeader, 0)
+		require.Error(t, err)
+		require.True(t, IsErrorInvalidProof(err))
+	})
+}
+
 func BitcoinMerkleProofLiveTest(t *testing.T) {
 	client := createBTCClient(t)
 	bn, err := client.GetBlockCount()
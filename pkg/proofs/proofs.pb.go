@@ -9,6 +9,8 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	bitcoin "github.com/zeta-chain/node/pkg/proofs/bitcoin"
 	ethereum "github.com/zeta-chain/node/pkg/proofs/ethereum"
+	solana "github.com/zeta-chain/node/pkg/proofs/solana"
+	ton "github.com/zeta-chain/node/pkg/proofs/ton"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -107,6 +109,8 @@ type HeaderData struct {
 	//
 	//	*HeaderData_EthereumHeader
 	//	*HeaderData_BitcoinHeader
+	//	*HeaderData_TonHeader
+	//	*HeaderData_SolanaHeader
 	Data isHeaderData_Data `protobuf_oneof:"data"`
 }
 
@@ -155,9 +159,17 @@ type HeaderData_EthereumHeader struct {
 type HeaderData_BitcoinHeader struct {
 	BitcoinHeader []byte `protobuf:"bytes,2,opt,name=bitcoin_header,json=bitcoinHeader,proto3,oneof" json:"bitcoin_header,omitempty"`
 }
+type HeaderData_TonHeader struct {
+	TonHeader []byte `protobuf:"bytes,3,opt,name=ton_header,json=tonHeader,proto3,oneof" json:"ton_header,omitempty"`
+}
+type HeaderData_SolanaHeader struct {
+	SolanaHeader *solana.Header `protobuf:"bytes,4,opt,name=solana_header,json=solanaHeader,proto3,oneof" json:"solana_header,omitempty"`
+}
 
 func (*HeaderData_EthereumHeader) isHeaderData_Data() {}
 func (*HeaderData_BitcoinHeader) isHeaderData_Data()  {}
+func (*HeaderData_TonHeader) isHeaderData_Data()      {}
+func (*HeaderData_SolanaHeader) isHeaderData_Data()   {}
 
 func (m *HeaderData) GetData() isHeaderData_Data {
 	if m != nil {
@@ -180,11 +192,27 @@ func (m *HeaderData) GetBitcoinHeader() []byte {
 	return nil
 }
 
+func (m *HeaderData) GetTonHeader() []byte {
+	if x, ok := m.GetData().(*HeaderData_TonHeader); ok {
+		return x.TonHeader
+	}
+	return nil
+}
+
+func (m *HeaderData) GetSolanaHeader() *solana.Header {
+	if x, ok := m.GetData().(*HeaderData_SolanaHeader); ok {
+		return x.SolanaHeader
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*HeaderData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*HeaderData_EthereumHeader)(nil),
 		(*HeaderData_BitcoinHeader)(nil),
+		(*HeaderData_TonHeader)(nil),
+		(*HeaderData_SolanaHeader)(nil),
 	}
 }
 
@@ -193,6 +221,8 @@ type Proof struct {
 	//
 	//	*Proof_EthereumProof
 	//	*Proof_BitcoinProof
+	//	*Proof_TonProof
+	//	*Proof_SolanaProof
 	Proof isProof_Proof `protobuf_oneof:"proof"`
 }
 
@@ -241,9 +271,17 @@ type Proof_EthereumProof struct {
 type Proof_BitcoinProof struct {
 	BitcoinProof *bitcoin.Proof `protobuf:"bytes,2,opt,name=bitcoin_proof,json=bitcoinProof,proto3,oneof" json:"bitcoin_proof,omitempty"`
 }
+type Proof_TonProof struct {
+	TonProof *ton.Proof `protobuf:"bytes,3,opt,name=ton_proof,json=tonProof,proto3,oneof" json:"ton_proof,omitempty"`
+}
+type Proof_SolanaProof struct {
+	SolanaProof *solana.Proof `protobuf:"bytes,4,opt,name=solana_proof,json=solanaProof,proto3,oneof" json:"solana_proof,omitempty"`
+}
 
 func (*Proof_EthereumProof) isProof_Proof() {}
 func (*Proof_BitcoinProof) isProof_Proof()  {}
+func (*Proof_TonProof) isProof_Proof()      {}
+func (*Proof_SolanaProof) isProof_Proof()   {}
 
 func (m *Proof) GetProof() isProof_Proof {
 	if m != nil {
@@ -266,11 +304,27 @@ func (m *Proof) GetBitcoinProof() *bitcoin.Proof {
 	return nil
 }
 
+func (m *Proof) GetTonProof() *ton.Proof {
+	if x, ok := m.GetProof().(*Proof_TonProof); ok {
+		return x.TonProof
+	}
+	return nil
+}
+
+func (m *Proof) GetSolanaProof() *solana.Proof {
+	if x, ok := m.GetProof().(*Proof_SolanaProof); ok {
+		return x.SolanaProof
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Proof) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Proof_EthereumProof)(nil),
 		(*Proof_BitcoinProof)(nil),
+		(*Proof_TonProof)(nil),
+		(*Proof_SolanaProof)(nil),
 	}
 }
 
@@ -285,32 +339,38 @@ func init() {
 }
 
 var fileDescriptor_874830d2276ded66 = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0xab, 0xd3, 0x40,
-	0x10, 0xce, 0xf6, 0xa5, 0x7d, 0x32, 0xa9, 0x4f, 0x58, 0x44, 0xe2, 0x03, 0xd3, 0x52, 0x10, 0x5b,
-	0xb1, 0x09, 0xb4, 0x78, 0x16, 0x82, 0x60, 0xbd, 0x49, 0x04, 0x0f, 0x5e, 0xc2, 0x36, 0x59, 0xb3,
-	0xa1, 0x36, 0x1b, 0xd2, 0xed, 0xc5, 0x5f, 0xe1, 0x1f, 0xd2, 0x73, 0x8f, 0x3d, 0x7a, 0x12, 0x69,
-	0xff, 0x88, 0x64, 0x76, 0x37, 0x7a, 0x6a, 0x4f, 0x3b, 0x3b, 0xf3, 0xcd, 0xf7, 0xcd, 0x0c, 0x1f,
-	0xbc, 0xfc, 0xc6, 0x15, 0xcb, 0x04, 0x2b, 0xab, 0x08, 0x23, 0xd9, 0xf0, 0xa8, 0xde, 0x14, 0x51,
-	0xdd, 0x48, 0xf9, 0x65, 0x67, 0x9e, 0xb0, 0x6e, 0xa4, 0x92, 0xf4, 0x59, 0x87, 0x0d, 0x2d, 0x36,
-	0xac, 0x37, 0x45, 0xa8, 0x41, 0xf7, 0x8f, 0x0b, 0x59, 0x48, 0x44, 0x46, 0x6d, 0xa4, 0x9b, 0xee,
-	0x97, 0x97, 0x05, 0xd6, 0xa5, 0xca, 0x64, 0x59, 0xd9, 0xd7, 0x34, 0xbd, 0xbe, 0xdc, 0xc4, 0x95,
-	0xe0, 0x0d, 0xdf, 0x6f, 0xbb, 0x40, 0xb7, 0x4d, 0x7e, 0x12, 0xf0, 0xe2, 0xaf, 0x32, 0xdb, 0xac,
-	0x38, 0xcb, 0x79, 0x43, 0x9f, 0xc0, 0x40, 0xf0, 0xb2, 0x10, 0xca, 0x27, 0x63, 0x32, 0xbd, 0x49,
-	0xcc, 0x8f, 0x52, 0x70, 0x05, 0xdb, 0x09, 0xbf, 0x37, 0x26, 0xd3, 0x61, 0x82, 0x31, 0x1d, 0x81,
-	0x57, 0xb3, 0x86, 0x57, 0x2a, 0xc5, 0xd2, 0x0d, 0x96, 0x40, 0xa7, 0x56, 0x2d, 0xe0, 0x29, 0x3c,
-	0xc0, 0x89, 0xd2, 0x32, 0xf7, 0x5d, 0xa4, 0xbb, 0xc5, 0xff, 0xfb, 0x9c, 0xbe, 0x6b, 0x75, 0x5a,
-	0x45, 0xbf, 0x3f, 0x26, 0x53, 0x6f, 0x31, 0x0b, 0x2f, 0x5e, 0x2a, 0xd4, 0xe3, 0xbd, 0x65, 0x8a,
-	0xc5, 0xee, 0xe1, 0xf7, 0xc8, 0x49, 0x4c, 0xfb, 0x44, 0x00, 0xfc, 0xab, 0xd1, 0x19, 0x3c, 0xb2,
-	0x0b, 0xa6, 0x86, 0xbf, 0xdd, 0x63, 0xb8, 0x72, 0x92, 0x3b, 0x5b, 0x30, 0x9b, 0xbe, 0x80, 0x3b,
-	0x73, 0x41, 0x8b, 0xec, 0x19, 0xe4, 0x43, 0x93, 0xd7, 0xc0, 0x78, 0x00, 0x6e, 0xce, 0x14, 0x9b,
-	0xfc, 0x20, 0xd0, 0xff, 0xd0, 0x4e, 0x43, 0x3f, 0x41, 0x47, 0x96, 0xe2, 0x7c, 0x28, 0xe2, 0x2d,
-	0xe6, 0x57, 0x96, 0xe8, 0x6e, 0x8f, 0x34, 0xad, 0x92, 0xcd, 0x68, 0xde, 0x8f, 0x60, 0xa5, 0x0d,
-	0x6d, 0x0f, 0x69, 0x5f, 0x5d, 0xa1, 0xb5, 0x46, 0xb0, 0xac, 0x43, 0x93, 0xc0, 0x7f, 0x7c, 0x0b,
-	0x7d, 0xc4, 0xc5, 0x6f, 0x0e, 0xa7, 0x80, 0x1c, 0x4f, 0x01, 0xf9, 0x73, 0x0a, 0xc8, 0xf7, 0x73,
-	0xe0, 0x1c, 0xcf, 0x81, 0xf3, 0xeb, 0x1c, 0x38, 0x9f, 0x9f, 0x17, 0xa5, 0x12, 0xfb, 0x75, 0x98,
-	0xc9, 0x2d, 0x9a, 0x67, 0xae, 0x7d, 0x54, 0xc9, 0xfc, 0x7f, 0x0f, 0xad, 0x07, 0x68, 0x99, 0xe5,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0x34, 0x1b, 0x28, 0x01, 0x03, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6a, 0xdb, 0x40,
+	0x10, 0xd5, 0xda, 0xb2, 0x93, 0x8e, 0x9c, 0x14, 0x44, 0x29, 0x6e, 0xa0, 0xb2, 0x31, 0x94, 0x3a,
+	0x4d, 0x23, 0xd3, 0x84, 0x9e, 0x0b, 0xa2, 0x10, 0xf7, 0x16, 0x14, 0xe8, 0xa1, 0x17, 0xb3, 0x96,
+	0xb6, 0x92, 0x70, 0xa2, 0x15, 0xf2, 0xe6, 0xd2, 0xaf, 0xe8, 0x17, 0xf5, 0x9c, 0x63, 0x8e, 0x39,
+	0x85, 0x62, 0xff, 0x48, 0xd9, 0xd9, 0x59, 0x35, 0x27, 0x39, 0x07, 0xa1, 0x9d, 0xd9, 0xf7, 0xde,
+	0xec, 0x9b, 0xdd, 0x81, 0x0f, 0xbf, 0x84, 0xe2, 0x49, 0xce, 0x8b, 0x72, 0x86, 0x2b, 0x59, 0x8b,
+	0x59, 0xb5, 0xca, 0x66, 0x55, 0x2d, 0xe5, 0xcf, 0x35, 0xfd, 0xc2, 0xaa, 0x96, 0x4a, 0xfa, 0x6f,
+	0x1b, 0x6c, 0x68, 0xb1, 0x61, 0xb5, 0xca, 0x42, 0x03, 0x3a, 0x7a, 0x95, 0xc9, 0x4c, 0x22, 0x72,
+	0xa6, 0x57, 0x86, 0x74, 0x74, 0xde, 0x5e, 0x60, 0x59, 0xa8, 0x44, 0x16, 0xa5, 0xfd, 0x13, 0xe9,
+	0x73, 0x3b, 0x49, 0xa8, 0x5c, 0xd4, 0xe2, 0xf6, 0xa6, 0x59, 0x10, 0xed, 0x53, 0x3b, 0x6d, 0x2d,
+	0xaf, 0x79, 0xc9, 0xe9, 0x47, 0x94, 0x93, 0x76, 0x8a, 0x92, 0xa5, 0xfe, 0x0c, 0x78, 0xf2, 0x87,
+	0x81, 0x17, 0x5d, 0xcb, 0x64, 0x35, 0x17, 0x3c, 0x15, 0xb5, 0xff, 0x1a, 0xfa, 0xb9, 0x28, 0xb2,
+	0x5c, 0x0d, 0xd9, 0x98, 0x4d, 0xbb, 0x31, 0x45, 0xbe, 0x0f, 0x6e, 0xce, 0xd7, 0xf9, 0xb0, 0x33,
+	0x66, 0xd3, 0x41, 0x8c, 0x6b, 0x7f, 0x04, 0x5e, 0xc5, 0x6b, 0x51, 0xaa, 0x05, 0x6e, 0x75, 0x71,
+	0x0b, 0x4c, 0x6a, 0xae, 0x01, 0x6f, 0x60, 0x1f, 0xcf, 0xb1, 0x28, 0xd2, 0xa1, 0x8b, 0x72, 0x7b,
+	0x18, 0x7f, 0x4b, 0xfd, 0x0b, 0x5d, 0x47, 0x57, 0x1c, 0xf6, 0xc6, 0x6c, 0xea, 0x9d, 0x1d, 0x87,
+	0xad, 0x37, 0x11, 0x9a, 0xe3, 0x7d, 0xe5, 0x8a, 0x47, 0xee, 0xdd, 0xe3, 0xc8, 0x89, 0x89, 0x3e,
+	0x79, 0x64, 0x00, 0xff, 0x37, 0xfd, 0x63, 0x78, 0x69, 0x3b, 0xb8, 0xa0, 0x02, 0xda, 0xc8, 0x60,
+	0xee, 0xc4, 0x87, 0x76, 0x83, 0xac, 0xbe, 0x87, 0x43, 0xba, 0x22, 0x8b, 0xec, 0x10, 0xf2, 0x80,
+	0xf2, 0x04, 0x1c, 0x01, 0x28, 0xd9, 0x80, 0xba, 0x04, 0x7a, 0xa1, 0xa4, 0x05, 0x5c, 0xc1, 0x81,
+	0xb9, 0x01, 0x8b, 0x71, 0xd1, 0xd3, 0xc7, 0x1d, 0x9e, 0xe8, 0xd6, 0x8c, 0xc8, 0xdc, 0x89, 0x07,
+	0x26, 0x61, 0xe2, 0xa8, 0x0f, 0x6e, 0xca, 0x15, 0x9f, 0x3c, 0x74, 0xa0, 0x77, 0xa9, 0x09, 0xfe,
+	0x77, 0x68, 0x2c, 0x2c, 0x50, 0x02, 0xad, 0x79, 0x67, 0xa7, 0x3b, 0xea, 0x34, 0x4f, 0x0a, 0x65,
+	0xb4, 0x3f, 0x9b, 0x31, 0xba, 0x57, 0x60, 0x0d, 0x93, 0x6c, 0xe7, 0x59, 0xc7, 0xb7, 0xef, 0xdb,
+	0xaa, 0x0e, 0x28, 0x61, 0x44, 0x2f, 0x40, 0x37, 0x88, 0x04, 0xbb, 0x28, 0x38, 0xdd, 0x21, 0xa8,
+	0x5f, 0xa5, 0x15, 0xdb, 0x57, 0x92, 0x84, 0x2e, 0x81, 0xfa, 0x42, 0x5a, 0xa6, 0xb7, 0x27, 0xcf,
+	0xeb, 0xad, 0x95, 0xf3, 0x4c, 0x8c, 0x61, 0xb4, 0x07, 0x3d, 0x44, 0x45, 0x5f, 0xee, 0x36, 0x01,
+	0xbb, 0xdf, 0x04, 0xec, 0xef, 0x26, 0x60, 0xbf, 0xb7, 0x81, 0x73, 0xbf, 0x0d, 0x9c, 0x87, 0x6d,
+	0xe0, 0xfc, 0x78, 0x97, 0x15, 0x2a, 0xbf, 0x5d, 0x86, 0x89, 0xbc, 0xc1, 0x21, 0x3a, 0x35, 0xf3,
+	0x54, 0xca, 0xf4, 0xe9, 0x2c, 0x2d, 0xfb, 0x38, 0x44, 0xe7, 0xff, 0x06, 0x00, 0x08, 0x60, 0x97,
+	0xd7, 0x73, 0x04, 0x00, 0x00,
 }
 
 func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
@@ -434,6 +494,43 @@ func (m *HeaderData_BitcoinHeader) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
+func (m *HeaderData_TonHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderData_TonHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TonHeader != nil {
+		i -= len(m.TonHeader)
+		copy(dAtA[i:], m.TonHeader)
+		i = encodeVarintProofs(dAtA, i, uint64(len(m.TonHeader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HeaderData_SolanaHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderData_SolanaHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SolanaHeader != nil {
+		{
+			size, err := m.SolanaHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProofs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Proof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -508,6 +605,48 @@ func (m *Proof_BitcoinProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Proof_TonProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proof_TonProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TonProof != nil {
+		{
+			size, err := m.TonProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProofs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Proof_SolanaProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proof_SolanaProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SolanaProof != nil {
+		{
+			size, err := m.SolanaProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProofs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintProofs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProofs(v)
 	base := offset
@@ -580,6 +719,30 @@ func (m *HeaderData_BitcoinHeader) Size() (n int) {
 	}
 	return n
 }
+func (m *HeaderData_TonHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TonHeader != nil {
+		l = len(m.TonHeader)
+		n += 1 + l + sovProofs(uint64(l))
+	}
+	return n
+}
+func (m *HeaderData_SolanaHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SolanaHeader != nil {
+		l = m.SolanaHeader.Size()
+		n += 1 + l + sovProofs(uint64(l))
+	}
+	return n
+}
 func (m *Proof) Size() (n int) {
 	if m == nil {
 		return 0
@@ -616,6 +779,30 @@ func (m *Proof_BitcoinProof) Size() (n int) {
 	}
 	return n
 }
+func (m *Proof_TonProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TonProof != nil {
+		l = m.TonProof.Size()
+		n += 1 + l + sovProofs(uint64(l))
+	}
+	return n
+}
+func (m *Proof_SolanaProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SolanaProof != nil {
+		l = m.SolanaProof.Size()
+		n += 1 + l + sovProofs(uint64(l))
+	}
+	return n
+}
 
 func sovProofs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -907,6 +1094,74 @@ func (m *HeaderData) Unmarshal(dAtA []byte) error {
 			copy(v, dAtA[iNdEx:postIndex])
 			m.Data = &HeaderData_BitcoinHeader{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TonHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProofs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProofs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Data = &HeaderData_TonHeader{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SolanaHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProofs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProofs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &solana.Header{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &HeaderData_SolanaHeader{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProofs(dAtA[iNdEx:])
@@ -1027,6 +1282,76 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 			}
 			m.Proof = &Proof_BitcoinProof{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TonProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProofs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProofs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ton.Proof{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Proof = &Proof_TonProof{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SolanaProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProofs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProofs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &solana.Proof{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Proof = &Proof_SolanaProof{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProofs(dAtA[iNdEx:])
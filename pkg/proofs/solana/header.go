@@ -0,0 +1,42 @@
+package solana
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// NewHeader returns a new Header of a confirmed block
+func NewHeader(slot, parentSlot uint64, blockhash, previousBlockhash solana.Hash, blockTime int64) *Header {
+	return &Header{
+		Slot:              slot,
+		ParentSlot:        parentSlot,
+		Blockhash:         blockhash[:],
+		PreviousBlockhash: previousBlockhash[:],
+		BlockTime:         blockTime,
+	}
+}
+
+// Validate performs a basic validation of the header of the block with the given hash and slot
+// NOTE: the blockhash can't be computed from the header, the header is trusted from the observer votes
+func (m *Header) Validate(blockHash []byte, slot int64) error {
+	if len(m.Blockhash) != solana.PublicKeyLength || len(m.PreviousBlockhash) != solana.PublicKeyLength {
+		return fmt.Errorf("invalid blockhash length")
+	}
+	if !bytes.Equal(blockHash, m.Blockhash) {
+		return fmt.Errorf(
+			"block hash mismatch (%s) vs (%s)",
+			solana.HashFromBytes(blockHash),
+			solana.HashFromBytes(m.Blockhash),
+		)
+	}
+	// #nosec G115 checked as positive
+	if slot < 0 || uint64(slot) != m.Slot {
+		return fmt.Errorf("slot mismatch (%d) vs (%d)", slot, m.Slot)
+	}
+	if m.ParentSlot >= m.Slot {
+		return fmt.Errorf("parent slot (%d) is not before slot (%d)", m.ParentSlot, m.Slot)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+const (
+	// MaxNumHashes is the maximum number of proof of history hashes of a proof
+	// a block contains 64 ticks of 12500 hashes on mainnet, so a proof covers at most a whole block
+	MaxNumHashes = 64 * 12500
+
+	// MaxVerificationGas is the maximum gas consumed when verifying a proof, it fits in the gas limit of a tx
+	MaxVerificationGas = 4_000_000
+
+	// HashGasCost is the gas consumed per proof of history hash when verifying a proof
+	// it's derived from MaxVerificationGas so the proof of a whole block costs at most MaxVerificationGas,
+	// it's below the cost of a 32 bytes SHA-256 with the EVM precompile (72) since the hashes are computed natively
+	HashGasCost = MaxVerificationGas / MaxNumHashes
+)
+
+var (
+	// merkleLeafPrefix and merkleIntermediatePrefix are the prefixes of the nodes of the merkle tree of the signatures
+	merkleLeafPrefix         = []byte{0}
+	merkleIntermediatePrefix = []byte{1}
+)
+
+// NewProof returns a new Proof of a transaction recorded in the first entry
+func NewProof(txBytes []byte, startHash solana.Hash, entries []*Entry) *Proof {
+	return &Proof{
+		TxBytes:   txBytes,
+		StartHash: startHash[:],
+		Entries:   entries,
+	}
+}
+
+// NewEntry returns a new Entry, signatures is empty for a tick
+func NewEntry(numHashes uint64, signatures []solana.Signature) *Entry {
+	entry := &Entry{
+		NumHashes:  numHashes,
+		Signatures: make([][]byte, 0, len(signatures)),
+	}
+	for _, sig := range signatures {
+		entry.Signatures = append(entry.Signatures, sig[:])
+	}
+	return entry
+}
+
+// NumHashes returns the number of proof of history hashes computed to verify the proof
+// the result saturates at the maximum uint64 value
+func (m *Proof) NumHashes() uint64 {
+	numHashes := uint64(0)
+	for _, entry := range m.Entries {
+		if numHashes > math.MaxUint64-entry.NumHashes {
+			return math.MaxUint64
+		}
+		numHashes += entry.NumHashes
+	}
+	return numHashes
+}
+
+// VerificationGas returns the gas to consume to verify the proof
+// it is computed from the number of hashes capped to MaxNumHashes since the verification stops past the cap
+func (m *Proof) VerificationGas() uint64 {
+	return min(m.NumHashes(), MaxNumHashes) * HashGasCost
+}
+
+// Verify verifies the proof against the blockhash
+// Returns the transaction in bytes if the verification is successful
+//
+// NOTE: the proof of history entries record the signatures of the processed transactions, failed ones included,
+// so the proof only proves the inclusion of the transaction in the block, not its success. The status of the
+// transaction isn't part of the block and isn't checked: the observers check it before voting the inbound.
+func (m *Proof) Verify(blockhash []byte) ([]byte, error) {
+	if len(m.StartHash) != sha256.Size {
+		return nil, errors.New("invalid start hash")
+	}
+	if len(m.Entries) == 0 {
+		return nil, errors.New("no entries")
+	}
+
+	tx, err := solana.TransactionFromBytes(m.TxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode transaction (%s)", err)
+	}
+	if len(tx.Signatures) == 0 {
+		return nil, errors.New("transaction not signed")
+	}
+	if err := tx.VerifySignatures(); err != nil {
+		return nil, err
+	}
+
+	// the transaction must be recorded in the first entry
+	recorded := false
+	for _, sig := range m.Entries[0].Signatures {
+		if bytes.Equal(sig, tx.Signatures[0][:]) {
+			recorded = true
+			break
+		}
+	}
+	if !recorded {
+		return nil, errors.New("transaction not recorded in entry")
+	}
+
+	// the hash of the last entry is the blockhash
+	hash := m.StartHash
+	numHashes := uint64(0)
+	for _, entry := range m.Entries {
+		numHashes += entry.NumHashes
+		if numHashes > MaxNumHashes {
+			return nil, fmt.Errorf("too many hashes (%d)", numHashes)
+		}
+		hash = entry.Hash(hash)
+	}
+	if !bytes.Equal(hash, blockhash) {
+		return nil, errors.New("entries hash mismatch with blockhash")
+	}
+	return m.TxBytes, nil
+}
+
+// Hash returns the hash of the entry following the entry with the given hash
+func (m *Entry) Hash(prevHash []byte) []byte {
+	if m.NumHashes == 0 && len(m.Signatures) == 0 {
+		return prevHash
+	}
+
+	// the entry is the last of num_hashes hashes, the transactions are mixed in with the last one
+	hash := append([]byte{}, prevHash...)
+	for i := uint64(1); i < m.NumHashes; i++ {
+		h := sha256.Sum256(hash)
+		hash = h[:]
+	}
+	if len(m.Signatures) > 0 {
+		hash = append(hash, signaturesRoot(m.Signatures)...)
+	}
+	h := sha256.Sum256(hash)
+	return h[:]
+}
+
+// signaturesRoot returns the root of the merkle tree of the signatures
+func signaturesRoot(signatures [][]byte) []byte {
+	level := make([][]byte, 0, len(signatures))
+	for _, sig := range signatures {
+		leaf := sha256.Sum256(append(append([]byte{}, merkleLeafPrefix...), sig...))
+		level = append(level, leaf[:])
+	}
+
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			// the last node is paired with itself if the level length is odd
+			right := level[i]
+			if i+1 < len(level) {
+				right = level[i+1]
+			}
+			node := make([]byte, 0, 1+2*sha256.Size)
+			node = append(node, merkleIntermediatePrefix...)
+			node = append(node, level[i]...)
+			node = append(node, right...)
+			h := sha256.Sum256(node)
+			next = append(next, h[:])
+		}
+		level = next
+	}
+	return level[0]
+}
@@ -0,0 +1,160 @@
+package solana
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/require"
+)
+
+// signedTx returns a signed transfer transaction
+func signedTx(t *testing.T) *solana.Transaction {
+	privKey, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(1000, privKey.PublicKey(), solana.NewWallet().PublicKey()).Build(),
+		},
+		solana.Hash{},
+		solana.TransactionPayer(privKey.PublicKey()),
+	)
+	require.NoError(t, err)
+	_, err = tx.Sign(func(solana.PublicKey) *solana.PrivateKey { return &privKey })
+	require.NoError(t, err)
+	return tx
+}
+
+func TestSignaturesRoot(t *testing.T) {
+	t.Run("should compute the root of a single signature", func(t *testing.T) {
+		leaf := sha256.Sum256([]byte("\x00test"))
+		require.Equal(t, leaf[:], signaturesRoot([][]byte{[]byte("test")}))
+	})
+
+	t.Run("should compute the root of many signatures", func(t *testing.T) {
+		// test vector from solana merkle-tree
+		var items [][]byte
+		for _, item := range []string{
+			"my", "very", "eager", "mother", "just", "served", "us", "nine", "pizzas", "make", "prime",
+		} {
+			items = append(items, []byte(item))
+		}
+		require.Equal(
+			t,
+			"b40c847546fdceea166f927fc46c5ca33c3638236a36275c1346d3dffb84e1bc",
+			hex.EncodeToString(signaturesRoot(items)),
+		)
+	})
+}
+
+func TestEntry_Hash(t *testing.T) {
+	prevHash := sha256.Sum256([]byte("prev"))
+
+	t.Run("should hash a tick", func(t *testing.T) {
+		one := sha256.Sum256(prevHash[:])
+		two := sha256.Sum256(one[:])
+		require.Equal(t, two[:], NewEntry(2, nil).Hash(prevHash[:]))
+	})
+
+	t.Run("should mix in the signatures with the last hash", func(t *testing.T) {
+		sig := solana.Signature{1, 2, 3}
+		one := sha256.Sum256(prevHash[:])
+		two := sha256.Sum256(append(one[:], signaturesRoot([][]byte{sig[:]})...))
+		require.Equal(t, two[:], NewEntry(2, []solana.Signature{sig}).Hash(prevHash[:]))
+	})
+
+	t.Run("should not hash an empty entry", func(t *testing.T) {
+		require.Equal(t, prevHash[:], NewEntry(0, nil).Hash(prevHash[:]))
+	})
+}
+
+func TestProof_Verify(t *testing.T) {
+	tx := signedTx(t)
+	txBytes, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	startHash := solana.Hash(sha256.Sum256([]byte("start")))
+	entries := []*Entry{
+		NewEntry(3, []solana.Signature{{4, 5, 6}, tx.Signatures[0]}),
+		NewEntry(10, nil),
+		NewEntry(10, nil),
+	}
+	blockhash := startHash[:]
+	for _, entry := range entries {
+		blockhash = entry.Hash(blockhash)
+	}
+
+	t.Run("should verify a transaction", func(t *testing.T) {
+		res, err := NewProof(txBytes, startHash, entries).Verify(blockhash)
+		require.NoError(t, err)
+		require.Equal(t, txBytes, res)
+	})
+
+	t.Run("should fail if the entries don't lead to the blockhash", func(t *testing.T) {
+		_, err := NewProof(txBytes, startHash, entries[:2]).Verify(blockhash)
+		require.ErrorContains(t, err, "hash mismatch")
+	})
+
+	t.Run("should fail if the transaction is not recorded in the first entry", func(t *testing.T) {
+		_, err := NewProof(txBytes, solana.HashFromBytes(entries[0].Hash(startHash[:])), entries[1:]).Verify(blockhash)
+		require.ErrorContains(t, err, "not recorded")
+	})
+
+	t.Run("should fail if the transaction signature is invalid", func(t *testing.T) {
+		forged := signedTx(t)
+		forged.Signatures[0] = tx.Signatures[0]
+		forgedBytes, err := forged.MarshalBinary()
+		require.NoError(t, err)
+
+		_, err = NewProof(forgedBytes, startHash, entries).Verify(blockhash)
+		require.ErrorContains(t, err, "invalid signature")
+	})
+
+	t.Run("should fail if there are too many hashes", func(t *testing.T) {
+		_, err := NewProof(txBytes, startHash, append(entries, NewEntry(MaxNumHashes, nil))).Verify(blockhash)
+		require.ErrorContains(t, err, "too many hashes")
+	})
+}
+
+func TestProof_VerificationGas(t *testing.T) {
+	startHash := solana.Hash(sha256.Sum256([]byte("start")))
+
+	proof := NewProof(nil, startHash, []*Entry{NewEntry(3, nil), NewEntry(10, nil)})
+	require.EqualValues(t, 13, proof.NumHashes())
+	require.EqualValues(t, 13*HashGasCost, proof.VerificationGas())
+
+	// the gas is capped since the verification stops past MaxNumHashes
+	proof = NewProof(nil, startHash, []*Entry{NewEntry(math.MaxUint64, nil), NewEntry(10, nil)})
+	require.EqualValues(t, uint64(math.MaxUint64), proof.NumHashes())
+	require.EqualValues(t, MaxNumHashes*HashGasCost, proof.VerificationGas())
+	require.LessOrEqual(t, proof.VerificationGas(), uint64(MaxVerificationGas))
+}
+
+func TestHeader_Validate(t *testing.T) {
+	blockhash := solana.Hash(sha256.Sum256([]byte("block")))
+	previous := solana.Hash(sha256.Sum256([]byte("previous")))
+
+	t.Run("should validate header", func(t *testing.T) {
+		header := NewHeader(100, 98, blockhash, previous, 1700000000)
+		require.NoError(t, header.Validate(blockhash[:], 100))
+	})
+
+	t.Run("should fail if block hash mismatch", func(t *testing.T) {
+		header := NewHeader(100, 98, blockhash, previous, 1700000000)
+		require.ErrorContains(t, header.Validate(previous[:], 100), "block hash mismatch")
+	})
+
+	t.Run("should fail if slot mismatch", func(t *testing.T) {
+		header := NewHeader(100, 98, blockhash, previous, 1700000000)
+		require.ErrorContains(t, header.Validate(blockhash[:], 101), "slot mismatch")
+	})
+
+	t.Run("should fail if parent slot is not before slot", func(t *testing.T) {
+		header := NewHeader(100, 100, blockhash, previous, 1700000000)
+		require.ErrorContains(t, header.Validate(blockhash[:], 100), "parent slot")
+	})
+}
@@ -0,0 +1,124 @@
+package ton
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+)
+
+// MasterchainID is the workchain id of the masterchain
+const MasterchainID = -1
+
+// Header is the header of a TON masterchain block
+type Header struct {
+	RootHash []byte
+	Info     tlb.BlockInfo
+}
+
+// ParseHeader parses the merkle proof of a masterchain block
+// The proof must keep the block info
+func ParseHeader(header []byte) (Header, error) {
+	rootHash, virtualRoot, err := parseMerkleProof(header)
+	if err != nil {
+		return Header{}, err
+	}
+	refs := virtualRoot.Refs()
+	if len(refs) == 0 || refs[0].CellType() == boc.PrunedBranchCell {
+		return Header{}, errors.New("block info is pruned")
+	}
+
+	var block tlb.BlockHeader
+	if err := tlb.Unmarshal(virtualRoot, &block); err != nil {
+		return Header{}, fmt.Errorf("cannot decode block header (%s)", err)
+	}
+	if block.Info.NotMaster || block.Info.Shard.WorkchainID != MasterchainID {
+		return Header{}, errors.New("not a masterchain block")
+	}
+	if block.Info.PrevRef.PrevBlkInfo == nil {
+		return Header{}, errors.New("masterchain block with two previous blocks")
+	}
+
+	return Header{
+		RootHash: rootHash,
+		Info:     block.Info,
+	}, nil
+}
+
+// ParentHash returns the root hash of the previous masterchain block
+func (h Header) ParentHash() []byte {
+	return h.Info.PrevRef.PrevBlkInfo.Prev.RootHash[:]
+}
+
+// ParseBlockProof parses the merkle proof of a block
+// It returns the root hash of the block and the block decoded from the cells kept in the proof
+func ParseBlockProof(proof []byte) ([]byte, *tlb.Block, error) {
+	rootHash, virtualRoot, err := parseMerkleProof(proof)
+	if err != nil {
+		return nil, nil, err
+	}
+	var block tlb.Block
+	if err := tlb.Unmarshal(virtualRoot, &block); err != nil {
+		return nil, nil, fmt.Errorf("cannot decode block (%s)", err)
+	}
+	return rootHash, &block, nil
+}
+
+// parseMerkleProof deserializes a merkle proof
+// It returns the hash of the original tree and the virtual root of the proof
+func parseMerkleProof(proof []byte) ([]byte, *boc.Cell, error) {
+	cells, err := boc.DeserializeBoc(proof)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot deserialize BoC (%s)", err)
+	}
+	if len(cells) != 1 {
+		return nil, nil, fmt.Errorf("expected one root cell, got %d", len(cells))
+	}
+	root := cells[0]
+	if root.CellType() != boc.MerkleProofCell || root.RefsSize() != 1 {
+		return nil, nil, errors.New("not a merkle proof")
+	}
+
+	virtualRoot := root.Refs()[0]
+	rootHash, err := CellHash(virtualRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rootHash, virtualRoot, nil
+}
+
+// findShardBlock returns the description of the shard block with the given root hash
+// referenced by the masterchain block
+func findShardBlock(masterchainBlock *tlb.Block, rootHash []byte) (tlb.ShardDesc, bool) {
+	if !masterchainBlock.Extra.Custom.Exists {
+		return tlb.ShardDesc{}, false
+	}
+	for _, shards := range masterchainBlock.Extra.Custom.Value.Value.ShardHashes.Values() {
+		for _, desc := range shards.Value.BinTree.Values {
+			descRootHash := desc.Old.RootHash
+			if desc.SumType == "New" {
+				descRootHash = desc.New.RootHash
+			}
+			if bytes.Equal(descRootHash[:], rootHash) {
+				return desc, true
+			}
+		}
+	}
+	return tlb.ShardDesc{}, false
+}
+
+// findTransaction returns true if the block contains the transaction with the given hash
+// The transaction must be kept entirely in the proof of the block
+func findTransaction(block *tlb.Block, txHash []byte) bool {
+	for _, accountBlock := range block.Extra.AccountBlocks.Values() {
+		for _, tx := range accountBlock.Transactions.Values() {
+			hash := tx.Value.Hash()
+			if bytes.Equal(hash[:], txHash) {
+				return true
+			}
+		}
+	}
+	return false
+}
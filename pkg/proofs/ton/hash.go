@@ -0,0 +1,152 @@
+package ton
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"github.com/tonkeeper/tongo/boc"
+)
+
+// hashedCell is the hash and the depth of a cell at level 0
+type hashedCell struct {
+	hash  []byte
+	depth uint16
+}
+
+// cellHasher computes the hashes of the cells at level 0
+// The hash at level 0 of a cell containing pruned branches is the hash of the original cell,
+// it is the hash to compare against a trusted hash (block root hash, transaction hash, ...) when verifying a merkle proof
+type cellHasher struct {
+	cache  map[*boc.Cell]hashedCell
+	depths map[*boc.Cell]uint16
+}
+
+func newCellHasher() *cellHasher {
+	return &cellHasher{
+		cache:  make(map[*boc.Cell]hashedCell),
+		depths: make(map[*boc.Cell]uint16),
+	}
+}
+
+// CellHash returns the hash of the cell at level 0
+func CellHash(c *boc.Cell) ([]byte, error) {
+	hashed, err := newCellHasher().hash(c)
+	if err != nil {
+		return nil, err
+	}
+	return hashed.hash, nil
+}
+
+func (h *cellHasher) hash(c *boc.Cell) (hashedCell, error) {
+	if hashed, ok := h.cache[c]; ok {
+		return hashed, nil
+	}
+
+	var (
+		hashed hashedCell
+		err    error
+	)
+	switch {
+	case c.CellType() == boc.PrunedBranchCell:
+		hashed, err = prunedBranchHash(c)
+	case c.Level() == 0:
+		// the cell doesn't contain any pruned branch, its representation hash is its hash at level 0
+		hashed.hash, err = c.Hash()
+		hashed.depth = h.depth(c)
+	case c.CellType() == boc.OrdinaryCell || c.CellType() == boc.LibraryCell:
+		hashed, err = h.ordinaryHash(c)
+	default:
+		err = errors.New("pruned merkle cells are not supported")
+	}
+	if err != nil {
+		return hashedCell{}, err
+	}
+
+	h.cache[c] = hashed
+	return hashed, nil
+}
+
+// depth returns the depth of a cell that doesn't contain any pruned branch
+func (h *cellHasher) depth(c *boc.Cell) uint16 {
+	if depth, ok := h.depths[c]; ok {
+		return depth
+	}
+	depth := uint16(0)
+	for _, ref := range c.Refs() {
+		if refDepth := h.depth(ref) + 1; refDepth > depth {
+			depth = refDepth
+		}
+	}
+	h.depths[c] = depth
+	return depth
+}
+
+// prunedBranchHash returns the hash and depth of the original cell stored in the pruned branch
+// A pruned branch contains its type, its level mask, the hashes and then the depths of the original cell
+func prunedBranchHash(c *boc.Cell) (hashedCell, error) {
+	bits := c.RawBitString()
+	data := bits.Buffer()
+	if len(data) < 2 {
+		return hashedCell{}, errors.New("invalid pruned branch")
+	}
+	hashesCount := 0
+	for mask := data[1]; mask != 0; mask >>= 1 {
+		hashesCount += int(mask & 1)
+	}
+	depthOffset := 2 + hashesCount*sha256.Size
+	if hashesCount == 0 || len(data) < depthOffset+2*hashesCount {
+		return hashedCell{}, errors.New("invalid pruned branch")
+	}
+	return hashedCell{
+		hash:  data[2 : 2+sha256.Size],
+		depth: binary.BigEndian.Uint16(data[depthOffset : depthOffset+2]),
+	}, nil
+}
+
+// ordinaryHash computes the representation hash of the cell with the hashes of the references at level 0
+func (h *cellHasher) ordinaryHash(c *boc.Cell) (hashedCell, error) {
+	refs := c.Refs()
+	refsHashed := make([]hashedCell, 0, len(refs))
+	depth := uint16(0)
+	for _, ref := range refs {
+		hashed, err := h.hash(ref)
+		if err != nil {
+			return hashedCell{}, err
+		}
+		if hashed.depth+1 > depth {
+			depth = hashed.depth + 1
+		}
+		refsHashed = append(refsHashed, hashed)
+	}
+
+	bitSize := c.BitSize()
+	bits := c.RawBitString()
+	data := make([]byte, (bitSize+7)/8)
+	copy(data, bits.Buffer())
+	if bitSize%8 != 0 {
+		data[len(data)-1] |= 1 << (7 - bitSize%8)
+	}
+
+	// cell descriptors at level 0
+	d1 := byte(len(refs))
+	if c.IsExotic() {
+		d1 += 8
+	}
+	// #nosec G115 always in range, a cell contains at most 1023 bits
+	d2 := byte((bitSize+7)/8 + bitSize/8)
+
+	hasher := sha256.New()
+	hasher.Write([]byte{d1, d2})
+	hasher.Write(data)
+	for _, ref := range refsHashed {
+		var depthRepr [2]byte
+		binary.BigEndian.PutUint16(depthRepr[:], ref.depth)
+		hasher.Write(depthRepr[:])
+	}
+	for _, ref := range refsHashed {
+		hasher.Write(ref.hash)
+	}
+
+	return hashedCell{hash: hasher.Sum(nil), depth: depth}, nil
+}
@@ -0,0 +1,69 @@
+package ton
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tonkeeper/tongo/boc"
+)
+
+// NewProof returns a new Proof of a transaction
+// shardProof is empty if the transaction is in the masterchain block
+func NewProof(masterchainProof, shardProof, tx []byte) *Proof {
+	return &Proof{
+		MasterchainProof: masterchainProof,
+		ShardProof:       shardProof,
+		Tx:               tx,
+	}
+}
+
+// IsMasterchainTx returns true if the proven transaction is in the masterchain block
+func (m *Proof) IsMasterchainTx() bool {
+	return len(m.ShardProof) == 0
+}
+
+// Verify verifies the proof against the root hash of a masterchain block
+// Returns the transaction in bytes if the verification is successful
+func (m *Proof) Verify(rootHash []byte) ([]byte, error) {
+	masterchainHash, block, err := ParseBlockProof(m.MasterchainProof)
+	if err != nil {
+		return nil, fmt.Errorf("invalid masterchain proof (%s)", err)
+	}
+	if !bytes.Equal(masterchainHash, rootHash) {
+		return nil, errors.New("masterchain proof is not a proof of the block")
+	}
+
+	// the masterchain block references the shard block with its root hash
+	if !m.IsMasterchainTx() {
+		shardHash, shardBlock, err := ParseBlockProof(m.ShardProof)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shard proof (%s)", err)
+		}
+		if _, found := findShardBlock(block, shardHash); !found {
+			return nil, errors.New("shard block not found in masterchain block")
+		}
+		block = shardBlock
+	}
+
+	txHash, err := transactionHash(m.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if !findTransaction(block, txHash) {
+		return nil, errors.New("transaction not found in block")
+	}
+	return m.Tx, nil
+}
+
+// transactionHash returns the hash of the transaction from its BoC
+func transactionHash(tx []byte) ([]byte, error) {
+	cells, err := boc.DeserializeBoc(tx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot deserialize transaction (%s)", err)
+	}
+	if len(cells) != 1 || cells[0].Level() != 0 {
+		return nil, errors.New("invalid transaction")
+	}
+	return cells[0].Hash()
+}
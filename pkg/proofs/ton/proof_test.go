@@ -0,0 +1,173 @@
+package ton
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/zeta-chain/node/testutil/testdata"
+)
+
+func loadBlock(t *testing.T) (*boc.Cell, *tlb.Block) {
+	cells, err := boc.DeserializeBoc(testdata.ReadTONMasterchainBlock(t))
+	require.NoError(t, err)
+	require.Len(t, cells, 1)
+
+	var block tlb.Block
+	require.NoError(t, tlb.Unmarshal(cells[0], &block))
+	cells[0].ResetCounters()
+	return cells[0], &block
+}
+
+// findCell returns the cell of the tree with the given hash
+func findCell(t *testing.T, root *boc.Cell, hash []byte) *boc.Cell {
+	var find func(c *boc.Cell) *boc.Cell
+	find = func(c *boc.Cell) *boc.Cell {
+		h, err := c.Hash()
+		require.NoError(t, err)
+		if bytes.Equal(h, hash) {
+			return c
+		}
+		for _, ref := range c.Refs() {
+			if found := find(ref); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	c := find(root)
+	require.NotNil(t, c)
+	return c
+}
+
+func TestCellHash(t *testing.T) {
+	root, _ := loadBlock(t)
+	rootHash, err := root.Hash()
+	require.NoError(t, err)
+
+	t.Run("should compute the hash of a full tree", func(t *testing.T) {
+		hash, err := CellHash(root)
+		require.NoError(t, err)
+		require.Equal(t, rootHash, hash)
+	})
+
+	t.Run("should compute the hash of the original tree from a proof", func(t *testing.T) {
+		proof, err := CreateProof(root, root.Refs()[0])
+		require.NoError(t, err)
+
+		hash, virtualRoot, err := parseMerkleProof(proof)
+		require.NoError(t, err)
+		require.Equal(t, rootHash, hash)
+
+		// the info is kept, the other refs are pruned
+		refs := virtualRoot.Refs()
+		require.Len(t, refs, 4)
+		require.Equal(t, boc.OrdinaryCell, refs[0].CellType())
+		for _, ref := range refs[1:] {
+			require.Equal(t, boc.PrunedBranchCell, ref.CellType())
+		}
+	})
+}
+
+func TestParseHeader(t *testing.T) {
+	root, block := loadBlock(t)
+	rootHash, err := root.Hash()
+	require.NoError(t, err)
+
+	t.Run("should parse header", func(t *testing.T) {
+		proof, err := CreateProof(root, root.Refs()[0])
+		require.NoError(t, err)
+
+		header, err := ParseHeader(proof)
+		require.NoError(t, err)
+		require.Equal(t, rootHash, header.RootHash)
+		require.EqualValues(t, 17734191, header.Info.SeqNo)
+		require.Equal(t, block.Info.PrevRef.PrevBlkInfo.Prev.RootHash[:], header.ParentHash())
+	})
+
+	t.Run("should fail if block info is pruned", func(t *testing.T) {
+		proof, err := CreateProof(root, root.Refs()[1])
+		require.NoError(t, err)
+
+		_, err = ParseHeader(proof)
+		require.ErrorContains(t, err, "block info is pruned")
+	})
+
+	t.Run("should fail if not a merkle proof", func(t *testing.T) {
+		_, err := ParseHeader(testdata.ReadTONMasterchainBlock(t))
+		require.ErrorContains(t, err, "not a merkle proof")
+	})
+}
+
+func TestProof_Verify(t *testing.T) {
+	root, block := loadBlock(t)
+	rootHash, err := root.Hash()
+	require.NoError(t, err)
+
+	txs := block.AllTransactions()
+	require.Greater(t, len(txs), 1)
+	txHash := txs[0].Hash()
+	txCell := findCell(t, root, txHash[:])
+	tx, err := txCell.ToBoc()
+	require.NoError(t, err)
+
+	t.Run("should verify a masterchain transaction", func(t *testing.T) {
+		proof, err := CreateProof(root, txCell)
+		require.NoError(t, err)
+
+		txBytes, err := NewProof(proof, nil, tx).Verify(rootHash)
+		require.NoError(t, err)
+		require.Equal(t, tx, txBytes)
+	})
+
+	t.Run("should fail if the proof is not for the block", func(t *testing.T) {
+		proof, err := CreateProof(root, txCell)
+		require.NoError(t, err)
+
+		_, err = NewProof(proof, nil, tx).Verify(make([]byte, 32))
+		require.ErrorContains(t, err, "not a proof of the block")
+	})
+
+	t.Run("should fail if the transaction is pruned", func(t *testing.T) {
+		otherHash := txs[1].Hash()
+		proof, err := CreateProof(root, findCell(t, root, otherHash[:]))
+		require.NoError(t, err)
+
+		_, err = NewProof(proof, nil, tx).Verify(rootHash)
+		require.ErrorContains(t, err, "transaction not found")
+	})
+
+	t.Run("should fail if the shard block is not referenced by the masterchain block", func(t *testing.T) {
+		mcExtra := root.Refs()[3].Refs()[3]
+		masterchainProof, err := CreateProof(root, mcExtra)
+		require.NoError(t, err)
+		shardProof, err := CreateProof(root, txCell)
+		require.NoError(t, err)
+
+		_, err = NewProof(masterchainProof, shardProof, tx).Verify(rootHash)
+		require.ErrorContains(t, err, "shard block not found")
+	})
+
+	t.Run("should find the shard blocks referenced by the masterchain block", func(t *testing.T) {
+		mcExtra := root.Refs()[3].Refs()[3]
+		masterchainProof, err := CreateProof(root, mcExtra)
+		require.NoError(t, err)
+		_, masterchainBlock, err := ParseBlockProof(masterchainProof)
+		require.NoError(t, err)
+
+		for _, shards := range block.Extra.Custom.Value.Value.ShardHashes.Values() {
+			for _, desc := range shards.Value.BinTree.Values {
+				descRootHash := desc.Old.RootHash
+				if desc.SumType == "New" {
+					descRootHash = desc.New.RootHash
+				}
+				found, ok := findShardBlock(masterchainBlock, descRootHash[:])
+				require.True(t, ok)
+				require.Equal(t, desc.SeqNo(), found.SeqNo())
+			}
+		}
+	})
+}
@@ -0,0 +1,235 @@
+package ton
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/tonkeeper/tongo/boc"
+)
+
+const (
+	bocMagic        = 0xb5ee9c72
+	merkleProofType = 3
+	prunedType      = 1
+)
+
+// proofCell is a cell of a merkle proof being built
+type proofCell struct {
+	data   []byte
+	bits   int
+	refs   []*proofCell
+	exotic bool
+	mask   byte
+}
+
+// CreateProof creates the merkle proof (BoC) of the cell tree with the given root
+// The proof keeps the subtrees of the given cells and the cells on the paths from the root to them,
+// the other cells are replaced by pruned branches
+// The cells of the tree must not contain pruned branches
+func CreateProof(root *boc.Cell, keep ...*boc.Cell) ([]byte, error) {
+	if root.Level() != 0 {
+		return nil, errors.New("cannot create the proof of a pruned tree")
+	}
+
+	kept := make(map[*boc.Cell]bool, len(keep))
+	for _, c := range keep {
+		kept[c] = true
+	}
+	p := &prover{
+		hasher: newCellHasher(),
+		kept:   kept,
+		onPath: make(map[*boc.Cell]bool),
+		cells:  make(map[*boc.Cell]*proofCell),
+	}
+	if !p.markPath(root) {
+		return nil, errors.New("the cells to keep are not in the tree")
+	}
+
+	virtualRoot, err := p.build(root, false)
+	if err != nil {
+		return nil, err
+	}
+	hashed, err := p.hasher.hash(root)
+	if err != nil {
+		return nil, err
+	}
+
+	// !merkle_proof#03 {X:Type} virtual_hash:bits256 depth:uint16 virtual_root:^X = MERKLE_PROOF X;
+	data := make([]byte, 0, 1+sha256.Size+2)
+	data = append(data, merkleProofType)
+	data = append(data, hashed.hash...)
+	data = binary.BigEndian.AppendUint16(data, hashed.depth)
+	proof := &proofCell{
+		data:   data,
+		bits:   len(data) * 8,
+		refs:   []*proofCell{virtualRoot},
+		exotic: true,
+		mask:   virtualRoot.mask >> 1,
+	}
+
+	return serializeBoc(proof)
+}
+
+type prover struct {
+	hasher *cellHasher
+	kept   map[*boc.Cell]bool
+	onPath map[*boc.Cell]bool
+	cells  map[*boc.Cell]*proofCell
+}
+
+// markPath marks the cells on the paths from c to the cells to keep
+// returns true if c is on one of these paths
+func (p *prover) markPath(c *boc.Cell) bool {
+	if onPath, ok := p.onPath[c]; ok {
+		return onPath
+	}
+	onPath := p.kept[c]
+	for _, ref := range c.Refs() {
+		if p.markPath(ref) {
+			onPath = true
+		}
+	}
+	p.onPath[c] = onPath
+	return onPath
+}
+
+// build returns the cell of the proof for c, full is true if c is in the subtree of a kept cell
+func (p *prover) build(c *boc.Cell, full bool) (*proofCell, error) {
+	full = full || p.kept[c]
+	if !full && !p.onPath[c] {
+		return p.prunedBranch(c)
+	}
+	if cell, ok := p.cells[c]; ok {
+		return cell, nil
+	}
+
+	bits := c.RawBitString()
+	cell := &proofCell{
+		data:   make([]byte, (c.BitSize()+7)/8),
+		bits:   c.BitSize(),
+		exotic: c.IsExotic(),
+	}
+	copy(cell.data, bits.Buffer())
+
+	var mask byte
+	for _, ref := range c.Refs() {
+		refCell, err := p.build(ref, full)
+		if err != nil {
+			return nil, err
+		}
+		mask |= refCell.mask
+		cell.refs = append(cell.refs, refCell)
+	}
+	switch c.CellType() {
+	case boc.OrdinaryCell, boc.LibraryCell:
+		cell.mask = mask
+	case boc.MerkleProofCell, boc.MerkleUpdateCell:
+		cell.mask = mask >> 1
+	default:
+		return nil, fmt.Errorf("unsupported cell type %d", c.CellType())
+	}
+
+	// the cells shared by the kept subtrees and the paths are built once
+	if full {
+		p.cells[c] = cell
+	}
+	return cell, nil
+}
+
+// prunedBranch returns the pruned branch of c at level 1
+func (p *prover) prunedBranch(c *boc.Cell) (*proofCell, error) {
+	hashed, err := p.hasher.hash(c)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0, 2+sha256.Size+2)
+	data = append(data, prunedType, 1)
+	data = append(data, hashed.hash...)
+	data = binary.BigEndian.AppendUint16(data, hashed.depth)
+	return &proofCell{
+		data:   data,
+		bits:   len(data) * 8,
+		exotic: true,
+		mask:   1,
+	}, nil
+}
+
+// serializeBoc serializes the tree with the given root in a BoC without index, crc and cache bits
+func serializeBoc(root *proofCell) ([]byte, error) {
+	// order the cells with the parents before the children
+	var (
+		order   []*proofCell
+		visited = make(map[*proofCell]bool)
+		visit   func(c *proofCell)
+	)
+	visit = func(c *proofCell) {
+		if visited[c] {
+			return
+		}
+		visited[c] = true
+		for _, ref := range c.refs {
+			visit(ref)
+		}
+		order = append(order, c)
+	}
+	visit(root)
+	indexes := make(map[*proofCell]int, len(order))
+	for i := range order {
+		j := len(order) - 1 - i
+		indexes[order[j]] = i
+	}
+
+	sizeBytes := bytesLen(uint64(len(order)))
+	var cells []byte
+	for i := len(order) - 1; i >= 0; i-- {
+		c := order[i]
+		// #nosec G115 always in range, a cell contains at most 4 refs and 1023 bits
+		d1 := byte(len(c.refs)) + c.mask<<5
+		if c.exotic {
+			d1 += 8
+		}
+		// #nosec G115 always in range
+		d2 := byte(c.bits/8 + (c.bits+7)/8)
+		cells = append(cells, d1, d2)
+		data := make([]byte, (c.bits+7)/8)
+		copy(data, c.data)
+		if c.bits%8 != 0 {
+			data[len(data)-1] |= 1 << (7 - c.bits%8)
+		}
+		cells = append(cells, data...)
+		for _, ref := range c.refs {
+			// #nosec G115 always positive
+			cells = appendUint(cells, uint64(indexes[ref]), sizeBytes)
+		}
+	}
+	offsetBytes := bytesLen(uint64(len(cells)))
+
+	res := binary.BigEndian.AppendUint32(nil, bocMagic)
+	// #nosec G115 always in range
+	res = append(res, byte(sizeBytes), byte(offsetBytes))
+	res = appendUint(res, uint64(len(order)), sizeBytes)
+	res = appendUint(res, 1, sizeBytes)
+	res = appendUint(res, 0, sizeBytes)
+	res = appendUint(res, uint64(len(cells)), offsetBytes)
+	res = appendUint(res, 0, sizeBytes)
+	return append(res, cells...), nil
+}
+
+// bytesLen returns the number of bytes needed to encode n
+func bytesLen(n uint64) int {
+	size := 1
+	for n >= 1<<(8*size) && size < 8 {
+		size++
+	}
+	return size
+}
+
+// appendUint appends n encoded in big endian on size bytes
+func appendUint(b []byte, n uint64, size int) []byte {
+	for i := size - 1; i >= 0; i-- {
+		b = append(b, byte(n>>(8*i)))
+	}
+	return b
+}
@@ -116,6 +116,7 @@ func (c *Clients) ListPendingCCTXWithinRateLimit(
 
 // ListPendingCCTX returns a list of pending cctxs for a given chain
 //   - The max size of the list is crosschainkeeper.MaxPendingCctxs
+//   - The cctxs held until released or rejected by the authority are skipped
 func (c *Clients) ListPendingCCTX(ctx context.Context, chainID int64) ([]*types.CrossChainTx, uint64, error) {
 	in := &types.QueryListPendingCctxRequest{ChainId: chainID}
 
@@ -124,7 +125,15 @@ func (c *Clients) ListPendingCCTX(ctx context.Context, chainID int64) ([]*types.
 		return nil, 0, errors.Wrap(err, "failed to get pending cctxs")
 	}
 
-	return resp.CrossChainTx, resp.TotalPending, nil
+	cctxs := make([]*types.CrossChainTx, 0, len(resp.CrossChainTx))
+	for _, cctx := range resp.CrossChainTx {
+		if cctx.GetCctxStatus().GetStatus() == types.CctxStatus_PendingOutboundHeld {
+			continue
+		}
+		cctxs = append(cctxs, cctx)
+	}
+
+	return cctxs, resp.TotalPending, nil
 }
 
 // GetOutboundTracker returns the outbound tracker for a chain and nonce
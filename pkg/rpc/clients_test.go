@@ -355,6 +355,36 @@ func TestZetacore_ListPendingCctx(t *testing.T) {
 	require.Equal(t, expectedOutput.TotalPending, totalPending)
 }
 
+func TestZetacore_ListPendingCctxSkipsHeldCctx(t *testing.T) {
+	ctx := context.Background()
+
+	pending := &crosschaintypes.CrossChainTx{
+		Index:      "cross-chain4456",
+		CctxStatus: &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_PendingOutbound},
+	}
+	expectedOutput := crosschaintypes.QueryListPendingCctxResponse{
+		CrossChainTx: []*crosschaintypes.CrossChainTx{
+			pending,
+			{
+				Index:      "cross-chain4457",
+				CctxStatus: &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_PendingOutboundHeld},
+			},
+		},
+		TotalPending: 2,
+	}
+	input := crosschaintypes.QueryListPendingCctxRequest{ChainId: 7000}
+	method := "/zetachain.zetacore.crosschain.Query/ListPendingCctx"
+	setupMockServer(t, crosschaintypes.RegisterQueryServer, method, input, expectedOutput)
+
+	client := setupZetacoreClients(t)
+
+	resp, totalPending, err := client.ListPendingCCTX(ctx, 7000)
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	require.Equal(t, pending.Index, resp[0].Index)
+	require.Equal(t, expectedOutput.TotalPending, totalPending)
+}
+
 func TestZetacore_GetAbortedZetaAmount(t *testing.T) {
 	ctx := context.Background()
 
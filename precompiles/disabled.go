@@ -0,0 +1,35 @@
+package precompiles
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// disabledContract is the placeholder of a registered stateful precompile that is not enabled on-chain.
+// The EVM requires every custom contract function to return a contract, calls to the placeholder revert.
+type disabledContract struct {
+	address common.Address
+}
+
+var _ vm.StatefulPrecompiledContract = (*disabledContract)(nil)
+
+func newDisabledContract(address common.Address) *disabledContract {
+	return &disabledContract{address: address}
+}
+
+// Address returns the address of the disabled contract.
+func (c *disabledContract) Address() common.Address {
+	return c.address
+}
+
+// RequiredGas returns zero, no computation is done by a disabled contract.
+func (c *disabledContract) RequiredGas(_ []byte) uint64 {
+	return 0
+}
+
+// Run always fails as the contract is disabled.
+func (c *disabledContract) Run(_ *vm.EVM, _ *vm.Contract, _ bool) ([]byte, error) {
+	return nil, fmt.Errorf("precompiled contract %s is disabled", c.address.Hex())
+}
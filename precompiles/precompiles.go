@@ -18,16 +18,18 @@ import (
 	fungiblekeeper "github.com/zeta-chain/node/x/fungible/keeper"
 )
 
-// EnabledStatefulContracts contains the list of all enabled stateful precompiles.
+// RegisteredStatefulContracts contains the addresses of all the registered stateful precompiles.
 // This is useful for listing and reading from other packages, such as BlockedAddrs() function.
-// Setting to false a contract here will disable it, not being included in the blockchain.
-var EnabledStatefulContracts = map[common.Address]bool{
-	prototype.ContractAddress: false,
-	staking.ContractAddress:   false,
-	bank.ContractAddress:      false,
+// A registered contract is only usable on ZEVM once it's enabled on-chain through the fungible module,
+// a call to a disabled contract reverts.
+var RegisteredStatefulContracts = []common.Address{
+	prototype.ContractAddress,
+	staking.ContractAddress,
+	bank.ContractAddress,
 }
 
 // StatefulContracts returns all the registered precompiled contracts.
+// The enabled status of each contract is read from state every time the EVM is instantiated.
 func StatefulContracts(
 	fungibleKeeper *fungiblekeeper.Keeper,
 	stakingKeeper *stakingkeeper.Keeper,
@@ -40,41 +42,51 @@ func StatefulContracts(
 	precompiledContracts = make([]evmkeeper.CustomContractFn, 0)
 
 	// Define the prototype contract function.
-	if EnabledStatefulContracts[prototype.ContractAddress] {
-		prototypeContract := func(_ sdktypes.Context, _ ethparams.Rules) vm.StatefulPrecompiledContract {
-			return prototype.NewIPrototypeContract(fungibleKeeper, cdc, gasConfig)
+	prototypeContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.StatefulPrecompiledContract {
+		if !isEnabled(ctx, fungibleKeeper, prototype.ContractAddress) {
+			return newDisabledContract(prototype.ContractAddress)
 		}
-
-		// Append the prototype contract to the precompiledContracts slice.
-		precompiledContracts = append(precompiledContracts, prototypeContract)
+		return prototype.NewIPrototypeContract(fungibleKeeper, cdc, gasConfig)
 	}
 
+	// Append the prototype contract to the precompiledContracts slice.
+	precompiledContracts = append(precompiledContracts, prototypeContract)
+
 	// Define the staking contract function.
-	if EnabledStatefulContracts[staking.ContractAddress] {
-		stakingContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.StatefulPrecompiledContract {
-			return staking.NewIStakingContract(
-				ctx,
-				stakingKeeper,
-				*fungibleKeeper,
-				bankKeeper,
-				distributionKeeper,
-				cdc,
-				gasConfig,
-			)
+	stakingContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.StatefulPrecompiledContract {
+		if !isEnabled(ctx, fungibleKeeper, staking.ContractAddress) {
+			return newDisabledContract(staking.ContractAddress)
 		}
-
-		// Append the staking contract to the precompiledContracts slice.
-		precompiledContracts = append(precompiledContracts, stakingContract)
+		return staking.NewIStakingContract(
+			ctx,
+			stakingKeeper,
+			*fungibleKeeper,
+			bankKeeper,
+			distributionKeeper,
+			cdc,
+			gasConfig,
+		)
 	}
 
-	if EnabledStatefulContracts[bank.ContractAddress] {
-		bankContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.StatefulPrecompiledContract {
-			return bank.NewIBankContract(ctx, bankKeeper, *fungibleKeeper, cdc, gasConfig)
-		}
+	// Append the staking contract to the precompiledContracts slice.
+	precompiledContracts = append(precompiledContracts, stakingContract)
 
-		// Append the staking contract to the precompiledContracts slice.
-		precompiledContracts = append(precompiledContracts, bankContract)
+	// Define the bank contract function.
+	bankContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.StatefulPrecompiledContract {
+		if !isEnabled(ctx, fungibleKeeper, bank.ContractAddress) {
+			return newDisabledContract(bank.ContractAddress)
+		}
+		return bank.NewIBankContract(ctx, bankKeeper, *fungibleKeeper, cdc, gasConfig)
 	}
 
+	// Append the bank contract to the precompiledContracts slice.
+	precompiledContracts = append(precompiledContracts, bankContract)
+
 	return precompiledContracts
 }
+
+// isEnabled reads the status of the precompiled contract from state.
+// The read doesn't consume gas, so the gas cost of transactions doesn't depend on the number of registered contracts.
+func isEnabled(ctx sdktypes.Context, fungibleKeeper *fungiblekeeper.Keeper, address common.Address) bool {
+	return fungibleKeeper.IsPrecompileEnabled(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), address)
+}
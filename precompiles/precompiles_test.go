@@ -7,6 +7,8 @@ import (
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 	ethermint "github.com/zeta-chain/ethermint/types"
+
+	"github.com/zeta-chain/node/precompiles/prototype"
 	"github.com/zeta-chain/node/testutil/keeper"
 )
 
@@ -17,14 +19,7 @@ func Test_StatefulContracts(t *testing.T) {
 	var encoding ethermint.EncodingConfig
 	appCodec := encoding.Codec
 
-	var expectedContracts int
-	for _, enabled := range EnabledStatefulContracts {
-		if enabled {
-			expectedContracts++
-		}
-	}
-
-	// StatefulContracts() should return all the enabled contracts.
+	// StatefulContracts() should return all the registered contracts.
 	contracts := StatefulContracts(
 		k,
 		&sdkk.StakingKeeper,
@@ -34,14 +29,45 @@ func Test_StatefulContracts(t *testing.T) {
 		gasConfig,
 	)
 	require.NotNil(t, contracts, "StatefulContracts() should not return a nil slice")
-	require.Len(t, contracts, expectedContracts, "StatefulContracts() should return all the enabled contracts")
+	require.Len(
+		t,
+		contracts,
+		len(RegisteredStatefulContracts),
+		"StatefulContracts() should return all the registered contracts",
+	)
 
-	for _, customContractFn := range contracts {
+	// contracts are disabled by default
+	for i, customContractFn := range contracts {
 		// Extract the contract function.
 		contract := customContractFn(ctx, ethparams.Rules{})
 
 		// Check the contract function returns a valid address.
-		contractAddr := contract.Address()
-		require.NotNil(t, contractAddr, "The called contract should have a valid address")
+		require.Equal(t, RegisteredStatefulContracts[i], contract.Address())
+		require.IsType(t, &disabledContract{}, contract)
 	}
+
+	// enable the prototype contract
+	k.SetPrecompileStatus(ctx, prototype.ContractAddress, true)
+
+	for i, customContractFn := range contracts {
+		contract := customContractFn(ctx, ethparams.Rules{})
+		require.Equal(t, RegisteredStatefulContracts[i], contract.Address())
+
+		if contract.Address() == prototype.ContractAddress {
+			require.IsType(t, &prototype.Contract{}, contract)
+		} else {
+			require.IsType(t, &disabledContract{}, contract)
+		}
+	}
+}
+
+func Test_DisabledContract(t *testing.T) {
+	contract := newDisabledContract(prototype.ContractAddress)
+
+	require.Equal(t, prototype.ContractAddress, contract.Address())
+	require.Zero(t, contract.RequiredGas([]byte{0x01}))
+
+	res, err := contract.Run(nil, nil, false)
+	require.ErrorContains(t, err, "is disabled")
+	require.Nil(t, res)
 }
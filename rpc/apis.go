@@ -21,6 +21,7 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/ethereum/go-ethereum/rpc"
 	ethermint "github.com/zeta-chain/ethermint/types"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/personal"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/txpool"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/web3"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
 )
 
 // RPC namespaces and API version
@@ -61,6 +63,8 @@ type APICreator = func(
 	tendermintWebsocketClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
+	mempool sdkmempool.Mempool,
+	simulator rpctypes.Simulator,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -73,8 +77,10 @@ func init() {
 			tmWSClient *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			_ sdkmempool.Mempool,
+			simulator rpctypes.Simulator,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, simulator)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -90,7 +96,15 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, ethermint.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(
+			*server.Context,
+			client.Context,
+			*rpcclient.WSClient,
+			bool,
+			ethermint.EVMTxIndexer,
+			sdkmempool.Mempool,
+			rpctypes.Simulator,
+		) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -100,7 +114,15 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(
+			_ *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			_ bool,
+			_ ethermint.EVMTxIndexer,
+			_ sdkmempool.Mempool,
+			_ rpctypes.Simulator,
+		) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -116,8 +138,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			_ sdkmempool.Mempool,
+			simulator rpctypes.Simulator,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, simulator)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -127,12 +151,20 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+			mempool sdkmempool.Mempool,
+			simulator rpctypes.Simulator,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, simulator)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend, mempool),
 					Public:    true,
 				},
 			}
@@ -142,8 +174,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			_ sdkmempool.Mempool,
+			simulator rpctypes.Simulator,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, simulator)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -158,8 +192,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			_ sdkmempool.Mempool,
+			simulator rpctypes.Simulator,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, simulator)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -178,13 +214,15 @@ func GetRPCAPIs(ctx *server.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
+	mempool sdkmempool.Mempool,
+	simulator rpctypes.Simulator,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer, mempool, simulator)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
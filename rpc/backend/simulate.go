@@ -0,0 +1,339 @@
+package backend
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/pkg/errors"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks simulated by eth_simulateV1
+	maxSimulateBlocks = 256
+
+	// maxSimulateCalls is the maximum number of calls simulated by eth_simulateV1
+	maxSimulateCalls = 100
+
+	// simulateBlockTimeIncrement is the default timestamp increment (in seconds) between simulated blocks
+	simulateBlockTimeIncrement = 1
+
+	// simErrCodeReverted and simErrCodeVMError are the error codes of the failed calls, as defined by go-ethereum
+	simErrCodeReverted = 3
+	simErrCodeVMError  = -32015
+
+	// error codes of the request errors, as defined by the eth_simulateV1 spec and EIP-1474
+	simErrCodeInvalidParams         = -32602
+	simErrCodeNotSupported          = -32004
+	simErrCodeBlockGasLimitReached  = -38015
+	simErrCodeBlockNumberInvalid    = -38020
+	simErrCodeBlockTimestampInvalid = -38021
+	simErrCodeClientLimitExceeded   = -38026
+)
+
+// simError is an error of an eth_simulateV1 request with its JSON-RPC error code
+type simError struct {
+	code    int
+	message string
+}
+
+func (e *simError) Error() string { return e.message }
+
+// ErrorCode returns the JSON-RPC error code
+func (e *simError) ErrorCode() int { return e.code }
+
+// simNotSupported returns the error of an eth_simulateV1 feature not supported by the EVM module
+func simNotSupported(feature string) error {
+	return &simError{code: simErrCodeNotSupported, message: feature + " not supported"}
+}
+
+// SimulateV1 executes a sequence of calls, grouped in blocks, on top of the state of the given block.
+// Every call sees the state changes of the previous ones, including the state overrides of its block
+// and of the preceding blocks.
+//
+// The calls share a gas budget equal to the RPC gas cap: a call without gas limit gets the remaining budget
+// (capped by the remaining gas of its block if the block gas limit is overridden),
+// and the gas used by every call is deducted from it.
+//
+// The calls are executed by the app on a throwaway copy of the state, the following requests fail
+// with the "not supported" error code (-32004):
+//   - prevRandao block override, the EVM module has no randomness
+//   - validation mode and transfer tracing
+func (b *Backend) SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]*rpctypes.SimBlockResult, error) {
+	switch {
+	case len(opts.BlockStateCalls) == 0:
+		return nil, &simError{code: simErrCodeInvalidParams, message: "empty input"}
+	case len(opts.BlockStateCalls) > maxSimulateBlocks:
+		return nil, &simError{
+			code:    simErrCodeClientLimitExceeded,
+			message: fmt.Sprintf("too many blocks (max %d)", maxSimulateBlocks),
+		}
+	case opts.Validation:
+		return nil, simNotSupported("validation mode")
+	case opts.TraceTransfers:
+		return nil, simNotSupported("tracing transfers")
+	case b.simulator == nil:
+		return nil, simNotSupported("eth_simulateV1")
+	}
+
+	if err := simCheckCalls(opts.BlockStateCalls); err != nil {
+		return nil, err
+	}
+
+	base, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil || base == nil || base.Block == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	sim, err := b.simulator.NewSimulation(
+		base.Block.Height,
+		sdk.ConsAddress(base.Block.ProposerAddress),
+		b.chainID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to start simulation")
+	}
+
+	budget := b.RPCGasCap()
+	if budget == 0 {
+		// no gas cap
+		budget = math.MaxInt64
+	}
+
+	var (
+		number    = base.Block.Height
+		timestamp = base.Block.Time.Unix()
+		results   = make([]*rpctypes.SimBlockResult, 0, len(opts.BlockStateCalls))
+	)
+
+	for _, block := range opts.BlockStateCalls {
+		header, err := simBlockHeader(block.BlockOverrides, number, timestamp)
+		if err != nil {
+			return nil, err
+		}
+		number, timestamp = header.Number, header.Time.Unix()
+
+		if err := sim.StartBlock(header); err != nil {
+			return nil, errors.Wrapf(err, "unable to start block %d", number)
+		}
+
+		if block.StateOverrides != nil {
+			if err := sim.ApplyStateOverrides(*block.StateOverrides); err != nil {
+				return nil, &simError{
+					code:    simErrCodeInvalidParams,
+					message: fmt.Sprintf("invalid state overrides of block %d: %s", number, err.Error()),
+				}
+			}
+		}
+
+		result := &rpctypes.SimBlockResult{
+			// #nosec G115 always positive
+			Number: hexutil.Uint64(number),
+			// #nosec G115 always positive
+			Timestamp:    hexutil.Uint64(timestamp),
+			Transactions: make([]common.Hash, 0, len(block.Calls)),
+			Calls:        make([]rpctypes.SimCallResult, 0, len(block.Calls)),
+		}
+
+		logIndex := uint(0)
+		for i, args := range block.Calls {
+			if err := simCallGas(&args, budget, header.GasLimit, uint64(result.GasUsed)); err != nil {
+				return nil, errors.Wrapf(err, "call %d of block %d", i, number)
+			}
+
+			if args.From == nil {
+				args.From = &common.Address{}
+			}
+			if args.Nonce == nil {
+				nonce := hexutil.Uint64(sim.GetNonce(*args.From))
+				args.Nonce = &nonce
+			}
+
+			tx := args.ToTransaction()
+			if tx == nil {
+				return nil, fmt.Errorf("invalid call %d of block %d", i, number)
+			}
+			txHash := tx.AsTransaction().Hash()
+
+			res, err := sim.Call(args, txHash, uint(i))
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to simulate call %d of block %d", i, number)
+			}
+
+			// #nosec G115 always positive
+			callResult := simCallResult(res, uint64(number), txHash, uint(i), &logIndex)
+
+			budget -= min(budget, uint64(callResult.GasUsed))
+
+			result.GasUsed += callResult.GasUsed
+			result.Transactions = append(result.Transactions, txHash)
+			result.Calls = append(result.Calls, callResult)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// simCallGas sets the gas limit of the call from the remaining gas budget and block gas
+func simCallGas(args *evmtypes.TransactionArgs, budget uint64, blockGasLimit *uint64, blockGasUsed uint64) error {
+	available := budget
+	if blockGasLimit != nil {
+		blockGasLeft := *blockGasLimit - min(*blockGasLimit, blockGasUsed)
+		if args.Gas != nil && uint64(*args.Gas) > blockGasLeft {
+			return &simError{
+				code:    simErrCodeBlockGasLimitReached,
+				message: fmt.Sprintf("block gas limit reached: %d > %d", uint64(*args.Gas), blockGasLeft),
+			}
+		}
+		available = min(available, blockGasLeft)
+	}
+
+	if available == 0 {
+		return &simError{code: simErrCodeClientLimitExceeded, message: "gas budget exhausted"}
+	}
+
+	if args.Gas == nil || uint64(*args.Gas) > available {
+		gas := hexutil.Uint64(available)
+		args.Gas = &gas
+	}
+
+	return nil
+}
+
+// simCheckCalls checks the number of simulated calls
+func simCheckCalls(blocks []rpctypes.SimBlock) error {
+	calls := 0
+	for _, block := range blocks {
+		calls += len(block.Calls)
+	}
+
+	if calls > maxSimulateCalls {
+		return &simError{
+			code:    simErrCodeClientLimitExceeded,
+			message: fmt.Sprintf("too many calls (max %d)", maxSimulateCalls),
+		}
+	}
+
+	return nil
+}
+
+// simBlockHeader returns the header of the next simulated block
+func simBlockHeader(
+	overrides *rpctypes.BlockOverrides,
+	prevNumber, prevTimestamp int64,
+) (rpctypes.SimHeader, error) {
+	number, timestamp := prevNumber+1, prevTimestamp+simulateBlockTimeIncrement
+	if overrides == nil {
+		return rpctypes.SimHeader{Number: number, Time: time.Unix(timestamp, 0).UTC()}, nil
+	}
+
+	if overrides.PrevRandao != nil {
+		return rpctypes.SimHeader{}, simNotSupported("prevRandao block override")
+	}
+
+	if overrides.Number != nil {
+		n := overrides.Number.ToInt()
+		if !n.IsInt64() || n.Int64() <= prevNumber {
+			return rpctypes.SimHeader{}, &simError{
+				code:    simErrCodeBlockNumberInvalid,
+				message: fmt.Sprintf("block numbers must be in order: %s <= %d", n.String(), prevNumber),
+			}
+		}
+		number = n.Int64()
+	}
+
+	if overrides.Time != nil {
+		// #nosec G115 checked below
+		t := int64(*overrides.Time)
+		if t < 0 || t <= prevTimestamp {
+			return rpctypes.SimHeader{}, &simError{
+				code: simErrCodeBlockTimestampInvalid,
+				message: fmt.Sprintf(
+					"block timestamps must be in order: %d <= %d",
+					uint64(*overrides.Time),
+					prevTimestamp,
+				),
+			}
+		}
+		timestamp = t
+	}
+
+	header := rpctypes.SimHeader{
+		Number:       number,
+		Time:         time.Unix(timestamp, 0).UTC(),
+		FeeRecipient: overrides.FeeRecipient,
+	}
+	if overrides.GasLimit != nil {
+		gasLimit := uint64(*overrides.GasLimit)
+		header.GasLimit = &gasLimit
+	}
+	if overrides.BaseFeePerGas != nil {
+		header.BaseFee = overrides.BaseFeePerGas.ToInt()
+	}
+
+	return header, nil
+}
+
+// simCallResult converts the response of the simulated call into the call result
+func simCallResult(
+	res *evmtypes.MsgEthereumTxResponse,
+	blockNumber uint64,
+	txHash common.Hash,
+	txIndex uint,
+	logIndex *uint,
+) rpctypes.SimCallResult {
+	result := rpctypes.SimCallResult{
+		ReturnData: res.Ret,
+		Logs:       []*ethtypes.Log{},
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+
+	if result.ReturnData == nil {
+		result.ReturnData = hexutil.Bytes{}
+	}
+
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		result.Error = &rpctypes.SimCallError{Code: simErrCodeVMError, Message: res.VmError}
+
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			result.Error.Code = simErrCodeReverted
+			if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+				result.Error.Message = fmt.Sprintf("%s: %s", res.VmError, reason)
+			}
+			result.Error.Data = hexutil.Encode(res.Ret)
+		}
+
+		// the logs of a failed call are discarded
+		return result
+	}
+
+	// the logs are in order of emission
+	for _, log := range evmtypes.LogsToEthereum(res.Logs) {
+		result.Logs = append(result.Logs, &ethtypes.Log{
+			Address:     log.Address,
+			Topics:      log.Topics,
+			Data:        log.Data,
+			BlockNumber: blockNumber,
+			TxHash:      txHash,
+			TxIndex:     txIndex,
+			Index:       *logIndex,
+		})
+		*logIndex++
+	}
+
+	return result
+}
@@ -0,0 +1,438 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	tmlog "cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zeta-chain/ethermint/indexer"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	"github.com/zeta-chain/node/rpc/backend/mocks"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+// fakeSimulation records the simulated blocks and calls, and returns the given call responses in order
+type fakeSimulation struct {
+	height    int64
+	nonces    map[common.Address]uint64
+	headers   []rpctypes.SimHeader
+	overrides []rpctypes.StateOverride
+	calls     []evmtypes.TransactionArgs
+	txIndexes []uint
+
+	responses []*evmtypes.MsgEthereumTxResponse
+	callErr   error
+}
+
+func (s *fakeSimulation) NewSimulation(height int64, _ sdk.ConsAddress, _ *big.Int) (rpctypes.Simulation, error) {
+	s.height = height
+	return s, nil
+}
+
+func (s *fakeSimulation) StartBlock(header rpctypes.SimHeader) error {
+	s.headers = append(s.headers, header)
+	return nil
+}
+
+func (s *fakeSimulation) ApplyStateOverrides(overrides rpctypes.StateOverride) error {
+	for addr, account := range overrides {
+		if account.State != nil && account.StateDiff != nil {
+			return errors.New("both state and stateDiff")
+		}
+		if account.Nonce != nil {
+			s.nonces[addr] = uint64(*account.Nonce)
+		}
+	}
+	s.overrides = append(s.overrides, overrides)
+	return nil
+}
+
+func (s *fakeSimulation) GetNonce(address common.Address) uint64 {
+	return s.nonces[address]
+}
+
+func (s *fakeSimulation) Call(
+	args evmtypes.TransactionArgs,
+	_ common.Hash,
+	txIndex uint,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	if s.callErr != nil {
+		return nil, s.callErr
+	}
+
+	s.calls = append(s.calls, args)
+	s.txIndexes = append(s.txIndexes, txIndex)
+	s.nonces[*args.From] = uint64(*args.Nonce) + 1
+
+	return s.responses[len(s.calls)-1], nil
+}
+
+func (suite *BackendTestSuite) TestSimulateV1() {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	other := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	feeRecipient := common.HexToAddress("0x4000000000000000000000000000000000000004")
+	nonce := hexutil.Uint64(3)
+	gasLimit := hexutil.Uint64(100000)
+	aboveGasLimit := gasLimit + 1
+	timestamp := hexutil.Uint64(100)
+	prevRandao := common.HexToHash("0x01")
+
+	call := evmtypes.TransactionArgs{From: &from, To: &to, Nonce: &nonce}
+
+	// the successful call emits a log, then a sub call emits another one
+	successResponse := &evmtypes.MsgEthereumTxResponse{
+		GasUsed: 0x5208,
+		Ret:     []byte{0x01},
+		Logs: evmtypes.NewLogsFromEth([]*ethtypes.Log{
+			{Address: to, Topics: []common.Hash{}, Data: []byte{0x01}},
+			{Address: other, Topics: []common.Hash{}, Data: []byte{0x02}},
+		}),
+	}
+
+	// Error("nope")
+	revertData := append(crypto.Keccak256([]byte("Error(string)"))[:4], common.LeftPadBytes([]byte{0x20}, 32)...)
+	revertData = append(revertData, common.LeftPadBytes([]byte{0x04}, 32)...)
+	revertData = append(revertData, common.RightPadBytes([]byte("nope"), 32)...)
+	revertResponse := &evmtypes.MsgEthereumTxResponse{
+		GasUsed: 0x6000,
+		Ret:     revertData,
+		VmError: "execution reverted",
+	}
+
+	gasCap := uint64(25_000_000)
+
+	newSimulation := func(responses ...*evmtypes.MsgEthereumTxResponse) *fakeSimulation {
+		return &fakeSimulation{nonces: map[common.Address]uint64{from: 7}, responses: responses}
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		simulation   *fakeSimulation
+		opts         rpctypes.SimOpts
+		expCode      int
+	}{
+		{
+			"fail - empty input",
+			func() {},
+			newSimulation(),
+			rpctypes.SimOpts{},
+			simErrCodeInvalidParams,
+		},
+		{
+			"fail - validation mode is not supported",
+			func() {},
+			newSimulation(),
+			rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{{Calls: []evmtypes.TransactionArgs{call}}},
+				Validation:      true,
+			},
+			simErrCodeNotSupported,
+		},
+		{
+			"fail - no simulator",
+			func() {},
+			nil,
+			rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{Calls: []evmtypes.TransactionArgs{call}}}},
+			simErrCodeNotSupported,
+		},
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			newSimulation(),
+			rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{Calls: []evmtypes.TransactionArgs{call}}}},
+			0,
+		},
+		{
+			"fail - too many calls",
+			func() {},
+			newSimulation(),
+			rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+				{Calls: make([]evmtypes.TransactionArgs, maxSimulateCalls+1)},
+			}},
+			simErrCodeClientLimitExceeded,
+		},
+		{
+			"fail - prevRandao block override is not supported",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+			},
+			newSimulation(),
+			rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{
+				BlockOverrides: &rpctypes.BlockOverrides{PrevRandao: &prevRandao},
+				Calls:          []evmtypes.TransactionArgs{call},
+			}}},
+			simErrCodeNotSupported,
+		},
+		{
+			"fail - block numbers not in order",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+			},
+			newSimulation(),
+			rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{
+				BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1))},
+				Calls:          []evmtypes.TransactionArgs{call},
+			}}},
+			simErrCodeBlockNumberInvalid,
+		},
+		{
+			"fail - invalid state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+			},
+			newSimulation(),
+			rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{
+				StateOverrides: &rpctypes.StateOverride{from: rpctypes.OverrideAccount{
+					State:     &map[common.Hash]common.Hash{},
+					StateDiff: &map[common.Hash]common.Hash{},
+				}},
+				Calls: []evmtypes.TransactionArgs{call},
+			}}},
+			simErrCodeInvalidParams,
+		},
+		{
+			"fail - call gas above the block gas limit",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+			},
+			newSimulation(),
+			rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{
+				BlockOverrides: &rpctypes.BlockOverrides{GasLimit: &gasLimit},
+				Calls:          []evmtypes.TransactionArgs{{From: &from, To: &to, Gas: &aboveGasLimit}},
+			}}},
+			simErrCodeBlockGasLimitReached,
+		},
+		{
+			"fail - call error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+			},
+			&fakeSimulation{nonces: map[common.Address]uint64{}, callErr: errors.New("intrinsic gas too low")},
+			rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{Calls: []evmtypes.TransactionArgs{call}}}},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.GasCap = gasCap
+			if tc.simulation != nil {
+				suite.backend.simulator = tc.simulation
+			}
+			tc.registerMock()
+
+			_, err := suite.backend.SimulateV1(tc.opts, 1)
+			suite.Require().Error(err)
+
+			// the request errors have the error code of the spec
+			if tc.expCode != 0 {
+				var rpcErr interface{ ErrorCode() int }
+				suite.Require().ErrorAs(err, &rpcErr)
+				suite.Require().Equal(tc.expCode, rpcErr.ErrorCode())
+			}
+		})
+	}
+
+	suite.Run("pass - calls in several blocks with overrides", func() {
+		suite.SetupTest()
+		suite.backend.cfg.JSONRPC.GasCap = gasCap
+
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		RegisterBlock(client, 1, nil)
+		sim := newSimulation(successResponse, revertResponse, successResponse)
+		suite.backend.simulator = sim
+
+		senderNonce := hexutil.Uint64(10)
+		baseFee := (*hexutil.Big)(big.NewInt(5))
+		overrides := rpctypes.StateOverride{
+			from: rpctypes.OverrideAccount{Nonce: &senderNonce},
+		}
+
+		// the first block overrides the state of the sender, the calls without nonce follow its overridden nonce
+		opts := rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+			{
+				BlockOverrides: &rpctypes.BlockOverrides{Time: &timestamp},
+				StateOverrides: &overrides,
+				Calls:          []evmtypes.TransactionArgs{{From: &from, To: &to}, {From: &from, To: &to}},
+			},
+			{
+				BlockOverrides: &rpctypes.BlockOverrides{
+					GasLimit:      &gasLimit,
+					FeeRecipient:  &feeRecipient,
+					BaseFeePerGas: baseFee,
+				},
+				Calls: []evmtypes.TransactionArgs{{From: &other, To: &to}},
+			},
+		}}
+
+		results, err := suite.backend.SimulateV1(opts, 1)
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 2)
+
+		// simulation on top of the base block, with the headers of the simulated blocks
+		suite.Require().EqualValues(1, sim.height)
+		suite.Require().Len(sim.headers, 2)
+		suite.Require().EqualValues(2, sim.headers[0].Number)
+		suite.Require().EqualValues(100, sim.headers[0].Time.Unix())
+		suite.Require().Nil(sim.headers[0].GasLimit)
+		suite.Require().EqualValues(3, sim.headers[1].Number)
+		suite.Require().EqualValues(101, sim.headers[1].Time.Unix())
+		suite.Require().EqualValues(gasLimit, *sim.headers[1].GasLimit)
+		suite.Require().Equal(&feeRecipient, sim.headers[1].FeeRecipient)
+		suite.Require().Equal(baseFee.ToInt(), sim.headers[1].BaseFee)
+		suite.Require().Equal([]rpctypes.StateOverride{overrides}, sim.overrides)
+
+		// calls with their senders and nonces, the gas used is deducted from the budget
+		suite.Require().Len(sim.calls, 3)
+		suite.Require().EqualValues(10, *sim.calls[0].Nonce)
+		suite.Require().EqualValues(gasCap, *sim.calls[0].Gas)
+		suite.Require().EqualValues(11, *sim.calls[1].Nonce)
+		suite.Require().EqualValues(gasCap-0x5208, *sim.calls[1].Gas)
+		suite.Require().Equal(other, *sim.calls[2].From)
+		suite.Require().EqualValues(0, *sim.calls[2].Nonce)
+		suite.Require().EqualValues(gasLimit, *sim.calls[2].Gas)
+		suite.Require().Equal([]uint{0, 1, 0}, sim.txIndexes)
+
+		// first block: successful call with its logs in order of emission, then reverted call
+		suite.Require().EqualValues(2, results[0].Number)
+		suite.Require().EqualValues(100, results[0].Timestamp)
+		suite.Require().EqualValues(0x5208+0x6000, results[0].GasUsed)
+		suite.Require().Len(results[0].Transactions, 2)
+		suite.Require().Len(results[0].Calls, 2)
+
+		success := results[0].Calls[0]
+		suite.Require().EqualValues(1, success.Status)
+		suite.Require().Nil(success.Error)
+		suite.Require().Equal(hexutil.Bytes{0x01}, success.ReturnData)
+		suite.Require().Len(success.Logs, 2)
+		for i, log := range success.Logs {
+			suite.Require().Equal([]byte{byte(i + 1)}, log.Data)
+			suite.Require().EqualValues(i, log.Index)
+			suite.Require().EqualValues(2, log.BlockNumber)
+			suite.Require().Equal(results[0].Transactions[0], log.TxHash)
+		}
+		suite.Require().Equal(other, success.Logs[1].Address)
+
+		reverted := results[0].Calls[1]
+		suite.Require().EqualValues(0, reverted.Status)
+		suite.Require().Empty(reverted.Logs)
+		suite.Require().Equal(&rpctypes.SimCallError{
+			Code:    simErrCodeReverted,
+			Message: "execution reverted: nope",
+			Data:    hexutil.Encode(revertData),
+		}, reverted.Error)
+
+		// second block: the log index restarts
+		suite.Require().EqualValues(3, results[1].Number)
+		suite.Require().EqualValues(0x5208, results[1].GasUsed)
+		suite.Require().EqualValues(0, results[1].Calls[0].Logs[0].Index)
+		suite.Require().EqualValues(0, results[1].Calls[0].Logs[0].TxIndex)
+	})
+
+	suite.Run("pass - call gas is capped by the budget", func() {
+		suite.SetupTest()
+		suite.backend.cfg.JSONRPC.GasCap = gasCap
+
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		RegisterBlock(client, 1, nil)
+		sim := newSimulation(successResponse)
+		suite.backend.simulator = sim
+
+		gas := hexutil.Uint64(gasCap + 1)
+		opts := rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{
+			Calls: []evmtypes.TransactionArgs{{From: &from, To: &to, Nonce: &nonce, Gas: &gas}},
+		}}}
+
+		results, err := suite.backend.SimulateV1(opts, 1)
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 1)
+		suite.Require().EqualValues(0x5208, results[0].GasUsed)
+		suite.Require().EqualValues(gasCap, *sim.calls[0].Gas)
+		suite.Require().EqualValues(3, *sim.calls[0].Nonce)
+	})
+}
+
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	suite.Run("block not found", func() {
+		suite.SetupTest()
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		RegisterBlockNotFound(client, 1)
+
+		receipts, err := suite.backend.GetBlockReceipts(1)
+		suite.Require().NoError(err)
+		suite.Require().Nil(receipts)
+	})
+
+	suite.Run("block without transactions", func() {
+		suite.SetupTest()
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		RegisterBlock(client, 1, []types.Tx{})
+		RegisterBlockResults(client, 1)
+
+		receipts, err := suite.backend.GetBlockReceipts(1)
+		suite.Require().NoError(err)
+		suite.Require().Empty(receipts)
+		suite.Require().NotNil(receipts)
+	})
+
+	suite.Run("block with transactions fetched once", func() {
+		suite.SetupTest()
+		msgEthereumTx, _ := suite.buildEthereumTx()
+		txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+		txHash := msgEthereumTx.AsTransaction().Hash()
+
+		txResults := []*abci.ExecTxResult{{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+			},
+		}}
+
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		resBlock, err := RegisterBlock(client, 1, []types.Tx{txBz})
+		suite.Require().NoError(err)
+		_, err = RegisterBlockResultsWithTxResults(client, 1, txResults)
+		suite.Require().NoError(err)
+
+		suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+		suite.Require().NoError(suite.backend.indexer.IndexBlock(resBlock.Block, txResults))
+
+		receipts, err := suite.backend.GetBlockReceipts(1)
+		suite.Require().NoError(err)
+		suite.Require().Len(receipts, 1)
+		suite.Require().Equal(txHash, receipts[0]["transactionHash"])
+		suite.Require().EqualValues(0, receipts[0]["transactionIndex"])
+
+		client.AssertNumberOfCalls(suite.T(), "Block", 1)
+		client.AssertNumberOfCalls(suite.T(), "BlockResults", 1)
+	})
+}
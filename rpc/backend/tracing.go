@@ -18,10 +18,12 @@ package backend
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 
@@ -105,6 +107,91 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 	return decodedResult, nil
 }
 
+// TraceCall returns the structured logs created during the execution of a call on top of the state
+// of the given block and returns them as a JSON object. The call is traced as an unsigned message
+// from the given sender, at the height and time of the block.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	if config != nil && (config.StateOverrides != nil || config.BlockOverrides != nil) {
+		return nil, errors.New("state and block overrides are not supported")
+	}
+
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil || blk == nil || blk.Block == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	if blk.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	var from common.Address
+	if args.From != nil {
+		from = *args.From
+	}
+	args.From = &from
+
+	if args.Gas == nil {
+		gasCap := b.RPCGasCap()
+		if gasCap == 0 {
+			// no gas cap
+			gasCap = math.MaxInt64
+		}
+		gas := hexutil.Uint64(gasCap)
+		args.Gas = &gas
+	}
+
+	if args.Nonce == nil {
+		nonce, err := b.getAccountNonce(from, false, blk.Block.Height, b.logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get sender nonce")
+		}
+		n := hexutil.Uint64(nonce)
+		args.Nonce = &n
+	}
+
+	msg := args.ToTransaction()
+	if msg == nil {
+		return nil, errors.New("invalid call")
+	}
+
+	traceTxRequest := evmtypes.QueryTraceTxRequest{
+		Msg:             msg,
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	if config != nil {
+		traceTxRequest.TraceConfig = &config.TraceConfig
+	}
+
+	// the call is traced on top of the state at the end of the block
+	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(blk.Block.Height), &traceTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var decodedResult interface{}
+	err = json.Unmarshal(traceResult.Data, &decodedResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	tmlog "cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -9,13 +10,17 @@ import (
 	"github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/zeta-chain/ethermint/crypto/ethsecp256k1"
 	"github.com/zeta-chain/ethermint/indexer"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 
 	"github.com/zeta-chain/node/rpc/backend/mocks"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
 )
 
 func (suite *BackendTestSuite) TestTraceTransaction() {
@@ -314,3 +319,112 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	nonce := hexutil.Uint64(3)
+	gasCap := uint64(25_000_000)
+
+	args := evmtypes.TransactionArgs{From: &from, To: &to, Nonce: &nonce}
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+
+	// traceCallRequest matches the trace request of the call with the given tracer
+	traceCallRequest := func(tracer string) interface{} {
+		return mock.MatchedBy(func(req *evmtypes.QueryTraceTxRequest) bool {
+			tx := req.Msg.AsTransaction()
+			return req.Msg.From == from.Hex() &&
+				tx.Nonce() == 3 &&
+				tx.Gas() == gasCap &&
+				len(req.Predecessors) == 0 &&
+				req.BlockNumber == 1 &&
+				req.ChainId == 7001 &&
+				req.TraceConfig.Tracer == tracer
+		})
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"pass - call tracer",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				queryClient.On("TraceTx", rpctypes.ContextWithHeight(1), traceCallRequest("callTracer")).
+					Return(&evmtypes.QueryTraceTxResponse{Data: []byte(`{"type":"CALL","gasUsed":"0x5208"}`)}, nil)
+			},
+			&rpctypes.TraceCallConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"}},
+			map[string]interface{}{"type": "CALL", "gasUsed": "0x5208"},
+			true,
+		},
+		{
+			"pass - prestate tracer",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				data := []byte(`{"0x2000000000000000000000000000000000000002":{"balance":"0x0"}}`)
+				queryClient.On("TraceTx", rpctypes.ContextWithHeight(1), traceCallRequest("prestateTracer")).
+					Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
+			},
+			&rpctypes.TraceCallConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "prestateTracer"}},
+			map[string]interface{}{
+				"0x2000000000000000000000000000000000000002": map[string]interface{}{"balance": "0x0"},
+			},
+			true,
+		},
+		{
+			"fail - state overrides are not supported",
+			func() {},
+			&rpctypes.TraceCallConfig{StateOverrides: &rpctypes.StateOverride{}},
+			nil,
+			false,
+		},
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			&rpctypes.TraceCallConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"}},
+			nil,
+			false,
+		},
+		{
+			"fail - trace error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				queryClient.On("TraceTx", rpctypes.ContextWithHeight(1), traceCallRequest("callTracer")).
+					Return(nil, errortypes.ErrInvalidRequest)
+			},
+			&rpctypes.TraceCallConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"}},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.GasCap = gasCap
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(args, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
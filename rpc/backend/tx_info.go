@@ -164,6 +164,44 @@ func (b *Backend) getTransactionByHashPending(txHash common.Hash) (*rpctypes.RPC
 	return nil, nil
 }
 
+// GetBlockReceipts returns the receipts of all the ethereum transactions of the block, in the block order.
+// It returns nil if the block is not found.
+func (b *Backend) GetBlockReceipts(blockNum rpctypes.BlockNumber) ([]map[string]interface{}, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to fetch block result from Tendermint", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	msgs, _ := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+
+	receipts := make([]map[string]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		hash := common.HexToHash(msg.Hash)
+		res, additional, err := b.GetTxByEthHash(hash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "tx %s not found", msg.Hash)
+		}
+
+		receipt, err := b.formatTxReceipt(hash, res, additional, resBlock, blockRes, msgs)
+		switch {
+		case err != nil:
+			return nil, err
+		case receipt == nil:
+			return nil, fmt.Errorf("receipt not found for tx %s", msg.Hash)
+		}
+
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
 // GetTransactionReceipt returns the transaction receipt identified by hash.
 func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
 	hexTx := hash.Hex()
@@ -180,8 +218,32 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, nil
 	}
 
-	var txData evmtypes.TxData
-	var ethMsg *evmtypes.MsgEthereumTx
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	return b.formatTxReceipt(hash, res, additional, resBlock, blockRes, nil)
+}
+
+// formatTxReceipt returns the receipt of the transaction from its result and the block including it,
+// msgs are the ethereum messages of the block, they are fetched from the block if nil
+func (b *Backend) formatTxReceipt(
+	hash common.Hash,
+	res *ethermint.TxResult,
+	additional *rpctypes.TxResultAdditionalFields,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	msgs []*evmtypes.MsgEthereumTx,
+) (map[string]interface{}, error) {
+	hexTx := hash.Hex()
+
+	var (
+		txData evmtypes.TxData
+		ethMsg *evmtypes.MsgEthereumTx
+		err    error
+	)
 	// if additional fields are empty we can try to get MsgEthereumTx from sdk.Msg array
 	if additional == nil {
 		// #nosec G115 always in range
@@ -215,11 +277,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 
 	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
-	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
-		return nil, nil
-	}
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		// #nosec G115 always positive
 		cumulativeGasUsed += uint64(txResult.GasUsed)
@@ -254,7 +311,9 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 	if res.EthTxIndex == -1 {
 		// Fallback to find tx index by iterating all valid eth transactions
-		msgs, _ := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		if msgs == nil {
+			msgs, _ = b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		}
 		for i := range msgs {
 			if msgs[i].Hash == hexTx {
 				// #nosec G115 always in range
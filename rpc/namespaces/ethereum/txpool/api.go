@@ -16,50 +16,194 @@
 package txpool
 
 import (
+	"fmt"
+	"sort"
+
 	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 
+	"github.com/zeta-chain/node/rpc/backend"
 	"github.com/zeta-chain/node/rpc/types"
 )
 
+const (
+	pendingKey = "pending"
+	queuedKey  = "queued"
+)
+
+// txsSnapshotter is the app-side mempool able to return a snapshot of its transactions
+type txsSnapshotter interface {
+	Txs() []sdk.Tx
+}
+
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/zeta-chain/ethermint/issues/124
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
+	mempool txsSnapshotter
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+// The transactions are read from the app-side mempool, if it doesn't support snapshots the pool is reported empty.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend, mempool sdkmempool.Mempool) *PublicAPI {
+	logger = logger.With("module", "txpool")
+
+	snapshotter, ok := mempool.(txsSnapshotter)
+	if !ok {
+		logger.Info("app mempool doesn't support snapshots, txpool namespace reports an empty pool")
+	}
+
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger,
+		backend: backend,
+		mempool: snapshotter,
 	}
 }
 
+// poolTx is an ethereum transaction of the pool
+type poolTx struct {
+	msg   *evmtypes.MsgEthereumTx
+	rpcTx *types.RPCTransaction
+}
+
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
-	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+
+	pending, queued, err := api.poolTxs()
+	if err != nil {
+		return nil, err
 	}
-	return content, nil
+
+	format := func(tx poolTx) *types.RPCTransaction { return tx.rpcTx }
+
+	return map[string]map[string]map[string]*types.RPCTransaction{
+		pendingKey: groupBySenderAndNonce(pending, format),
+		queuedKey:  groupBySenderAndNonce(queued, format),
+	}, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
-	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+
+	pending, queued, err := api.poolTxs()
+	if err != nil {
+		return nil, err
 	}
-	return content, nil
+
+	return map[string]map[string]map[string]string{
+		pendingKey: groupBySenderAndNonce(pending, inspect),
+		queuedKey:  groupBySenderAndNonce(queued, inspect),
+	}, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.poolTxs()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		pendingKey: hexutil.Uint(len(pending)),
+		queuedKey:  hexutil.Uint(len(queued)),
+	}, nil
+}
+
+// poolTxs returns the ethereum transactions of the mempool split into pending and queued ones.
+// Pending transactions have consecutive nonces starting from the sender's account nonce,
+// queued ones come after a nonce gap and can't be executed yet.
+func (api *PublicAPI) poolTxs() (pending, queued []poolTx, err error) {
+	if api.mempool == nil {
+		return nil, nil, nil
 	}
+
+	chainID := api.backend.ChainConfig().ChainID
+
+	senders := make(map[common.Address][]poolTx)
+	for _, tx := range api.mempool.Txs() {
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			rpcTx, err := types.NewTransactionFromMsg(
+				ethMsg,
+				common.Hash{},
+				uint64(0),
+				uint64(0),
+				nil,
+				chainID,
+				nil,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			senders[rpcTx.From] = append(senders[rpcTx.From], poolTx{msg: ethMsg, rpcTx: rpcTx})
+		}
+	}
+
+	for sender, txs := range senders {
+		accountNonce, err := api.backend.GetTransactionCount(sender, types.EthLatestBlockNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sort.Slice(txs, func(i, j int) bool {
+			return txs[i].rpcTx.Nonce < txs[j].rpcTx.Nonce
+		})
+
+		nextNonce := uint64(*accountNonce)
+		for _, tx := range txs {
+			nonce := uint64(tx.rpcTx.Nonce)
+			switch {
+			case nonce < nextNonce:
+				// already executed, about to be removed from the mempool
+				continue
+			case nonce == nextNonce:
+				pending = append(pending, tx)
+				nextNonce++
+			default:
+				queued = append(queued, tx)
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// groupBySenderAndNonce groups the formatted transactions by sender address and nonce
+func groupBySenderAndNonce[T any](txs []poolTx, format func(poolTx) T) map[string]map[string]T {
+	grouped := make(map[string]map[string]T)
+	for _, tx := range txs {
+		sender := tx.rpcTx.From.Hex()
+		if _, ok := grouped[sender]; !ok {
+			grouped[sender] = make(map[string]T)
+		}
+
+		grouped[sender][fmt.Sprintf("%d", uint64(tx.rpcTx.Nonce))] = format(tx)
+	}
+
+	return grouped
+}
+
+// inspect formats the transaction the same way geth does in txpool_inspect
+func inspect(tx poolTx) string {
+	ethTx := tx.msg.AsTransaction()
+	if to := ethTx.To(); to != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), ethTx.Value(), ethTx.Gas(), ethTx.GasPrice())
+	}
+
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", ethTx.Value(), ethTx.Gas(), ethTx.GasPrice())
 }
@@ -0,0 +1,160 @@
+package txpool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/zeta-chain/node/rpc/backend"
+	"github.com/zeta-chain/node/rpc/types"
+)
+
+var testChainID = big.NewInt(7001)
+
+type testBackend struct {
+	backend.EVMBackend
+	nonces map[common.Address]uint64
+}
+
+func (b *testBackend) ChainConfig() *params.ChainConfig {
+	return &params.ChainConfig{ChainID: testChainID}
+}
+
+func (b *testBackend) GetTransactionCount(address common.Address, _ types.BlockNumber) (*hexutil.Uint64, error) {
+	n := hexutil.Uint64(b.nonces[address])
+	return &n, nil
+}
+
+type testMempool struct {
+	txs []sdk.Tx
+}
+
+func (m *testMempool) Txs() []sdk.Tx {
+	return m.txs
+}
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func signedTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, to *common.Address) sdk.Tx {
+	tx, err := ethtypes.SignTx(
+		ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    nonce,
+			To:       to,
+			Value:    big.NewInt(10),
+			Gas:      21000,
+			GasPrice: big.NewInt(2),
+		}),
+		ethtypes.LatestSignerForChainID(testChainID),
+		key,
+	)
+	require.NoError(t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+
+	return testTx{msgs: []sdk.Msg{msg}}
+}
+
+func TestPublicAPI(t *testing.T) {
+	keyA, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	keyB, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	addrA := crypto.PubkeyToAddress(keyA.PublicKey)
+	addrB := crypto.PubkeyToAddress(keyB.PublicKey)
+	recipient := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// A has nonce 3: tx 2 is already executed, txs 3 and 4 are pending and tx 6 is queued
+	// B has nonce 0: tx 0 (contract creation) is pending
+	mempool := &testMempool{txs: []sdk.Tx{
+		signedTx(t, keyA, 4, &recipient),
+		signedTx(t, keyA, 6, &recipient),
+		signedTx(t, keyB, 0, nil),
+		signedTx(t, keyA, 2, &recipient),
+		signedTx(t, keyA, 3, &recipient),
+	}}
+	evmBackend := &testBackend{nonces: map[common.Address]uint64{addrA: 3}}
+
+	api := NewPublicAPI(log.NewNopLogger(), evmBackend, nil)
+	api.mempool = mempool
+
+	t.Run("Content", func(t *testing.T) {
+		content, err := api.Content()
+		require.NoError(t, err)
+
+		pending, queued := content[pendingKey], content[queuedKey]
+		require.Len(t, pending, 2)
+		require.Len(t, pending[addrA.Hex()], 2)
+		require.Len(t, pending[addrB.Hex()], 1)
+		require.Len(t, queued, 1)
+		require.Len(t, queued[addrA.Hex()], 1)
+
+		tx := pending[addrA.Hex()]["3"]
+		require.NotNil(t, tx)
+		require.Equal(t, addrA, tx.From)
+		require.EqualValues(t, 3, tx.Nonce)
+		require.Equal(t, &recipient, tx.To)
+
+		require.EqualValues(t, 4, pending[addrA.Hex()]["4"].Nonce)
+		require.EqualValues(t, 0, pending[addrB.Hex()]["0"].Nonce)
+		require.EqualValues(t, 6, queued[addrA.Hex()]["6"].Nonce)
+	})
+
+	t.Run("Inspect", func(t *testing.T) {
+		content, err := api.Inspect()
+		require.NoError(t, err)
+
+		require.Equal(t,
+			recipient.Hex()+": 10 wei + 21000 gas × 2 wei",
+			content[pendingKey][addrA.Hex()]["3"],
+		)
+		require.Equal(t,
+			"contract creation: 10 wei + 21000 gas × 2 wei",
+			content[pendingKey][addrB.Hex()]["0"],
+		)
+		require.Equal(t,
+			recipient.Hex()+": 10 wei + 21000 gas × 2 wei",
+			content[queuedKey][addrA.Hex()]["6"],
+		)
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		status, err := api.Status()
+		require.NoError(t, err)
+
+		require.Equal(t, hexutil.Uint(3), status[pendingKey])
+		require.Equal(t, hexutil.Uint(1), status[queuedKey])
+	})
+
+	t.Run("empty pool without mempool snapshots", func(t *testing.T) {
+		api := NewPublicAPI(log.NewNopLogger(), evmBackend, nil)
+
+		content, err := api.Content()
+		require.NoError(t, err)
+		require.Empty(t, content[pendingKey])
+		require.Empty(t, content[queuedKey])
+
+		status, err := api.Status()
+		require.NoError(t, err)
+		require.Equal(t, hexutil.Uint(0), status[pendingKey])
+		require.Equal(t, hexutil.Uint(0), status[queuedKey])
+	})
+}
@@ -17,10 +17,13 @@ package types
 
 import (
 	"math/big"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -97,6 +100,13 @@ type OverrideAccount struct {
 	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
 }
 
+// TraceCallConfig is the config of debug_traceCall, the trace config with the overrides of the call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
 	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
@@ -115,3 +125,83 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	TraceTransfers  bool       `json:"traceTransfers"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimBlock is a block of calls to simulate with its block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is the set of header fields to override when simulating a block.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number       hexutil.Uint64  `json:"number"`
+	Timestamp    hexutil.Uint64  `json:"timestamp"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Transactions []common.Hash   `json:"transactions"`
+	Calls        []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimHeader is the header of a simulated block, the nil fields keep the values of the base block.
+type SimHeader struct {
+	Number       int64
+	Time         time.Time
+	GasLimit     *uint64
+	FeeRecipient *common.Address
+	BaseFee      *big.Int
+}
+
+// Simulator creates the simulations of eth_simulateV1, it's implemented by the app.
+type Simulator interface {
+	// NewSimulation returns a simulation on top of the state of the given block.
+	NewSimulation(height int64, proposer sdk.ConsAddress, chainID *big.Int) (Simulation, error)
+}
+
+// Simulation executes calls on a throwaway copy of the state, every call sees the state changes of the previous ones.
+type Simulation interface {
+	// StartBlock sets the header of the block of the next calls.
+	StartBlock(header SimHeader) error
+
+	// ApplyStateOverrides overrides the accounts state.
+	ApplyStateOverrides(overrides StateOverride) error
+
+	// GetNonce returns the nonce of the account.
+	GetNonce(address common.Address) uint64
+
+	// Call executes the call and commits its state changes, the sender nonce is incremented.
+	// The args must have the sender, the gas and the nonce set.
+	Call(args evmtypes.TransactionArgs, txHash common.Hash, txIndex uint) (*evmtypes.MsgEthereumTxResponse, error)
+}
@@ -50,6 +50,7 @@ import (
 	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/spf13/cobra"
@@ -60,6 +61,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	zetaos "github.com/zeta-chain/node/pkg/os"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
 	"github.com/zeta-chain/node/server/config"
 	srvflags "github.com/zeta-chain/node/server/flags"
 )
@@ -579,7 +581,16 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer)
+		// the app-side mempool backs the txpool namespace
+		var mempool sdkmempool.Mempool
+		if mempoolApp, ok := app.(interface{ Mempool() sdkmempool.Mempool }); ok {
+			mempool = mempoolApp.Mempool()
+		}
+
+		// the app executes the calls of eth_simulateV1
+		simulator, _ := app.(rpctypes.Simulator)
+
+		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer, mempool, simulator)
 		if err != nil {
 			return err
 		}
@@ -680,6 +680,24 @@ func (_m *CrosschainObserverKeeper) IsInboundEnabled(ctx types.Context) bool {
 	return r0
 }
 
+// IsChainHalted provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) IsChainHalted(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsChainHalted")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsNonTombstonedObserver provides a mock function with given fields: ctx, address
 func (_m *CrosschainObserverKeeper) IsNonTombstonedObserver(ctx types.Context, address string) bool {
 	ret := _m.Called(ctx, address)
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	math "cosmossdk.io/math"
 	mock "github.com/stretchr/testify/mock"
 	chains "github.com/zeta-chain/node/pkg/chains"
 
@@ -191,6 +192,11 @@ func (_m *EmissionObserverKeeper) IsNonTombstonedObserver(ctx types.Context, add
 	return r0
 }
 
+// UpdateObserverLiveness provides a mock function with given fields: ctx, ballots, window, maxMissedRatio, jailDuration
+func (_m *EmissionObserverKeeper) UpdateObserverLiveness(ctx types.Context, ballots []observertypes.Ballot, window int64, maxMissedRatio math.LegacyDec, jailDuration int64) {
+	_m.Called(ctx, ballots, window, maxMissedRatio, jailDuration)
+}
+
 // NewEmissionObserverKeeper creates a new instance of EmissionObserverKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEmissionObserverKeeper(t interface {
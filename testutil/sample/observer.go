@@ -342,6 +342,14 @@ func OperationalFlags() types.OperationalFlags {
 	}
 }
 
+func ObserverLiveness(address string) types.ObserverLiveness {
+	liveness := types.ObserverLiveness{ObserverAddress: address}
+	for i := 0; i < 10; i++ {
+		liveness.AddVote(100, i%2 == 0)
+	}
+	return liveness
+}
+
 func ConfirmationParams(r *rand.Rand) types.ConfirmationParams {
 	randInboundCount := Uint64InRangeFromRand(r, 2, 200)
 	randOutboundCount := Uint64InRangeFromRand(r, 2, 200)
@@ -105,3 +105,10 @@ func LoadTestBlocks(t *testing.T) Blocks {
 
 	return blocks
 }
+
+// ReadTONMasterchainBlock reads a TON masterchain block (BoC) from a file.
+func ReadTONMasterchainBlock(t *testing.T) []byte {
+	data, err := testDataFiles.ReadFile("ton/masterchain_block_17734191.boc")
+	require.NoError(t, err)
+	return data
+}
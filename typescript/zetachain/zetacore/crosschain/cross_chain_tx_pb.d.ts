@@ -52,6 +52,22 @@ export declare enum CctxStatus {
    * @generated from enum value: Aborted = 6;
    */
   Aborted = 6,
+
+  /**
+   * inbound held by the inbound rate limiter of
+   * the sender chain; processed once released
+   *
+   * @generated from enum value: PendingInboundRateLimit = 7;
+   */
+  PendingInboundRateLimit = 7,
+
+  /**
+   * outbound value exceeds the held threshold of the
+   * receiver chain; waits for release or reject
+   *
+   * @generated from enum value: PendingOutboundHeld = 8;
+   */
+  PendingOutboundHeld = 8,
 }
 
 /**
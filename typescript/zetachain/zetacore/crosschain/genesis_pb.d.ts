@@ -11,7 +11,7 @@ import type { CrossChainTx, ZetaAccounting } from "./cross_chain_tx_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
 import type { InboundHashToCctx } from "./inbound_hash_to_cctx_pb.js";
 import type { InboundTracker } from "./inbound_tracker_pb.js";
-import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { InboundRateLimiterState, RateLimiterFlags } from "./rate_limiter_flags_pb.js";
 
 /**
  * GenesisState defines the crosschain module's genesis state.
@@ -69,6 +69,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   counter: bigint;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.InboundRateLimiterState inbound_rate_limiter_states = 19;
+   */
+  inboundRateLimiterStates: InboundRateLimiterState[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -12,7 +12,7 @@ import type { InboundHashToCctx } from "./inbound_hash_to_cctx_pb.js";
 import type { CrossChainTx } from "./cross_chain_tx_pb.js";
 import type { GasPrice } from "./gas_price_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
-import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { InboundRateLimiterState, RateLimitBucket, RateLimiterFlags } from "./rate_limiter_flags_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryZetaAccountingRequest
@@ -879,6 +879,54 @@ export declare class QueryListPendingCctxResponse extends Message<QueryListPendi
   static equals(a: QueryListPendingCctxResponse | PlainMessage<QueryListPendingCctxResponse> | undefined, b: QueryListPendingCctxResponse | PlainMessage<QueryListPendingCctxResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryListHeldCctxRequest
+ */
+export declare class QueryListHeldCctxRequest extends Message<QueryListHeldCctxRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryListHeldCctxRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryListHeldCctxRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryListHeldCctxRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryListHeldCctxRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryListHeldCctxRequest;
+
+  static equals(a: QueryListHeldCctxRequest | PlainMessage<QueryListHeldCctxRequest> | undefined, b: QueryListHeldCctxRequest | PlainMessage<QueryListHeldCctxRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryListHeldCctxResponse
+ */
+export declare class QueryListHeldCctxResponse extends Message<QueryListHeldCctxResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.CrossChainTx CrossChainTx = 1;
+   */
+  CrossChainTx: CrossChainTx[];
+
+  constructor(data?: PartialMessage<QueryListHeldCctxResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryListHeldCctxResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryListHeldCctxResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryListHeldCctxResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryListHeldCctxResponse;
+
+  static equals(a: QueryListHeldCctxResponse | PlainMessage<QueryListHeldCctxResponse> | undefined, b: QueryListHeldCctxResponse | PlainMessage<QueryListHeldCctxResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryRateLimiterInputRequest
  */
@@ -947,6 +995,13 @@ export declare class QueryRateLimiterInputResponse extends Message<QueryRateLimi
    */
   lowestPendingCctxHeight: bigint;
 
+  /**
+   * usage of the per-chain and per-asset rate limits
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.RateLimitBucket buckets = 8;
+   */
+  buckets: RateLimitBucket[];
+
   constructor(data?: PartialMessage<QueryRateLimiterInputResponse>);
 
   static readonly runtime: typeof proto3;
@@ -1222,6 +1277,61 @@ export declare class QueryRateLimiterFlagsResponse extends Message<QueryRateLimi
   static equals(a: QueryRateLimiterFlagsResponse | PlainMessage<QueryRateLimiterFlagsResponse> | undefined, b: QueryRateLimiterFlagsResponse | PlainMessage<QueryRateLimiterFlagsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryInboundRateLimiterStateRequest
+ */
+export declare class QueryInboundRateLimiterStateRequest extends Message<QueryInboundRateLimiterStateRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryInboundRateLimiterStateRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryInboundRateLimiterStateRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryInboundRateLimiterStateRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryInboundRateLimiterStateRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryInboundRateLimiterStateRequest;
+
+  static equals(a: QueryInboundRateLimiterStateRequest | PlainMessage<QueryInboundRateLimiterStateRequest> | undefined, b: QueryInboundRateLimiterStateRequest | PlainMessage<QueryInboundRateLimiterStateRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryInboundRateLimiterStateResponse
+ */
+export declare class QueryInboundRateLimiterStateResponse extends Message<QueryInboundRateLimiterStateResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.InboundRateLimiterState state = 1;
+   */
+  state?: InboundRateLimiterState;
+
+  /**
+   * indexes of the held cctxs in release order
+   *
+   * @generated from field: repeated string held_cctx_indexes = 2;
+   */
+  heldCctxIndexes: string[];
+
+  constructor(data?: PartialMessage<QueryInboundRateLimiterStateResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryInboundRateLimiterStateResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryInboundRateLimiterStateResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryInboundRateLimiterStateResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryInboundRateLimiterStateResponse;
+
+  static equals(a: QueryInboundRateLimiterStateResponse | PlainMessage<QueryInboundRateLimiterStateResponse> | undefined, b: QueryInboundRateLimiterStateResponse | PlainMessage<QueryInboundRateLimiterStateResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryInboundTrackerRequest
  */
@@ -37,6 +37,40 @@ export declare class RateLimiterFlags extends Message<RateLimiterFlags> {
    */
   conversions: Conversion[];
 
+  /**
+   * optional rate limits of the withdrawals to a given chain,
+   * enforced in addition to the global rate limit
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.ChainRateLimit chain_rate_limits = 5;
+   */
+  chainRateLimits: ChainRateLimit[];
+
+  /**
+   * optional rate limits of the withdrawals of a given zrc20,
+   * enforced in addition to the global rate limit
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.AssetRateLimit asset_rate_limits = 6;
+   */
+  assetRateLimits: AssetRateLimit[];
+
+  /**
+   * optional rate limits of the deposits from a given chain, enforced
+   * regardless of the enabled flag, the deposits over the limit are held
+   * until the window frees up
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.ChainRateLimit inbound_rate_limits = 7;
+   */
+  inboundRateLimits: ChainRateLimit[];
+
+  /**
+   * optional thresholds of the withdrawals to a given chain, enforced
+   * regardless of the enabled flag, the withdrawals over the threshold are
+   * held until released or rejected by the authority
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.HeldCctxThreshold held_cctx_thresholds = 8;
+   */
+  heldCctxThresholds: HeldCctxThreshold[];
+
   constructor(data?: PartialMessage<RateLimiterFlags>);
 
   static readonly runtime: typeof proto3;
@@ -52,6 +86,121 @@ export declare class RateLimiterFlags extends Message<RateLimiterFlags> {
   static equals(a: RateLimiterFlags | PlainMessage<RateLimiterFlags> | undefined, b: RateLimiterFlags | PlainMessage<RateLimiterFlags> | undefined): boolean;
 }
 
+/**
+ * ChainRateLimit is the rate limit of the withdrawals to a foreign chain
+ *
+ * @generated from message zetachain.zetacore.crosschain.ChainRateLimit
+ */
+export declare class ChainRateLimit extends Message<ChainRateLimit> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * window in blocks
+   *
+   * @generated from field: int64 window = 2;
+   */
+  window: bigint;
+
+  /**
+   * rate in azeta per block
+   *
+   * @generated from field: string rate = 3;
+   */
+  rate: string;
+
+  constructor(data?: PartialMessage<ChainRateLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.ChainRateLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainRateLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainRateLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainRateLimit;
+
+  static equals(a: ChainRateLimit | PlainMessage<ChainRateLimit> | undefined, b: ChainRateLimit | PlainMessage<ChainRateLimit> | undefined): boolean;
+}
+
+/**
+ * AssetRateLimit is the rate limit of the withdrawals of a zrc20,
+ * the value of the withdrawals is priced with the conversion of the zrc20
+ *
+ * @generated from message zetachain.zetacore.crosschain.AssetRateLimit
+ */
+export declare class AssetRateLimit extends Message<AssetRateLimit> {
+  /**
+   * @generated from field: string zrc20 = 1;
+   */
+  zrc20: string;
+
+  /**
+   * window in blocks
+   *
+   * @generated from field: int64 window = 2;
+   */
+  window: bigint;
+
+  /**
+   * rate in azeta per block
+   *
+   * @generated from field: string rate = 3;
+   */
+  rate: string;
+
+  constructor(data?: PartialMessage<AssetRateLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.AssetRateLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssetRateLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AssetRateLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AssetRateLimit;
+
+  static equals(a: AssetRateLimit | PlainMessage<AssetRateLimit> | undefined, b: AssetRateLimit | PlainMessage<AssetRateLimit> | undefined): boolean;
+}
+
+/**
+ * HeldCctxThreshold is the value above which the withdrawals to a foreign chain
+ * are held, the value of the withdrawals is priced with the conversions
+ *
+ * @generated from message zetachain.zetacore.crosschain.HeldCctxThreshold
+ */
+export declare class HeldCctxThreshold extends Message<HeldCctxThreshold> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * threshold in azeta
+   *
+   * @generated from field: string threshold = 2;
+   */
+  threshold: string;
+
+  constructor(data?: PartialMessage<HeldCctxThreshold>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.HeldCctxThreshold";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HeldCctxThreshold;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HeldCctxThreshold;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HeldCctxThreshold;
+
+  static equals(a: HeldCctxThreshold | PlainMessage<HeldCctxThreshold> | undefined, b: HeldCctxThreshold | PlainMessage<HeldCctxThreshold> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.Conversion
  */
@@ -125,3 +274,132 @@ export declare class AssetRate extends Message<AssetRate> {
   static equals(a: AssetRate | PlainMessage<AssetRate> | undefined, b: AssetRate | PlainMessage<AssetRate> | undefined): boolean;
 }
 
+/**
+ * RateLimitBucket is the usage of a per-chain or per-asset rate limit
+ *
+ * @generated from message zetachain.zetacore.crosschain.RateLimitBucket
+ */
+export declare class RateLimitBucket extends Message<RateLimitBucket> {
+  /**
+   * foreign chain of the bucket, also set for an asset bucket
+   *
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * zrc20 of an asset bucket, empty for a chain bucket
+   *
+   * @generated from field: string zrc20 = 2;
+   */
+  zrc20: string;
+
+  /**
+   * coin type and asset on the foreign chain of an asset bucket
+   *
+   * @generated from field: zetachain.zetacore.pkg.coin.CoinType coin_type = 3;
+   */
+  coinType: CoinType;
+
+  /**
+   * @generated from field: string asset = 4;
+   */
+  asset: string;
+
+  /**
+   * window in blocks
+   *
+   * @generated from field: int64 window = 5;
+   */
+  window: bigint;
+
+  /**
+   * rate in azeta per block
+   *
+   * @generated from field: string rate = 6;
+   */
+  rate: string;
+
+  /**
+   * the total value in azeta of the past cctxs of the bucket within its window
+   *
+   * @generated from field: string past_cctxs_value = 7;
+   */
+  pastCctxsValue: string;
+
+  /**
+   * the total value in azeta of the pending cctxs of the bucket
+   *
+   * @generated from field: string pending_cctxs_value = 8;
+   */
+  pendingCctxsValue: string;
+
+  /**
+   * the lowest height of the pending cctxs of the bucket
+   *
+   * @generated from field: int64 lowest_pending_cctx_height = 9;
+   */
+  lowestPendingCctxHeight: bigint;
+
+  constructor(data?: PartialMessage<RateLimitBucket>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.RateLimitBucket";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RateLimitBucket;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RateLimitBucket;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RateLimitBucket;
+
+  static equals(a: RateLimitBucket | PlainMessage<RateLimitBucket> | undefined, b: RateLimitBucket | PlainMessage<RateLimitBucket> | undefined): boolean;
+}
+
+/**
+ * InboundRateLimiterState is the state of the inbound rate limiter of a chain
+ *
+ * @generated from message zetachain.zetacore.crosschain.InboundRateLimiterState
+ */
+export declare class InboundRateLimiterState extends Message<InboundRateLimiterState> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * if tripped, all the deposits from the chain are held
+   *
+   * @generated from field: bool circuit_breaker_tripped = 2;
+   */
+  circuitBreakerTripped: boolean;
+
+  /**
+   * first block of the current window
+   *
+   * @generated from field: int64 window_start_height = 3;
+   */
+  windowStartHeight: bigint;
+
+  /**
+   * the total value in azeta of the deposits within the current window
+   *
+   * @generated from field: string window_value = 4;
+   */
+  windowValue: string;
+
+  constructor(data?: PartialMessage<InboundRateLimiterState>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.InboundRateLimiterState";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InboundRateLimiterState;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InboundRateLimiterState;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InboundRateLimiterState;
+
+  static equals(a: InboundRateLimiterState | PlainMessage<InboundRateLimiterState> | undefined, b: InboundRateLimiterState | PlainMessage<InboundRateLimiterState> | undefined): boolean;
+}
+
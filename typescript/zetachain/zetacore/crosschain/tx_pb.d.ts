@@ -141,20 +141,20 @@ export declare class MsgAddInboundTracker extends Message<MsgAddInboundTracker>
   coinType: CoinType;
 
   /**
-   * @generated from field: zetachain.zetacore.pkg.proofs.Proof proof = 5 [deprecated = true];
-   * @deprecated
+   * proof of the inbound transaction against a block header of the light
+   * client, required if the creator is neither an observer nor authorized
+   *
+   * @generated from field: zetachain.zetacore.pkg.proofs.Proof proof = 5;
    */
   proof?: Proof;
 
   /**
-   * @generated from field: string block_hash = 6 [deprecated = true];
-   * @deprecated
+   * @generated from field: string block_hash = 6;
    */
   blockHash: string;
 
   /**
-   * @generated from field: int64 tx_index = 7 [deprecated = true];
-   * @deprecated
+   * @generated from field: int64 tx_index = 7;
    */
   txIndex: bigint;
 
@@ -1083,3 +1083,206 @@ export declare class MsgUpdateERC20CustodyPauseStatusResponse extends Message<Ms
   static equals(a: MsgUpdateERC20CustodyPauseStatusResponse | PlainMessage<MsgUpdateERC20CustodyPauseStatusResponse> | undefined, b: MsgUpdateERC20CustodyPauseStatusResponse | PlainMessage<MsgUpdateERC20CustodyPauseStatusResponse> | undefined): boolean;
 }
 
+/**
+ * MsgTripInboundCircuitBreaker defines a message to hold all the deposits from
+ * a chain
+ *
+ * @generated from message zetachain.zetacore.crosschain.MsgTripInboundCircuitBreaker
+ */
+export declare class MsgTripInboundCircuitBreaker extends Message<MsgTripInboundCircuitBreaker> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<MsgTripInboundCircuitBreaker>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgTripInboundCircuitBreaker";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgTripInboundCircuitBreaker;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgTripInboundCircuitBreaker;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgTripInboundCircuitBreaker;
+
+  static equals(a: MsgTripInboundCircuitBreaker | PlainMessage<MsgTripInboundCircuitBreaker> | undefined, b: MsgTripInboundCircuitBreaker | PlainMessage<MsgTripInboundCircuitBreaker> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgTripInboundCircuitBreakerResponse
+ */
+export declare class MsgTripInboundCircuitBreakerResponse extends Message<MsgTripInboundCircuitBreakerResponse> {
+  constructor(data?: PartialMessage<MsgTripInboundCircuitBreakerResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgTripInboundCircuitBreakerResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgTripInboundCircuitBreakerResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgTripInboundCircuitBreakerResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgTripInboundCircuitBreakerResponse;
+
+  static equals(a: MsgTripInboundCircuitBreakerResponse | PlainMessage<MsgTripInboundCircuitBreakerResponse> | undefined, b: MsgTripInboundCircuitBreakerResponse | PlainMessage<MsgTripInboundCircuitBreakerResponse> | undefined): boolean;
+}
+
+/**
+ * MsgResetInboundCircuitBreaker defines a message to resume the deposits from
+ * a chain, the held deposits are released within the inbound rate limit
+ *
+ * @generated from message zetachain.zetacore.crosschain.MsgResetInboundCircuitBreaker
+ */
+export declare class MsgResetInboundCircuitBreaker extends Message<MsgResetInboundCircuitBreaker> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<MsgResetInboundCircuitBreaker>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgResetInboundCircuitBreaker";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgResetInboundCircuitBreaker;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgResetInboundCircuitBreaker;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgResetInboundCircuitBreaker;
+
+  static equals(a: MsgResetInboundCircuitBreaker | PlainMessage<MsgResetInboundCircuitBreaker> | undefined, b: MsgResetInboundCircuitBreaker | PlainMessage<MsgResetInboundCircuitBreaker> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgResetInboundCircuitBreakerResponse
+ */
+export declare class MsgResetInboundCircuitBreakerResponse extends Message<MsgResetInboundCircuitBreakerResponse> {
+  constructor(data?: PartialMessage<MsgResetInboundCircuitBreakerResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgResetInboundCircuitBreakerResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgResetInboundCircuitBreakerResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgResetInboundCircuitBreakerResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgResetInboundCircuitBreakerResponse;
+
+  static equals(a: MsgResetInboundCircuitBreakerResponse | PlainMessage<MsgResetInboundCircuitBreakerResponse> | undefined, b: MsgResetInboundCircuitBreakerResponse | PlainMessage<MsgResetInboundCircuitBreakerResponse> | undefined): boolean;
+}
+
+/**
+ * MsgReleaseHeldCctx defines a message to approve the outbound of a held cctx
+ *
+ * @generated from message zetachain.zetacore.crosschain.MsgReleaseHeldCctx
+ */
+export declare class MsgReleaseHeldCctx extends Message<MsgReleaseHeldCctx> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<MsgReleaseHeldCctx>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgReleaseHeldCctx";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgReleaseHeldCctx;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgReleaseHeldCctx;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgReleaseHeldCctx;
+
+  static equals(a: MsgReleaseHeldCctx | PlainMessage<MsgReleaseHeldCctx> | undefined, b: MsgReleaseHeldCctx | PlainMessage<MsgReleaseHeldCctx> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgReleaseHeldCctxResponse
+ */
+export declare class MsgReleaseHeldCctxResponse extends Message<MsgReleaseHeldCctxResponse> {
+  constructor(data?: PartialMessage<MsgReleaseHeldCctxResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgReleaseHeldCctxResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgReleaseHeldCctxResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgReleaseHeldCctxResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgReleaseHeldCctxResponse;
+
+  static equals(a: MsgReleaseHeldCctxResponse | PlainMessage<MsgReleaseHeldCctxResponse> | undefined, b: MsgReleaseHeldCctxResponse | PlainMessage<MsgReleaseHeldCctxResponse> | undefined): boolean;
+}
+
+/**
+ * MsgRejectHeldCctx defines a message to reject the outbound of a held cctx,
+ * the cctx is reverted
+ *
+ * @generated from message zetachain.zetacore.crosschain.MsgRejectHeldCctx
+ */
+export declare class MsgRejectHeldCctx extends Message<MsgRejectHeldCctx> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<MsgRejectHeldCctx>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgRejectHeldCctx";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRejectHeldCctx;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRejectHeldCctx;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRejectHeldCctx;
+
+  static equals(a: MsgRejectHeldCctx | PlainMessage<MsgRejectHeldCctx> | undefined, b: MsgRejectHeldCctx | PlainMessage<MsgRejectHeldCctx> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgRejectHeldCctxResponse
+ */
+export declare class MsgRejectHeldCctxResponse extends Message<MsgRejectHeldCctxResponse> {
+  constructor(data?: PartialMessage<MsgRejectHeldCctxResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgRejectHeldCctxResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRejectHeldCctxResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRejectHeldCctxResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRejectHeldCctxResponse;
+
+  static equals(a: MsgRejectHeldCctxResponse | PlainMessage<MsgRejectHeldCctxResponse> | undefined, b: MsgRejectHeldCctxResponse | PlainMessage<MsgRejectHeldCctxResponse> | undefined): boolean;
+}
+
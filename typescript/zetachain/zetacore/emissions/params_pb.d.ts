@@ -15,6 +15,9 @@ import { Message, proto3 } from "@bufbuild/protobuf";
  *    ObserverSlashAmount:         100000000000000000,
  *    BallotMaturityBlocks:        100,
  *    BlockRewardAmount:           9620949074074074074.074070733466756687,
+ *    ObserverLivenessWindow:      0,
+ *    ObserverMaxMissedRatio:      "0.5",
+ *    ObserverJailDuration:        0,
  *
  * @generated from message zetachain.zetacore.emissions.Params
  */
@@ -49,6 +52,30 @@ export declare class Params extends Message<Params> {
    */
   blockRewardAmount: string;
 
+  /**
+   * number of matured ballots over which the missed votes of the observers
+   * are tracked, 0 disables the tracking
+   *
+   * @generated from field: int64 observer_liveness_window = 12;
+   */
+  observerLivenessWindow: bigint;
+
+  /**
+   * an observer missing more than this ratio of the ballots in the window is
+   * jailed and removed from the observer set
+   *
+   * @generated from field: string observer_max_missed_ratio = 13;
+   */
+  observerMaxMissedRatio: string;
+
+  /**
+   * number of blocks a jailed observer has to wait before rejoining the
+   * observer set
+   *
+   * @generated from field: int64 observer_jail_duration = 14;
+   */
+  observerJailDuration: bigint;
+
   constructor(data?: PartialMessage<Params>);
 
   static readonly runtime: typeof proto3;
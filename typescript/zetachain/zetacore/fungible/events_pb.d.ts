@@ -379,3 +379,42 @@ export declare class EventGatewayContractUpdated extends Message<EventGatewayCon
   static equals(a: EventGatewayContractUpdated | PlainMessage<EventGatewayContractUpdated> | undefined, b: EventGatewayContractUpdated | PlainMessage<EventGatewayContractUpdated> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.fungible.EventPrecompileStatusUpdated
+ */
+export declare class EventPrecompileStatusUpdated extends Message<EventPrecompileStatusUpdated> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string precompile_address = 2;
+   */
+  precompileAddress: string;
+
+  /**
+   * @generated from field: bool enabled = 3;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: string signer = 4;
+   */
+  signer: string;
+
+  constructor(data?: PartialMessage<EventPrecompileStatusUpdated>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.EventPrecompileStatusUpdated";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventPrecompileStatusUpdated;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventPrecompileStatusUpdated;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventPrecompileStatusUpdated;
+
+  static equals(a: EventPrecompileStatusUpdated | PlainMessage<EventPrecompileStatusUpdated> | undefined, b: EventPrecompileStatusUpdated | PlainMessage<EventPrecompileStatusUpdated> | undefined): boolean;
+}
+
@@ -24,6 +24,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   systemContract?: SystemContract;
 
+  /**
+   * @generated from field: repeated string enabled_precompiles = 4;
+   */
+  enabledPrecompiles: string[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -369,3 +369,46 @@ export declare class QueryCodeHashResponse extends Message<QueryCodeHashResponse
   static equals(a: QueryCodeHashResponse | PlainMessage<QueryCodeHashResponse> | undefined, b: QueryCodeHashResponse | PlainMessage<QueryCodeHashResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryActivePrecompilesRequest
+ */
+export declare class QueryActivePrecompilesRequest extends Message<QueryActivePrecompilesRequest> {
+  constructor(data?: PartialMessage<QueryActivePrecompilesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryActivePrecompilesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryActivePrecompilesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryActivePrecompilesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryActivePrecompilesRequest;
+
+  static equals(a: QueryActivePrecompilesRequest | PlainMessage<QueryActivePrecompilesRequest> | undefined, b: QueryActivePrecompilesRequest | PlainMessage<QueryActivePrecompilesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryActivePrecompilesResponse
+ */
+export declare class QueryActivePrecompilesResponse extends Message<QueryActivePrecompilesResponse> {
+  /**
+   * @generated from field: repeated string addresses = 1;
+   */
+  addresses: string[];
+
+  constructor(data?: PartialMessage<QueryActivePrecompilesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryActivePrecompilesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryActivePrecompilesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryActivePrecompilesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryActivePrecompilesResponse;
+
+  static equals(a: QueryActivePrecompilesResponse | PlainMessage<QueryActivePrecompilesResponse> | undefined, b: QueryActivePrecompilesResponse | PlainMessage<QueryActivePrecompilesResponse> | undefined): boolean;
+}
+
@@ -627,3 +627,56 @@ export declare class MsgUpdateZRC20NameResponse extends Message<MsgUpdateZRC20Na
   static equals(a: MsgUpdateZRC20NameResponse | PlainMessage<MsgUpdateZRC20NameResponse> | undefined, b: MsgUpdateZRC20NameResponse | PlainMessage<MsgUpdateZRC20NameResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.fungible.MsgUpdatePrecompileStatus
+ */
+export declare class MsgUpdatePrecompileStatus extends Message<MsgUpdatePrecompileStatus> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string precompile_address = 2;
+   */
+  precompileAddress: string;
+
+  /**
+   * @generated from field: bool enabled = 3;
+   */
+  enabled: boolean;
+
+  constructor(data?: PartialMessage<MsgUpdatePrecompileStatus>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.MsgUpdatePrecompileStatus";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdatePrecompileStatus;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdatePrecompileStatus;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdatePrecompileStatus;
+
+  static equals(a: MsgUpdatePrecompileStatus | PlainMessage<MsgUpdatePrecompileStatus> | undefined, b: MsgUpdatePrecompileStatus | PlainMessage<MsgUpdatePrecompileStatus> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.MsgUpdatePrecompileStatusResponse
+ */
+export declare class MsgUpdatePrecompileStatusResponse extends Message<MsgUpdatePrecompileStatusResponse> {
+  constructor(data?: PartialMessage<MsgUpdatePrecompileStatusResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.MsgUpdatePrecompileStatusResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdatePrecompileStatusResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdatePrecompileStatusResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdatePrecompileStatusResponse;
+
+  static equals(a: MsgUpdatePrecompileStatusResponse | PlainMessage<MsgUpdatePrecompileStatusResponse> | undefined, b: MsgUpdatePrecompileStatusResponse | PlainMessage<MsgUpdatePrecompileStatusResponse> | undefined): boolean;
+}
+
@@ -98,6 +98,14 @@ export declare class Ballot extends Message<Ballot> {
    */
   ballotCreationHeight: bigint;
 
+  /**
+   * weights of the voters in the voter list, snapshotted at the ballot
+   * creation, the voters have the same weight if empty
+   *
+   * @generated from field: repeated int64 voter_weights = 9;
+   */
+  voterWeights: bigint[];
+
   constructor(data?: PartialMessage<Ballot>);
 
   static readonly runtime: typeof proto3;
@@ -75,6 +75,14 @@ export declare class CrosschainFlags extends Message<CrosschainFlags> {
    */
   gasPriceIncreaseFlags?: GasPriceIncreaseFlags;
 
+  /**
+   * chains halted by an emergency vote of the observers, the inbounds and
+   * outbounds of these chains are disabled
+   *
+   * @generated from field: repeated int64 haltedChains = 4;
+   */
+  haltedChains: bigint[];
+
   constructor(data?: PartialMessage<CrosschainFlags>);
 
   static readonly runtime: typeof proto3;
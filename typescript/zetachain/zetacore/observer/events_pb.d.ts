@@ -226,3 +226,134 @@ export declare class EventGasPriceIncreaseFlagsUpdated extends Message<EventGasP
   static equals(a: EventGasPriceIncreaseFlagsUpdated | PlainMessage<EventGasPriceIncreaseFlagsUpdated> | undefined, b: EventGasPriceIncreaseFlagsUpdated | PlainMessage<EventGasPriceIncreaseFlagsUpdated> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverJailed
+ */
+export declare class EventObserverJailed extends Message<EventObserverJailed> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 missed_votes_counter = 2;
+   */
+  missedVotesCounter: bigint;
+
+  /**
+   * @generated from field: int64 jailed_until = 3;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<EventObserverJailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverJailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverJailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static equals(a: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined, b: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverRejoined
+ */
+export declare class EventObserverRejoined extends Message<EventObserverRejoined> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string observer_address = 2;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: uint64 observer_last_block_count = 3;
+   */
+  observerLastBlockCount: bigint;
+
+  constructor(data?: PartialMessage<EventObserverRejoined>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverRejoined";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverRejoined;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverRejoined;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverRejoined;
+
+  static equals(a: EventObserverRejoined | PlainMessage<EventObserverRejoined> | undefined, b: EventObserverRejoined | PlainMessage<EventObserverRejoined> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventChainHalted
+ */
+export declare class EventChainHalted extends Message<EventChainHalted> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string ballot_identifier = 3;
+   */
+  ballotIdentifier: string;
+
+  constructor(data?: PartialMessage<EventChainHalted>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventChainHalted";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventChainHalted;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventChainHalted;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventChainHalted;
+
+  static equals(a: EventChainHalted | PlainMessage<EventChainHalted> | undefined, b: EventChainHalted | PlainMessage<EventChainHalted> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventChainUnhalted
+ */
+export declare class EventChainUnhalted extends Message<EventChainUnhalted> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<EventChainUnhalted>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventChainUnhalted";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventChainUnhalted;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventChainUnhalted;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventChainUnhalted;
+
+  static equals(a: EventChainUnhalted | PlainMessage<EventChainUnhalted> | undefined, b: EventChainUnhalted | PlainMessage<EventChainUnhalted> | undefined): boolean;
+}
+
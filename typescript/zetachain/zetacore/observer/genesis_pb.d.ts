@@ -18,6 +18,7 @@ import type { PendingNonces } from "./pending_nonces_pb.js";
 import type { ChainNonces } from "./chain_nonces_pb.js";
 import type { NonceToCctx } from "./nonce_to_cctx_pb.js";
 import type { OperationalFlags } from "./operational_pb.js";
+import type { ObserverLiveness } from "./liveness_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.GenesisState
@@ -105,6 +106,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   operationalFlags?: OperationalFlags;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLiveness observer_liveness = 17;
+   */
+  observerLiveness: ObserverLiveness[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
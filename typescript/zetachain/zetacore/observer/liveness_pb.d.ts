@@ -0,0 +1,71 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/observer/liveness.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * ObserverLiveness tracks the votes missed by an observer on the matured
+ * ballots over a sliding window
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverLiveness
+ */
+export declare class ObserverLiveness extends Message<ObserverLiveness> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * number of matured ballots tracked for the observer, the position of the
+   * next ballot in the window is index_offset modulo the window size
+   *
+   * @generated from field: int64 index_offset = 2;
+   */
+  indexOffset: bigint;
+
+  /**
+   * number of ballots missed by the observer in the window
+   *
+   * @generated from field: int64 missed_votes_counter = 3;
+   */
+  missedVotesCounter: bigint;
+
+  /**
+   * bit array of the missed ballots in the window
+   *
+   * @generated from field: bytes missed_votes = 4;
+   */
+  missedVotes: Uint8Array;
+
+  /**
+   * the jailed observer is removed from the observer set until it rejoins
+   *
+   * @generated from field: bool jailed = 5;
+   */
+  jailed: boolean;
+
+  /**
+   * height from which the jailed observer can rejoin the observer set
+   *
+   * @generated from field: int64 jailed_until = 6;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<ObserverLiveness>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverLiveness";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverLiveness;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static equals(a: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined, b: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined): boolean;
+}
+
@@ -34,6 +34,11 @@ export declare enum ObservationType {
    * @generated from enum value: TSSKeySign = 4;
    */
   TSSKeySign = 4,
+
+  /**
+   * @generated from enum value: ChainHalt = 5;
+   */
+  ChainHalt = 5,
 }
 
 /**
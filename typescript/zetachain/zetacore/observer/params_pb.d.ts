@@ -120,6 +120,22 @@ export declare class ChainParams extends Message<ChainParams> {
    */
   confirmationParams?: ConfirmationParams;
 
+  /**
+   * The votes on the ballots of the chain are weighted by the bonded tokens of
+   * the observer validators, snapshotted at the ballot creation
+   *
+   * @generated from field: bool stake_weighted_voting = 19;
+   */
+  stakeWeightedVoting: boolean;
+
+  /**
+   * The threshold of the ballots voting the emergency halt of the chain, the
+   * default emergency halt threshold is used if not set
+   *
+   * @generated from field: string emergency_halt_ballot_threshold = 20;
+   */
+  emergencyHaltBallotThreshold: string;
+
   constructor(data?: PartialMessage<ChainParams>);
 
   static readonly runtime: typeof proto3;
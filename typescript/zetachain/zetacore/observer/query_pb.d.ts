@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { ObserverLiveness } from "./liveness_pb.js";
 import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { Ballot, BallotStatus, VoteType } from "./ballot_pb.js";
 import type { OperationalFlags } from "./operational_pb.js";
@@ -20,6 +21,107 @@ import type { CrosschainFlags } from "./crosschain_flags_pb.js";
 import type { Keygen } from "./keygen_pb.js";
 import type { Blame } from "./blame_pb.js";
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetObserverLivenessRequest
+ */
+export declare class QueryGetObserverLivenessRequest extends Message<QueryGetObserverLivenessRequest> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<QueryGetObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessRequest;
+
+  static equals(a: QueryGetObserverLivenessRequest | PlainMessage<QueryGetObserverLivenessRequest> | undefined, b: QueryGetObserverLivenessRequest | PlainMessage<QueryGetObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetObserverLivenessResponse
+ */
+export declare class QueryGetObserverLivenessResponse extends Message<QueryGetObserverLivenessResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverLiveness observer_liveness = 1;
+   */
+  observerLiveness?: ObserverLiveness;
+
+  constructor(data?: PartialMessage<QueryGetObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessResponse;
+
+  static equals(a: QueryGetObserverLivenessResponse | PlainMessage<QueryGetObserverLivenessResponse> | undefined, b: QueryGetObserverLivenessResponse | PlainMessage<QueryGetObserverLivenessResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllObserverLivenessRequest
+ */
+export declare class QueryAllObserverLivenessRequest extends Message<QueryAllObserverLivenessRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessRequest;
+
+  static equals(a: QueryAllObserverLivenessRequest | PlainMessage<QueryAllObserverLivenessRequest> | undefined, b: QueryAllObserverLivenessRequest | PlainMessage<QueryAllObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllObserverLivenessResponse
+ */
+export declare class QueryAllObserverLivenessResponse extends Message<QueryAllObserverLivenessResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLiveness observer_liveness = 1;
+   */
+  observerLiveness: ObserverLiveness[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessResponse;
+
+  static equals(a: QueryAllObserverLivenessResponse | PlainMessage<QueryAllObserverLivenessResponse> | undefined, b: QueryAllObserverLivenessResponse | PlainMessage<QueryAllObserverLivenessResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryBallotsRequest
  */
@@ -707,6 +809,13 @@ export declare class VoterList extends Message<VoterList> {
    */
   voteType: VoteType;
 
+  /**
+   * weight of the vote, 1 if the ballot is not stake weighted
+   *
+   * @generated from field: int64 weight = 3;
+   */
+  weight: bigint;
+
   constructor(data?: PartialMessage<VoterList>);
 
   static readonly runtime: typeof proto3;
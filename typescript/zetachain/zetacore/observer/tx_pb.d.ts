@@ -867,3 +867,167 @@ export declare class MsgDisableFastConfirmationResponse extends Message<MsgDisab
   static equals(a: MsgDisableFastConfirmationResponse | PlainMessage<MsgDisableFastConfirmationResponse> | undefined, b: MsgDisableFastConfirmationResponse | PlainMessage<MsgDisableFastConfirmationResponse> | undefined): boolean;
 }
 
+/**
+ * MsgRejoinObserverSet is used by an observer jailed for missing too many
+ * votes to rejoin the observer set.
+ *
+ * @generated from message zetachain.zetacore.observer.MsgRejoinObserverSet
+ */
+export declare class MsgRejoinObserverSet extends Message<MsgRejoinObserverSet> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  constructor(data?: PartialMessage<MsgRejoinObserverSet>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgRejoinObserverSet";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRejoinObserverSet;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRejoinObserverSet;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRejoinObserverSet;
+
+  static equals(a: MsgRejoinObserverSet | PlainMessage<MsgRejoinObserverSet> | undefined, b: MsgRejoinObserverSet | PlainMessage<MsgRejoinObserverSet> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgRejoinObserverSetResponse
+ */
+export declare class MsgRejoinObserverSetResponse extends Message<MsgRejoinObserverSetResponse> {
+  constructor(data?: PartialMessage<MsgRejoinObserverSetResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgRejoinObserverSetResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRejoinObserverSetResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRejoinObserverSetResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRejoinObserverSetResponse;
+
+  static equals(a: MsgRejoinObserverSetResponse | PlainMessage<MsgRejoinObserverSetResponse> | undefined, b: MsgRejoinObserverSetResponse | PlainMessage<MsgRejoinObserverSetResponse> | undefined): boolean;
+}
+
+/**
+ * MsgVoteHaltChain is used by an observer to vote for the emergency halt of a
+ * connected chain. The inbounds and outbounds of the chain are disabled once
+ * a supermajority of the observers voted.
+ *
+ * @generated from message zetachain.zetacore.observer.MsgVoteHaltChain
+ */
+export declare class MsgVoteHaltChain extends Message<MsgVoteHaltChain> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+
+  constructor(data?: PartialMessage<MsgVoteHaltChain>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgVoteHaltChain";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgVoteHaltChain;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgVoteHaltChain;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgVoteHaltChain;
+
+  static equals(a: MsgVoteHaltChain | PlainMessage<MsgVoteHaltChain> | undefined, b: MsgVoteHaltChain | PlainMessage<MsgVoteHaltChain> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgVoteHaltChainResponse
+ */
+export declare class MsgVoteHaltChainResponse extends Message<MsgVoteHaltChainResponse> {
+  /**
+   * @generated from field: bool ballot_created = 1;
+   */
+  ballotCreated: boolean;
+
+  /**
+   * @generated from field: bool vote_finalized = 2;
+   */
+  voteFinalized: boolean;
+
+  constructor(data?: PartialMessage<MsgVoteHaltChainResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgVoteHaltChainResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgVoteHaltChainResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgVoteHaltChainResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgVoteHaltChainResponse;
+
+  static equals(a: MsgVoteHaltChainResponse | PlainMessage<MsgVoteHaltChainResponse> | undefined, b: MsgVoteHaltChainResponse | PlainMessage<MsgVoteHaltChainResponse> | undefined): boolean;
+}
+
+/**
+ * MsgUnhaltChain is used to enable again the inbounds and outbounds of a
+ * chain halted by the observers.
+ *
+ * @generated from message zetachain.zetacore.observer.MsgUnhaltChain
+ */
+export declare class MsgUnhaltChain extends Message<MsgUnhaltChain> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<MsgUnhaltChain>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnhaltChain";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnhaltChain;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnhaltChain;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnhaltChain;
+
+  static equals(a: MsgUnhaltChain | PlainMessage<MsgUnhaltChain> | undefined, b: MsgUnhaltChain | PlainMessage<MsgUnhaltChain> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnhaltChainResponse
+ */
+export declare class MsgUnhaltChainResponse extends Message<MsgUnhaltChainResponse> {
+  constructor(data?: PartialMessage<MsgUnhaltChainResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnhaltChainResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnhaltChainResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnhaltChainResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnhaltChainResponse;
+
+  static equals(a: MsgUnhaltChainResponse | PlainMessage<MsgUnhaltChainResponse> | undefined, b: MsgUnhaltChainResponse | PlainMessage<MsgUnhaltChainResponse> | undefined): boolean;
+}
+
@@ -5,8 +5,11 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { Header } from "./solana/solana_pb.js";
 import type { Proof as Proof$1 } from "./ethereum/ethereum_pb.js";
 import type { Proof as Proof$2 } from "./bitcoin/bitcoin_pb.js";
+import type { Proof as Proof$3 } from "./ton/ton_pb.js";
+import type { Proof as Proof$4 } from "./solana/solana_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.pkg.proofs.BlockHeader
@@ -77,6 +80,20 @@ export declare class HeaderData extends Message<HeaderData> {
      */
     value: Uint8Array;
     case: "bitcoinHeader";
+  } | {
+    /**
+     * merkle proof (BoC) of a TON masterchain block
+     *
+     * @generated from field: bytes ton_header = 3;
+     */
+    value: Uint8Array;
+    case: "tonHeader";
+  } | {
+    /**
+     * @generated from field: zetachain.zetacore.pkg.proofs.solana.Header solana_header = 4;
+     */
+    value: Header;
+    case: "solanaHeader";
   } | { case: undefined; value?: undefined };
 
   constructor(data?: PartialMessage<HeaderData>);
@@ -113,6 +130,18 @@ export declare class Proof extends Message<Proof> {
      */
     value: Proof$2;
     case: "bitcoinProof";
+  } | {
+    /**
+     * @generated from field: zetachain.zetacore.pkg.proofs.ton.Proof ton_proof = 3;
+     */
+    value: Proof$3;
+    case: "tonProof";
+  } | {
+    /**
+     * @generated from field: zetachain.zetacore.pkg.proofs.solana.Proof solana_proof = 4;
+     */
+    value: Proof$4;
+    case: "solanaProof";
   } | { case: undefined; value?: undefined };
 
   constructor(data?: PartialMessage<Proof>);
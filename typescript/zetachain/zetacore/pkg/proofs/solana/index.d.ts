@@ -0,0 +1 @@
+export * from "./solana_pb";
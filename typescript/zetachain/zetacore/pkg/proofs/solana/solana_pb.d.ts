@@ -0,0 +1,126 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/pkg/proofs/solana/solana.proto (package zetachain.zetacore.pkg.proofs.solana, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * Header is the header of a confirmed Solana block
+ *
+ * @generated from message zetachain.zetacore.pkg.proofs.solana.Header
+ */
+export declare class Header extends Message<Header> {
+  /**
+   * @generated from field: uint64 slot = 1;
+   */
+  slot: bigint;
+
+  /**
+   * @generated from field: uint64 parent_slot = 2;
+   */
+  parentSlot: bigint;
+
+  /**
+   * @generated from field: bytes blockhash = 3;
+   */
+  blockhash: Uint8Array;
+
+  /**
+   * @generated from field: bytes previous_blockhash = 4;
+   */
+  previousBlockhash: Uint8Array;
+
+  /**
+   * @generated from field: int64 block_time = 5;
+   */
+  blockTime: bigint;
+
+  constructor(data?: PartialMessage<Header>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.pkg.proofs.solana.Header";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Header;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Header;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Header;
+
+  static equals(a: Header | PlainMessage<Header> | undefined, b: Header | PlainMessage<Header> | undefined): boolean;
+}
+
+/**
+ * Entry is a proof of history entry of a Solana block
+ *
+ * @generated from message zetachain.zetacore.pkg.proofs.solana.Entry
+ */
+export declare class Entry extends Message<Entry> {
+  /**
+   * @generated from field: uint64 num_hashes = 1;
+   */
+  numHashes: bigint;
+
+  /**
+   * signatures of the transactions recorded in the entry, empty for a tick
+   *
+   * @generated from field: repeated bytes signatures = 2;
+   */
+  signatures: Uint8Array[];
+
+  constructor(data?: PartialMessage<Entry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.pkg.proofs.solana.Entry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Entry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Entry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Entry;
+
+  static equals(a: Entry | PlainMessage<Entry> | undefined, b: Entry | PlainMessage<Entry> | undefined): boolean;
+}
+
+/**
+ * Proof is the proof of inclusion of a transaction in a Solana block
+ * The entries go from the entry recording the transaction to the last entry of
+ * the block, whose hash is the blockhash
+ *
+ * @generated from message zetachain.zetacore.pkg.proofs.solana.Proof
+ */
+export declare class Proof extends Message<Proof> {
+  /**
+   * @generated from field: bytes tx_bytes = 1;
+   */
+  txBytes: Uint8Array;
+
+  /**
+   * hash of the entry preceding the first entry
+   *
+   * @generated from field: bytes start_hash = 2;
+   */
+  startHash: Uint8Array;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.pkg.proofs.solana.Entry entries = 3;
+   */
+  entries: Entry[];
+
+  constructor(data?: PartialMessage<Proof>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.pkg.proofs.solana.Proof";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Proof;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Proof;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Proof;
+
+  static equals(a: Proof | PlainMessage<Proof> | undefined, b: Proof | PlainMessage<Proof> | undefined): boolean;
+}
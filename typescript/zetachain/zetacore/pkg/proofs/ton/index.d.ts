@@ -0,0 +1 @@
+export * from "./ton_pb";
@@ -0,0 +1,52 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/pkg/proofs/ton/ton.proto (package zetachain.zetacore.pkg.proofs.ton, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * Proof is the proof of inclusion of a transaction in a TON masterchain block
+ *
+ * @generated from message zetachain.zetacore.pkg.proofs.ton.Proof
+ */
+export declare class Proof extends Message<Proof> {
+  /**
+   * merkle proof (BoC) of the masterchain block
+   * it contains the transaction or the description of the shard block
+   * containing the transaction
+   *
+   * @generated from field: bytes masterchain_proof = 1;
+   */
+  masterchainProof: Uint8Array;
+
+  /**
+   * merkle proof (BoC) of the shard block containing the transaction
+   * empty if the transaction is in the masterchain block
+   *
+   * @generated from field: bytes shard_proof = 2;
+   */
+  shardProof: Uint8Array;
+
+  /**
+   * transaction (BoC)
+   *
+   * @generated from field: bytes tx = 3;
+   */
+  tx: Uint8Array;
+
+  constructor(data?: PartialMessage<Proof>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.pkg.proofs.ton.Proof";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Proof;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Proof;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Proof;
+
+  static equals(a: Proof | PlainMessage<Proof> | undefined, b: Proof | PlainMessage<Proof> | undefined): boolean;
+}
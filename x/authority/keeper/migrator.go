@@ -5,6 +5,8 @@ import (
 
 	v2 "github.com/zeta-chain/node/x/authority/migrations/v2"
 	v3 "github.com/zeta-chain/node/x/authority/migrations/v3"
+	v4 "github.com/zeta-chain/node/x/authority/migrations/v4"
+	v5 "github.com/zeta-chain/node/x/authority/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -28,3 +30,13 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.authorityKeeper)
 }
+
+// Migrate3to4 migrates the authority store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.authorityKeeper)
+}
+
+// Migrate4to5 migrates the authority store from consensus version 4 to 5
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.authorityKeeper)
+}
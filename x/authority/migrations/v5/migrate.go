@@ -0,0 +1,65 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/authority/types"
+)
+
+type authorityKeeper interface {
+	SetAuthorizationList(ctx sdk.Context, list types.AuthorizationList)
+	GetAuthorizationList(ctx sdk.Context) (val types.AuthorizationList, found bool)
+}
+
+// MigrateStore migrates the authority module state from the consensus version 4 to 5
+func MigrateStore(
+	ctx sdk.Context,
+	keeper authorityKeeper,
+) error {
+	var (
+		authorizationList = types.DefaultAuthorizationsList()
+		newAuthorizations = []types.Authorization{
+			{
+				MsgUrl:           "/zetachain.zetacore.crosschain.MsgTripInboundCircuitBreaker",
+				AuthorizedPolicy: types.PolicyType_groupEmergency,
+			},
+			{
+				MsgUrl:           "/zetachain.zetacore.crosschain.MsgResetInboundCircuitBreaker",
+				AuthorizedPolicy: types.PolicyType_groupOperational,
+			},
+			{
+				MsgUrl:           "/zetachain.zetacore.crosschain.MsgReleaseHeldCctx",
+				AuthorizedPolicy: types.PolicyType_groupAdmin,
+			},
+			{
+				MsgUrl:           "/zetachain.zetacore.crosschain.MsgRejectHeldCctx",
+				AuthorizedPolicy: types.PolicyType_groupEmergency,
+			},
+			{
+				MsgUrl:           "/zetachain.zetacore.observer.MsgUnhaltChain",
+				AuthorizedPolicy: types.PolicyType_groupAdmin,
+			},
+		}
+	)
+
+	// Fetch the current authorization list, if found use that instead of default list
+	al, found := keeper.GetAuthorizationList(ctx)
+	if found {
+		authorizationList = al
+	}
+
+	// Add the new authorizations
+	for _, authorization := range newAuthorizations {
+		authorizationList.SetAuthorization(authorization)
+	}
+
+	// Validate the authorization list
+	err := authorizationList.Validate()
+	if err != nil {
+		return err
+	}
+
+	// Set the new authorization list
+	keeper.SetAuthorizationList(ctx, authorizationList)
+	return nil
+}
@@ -20,7 +20,7 @@ import (
 	"github.com/zeta-chain/node/x/authority/types"
 )
 
-const ConsensusVersion = 3
+const ConsensusVersion = 5
 
 var (
 	_ module.AppModule      = AppModule{}
@@ -131,6 +131,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
 		panic(err)
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the authority module's invariants.
@@ -12,6 +12,7 @@ var (
 		"/zetachain.zetacore.crosschain.MsgRefundAbortedCCTX",
 		"/zetachain.zetacore.crosschain.MsgAbortStuckCCTX",
 		"/zetachain.zetacore.crosschain.MsgUpdateRateLimiterFlags",
+		"/zetachain.zetacore.crosschain.MsgResetInboundCircuitBreaker",
 		"/zetachain.zetacore.fungible.MsgDeploySystemContracts",
 		"/zetachain.zetacore.fungible.MsgUpdateZRC20LiquidityCap",
 		"/zetachain.zetacore.fungible.MsgUpdateZRC20WithdrawFee",
@@ -29,15 +30,18 @@ var (
 		"/zetachain.zetacore.crosschain.MsgMigrateTssFunds",
 		"/zetachain.zetacore.crosschain.MsgUpdateTssAddress",
 		"/zetachain.zetacore.crosschain.MsgWhitelistERC20",
+		"/zetachain.zetacore.crosschain.MsgReleaseHeldCctx",
 		"/zetachain.zetacore.fungible.MsgUpdateContractBytecode",
 		"/zetachain.zetacore.fungible.MsgUpdateSystemContract",
 		"/zetachain.zetacore.fungible.MsgUpdateGatewayContract",
 		"/zetachain.zetacore.fungible.MsgRemoveForeignCoin",
 		"/zetachain.zetacore.fungible.MsgDeployFungibleCoinZRC20",
 		"/zetachain.zetacore.fungible.MsgUpdateZRC20Name",
+		"/zetachain.zetacore.fungible.MsgUpdatePrecompileStatus",
 		"/zetachain.zetacore.observer.MsgUpdateObserver",
 		"/zetachain.zetacore.observer.MsgAddObserver",
 		"/zetachain.zetacore.observer.MsgRemoveChainParams",
+		"/zetachain.zetacore.observer.MsgUnhaltChain",
 		"/zetachain.zetacore.authority.MsgAddAuthorization",
 		"/zetachain.zetacore.authority.MsgRemoveAuthorization",
 		"/zetachain.zetacore.authority.MsgUpdateChainInfo",
@@ -51,6 +55,8 @@ var (
 		"/zetachain.zetacore.crosschain.MsgAddOutboundTracker",
 		"/zetachain.zetacore.crosschain.MsgRemoveOutboundTracker",
 		"/zetachain.zetacore.crosschain.MsgRemoveInboundTracker",
+		"/zetachain.zetacore.crosschain.MsgTripInboundCircuitBreaker",
+		"/zetachain.zetacore.crosschain.MsgRejectHeldCctx",
 		"/zetachain.zetacore.fungible.MsgPauseZRC20",
 		"/zetachain.zetacore.observer.MsgUpdateKeygen",
 		"/zetachain.zetacore.observer.MsgDisableCCTX",
@@ -391,6 +391,7 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&crosschaintypes.MsgRefundAbortedCCTX{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgAbortStuckCCTX{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgUpdateRateLimiterFlags{}),
+			sdk.MsgTypeURL(&crosschaintypes.MsgResetInboundCircuitBreaker{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgDeploySystemContracts{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgUpdateZRC20LiquidityCap{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgUpdateZRC20WithdrawFee{}),
@@ -408,6 +409,8 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&crosschaintypes.MsgRemoveInboundTracker{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgAddOutboundTracker{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgRemoveOutboundTracker{}),
+			sdk.MsgTypeURL(&crosschaintypes.MsgTripInboundCircuitBreaker{}),
+			sdk.MsgTypeURL(&crosschaintypes.MsgRejectHeldCctx{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgPauseZRC20{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateKeygen{}),
 			sdk.MsgTypeURL(&observertypes.MsgDisableCCTX{}),
@@ -422,15 +425,18 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&crosschaintypes.MsgMigrateTssFunds{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgUpdateTssAddress{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgWhitelistERC20{}),
+			sdk.MsgTypeURL(&crosschaintypes.MsgReleaseHeldCctx{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgDeployFungibleCoinZRC20{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgUpdateContractBytecode{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgUpdateSystemContract{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgUpdateGatewayContract{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgRemoveForeignCoin{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgUpdateZRC20Name{}),
+			sdk.MsgTypeURL(&fungibletypes.MsgUpdatePrecompileStatus{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateObserver{}),
 			sdk.MsgTypeURL(&observertypes.MsgAddObserver{}),
 			sdk.MsgTypeURL(&observertypes.MsgRemoveChainParams{}),
+			sdk.MsgTypeURL(&observertypes.MsgUnhaltChain{}),
 			sdk.MsgTypeURL(&types.MsgAddAuthorization{}),
 			sdk.MsgTypeURL(&types.MsgRemoveAuthorization{}),
 			sdk.MsgTypeURL(&types.MsgUpdateChainInfo{}),
@@ -41,6 +41,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListPendingCCTXWithinRateLimit(),
 
 		CmdShowUpdateRateLimiterFlags(),
+		CmdShowInboundRateLimiterState(),
+		CmdListHeldCctx(),
 	)
 
 	return cmd
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func CmdShowInboundRateLimiterState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-inbound-rate-limiter-state [chain-id]",
+		Short: "shows the inbound rate limiter state of a chain and the inbounds it holds",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InboundRateLimiterState(
+				context.Background(),
+				&types.QueryInboundRateLimiterStateRequest{ChainId: chainID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
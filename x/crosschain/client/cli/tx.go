@@ -32,6 +32,8 @@ func GetTxCmd() *cobra.Command {
 		CmdAbortStuckCCTX(),
 		CmdRefundAborted(),
 		CmdRemoveInboundTracker(),
+		CmdReleaseHeldCctx(),
+		CmdRejectHeldCctx(),
 	)
 
 	return cmd
@@ -43,10 +43,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set the cross-chain transactions only,
 	// We don't need to call SaveCCTXUpdate as the other fields are being set already
+	// The inbounds held by the inbound rate limiter are added back to the queue of their sender chain
+	// The held outbounds are added back to the held cctxs of their receiver chain
 	for _, elem := range genState.CrossChainTxs {
 		if elem != nil {
 			cctx := *elem
 			k.SetCrossChainTx(ctx, cctx)
+			if cctx.CctxStatus.Status == types.CctxStatus_PendingInboundRateLimit {
+				k.SetInboundRateLimitedCctx(ctx, cctx, cctx.InboundParams.FinalizedZetaHeight)
+			}
+			if cctx.CctxStatus.Status == types.CctxStatus_PendingOutboundHeld {
+				k.SetHeldCctx(ctx, cctx.GetCurrentOutboundParam().ReceiverChainId, cctx.Index)
+			}
 		}
 	}
 
@@ -56,6 +64,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	k.SetRateLimiterFlags(ctx, genState.RateLimiterFlags)
 
+	// Set all the inbound rate limiter states
+	for _, elem := range genState.InboundRateLimiterStates {
+		k.SetInboundRateLimiterState(ctx, elem)
+	}
+
 	k.SetCctxCounter(ctx, genState.Counter)
 }
 
@@ -99,6 +112,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.RateLimiterFlags = rateLimiterFlags
 	}
 
+	genesis.InboundRateLimiterStates = k.GetAllInboundRateLimiterState(ctx)
+
 	genesis.Counter = k.GetCctxCounter(ctx)
 
 	return &genesis
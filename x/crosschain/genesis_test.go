@@ -3,6 +3,7 @@ package crosschain_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
@@ -51,12 +52,21 @@ func TestGenesis(t *testing.T) {
 			sample.InboundHashToCctx(t, "0x2"),
 		},
 		RateLimiterFlags: sample.RateLimiterFlags(),
-		Counter:          1,
+		InboundRateLimiterStates: []types.InboundRateLimiterState{
+			{ChainId: 1, CircuitBreakerTripped: true, WindowStartHeight: 10, WindowValue: math.NewUint(100)},
+			{ChainId: 2, WindowStartHeight: 20, WindowValue: math.NewUint(200)},
+		},
+		Counter: 1,
 	}
 
+	// the held outbound is added back to the held cctxs of its receiver chain
+	heldCctx := genesisState.CrossChainTxs[1]
+	heldCctx.CctxStatus.Status = types.CctxStatus_PendingOutboundHeld
+
 	// Init and export
 	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
 	crosschain.InitGenesis(ctx, *k, genesisState)
+	require.Equal(t, []string{heldCctx.Index}, k.GetHeldCctxs(ctx, heldCctx.GetCurrentOutboundParam().ReceiverChainId))
 	got := crosschain.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
 
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	zetachains "github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/constant"
 	mathpkg "github.com/zeta-chain/node/pkg/math"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
@@ -52,8 +53,13 @@ func (k Keeper) IterateAndUpdateCctxGasPrice(
 
 IterateChains:
 	for _, chain := range chains {
-		// support only external evm chains
-		if zetachains.IsEVMChain(chain.ChainId, additionalChains) && !zetachains.IsZetaChain(chain.ChainId, additionalChains) {
+		// the outbounds of a chain halted by the observers are no longer processed
+		if found && crosschainFlags.IsChainHalted(chain.ChainId) {
+			continue
+		}
+
+		// support only external evm chains, bitcoin chains and solana chains
+		if isGasPriceIncreaseSupported(chain.ChainId, additionalChains) {
 			res, err := k.ListPendingCctx(sdk.UnwrapSDKContext(ctx), &types.QueryListPendingCctxRequest{
 				ChainId: chain.ChainId,
 				Limit:   gasPriceIncreaseFlags.MaxPendingCctxs,
@@ -100,6 +106,28 @@ IterateChains:
 	return cctxCount, gasPriceIncreaseFlags
 }
 
+// isGasPriceIncreaseSupported returns true if the gas price of pending cctxs can be increased for the chain
+//   - external EVM chains: the signer replaces the pending tx with the same nonce
+//   - Bitcoin chains: the signer replaces the pending tx by RBF (or CPFP for stuck ancestors)
+//   - Solana chains: the signer re-signs the pending tx with a higher compute unit price
+//
+// TON chains are not supported: the gateway withdrawal message doesn't carry a fee, the gateway
+// pays the tx fee at the network gas price, so a higher cctx gas price can't speed up a withdrawal.
+func isGasPriceIncreaseSupported(chainID int64, additionalChains []zetachains.Chain) bool {
+	switch {
+	case zetachains.IsZetaChain(chainID, additionalChains):
+		return false
+	case zetachains.IsEVMChain(chainID, additionalChains):
+		return true
+	case zetachains.IsBitcoinChain(chainID, additionalChains):
+		return true
+	case zetachains.IsSolanaChain(chainID, additionalChains):
+		return true
+	default:
+		return false
+	}
+}
+
 // CheckAndUpdateCctxGasPrice checks if the retry interval is reached and updates the gas price if so
 // The function returns the gas price increase and the additional fees paid from the gas stability pool
 func CheckAndUpdateCctxGasPrice(
@@ -128,6 +156,13 @@ func CheckAndUpdateCctxGasPrice(
 			fmt.Sprintf("cannot get gas price for chain %d", chainID),
 		)
 	}
+
+	// Solana charges a flat fee per signature, only the priority fee can be increased
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
+	if zetachains.IsSolanaChain(chainID, additionalChains) {
+		return updateCctxPriorityFee(ctx, k, cctx, flags, medianPriorityFee)
+	}
+
 	gasPriceIncrease := medianGasPrice.MulUint64(uint64(flags.GasPriceIncreasePercent)).QuoUint64(100)
 
 	// compute new gas price
@@ -175,3 +210,54 @@ func CheckAndUpdateCctxGasPrice(
 
 	return gasPriceIncrease, additionalFees, nil
 }
+
+// updateCctxPriorityFee increases the priority fee (micro-lamports per compute unit) of a pending Solana cctx
+// The function returns the priority fee increase and the additional fees (in lamports) paid from the gas stability pool
+func updateCctxPriorityFee(
+	ctx sdk.Context,
+	k Keeper,
+	cctx types.CrossChainTx,
+	flags observertypes.GasPriceIncreaseFlags,
+	medianPriorityFee math.Uint,
+) (math.Uint, math.Uint, error) {
+	// use default priority fee as the base if there is no recent prioritization fee on the network
+	basePriorityFee := medianPriorityFee
+	if basePriorityFee.IsZero() {
+		basePriorityFee = math.NewUint(constant.SolanaDefaultPriorityFee)
+	}
+	priorityFeeIncrease := basePriorityFee.MulUint64(uint64(flags.GasPriceIncreasePercent)).QuoUint64(100)
+
+	// compute new priority fee
+	currentPriorityFee, err := cctx.GetCurrentOutboundParam().GetGasPriorityFeeUInt64()
+	if err != nil {
+		return math.ZeroUint(), math.ZeroUint(), err
+	}
+	newPriorityFee := math.NewUint(currentPriorityFee).Add(priorityFeeIncrease)
+
+	// check limit -- use default limit if not set
+	gasPriceIncreaseMax := flags.GasPriceIncreaseMax
+	if gasPriceIncreaseMax == 0 {
+		gasPriceIncreaseMax = observertypes.DefaultGasPriceIncreaseFlags.GasPriceIncreaseMax
+	}
+	limit := basePriorityFee.MulUint64(uint64(gasPriceIncreaseMax)).QuoUint64(100)
+	if newPriorityFee.GT(limit) {
+		return math.ZeroUint(), math.ZeroUint(), nil
+	}
+
+	// withdraw additional fees from the gas stability pool
+	chainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+	additionalFees := priorityFeeIncrease.MulUint64(constant.SolanaComputeUnitLimit).
+		QuoUint64(constant.SolanaMicroLamportsPerLamport)
+	if err := k.fungibleKeeper.WithdrawFromGasStabilityPool(ctx, chainID, additionalFees.BigInt()); err != nil {
+		return math.ZeroUint(), math.ZeroUint(), cosmoserrors.Wrap(
+			types.ErrNotEnoughFunds,
+			fmt.Sprintf("cannot withdraw %s from gas stability pool, error: %s", additionalFees.String(), err.Error()),
+		)
+	}
+
+	// set new priority fee and last update timestamp
+	cctx.GetCurrentOutboundParam().GasPriorityFee = newPriorityFee.String()
+	k.SetCrossChainTx(ctx, cctx)
+
+	return priorityFeeIncrease, additionalFees, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/node/pkg/chains"
@@ -46,6 +47,8 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 		{ChainId: chains.Ethereum.ChainId},
 		{ChainId: chains.BitcoinMainnet.ChainId},
 		{ChainId: chains.BscMainnet.ChainId},
+		{ChainId: chains.SolanaMainnet.ChainId},
+		{ChainId: chains.TONMainnet.ChainId},
 		{ChainId: chains.ZetaChainMainnet.ChainId},
 	}
 
@@ -56,6 +59,8 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 	createCctxWithNonceRange(t, ctx, *k, 20, 25, chains.BitcoinMainnet.ChainId, tss, zk)
 	createCctxWithNonceRange(t, ctx, *k, 30, 35, chains.BscMainnet.ChainId, tss, zk)
 	createCctxWithNonceRange(t, ctx, *k, 40, 45, chains.ZetaChainMainnet.ChainId, tss, zk)
+	createCctxWithNonceRange(t, ctx, *k, 50, 55, chains.SolanaMainnet.ChainId, tss, zk)
+	createCctxWithNonceRange(t, ctx, *k, 60, 65, chains.TONMainnet.ChainId, tss, zk)
 
 	// set a cctx where the update function should fail to test that the next cctx are not updated but the next chains are
 	failMap[sample.GetCctxIndexFromString("1-12")] = struct{}{}
@@ -87,20 +92,46 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 	ctx = ctx.WithBlockHeight(observertypes.DefaultCrosschainFlags().GasPriceIncreaseFlags.EpochLength * 2)
 	cctxCount, flags = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFunc)
 
-	// 2 eth + 5 bsc = 7
-	require.Equal(t, 7, cctxCount)
+	// 2 eth + 5 btc + 5 bsc + 5 sol = 17
+	require.Equal(t, 17, cctxCount)
 	require.Equal(t, customFlags, flags)
 
 	// check that the update function was called with the cctx index
-	require.Equal(t, 7, len(updateFuncMap))
+	require.Equal(t, 17, len(updateFuncMap))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("1-10"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("1-11"))
 
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-20"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-21"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-22"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-23"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-24"))
+
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-30"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-31"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-32"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-33"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-34"))
+
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-50"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-51"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-52"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-53"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-54"))
+
+	// ton cctxs are not updated
+	require.NotContains(t, updateFuncMap, sample.GetCctxIndexFromString("2015140-60"))
+
+	// test that the cctxs of a halted chain are not updated
+	updateFuncMap = make(map[string]struct{})
+	crosschainFlags.HaltedChains = []int64{chains.BscMainnet.ChainId}
+	zk.ObserverKeeper.SetCrosschainFlags(ctx, *crosschainFlags)
+
+	cctxCount, _ = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFunc)
+
+	// 2 eth + 5 btc + 5 sol = 12
+	require.Equal(t, 12, cctxCount)
+	require.NotContains(t, updateFuncMap, sample.GetCctxIndexFromString("56-30"))
 }
 
 func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
@@ -150,6 +181,119 @@ func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
 			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
 			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
 		},
+		{
+			name: "can update bitcoin fee rate when retry interval is reached",
+			cctx: types.CrossChainTx{
+				Index: "a1-btc",
+				CctxStatus: &types.Status{
+					CreatedTimestamp:    sampleTimestamp.Unix(),
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundParams: []*types.OutboundParams{
+					{
+						ReceiverChainId: chains.BitcoinMainnet.ChainId,
+						CallOptions: &types.CallOptions{
+							GasLimit: 254, // vB
+						},
+						GasPrice: "10", // sat/vB
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         10,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(10),   // 100% medianGasPrice
+			expectedAdditionalFees:                 math.NewUint(2540), // txSize * increase
+		},
+		{
+			name: "can update solana priority fee when retry interval is reached",
+			cctx: types.CrossChainTx{
+				Index: "a1-sol",
+				CctxStatus: &types.Status{
+					CreatedTimestamp:    sampleTimestamp.Unix(),
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundParams: []*types.OutboundParams{
+					{
+						ReceiverChainId: chains.SolanaMainnet.ChainId,
+						CallOptions: &types.CallOptions{
+							GasLimit: 5000,
+						},
+						GasPrice:       "1",
+						GasPriorityFee: "50000", // micro-lamports per CU
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         1,
+			medianPriorityFee:                      50000,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(50000), // 100% medianPriorityFee
+			expectedAdditionalFees:                 math.NewUint(10000), // 200K CU * increase / 1M
+		},
+		{
+			name: "can update solana priority fee using default priority fee",
+			cctx: types.CrossChainTx{
+				Index: "a2-sol",
+				CctxStatus: &types.Status{
+					CreatedTimestamp:    sampleTimestamp.Unix(),
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundParams: []*types.OutboundParams{
+					{
+						ReceiverChainId: chains.SolanaMainnet.ChainId,
+						CallOptions: &types.CallOptions{
+							GasLimit: 5000,
+						},
+						GasPrice: "1",
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         1,
+			medianPriorityFee:                      0,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(10000), // 100% default priority fee
+			expectedAdditionalFees:                 math.NewUint(2000),  // 200K CU * increase / 1M
+		},
+		{
+			name: "skip if solana priority fee max limit reached",
+			cctx: types.CrossChainTx{
+				Index: "b0-sol",
+				CctxStatus: &types.Status{
+					CreatedTimestamp:    sampleTimestamp.Unix(),
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundParams: []*types.OutboundParams{
+					{
+						ReceiverChainId: chains.SolanaMainnet.ChainId,
+						CallOptions: &types.CallOptions{
+							GasLimit: 5000,
+						},
+						GasPrice:       "1",
+						GasPriorityFee: "100000",
+					},
+				},
+			},
+			flags: observertypes.GasPriceIncreaseFlags{
+				EpochLength:             100,
+				RetryInterval:           time.Minute * 10,
+				GasPriceIncreasePercent: 100, // Increase priority fee to 100000+50000 = 150000
+				GasPriceIncreaseMax:     200, // Max priority fee is 50000*2 = 100000
+			},
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         1,
+			medianPriorityFee:                      50000,
+			expectWithdrawFromGasStabilityPoolCall: false,
+			expectedGasPriceIncrease:               math.NewUint(0),
+			expectedAdditionalFees:                 math.NewUint(0),
+		},
 		{
 			name: "can update gas price at max limit",
 			cctx: types.CrossChainTx{
@@ -374,11 +518,18 @@ func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			k, ctx := testkeeper.CrosschainKeeperAllMocks(t)
 			fungibleMock := testkeeper.GetCrosschainFungibleMock(t, k)
+			authorityMock := testkeeper.GetCrosschainAuthorityMock(t, k)
+			authorityMock.On("GetAdditionalChainList", mock.Anything).Maybe().Return([]chains.Chain{})
 			chainID := tc.cctx.GetCurrentOutboundParam().ReceiverChainId
+			isSolana := chains.IsSolanaChain(chainID, []chains.Chain{})
 			previousGasPrice, err := tc.cctx.GetCurrentOutboundParam().GetGasPriceUInt64()
 			if err != nil {
 				previousGasPrice = 0
 			}
+			previousPriorityFee, err := tc.cctx.GetCurrentOutboundParam().GetGasPriorityFeeUInt64()
+			if err != nil {
+				previousPriorityFee = 0
+			}
 
 			// set median gas price if not zero
 			if tc.medianGasPrice != 0 {
@@ -436,14 +587,27 @@ func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
 				require.True(t, found)
 				newGasPrice, err := cctx.GetCurrentOutboundParam().GetGasPriceUInt64()
 				require.NoError(t, err)
-				require.EqualValues(
-					t,
-					tc.expectedGasPriceIncrease.AddUint64(previousGasPrice).Uint64(),
-					newGasPrice,
-					"%d - %d",
-					tc.expectedGasPriceIncrease.Uint64(),
-					previousGasPrice,
-				)
+				newPriorityFee, err := cctx.GetCurrentOutboundParam().GetGasPriorityFeeUInt64()
+				require.NoError(t, err)
+
+				// solana cctx has its priority fee increased instead of gas price
+				if isSolana {
+					require.EqualValues(t, previousGasPrice, newGasPrice)
+					require.EqualValues(
+						t,
+						tc.expectedGasPriceIncrease.AddUint64(previousPriorityFee).Uint64(),
+						newPriorityFee,
+					)
+				} else {
+					require.EqualValues(
+						t,
+						tc.expectedGasPriceIncrease.AddUint64(previousGasPrice).Uint64(),
+						newGasPrice,
+						"%d - %d",
+						tc.expectedGasPriceIncrease.Uint64(),
+						previousGasPrice,
+					)
+				}
 				require.EqualValues(t, tc.blockTimestamp.Unix(), cctx.CctxStatus.LastUpdateTimestamp)
 			}
 		})
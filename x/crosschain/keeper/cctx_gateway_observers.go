@@ -27,6 +27,9 @@ InitiateOutbound updates the store so observers can use the PendingCCTX query:
 
   - If preprocessing of outbound is successful, the CCTX status is changed to PendingOutbound.
 
+  - If the value of the outbound exceeds the held threshold of the receiver chain, the CCTX status is changed to PendingOutboundHeld
+    and no nonce is assigned until the CCTX is released.
+
   - if preprocessing of outbound, such as paying the gas fee for the destination fails, the state is reverted to aborted
 
     We do not return an error from this function, as all changes need to be persisted to the state.
@@ -49,6 +52,7 @@ func (c CCTXGatewayObservers) InitiateOutbound(
 		noEthereumTxEvent = true
 	}
 
+	held := false
 	err = func() error {
 		// If ShouldPayGas flag is set during ValidateInbound PayGasAndUpdateCctx should be called
 		// which will set GasPrice and Amount. Otherwise, use median gas price and InboundParams amount.
@@ -72,6 +76,10 @@ func (c CCTXGatewayObservers) InitiateOutbound(
 			config.CCTX.GetCurrentOutboundParam().GasPriorityFee = priorityFee.String()
 			config.CCTX.GetCurrentOutboundParam().Amount = config.CCTX.InboundParams.Amount
 		}
+		if c.crosschainKeeper.applyHeldCctxThreshold(tmpCtx, config.CCTX) {
+			held = true
+			return nil
+		}
 		return c.crosschainKeeper.SetObserverOutboundInfo(tmpCtx, outboundReceiverChainID, config.CCTX)
 	}()
 	if err != nil {
@@ -83,5 +91,8 @@ func (c CCTXGatewayObservers) InitiateOutbound(
 		return types.CctxStatus_Aborted, err
 	}
 	commit()
+	if held {
+		return types.CctxStatus_PendingOutboundHeld, nil
+	}
 	return types.CctxStatus_PendingOutbound, nil
 }
@@ -36,14 +36,17 @@ func (k Keeper) ValidateInbound(
 		return nil, err
 	}
 
-	// Initiate outbound, the process function manages the state commit and cctx status change.
+	// Hold the CCTX if the inbound rate limiter of the sender chain doesn't allow it, the CCTX is processed once released.
+	// Otherwise, initiate outbound, the process function manages the state commit and cctx status change.
 	// If the process fails, the changes to the evm state are rolled back.
-	_, err = k.InitiateOutbound(ctx, InitiateOutboundConfig{
-		CCTX:         &cctx,
-		ShouldPayGas: shouldPayGas,
-	})
-	if err != nil {
-		return nil, err
+	if !k.applyInboundRateLimiter(ctx, &cctx) {
+		_, err = k.InitiateOutbound(ctx, InitiateOutboundConfig{
+			CCTX:         &cctx,
+			ShouldPayGas: shouldPayGas,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	inCctxIndex, ok := ctx.Value(InCCTXIndexKey).(string)
@@ -92,6 +92,65 @@ func TestKeeper_ValidateInbound(t *testing.T) {
 		require.Len(t, k.GetAllCrossChainTx(ctx), 1)
 	})
 
+	t.Run("hold inbound if inbound circuit breaker of sender chain is tripped", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t,
+			keepertest.CrosschainMockOptions{
+				UseObserverMock:  true,
+				UseFungibleMock:  true,
+				UseAuthorityMock: true,
+			})
+
+		// Setup mock data
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		tss := sample.Tss()
+		senderChain := chains.Goerli
+		sender := sample.EthAddress()
+
+		// Set up mocks for CheckIfTSSMigrationTransfer
+		observerMock.On("GetAllTSS", ctx).Return(sample.TssList(3))
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, senderChain.ChainId).Return(senderChain, true)
+		authorityMock.On("GetAdditionalChainList", ctx).Return([]chains.Chain{})
+		// setup Mocks for GetTSS
+		observerMock.On("GetTSS", mock.Anything).Return(tss, true)
+		// setup Mocks for IsInboundEnabled
+		observerMock.On("IsInboundEnabled", ctx).Return(true)
+		// setup Mocks for SaveCCTXUpdate
+		observerMock.On("SetNonceToCctx", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+		k.SetInboundRateLimiterState(ctx, types.InboundRateLimiterState{
+			ChainId:               senderChain.ChainId,
+			CircuitBreakerTripped: true,
+		})
+
+		// call ValidateInbound
+		msg := types.MsgVoteInbound{
+			Creator:            sample.AccAddress(),
+			Sender:             sender.String(),
+			SenderChainId:      senderChain.ChainId,
+			Receiver:           sample.EthAddress().String(),
+			ReceiverChain:      chains.ZetaChainMainnet.ChainId,
+			Amount:             sdkmath.NewUint(42),
+			InboundHash:        sample.Hash().String(),
+			InboundBlockHeight: 420,
+			CallOptions: &types.CallOptions{
+				GasLimit: 100,
+			},
+			CoinType:   coin.CoinType_Gas,
+			TxOrigin:   sender.String(),
+			EventIndex: 1,
+		}
+
+		cctx, err := k.ValidateInbound(ctx, &msg, false)
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_PendingInboundRateLimit, cctx.CctxStatus.Status)
+		require.Equal(t, []string{cctx.Index}, k.GetInboundRateLimitedCctxs(ctx, senderChain.ChainId, 0))
+
+		stored, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingInboundRateLimit, stored.CctxStatus.Status)
+	})
+
 	t.Run("fail if tss not found", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t,
 			keepertest.CrosschainMockOptions{
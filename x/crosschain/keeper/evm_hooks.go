@@ -254,6 +254,10 @@ func (k Keeper) ProcessZetaSentEvent(
 		return observertypes.ErrSupportedChains
 	}
 
+	if err := k.checkChainNotHalted(ctx, receiverChain.ChainId); err != nil {
+		return err
+	}
+
 	// Validation if we want to send ZETA to an external chain, but there is no ZETA token.
 	chainParams, found := k.zetaObserverKeeper.GetChainParamsByChainID(ctx, receiverChain.ChainId)
 	if !found {
@@ -307,6 +311,15 @@ func (k Keeper) ProcessZetaSentEvent(
 	return nil
 }
 
+// checkChainNotHalted returns an error if the chain has been halted by the observers
+// the ZEVM transactions creating an outbound for a halted chain are reverted
+func (k Keeper) checkChainNotHalted(ctx sdk.Context, chainID int64) error {
+	if k.zetaObserverKeeper.IsChainHalted(ctx, chainID) {
+		return errorsmod.Wrapf(observertypes.ErrChainHalted, "chain with chainID %d halted", chainID)
+	}
+	return nil
+}
+
 // ValidateZRC20WithdrawEvent checks if the ZRC20Withdrawal event is valid
 // It verifies event information for BTC chains and returns an error if the event is invalid
 func (k Keeper) ValidateZRC20WithdrawEvent(
@@ -315,6 +328,10 @@ func (k Keeper) ValidateZRC20WithdrawEvent(
 	chainID int64,
 	coinType coin.CoinType,
 ) error {
+	if err := k.checkChainNotHalted(ctx, chainID); err != nil {
+		return err
+	}
+
 	// The event was parsed; that means the user has deposited tokens to the contract.
 	return k.validateZRC20Withdrawal(ctx, chainID, coinType, event.Value, event.To)
 }
@@ -172,6 +172,25 @@ func TestValidateZrc20WithdrawEvent(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("unable to validate a withdrawal event to a halted chain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{
+			HaltedChains: []int64{chains.BitcoinMainnet.ChainId},
+		})
+
+		btcMainNetWithdrawalEvent, err := crosschainkeeper.ParseZRC20WithdrawalEvent(
+			*sample.ValidZRC20WithdrawToBTCReceipt(t).Logs[3],
+		)
+		require.NoError(t, err)
+		err = k.ValidateZRC20WithdrawEvent(
+			ctx,
+			btcMainNetWithdrawalEvent,
+			chains.BitcoinMainnet.ChainId,
+			coin.CoinType_Gas,
+		)
+		require.ErrorIs(t, err, observertypes.ErrChainHalted)
+	})
+
 	t.Run("successfully validate a valid SOL withdrawal event", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
 
@@ -648,6 +667,41 @@ func TestKeeper_ProcessZetaSentEvent(t *testing.T) {
 		require.ErrorContains(t, err, "chain not supported")
 	})
 
+	t.Run("unable to process ZetaSentEvent if receiver chain is halted", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+
+		chain := chains.Ethereum
+		setSupportedChain(ctx, zk, chain.ChainId)
+		SetupStateForProcessLogs(t, ctx, k, zk, sdkk, chain)
+		admin := keepertest.SetAdminPolicies(ctx, zk.AuthorityKeeper)
+		SetupStateForProcessLogsZetaSent(t, ctx, k, zk, sdkk, chain, admin)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			HaltedChains:      []int64{chain.ChainId},
+		})
+
+		amount, ok := sdkmath.NewIntFromString("20000000000000000000000")
+		require.True(t, ok)
+		err := sdkk.BankKeeper.MintCoins(
+			ctx,
+			fungibletypes.ModuleName,
+			sdk.NewCoins(sdk.NewCoin(config.BaseDenom, amount)),
+		)
+		require.NoError(t, err)
+
+		event, err := crosschainkeeper.ParseZetaSentEvent(
+			*sample.ValidZetaSentDestinationExternalReceipt(t).Logs[4],
+			sample.ValidZetaSentDestinationExternalReceipt(t).Logs[4].Address,
+		)
+		require.NoError(t, err)
+
+		err = k.ProcessZetaSentEvent(ctx, event, sample.EthAddress(), sample.EthAddress().Hex())
+		require.ErrorIs(t, err, observertypes.ErrChainHalted)
+		require.Empty(t, k.GetAllCrossChainTx(ctx))
+	})
+
 	t.Run("unable to process ZetaSentEvent if zetachain chain id not correctly set in context", func(t *testing.T) {
 		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 	"sort"
 
 	sdkmath "cosmossdk.io/math"
@@ -22,8 +23,8 @@ func (k Keeper) RateLimiterInput(
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
-	if req.Window <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	if req.Window < 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must not be negative")
 	}
 
 	// use default MaxPendingCctxs if not specified or too high
@@ -43,10 +44,9 @@ func (k Keeper) RateLimiterInput(
 		return nil, observertypes.ErrTssNotFound
 	}
 
-	// calculate the rate limiter sliding window left boundary (inclusive)
-	leftWindowBoundary := height - req.Window + 1
-	if leftWindowBoundary < 1 {
-		leftWindowBoundary = 1
+	// calculate the left boundary (inclusive) of a rate limiter sliding window
+	leftWindowBoundary := func(window int64) int64 {
+		return max(height-window+1, 1)
 	}
 
 	// the `limit` of pending result is reached or not
@@ -55,10 +55,10 @@ func (k Keeper) RateLimiterInput(
 		return uint32(len(cctxs)) > limit
 	}
 
-	// if a cctx falls within the rate limiter window
-	isCCTXInWindow := func(cctx *types.CrossChainTx) bool {
+	// if a cctx falls within a rate limiter window
+	isCCTXInWindow := func(cctx *types.CrossChainTx, window int64) bool {
 		// #nosec G115 checked positive
-		return cctx.InboundParams.ObservedExternalHeight >= uint64(leftWindowBoundary)
+		return cctx.InboundParams.ObservedExternalHeight >= uint64(leftWindowBoundary(window))
 	}
 
 	// if a cctx is an outgoing cctx that orginates from ZetaChain
@@ -79,12 +79,23 @@ func (k Keeper) RateLimiterInput(
 		chains.FilterExternalChains,
 	)
 
-	_, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
+	flags, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
 	if !found {
 		return nil, status.Error(codes.Internal, "asset rates not found")
 	}
 	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
 
+	// get the per-chain and per-asset rate limits, the cctxs are queried back to the widest window
+	buckets := k.GetRateLimitBuckets(ctx, flags)
+	bucketsPastValue := make([]sdkmath.Int, len(buckets))
+	bucketsPendingValue := make([]sdkmath.Int, len(buckets))
+	queryWindow := req.Window
+	for i, bucket := range buckets {
+		bucketsPastValue[i] = sdkmath.NewInt(0)
+		bucketsPendingValue[i] = sdkmath.NewInt(0)
+		queryWindow = max(queryWindow, bucket.Window)
+	}
+
 	// query pending nonces of each foreign chain and get the lowest height of the pending cctxs
 	lowestPendingCctxHeight := int64(0)
 	pendingNoncesMap := make(map[int64]observertypes.PendingNonces)
@@ -134,21 +145,27 @@ func (k Keeper) RateLimiterInput(
 			if err != nil {
 				return nil, err
 			}
-			inWindow := isCCTXInWindow(cctx)
+			inWindow := isCCTXInWindow(cctx, req.Window)
 			isOutgoing := isCCTXOutgoing(cctx)
 			isPast := isPastCctx(cctx, pendingNonces.NonceLow)
 
 			// we should at least go backwards by 1000 nonces to pick up missed pending cctxs
-			// we might go even further back if the endNonce hasn't hit the left window boundary yet
-			if nonce < endNonce && !inWindow {
+			// we might go even further back if the endNonce hasn't hit the left boundary of the widest window yet
+			if nonce < endNonce && !isCCTXInWindow(cctx, queryWindow) {
 				break
 			}
+			cctxValue := types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap)
 
 			// sum up the cctxs' value if the cctx is outgoing, within the window and in the past
 			if inWindow && isOutgoing && isPast {
-				pastCctxsValue = pastCctxsValue.Add(
-					types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap),
-				)
+				pastCctxsValue = pastCctxsValue.Add(cctxValue)
+			}
+
+			// same for the buckets of the cctx, within the window of the bucket
+			for i, bucket := range buckets {
+				if isOutgoing && isPast && bucket.Contains(cctx) && isCCTXInWindow(cctx, bucket.Window) {
+					bucketsPastValue[i] = bucketsPastValue[i].Add(cctxValue)
+				}
 			}
 
 			// add cctx to corresponding list
@@ -159,7 +176,21 @@ func (k Keeper) RateLimiterInput(
 				} else {
 					cctxsPending = append(cctxsPending, cctx)
 					// sum up non-past pending cctxs' value
-					pendingCctxsValue = pendingCctxsValue.Add(types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap))
+					pendingCctxsValue = pendingCctxsValue.Add(cctxValue)
+
+					// same for the buckets of the cctx and update their lowest pending cctx height
+					for i := range buckets {
+						if !buckets[i].Contains(cctx) {
+							continue
+						}
+						bucketsPendingValue[i] = bucketsPendingValue[i].Add(cctxValue)
+
+						// #nosec G115 always in range
+						cctxHeight := int64(cctx.InboundParams.ObservedExternalHeight)
+						if buckets[i].LowestPendingCctxHeight == 0 || cctxHeight < buckets[i].LowestPendingCctxHeight {
+							buckets[i].LowestPendingCctxHeight = cctxHeight
+						}
+					}
 				}
 			}
 		}
@@ -176,6 +207,11 @@ func (k Keeper) RateLimiterInput(
 		cctxsPending = cctxsPending[:limit]
 	}
 
+	for i := range buckets {
+		buckets[i].PastCctxsValue = bucketsPastValue[i].String()
+		buckets[i].PendingCctxsValue = bucketsPendingValue[i].String()
+	}
+
 	return &types.QueryRateLimiterInputResponse{
 		Height:                  height,
 		CctxsMissed:             cctxsMissed,
@@ -184,6 +220,7 @@ func (k Keeper) RateLimiterInput(
 		PastCctxsValue:          pastCctxsValue.String(),
 		PendingCctxsValue:       pendingCctxsValue.String(),
 		LowestPendingCctxHeight: lowestPendingCctxHeight,
+		Buckets:                 buckets,
 	}, nil
 }
 
@@ -211,15 +248,15 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 	cctxs := make([]*types.CrossChainTx, 0)
 	foreignChains := chains.FilterChains(k.zetaObserverKeeper.GetSupportedChains(ctx), chains.FilterExternalChains)
 
-	// check rate limit flags to decide if we should apply rate limit
-	applyLimit := true
+	// check rate limit flags to decide if we should apply rate limit,
+	// the per-chain and per-asset rate limits apply even if the global one is not set
 	rateLimitFlags, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
-	if !found || !rateLimitFlags.Enabled {
-		applyLimit = false
-	}
-	if rateLimitFlags.Rate.IsNil() || rateLimitFlags.Rate.IsZero() {
-		applyLimit = false
+	applyGlobalLimit := found && rateLimitFlags.IsGlobalRateLimitSet()
+	var buckets []types.RateLimitBucket
+	if found {
+		buckets = k.GetRateLimitBuckets(ctx, rateLimitFlags)
 	}
+	applyLimit := found && rateLimitFlags.Enabled && (applyGlobalLimit || len(buckets) > 0)
 
 	// fallback to non-rate-limited query if rate limiter is disabled
 	if !applyLimit {
@@ -250,15 +287,16 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		return nil, observertypes.ErrTssNotFound
 	}
 
-	// calculate the rate limiter sliding window left boundary (inclusive)
-	leftWindowBoundary := height - rateLimitFlags.Window + 1
-	if leftWindowBoundary < 0 {
-		leftWindowBoundary = 0
+	// the cctxs are queried back to the left boundary of the widest window of the global and bucket rate limits
+	queryWindow := int64(0)
+	if applyGlobalLimit {
+		queryWindow = rateLimitFlags.Window
+	}
+	for _, bucket := range buckets {
+		queryWindow = max(queryWindow, bucket.Window)
 	}
 
-	// initiate block limit and window limit in azeta; build asset rate maps
-	blockLimitInAzeta := sdkmath.NewIntFromBigInt(rateLimitFlags.Rate.BigInt())
-	windowLimitInAzeta := blockLimitInAzeta.Mul(sdkmath.NewInt(rateLimitFlags.Window))
+	// build asset rate maps
 	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
 
 	// the criteria to stop adding cctxs to the rpc response
@@ -267,8 +305,9 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		return uint32(len(cctxs)) >= limit
 	}
 
-	// if a cctx falls within the rate limiter window
-	isCCTXInWindow := func(cctx *types.CrossChainTx) bool {
+	// if a cctx falls within a rate limiter sliding window, the left boundary is inclusive
+	isCCTXInWindow := func(cctx *types.CrossChainTx, window int64) bool {
+		leftWindowBoundary := max(height-window+1, 0)
 		// #nosec G115 checked positive
 		return cctx.InboundParams.ObservedExternalHeight >= uint64(leftWindowBoundary)
 	}
@@ -305,15 +344,51 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 
 	// invariant: for period of time >= `rateLimitFlags.Window`, the zetaclient-side average withdraw rate should be <= `blockLimitInZeta`
 	// otherwise, this query should return empty result and wait for the average rate to drop below `blockLimitInZeta`
-	withdrawWindow := rateLimitFlags.Window
-	withdrawLimitInAzeta := windowLimitInAzeta
-	if lowestPendingCctxHeight != 0 {
-		// `pendingCctxWindow` is the width of [lowestPendingCctxHeight, height] window
-		// if the window can be wider than `rateLimitFlags.Window`, we should adjust the total withdraw limit proportionally
-		pendingCctxWindow := height - lowestPendingCctxHeight + 1
-		if pendingCctxWindow > rateLimitFlags.Window {
-			withdrawWindow = pendingCctxWindow
-			withdrawLimitInAzeta = blockLimitInAzeta.Mul(sdkmath.NewInt(pendingCctxWindow))
+	withdrawWindow := int64(0)
+	withdrawLimitInAzeta := sdkmath.NewInt(0)
+	if applyGlobalLimit {
+		withdrawWindow, withdrawLimitInAzeta = withdrawWindowLimit(
+			height,
+			lowestPendingCctxHeight,
+			rateLimitFlags.Window,
+			rateLimitFlags.Rate,
+		)
+	}
+
+	// the same invariant applies to the buckets, the window of a bucket is widened by its own pending cctxs
+	bucketsWithdrawInAzeta := make([]sdkmath.Int, len(buckets))
+	bucketsLowestPendingCctxHeight := make([]int64, len(buckets))
+	for i := range buckets {
+		bucketsWithdrawInAzeta[i] = sdkmath.NewInt(0)
+	}
+
+	// countInBuckets sums up the value of the outgoing cctx in the buckets including it,
+	// a past cctx is only counted in the buckets whose window it falls within
+	countInBuckets := func(chainID int64, cctx *types.CrossChainTx, past bool) {
+		if len(buckets) == 0 {
+			return
+		}
+		cctxValue := types.ConvertCctxValueToAzeta(chainID, cctx, gasAssetRateMap, erc20AssetRateMap)
+		for i := range buckets {
+			if !buckets[i].Contains(cctx) || (past && !isCCTXInWindow(cctx, buckets[i].Window)) {
+				continue
+			}
+			bucketsWithdrawInAzeta[i] = bucketsWithdrawInAzeta[i].Add(cctxValue)
+		}
+	}
+
+	// updateBucketsLowestHeight updates the lowest pending cctx height of the buckets including the pending cctx,
+	// as the rate limiter input does
+	updateBucketsLowestHeight := func(cctx *types.CrossChainTx) {
+		// #nosec G115 always in range
+		cctxHeight := int64(cctx.InboundParams.ObservedExternalHeight)
+		for i := range buckets {
+			if !buckets[i].Contains(cctx) {
+				continue
+			}
+			if bucketsLowestPendingCctxHeight[i] == 0 || cctxHeight < bucketsLowestPendingCctxHeight[i] {
+				bucketsLowestPendingCctxHeight[i] = cctxHeight
+			}
 		}
 	}
 
@@ -335,15 +410,21 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 			if err != nil {
 				return nil, err
 			}
-			inWindow := isCCTXInWindow(cctx)
+			inWindow := applyGlobalLimit && isCCTXInWindow(cctx, rateLimitFlags.Window)
 			isOutgoing := isCCTXOutgoing(cctx)
 
 			// we should at least go backwards by 1000 nonces to pick up missed pending cctxs
 			// we might go even further back if rate limiter is enabled and the endNonce hasn't hit the left window boundary yet
-			// stop at the left window boundary if the `endNonce` hasn't hit it yet
-			if nonce < endNonce && !inWindow {
+			// stop at the left boundary of the widest window if the `endNonce` hasn't hit it yet
+			if nonce < endNonce && !isCCTXInWindow(cctx, queryWindow) {
 				break
 			}
+
+			// sum up the cctx value in the buckets if the cctx is outgoing
+			if isOutgoing {
+				countInBuckets(chain.ChainId, cctx, true)
+			}
+
 			// sum up the cctxs' value if the cctx is outgoing and within the window
 			if inWindow && isOutgoing &&
 				types.RateLimitExceeded(
@@ -385,9 +466,15 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 				return nil, err
 			}
 			isOutgoing := isCCTXOutgoing(cctx)
+			updateBucketsLowestHeight(cctx)
+
+			// sum up the cctx value in the buckets if the cctx is outgoing
+			if isOutgoing {
+				countInBuckets(chain.ChainId, cctx, false)
+			}
 
 			// skip the cctx if rate limit is exceeded but still accumulate the total withdraw value
-			if isOutgoing && types.RateLimitExceeded(
+			if applyGlobalLimit && isOutgoing && types.RateLimitExceeded(
 				chain.ChainId,
 				cctx,
 				gasAssetRateMap,
@@ -406,9 +493,47 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		}
 	}
 
-	// if the rate limit is exceeded, only return the missed pending cctxs
+	// a bucket is exceeded if its withdraw value is above the limit of its window,
+	// widened by the lowest pending cctx height of the bucket
+	bucketsExceeded := make([]bool, len(buckets))
+	for i, bucket := range buckets {
+		_, bucketLimitInAzeta := withdrawWindowLimit(
+			height,
+			bucketsLowestPendingCctxHeight[i],
+			bucket.Window,
+			bucket.Rate,
+		)
+		bucketsExceeded[i] = bucketsWithdrawInAzeta[i].GT(bucketLimitInAzeta)
+	}
+
+	// if a cctx is counted in an exceeded bucket
+	inExceededBucket := func(cctx *types.CrossChainTx) bool {
+		for i := range buckets {
+			if bucketsExceeded[i] && buckets[i].Contains(cctx) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// if the rate limit is exceeded, only return the missed pending cctxs,
+	// otherwise stop each chain at its first pending cctx in an exceeded bucket to not leave a nonce gap
 	if limitExceeded {
 		cctxs = cctxs[:missedPending]
+	} else if slices.Contains(bucketsExceeded, true) {
+		limitExceeded = true
+		heldChains := make(map[int64]bool)
+		pending := cctxs[missedPending:]
+		cctxs = cctxs[:missedPending:missedPending]
+		for _, cctx := range pending {
+			// the pending cctxs of a chain are in nonce order
+			chainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+			if heldChains[chainID] || inExceededBucket(cctx) {
+				heldChains[chainID] = true
+				continue
+			}
+			cctxs = append(cctxs, cctx)
+		}
 	}
 
 	// sort the cctxs by chain ID and nonce (lower nonce holds higher priority for scheduling)
@@ -419,11 +544,35 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		return cctxs[i].GetCurrentOutboundParam().ReceiverChainId < cctxs[j].GetCurrentOutboundParam().ReceiverChainId
 	})
 
+	// the current withdraw rate is only reported for the global rate limit
+	currentWithdrawRate := sdkmath.NewInt(0)
+	if withdrawWindow > 0 {
+		currentWithdrawRate = totalWithdrawInAzeta.Quo(sdkmath.NewInt(withdrawWindow))
+	}
+
 	return &types.QueryListPendingCctxWithinRateLimitResponse{
 		CrossChainTx:          cctxs,
 		TotalPending:          totalPending,
 		CurrentWithdrawWindow: withdrawWindow,
-		CurrentWithdrawRate:   totalWithdrawInAzeta.Quo(sdkmath.NewInt(withdrawWindow)).String(),
+		CurrentWithdrawRate:   currentWithdrawRate.String(),
 		RateLimitExceeded:     limitExceeded,
 	}, nil
 }
+
+// withdrawWindowLimit returns the withdraw window and the withdraw limit in azeta of a rate limit.
+// If [lowestPendingCctxHeight, height] is wider than the window of the rate limit,
+// the wider window is used and the limit is adjusted proportionally
+func withdrawWindowLimit(
+	height int64,
+	lowestPendingCctxHeight int64,
+	window int64,
+	rate sdkmath.Uint,
+) (int64, sdkmath.Int) {
+	withdrawWindow := window
+	if lowestPendingCctxHeight != 0 {
+		withdrawWindow = max(window, height-lowestPendingCctxHeight+1)
+	}
+
+	blockLimitInAzeta := sdkmath.NewIntFromBigInt(rate.BigInt())
+	return withdrawWindow, blockLimitInAzeta.Mul(sdkmath.NewInt(withdrawWindow))
+}
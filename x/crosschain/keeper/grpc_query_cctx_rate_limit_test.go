@@ -518,6 +518,98 @@ func TestKeeper_RateLimiterInput(t *testing.T) {
 	}
 }
 
+func TestKeeper_RateLimiterInput_Buckets(t *testing.T) {
+	// create sample TSS
+	tss := sample.Tss()
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+
+	// create sample zrc20 addresses for ETH, BTC, USDT
+	zrc20ETH := sample.EthAddress().Hex()
+	zrc20BTC := sample.EthAddress().Hex()
+	zrc20USDT := sample.EthAddress().Hex()
+
+	// create Eth and Btc chain 999 mined and 200 pending cctxs
+	ethMinedCctxs := sample.CustomCctxsInBlockRange(
+		t, 1, 999, zetaChainID, ethChainID, coin.CoinType_Gas, "", uint64(1e15), types.CctxStatus_OutboundMined,
+	)
+	ethPendingCctxs := sample.CustomCctxsInBlockRange(
+		t, 1000, 1199, zetaChainID, ethChainID, coin.CoinType_Gas, "", uint64(1e15), types.CctxStatus_PendingOutbound,
+	)
+	btcMinedCctxs := sample.CustomCctxsInBlockRange(
+		t, 1, 999, zetaChainID, btcChainID, coin.CoinType_Gas, "", 1000, types.CctxStatus_OutboundMined,
+	)
+	btcPendingCctxs := sample.CustomCctxsInBlockRange(
+		t, 1000, 1199, zetaChainID, btcChainID, coin.CoinType_Gas, "", 1000, types.CctxStatus_PendingOutbound,
+	)
+
+	// create test keepers
+	k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+	zk.ObserverKeeper.SetTSS(ctx, tss)
+	setupForeignCoins(t, ctx, zk, zrc20ETH, zrc20BTC, zrc20USDT, sample.EthAddress().Hex())
+
+	// limit the Btc chain on a wider window and the ETH asset on a narrower window than the global one
+	flags := createTestRateLimiterFlags(
+		500,
+		math.NewUint(10*1e18),
+		zrc20ETH,
+		zrc20BTC,
+		zrc20USDT,
+		"2500",
+		"50000",
+		"0.8",
+	)
+	flags.ChainRateLimits = []types.ChainRateLimit{
+		{ChainId: btcChainID, Window: 1000, Rate: math.NewUint(1e18)},
+	}
+	flags.AssetRateLimits = []types.AssetRateLimit{
+		{Zrc20: zrc20ETH, Window: 100, Rate: math.NewUint(2 * 1e18)},
+		{Zrc20: sample.EthAddress().Hex(), Window: 100, Rate: math.NewUint(1e18)}, // no foreign coin, ignored
+	}
+	k.SetRateLimiterFlags(ctx, *flags)
+
+	for _, nonces := range []observertypes.PendingNonces{
+		{ChainId: ethChainID, NonceLow: 1099, NonceHigh: 1199, Tss: tss.TssPubkey},
+		{ChainId: btcChainID, NonceLow: 1099, NonceHigh: 1199, Tss: tss.TssPubkey},
+		{ChainId: solanaChainID, Tss: tss.TssPubkey},
+	} {
+		zk.ObserverKeeper.SetPendingNonces(ctx, nonces)
+	}
+	setCctxsInKeeper(ctx, *k, zk, tss, ethMinedCctxs)
+	setCctxsInKeeper(ctx, *k, zk, tss, ethPendingCctxs)
+	setCctxsInKeeper(ctx, *k, zk, tss, btcMinedCctxs)
+	setCctxsInKeeper(ctx, *k, zk, tss, btcPendingCctxs)
+
+	// Query input data for the rate limiter
+	ctx = ctx.WithBlockHeight(1199)
+	res, err := k.RateLimiterInput(ctx, &types.QueryRateLimiterInputRequest{Window: flags.Window})
+	require.NoError(t, err)
+
+	// global usage is not affected by the buckets
+	require.Equal(t, sdkmath.NewInt(1200).Mul(sdkmath.NewInt(1e18)).String(), res.PastCctxsValue)
+	require.Equal(t, sdkmath.NewInt(300).Mul(sdkmath.NewInt(1e18)).String(), res.PendingCctxsValue)
+
+	require.Equal(t, []types.RateLimitBucket{
+		{
+			ChainId:                 btcChainID,
+			Window:                  1000,
+			Rate:                    math.NewUint(1e18),
+			PastCctxsValue:          sdkmath.NewInt(450).Mul(sdkmath.NewInt(1e18)).String(), // 900 * 0.5 ZETA
+			PendingCctxsValue:       sdkmath.NewInt(50).Mul(sdkmath.NewInt(1e18)).String(),  // 100 * 0.5 ZETA
+			LowestPendingCctxHeight: 1100,
+		},
+		{
+			ChainId:                 ethChainID,
+			Zrc20:                   zrc20ETH,
+			CoinType:                coin.CoinType_Gas,
+			Window:                  100,
+			Rate:                    math.NewUint(2 * 1e18),
+			PastCctxsValue:          "0",                                                    // no past cctx within the window
+			PendingCctxsValue:       sdkmath.NewInt(250).Mul(sdkmath.NewInt(1e18)).String(), // 100 * 2.5 ZETA
+			LowestPendingCctxHeight: 1100,
+		},
+	}, res.Buckets)
+}
+
 func TestKeeper_RateLimiterInput_Errors(t *testing.T) {
 	t.Run("should fail for empty req", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
@@ -525,12 +617,12 @@ func TestKeeper_RateLimiterInput_Errors(t *testing.T) {
 		require.ErrorContains(t, err, "invalid request")
 	})
 
-	t.Run("window must be positive", func(t *testing.T) {
+	t.Run("window must not be negative", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
 		_, err := k.RateLimiterInput(ctx, &types.QueryRateLimiterInputRequest{
-			Window: 0, // 0 window
+			Window: -1, // negative window
 		})
-		require.ErrorContains(t, err, "window must be positive")
+		require.ErrorContains(t, err, "window must not be negative")
 	})
 	t.Run("height out of range", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
@@ -1082,6 +1174,148 @@ func TestKeeper_ListPendingCctxWithinRateLimit(t *testing.T) {
 	}
 }
 
+func TestKeeper_ListPendingCctxWithinRateLimit_Buckets(t *testing.T) {
+	// create sample TSS
+	tss := sample.Tss()
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+
+	// create sample zrc20 addresses for ETH, BTC, USDT
+	zrc20ETH := sample.EthAddress().Hex()
+	zrc20BTC := sample.EthAddress().Hex()
+	zrc20USDT := sample.EthAddress().Hex()
+	assetUSDT := sample.EthAddress().Hex()
+
+	// create Eth and Btc chain 999 mined and 200 pending cctxs, the 100 first pending ones are missed
+	ethMinedCctxs := sample.CustomCctxsInBlockRange(
+		t, 1, 999, zetaChainID, ethChainID, coin.CoinType_Gas, "", uint64(1e15), types.CctxStatus_OutboundMined,
+	)
+	ethPendingCctxs := sample.CustomCctxsInBlockRange(
+		t, 1000, 1199, zetaChainID, ethChainID, coin.CoinType_Gas, "", uint64(1e15), types.CctxStatus_PendingOutbound,
+	)
+	btcMinedCctxs := sample.CustomCctxsInBlockRange(
+		t, 1, 999, zetaChainID, btcChainID, coin.CoinType_Gas, "", 1000, types.CctxStatus_OutboundMined,
+	)
+	btcPendingCctxs := sample.CustomCctxsInBlockRange(
+		t, 1000, 1199, zetaChainID, btcChainID, coin.CoinType_Gas, "", 1000, types.CctxStatus_PendingOutbound,
+	)
+
+	// create Eth chain pending cctxs with 10 USDT withdrawals of 8 ZETA within [1150, 1159]
+	ethMixedPendingCctxs := append(append(append([]*types.CrossChainTx{},
+		ethPendingCctxs[:150]...),
+		sample.CustomCctxsInBlockRange(
+			t, 1150, 1159, zetaChainID, ethChainID, coin.CoinType_ERC20, assetUSDT, uint64(1e19), types.CctxStatus_PendingOutbound,
+		)...),
+		ethPendingCctxs[160:]...)
+
+	// listPendingCctxs lists the pending cctxs with the given rate limits and no global rate limit
+	listPendingCctxs := func(
+		t *testing.T,
+		ethPending []*types.CrossChainTx,
+		chainLimits []types.ChainRateLimit,
+		assetLimits []types.AssetRateLimit,
+	) *types.QueryListPendingCctxWithinRateLimitResponse {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		setupForeignCoins(t, ctx, zk, zrc20ETH, zrc20BTC, zrc20USDT, assetUSDT)
+
+		flags := createTestRateLimiterFlags(0, math.NewUint(0), zrc20ETH, zrc20BTC, zrc20USDT, "2500", "50000", "0.8")
+		flags.ChainRateLimits = chainLimits
+		flags.AssetRateLimits = assetLimits
+		k.SetRateLimiterFlags(ctx, *flags)
+
+		for _, nonces := range []observertypes.PendingNonces{
+			{ChainId: ethChainID, NonceLow: 1099, NonceHigh: 1199, Tss: tss.TssPubkey},
+			{ChainId: btcChainID, NonceLow: 1099, NonceHigh: 1199, Tss: tss.TssPubkey},
+			{ChainId: solanaChainID, Tss: tss.TssPubkey},
+		} {
+			zk.ObserverKeeper.SetPendingNonces(ctx, nonces)
+		}
+		setCctxsInKeeper(ctx, *k, zk, tss, ethMinedCctxs)
+		setCctxsInKeeper(ctx, *k, zk, tss, ethPending)
+		setCctxsInKeeper(ctx, *k, zk, tss, btcMinedCctxs)
+		setCctxsInKeeper(ctx, *k, zk, tss, btcPendingCctxs)
+
+		ctx = ctx.WithBlockHeight(1199)
+		res, err := k.ListPendingCctxWithinRateLimit(
+			ctx,
+			&types.QueryListPendingCctxWithinRateLimitRequest{Limit: keeper.MaxPendingCctxs},
+		)
+		require.NoError(t, err)
+
+		// the withdraw rate is only reported for the global rate limit
+		require.EqualValues(t, 0, res.CurrentWithdrawWindow)
+		require.Equal(t, "0", res.CurrentWithdrawRate)
+		require.EqualValues(t, 400, res.TotalPending)
+
+		return res
+	}
+
+	t.Run("should skip the pending cctxs of an exceeded asset rate limit", func(t *testing.T) {
+		// 100 pending ETH cctxs of 2.5 ZETA within [1100, 1199] exceed 2 ZETA per block
+		res := listPendingCctxs(t, ethPendingCctxs, nil, []types.AssetRateLimit{
+			{Zrc20: zrc20ETH, Window: 100, Rate: math.NewUint(2 * 1e18)},
+		})
+
+		require.True(t, res.RateLimitExceeded)
+		require.EqualValues(t, append(
+			append([]*types.CrossChainTx{}, ethPendingCctxs[0:100]...),
+			btcPendingCctxs...), res.CrossChainTx)
+	})
+
+	t.Run("should skip the pending cctxs of an exceeded chain rate limit", func(t *testing.T) {
+		// 900 past and 100 pending BTC cctxs of 0.5 ZETA within [200, 1199] exceed 0.4 ZETA per block
+		res := listPendingCctxs(t, ethPendingCctxs, []types.ChainRateLimit{
+			{ChainId: ethChainID, Window: 100, Rate: math.NewUint(3 * 1e18)},
+			{ChainId: btcChainID, Window: 1000, Rate: math.NewUint(4 * 1e17)},
+		}, nil)
+
+		require.True(t, res.RateLimitExceeded)
+		require.EqualValues(t, append(
+			append([]*types.CrossChainTx{}, ethPendingCctxs...),
+			btcPendingCctxs[0:100]...), res.CrossChainTx)
+	})
+
+	t.Run("should stop at the first pending cctx of an exceeded asset rate limit", func(t *testing.T) {
+		// 10 pending USDT cctxs of 8 ZETA within [1100, 1199] exceed 0.5 ZETA per block,
+		// the ETH cctxs after them are within the ETH rate limit but can't be signed before them
+		res := listPendingCctxs(t, ethMixedPendingCctxs, nil, []types.AssetRateLimit{
+			{Zrc20: zrc20ETH, Window: 100, Rate: math.NewUint(3 * 1e18)},
+			{Zrc20: zrc20USDT, Window: 100, Rate: math.NewUint(5 * 1e17)},
+		})
+
+		require.True(t, res.RateLimitExceeded)
+		require.EqualValues(t, append(
+			append([]*types.CrossChainTx{}, ethMixedPendingCctxs[0:150]...),
+			btcPendingCctxs...), res.CrossChainTx)
+	})
+
+	t.Run("should widen the window of a bucket by its own pending cctxs only", func(t *testing.T) {
+		// 10 pending USDT cctxs worth 8e31 azeta within [1150, 1199] exceed 1e30 azeta per block,
+		// they would be within the limit if the older pending ETH cctxs widened the window to [1100, 1199]
+		res := listPendingCctxs(t, ethMixedPendingCctxs, nil, []types.AssetRateLimit{
+			{Zrc20: zrc20USDT, Window: 10, Rate: math.NewUintFromString("1000000000000000000000000000000")},
+		})
+
+		require.True(t, res.RateLimitExceeded)
+		require.EqualValues(t, append(
+			append([]*types.CrossChainTx{}, ethMixedPendingCctxs[0:150]...),
+			btcPendingCctxs...), res.CrossChainTx)
+	})
+
+	t.Run("should return all pending cctxs within the rate limits", func(t *testing.T) {
+		res := listPendingCctxs(t, ethPendingCctxs, []types.ChainRateLimit{
+			{ChainId: btcChainID, Window: 1000, Rate: math.NewUint(1e18)},
+		}, []types.AssetRateLimit{
+			{Zrc20: zrc20ETH, Window: 100, Rate: math.NewUint(3 * 1e18)},
+		})
+
+		require.False(t, res.RateLimitExceeded)
+		require.EqualValues(t, append(
+			append([]*types.CrossChainTx{}, ethPendingCctxs...),
+			btcPendingCctxs...), res.CrossChainTx)
+	})
+}
+
 func TestKeeper_ListPendingCctxWithinRateLimit_Errors(t *testing.T) {
 	t.Run("should fail for empty req", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// InboundRateLimiterState queries the inbound rate limiter state of a chain and the inbounds it holds
+func (k Keeper) InboundRateLimiterState(
+	c context.Context,
+	req *types.QueryInboundRateLimiterStateRequest,
+) (*types.QueryInboundRateLimiterStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, found := k.GetInboundRateLimiterState(ctx, req.ChainId)
+	if !found {
+		state = types.InboundRateLimiterState{ChainId: req.ChainId}
+	}
+
+	return &types.QueryInboundRateLimiterStateResponse{
+		State:           state,
+		HeldCctxIndexes: k.GetInboundRateLimitedCctxs(ctx, req.ChainId, 0),
+	}, nil
+}
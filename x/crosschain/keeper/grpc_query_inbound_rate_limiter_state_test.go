@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestKeeper_InboundRateLimiterState(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		res, err := k.InboundRateLimiterState(ctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return default state if not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		res, err := k.InboundRateLimiterState(ctx, &types.QueryInboundRateLimiterStateRequest{
+			ChainId: chains.Ethereum.ChainId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryInboundRateLimiterStateResponse{
+			State: types.InboundRateLimiterState{ChainId: chains.Ethereum.ChainId},
+		}, res)
+	})
+
+	t.Run("should return state and held cctxs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		chainID := chains.Ethereum.ChainId
+
+		state := types.InboundRateLimiterState{
+			ChainId:               chainID,
+			CircuitBreakerTripped: true,
+			WindowStartHeight:     10,
+			WindowValue:           sdkmath.NewUint(42),
+		}
+		k.SetInboundRateLimiterState(ctx, state)
+		cctx1 := heldInboundCctx(t, "1", chainID, 1)
+		cctx2 := heldInboundCctx(t, "2", chainID, 1)
+		k.SetInboundRateLimitedCctx(ctx, cctx2, 2)
+		k.SetInboundRateLimitedCctx(ctx, cctx1, 1)
+
+		res, err := k.InboundRateLimiterState(ctx, &types.QueryInboundRateLimiterStateRequest{ChainId: chainID})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryInboundRateLimiterStateResponse{
+			State:           state,
+			HeldCctxIndexes: []string{cctx1.Index, cctx2.Index},
+		}, res)
+	})
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// ListHeldCctx returns the cctxs of a chain held until released or rejected by the authority
+func (k Keeper) ListHeldCctx(
+	c context.Context,
+	req *types.QueryListHeldCctxRequest,
+) (*types.QueryListHeldCctxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	cctxs := make([]*types.CrossChainTx, 0)
+	for _, index := range k.GetHeldCctxs(ctx, req.ChainId) {
+		cctx, found := k.GetCrossChainTx(ctx, index)
+		if !found {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("cctx not found: index %s", index))
+		}
+		cctxs = append(cctxs, &cctx)
+	}
+
+	return &types.QueryListHeldCctxResponse{CrossChainTx: cctxs}, nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestKeeper_ListHeldCctx(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		res, err := k.ListHeldCctx(ctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return empty list if no held cctx", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		res, err := k.ListHeldCctx(ctx, &types.QueryListHeldCctxRequest{ChainId: chains.Ethereum.ChainId})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+	})
+
+	t.Run("should return held cctxs of the chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		chainID := chains.Ethereum.ChainId
+
+		cctx := sample.CrossChainTx(t, "1")
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutboundHeld
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetHeldCctx(ctx, chainID, cctx.Index)
+
+		res, err := k.ListHeldCctx(ctx, &types.QueryListHeldCctxRequest{ChainId: chainID})
+		require.NoError(t, err)
+		require.Equal(t, []*types.CrossChainTx{cctx}, res.CrossChainTx)
+
+		res, err = k.ListHeldCctx(ctx, &types.QueryListHeldCctxRequest{ChainId: chains.BitcoinMainnet.ChainId})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+	})
+
+	t.Run("should error if held cctx not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetHeldCctx(ctx, chains.Ethereum.ChainId, "1")
+
+		_, err := k.ListHeldCctx(ctx, &types.QueryListHeldCctxRequest{ChainId: chains.Ethereum.ChainId})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SetHeldCctx adds a cctx to the held cctxs of its receiver chain
+func (k Keeper) SetHeldCctx(ctx sdk.Context, chainID int64, cctxIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeldCctxKey))
+	store.Set(types.HeldCctxStoreKey(chainID, cctxIndex), []byte(cctxIndex))
+}
+
+// RemoveHeldCctx removes a cctx from the held cctxs of its receiver chain
+func (k Keeper) RemoveHeldCctx(ctx sdk.Context, chainID int64, cctxIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeldCctxKey))
+	store.Delete(types.HeldCctxStoreKey(chainID, cctxIndex))
+}
+
+// GetHeldCctxs returns the indexes of the held cctxs of a chain
+func (k Keeper) GetHeldCctxs(ctx sdk.Context, chainID int64) (indexes []string) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefix(types.HeldCctxKey), types.HeldCctxStoreKey(chainID, "")...),
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		indexes = append(indexes, string(iterator.Value()))
+	}
+
+	return indexes
+}
+
+// applyHeldCctxThreshold holds the outbound of the cctx if its value exceeds the held threshold of the receiver chain,
+// the held cctx has no nonce assigned and waits to be released or rejected by the authority
+func (k Keeper) applyHeldCctxThreshold(ctx sdk.Context, cctx *types.CrossChainTx) (held bool) {
+	chainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+
+	flags, assetRates, _ := k.GetRateLimiterAssetRateList(ctx)
+	threshold, found := flags.GetHeldCctxThreshold(chainID)
+	if !found {
+		return false
+	}
+
+	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
+	value := types.ConvertCctxValueToAzeta(chainID, cctx, gasAssetRateMap, erc20AssetRateMap)
+	if math.NewUintFromBigInt(value.BigInt()).LTE(threshold) {
+		return false
+	}
+
+	cctx.SetPendingOutboundHeld(types.StatusMessages{StatusMessage: "outbound value exceeds the held threshold"})
+	k.SetHeldCctx(ctx, chainID, cctx.Index)
+
+	return true
+}
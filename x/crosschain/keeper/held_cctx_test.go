@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+)
+
+func TestKeeper_GetHeldCctxs(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	chainID := chains.Ethereum.ChainId
+
+	k.SetHeldCctx(ctx, chainID, "2")
+	k.SetHeldCctx(ctx, chainID, "1")
+	k.SetHeldCctx(ctx, chains.BitcoinMainnet.ChainId, "3")
+
+	require.Equal(t, []string{"1", "2"}, k.GetHeldCctxs(ctx, chainID))
+	require.Equal(t, []string{"3"}, k.GetHeldCctxs(ctx, chains.BitcoinMainnet.ChainId))
+	require.Empty(t, k.GetHeldCctxs(ctx, chains.Polygon.ChainId))
+
+	k.RemoveHeldCctx(ctx, chainID, "1")
+	require.Equal(t, []string{"2"}, k.GetHeldCctxs(ctx, chainID))
+}
@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+var (
+	// errReleasePanic is returned when the release of a held inbound panics
+	errReleasePanic = errors.New("panic releasing held inbound")
+
+	// errReleaseOutOfGas is returned when the release of a held inbound runs out of the gas left in the block
+	errReleaseOutOfGas = errors.New("out of gas releasing held inbound")
+)
+
+const (
+	// MaxInboundReleasesPerBlock is the maximum number of held inbounds released by the inbound rate limiter in a block
+	MaxInboundReleasesPerBlock = 100
+
+	// MaxInboundReleaseGasPerBlock is the gas budget of the release of the held inbounds in a block.
+	// The releases run in BeginBlock, which isn't metered, so they're metered with this budget instead.
+	// A held inbound whose release doesn't fit in what's left of the budget is released in a next block.
+	MaxInboundReleaseGasPerBlock = 10_000_000
+)
+
+// SetInboundRateLimiterState sets the inbound rate limiter state of a chain in the store
+func (k Keeper) SetInboundRateLimiterState(ctx sdk.Context, state types.InboundRateLimiterState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundRateLimiterStateKey))
+	b := k.cdc.MustMarshal(&state)
+	store.Set(types.InboundRateLimitChainKey(state.ChainId), b)
+}
+
+// GetInboundRateLimiterState returns the inbound rate limiter state of a chain
+func (k Keeper) GetInboundRateLimiterState(
+	ctx sdk.Context,
+	chainID int64,
+) (val types.InboundRateLimiterState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundRateLimiterStateKey))
+
+	b := store.Get(types.InboundRateLimitChainKey(chainID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllInboundRateLimiterState returns the inbound rate limiter states of all chains
+func (k Keeper) GetAllInboundRateLimiterState(ctx sdk.Context) (list []types.InboundRateLimiterState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundRateLimiterStateKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.InboundRateLimiterState
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// SetInboundRateLimitedCctx adds a cctx to the queue of the held inbounds of its sender chain
+func (k Keeper) SetInboundRateLimitedCctx(ctx sdk.Context, cctx types.CrossChainTx, heldHeight uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundRateLimitQueueKey))
+	store.Set(
+		types.InboundRateLimitQueueStoreKey(cctx.InboundParams.SenderChainId, heldHeight, cctx.Index),
+		[]byte(cctx.Index),
+	)
+}
+
+// GetInboundRateLimitedCctxs returns the indexes of the held inbounds of a chain in the order they are released,
+// a limit of 0 returns all of them
+func (k Keeper) GetInboundRateLimitedCctxs(ctx sdk.Context, chainID int64, limit int) []string {
+	_, indexes := k.getInboundRateLimitQueue(ctx, chainID, limit)
+	return indexes
+}
+
+// getInboundRateLimitQueue returns the store keys (within the queue of the chain) and indexes of the held inbounds
+func (k Keeper) getInboundRateLimitQueue(
+	ctx sdk.Context,
+	chainID int64,
+	limit int,
+) (keys [][]byte, indexes []string) {
+	store := k.inboundRateLimitQueueStore(ctx, chainID)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid() && (limit == 0 || len(indexes) < limit); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+		indexes = append(indexes, string(iterator.Value()))
+	}
+
+	return keys, indexes
+}
+
+// inboundRateLimitQueueStore returns the store of the queue of the held inbounds of a chain
+func (k Keeper) inboundRateLimitQueueStore(ctx sdk.Context, chainID int64) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefix(types.InboundRateLimitQueueKey), types.InboundRateLimitChainKey(chainID)...),
+	)
+}
+
+// applyInboundRateLimiter holds the new inbound cctx if the inbound rate limiter of its sender chain doesn't allow it:
+//   - the circuit breaker of the chain is tripped
+//   - older inbounds of the chain are still held, the inbounds are released in order
+//   - the value of the inbound exceeds what's left of the rate limit in the current window
+//
+// The held cctx is added to the queue of its sender chain, otherwise its value is counted in the current window.
+func (k Keeper) applyInboundRateLimiter(ctx sdk.Context, cctx *types.CrossChainTx) (held bool) {
+	chainID := cctx.InboundParams.SenderChainId
+	if chains.IsZetaChain(chainID, k.GetAuthorityKeeper().GetAdditionalChainList(ctx)) {
+		return false
+	}
+
+	state, found := k.GetInboundRateLimiterState(ctx, chainID)
+	if !found {
+		state = types.InboundRateLimiterState{ChainId: chainID}
+	}
+
+	flags, assetRates, _ := k.GetRateLimiterAssetRateList(ctx)
+	limit, hasLimit := flags.GetInboundRateLimit(chainID)
+
+	var reason string
+	switch {
+	case state.CircuitBreakerTripped:
+		reason = "inbound circuit breaker tripped"
+	case !hasLimit:
+		return false
+	case len(k.GetInboundRateLimitedCctxs(ctx, chainID, 1)) > 0:
+		reason = "older inbounds held by the inbound rate limiter"
+	default:
+		gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
+		value := types.ConvertCctxValueToAzeta(chainID, cctx, gasAssetRateMap, erc20AssetRateMap)
+		if state.Consume(limit, math.NewUintFromBigInt(value.BigInt()), ctx.BlockHeight()) {
+			k.SetInboundRateLimiterState(ctx, state)
+			return false
+		}
+		reason = "inbound rate limit exceeded"
+	}
+
+	cctx.SetPendingInboundRateLimit(types.StatusMessages{StatusMessage: reason})
+	// #nosec G115 always positive
+	k.SetInboundRateLimitedCctx(ctx, *cctx, uint64(ctx.BlockHeight()))
+
+	return true
+}
+
+// ReleaseInboundRateLimitedCctxs processes the held inbounds of each supported chain, in the order they are held,
+// as long as the circuit breaker of the chain is not tripped and its inbound rate limit allows it.
+// It returns the number of released inbounds, at most MaxInboundReleasesPerBlock,
+// and the releases consume at most MaxInboundReleaseGasPerBlock gas.
+func (k Keeper) ReleaseInboundRateLimitedCctxs(ctx sdk.Context, supportedChains []chains.Chain) int {
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return 0
+	}
+
+	gasMeter := storetypes.NewGasMeter(MaxInboundReleaseGasPerBlock)
+
+	flags, assetRates, _ := k.GetRateLimiterAssetRateList(ctx)
+	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
+
+	released := 0
+	for _, chain := range supportedChains {
+		if released >= MaxInboundReleasesPerBlock || gasMeter.IsOutOfGas() {
+			break
+		}
+
+		state, found := k.GetInboundRateLimiterState(ctx, chain.ChainId)
+		if !found {
+			state = types.InboundRateLimiterState{ChainId: chain.ChainId}
+		}
+		if state.CircuitBreakerTripped {
+			continue
+		}
+		limit, hasLimit := flags.GetInboundRateLimit(chain.ChainId)
+
+		keys, indexes := k.getInboundRateLimitQueue(ctx, chain.ChainId, MaxInboundReleasesPerBlock-released)
+		for i, index := range indexes {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			if !found {
+				ctx.Logger().Error("InboundRateLimiter: held cctx not found", "cctxIndex", index)
+				k.inboundRateLimitQueueStore(ctx, chain.ChainId).Delete(keys[i])
+				continue
+			}
+
+			// the held cctx can be aborted by the authority in the meantime
+			if cctx.CctxStatus.Status != types.CctxStatus_PendingInboundRateLimit {
+				k.inboundRateLimitQueueStore(ctx, chain.ChainId).Delete(keys[i])
+				continue
+			}
+
+			// the held inbounds of the chain are released in order
+			value := types.ConvertCctxValueToAzeta(chain.ChainId, &cctx, gasAssetRateMap, erc20AssetRateMap)
+			consumed := state
+			if hasLimit && !consumed.Consume(limit, math.NewUintFromBigInt(value.BigInt()), ctx.BlockHeight()) {
+				break
+			}
+
+			if !k.releaseInboundCctx(ctx, cctx, tss.TssPubkey, gasMeter) {
+				// the gas left in the block is exhausted, the inbound stays held
+				break
+			}
+
+			state = consumed
+			k.inboundRateLimitQueueStore(ctx, chain.ChainId).Delete(keys[i])
+			released++
+		}
+
+		if hasLimit && len(indexes) > 0 {
+			k.SetInboundRateLimiterState(ctx, state)
+		}
+	}
+
+	return released
+}
+
+// releaseInboundCctx processes a held inbound cctx like a new inbound.
+// The processing runs in a cached context, if it panics its state changes are discarded and the cctx is aborted,
+// so a single held inbound can't halt the chain or block the release of the others.
+//
+// The processing is metered with the gas left in the block gas meter. If it runs out of gas, its state changes
+// are discarded and false is returned so the cctx stays held, unless the whole budget of the block
+// isn't enough to release it, in which case the cctx is aborted.
+func (k Keeper) releaseInboundCctx(
+	ctx sdk.Context,
+	cctx types.CrossChainTx,
+	tssPubkey string,
+	blockGasMeter storetypes.GasMeter,
+) bool {
+	tmpCtx, commit := ctx.CacheContext()
+	tmpCtx = tmpCtx.WithGasMeter(storetypes.NewGasMeter(blockGasMeter.GasRemaining()))
+
+	fullBudget := blockGasMeter.GasConsumed() == 0
+	err := k.initiateReleasedOutbound(tmpCtx, &cctx)
+	blockGasMeter.ConsumeGas(tmpCtx.GasMeter().GasConsumedToLimit(), "release held inbound")
+
+	if errors.Is(err, errReleaseOutOfGas) && !fullBudget {
+		return false
+	}
+
+	if errors.Is(err, errReleasePanic) || errors.Is(err, errReleaseOutOfGas) {
+		// the cctx may have been partially updated, start back from the held one
+		if held, found := k.GetCrossChainTx(ctx, cctx.Index); found {
+			cctx = held
+		}
+	} else {
+		// the process function manages the state commit and cctx status change
+		commit()
+	}
+
+	if err != nil {
+		ctx.Logger().Error("InboundRateLimiter: unable to release held cctx",
+			"cctxIndex", cctx.Index,
+			"err", err.Error(),
+		)
+		cctx.SetAbort(types.StatusMessages{
+			StatusMessage:     "unable to release held inbound",
+			ErrorMessageAbort: fmt.Sprintf("unable to initiate outbound: %s", err.Error()),
+		})
+	}
+
+	k.SaveCCTXUpdate(ctx, cctx, tssPubkey)
+
+	return true
+}
+
+// initiateReleasedOutbound initiates the outbound of a released cctx,
+// a panic is recovered as errReleasePanic, or as errReleaseOutOfGas if the gas meter ran out of gas
+func (k Keeper) initiateReleasedOutbound(ctx sdk.Context, cctx *types.CrossChainTx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = fmt.Errorf("%w: %s", errReleaseOutOfGas, outOfGas.Descriptor)
+				return
+			}
+			err = fmt.Errorf("%w: %v", errReleasePanic, r)
+		}
+	}()
+
+	_, err = k.InitiateOutbound(ctx, InitiateOutboundConfig{
+		CCTX:         cctx,
+		ShouldPayGas: true,
+	})
+
+	return err
+}
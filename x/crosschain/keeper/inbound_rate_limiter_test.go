@@ -0,0 +1,306 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// heldInboundCctx returns a ZETA deposit held by the inbound rate limiter
+func heldInboundCctx(t *testing.T, index string, chainID int64, amount uint64) types.CrossChainTx {
+	cctx := *sample.CrossChainTx(t, index)
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingInboundRateLimit}
+	cctx.InboundParams.SenderChainId = chainID
+	cctx.InboundParams.CoinType = coin.CoinType_Zeta
+	cctx.OutboundParams = []*types.OutboundParams{{
+		ReceiverChainId: 999999,
+		Amount:          sdkmath.NewUint(amount),
+	}}
+	return cctx
+}
+
+func TestKeeper_SetInboundRateLimiterState(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+	_, found := k.GetInboundRateLimiterState(ctx, chains.Ethereum.ChainId)
+	require.False(t, found)
+
+	states := []types.InboundRateLimiterState{
+		{ChainId: chains.Ethereum.ChainId, CircuitBreakerTripped: true, WindowValue: sdkmath.ZeroUint()},
+		{ChainId: chains.BitcoinMainnet.ChainId, WindowStartHeight: 10, WindowValue: sdkmath.NewUint(42)},
+	}
+	for _, state := range states {
+		k.SetInboundRateLimiterState(ctx, state)
+	}
+
+	state, found := k.GetInboundRateLimiterState(ctx, chains.BitcoinMainnet.ChainId)
+	require.True(t, found)
+	require.Equal(t, states[1], state)
+	require.ElementsMatch(t, states, k.GetAllInboundRateLimiterState(ctx))
+}
+
+func TestKeeper_GetInboundRateLimitedCctxs(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	chainID := chains.Ethereum.ChainId
+
+	cctx1 := heldInboundCctx(t, "1", chainID, 1)
+	cctx2 := heldInboundCctx(t, "2", chainID, 1)
+	cctx3 := heldInboundCctx(t, "3", chainID, 1)
+	other := heldInboundCctx(t, "4", chains.BitcoinMainnet.ChainId, 1)
+
+	k.SetInboundRateLimitedCctx(ctx, cctx3, 30)
+	k.SetInboundRateLimitedCctx(ctx, cctx1, 10)
+	k.SetInboundRateLimitedCctx(ctx, cctx2, 20)
+	k.SetInboundRateLimitedCctx(ctx, other, 5)
+
+	require.Equal(t, []string{cctx1.Index, cctx2.Index, cctx3.Index}, k.GetInboundRateLimitedCctxs(ctx, chainID, 0))
+	require.Equal(t, []string{cctx1.Index, cctx2.Index}, k.GetInboundRateLimitedCctxs(ctx, chainID, 2))
+	require.Equal(t, []string{other.Index}, k.GetInboundRateLimitedCctxs(ctx, chains.BitcoinMainnet.ChainId, 0))
+	require.Empty(t, k.GetInboundRateLimitedCctxs(ctx, chains.Polygon.ChainId, 0))
+}
+
+func TestKeeper_ReleaseInboundRateLimitedCctxs(t *testing.T) {
+	chain := chains.Ethereum
+	limit := types.ChainRateLimit{ChainId: chain.ChainId, Window: 10, Rate: sdkmath.NewUint(10)}
+
+	t.Run("should release held inbounds in order within the rate limit", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		k.SetRateLimiterFlags(ctx, types.RateLimiterFlags{InboundRateLimits: []types.ChainRateLimit{limit}})
+		ctx = ctx.WithBlockHeight(100)
+
+		// the window allows 100 azeta
+		cctx1 := heldInboundCctx(t, "1", chain.ChainId, 60)
+		cctx2 := heldInboundCctx(t, "2", chain.ChainId, 30)
+		cctx3 := heldInboundCctx(t, "3", chain.ChainId, 20)
+		for i, cctx := range []types.CrossChainTx{cctx1, cctx2, cctx3} {
+			k.SetCrossChainTx(ctx, cctx)
+			k.SetInboundRateLimitedCctx(ctx, cctx, uint64(i+1))
+		}
+
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Equal(t, 2, released)
+		require.Equal(t, []string{cctx3.Index}, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+
+		// the released cctxs are processed, the receiver chain of the sample cctxs is unknown
+		for _, index := range []string{cctx1.Index, cctx2.Index} {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		}
+		cctx, found := k.GetCrossChainTx(ctx, cctx3.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingInboundRateLimit, cctx.CctxStatus.Status)
+
+		state, found := k.GetInboundRateLimiterState(ctx, chain.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 100, state.WindowStartHeight)
+		require.Equal(t, sdkmath.NewUint(90), state.WindowValue)
+
+		// the last held cctx is released once a new window starts
+		ctx = ctx.WithBlockHeight(110)
+		released = k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Equal(t, 1, released)
+		require.Empty(t, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+	})
+
+	t.Run("should not release held inbounds if circuit breaker is tripped", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		k.SetInboundRateLimiterState(ctx, types.InboundRateLimiterState{
+			ChainId:               chain.ChainId,
+			CircuitBreakerTripped: true,
+		})
+
+		cctx := heldInboundCctx(t, "1", chain.ChainId, 1)
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetInboundRateLimitedCctx(ctx, cctx, 1)
+
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Zero(t, released)
+		require.Equal(t, []string{cctx.Index}, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+	})
+
+	t.Run("should remove held inbound if cctx not found", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		cctx := heldInboundCctx(t, "1", chain.ChainId, 1)
+		k.SetInboundRateLimitedCctx(ctx, cctx, 1)
+
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Zero(t, released)
+		require.Empty(t, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+	})
+
+	t.Run("should remove held inbound if cctx no longer held", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		cctx := heldInboundCctx(t, "1", chain.ChainId, 1)
+		cctx.CctxStatus.Status = types.CctxStatus_Aborted
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetInboundRateLimitedCctx(ctx, cctx, 1)
+
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Zero(t, released)
+		require.Empty(t, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+	})
+
+	t.Run("should not release held inbounds if tss not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		cctx := heldInboundCctx(t, "1", chain.ChainId, 1)
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetInboundRateLimitedCctx(ctx, cctx, 1)
+
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Zero(t, released)
+		require.Equal(t, []string{cctx.Index}, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+	})
+
+	t.Run("should release a held inbound larger than the window limit in an empty window", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		k.SetRateLimiterFlags(ctx, types.RateLimiterFlags{InboundRateLimits: []types.ChainRateLimit{limit}})
+		ctx = ctx.WithBlockHeight(100)
+
+		// the window allows 100 azeta
+		cctx1 := heldInboundCctx(t, "1", chain.ChainId, 150)
+		cctx2 := heldInboundCctx(t, "2", chain.ChainId, 1)
+		for i, cctx := range []types.CrossChainTx{cctx1, cctx2} {
+			k.SetCrossChainTx(ctx, cctx)
+			k.SetInboundRateLimitedCctx(ctx, cctx, uint64(i+1))
+		}
+
+		// the large inbound takes up the whole window
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Equal(t, 1, released)
+		require.Equal(t, []string{cctx2.Index}, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+
+		state, found := k.GetInboundRateLimiterState(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, sdkmath.NewUint(150), state.WindowValue)
+
+		// the next one is released once a new window starts
+		ctx = ctx.WithBlockHeight(110)
+		released = k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Equal(t, 1, released)
+		require.Empty(t, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+	})
+
+	t.Run("should abort held inbounds whose release panics", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		authorityMock.On("GetAdditionalChainList", mock.Anything).
+			Run(func(mock.Arguments) { panic("boom") }).
+			Return([]chains.Chain{})
+
+		cctx1 := heldInboundCctx(t, "1", chain.ChainId, 1)
+		cctx2 := heldInboundCctx(t, "2", chain.ChainId, 1)
+		for i, cctx := range []types.CrossChainTx{cctx1, cctx2} {
+			k.SetCrossChainTx(ctx, cctx)
+			k.SetInboundRateLimitedCctx(ctx, cctx, uint64(i+1))
+		}
+
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Equal(t, 2, released)
+		require.Empty(t, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+
+		for _, index := range []string{cctx1.Index, cctx2.Index} {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+			require.Contains(t, cctx.CctxStatus.ErrorMessageAbort, "panic releasing held inbound: boom")
+		}
+	})
+}
+
+func TestKeeper_ReleaseInboundRateLimitedCctxsGasBudget(t *testing.T) {
+	chain := chains.Ethereum
+
+	// newKeeper returns a keeper whose release of a held inbound consumes the given gas
+	newKeeper := func(t *testing.T, gasPerRelease uint64) (*keeper.Keeper, sdk.Context, *int) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		calls := 0
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		authorityMock.On("GetAdditionalChainList", mock.Anything).
+			Run(func(args mock.Arguments) {
+				calls++
+				args.Get(0).(sdk.Context).GasMeter().ConsumeGas(gasPerRelease, "test")
+			}).
+			Return([]chains.Chain{})
+
+		return k, ctx, &calls
+	}
+	setHeldCctxs := func(k *keeper.Keeper, ctx sdk.Context, count int) []types.CrossChainTx {
+		cctxs := make([]types.CrossChainTx, count)
+		for i := range cctxs {
+			cctxs[i] = heldInboundCctx(t, fmt.Sprintf("%d", i), chain.ChainId, 1)
+			k.SetCrossChainTx(ctx, cctxs[i])
+			k.SetInboundRateLimitedCctx(ctx, cctxs[i], uint64(i+1))
+		}
+		return cctxs
+	}
+
+	t.Run("should stop releasing held inbounds once the gas budget is exhausted", func(t *testing.T) {
+		// the budget allows two releases in a block
+		k, ctx, calls := newKeeper(t, keeper.MaxInboundReleaseGasPerBlock*2/5)
+		cctxs := setHeldCctxs(k, ctx, 3)
+
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Equal(t, 2, released)
+		require.Equal(t, []string{cctxs[2].Index}, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+
+		// the release of the last one was attempted and discarded, the cctx is still held
+		require.Equal(t, 3, *calls)
+		cctx, found := k.GetCrossChainTx(ctx, cctxs[2].Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingInboundRateLimit, cctx.CctxStatus.Status)
+
+		// the last one is released in the next block
+		released = k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Equal(t, 1, released)
+		require.Empty(t, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+	})
+
+	t.Run("should abort a held inbound whose release exceeds the whole gas budget", func(t *testing.T) {
+		k, ctx, _ := newKeeper(t, keeper.MaxInboundReleaseGasPerBlock+1)
+		cctxs := setHeldCctxs(k, ctx, 2)
+
+		released := k.ReleaseInboundRateLimitedCctxs(ctx, []chains.Chain{chain})
+		require.Equal(t, 1, released)
+		require.Equal(t, []string{cctxs[1].Index}, k.GetInboundRateLimitedCctxs(ctx, chain.ChainId, 0))
+
+		cctx, found := k.GetCrossChainTx(ctx, cctxs[0].Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		require.Contains(t, cctx.CctxStatus.ErrorMessageAbort, "out of gas releasing held inbound")
+
+		cctx, found = k.GetCrossChainTx(ctx, cctxs[1].Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingInboundRateLimit, cctx.CctxStatus.Status)
+	})
+}
@@ -461,6 +461,74 @@ func TestKeeper_InitiateOutboundProcessCrosschainMsgPassing(t *testing.T) {
 	})
 }
 
+func TestKeeper_InitiateOutboundHeld(t *testing.T) {
+	t.Run("hold the outbound if its value exceeds the held threshold of the receiver chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		receiverChain := getValidEthChain()
+		k.SetGasPrice(ctx, types.GasPrice{
+			ChainId:      receiverChain.ChainId,
+			Prices:       []uint64{100},
+			PriorityFees: []uint64{10},
+		})
+		k.SetRateLimiterFlags(ctx, types.RateLimiterFlags{
+			HeldCctxThresholds: []types.HeldCctxThreshold{
+				{ChainId: receiverChain.ChainId, Threshold: sdkmath.NewUint(1000)},
+			},
+		})
+
+		// call InitiateOutbound, the nonce of the receiver chain is not used
+		cctx := GetERC20Cctx(t, sample.EthAddress(), receiverChain, "", big.NewInt(1001))
+		cctx.InboundParams.CoinType = coin.CoinType_Zeta
+		newStatus, err := k.InitiateOutbound(ctx, keeper.InitiateOutboundConfig{CCTX: cctx, ShouldPayGas: false})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_PendingOutboundHeld, cctx.CctxStatus.Status)
+		require.Equal(t, types.CctxStatus_PendingOutboundHeld, newStatus)
+		require.Equal(t, "100", cctx.GetCurrentOutboundParam().GasPrice)
+		require.Equal(t, []string{cctx.Index}, k.GetHeldCctxs(ctx, receiverChain.ChainId))
+	})
+
+	t.Run("do not hold the outbound if its value doesn't exceed the held threshold", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+		})
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		receiverChain := getValidEthChain()
+		k.SetGasPrice(ctx, types.GasPrice{
+			ChainId:      receiverChain.ChainId,
+			Prices:       []uint64{100},
+			PriorityFees: []uint64{10},
+		})
+		k.SetRateLimiterFlags(ctx, types.RateLimiterFlags{
+			HeldCctxThresholds: []types.HeldCctxThreshold{
+				{ChainId: receiverChain.ChainId, Threshold: sdkmath.NewUint(1000)},
+			},
+		})
+
+		// mock successful UpdateNonce
+		nonce := uint64(1)
+		tss := sample.Tss()
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, receiverChain.ChainId).
+			Return(receiverChain, true)
+		observerMock.On("GetChainNonces", mock.Anything, receiverChain.ChainId).
+			Return(observertypes.ChainNonces{Nonce: nonce}, true)
+		observerMock.On("GetTSS", mock.Anything).
+			Return(tss, true)
+		observerMock.On("GetPendingNonces", mock.Anything, tss.TssPubkey, mock.Anything).
+			Return(observertypes.PendingNonces{NonceHigh: int64(nonce)}, true)
+		observerMock.On("SetChainNonces", mock.Anything, mock.Anything)
+		observerMock.On("SetPendingNonces", mock.Anything, mock.Anything)
+
+		// call InitiateOutbound
+		cctx := GetERC20Cctx(t, sample.EthAddress(), receiverChain, "", big.NewInt(1000))
+		cctx.InboundParams.CoinType = coin.CoinType_Zeta
+		newStatus, err := k.InitiateOutbound(ctx, keeper.InitiateOutboundConfig{CCTX: cctx, ShouldPayGas: false})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_PendingOutbound, newStatus)
+		require.Equal(t, nonce, cctx.GetCurrentOutboundParam().TssNonce)
+		require.Empty(t, k.GetHeldCctxs(ctx, receiverChain.ChainId))
+	})
+}
+
 func TestKeeper_InitiateOutboundFailures(t *testing.T) {
 	t.Run("should fail if chain info can not be found for receiver chain id", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
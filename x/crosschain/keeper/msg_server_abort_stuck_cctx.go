@@ -40,6 +40,11 @@ func (k msgServer) AbortStuckCCTX(
 		return nil, types.ErrStatusNotPending
 	}
 
+	// a held outbound is no longer waiting for release or reject
+	if cctx.CctxStatus.Status == types.CctxStatus_PendingOutboundHeld {
+		k.RemoveHeldCctx(ctx, cctx.GetCurrentOutboundParam().ReceiverChainId, cctx.Index)
+	}
+
 	// update the status
 	k.ProcessAbort(ctx, &cctx, types.StatusMessages{
 		StatusMessage: AbortMessage,
@@ -157,6 +157,44 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 		require.Equal(t, pendingNonces.NonceLow, int64(cctx.GetCurrentOutboundParam().TssNonce+1))
 	})
 
+	t.Run("can abort a held cctx", func(t *testing.T) {
+		// Arrange
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		// create a held cctx
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{
+			Status:        crosschaintypes.CctxStatus_PendingOutboundHeld,
+			StatusMessage: "pending outbound held",
+		}
+		receiverChainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetHeldCctx(ctx, receiverChainID, cctx.Index)
+
+		// abort the cctx
+		msg := crosschaintypes.MsgAbortStuckCCTX{
+			Creator:   admin,
+			CctxIndex: sample.GetCctxIndexFromString("cctx_index"),
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		// Act
+		_, err := msgServer.AbortStuckCCTX(ctx, &msg)
+
+		// Assert
+		require.NoError(t, err)
+		cctxFound, found := k.GetCrossChainTx(ctx, sample.GetCctxIndexFromString("cctx_index"))
+		require.True(t, found)
+		require.Equal(t, crosschaintypes.CctxStatus_Aborted, cctxFound.CctxStatus.Status)
+		require.Empty(t, k.GetHeldCctxs(ctx, receiverChainID))
+	})
+
 	t.Run("cannot abort a cctx in pending outbound if not admin", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
 			UseAuthorityMock: true,
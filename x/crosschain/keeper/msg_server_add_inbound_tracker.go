@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/zeta-chain/node/pkg/chains"
 	authoritytypes "github.com/zeta-chain/node/x/authority/types"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
@@ -22,14 +23,20 @@ func (k msgServer) AddInboundTracker(
 		return nil, observertypes.ErrSupportedChains
 	}
 
-	// only emergency group and observer can submit a tracker
+	// emergency group and observer can submit a tracker without a proof
 	var (
 		isAuthorizedPolicy = k.GetAuthorityKeeper().CheckAuthorization(ctx, msg) == nil
 		isObserver         = k.GetObserverKeeper().IsNonTombstonedObserver(ctx, msg.Creator)
 	)
 
+	// any other sender must prove the inbound against a block header of the light client
 	if !(isAuthorizedPolicy || isObserver) {
-		return nil, errorsmod.Wrapf(authoritytypes.ErrUnauthorized, "Creator %s", msg.Creator)
+		if msg.Proof == nil {
+			return nil, errorsmod.Wrapf(authoritytypes.ErrUnauthorized, "Creator %s", msg.Creator)
+		}
+		if err := verifyProofAndInboundBody(ctx, k, msg); err != nil {
+			return nil, err
+		}
 	}
 
 	// add the inTx tracker
@@ -41,3 +48,37 @@ func (k msgServer) AddInboundTracker(
 
 	return &types.MsgAddInboundTrackerResponse{}, nil
 }
+
+// verifyProofAndInboundBody verifies the proof and the inbound body of the message
+// It verifies if the transaction is a valid inbound transaction
+func verifyProofAndInboundBody(ctx sdk.Context, k msgServer, msg *types.MsgAddInboundTracker) error {
+	txBytes, err := k.GetLightclientKeeper().VerifyProof(ctx, msg.Proof, msg.ChainId, msg.BlockHash, msg.TxIndex)
+	if err != nil {
+		return types.ErrProofVerificationFail.Wrap(err.Error())
+	}
+
+	// get chain params and tss addresses to verify the inbound body
+	chainParams, found := k.GetObserverKeeper().GetChainParamsByChainID(ctx, msg.ChainId)
+	if !found || chainParams == nil {
+		return types.ErrUnsupportedChain.Wrapf("chain params not found for chain %d", msg.ChainId)
+	}
+	// the bitcoin chain id is only used to derive the bitcoin tss address
+	bitcoinChainID := int64(0)
+	if chains.IsBitcoinChain(msg.ChainId, []chains.Chain{}) {
+		bitcoinChainID = msg.ChainId
+	}
+	tss, err := k.GetObserverKeeper().GetTssAddress(ctx, &observertypes.QueryGetTssAddressRequest{
+		BitcoinChainId: bitcoinChainID,
+	})
+	if err != nil {
+		return observertypes.ErrTssNotFound.Wrap(err.Error())
+	}
+	if tss == nil {
+		return observertypes.ErrTssNotFound.Wrap("tss address nil")
+	}
+
+	if err := types.VerifyInboundBody(*msg, txBytes, *chainParams, *tss); err != nil {
+		return types.ErrTxBodyVerificationFail.Wrap(err.Error())
+	}
+	return nil
+}
@@ -1,13 +1,17 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/gagliardetto/solana-go"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/proofs"
+	solanaproofs "github.com/zeta-chain/node/pkg/proofs/solana"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
 	authoritytypes "github.com/zeta-chain/node/x/authority/types"
@@ -131,4 +135,104 @@ func TestMsgServer_AddInboundTracker(t *testing.T) {
 		_, found := k.GetInboundTracker(ctx, chainID, txHash)
 		require.True(t, found)
 	})
+
+	t.Run("fail normal user submit with an invalid proof", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock:   true,
+			UseObserverMock:    true,
+			UseLightclientMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
+		txHash := "string"
+		chainID := chains.SolanaDevnet.ChainId
+
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, mock.Anything).Return(chains.Chain{}, true)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, mock.Anything).Return(false)
+		lightclientMock.On("VerifyProof", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("invalid proof"))
+
+		msg := types.MsgAddInboundTracker{
+			Creator:   sample.AccAddress(),
+			ChainId:   chainID,
+			TxHash:    txHash,
+			CoinType:  coin.CoinType_Gas,
+			Proof:     proofs.NewSolanaProof(&solanaproofs.Proof{}),
+			BlockHash: solana.Hash{}.String(),
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.AddInboundTracker(ctx, &msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+		_, found := k.GetInboundTracker(ctx, chainID, txHash)
+		require.False(t, found)
+	})
+
+	t.Run("normal user add tx tracker with a proof", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock:   true,
+			UseObserverMock:    true,
+			UseLightclientMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
+		chainID := chains.SolanaDevnet.ChainId
+		gateway := sample.SolanaAddress(t)
+		tx, txBytes := signedSolanaTx(t, solana.MustPublicKeyFromBase58(gateway))
+
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, mock.Anything).Return(chains.Chain{}, true)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, mock.Anything).Return(false)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, mock.Anything).
+			Return(&observertypes.ChainParams{GatewayAddress: gateway}, true)
+		observerMock.On("GetTssAddress", mock.Anything, mock.Anything).
+			Return(&observertypes.QueryGetTssAddressResponse{}, nil)
+		lightclientMock.On("VerifyProof", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(txBytes, nil)
+
+		msg := types.MsgAddInboundTracker{
+			Creator:   sample.AccAddress(),
+			ChainId:   chainID,
+			TxHash:    tx.Signatures[0].String(),
+			CoinType:  coin.CoinType_Gas,
+			Proof:     proofs.NewSolanaProof(&solanaproofs.Proof{}),
+			BlockHash: solana.Hash{}.String(),
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.AddInboundTracker(ctx, &msg)
+		require.NoError(t, err)
+		_, found := k.GetInboundTracker(ctx, chainID, msg.TxHash)
+		require.True(t, found)
+
+		// the proven transaction must be the tracked one
+		msg.TxHash = solana.Signature{}.String()
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err = msgServer.AddInboundTracker(ctx, &msg)
+		require.ErrorIs(t, err, types.ErrTxBodyVerificationFail)
+	})
+}
+
+// signedSolanaTx returns a signed transaction invoking the given program
+func signedSolanaTx(t *testing.T, programID solana.PublicKey) (*solana.Transaction, []byte) {
+	privKey, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{solana.NewInstruction(programID, solana.AccountMetaSlice{
+			solana.Meta(privKey.PublicKey()).WRITE().SIGNER(),
+		}, []byte{1})},
+		solana.Hash{},
+		solana.TransactionPayer(privKey.PublicKey()),
+	)
+	require.NoError(t, err)
+	_, err = tx.Sign(func(solana.PublicKey) *solana.PrivateKey { return &privKey })
+	require.NoError(t, err)
+
+	txBytes, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return tx, txBytes
 }
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// RejectHeldCctxMessage is the status message of a held cctx rejected through MsgRejectHeldCctx
+const RejectHeldCctxMessage = "held outbound rejected through MsgRejectHeldCctx"
+
+// RejectHeldCctx rejects the outbound of a cctx held for exceeding the held threshold of its receiver chain,
+// the cctx is processed as a failed outbound and reverted to the sender.
+// Authorized: admin policy emergency.
+func (k msgServer) RejectHeldCctx(
+	goCtx context.Context,
+	msg *types.MsgRejectHeldCctx,
+) (*types.MsgRejectHeldCctxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	cctx, err := k.getHeldCctx(ctx, msg.CctxIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return nil, types.ErrCannotFindTSSKeys
+	}
+
+	// the rejected outbound is processed like a failed outbound, the revert path depends on the cctx
+	receiverChainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+	cctx.SetPendingOutbound(types.StatusMessages{StatusMessage: RejectHeldCctxMessage})
+	err = k.ValidateOutboundObservers(ctx, &cctx, observertypes.BallotStatus_BallotFinalized_FailureObservation, "")
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidStatus, err.Error())
+	}
+
+	k.RemoveHeldCctx(ctx, receiverChainID, cctx.Index)
+	k.SaveCCTXUpdate(ctx, cctx, tss.TssPubkey)
+
+	return &types.MsgRejectHeldCctxResponse{}, nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgServer_RejectHeldCctx(t *testing.T) {
+	chain := chains.Ethereum
+
+	t.Run("can reject a held cctx and revert it", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+			UseFungibleMock:  true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		// a withdrawal from ZetaChain is reverted on ZetaChain
+		cctx := heldOutboundCctx(t, "1", chain.ChainId)
+		cctx.ProtocolContractVersion = types.ProtocolContractVersion_V2
+		cctx.InboundParams.SenderChainId = chains.ZetaChainMainnet.ChainId
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetHeldCctx(ctx, chain.ChainId, cctx.Index)
+		keepertest.MockProcessV2RevertDeposit(fungibleMock, &evmtypes.MsgEthereumTxResponse{}, nil)
+
+		msg := types.NewMsgRejectHeldCctx(sample.AccAddress(), cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.RejectHeldCctx(ctx, msg)
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Reverted, cctx.CctxStatus.Status)
+		require.Len(t, cctx.OutboundParams, 2)
+		require.Empty(t, k.GetHeldCctxs(ctx, chain.ChainId))
+	})
+
+	t.Run("can reject a held cctx and abort it if the revert fails", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		// a deposit and call to a foreign chain can't be reverted on ZetaChain with the v2 protocol
+		cctx := heldOutboundCctx(t, "1", chain.ChainId)
+		cctx.ProtocolContractVersion = types.ProtocolContractVersion_V2
+		cctx.InboundParams.SenderChainId = chains.BitcoinMainnet.ChainId
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetHeldCctx(ctx, chain.ChainId, cctx.Index)
+
+		msg := types.NewMsgRejectHeldCctx(sample.AccAddress(), cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.RejectHeldCctx(ctx, msg)
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		require.Empty(t, k.GetHeldCctxs(ctx, chain.ChainId))
+	})
+
+	t.Run("cannot reject a held cctx if unauthorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		msg := types.NewMsgRejectHeldCctx(sample.AccAddress(), sample.ZetaIndex(t))
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.RejectHeldCctx(ctx, msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot reject a cctx not held", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		cctx := heldOutboundCctx(t, "1", chain.ChainId)
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCrossChainTx(ctx, cctx)
+
+		msg := types.NewMsgRejectHeldCctx(sample.AccAddress(), cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.RejectHeldCctx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidStatus)
+	})
+}
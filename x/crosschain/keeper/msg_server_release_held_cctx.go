@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// ReleaseHeldCctx releases the outbound of a cctx held for exceeding the held threshold of its receiver chain,
+// the outbound nonce is assigned and the cctx is processed by the observers.
+// Authorized: admin policy admin.
+func (k msgServer) ReleaseHeldCctx(
+	goCtx context.Context,
+	msg *types.MsgReleaseHeldCctx,
+) (*types.MsgReleaseHeldCctxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	cctx, err := k.getHeldCctx(ctx, msg.CctxIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return nil, types.ErrCannotFindTSSKeys
+	}
+
+	receiverChainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+	cctx.SetPendingOutbound(types.StatusMessages{StatusMessage: "held outbound released"})
+	if err := k.SetObserverOutboundInfo(ctx, receiverChainID, &cctx); err != nil {
+		return nil, errorsmod.Wrap(types.ErrUnableToSetOutboundInfo, err.Error())
+	}
+
+	k.RemoveHeldCctx(ctx, receiverChainID, cctx.Index)
+	k.SaveCCTXUpdate(ctx, cctx, tss.TssPubkey)
+
+	return &types.MsgReleaseHeldCctxResponse{}, nil
+}
+
+// getHeldCctx returns the cctx with the given index if its outbound is held
+func (k Keeper) getHeldCctx(ctx sdk.Context, cctxIndex string) (types.CrossChainTx, error) {
+	cctx, found := k.GetCrossChainTx(ctx, cctxIndex)
+	if !found {
+		return types.CrossChainTx{}, types.ErrCannotFindCctx
+	}
+
+	if cctx.CctxStatus.Status != types.CctxStatus_PendingOutboundHeld {
+		return types.CrossChainTx{}, errorsmod.Wrapf(
+			types.ErrInvalidStatus,
+			"cctx %s is not held: %s",
+			cctxIndex,
+			cctx.CctxStatus.Status.String(),
+		)
+	}
+
+	return cctx, nil
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// heldOutboundCctx returns a cctx held for exceeding the held threshold of the receiver chain
+func heldOutboundCctx(t *testing.T, index string, chainID int64) types.CrossChainTx {
+	cctx := *sample.CrossChainTx(t, index)
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingOutboundHeld}
+	cctx.OutboundParams = []*types.OutboundParams{sample.OutboundParams(sample.Rand())}
+	cctx.GetCurrentOutboundParam().ReceiverChainId = chainID
+	cctx.GetCurrentOutboundParam().TssNonce = 0
+	return cctx
+}
+
+func TestMsgServer_ReleaseHeldCctx(t *testing.T) {
+	chain := chains.Ethereum
+
+	t.Run("can release a held cctx", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		setSupportedChain(ctx, zk, chain.ChainId)
+		zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{ChainId: chain.ChainId, Nonce: 42})
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   chain.ChainId,
+			NonceLow:  42,
+			NonceHigh: 42,
+			Tss:       tss.TssPubkey,
+		})
+
+		cctx := heldOutboundCctx(t, "1", chain.ChainId)
+		cctx.GetCurrentOutboundParam().TssPubkey = tss.TssPubkey
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetHeldCctx(ctx, chain.ChainId, cctx.Index)
+
+		msg := types.NewMsgReleaseHeldCctx(sample.AccAddress(), cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.ReleaseHeldCctx(ctx, msg)
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.EqualValues(t, 42, cctx.GetCurrentOutboundParam().TssNonce)
+		require.Empty(t, k.GetHeldCctxs(ctx, chain.ChainId))
+
+		nonceToCctx, found := zk.ObserverKeeper.GetNonceToCctx(ctx, tss.TssPubkey, chain.ChainId, 42)
+		require.True(t, found)
+		require.Equal(t, cctx.Index, nonceToCctx.CctxIndex)
+	})
+
+	t.Run("cannot release a held cctx if unauthorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		msg := types.NewMsgReleaseHeldCctx(sample.AccAddress(), sample.ZetaIndex(t))
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.ReleaseHeldCctx(ctx, msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot release a cctx not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		msg := types.NewMsgReleaseHeldCctx(sample.AccAddress(), sample.ZetaIndex(t))
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.ReleaseHeldCctx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrCannotFindCctx)
+	})
+
+	t.Run("cannot release a cctx not held", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		cctx := heldOutboundCctx(t, "1", chain.ChainId)
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		k.SetCrossChainTx(ctx, cctx)
+
+		msg := types.NewMsgReleaseHeldCctx(sample.AccAddress(), cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.ReleaseHeldCctx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidStatus)
+	})
+
+	t.Run("cannot release a held cctx if the outbound info can't be set", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		cctx := heldOutboundCctx(t, "1", chain.ChainId)
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetHeldCctx(ctx, chain.ChainId, cctx.Index)
+
+		msg := types.NewMsgReleaseHeldCctx(sample.AccAddress(), cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.ReleaseHeldCctx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnableToSetOutboundInfo)
+	})
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// ResetInboundCircuitBreaker resets the inbound circuit breaker of a chain, the held inbounds from the chain are
+// released within the inbound rate limit of the chain.
+// Authorized: admin policy operational.
+func (k msgServer) ResetInboundCircuitBreaker(
+	goCtx context.Context,
+	msg *types.MsgResetInboundCircuitBreaker,
+) (*types.MsgResetInboundCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	if _, found := k.zetaObserverKeeper.GetSupportedChainFromChainID(ctx, msg.ChainId); !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidChainID, "chain %d not supported", msg.ChainId)
+	}
+
+	state, found := k.GetInboundRateLimiterState(ctx, msg.ChainId)
+	if !found {
+		state = types.InboundRateLimiterState{ChainId: msg.ChainId}
+	}
+	state.CircuitBreakerTripped = false
+	k.SetInboundRateLimiterState(ctx, state)
+
+	return &types.MsgResetInboundCircuitBreakerResponse{}, nil
+}
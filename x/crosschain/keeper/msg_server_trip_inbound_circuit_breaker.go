@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// TripInboundCircuitBreaker trips the inbound circuit breaker of a chain, the new inbounds from the chain are held
+// until the circuit breaker is reset.
+// Authorized: admin policy emergency.
+func (k msgServer) TripInboundCircuitBreaker(
+	goCtx context.Context,
+	msg *types.MsgTripInboundCircuitBreaker,
+) (*types.MsgTripInboundCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	if _, found := k.zetaObserverKeeper.GetSupportedChainFromChainID(ctx, msg.ChainId); !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidChainID, "chain %d not supported", msg.ChainId)
+	}
+
+	state, found := k.GetInboundRateLimiterState(ctx, msg.ChainId)
+	if !found {
+		state = types.InboundRateLimiterState{ChainId: msg.ChainId}
+	}
+	state.CircuitBreakerTripped = true
+	k.SetInboundRateLimiterState(ctx, state)
+
+	return &types.MsgTripInboundCircuitBreakerResponse{}, nil
+}
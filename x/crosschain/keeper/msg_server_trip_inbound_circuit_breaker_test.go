@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgServer_TripInboundCircuitBreaker(t *testing.T) {
+	chain := chains.Ethereum
+
+	t.Run("can trip inbound circuit breaker", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+			UseObserverMock:  true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, chain.ChainId).Return(chain, true)
+
+		k.SetInboundRateLimiterState(ctx, types.InboundRateLimiterState{
+			ChainId:               chain.ChainId,
+			CircuitBreakerTripped: false,
+			WindowStartHeight:     10,
+		})
+
+		msg := types.NewMsgTripInboundCircuitBreaker(sample.AccAddress(), chain.ChainId)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.TripInboundCircuitBreaker(ctx, msg)
+		require.NoError(t, err)
+
+		state, found := k.GetInboundRateLimiterState(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, true, state.CircuitBreakerTripped)
+		require.EqualValues(t, 10, state.WindowStartHeight)
+	})
+
+	t.Run("can trip inbound circuit breaker if state not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+			UseObserverMock:  true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, chain.ChainId).Return(chain, true)
+
+		msg := types.NewMsgTripInboundCircuitBreaker(sample.AccAddress(), chain.ChainId)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.TripInboundCircuitBreaker(ctx, msg)
+		require.NoError(t, err)
+
+		state, found := k.GetInboundRateLimiterState(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, true, state.CircuitBreakerTripped)
+	})
+
+	t.Run("cannot trip inbound circuit breaker if unauthorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		msg := types.NewMsgTripInboundCircuitBreaker(sample.AccAddress(), chain.ChainId)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.TripInboundCircuitBreaker(ctx, msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot trip inbound circuit breaker if chain not supported", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+			UseObserverMock:  true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, chain.ChainId).Return(chains.Chain{}, false)
+
+		msg := types.NewMsgTripInboundCircuitBreaker(sample.AccAddress(), chain.ChainId)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.TripInboundCircuitBreaker(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidChainID)
+	})
+}
@@ -2,7 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
+	"regexp"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +63,16 @@ func (k msgServer) WhitelistERC20(
 			)
 		}
 
+	case chain.IsSuiChain():
+		if err := validateSuiCoinType(msg.Erc20Address); err != nil {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidAddress,
+				"invalid sui coin type (%s): %s",
+				msg.Erc20Address,
+				err.Error(),
+			)
+		}
+
 	default:
 		return nil, errorsmod.Wrapf(
 			sdkerrors.ErrInvalidChainID,
@@ -197,3 +211,23 @@ func (k msgServer) WhitelistERC20(
 		CctxIndex:    cctx.Index,
 	}, nil
 }
+
+// suiCoinTypeRegex matches a Move coin type "<64 hex chars package address>::<module>::<struct>",
+// optionally with the 0x prefix
+var suiCoinTypeRegex = regexp.MustCompile(`^(0x)?[0-9a-f]{64}::[a-zA-Z_][a-zA-Z0-9_]*::[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// suiGasCoinType is the coin type of SUI, the gas token of the chain
+const suiGasCoinType = "0000000000000000000000000000000000000000000000000000000000000002::sui::SUI"
+
+// validateSuiCoinType checks the coin type is a Move coin type that can be whitelisted
+// it mirrors the coin type validation of the Sui gateway without importing the contracts package
+func validateSuiCoinType(coinType string) error {
+	switch {
+	case strings.TrimPrefix(coinType, "0x") == suiGasCoinType:
+		return errors.New("SUI is the gas coin type")
+	case !suiCoinTypeRegex.MatchString(coinType):
+		return fmt.Errorf("invalid coin type %q", coinType)
+	default:
+		return nil
+	}
+}
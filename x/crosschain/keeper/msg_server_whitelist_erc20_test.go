@@ -48,6 +48,12 @@ func TestKeeper_WhitelistERC20(t *testing.T) {
 			secondTokenAddress: secondTokenAddress,
 			chainID:            getValidSolanaChainID(),
 		},
+		{
+			name:               "can deploy and whitelist a sui coin type",
+			tokenAddress:       "a1ec7fc00a6f40db9693ad1415d0c193ad3906494428cf252621037bd7117e29::usdc::USDC",
+			secondTokenAddress: "a1ec7fc00a6f40db9693ad1415d0c193ad3906494428cf252621037bd7117e29::usdt::USDT",
+			chainID:            getValidSuiChainID(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -218,6 +224,36 @@ func TestKeeper_WhitelistERC20(t *testing.T) {
 		require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
 	})
 
+	t.Run("should fail if invalid sui coin type", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		chainID := getValidSuiChainID()
+		setSupportedChain(ctx, zk, chainID)
+
+		msg := types.MsgWhitelistERC20{
+			Creator:      admin,
+			Erc20Address: "0x2::sui::SUI",
+			ChainId:      chainID,
+			Name:         "foo",
+			Symbol:       "FOO",
+			Decimals:     9,
+			GasLimit:     100000,
+			LiquidityCap: sdkmath.NewUint(1000),
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		_, err := msgServer.WhitelistERC20(ctx, &msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	})
+
 	t.Run("should fail if whitelisting not supported for chain", func(t *testing.T) {
 		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
 			UseAuthorityMock: true,
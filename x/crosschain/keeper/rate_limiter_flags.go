@@ -56,3 +56,35 @@ func (k Keeper) GetRateLimiterAssetRateList(
 	}
 	return flags, assetRates, true
 }
+
+// GetRateLimitBuckets returns the buckets (without usage) of the per-chain and per-asset rate limits of the flags.
+// The rate limits of zrc20s without foreign coin are ignored.
+func (k Keeper) GetRateLimitBuckets(ctx sdk.Context, flags types.RateLimiterFlags) []types.RateLimitBucket {
+	buckets := make([]types.RateLimitBucket, 0, len(flags.ChainRateLimits)+len(flags.AssetRateLimits))
+
+	for _, limit := range flags.ChainRateLimits {
+		buckets = append(buckets, types.RateLimitBucket{
+			ChainId: limit.ChainId,
+			Window:  limit.Window,
+			Rate:    limit.Rate,
+		})
+	}
+
+	for _, limit := range flags.AssetRateLimits {
+		fCoin, found := k.fungibleKeeper.GetForeignCoins(ctx, limit.Zrc20)
+		if !found {
+			continue
+		}
+
+		buckets = append(buckets, types.RateLimitBucket{
+			ChainId:  fCoin.ForeignChainId,
+			Zrc20:    limit.Zrc20,
+			CoinType: fCoin.CoinType,
+			Asset:    strings.ToLower(fCoin.Asset),
+			Window:   limit.Window,
+			Rate:     limit.Rate,
+		})
+	}
+
+	return buckets
+}
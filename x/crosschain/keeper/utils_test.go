@@ -51,6 +51,10 @@ func getValidSolanaChainID() int64 {
 	return chains.SolanaLocalnet.ChainId
 }
 
+func getValidSuiChainID() int64 {
+	return chains.SuiLocalnet.ChainId
+}
+
 // getValidEthChainIDWithIndex get a valid eth chain id with index
 func getValidEthChainIDWithIndex(t *testing.T, index int) int64 {
 	switch index {
@@ -74,7 +74,10 @@ func (k Keeper) ProcessZEVMInboundV2(
 			return err
 		}
 
-		// validate data of the withdrawal event
+		// validate the destination chain and the data of the withdrawal event
+		if err := k.checkChainNotHalted(ctx, foreignCoin.ForeignChainId); err != nil {
+			return err
+		}
 		if err := k.validateZRC20Withdrawal(ctx, foreignCoin.ForeignChainId, foreignCoin.CoinType, value, receiver); err != nil {
 			return err
 		}
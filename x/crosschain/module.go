@@ -179,6 +179,9 @@ func (am AppModule) BeginBlock(c context.Context) error {
 	// error is logged in the function
 	am.keeper.IterateAndUpdateCctxGasPrice(ctx, supportedChains, keeper.CheckAndUpdateCctxGasPrice)
 
+	// release the inbounds held by the inbound rate limiter as long as the rate limits allow it
+	am.keeper.ReleaseInboundRateLimitedCctxs(ctx, supportedChains)
+
 	return nil
 }
 
@@ -16,5 +16,6 @@ func GetAllAuthzZetaclientTxTypes() []string {
 		sdk.MsgTypeURL(&observertypes.MsgVoteTSS{}),
 		sdk.MsgTypeURL(&observertypes.MsgVoteBlame{}),
 		sdk.MsgTypeURL(&observertypes.MsgVoteBlockHeader{}),
+		sdk.MsgTypeURL(&observertypes.MsgVoteHaltChain{}),
 	}
 }
@@ -15,6 +15,7 @@ func TestGetAllAuthzZetaclientTxTypes(t *testing.T) {
 		"/zetachain.zetacore.crosschain.MsgAddOutboundTracker",
 		"/zetachain.zetacore.observer.MsgVoteTSS",
 		"/zetachain.zetacore.observer.MsgVoteBlame",
-		"/zetachain.zetacore.observer.MsgVoteBlockHeader"},
+		"/zetachain.zetacore.observer.MsgVoteBlockHeader",
+		"/zetachain.zetacore.observer.MsgVoteHaltChain"},
 		crosschaintypes.GetAllAuthzZetaclientTxTypes())
 }
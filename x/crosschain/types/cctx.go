@@ -236,6 +236,16 @@ func (m CrossChainTx) SetPendingOutbound(messages StatusMessages) {
 	m.CctxStatus.UpdateStatusAndErrorMessages(CctxStatus_PendingOutbound, messages)
 }
 
+// SetPendingInboundRateLimit sets the CCTX status to PendingInboundRateLimit with the given error message.
+func (m CrossChainTx) SetPendingInboundRateLimit(messages StatusMessages) {
+	m.CctxStatus.UpdateStatusAndErrorMessages(CctxStatus_PendingInboundRateLimit, messages)
+}
+
+// SetPendingOutboundHeld sets the CCTX status to PendingOutboundHeld with the given error message.
+func (m CrossChainTx) SetPendingOutboundHeld(messages StatusMessages) {
+	m.CctxStatus.UpdateStatusAndErrorMessages(CctxStatus_PendingOutboundHeld, messages)
+}
+
 // SetOutboundMined sets the CCTX status to OutboundMined with the given error message.
 func (m CrossChainTx) SetOutboundMined() {
 	m.CctxStatus.UpdateStatusAndErrorMessages(CctxStatus_OutboundMined, StatusMessages{})
@@ -20,6 +20,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAbortStuckCCTX{}, "crosschain/AbortStuckCCTX", nil)
 	cdc.RegisterConcrete(&MsgUpdateRateLimiterFlags{}, "crosschain/UpdateRateLimiterFlags", nil)
 	cdc.RegisterConcrete(&MsgRemoveInboundTracker{}, "crosschain/RemoveInboundTracker", nil)
+	cdc.RegisterConcrete(&MsgTripInboundCircuitBreaker{}, "crosschain/TripInboundCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgResetInboundCircuitBreaker{}, "crosschain/ResetInboundCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgReleaseHeldCctx{}, "crosschain/ReleaseHeldCctx", nil)
+	cdc.RegisterConcrete(&MsgRejectHeldCctx{}, "crosschain/RejectHeldCctx", nil)
 
 	// legacy messages defined for backward compatibility
 	cdc.RegisterConcrete(&MsgAddToInTxTracker{}, "crosschain/AddToInTxTracker", nil)
@@ -44,6 +48,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgAbortStuckCCTX{},
 		&MsgUpdateRateLimiterFlags{},
 		&MsgRemoveInboundTracker{},
+		&MsgTripInboundCircuitBreaker{},
+		&MsgResetInboundCircuitBreaker{},
+		&MsgReleaseHeldCctx{},
+		&MsgRejectHeldCctx{},
 
 		// legacy messages defined for backward compatibility
 		&MsgAddToInTxTracker{},
@@ -34,6 +34,10 @@ const (
 	CctxStatus_PendingRevert   CctxStatus = 4
 	CctxStatus_Reverted        CctxStatus = 5
 	CctxStatus_Aborted         CctxStatus = 6
+	// But the amount can be refunded to zetachain using and admin proposal
+	CctxStatus_PendingInboundRateLimit CctxStatus = 7
+	// the sender chain; processed once released
+	CctxStatus_PendingOutboundHeld CctxStatus = 8
 )
 
 var CctxStatus_name = map[int32]string{
@@ -43,15 +47,19 @@ var CctxStatus_name = map[int32]string{
 	4: "PendingRevert",
 	5: "Reverted",
 	6: "Aborted",
+	7: "PendingInboundRateLimit",
+	8: "PendingOutboundHeld",
 }
 
 var CctxStatus_value = map[string]int32{
-	"PendingInbound":  0,
-	"PendingOutbound": 1,
-	"OutboundMined":   3,
-	"PendingRevert":   4,
-	"Reverted":        5,
-	"Aborted":         6,
+	"PendingInbound":          0,
+	"PendingOutbound":         1,
+	"OutboundMined":           3,
+	"PendingRevert":           4,
+	"Reverted":                5,
+	"Aborted":                 6,
+	"PendingInboundRateLimit": 7,
+	"PendingOutboundHeld":     8,
 }
 
 func (x CctxStatus) String() string {
@@ -880,105 +888,106 @@ func init() {
 }
 
 var fileDescriptor_d4c1966807fb5cb2 = []byte{
-	// 1553 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0xe6, 0x5a, 0x14, 0x45, 0xbe, 0xfc, 0xd0, 0x6a, 0x44, 0xcb, 0x6b, 0xb5, 0xa6, 0x55, 0xa6,
-	0x4e, 0x19, 0x37, 0x26, 0x1b, 0x05, 0x08, 0x8a, 0xde, 0x68, 0x8a, 0x8c, 0xd9, 0x46, 0x1f, 0x58,
-	0x4a, 0x02, 0x1a, 0x14, 0xd8, 0x0c, 0x77, 0x47, 0xe4, 0x40, 0xe4, 0x0e, 0xb1, 0x33, 0x14, 0xa8,
-	0xa0, 0xb7, 0xfe, 0x81, 0xfe, 0x80, 0x1e, 0x7b, 0xe8, 0x4f, 0xc9, 0x31, 0xc7, 0xa2, 0x07, 0xa3,
-	0xb0, 0xff, 0x41, 0x4e, 0x3d, 0xf4, 0x50, 0xcc, 0x17, 0x3f, 0x54, 0x55, 0x52, 0x03, 0x9f, 0x38,
-	0xf3, 0x7e, 0xf3, 0x9d, 0xf7, 0x79, 0x66, 0x16, 0xf6, 0xbf, 0x25, 0x02, 0x87, 0x43, 0x4c, 0xe3,
-	0x86, 0x5a, 0xb1, 0x84, 0x34, 0xc2, 0x84, 0x71, 0xae, 0x65, 0x6a, 0x19, 0xa8, 0x75, 0x20, 0x66,
-	0xf5, 0x49, 0xc2, 0x04, 0x43, 0xcf, 0xe6, 0x3e, 0x75, 0xeb, 0x53, 0x5f, 0xf8, 0xec, 0x96, 0x07,
-	0x6c, 0xc0, 0x94, 0x65, 0x43, 0xae, 0xb4, 0xd3, 0xee, 0xc7, 0xb7, 0x24, 0x9a, 0x5c, 0x0e, 0x1a,
-	0x21, 0x93, 0x69, 0x18, 0x8d, 0xb5, 0x5d, 0xf5, 0x5f, 0xeb, 0x50, 0xec, 0xc6, 0x7d, 0x36, 0x8d,
-	0xa3, 0x13, 0x9c, 0xe0, 0x31, 0x47, 0x3b, 0x90, 0xe1, 0x24, 0x8e, 0x48, 0xe2, 0x39, 0x7b, 0x4e,
-	0x2d, 0xe7, 0x9b, 0x1d, 0xfa, 0x18, 0x36, 0xf5, 0xca, 0xd4, 0x47, 0x23, 0xef, 0xd1, 0x9e, 0x53,
-	0x5b, 0xf3, 0x8b, 0x5a, 0xdc, 0x92, 0xd2, 0x6e, 0x84, 0x7e, 0x02, 0x39, 0x31, 0x0b, 0x58, 0x42,
-	0x07, 0x34, 0xf6, 0xd6, 0x54, 0x88, 0xac, 0x98, 0x1d, 0xab, 0x3d, 0x7a, 0x0d, 0x39, 0x99, 0x3c,
-	0x10, 0xd7, 0x13, 0xe2, 0xa5, 0xf7, 0x9c, 0x5a, 0x69, 0xff, 0x45, 0xfd, 0x96, 0xff, 0x37, 0xb9,
-	0x1c, 0xd4, 0x55, 0x95, 0x2d, 0x46, 0xe3, 0xd3, 0xeb, 0x09, 0xf1, 0xb3, 0xa1, 0x59, 0xa1, 0x32,
-	0xac, 0x63, 0xce, 0x89, 0xf0, 0xd6, 0x55, 0x70, 0xbd, 0x41, 0x5f, 0x40, 0x06, 0x8f, 0xd9, 0x34,
-	0x16, 0x5e, 0x46, 0x8a, 0x5f, 0x57, 0xbe, 0x7b, 0xfb, 0x3c, 0xf5, 0x8f, 0xb7, 0xcf, 0x77, 0x42,
-	0xc6, 0xc7, 0x8c, 0xf3, 0xe8, 0xb2, 0x4e, 0x59, 0x63, 0x8c, 0xc5, 0xb0, 0x7e, 0x46, 0x63, 0xe1,
-	0x1b, 0x6b, 0xf4, 0x11, 0x14, 0x59, 0x9f, 0x93, 0xe4, 0x8a, 0x44, 0xc1, 0x10, 0xf3, 0xa1, 0xb7,
-	0xa1, 0xa2, 0x16, 0xac, 0xf0, 0x0d, 0xe6, 0x43, 0xf4, 0x6b, 0xf0, 0xe6, 0x46, 0x64, 0x26, 0x48,
-	0x12, 0xe3, 0x51, 0x30, 0x24, 0x74, 0x30, 0x14, 0x5e, 0x76, 0xcf, 0xa9, 0xa5, 0xfd, 0x1d, 0xab,
-	0x6f, 0x1b, 0xf5, 0x1b, 0xa5, 0x45, 0x3f, 0x83, 0x42, 0x1f, 0x8f, 0x46, 0x4c, 0x04, 0x34, 0x8e,
-	0xc8, 0xcc, 0xcb, 0xa9, 0xe8, 0x79, 0x2d, 0xeb, 0x4a, 0x11, 0xda, 0x87, 0xc7, 0x17, 0x34, 0xc6,
-	0x23, 0xfa, 0x2d, 0x89, 0x02, 0xd9, 0x01, 0x1b, 0x19, 0x54, 0xe4, 0xed, 0xb9, 0xf2, 0x6b, 0x22,
-	0xb0, 0x09, 0x4b, 0x61, 0x47, 0xcc, 0x02, 0xa3, 0xc1, 0x82, 0xb2, 0x38, 0xe0, 0x02, 0x8b, 0x29,
-	0xf7, 0xf2, 0xaa, 0xa9, 0x9f, 0xd7, 0xef, 0x1c, 0x9a, 0xfa, 0xe9, 0xac, 0xb3, 0xe4, 0xdb, 0x53,
-	0xae, 0x7e, 0x59, 0xdc, 0x22, 0x45, 0xaf, 0x60, 0x9b, 0xf2, 0x60, 0x79, 0x32, 0x43, 0x3c, 0x1a,
-	0x79, 0x85, 0x3d, 0xa7, 0x96, 0xf5, 0x5d, 0xca, 0x5b, 0x52, 0xa3, 0x0e, 0xbf, 0x85, 0x47, 0x23,
-	0x74, 0x00, 0x19, 0x53, 0x49, 0x59, 0x55, 0xf2, 0xe9, 0x3d, 0x95, 0x98, 0xe1, 0x33, 0x25, 0x18,
-	0x5f, 0xf4, 0x07, 0xd8, 0x0a, 0x59, 0x7c, 0x41, 0x93, 0xb1, 0xfe, 0x73, 0x63, 0x16, 0x11, 0xef,
-	0xb1, 0x0a, 0xd8, 0xb8, 0x27, 0x60, 0x6b, 0xc9, 0xef, 0x90, 0x45, 0xc4, 0x77, 0xc3, 0x1b, 0x92,
-	0xdf, 0xa6, 0xb3, 0x45, 0xb7, 0x5c, 0xfd, 0x06, 0x4a, 0xb2, 0xa3, 0xcd, 0x30, 0x94, 0x83, 0x40,
-	0xe3, 0x01, 0x3a, 0x82, 0x6d, 0xdc, 0x67, 0x89, 0xb0, 0xe7, 0x60, 0x06, 0xca, 0x79, 0xd0, 0x40,
-	0x6d, 0x19, 0x57, 0x15, 0x53, 0x39, 0x56, 0xcf, 0x21, 0x2f, 0x7b, 0x72, 0x3c, 0x91, 0x99, 0xb9,
-	0x44, 0xc6, 0x00, 0xf3, 0x60, 0x44, 0xc7, 0x54, 0x07, 0x4d, 0xfb, 0xd9, 0x01, 0xe6, 0x5f, 0xc9,
-	0x3d, 0x7a, 0x09, 0x5b, 0x94, 0x07, 0x38, 0xe9, 0x53, 0x91, 0xe0, 0xe4, 0x5a, 0x37, 0xf9, 0x91,
-	0x6a, 0xf2, 0x26, 0xe5, 0x4d, 0x2b, 0x97, 0xf1, 0xaa, 0x7f, 0xd9, 0x80, 0xd2, 0xf1, 0x54, 0x2c,
-	0xa3, 0x76, 0x17, 0xb2, 0x09, 0x09, 0x09, 0xbd, 0x9a, 0xe3, 0x76, 0xbe, 0x47, 0x9f, 0x80, 0x6b,
-	0xd7, 0xfa, 0x04, 0xbb, 0x16, 0xba, 0x9b, 0x56, 0x6e, 0xc1, 0xbb, 0x82, 0xcf, 0xb5, 0x1f, 0x87,
-	0xcf, 0x05, 0x12, 0xd3, 0xff, 0x17, 0x12, 0x25, 0x71, 0x70, 0x1e, 0xc4, 0x2c, 0x0e, 0x89, 0xc2,
-	0x76, 0xda, 0xcf, 0x0a, 0xce, 0x8f, 0xe4, 0x7e, 0xb5, 0x77, 0x99, 0x1b, 0xbd, 0x33, 0xca, 0x49,
-	0x42, 0x43, 0x62, 0xf0, 0x2b, 0x95, 0x27, 0x72, 0x8f, 0x6a, 0xe0, 0x1a, 0x25, 0x4b, 0xa8, 0xb8,
-	0x0e, 0x2e, 0x08, 0xf1, 0x9e, 0x28, 0x9b, 0x92, 0xb6, 0x51, 0xe2, 0x0e, 0x21, 0x08, 0x41, 0x5a,
-	0x31, 0x40, 0x56, 0x69, 0xd5, 0xfa, 0x21, 0xf8, 0xbd, 0x8b, 0x1c, 0xe0, 0x4e, 0x72, 0x78, 0x0a,
-	0xb2, 0xcc, 0x60, 0xca, 0x49, 0xa4, 0xd0, 0x92, 0xf6, 0x37, 0x06, 0x98, 0x9f, 0x71, 0x12, 0xa1,
-	0x43, 0xd8, 0x26, 0x17, 0x17, 0x24, 0x14, 0xf4, 0x8a, 0x04, 0x8b, 0x3f, 0xf7, 0x58, 0x75, 0xf4,
-	0x99, 0xe9, 0xe8, 0xe3, 0xff, 0xee, 0x68, 0x57, 0x4e, 0xe2, 0xdc, 0xf3, 0x4b, 0xdb, 0x84, 0xfa,
-	0xcd, 0x70, 0xba, 0x91, 0x3b, 0x2a, 0xe9, 0x8a, 0xbd, 0xee, 0xe8, 0x33, 0x00, 0x79, 0x16, 0x93,
-	0x69, 0xff, 0x92, 0x5c, 0x2b, 0x4e, 0xc9, 0xf9, 0xf2, 0x74, 0x4e, 0x94, 0xe0, 0x0e, 0xfa, 0x29,
-	0x7c, 0x68, 0xfa, 0x39, 0x84, 0x82, 0x84, 0x42, 0xc0, 0x34, 0x88, 0x3c, 0x6f, 0xcf, 0xa9, 0xe5,
-	0xf7, 0x5f, 0xde, 0x47, 0x02, 0x0b, 0xd8, 0xf9, 0xf9, 0x70, 0x09, 0x83, 0xb7, 0x12, 0xcb, 0xd3,
-	0x0f, 0x4b, 0x2c, 0x7f, 0x5a, 0x83, 0x8c, 0xa9, 0xbe, 0x39, 0x67, 0x43, 0x47, 0xe5, 0xf8, 0xe4,
-	0xbe, 0x1c, 0xa1, 0x98, 0xdd, 0xa0, 0xc2, 0x17, 0x50, 0xd2, 0xab, 0x60, 0x4c, 0x38, 0xc7, 0x03,
-	0xa2, 0xb0, 0x9b, 0xf3, 0x8b, 0x5a, 0x7a, 0xa8, 0x85, 0xf2, 0x1e, 0x23, 0x49, 0xc2, 0x92, 0xb9,
-	0x55, 0x46, 0xdf, 0x63, 0x4a, 0x68, 0x8d, 0x3e, 0x83, 0xf2, 0x08, 0x73, 0x71, 0x36, 0x89, 0xb0,
-	0x20, 0x81, 0xa0, 0x63, 0xc2, 0x05, 0x1e, 0x4f, 0x14, 0xd2, 0xd7, 0xfc, 0xed, 0x85, 0xee, 0xd4,
-	0xaa, 0x50, 0x0d, 0x24, 0xfd, 0x48, 0x6a, 0xf3, 0xc9, 0xc5, 0x34, 0x8e, 0x48, 0xa4, 0x60, 0xad,
-	0x59, 0x69, 0x59, 0x8c, 0x7e, 0x09, 0x5b, 0x61, 0x42, 0xb0, 0x64, 0xcf, 0x45, 0xe4, 0x75, 0x15,
-	0xd9, 0x35, 0x8a, 0x45, 0xd8, 0x5f, 0x41, 0x79, 0xa5, 0xdc, 0x20, 0x21, 0x57, 0x24, 0x11, 0x06,
-	0xbd, 0x68, 0xb9, 0x6a, 0x5f, 0x69, 0xd4, 0x08, 0xaf, 0x78, 0x28, 0xbe, 0x35, 0x60, 0xdd, 0x5a,
-	0x76, 0x50, 0x65, 0x55, 0x7f, 0x70, 0xa0, 0xa8, 0x5d, 0xed, 0xd9, 0xbf, 0x80, 0x92, 0xce, 0x12,
-	0xe0, 0x28, 0x4a, 0x08, 0xe7, 0x86, 0x29, 0x8b, 0x5a, 0xda, 0xd4, 0x42, 0xf4, 0x73, 0x28, 0xe9,
-	0x89, 0x8b, 0x6d, 0x51, 0x9a, 0x86, 0xd5, 0x1c, 0x1e, 0xc7, 0xa6, 0x9c, 0x8f, 0xa0, 0xa8, 0x0a,
-	0x98, 0xc7, 0xd2, 0x4f, 0x9d, 0x82, 0x12, 0xda, 0x50, 0x8b, 0x8c, 0xf6, 0x54, 0x64, 0xef, 0x0a,
-	0x36, 0xa3, 0x3d, 0x96, 0x37, 0x92, 0xa0, 0x95, 0xd9, 0x02, 0x9a, 0xeb, 0x0f, 0xe2, 0x4e, 0x13,
-	0xde, 0xe2, 0xb6, 0xfa, 0xef, 0x34, 0x14, 0x16, 0x17, 0xf2, 0xe9, 0x0c, 0x79, 0xb0, 0xa1, 0x7a,
-	0xcf, 0xec, 0xb5, 0x60, 0xb7, 0xf2, 0x19, 0xa5, 0x29, 0x4d, 0x8f, 0x93, 0xde, 0xa0, 0x63, 0xc8,
-	0xa9, 0xab, 0xef, 0x82, 0x10, 0x6e, 0x6a, 0xd8, 0xbf, 0xbb, 0x86, 0x1f, 0xde, 0x3e, 0x77, 0xaf,
-	0xf1, 0x78, 0xf4, 0x9b, 0xea, 0xdc, 0xb1, 0xea, 0x67, 0xe5, 0xba, 0x43, 0x08, 0x47, 0xbf, 0x80,
-	0xcd, 0x84, 0x8c, 0xf0, 0x35, 0x89, 0x6e, 0x4c, 0x66, 0xc9, 0x88, 0x6d, 0x13, 0x3a, 0x90, 0x0f,
-	0x43, 0x31, 0xb3, 0x44, 0x92, 0x55, 0x38, 0x7f, 0x71, 0x0f, 0x5e, 0x0c, 0x56, 0x20, 0x9c, 0xe3,
-	0x06, 0xf5, 0xa0, 0x44, 0xf5, 0x9b, 0x22, 0x98, 0xa8, 0xbb, 0x51, 0x71, 0x76, 0xfe, 0xa1, 0x0f,
-	0x11, 0x7d, 0x9f, 0xfa, 0x45, 0xba, 0xf2, 0x28, 0x3e, 0x87, 0x4d, 0x66, 0x2e, 0x5c, 0x1b, 0x15,
-	0xf6, 0xd6, 0x6a, 0xf9, 0xfd, 0x57, 0xf7, 0x44, 0x5d, 0xbd, 0xa6, 0xfd, 0x12, 0x5b, 0xbd, 0xb6,
-	0x13, 0x78, 0xaa, 0xde, 0xe1, 0x21, 0x1b, 0x05, 0x21, 0x8b, 0x45, 0x82, 0x43, 0x11, 0x5c, 0x91,
-	0x84, 0x53, 0x16, 0x9b, 0xa7, 0xdc, 0x17, 0xf7, 0x64, 0x38, 0x31, 0xfe, 0x2d, 0xe3, 0x7e, 0xae,
-	0xbd, 0xfd, 0x27, 0x93, 0xdb, 0x15, 0xe8, 0xf7, 0xf3, 0xa1, 0xb4, 0x9c, 0x5a, 0x78, 0x50, 0x83,
-	0x56, 0xc0, 0xf4, 0x3a, 0x2d, 0xa7, 0xc2, 0x0e, 0xb2, 0x11, 0xbe, 0xfc, 0x23, 0xc0, 0x82, 0xc1,
-	0x10, 0x82, 0xd2, 0x09, 0x89, 0x23, 0x1a, 0x0f, 0x4c, 0x6f, 0xdd, 0x14, 0xda, 0x86, 0x4d, 0x23,
-	0xb3, 0x9d, 0x71, 0x1d, 0xb4, 0x05, 0x45, 0xbb, 0x3b, 0xa4, 0x31, 0x89, 0xdc, 0x35, 0x29, 0x32,
-	0x76, 0x3a, 0xad, 0x9b, 0x46, 0x05, 0xc8, 0xea, 0x35, 0x89, 0xdc, 0x75, 0x94, 0x87, 0x8d, 0xa6,
-	0x7e, 0x70, 0xb9, 0x99, 0xdd, 0xf4, 0xdf, 0xfe, 0x5a, 0x71, 0x5e, 0xfe, 0x0e, 0xca, 0xb7, 0x5d,
-	0x2c, 0xc8, 0x85, 0xc2, 0x11, 0x13, 0x1d, 0xfb, 0x8c, 0x76, 0x53, 0xa8, 0x08, 0xb9, 0xc5, 0xd6,
-	0x91, 0x91, 0xdb, 0x33, 0x12, 0x4e, 0x65, 0xb0, 0x47, 0x26, 0xd8, 0xa7, 0xe0, 0xde, 0x24, 0x7c,
-	0x94, 0x85, 0x74, 0xaf, 0xd9, 0x69, 0xbb, 0x29, 0xb9, 0xea, 0x34, 0x7b, 0xa7, 0xae, 0x63, 0xac,
-	0xbf, 0x99, 0x7f, 0x45, 0x99, 0x9c, 0x79, 0xd8, 0xe8, 0x9d, 0xb5, 0x5a, 0xed, 0x5e, 0xcf, 0x4d,
-	0xa1, 0x0a, 0xec, 0x76, 0x8f, 0x7a, 0x67, 0x9d, 0x4e, 0xb7, 0xd5, 0x6d, 0x1f, 0x9d, 0x06, 0x07,
-	0xed, 0x93, 0xe3, 0x5e, 0xf7, 0xf4, 0xd8, 0x0f, 0x3a, 0xed, 0xb6, 0xeb, 0xa0, 0x9f, 0x82, 0xd7,
-	0x3d, 0x3a, 0x6f, 0x7e, 0xd5, 0x3d, 0x08, 0xfc, 0x76, 0xab, 0xdd, 0x3d, 0x6f, 0xfb, 0x41, 0xf3,
-	0xe0, 0xc0, 0x97, 0xde, 0xb6, 0x9e, 0x06, 0x3c, 0xf9, 0x1f, 0x27, 0x8d, 0x32, 0xf0, 0xe8, 0xfc,
-	0x33, 0x37, 0xa5, 0x7e, 0xf7, 0x6d, 0x49, 0xaf, 0xbf, 0xfc, 0xee, 0x5d, 0xc5, 0xf9, 0xfe, 0x5d,
-	0xc5, 0xf9, 0xe7, 0xbb, 0x8a, 0xf3, 0xe7, 0xf7, 0x95, 0xd4, 0xf7, 0xef, 0x2b, 0xa9, 0xbf, 0xbf,
-	0xaf, 0xa4, 0xbe, 0x7e, 0x35, 0xa0, 0x62, 0x38, 0xed, 0xd7, 0x43, 0x36, 0x56, 0x1f, 0x87, 0xaf,
-	0xf4, 0x77, 0x62, 0xcc, 0x22, 0xd2, 0x98, 0x2d, 0x7f, 0x8e, 0xca, 0x57, 0x20, 0xef, 0x67, 0xd4,
-	0x20, 0x7d, 0xfe, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0xf5, 0x9b, 0x4a, 0xbc, 0x0e, 0x00,
-	0x00,
+	// 1571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x4f, 0x2b, 0xc9,
+	0x15, 0x76, 0x83, 0x31, 0xf6, 0xf1, 0x83, 0xa6, 0x30, 0xd0, 0x97, 0xc9, 0xf5, 0x25, 0x9e, 0x30,
+	0xf1, 0x90, 0xc1, 0xce, 0x30, 0xd2, 0x28, 0xca, 0xce, 0x18, 0x7b, 0x70, 0x32, 0x3c, 0xd4, 0x06,
+	0xa4, 0x8c, 0x22, 0xf5, 0x94, 0xbb, 0x0b, 0xbb, 0x84, 0xdd, 0x65, 0x75, 0x95, 0x91, 0x99, 0x6d,
+	0xfe, 0x40, 0x7e, 0x40, 0x96, 0x59, 0x64, 0x9d, 0x5f, 0x31, 0xcb, 0x59, 0x46, 0x59, 0x5c, 0x45,
+	0xf7, 0xfe, 0x83, 0x59, 0x65, 0x91, 0x45, 0x54, 0x8f, 0xf6, 0x83, 0x10, 0x20, 0xd1, 0x5d, 0xb9,
+	0xce, 0x39, 0x75, 0x1e, 0x3e, 0x75, 0xbe, 0xaf, 0xaa, 0xe1, 0xf0, 0x3b, 0x22, 0xb0, 0xdf, 0xc7,
+	0x34, 0xac, 0xa9, 0x15, 0x8b, 0x48, 0xcd, 0x8f, 0x18, 0xe7, 0x5a, 0xa7, 0x96, 0x9e, 0x5a, 0x7b,
+	0x62, 0x52, 0x1d, 0x45, 0x4c, 0x30, 0xf4, 0x7a, 0xea, 0x53, 0x8d, 0x7d, 0xaa, 0x33, 0x9f, 0x9d,
+	0x62, 0x8f, 0xf5, 0x98, 0xda, 0x59, 0x93, 0x2b, 0xed, 0xb4, 0xf3, 0xc9, 0x23, 0x89, 0x46, 0xb7,
+	0xbd, 0x9a, 0xcf, 0x64, 0x1a, 0x46, 0x43, 0xbd, 0xaf, 0xfc, 0xcf, 0x15, 0xc8, 0xb7, 0xc3, 0x2e,
+	0x1b, 0x87, 0xc1, 0x05, 0x8e, 0xf0, 0x90, 0xa3, 0x2d, 0x48, 0x71, 0x12, 0x06, 0x24, 0x72, 0xac,
+	0x5d, 0xab, 0x92, 0x71, 0x8d, 0x84, 0x3e, 0x81, 0x35, 0xbd, 0x32, 0xf5, 0xd1, 0xc0, 0x59, 0xda,
+	0xb5, 0x2a, 0xcb, 0x6e, 0x5e, 0xab, 0x1b, 0x52, 0xdb, 0x0e, 0xd0, 0x47, 0x90, 0x11, 0x13, 0x8f,
+	0x45, 0xb4, 0x47, 0x43, 0x67, 0x59, 0x85, 0x48, 0x8b, 0xc9, 0xb9, 0x92, 0xd1, 0x11, 0x64, 0x64,
+	0x72, 0x4f, 0xdc, 0x8f, 0x88, 0x93, 0xdc, 0xb5, 0x2a, 0x85, 0xc3, 0xbd, 0xea, 0x23, 0xff, 0x6f,
+	0x74, 0xdb, 0xab, 0xaa, 0x2a, 0x1b, 0x8c, 0x86, 0x97, 0xf7, 0x23, 0xe2, 0xa6, 0x7d, 0xb3, 0x42,
+	0x45, 0x58, 0xc1, 0x9c, 0x13, 0xe1, 0xac, 0xa8, 0xe0, 0x5a, 0x40, 0x5f, 0x42, 0x0a, 0x0f, 0xd9,
+	0x38, 0x14, 0x4e, 0x4a, 0xaa, 0x8f, 0x4a, 0xdf, 0xbf, 0x7d, 0x93, 0xf8, 0xfb, 0xdb, 0x37, 0x5b,
+	0x3e, 0xe3, 0x43, 0xc6, 0x79, 0x70, 0x5b, 0xa5, 0xac, 0x36, 0xc4, 0xa2, 0x5f, 0xbd, 0xa2, 0xa1,
+	0x70, 0xcd, 0x6e, 0xf4, 0x31, 0xe4, 0x59, 0x97, 0x93, 0xe8, 0x8e, 0x04, 0x5e, 0x1f, 0xf3, 0xbe,
+	0xb3, 0xaa, 0xa2, 0xe6, 0x62, 0xe5, 0x09, 0xe6, 0x7d, 0xf4, 0x2b, 0x70, 0xa6, 0x9b, 0xc8, 0x44,
+	0x90, 0x28, 0xc4, 0x03, 0xaf, 0x4f, 0x68, 0xaf, 0x2f, 0x9c, 0xf4, 0xae, 0x55, 0x49, 0xba, 0x5b,
+	0xb1, 0xbd, 0x69, 0xcc, 0x27, 0xca, 0x8a, 0x7e, 0x0a, 0xb9, 0x2e, 0x1e, 0x0c, 0x98, 0xf0, 0x68,
+	0x18, 0x90, 0x89, 0x93, 0x51, 0xd1, 0xb3, 0x5a, 0xd7, 0x96, 0x2a, 0x74, 0x08, 0x9b, 0x37, 0x34,
+	0xc4, 0x03, 0xfa, 0x1d, 0x09, 0x3c, 0xd9, 0x81, 0x38, 0x32, 0xa8, 0xc8, 0x1b, 0x53, 0xe3, 0x37,
+	0x44, 0x60, 0x13, 0x96, 0xc2, 0x96, 0x98, 0x78, 0xc6, 0x82, 0x05, 0x65, 0xa1, 0xc7, 0x05, 0x16,
+	0x63, 0xee, 0x64, 0x55, 0x53, 0xbf, 0xa8, 0x3e, 0x39, 0x34, 0xd5, 0xcb, 0x49, 0x6b, 0xce, 0xb7,
+	0xa3, 0x5c, 0xdd, 0xa2, 0x78, 0x44, 0x8b, 0x0e, 0x60, 0x83, 0x72, 0x6f, 0x7e, 0x32, 0x7d, 0x3c,
+	0x18, 0x38, 0xb9, 0x5d, 0xab, 0x92, 0x76, 0x6d, 0xca, 0x1b, 0xd2, 0xa2, 0x0e, 0xbf, 0x81, 0x07,
+	0x03, 0x74, 0x0c, 0x29, 0x53, 0x49, 0x51, 0x55, 0xf2, 0xd9, 0x33, 0x95, 0x98, 0xe1, 0x33, 0x25,
+	0x18, 0x5f, 0xf4, 0x7b, 0x58, 0xf7, 0x59, 0x78, 0x43, 0xa3, 0xa1, 0xfe, 0x73, 0x43, 0x16, 0x10,
+	0x67, 0x53, 0x05, 0xac, 0x3d, 0x13, 0xb0, 0x31, 0xe7, 0x77, 0xca, 0x02, 0xe2, 0xda, 0xfe, 0x03,
+	0xcd, 0x6f, 0x92, 0xe9, 0xbc, 0x5d, 0x2c, 0x7f, 0x0b, 0x05, 0xd9, 0xd1, 0xba, 0xef, 0xcb, 0x41,
+	0xa0, 0x61, 0x0f, 0x9d, 0xc1, 0x06, 0xee, 0xb2, 0x48, 0xc4, 0xe7, 0x60, 0x06, 0xca, 0x7a, 0xd1,
+	0x40, 0xad, 0x1b, 0x57, 0x15, 0x53, 0x39, 0x96, 0xaf, 0x21, 0x2b, 0x7b, 0x72, 0x3e, 0x92, 0x99,
+	0xb9, 0x44, 0x46, 0x0f, 0x73, 0x6f, 0x40, 0x87, 0x54, 0x07, 0x4d, 0xba, 0xe9, 0x1e, 0xe6, 0x5f,
+	0x4b, 0x19, 0xed, 0xc3, 0x3a, 0xe5, 0x1e, 0x8e, 0xba, 0x54, 0x44, 0x38, 0xba, 0xd7, 0x4d, 0x5e,
+	0x52, 0x4d, 0x5e, 0xa3, 0xbc, 0x1e, 0xeb, 0x65, 0xbc, 0xf2, 0x9f, 0x56, 0xa1, 0x70, 0x3e, 0x16,
+	0xf3, 0xa8, 0xdd, 0x81, 0x74, 0x44, 0x7c, 0x42, 0xef, 0xa6, 0xb8, 0x9d, 0xca, 0xe8, 0x53, 0xb0,
+	0xe3, 0xb5, 0x3e, 0xc1, 0x76, 0x0c, 0xdd, 0xb5, 0x58, 0x1f, 0x83, 0x77, 0x01, 0x9f, 0xcb, 0xff,
+	0x1f, 0x3e, 0x67, 0x48, 0x4c, 0xfe, 0x4f, 0x48, 0x94, 0xc4, 0xc1, 0xb9, 0x17, 0xb2, 0xd0, 0x27,
+	0x0a, 0xdb, 0x49, 0x37, 0x2d, 0x38, 0x3f, 0x93, 0xf2, 0x62, 0xef, 0x52, 0x0f, 0x7a, 0x67, 0x8c,
+	0xa3, 0x88, 0xfa, 0xc4, 0xe0, 0x57, 0x1a, 0x2f, 0xa4, 0x8c, 0x2a, 0x60, 0x1b, 0x23, 0x8b, 0xa8,
+	0xb8, 0xf7, 0x6e, 0x08, 0x71, 0xb6, 0xd5, 0x9e, 0x82, 0xde, 0xa3, 0xd4, 0x2d, 0x42, 0x10, 0x82,
+	0xa4, 0x62, 0x80, 0xb4, 0xb2, 0xaa, 0xf5, 0x4b, 0xf0, 0xfb, 0x14, 0x39, 0xc0, 0x93, 0xe4, 0xf0,
+	0x0a, 0x64, 0x99, 0xde, 0x98, 0x93, 0x40, 0xa1, 0x25, 0xe9, 0xae, 0xf6, 0x30, 0xbf, 0xe2, 0x24,
+	0x40, 0xa7, 0xb0, 0x41, 0x6e, 0x6e, 0x88, 0x2f, 0xe8, 0x1d, 0xf1, 0x66, 0x7f, 0x6e, 0x53, 0x75,
+	0xf4, 0xb5, 0xe9, 0xe8, 0xe6, 0x7f, 0x76, 0xb4, 0x2d, 0x27, 0x71, 0xea, 0xf9, 0x55, 0xdc, 0x84,
+	0xea, 0xc3, 0x70, 0xba, 0x91, 0x5b, 0x2a, 0xe9, 0xc2, 0x7e, 0xdd, 0xd1, 0xd7, 0x00, 0xf2, 0x2c,
+	0x46, 0xe3, 0xee, 0x2d, 0xb9, 0x57, 0x9c, 0x92, 0x71, 0xe5, 0xe9, 0x5c, 0x28, 0xc5, 0x13, 0xf4,
+	0x93, 0xfb, 0xd0, 0xf4, 0x73, 0x0a, 0x39, 0x09, 0x05, 0x8f, 0x69, 0x10, 0x39, 0xce, 0xae, 0x55,
+	0xc9, 0x1e, 0xee, 0x3f, 0x47, 0x02, 0x33, 0xd8, 0xb9, 0x59, 0x7f, 0x26, 0x3c, 0x4e, 0x2c, 0xaf,
+	0x3e, 0x2c, 0xb1, 0xfc, 0x61, 0x19, 0x52, 0xa6, 0xfa, 0xfa, 0x94, 0x0d, 0x2d, 0x95, 0xe3, 0xd3,
+	0xe7, 0x72, 0xf8, 0x62, 0xf2, 0x80, 0x0a, 0xf7, 0xa0, 0xa0, 0x57, 0xde, 0x90, 0x70, 0x8e, 0x7b,
+	0x44, 0x61, 0x37, 0xe3, 0xe6, 0xb5, 0xf6, 0x54, 0x2b, 0xe5, 0x3d, 0x46, 0xa2, 0x88, 0x45, 0xd3,
+	0x5d, 0x29, 0x7d, 0x8f, 0x29, 0x65, 0xbc, 0xe9, 0x73, 0x28, 0x0e, 0x30, 0x17, 0x57, 0xa3, 0x00,
+	0x0b, 0xe2, 0x09, 0x3a, 0x24, 0x5c, 0xe0, 0xe1, 0x48, 0x21, 0x7d, 0xd9, 0xdd, 0x98, 0xd9, 0x2e,
+	0x63, 0x13, 0xaa, 0x80, 0xa4, 0x1f, 0x49, 0x6d, 0x2e, 0xb9, 0x19, 0x87, 0x01, 0x09, 0x9c, 0xe4,
+	0x94, 0x95, 0xe6, 0xd5, 0xe8, 0x17, 0xb0, 0xee, 0x47, 0x04, 0x4b, 0xf6, 0x9c, 0x45, 0x5e, 0x51,
+	0x91, 0x6d, 0x63, 0x98, 0x85, 0xfd, 0x25, 0x14, 0x17, 0xca, 0xf5, 0x22, 0x72, 0x47, 0x22, 0x61,
+	0xd0, 0x8b, 0xe6, 0xab, 0x76, 0x95, 0x45, 0x8d, 0xf0, 0x82, 0x87, 0xe2, 0x5b, 0x03, 0xd6, 0xf5,
+	0x79, 0x07, 0x55, 0x56, 0xf9, 0x47, 0x0b, 0xf2, 0xda, 0x35, 0x3e, 0xfb, 0x3d, 0x28, 0xe8, 0x2c,
+	0x1e, 0x0e, 0x82, 0x88, 0x70, 0x6e, 0x98, 0x32, 0xaf, 0xb5, 0x75, 0xad, 0x44, 0x3f, 0x83, 0x82,
+	0x9e, 0xb8, 0x30, 0x2e, 0x4a, 0xd3, 0xb0, 0x9a, 0xc3, 0xf3, 0xd0, 0x94, 0xf3, 0x31, 0xe4, 0x55,
+	0x01, 0xd3, 0x58, 0xfa, 0xa9, 0x93, 0x53, 0xca, 0x38, 0xd4, 0x2c, 0x63, 0x7c, 0x2a, 0xb2, 0x77,
+	0xb9, 0x38, 0x63, 0x7c, 0x2c, 0x27, 0x60, 0x6b, 0xc5, 0x1c, 0x34, 0x57, 0x5e, 0xc4, 0x9d, 0x26,
+	0x7c, 0x8c, 0xdb, 0xf2, 0xbf, 0x92, 0x90, 0x9b, 0x5d, 0xc8, 0x97, 0x13, 0xe4, 0xc0, 0xaa, 0xea,
+	0x3d, 0x8b, 0xaf, 0x85, 0x58, 0x94, 0xcf, 0x28, 0x4d, 0x69, 0x7a, 0x9c, 0xb4, 0x80, 0xce, 0x21,
+	0xa3, 0xae, 0xbe, 0x1b, 0x42, 0xb8, 0xa9, 0xe1, 0xf0, 0xe9, 0x1a, 0x7e, 0x7c, 0xfb, 0xc6, 0xbe,
+	0xc7, 0xc3, 0xc1, 0xaf, 0xcb, 0x53, 0xc7, 0xb2, 0x9b, 0x96, 0xeb, 0x16, 0x21, 0x1c, 0xfd, 0x1c,
+	0xd6, 0x22, 0x32, 0xc0, 0xf7, 0x24, 0x78, 0x30, 0x99, 0x05, 0xa3, 0x8e, 0x9b, 0xd0, 0x82, 0xac,
+	0xef, 0x8b, 0x49, 0x4c, 0x24, 0x69, 0x85, 0xf3, 0xbd, 0x67, 0xf0, 0x62, 0xb0, 0x02, 0xfe, 0x14,
+	0x37, 0xa8, 0x03, 0x05, 0xaa, 0xdf, 0x14, 0xde, 0x48, 0xdd, 0x8d, 0x8a, 0xb3, 0xb3, 0x2f, 0x7d,
+	0x88, 0xe8, 0xfb, 0xd4, 0xcd, 0xd3, 0x79, 0x11, 0x5d, 0xc3, 0x1a, 0x1b, 0x8b, 0x85, 0xa8, 0xb0,
+	0xbb, 0x5c, 0xc9, 0x1e, 0x1e, 0x3c, 0x13, 0x75, 0xf1, 0x9a, 0x76, 0x0b, 0x6c, 0x41, 0x46, 0x11,
+	0xbc, 0x52, 0xef, 0x70, 0x9f, 0x0d, 0x3c, 0x9f, 0x85, 0x22, 0xc2, 0xbe, 0xf0, 0xee, 0x48, 0xc4,
+	0x29, 0x0b, 0xcd, 0x53, 0xee, 0xcb, 0x67, 0x32, 0x5c, 0x18, 0xff, 0x86, 0x71, 0xbf, 0xd6, 0xde,
+	0xee, 0xf6, 0xe8, 0x71, 0x03, 0xfa, 0xdd, 0x74, 0x28, 0x63, 0x4e, 0xcd, 0xbd, 0xa8, 0x41, 0x0b,
+	0x60, 0x3a, 0x4a, 0xca, 0xa9, 0x88, 0x07, 0xd9, 0x28, 0xf7, 0xff, 0x6a, 0x01, 0xcc, 0x28, 0x0c,
+	0x21, 0x28, 0x5c, 0x90, 0x30, 0xa0, 0x61, 0xcf, 0x34, 0xd7, 0x4e, 0xa0, 0x0d, 0x58, 0x33, 0xba,
+	0xb8, 0x35, 0xb6, 0x85, 0xd6, 0x21, 0x1f, 0x4b, 0xa7, 0x34, 0x24, 0x81, 0xbd, 0x2c, 0x55, 0x66,
+	0x9f, 0xce, 0x6b, 0x27, 0x51, 0x0e, 0xd2, 0x7a, 0x4d, 0x02, 0x7b, 0x05, 0x65, 0x61, 0xb5, 0xae,
+	0x5f, 0x5c, 0x76, 0x0a, 0x7d, 0x04, 0xdb, 0x8b, 0x99, 0x5c, 0x2c, 0x88, 0x82, 0x84, 0xbd, 0x8a,
+	0xb6, 0x61, 0xe3, 0x41, 0xca, 0x13, 0x32, 0x08, 0xec, 0xf4, 0x4e, 0xf2, 0x2f, 0x7f, 0x2e, 0x59,
+	0xfb, 0xbf, 0x85, 0xe2, 0x63, 0xf7, 0x11, 0xb2, 0x21, 0x77, 0xc6, 0x44, 0x2b, 0x7e, 0x7d, 0xdb,
+	0x09, 0x94, 0x87, 0xcc, 0x4c, 0xb4, 0x64, 0x3d, 0xcd, 0x09, 0xf1, 0xc7, 0xb2, 0x84, 0x25, 0x13,
+	0xec, 0x33, 0xb0, 0x1f, 0xde, 0x13, 0x28, 0x0d, 0xc9, 0x4e, 0xbd, 0xd5, 0xb4, 0x13, 0x72, 0xd5,
+	0xaa, 0x77, 0x2e, 0x6d, 0xcb, 0xec, 0xfe, 0x76, 0xfa, 0xf1, 0x65, 0x72, 0x66, 0x61, 0xb5, 0x73,
+	0xd5, 0x68, 0x34, 0x3b, 0x1d, 0x3b, 0x81, 0x4a, 0xb0, 0xd3, 0x3e, 0xeb, 0x5c, 0xb5, 0x5a, 0xed,
+	0x46, 0xbb, 0x79, 0x76, 0xe9, 0x1d, 0x37, 0x2f, 0xce, 0x3b, 0xed, 0xcb, 0x73, 0xd7, 0x6b, 0x35,
+	0x9b, 0xb6, 0x85, 0x7e, 0x02, 0x4e, 0xfb, 0xec, 0xba, 0xfe, 0x75, 0xfb, 0xd8, 0x73, 0x9b, 0x8d,
+	0x66, 0xfb, 0xba, 0xe9, 0x7a, 0xf5, 0xe3, 0x63, 0x57, 0x7a, 0xc7, 0xf5, 0xd4, 0x60, 0xfb, 0xbf,
+	0x0c, 0x08, 0x4a, 0xc1, 0xd2, 0xf5, 0xe7, 0x76, 0x42, 0xfd, 0x1e, 0xc6, 0x25, 0x1d, 0x7d, 0xf5,
+	0xfd, 0xbb, 0x92, 0xf5, 0xc3, 0xbb, 0x92, 0xf5, 0x8f, 0x77, 0x25, 0xeb, 0x8f, 0xef, 0x4b, 0x89,
+	0x1f, 0xde, 0x97, 0x12, 0x7f, 0x7b, 0x5f, 0x4a, 0x7c, 0x73, 0xd0, 0xa3, 0xa2, 0x3f, 0xee, 0x56,
+	0x7d, 0x36, 0x54, 0xdf, 0x94, 0x07, 0xfa, 0xf3, 0x32, 0x64, 0x01, 0xa9, 0x4d, 0xe6, 0xbf, 0x62,
+	0xe5, 0xe3, 0x91, 0x77, 0x53, 0x6a, 0xfe, 0xbe, 0xf8, 0xf7, 0x00, 0xa3, 0xbf, 0x57, 0xd3, 0xf3,
+	0x0e, 0x00, 0x00,
 }
 
 func (m *InboundParams) Marshal() (dAtA []byte, err error) {
@@ -50,6 +50,16 @@ func (gs GenesisState) Validate() error {
 		gasPriceIndexMap[elem.Index] = true
 	}
 
+	// Check for duplicated chain in inbound rate limiter states
+	inboundRateLimiterStateMap := make(map[int64]bool)
+
+	for _, elem := range gs.InboundRateLimiterStates {
+		if _, ok := inboundRateLimiterStateMap[elem.ChainId]; ok {
+			return fmt.Errorf("duplicated chain %d for inboundRateLimiterState", elem.ChainId)
+		}
+		inboundRateLimiterStateMap[elem.ChainId] = true
+	}
+
 	return gs.RateLimiterFlags.Validate()
 }
 
@@ -25,16 +25,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the crosschain module's genesis state.
 type GenesisState struct {
-	OutboundTrackerList   []OutboundTracker   `protobuf:"bytes,2,rep,name=outboundTrackerList,proto3" json:"outboundTrackerList"`
-	GasPriceList          []*GasPrice         `protobuf:"bytes,5,rep,name=gasPriceList,proto3" json:"gasPriceList,omitempty"`
-	CrossChainTxs         []*CrossChainTx     `protobuf:"bytes,7,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs,omitempty"`
-	LastBlockHeightList   []*LastBlockHeight  `protobuf:"bytes,8,rep,name=lastBlockHeightList,proto3" json:"lastBlockHeightList,omitempty"`
-	InboundHashToCctxList []InboundHashToCctx `protobuf:"bytes,9,rep,name=inboundHashToCctxList,proto3" json:"inboundHashToCctxList"`
-	InboundTrackerList    []InboundTracker    `protobuf:"bytes,11,rep,name=inbound_tracker_list,json=inboundTrackerList,proto3" json:"inbound_tracker_list"`
-	ZetaAccounting        ZetaAccounting      `protobuf:"bytes,12,opt,name=zeta_accounting,json=zetaAccounting,proto3" json:"zeta_accounting"`
-	FinalizedInbounds     []string            `protobuf:"bytes,16,rep,name=FinalizedInbounds,proto3" json:"FinalizedInbounds,omitempty"`
-	RateLimiterFlags      RateLimiterFlags    `protobuf:"bytes,17,opt,name=rate_limiter_flags,json=rateLimiterFlags,proto3" json:"rate_limiter_flags"`
-	Counter               uint64              `protobuf:"varint,18,opt,name=counter,proto3" json:"counter,omitempty"`
+	OutboundTrackerList      []OutboundTracker         `protobuf:"bytes,2,rep,name=outboundTrackerList,proto3" json:"outboundTrackerList"`
+	GasPriceList             []*GasPrice               `protobuf:"bytes,5,rep,name=gasPriceList,proto3" json:"gasPriceList,omitempty"`
+	CrossChainTxs            []*CrossChainTx           `protobuf:"bytes,7,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs,omitempty"`
+	LastBlockHeightList      []*LastBlockHeight        `protobuf:"bytes,8,rep,name=lastBlockHeightList,proto3" json:"lastBlockHeightList,omitempty"`
+	InboundHashToCctxList    []InboundHashToCctx       `protobuf:"bytes,9,rep,name=inboundHashToCctxList,proto3" json:"inboundHashToCctxList"`
+	InboundTrackerList       []InboundTracker          `protobuf:"bytes,11,rep,name=inbound_tracker_list,json=inboundTrackerList,proto3" json:"inbound_tracker_list"`
+	ZetaAccounting           ZetaAccounting            `protobuf:"bytes,12,opt,name=zeta_accounting,json=zetaAccounting,proto3" json:"zeta_accounting"`
+	FinalizedInbounds        []string                  `protobuf:"bytes,16,rep,name=FinalizedInbounds,proto3" json:"FinalizedInbounds,omitempty"`
+	RateLimiterFlags         RateLimiterFlags          `protobuf:"bytes,17,opt,name=rate_limiter_flags,json=rateLimiterFlags,proto3" json:"rate_limiter_flags"`
+	Counter                  uint64                    `protobuf:"varint,18,opt,name=counter,proto3" json:"counter,omitempty"`
+	InboundRateLimiterStates []InboundRateLimiterState `protobuf:"bytes,19,rep,name=inbound_rate_limiter_states,json=inboundRateLimiterStates,proto3" json:"inbound_rate_limiter_states"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -140,6 +141,13 @@ func (m *GenesisState) GetCounter() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetInboundRateLimiterStates() []InboundRateLimiterState {
+	if m != nil {
+		return m.InboundRateLimiterStates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.crosschain.GenesisState")
 }
@@ -149,41 +157,42 @@ func init() {
 }
 
 var fileDescriptor_547615497292ea23 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0x12, 0x41,
-	0x14, 0xc7, 0xc1, 0xd6, 0xd6, 0x4e, 0xab, 0xb6, 0xd3, 0x9a, 0x6c, 0x48, 0x5c, 0x89, 0x17, 0x49,
-	0x2a, 0x8b, 0x69, 0xd5, 0x78, 0x15, 0x92, 0x52, 0x23, 0x89, 0xba, 0x72, 0x6a, 0x4c, 0xc6, 0x61,
-	0x98, 0xee, 0x4e, 0xba, 0xdd, 0x21, 0x33, 0x8f, 0x04, 0xf9, 0x14, 0x7e, 0xac, 0x1e, 0x7b, 0xec,
-	0xc9, 0x18, 0xf8, 0x22, 0x66, 0x67, 0x07, 0xec, 0x02, 0xd9, 0xe5, 0xf6, 0x78, 0xbc, 0xff, 0xff,
-	0xf7, 0xb2, 0xff, 0x79, 0xe8, 0x78, 0xcc, 0x81, 0xb2, 0x90, 0x8a, 0xb8, 0x61, 0x2a, 0xa9, 0x78,
-	0x83, 0x29, 0xa9, 0x75, 0xda, 0x0b, 0x78, 0xcc, 0xb5, 0xd0, 0xde, 0x40, 0x49, 0x90, 0xf8, 0xf9,
-	0x7c, 0xd8, 0x9b, 0x0d, 0x7b, 0xff, 0x87, 0x2b, 0x27, 0xf9, 0x5e, 0xa6, 0x24, 0xa6, 0x26, 0x30,
-	0x4a, 0x2d, 0x2b, 0xf5, 0x02, 0x3e, 0xd5, 0x64, 0xa0, 0x04, 0xe3, 0x76, 0xfc, 0x43, 0xfe, 0xb8,
-	0x88, 0x7b, 0x72, 0x18, 0xf7, 0x49, 0x48, 0x75, 0x48, 0x40, 0x12, 0xc6, 0xe6, 0xa0, 0xd3, 0xf5,
-	0x94, 0xa0, 0x28, 0xbb, 0xe2, 0xca, 0x8a, 0xde, 0xe5, 0x8b, 0x22, 0xaa, 0x81, 0xf4, 0x22, 0xc9,
-	0xae, 0x48, 0xc8, 0x45, 0x10, 0x82, 0x95, 0xbd, 0xcd, 0x97, 0xc9, 0x21, 0xac, 0x82, 0xbd, 0xcf,
-	0x57, 0x29, 0x0a, 0x9c, 0x44, 0xe2, 0x5a, 0x00, 0x57, 0xe4, 0x32, 0xa2, 0x81, 0x4d, 0xa5, 0x72,
-	0x14, 0xc8, 0x40, 0x9a, 0xb2, 0x91, 0x54, 0x69, 0xf7, 0xe5, 0xdd, 0x16, 0xda, 0x6b, 0xa7, 0xe9,
-	0x7d, 0x07, 0x0a, 0x1c, 0x5f, 0xa2, 0xc3, 0x19, 0xb8, 0x9b, 0x72, 0x3b, 0x42, 0x83, 0xf3, 0xa0,
-	0xba, 0x51, 0xdb, 0x3d, 0xf1, 0xbc, 0xdc, 0x68, 0xbd, 0x2f, 0x59, 0x65, 0x73, 0xf3, 0xe6, 0xcf,
-	0x8b, 0x92, 0xbf, 0xca, 0x10, 0x7f, 0x46, 0x7b, 0x01, 0xd5, 0x5f, 0x93, 0xd0, 0x0c, 0xe0, 0xa1,
-	0x01, 0xbc, 0x2a, 0x00, 0xb4, 0xad, 0xc4, 0xcf, 0x88, 0xf1, 0x37, 0xf4, 0xb8, 0x95, 0x0c, 0xb5,
-	0x92, 0xa1, 0xee, 0x48, 0x3b, 0xdb, 0xc6, 0xed, 0xb8, 0xc0, 0xed, 0xbe, 0xc6, 0xcf, 0x3a, 0xe0,
-	0x9f, 0xe8, 0x30, 0xc9, 0xad, 0x99, 0xc4, 0x76, 0x6e, 0x52, 0x33, 0x6b, 0x3e, 0x5a, 0xeb, 0x3b,
-	0x74, 0xb2, 0x4a, 0x7f, 0x95, 0x15, 0x8e, 0xd0, 0x33, 0xfb, 0x9c, 0xce, 0xa9, 0x0e, 0xbb, 0xb2,
-	0xc5, 0x60, 0x64, 0x18, 0x3b, 0x86, 0xf1, 0xa6, 0x80, 0xf1, 0x69, 0x51, 0x6b, 0xbf, 0xf6, 0x6a,
-	0x53, 0xcc, 0xd1, 0xd1, 0xc2, 0xe3, 0x25, 0x51, 0x02, 0xdb, 0x35, 0xb0, 0xfa, 0x7a, 0xb0, 0x6c,
-	0xae, 0x58, 0xc4, 0x4b, 0xb1, 0xfe, 0x40, 0x4f, 0x13, 0x3d, 0xa1, 0x8c, 0xc9, 0x61, 0x0c, 0x22,
-	0x0e, 0x9c, 0xbd, 0x6a, 0x79, 0x0d, 0xc2, 0x05, 0x07, 0xfa, 0x71, 0x2e, 0xb2, 0x84, 0x27, 0xe3,
-	0x4c, 0x17, 0xbf, 0x46, 0x07, 0x67, 0x22, 0xa6, 0x91, 0x18, 0xf3, 0xbe, 0x5d, 0x49, 0x3b, 0xfb,
-	0xd5, 0x8d, 0xda, 0x8e, 0xbf, 0xfc, 0x07, 0x66, 0x08, 0x2f, 0x5f, 0x83, 0x73, 0x60, 0xd6, 0x69,
-	0x14, 0xac, 0xe3, 0x53, 0xe0, 0x9d, 0x54, 0x77, 0x96, 0xc8, 0xec, 0x42, 0xfb, 0x6a, 0xa1, 0x8f,
-	0x1d, 0xb4, 0x6d, 0xd6, 0xe3, 0xca, 0xc1, 0xd5, 0x72, 0x6d, 0xd3, 0x9f, 0xfd, 0x6c, 0xb6, 0x6f,
-	0x26, 0x6e, 0xf9, 0x76, 0xe2, 0x96, 0xff, 0x4e, 0xdc, 0xf2, 0xef, 0xa9, 0x5b, 0xba, 0x9d, 0xba,
-	0xa5, 0xbb, 0xa9, 0x5b, 0xba, 0xa8, 0x07, 0x02, 0xc2, 0x61, 0xcf, 0x63, 0xf2, 0xda, 0xdc, 0x70,
-	0x3d, 0x3d, 0xdd, 0x58, 0xf6, 0x79, 0x63, 0x74, 0xff, 0x98, 0xe1, 0xd7, 0x80, 0xeb, 0xde, 0x96,
-	0x39, 0xd5, 0xd3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x17, 0x12, 0x52, 0x85, 0x05, 0x00,
-	0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xd9, 0x60, 0xcc, 0x2b, 0xb0, 0xb9, 0x43, 0x8a, 0x8a, 0x08, 0x15, 0x17, 0x2a, 0x8d,
+	0xa6, 0x68, 0x83, 0x89, 0x2b, 0xad, 0xb4, 0x0e, 0x51, 0x09, 0x08, 0x3d, 0x4d, 0x48, 0xc6, 0x75,
+	0xbd, 0xc4, 0x5a, 0x16, 0x57, 0xb6, 0x2b, 0x95, 0xf2, 0x25, 0xf8, 0x58, 0x3b, 0xee, 0x82, 0xc4,
+	0x09, 0xa1, 0xf6, 0x8b, 0x20, 0x3b, 0x6e, 0x69, 0xda, 0x2a, 0xcd, 0xed, 0xc5, 0x79, 0xbf, 0x3f,
+	0x7a, 0x3f, 0xfb, 0x81, 0xa3, 0x31, 0x55, 0x98, 0x84, 0x98, 0xc5, 0x0d, 0x53, 0x71, 0x41, 0x1b,
+	0x44, 0x70, 0x29, 0x93, 0xb3, 0x80, 0xc6, 0x54, 0x32, 0xe9, 0x0d, 0x04, 0x57, 0x1c, 0x3e, 0x9d,
+	0x37, 0x7b, 0xb3, 0x66, 0xef, 0x7f, 0x73, 0xe5, 0x38, 0x9b, 0xcb, 0x94, 0xc8, 0xd4, 0x48, 0x8d,
+	0x12, 0xca, 0x4a, 0x7d, 0x83, 0x3e, 0x96, 0x68, 0x20, 0x18, 0xa1, 0xb6, 0xfd, 0x6d, 0x76, 0x3b,
+	0x8b, 0x7b, 0x7c, 0x18, 0xf7, 0x51, 0x88, 0x65, 0x88, 0x14, 0x47, 0x84, 0xcc, 0x85, 0x4e, 0xf2,
+	0x21, 0x95, 0xc0, 0xe4, 0x8a, 0x0a, 0x0b, 0x7a, 0x93, 0x0d, 0x8a, 0xb0, 0x54, 0xa8, 0x17, 0x71,
+	0x72, 0x85, 0x42, 0xca, 0x82, 0x50, 0x59, 0xd8, 0xeb, 0x6c, 0x18, 0x1f, 0xaa, 0x75, 0x62, 0xa7,
+	0xd9, 0x28, 0x81, 0x15, 0x45, 0x11, 0xbb, 0x66, 0x8a, 0x0a, 0x74, 0x19, 0xe1, 0xc0, 0xa6, 0x52,
+	0x39, 0x0c, 0x78, 0xc0, 0x4d, 0xd9, 0xd0, 0x55, 0x72, 0xfa, 0xfc, 0xd7, 0x0e, 0x28, 0xb5, 0x93,
+	0xf4, 0xbe, 0x28, 0xac, 0x28, 0xbc, 0x04, 0xe5, 0x99, 0x70, 0x37, 0xd1, 0xed, 0x30, 0xa9, 0x9c,
+	0x3b, 0xd5, 0xad, 0xda, 0xde, 0xb1, 0xe7, 0x65, 0x46, 0xeb, 0x7d, 0x4c, 0x23, 0x9b, 0xdb, 0x37,
+	0x7f, 0x9e, 0x15, 0xfc, 0x75, 0x84, 0xf0, 0x03, 0x28, 0x05, 0x58, 0x7e, 0xd2, 0xa1, 0x19, 0x81,
+	0xbb, 0x46, 0xe0, 0xc5, 0x06, 0x81, 0xb6, 0x85, 0xf8, 0x29, 0x30, 0xfc, 0x0c, 0x1e, 0xb4, 0x74,
+	0x53, 0x4b, 0x37, 0x75, 0x47, 0xd2, 0xd9, 0x31, 0x6c, 0x47, 0x1b, 0xd8, 0x16, 0x31, 0x7e, 0x9a,
+	0x01, 0x7e, 0x03, 0x65, 0x9d, 0x5b, 0x53, 0xc7, 0x76, 0x6e, 0x52, 0x33, 0x36, 0xef, 0xe7, 0x9a,
+	0x43, 0x27, 0x8d, 0xf4, 0xd7, 0x51, 0xc1, 0x08, 0x3c, 0xb6, 0xd7, 0xe9, 0x1c, 0xcb, 0xb0, 0xcb,
+	0x5b, 0x44, 0x8d, 0x8c, 0xc6, 0xae, 0xd1, 0x78, 0xb5, 0x41, 0xe3, 0xfd, 0x32, 0xd6, 0x4e, 0x7b,
+	0x3d, 0x29, 0xa4, 0xe0, 0x70, 0xe9, 0xf2, 0xa2, 0x48, 0x8b, 0xed, 0x19, 0xb1, 0x7a, 0x3e, 0xb1,
+	0x74, 0xae, 0x90, 0xc5, 0x2b, 0xb1, 0x7e, 0x05, 0x8f, 0x34, 0x1e, 0x61, 0x42, 0xf8, 0x30, 0x56,
+	0x2c, 0x0e, 0x9c, 0x52, 0xb5, 0x98, 0x43, 0xe1, 0x82, 0x2a, 0xfc, 0x6e, 0x0e, 0xb2, 0x0a, 0x0f,
+	0xc7, 0xa9, 0x53, 0xf8, 0x12, 0x1c, 0x9c, 0xb1, 0x18, 0x47, 0x6c, 0x4c, 0xfb, 0xd6, 0x92, 0x74,
+	0xf6, 0xab, 0x5b, 0xb5, 0x5d, 0x7f, 0xf5, 0x07, 0x24, 0x00, 0xae, 0xbe, 0x06, 0xe7, 0xc0, 0xd8,
+	0x69, 0x6c, 0xb0, 0xe3, 0x63, 0x45, 0x3b, 0x09, 0xee, 0x4c, 0xc3, 0xac, 0xa1, 0x7d, 0xb1, 0x74,
+	0x0e, 0x1d, 0xb0, 0x63, 0xec, 0x51, 0xe1, 0xc0, 0x6a, 0xb1, 0xb6, 0xed, 0xcf, 0x3e, 0xe1, 0x0f,
+	0xf0, 0x64, 0x36, 0xf1, 0x94, 0x0d, 0xa9, 0xdf, 0x99, 0x74, 0xca, 0x66, 0xf0, 0xa7, 0xf9, 0x06,
+	0xbf, 0x60, 0xc7, 0x3c, 0x53, 0x6b, 0xc7, 0x61, 0xeb, 0x7f, 0xcb, 0x66, 0xfb, 0x66, 0xe2, 0x16,
+	0x6f, 0x27, 0x6e, 0xf1, 0xef, 0xc4, 0x2d, 0xfe, 0x9c, 0xba, 0x85, 0xdb, 0xa9, 0x5b, 0xf8, 0x3d,
+	0x75, 0x0b, 0x17, 0xf5, 0x80, 0xa9, 0x70, 0xd8, 0xf3, 0x08, 0xbf, 0x36, 0x0b, 0xa4, 0x9e, 0xec,
+	0x8d, 0x98, 0xf7, 0x69, 0x63, 0xb4, 0xb8, 0x49, 0xd4, 0xf7, 0x01, 0x95, 0xbd, 0x7b, 0x66, 0x4f,
+	0x9c, 0xfc, 0x1b, 0x00, 0x37, 0x16, 0x9a, 0x66, 0x02, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +215,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InboundRateLimiterStates) > 0 {
+		for iNdEx := len(m.InboundRateLimiterStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundRateLimiterStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	if m.Counter != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Counter))
 		i--
@@ -399,6 +424,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.Counter != 0 {
 		n += 2 + sovGenesis(uint64(m.Counter))
 	}
+	if len(m.InboundRateLimiterStates) > 0 {
+		for _, e := range m.InboundRateLimiterStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -758,6 +789,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRateLimiterStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundRateLimiterStates = append(m.InboundRateLimiterStates, InboundRateLimiterState{})
+			if err := m.InboundRateLimiterStates[len(m.InboundRateLimiterStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -105,6 +105,20 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated inboundRateLimiterStates",
+			genState: &types.GenesisState{
+				InboundRateLimiterStates: []types.InboundRateLimiterState{
+					{
+						ChainId: 1,
+					},
+					{
+						ChainId: 1,
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -67,6 +68,15 @@ const (
 	ZetaAccountingKey = "ZetaAccounting-value-"
 
 	RateLimiterFlagsKey = "RateLimiterFlags-value-"
+
+	// InboundRateLimiterStateKey is the prefix to store the inbound rate limiter state of each chain
+	InboundRateLimiterStateKey = "InboundRateLimiterState-value-"
+
+	// InboundRateLimitQueueKey is the prefix to store the cctxs held by the inbound rate limiter
+	InboundRateLimitQueueKey = "InboundRateLimitQueue-value-"
+
+	// HeldCctxKey is the prefix to store the cctxs held until released or rejected by the authority
+	HeldCctxKey = "HeldCctx-value-"
 )
 
 // OutboundTrackerKey returns the store key to retrieve a OutboundTracker from the index fields
@@ -82,6 +92,26 @@ func OutboundTrackerKey(
 	return key
 }
 
+// InboundRateLimitChainKey returns the store key of the inbound rate limiter state of a chain,
+// it's also the store key prefix of the cctxs of the chain held by the inbound rate limiter
+func InboundRateLimitChainKey(chainID int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(chainID)) // #nosec G115 chain id is positive
+}
+
+// InboundRateLimitQueueStoreKey returns the store key of a held cctx,
+// the cctxs of a chain are ordered by the height they are held at
+func InboundRateLimitQueueStoreKey(chainID int64, heldHeight uint64, cctxIndex string) []byte {
+	key := InboundRateLimitChainKey(chainID)
+	key = append(key, sdk.Uint64ToBigEndian(heldHeight)...)
+	return append(key, []byte(cctxIndex)...)
+}
+
+// HeldCctxStoreKey returns the store key of a held cctx, the cctxs are grouped by receiver chain
+func HeldCctxStoreKey(chainID int64, cctxIndex string) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(chainID)) // #nosec G115 chain id is positive
+	return append(key, []byte(cctxIndex)...)
+}
+
 func (m CrossChainTx) LogIdentifierForCCTX() string {
 	if len(m.OutboundParams) == 0 {
 		return fmt.Sprintf("%s-%d", m.InboundParams.Sender, m.InboundParams.SenderChainId)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRejectHeldCctx = "RejectHeldCctx"
+
+var _ sdk.Msg = &MsgRejectHeldCctx{}
+
+func NewMsgRejectHeldCctx(creator string, cctxIndex string) *MsgRejectHeldCctx {
+	return &MsgRejectHeldCctx{
+		Creator:   creator,
+		CctxIndex: cctxIndex,
+	}
+}
+
+func (msg *MsgRejectHeldCctx) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRejectHeldCctx) Type() string {
+	return TypeMsgRejectHeldCctx
+}
+
+func (msg *MsgRejectHeldCctx) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRejectHeldCctx) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRejectHeldCctx) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.CctxIndex) != CCTXIndexLength {
+		return ErrInvalidIndexValue
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgRejectHeldCctx_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgRejectHeldCctx
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgRejectHeldCctx("invalid_address", "cctx_index"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid cctx index",
+			msg:  types.NewMsgRejectHeldCctx(sample.AccAddress(), "cctx_index"),
+			err:  types.ErrInvalidIndexValue,
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgRejectHeldCctx(sample.AccAddress(), sample.GetCctxIndexFromString("test")),
+			err:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRejectHeldCctx_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgRejectHeldCctx
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgRejectHeldCctx(signer, "cctx_index"),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgRejectHeldCctx("invalid", "cctx_index"),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgRejectHeldCctx_Type(t *testing.T) {
+	msg := types.NewMsgRejectHeldCctx(sample.AccAddress(), "cctx_index")
+	require.Equal(t, types.TypeMsgRejectHeldCctx, msg.Type())
+}
+
+func TestMsgRejectHeldCctx_Route(t *testing.T) {
+	msg := types.NewMsgRejectHeldCctx(sample.AccAddress(), "cctx_index")
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgRejectHeldCctx_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgRejectHeldCctx(sample.AccAddress(), "cctx_index")
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
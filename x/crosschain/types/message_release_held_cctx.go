@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgReleaseHeldCctx = "ReleaseHeldCctx"
+
+var _ sdk.Msg = &MsgReleaseHeldCctx{}
+
+func NewMsgReleaseHeldCctx(creator string, cctxIndex string) *MsgReleaseHeldCctx {
+	return &MsgReleaseHeldCctx{
+		Creator:   creator,
+		CctxIndex: cctxIndex,
+	}
+}
+
+func (msg *MsgReleaseHeldCctx) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseHeldCctx) Type() string {
+	return TypeMsgReleaseHeldCctx
+}
+
+func (msg *MsgReleaseHeldCctx) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReleaseHeldCctx) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReleaseHeldCctx) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.CctxIndex) != CCTXIndexLength {
+		return ErrInvalidIndexValue
+	}
+	return nil
+}
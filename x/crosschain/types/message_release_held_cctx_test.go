@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgReleaseHeldCctx_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgReleaseHeldCctx
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgReleaseHeldCctx("invalid_address", "cctx_index"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid cctx index",
+			msg:  types.NewMsgReleaseHeldCctx(sample.AccAddress(), "cctx_index"),
+			err:  types.ErrInvalidIndexValue,
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgReleaseHeldCctx(sample.AccAddress(), sample.GetCctxIndexFromString("test")),
+			err:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgReleaseHeldCctx_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgReleaseHeldCctx
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgReleaseHeldCctx(signer, "cctx_index"),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgReleaseHeldCctx("invalid", "cctx_index"),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgReleaseHeldCctx_Type(t *testing.T) {
+	msg := types.NewMsgReleaseHeldCctx(sample.AccAddress(), "cctx_index")
+	require.Equal(t, types.TypeMsgReleaseHeldCctx, msg.Type())
+}
+
+func TestMsgReleaseHeldCctx_Route(t *testing.T) {
+	msg := types.NewMsgReleaseHeldCctx(sample.AccAddress(), "cctx_index")
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgReleaseHeldCctx_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgReleaseHeldCctx(sample.AccAddress(), "cctx_index")
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgResetInboundCircuitBreaker = "ResetInboundCircuitBreaker"
+
+var _ sdk.Msg = &MsgResetInboundCircuitBreaker{}
+
+func NewMsgResetInboundCircuitBreaker(creator string, chainID int64) *MsgResetInboundCircuitBreaker {
+	return &MsgResetInboundCircuitBreaker{
+		Creator: creator,
+		ChainId: chainID,
+	}
+}
+
+func (msg *MsgResetInboundCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResetInboundCircuitBreaker) Type() string {
+	return TypeMsgResetInboundCircuitBreaker
+}
+
+func (msg *MsgResetInboundCircuitBreaker) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResetInboundCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResetInboundCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId <= 0 {
+		return errorsmod.Wrapf(ErrInvalidChainID, "chain id (%d) must be positive", msg.ChainId)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgResetInboundCircuitBreaker_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgResetInboundCircuitBreaker
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgResetInboundCircuitBreaker(sample.AccAddress(), chains.Ethereum.ChainId),
+		},
+		{
+			name: "invalid creator address",
+			msg:  types.NewMsgResetInboundCircuitBreaker("invalid", chains.Ethereum.ChainId),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgResetInboundCircuitBreaker(sample.AccAddress(), 0),
+			err:  types.ErrInvalidChainID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgResetInboundCircuitBreaker_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgResetInboundCircuitBreaker
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgResetInboundCircuitBreaker(signer, chains.Ethereum.ChainId),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgResetInboundCircuitBreaker("invalid", chains.Ethereum.ChainId),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgResetInboundCircuitBreaker_Type(t *testing.T) {
+	msg := types.NewMsgResetInboundCircuitBreaker(sample.AccAddress(), chains.Ethereum.ChainId)
+	require.Equal(t, types.TypeMsgResetInboundCircuitBreaker, msg.Type())
+}
+
+func TestMsgResetInboundCircuitBreaker_Route(t *testing.T) {
+	msg := types.NewMsgResetInboundCircuitBreaker(sample.AccAddress(), chains.Ethereum.ChainId)
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgResetInboundCircuitBreaker_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgResetInboundCircuitBreaker(sample.AccAddress(), chains.Ethereum.ChainId)
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTripInboundCircuitBreaker = "TripInboundCircuitBreaker"
+
+var _ sdk.Msg = &MsgTripInboundCircuitBreaker{}
+
+func NewMsgTripInboundCircuitBreaker(creator string, chainID int64) *MsgTripInboundCircuitBreaker {
+	return &MsgTripInboundCircuitBreaker{
+		Creator: creator,
+		ChainId: chainID,
+	}
+}
+
+func (msg *MsgTripInboundCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTripInboundCircuitBreaker) Type() string {
+	return TypeMsgTripInboundCircuitBreaker
+}
+
+func (msg *MsgTripInboundCircuitBreaker) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTripInboundCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTripInboundCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId <= 0 {
+		return errorsmod.Wrapf(ErrInvalidChainID, "chain id (%d) must be positive", msg.ChainId)
+	}
+	return nil
+}
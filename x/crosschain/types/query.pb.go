@@ -1599,6 +1599,94 @@ func (m *QueryListPendingCctxResponse) GetTotalPending() uint64 {
 	return 0
 }
 
+type QueryListHeldCctxRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryListHeldCctxRequest) Reset()         { *m = QueryListHeldCctxRequest{} }
+func (m *QueryListHeldCctxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListHeldCctxRequest) ProtoMessage()    {}
+func (*QueryListHeldCctxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{33}
+}
+func (m *QueryListHeldCctxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListHeldCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListHeldCctxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListHeldCctxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListHeldCctxRequest.Merge(m, src)
+}
+func (m *QueryListHeldCctxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListHeldCctxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListHeldCctxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListHeldCctxRequest proto.InternalMessageInfo
+
+func (m *QueryListHeldCctxRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type QueryListHeldCctxResponse struct {
+	CrossChainTx []*CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
+}
+
+func (m *QueryListHeldCctxResponse) Reset()         { *m = QueryListHeldCctxResponse{} }
+func (m *QueryListHeldCctxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListHeldCctxResponse) ProtoMessage()    {}
+func (*QueryListHeldCctxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{34}
+}
+func (m *QueryListHeldCctxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListHeldCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListHeldCctxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListHeldCctxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListHeldCctxResponse.Merge(m, src)
+}
+func (m *QueryListHeldCctxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListHeldCctxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListHeldCctxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListHeldCctxResponse proto.InternalMessageInfo
+
+func (m *QueryListHeldCctxResponse) GetCrossChainTx() []*CrossChainTx {
+	if m != nil {
+		return m.CrossChainTx
+	}
+	return nil
+}
+
 type QueryRateLimiterInputRequest struct {
 	Limit  uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	Window int64  `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
@@ -1608,7 +1696,7 @@ func (m *QueryRateLimiterInputRequest) Reset()         { *m = QueryRateLimiterIn
 func (m *QueryRateLimiterInputRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterInputRequest) ProtoMessage()    {}
 func (*QueryRateLimiterInputRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{33}
+	return fileDescriptor_d00cb546ea76908b, []int{35}
 }
 func (m *QueryRateLimiterInputRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1659,13 +1747,15 @@ type QueryRateLimiterInputResponse struct {
 	PastCctxsValue          string          `protobuf:"bytes,5,opt,name=past_cctxs_value,json=pastCctxsValue,proto3" json:"past_cctxs_value,omitempty"`
 	PendingCctxsValue       string          `protobuf:"bytes,6,opt,name=pending_cctxs_value,json=pendingCctxsValue,proto3" json:"pending_cctxs_value,omitempty"`
 	LowestPendingCctxHeight int64           `protobuf:"varint,7,opt,name=lowest_pending_cctx_height,json=lowestPendingCctxHeight,proto3" json:"lowest_pending_cctx_height,omitempty"`
+	// usage of the per-chain and per-asset rate limits
+	Buckets []RateLimitBucket `protobuf:"bytes,8,rep,name=buckets,proto3" json:"buckets"`
 }
 
 func (m *QueryRateLimiterInputResponse) Reset()         { *m = QueryRateLimiterInputResponse{} }
 func (m *QueryRateLimiterInputResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterInputResponse) ProtoMessage()    {}
 func (*QueryRateLimiterInputResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{34}
+	return fileDescriptor_d00cb546ea76908b, []int{36}
 }
 func (m *QueryRateLimiterInputResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1743,6 +1833,13 @@ func (m *QueryRateLimiterInputResponse) GetLowestPendingCctxHeight() int64 {
 	return 0
 }
 
+func (m *QueryRateLimiterInputResponse) GetBuckets() []RateLimitBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
 type QueryListPendingCctxWithinRateLimitRequest struct {
 	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
@@ -1755,7 +1852,7 @@ func (m *QueryListPendingCctxWithinRateLimitRequest) String() string {
 }
 func (*QueryListPendingCctxWithinRateLimitRequest) ProtoMessage() {}
 func (*QueryListPendingCctxWithinRateLimitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{35}
+	return fileDescriptor_d00cb546ea76908b, []int{37}
 }
 func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1807,7 +1904,7 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) String() string {
 }
 func (*QueryListPendingCctxWithinRateLimitResponse) ProtoMessage() {}
 func (*QueryListPendingCctxWithinRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{36}
+	return fileDescriptor_d00cb546ea76908b, []int{38}
 }
 func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1878,7 +1975,7 @@ func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightR
 func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
 func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{37}
+	return fileDescriptor_d00cb546ea76908b, []int{39}
 }
 func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2012,7 @@ func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeight
 func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
 func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{38}
+	return fileDescriptor_d00cb546ea76908b, []int{40}
 }
 func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1960,7 +2057,7 @@ func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZ
 func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
 func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{39}
+	return fileDescriptor_d00cb546ea76908b, []int{41}
 }
 func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2013,7 +2110,7 @@ func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasTo
 func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
 func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{40}
+	return fileDescriptor_d00cb546ea76908b, []int{42}
 }
 func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2070,7 +2167,7 @@ func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMess
 func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{41}
+	return fileDescriptor_d00cb546ea76908b, []int{43}
 }
 func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2109,7 +2206,7 @@ func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
 func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{42}
+	return fileDescriptor_d00cb546ea76908b, []int{44}
 }
 func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2152,7 +2249,7 @@ func (m *QueryRateLimiterFlagsRequest) Reset()         { *m = QueryRateLimiterFl
 func (m *QueryRateLimiterFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsRequest) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{43}
+	return fileDescriptor_d00cb546ea76908b, []int{45}
 }
 func (m *QueryRateLimiterFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2189,7 +2286,7 @@ func (m *QueryRateLimiterFlagsResponse) Reset()         { *m = QueryRateLimiterF
 func (m *QueryRateLimiterFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsResponse) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{44}
+	return fileDescriptor_d00cb546ea76908b, []int{46}
 }
 func (m *QueryRateLimiterFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2225,6 +2322,103 @@ func (m *QueryRateLimiterFlagsResponse) GetRateLimiterFlags() RateLimiterFlags {
 	return RateLimiterFlags{}
 }
 
+type QueryInboundRateLimiterStateRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryInboundRateLimiterStateRequest) Reset()         { *m = QueryInboundRateLimiterStateRequest{} }
+func (m *QueryInboundRateLimiterStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInboundRateLimiterStateRequest) ProtoMessage()    {}
+func (*QueryInboundRateLimiterStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{47}
+}
+func (m *QueryInboundRateLimiterStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInboundRateLimiterStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInboundRateLimiterStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInboundRateLimiterStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInboundRateLimiterStateRequest.Merge(m, src)
+}
+func (m *QueryInboundRateLimiterStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInboundRateLimiterStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInboundRateLimiterStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInboundRateLimiterStateRequest proto.InternalMessageInfo
+
+func (m *QueryInboundRateLimiterStateRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type QueryInboundRateLimiterStateResponse struct {
+	State InboundRateLimiterState `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	// indexes of the held cctxs in release order
+	HeldCctxIndexes []string `protobuf:"bytes,2,rep,name=held_cctx_indexes,json=heldCctxIndexes,proto3" json:"held_cctx_indexes,omitempty"`
+}
+
+func (m *QueryInboundRateLimiterStateResponse) Reset()         { *m = QueryInboundRateLimiterStateResponse{} }
+func (m *QueryInboundRateLimiterStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInboundRateLimiterStateResponse) ProtoMessage()    {}
+func (*QueryInboundRateLimiterStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{48}
+}
+func (m *QueryInboundRateLimiterStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInboundRateLimiterStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInboundRateLimiterStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInboundRateLimiterStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInboundRateLimiterStateResponse.Merge(m, src)
+}
+func (m *QueryInboundRateLimiterStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInboundRateLimiterStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInboundRateLimiterStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInboundRateLimiterStateResponse proto.InternalMessageInfo
+
+func (m *QueryInboundRateLimiterStateResponse) GetState() InboundRateLimiterState {
+	if m != nil {
+		return m.State
+	}
+	return InboundRateLimiterState{}
+}
+
+func (m *QueryInboundRateLimiterStateResponse) GetHeldCctxIndexes() []string {
+	if m != nil {
+		return m.HeldCctxIndexes
+	}
+	return nil
+}
+
 type QueryInboundTrackerRequest struct {
 	ChainId int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	TxHash  string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
@@ -2234,7 +2428,7 @@ func (m *QueryInboundTrackerRequest) Reset()         { *m = QueryInboundTrackerR
 func (m *QueryInboundTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInboundTrackerRequest) ProtoMessage()    {}
 func (*QueryInboundTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{45}
+	return fileDescriptor_d00cb546ea76908b, []int{49}
 }
 func (m *QueryInboundTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2285,7 +2479,7 @@ func (m *QueryInboundTrackerResponse) Reset()         { *m = QueryInboundTracker
 func (m *QueryInboundTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInboundTrackerResponse) ProtoMessage()    {}
 func (*QueryInboundTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{46}
+	return fileDescriptor_d00cb546ea76908b, []int{50}
 }
 func (m *QueryInboundTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2355,6 +2549,8 @@ func init() {
 	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
 	proto.RegisterType((*QueryListPendingCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxRequest")
 	proto.RegisterType((*QueryListPendingCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxResponse")
+	proto.RegisterType((*QueryListHeldCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryListHeldCctxRequest")
+	proto.RegisterType((*QueryListHeldCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryListHeldCctxResponse")
 	proto.RegisterType((*QueryRateLimiterInputRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterInputRequest")
 	proto.RegisterType((*QueryRateLimiterInputResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterInputResponse")
 	proto.RegisterType((*QueryListPendingCctxWithinRateLimitRequest)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxWithinRateLimitRequest")
@@ -2367,6 +2563,8 @@ func init() {
 	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
 	proto.RegisterType((*QueryRateLimiterFlagsRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterFlagsRequest")
 	proto.RegisterType((*QueryRateLimiterFlagsResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterFlagsResponse")
+	proto.RegisterType((*QueryInboundRateLimiterStateRequest)(nil), "zetachain.zetacore.crosschain.QueryInboundRateLimiterStateRequest")
+	proto.RegisterType((*QueryInboundRateLimiterStateResponse)(nil), "zetachain.zetacore.crosschain.QueryInboundRateLimiterStateResponse")
 	proto.RegisterType((*QueryInboundTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryInboundTrackerRequest")
 	proto.RegisterType((*QueryInboundTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryInboundTrackerResponse")
 }
@@ -2376,157 +2574,166 @@ func init() {
 }
 
 var fileDescriptor_d00cb546ea76908b = []byte{
-	// 2386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xcf, 0xf5, 0xc6, 0x8e, 0x7d, 0x9d, 0xd8, 0xf1, 0x8d, 0x13, 0xbb, 0x5b, 0xc7, 0x49, 0x27,
-	0x4d, 0xec, 0x3a, 0x78, 0x37, 0xb1, 0x63, 0xe7, 0xb3, 0x4d, 0xfc, 0x91, 0x38, 0x46, 0x4e, 0xe2,
-	0xae, 0x2c, 0x82, 0x02, 0x62, 0x34, 0x9e, 0xbd, 0x9d, 0x1d, 0x3a, 0x9e, 0xd9, 0xce, 0xcc, 0xc6,
-	0x9b, 0x5a, 0x96, 0xa0, 0x12, 0x0f, 0xbc, 0x21, 0x55, 0x88, 0x17, 0x5e, 0x11, 0x48, 0xf0, 0xd0,
-	0x07, 0xd4, 0x17, 0x04, 0x12, 0xdf, 0x11, 0x05, 0x29, 0x14, 0x09, 0x21, 0x1e, 0x50, 0x49, 0x10,
-	0x7d, 0xe7, 0x2f, 0x40, 0x73, 0xe7, 0xcc, 0xee, 0x7c, 0xcf, 0xdd, 0xf5, 0x46, 0x72, 0x9f, 0x3c,
-	0x33, 0xf7, 0x9e, 0x73, 0x7f, 0xbf, 0x73, 0xce, 0xfd, 0xfa, 0xad, 0xf1, 0x1b, 0xef, 0x53, 0x5b,
-	0x92, 0x2b, 0x92, 0xaa, 0x17, 0xd9, 0x93, 0x61, 0xd2, 0xa2, 0x6c, 0x1a, 0x96, 0xe5, 0x7e, 0x7b,
-	0xaf, 0x46, 0xcd, 0x27, 0x85, 0xaa, 0x69, 0xd8, 0x06, 0x39, 0xd9, 0xe8, 0x5a, 0xf0, 0xba, 0x16,
-	0x9a, 0x5d, 0xf3, 0x53, 0xb2, 0x61, 0x6d, 0x19, 0x56, 0x71, 0x53, 0xb2, 0xa8, 0x6b, 0x57, 0x7c,
-	0x7c, 0x71, 0x93, 0xda, 0xd2, 0xc5, 0x62, 0x55, 0x52, 0x54, 0x5d, 0xb2, 0x55, 0x43, 0x77, 0x5d,
-	0xe5, 0x67, 0xd2, 0x47, 0x65, 0x8f, 0x22, 0x7b, 0x16, 0xed, 0x3a, 0xd8, 0x4c, 0xa7, 0xdb, 0x28,
-	0x92, 0x25, 0x56, 0x4d, 0x55, 0xa6, 0xd0, 0xfd, 0x4a, 0x7a, 0x77, 0x55, 0xdf, 0x34, 0x6a, 0x7a,
-	0x59, 0xac, 0x48, 0x56, 0x45, 0xb4, 0x0d, 0x51, 0x96, 0x1b, 0x03, 0xcd, 0xf2, 0x59, 0xda, 0xa6,
-	0x24, 0xbf, 0x4b, 0x4d, 0x30, 0x9a, 0x4b, 0x37, 0xd2, 0x24, 0xcb, 0x16, 0x37, 0x35, 0x43, 0x7e,
-	0x57, 0xac, 0x50, 0x55, 0xa9, 0xd8, 0x60, 0x76, 0x29, 0xdd, 0xcc, 0xa8, 0xd9, 0x71, 0x83, 0xcd,
-	0xa7, 0x5b, 0x99, 0x92, 0x4d, 0x45, 0x4d, 0xdd, 0x52, 0x6d, 0x6a, 0x8a, 0xef, 0x68, 0x92, 0x62,
-	0x81, 0xdd, 0xb0, 0x62, 0x28, 0x06, 0x7b, 0x2c, 0x3a, 0x4f, 0xf0, 0x75, 0x4c, 0x31, 0x0c, 0x45,
-	0xa3, 0x45, 0xa9, 0xaa, 0x16, 0x25, 0x5d, 0x37, 0x6c, 0x96, 0x29, 0xcf, 0x66, 0x04, 0xd2, 0xba,
-	0x65, 0x29, 0xc5, 0xc7, 0x17, 0x9d, 0x3f, 0x6e, 0x83, 0x30, 0x86, 0xf3, 0x6f, 0x3b, 0x59, 0x7e,
-	0x44, 0x6d, 0x69, 0x41, 0x96, 0x8d, 0x9a, 0x6e, 0xab, 0xba, 0x52, 0xa2, 0xef, 0xd5, 0xa8, 0x65,
-	0x0b, 0xf7, 0xf0, 0xab, 0xb1, 0xad, 0x56, 0xd5, 0xd0, 0x2d, 0x4a, 0x0a, 0xf8, 0x98, 0xb4, 0x69,
-	0x98, 0x36, 0x2d, 0x8b, 0x0e, 0x03, 0x51, 0xda, 0x72, 0x7a, 0x8c, 0xa2, 0xd3, 0x68, 0xb2, 0xaf,
-	0x34, 0x04, 0x4d, 0xcc, 0x96, 0x35, 0x08, 0xeb, 0x78, 0x9c, 0xb9, 0x5b, 0xa1, 0xf6, 0x03, 0x88,
-	0xc9, 0x86, 0x1b, 0x12, 0x18, 0x90, 0x8c, 0xe2, 0x43, 0x8c, 0xfd, 0xea, 0x32, 0xf3, 0x92, 0x2b,
-	0x79, 0xaf, 0x64, 0x18, 0x77, 0xeb, 0x86, 0x2e, 0xd3, 0xd1, 0xae, 0xd3, 0x68, 0xf2, 0x60, 0xc9,
-	0x7d, 0x11, 0xbe, 0x8d, 0xf0, 0xa9, 0x44, 0x97, 0x80, 0xf2, 0x1b, 0x78, 0xd0, 0x08, 0x36, 0x31,
-	0xdf, 0xfd, 0x33, 0x85, 0x42, 0xea, 0x5c, 0x28, 0x84, 0x1c, 0x2e, 0x1e, 0x7c, 0xfa, 0xaf, 0x53,
-	0x07, 0x4a, 0x61, 0x67, 0x42, 0x05, 0x58, 0x2d, 0x68, 0x5a, 0x02, 0xab, 0x3b, 0x18, 0x37, 0x27,
-	0x0f, 0x0c, 0x7e, 0xae, 0xe0, 0xa6, 0xa4, 0xe0, 0xcc, 0xb4, 0x82, 0x3b, 0x43, 0x61, 0xa6, 0x15,
-	0xd6, 0x25, 0x85, 0x82, 0x6d, 0xc9, 0x67, 0x29, 0xfc, 0xc9, 0x63, 0x1b, 0x37, 0x54, 0x1a, 0xdb,
-	0x5c, 0xc7, 0xd8, 0x92, 0x95, 0x00, 0x97, 0x2e, 0xc6, 0x65, 0x22, 0x93, 0x8b, 0x0b, 0x2e, 0x40,
-	0xe6, 0x3b, 0x08, 0x9f, 0x4d, 0x20, 0xb3, 0xf8, 0x64, 0xc9, 0x81, 0xe4, 0x85, 0x6f, 0x18, 0x77,
-	0x33, 0x88, 0x50, 0x12, 0xee, 0x4b, 0x28, 0xa8, 0x5d, 0x6d, 0x07, 0xf5, 0xaf, 0x08, 0x9f, 0xcb,
-	0xc2, 0xf1, 0x45, 0x8b, 0xed, 0x77, 0x11, 0x7e, 0xdd, 0xe3, 0xb4, 0xaa, 0xa7, 0x84, 0xf6, 0x15,
-	0xdc, 0xeb, 0x2e, 0xd0, 0x6a, 0x39, 0x38, 0xe1, 0xca, 0x1d, 0x8b, 0xef, 0x5f, 0x7c, 0x79, 0x4e,
-	0xc0, 0x02, 0xe1, 0xfd, 0x1a, 0x1e, 0x50, 0xf5, 0x98, 0xe8, 0x4e, 0x67, 0x44, 0x37, 0xe4, 0xd5,
-	0x0d, 0x6e, 0xc8, 0x55, 0xe7, 0x62, 0xeb, 0x9b, 0xee, 0xc1, 0x81, 0xad, 0x4e, 0x4f, 0xf7, 0x3f,
-	0xfa, 0xa6, 0x7b, 0x64, 0xa8, 0x2f, 0x54, 0xcc, 0x96, 0xf1, 0x69, 0x6f, 0x95, 0x86, 0x81, 0xef,
-	0x4a, 0x56, 0x65, 0xc3, 0x58, 0x92, 0xed, 0xba, 0x17, 0xb5, 0xd3, 0xb8, 0x5f, 0x6d, 0xb6, 0xc1,
-	0x26, 0xe2, 0xff, 0xe4, 0x54, 0xf5, 0x6b, 0x29, 0x6e, 0x20, 0x22, 0x65, 0x3c, 0xa4, 0x86, 0x1b,
-	0x21, 0x09, 0x17, 0xf8, 0x82, 0xd2, 0xb4, 0x83, 0xb8, 0x44, 0x1d, 0x0a, 0xb7, 0x01, 0x4a, 0xc4,
-	0x64, 0x59, 0xb2, 0x25, 0x7e, 0x4a, 0xbb, 0x58, 0x48, 0x73, 0x03, 0x94, 0x1e, 0xe2, 0x23, 0x4b,
-	0x0e, 0x4a, 0x36, 0x5d, 0x36, 0xea, 0x16, 0xe4, 0xf8, 0x7c, 0x06, 0x1d, 0xbf, 0x0d, 0x30, 0x09,
-	0xfa, 0x11, 0xbe, 0x09, 0x79, 0x69, 0x16, 0x58, 0x34, 0x2f, 0x9d, 0xaa, 0xe6, 0x4f, 0xbd, 0xec,
-	0xc5, 0x0f, 0x96, 0x9e, 0xbd, 0x5c, 0x47, 0xb3, 0xd7, 0xb9, 0xc2, 0x2e, 0xe2, 0x11, 0xaf, 0x22,
-	0x57, 0x24, 0x6b, 0xdd, 0x39, 0xb9, 0xfa, 0x76, 0x2d, 0x55, 0x2f, 0xd3, 0x3a, 0xa4, 0xdd, 0x7d,
-	0x11, 0x44, 0x3c, 0x1a, 0x35, 0x00, 0xee, 0x4b, 0xb8, 0xd7, 0xfb, 0x06, 0x71, 0x9e, 0xc8, 0xa0,
-	0xdc, 0x70, 0xd1, 0x30, 0x14, 0x24, 0x40, 0xb4, 0xa0, 0x69, 0x61, 0x44, 0x9d, 0xca, 0xe4, 0x4f,
-	0x10, 0x90, 0x08, 0x8c, 0x11, 0x4b, 0x22, 0xd7, 0x16, 0x89, 0xce, 0xe5, 0x67, 0xbe, 0x79, 0xe2,
-	0x5c, 0x93, 0x2c, 0x7b, 0xd1, 0x39, 0xbb, 0xdf, 0x65, 0x47, 0xf7, 0xf4, 0x34, 0xed, 0x34, 0x8f,
-	0x95, 0x11, 0x3b, 0x20, 0xfa, 0x55, 0x3c, 0x18, 0x6a, 0xe2, 0x3c, 0x56, 0x86, 0x1d, 0x86, 0xdd,
-	0xf8, 0x77, 0x98, 0x04, 0xd0, 0x9d, 0xca, 0xe4, 0xef, 0x7c, 0x3b, 0x4c, 0x4b, 0x3c, 0x73, 0x1d,
-	0xe0, 0xd9, 0xb9, 0x2c, 0x9f, 0xc7, 0xc7, 0xbc, 0x6c, 0xf9, 0x57, 0xae, 0xf8, 0xd4, 0xae, 0xc1,
-	0x8d, 0x07, 0x3a, 0x2f, 0x3e, 0xb9, 0xef, 0xdc, 0x24, 0xda, 0xbd, 0x80, 0x28, 0x78, 0x38, 0x38,
-	0x34, 0x44, 0xed, 0x01, 0x3e, 0xec, 0x5f, 0x6a, 0x21, 0x47, 0xad, 0xac, 0xd8, 0xa5, 0x80, 0x03,
-	0x61, 0x07, 0x38, 0x2e, 0x68, 0xda, 0x4b, 0x58, 0x9d, 0xc9, 0x18, 0xee, 0xab, 0xe9, 0x86, 0x59,
-	0xa6, 0x26, 0x2d, 0x33, 0x86, 0xbd, 0xa5, 0xe6, 0x07, 0xe1, 0x23, 0x04, 0x34, 0x1b, 0xa3, 0x27,
-	0xd2, 0xcc, 0xed, 0x89, 0x66, 0xe7, 0x6a, 0xe2, 0x3e, 0x5c, 0x5d, 0xd7, 0x54, 0xcb, 0x5e, 0xa7,
-	0x7a, 0x59, 0xd5, 0x15, 0x7f, 0xdc, 0x52, 0x0e, 0xbe, 0xc3, 0xb8, 0x9b, 0x5d, 0xbb, 0xd9, 0xe8,
-	0x47, 0x4a, 0xee, 0x8b, 0xf0, 0x21, 0xc2, 0x63, 0xf1, 0x0e, 0x5f, 0x56, 0x28, 0x04, 0x7c, 0xd8,
-	0x36, 0x6c, 0x49, 0x83, 0xc1, 0xa0, 0xee, 0x02, 0xdf, 0x84, 0x35, 0x00, 0x55, 0x92, 0x6c, 0xba,
-	0xe6, 0x6a, 0x05, 0xab, 0x7a, 0xb5, 0xe6, 0x5f, 0xdd, 0x5c, 0x2e, 0xc8, 0xc7, 0x85, 0x9c, 0xc0,
-	0x3d, 0xdb, 0xaa, 0x5e, 0x36, 0xb6, 0x99, 0xcf, 0x5c, 0x09, 0xde, 0x84, 0xef, 0xe7, 0xf0, 0xc9,
-	0x04, 0x77, 0x40, 0xf2, 0x04, 0xee, 0xa9, 0x34, 0xd7, 0xba, 0x5c, 0x09, 0xde, 0xc8, 0x7d, 0x7c,
-	0x58, 0x96, 0xed, 0xba, 0x25, 0x6e, 0xa9, 0x96, 0xc5, 0x2a, 0xa8, 0x65, 0xf2, 0xfd, 0xcc, 0xc1,
-	0x3d, 0x66, 0x4f, 0xd6, 0xf1, 0x11, 0xd7, 0x5f, 0x15, 0xc8, 0xe7, 0xda, 0x88, 0x26, 0xf3, 0x00,
-	0x91, 0x22, 0x67, 0xf0, 0x11, 0x16, 0xb9, 0x86, 0xc7, 0x83, 0xd1, 0x70, 0x92, 0x49, 0x7c, 0xb4,
-	0x2a, 0x59, 0xb6, 0xe8, 0x8e, 0xfd, 0x58, 0xd2, 0x6a, 0x74, 0xb4, 0x9b, 0x2d, 0x1e, 0x03, 0xce,
-	0x77, 0x27, 0xdf, 0xd6, 0x57, 0x9c, 0xaf, 0xa4, 0x80, 0x8f, 0x81, 0xa3, 0x40, 0xe7, 0x1e, 0x57,
-	0xfa, 0xa8, 0x36, 0xeb, 0x03, 0xfa, 0x5f, 0xc7, 0x79, 0xcd, 0xd8, 0xa6, 0x96, 0x2d, 0xfa, 0xcd,
-	0x40, 0x46, 0x1a, 0x3d, 0xc4, 0x82, 0x39, 0xe2, 0xf6, 0xf0, 0x15, 0x17, 0x6c, 0x08, 0x8b, 0x78,
-	0x2a, 0xae, 0xf4, 0x1e, 0xaa, 0x76, 0x45, 0xd5, 0x1b, 0xb9, 0x4a, 0xcd, 0xb9, 0xf0, 0xeb, 0x2e,
-	0x7c, 0x9e, 0xcb, 0x09, 0x64, 0xfa, 0x6d, 0x3c, 0x10, 0x14, 0xf0, 0xda, 0x2a, 0x68, 0xd9, 0x5f,
-	0xd0, 0x91, 0x14, 0xc4, 0x54, 0x34, 0x99, 0xc7, 0x23, 0x72, 0xcd, 0x34, 0xa9, 0x6e, 0x8b, 0xdb,
-	0xaa, 0x5d, 0x29, 0x9b, 0xd2, 0xb6, 0x08, 0xc5, 0x9a, 0x63, 0x51, 0x3a, 0x0e, 0xcd, 0x0f, 0xa1,
-	0xf5, 0x21, 0x6b, 0x24, 0x33, 0xf8, 0x78, 0xc4, 0xce, 0x94, 0x6c, 0xca, 0xf2, 0xdc, 0x57, 0x3a,
-	0x16, 0xb2, 0x72, 0x08, 0x3b, 0x49, 0x6c, 0xaa, 0x6c, 0x22, 0xad, 0xcb, 0x94, 0x96, 0x69, 0x99,
-	0x65, 0xbc, 0xb7, 0x34, 0x64, 0x7a, 0x31, 0xb9, 0x0d, 0x0d, 0x0d, 0xb1, 0xcc, 0xd9, 0xc8, 0x1e,
-	0x51, 0x5b, 0x0a, 0x6c, 0xca, 0xc2, 0x9c, 0xb7, 0xe2, 0x84, 0x5a, 0x9b, 0x53, 0xe7, 0x6e, 0x60,
-	0xea, 0x40, 0x72, 0x37, 0x60, 0x0a, 0x2f, 0x19, 0xfa, 0x63, 0x6a, 0x3a, 0xa7, 0xc2, 0x0d, 0xc3,
-	0x31, 0x8f, 0xec, 0x48, 0x91, 0x85, 0x2a, 0x8f, 0x7b, 0x15, 0xc9, 0x5a, 0x6b, 0xac, 0x55, 0x7d,
-	0xa5, 0xc6, 0xbb, 0xf0, 0x23, 0x04, 0x53, 0x39, 0xea, 0x16, 0xf0, 0x7c, 0x09, 0x0f, 0x79, 0xfa,
-	0xc3, 0x8a, 0x64, 0xad, 0xea, 0x4e, 0xa3, 0x27, 0xdd, 0x45, 0x1a, 0x9c, 0xde, 0x4c, 0x30, 0x94,
-	0x0d, 0xed, 0x0e, 0xa5, 0xd0, 0xbb, 0x0b, 0xaa, 0x3d, 0xdc, 0x40, 0x26, 0xf1, 0xa0, 0xf3, 0xd7,
-	0x7f, 0x66, 0xc8, 0xb1, 0x5c, 0x87, 0x3f, 0x0b, 0x13, 0x20, 0x0e, 0xdc, 0xa3, 0x96, 0x25, 0x29,
-	0x74, 0x5d, 0xb2, 0x2c, 0x55, 0x57, 0xd6, 0x9b, 0x1e, 0xbd, 0xe8, 0xde, 0x01, 0x95, 0x26, 0xa5,
-	0x23, 0x10, 0x1b, 0xc3, 0x7d, 0xef, 0x34, 0x20, 0xba, 0x84, 0x9a, 0x1f, 0x84, 0xf1, 0xe8, 0x8a,
-	0x79, 0x47, 0x93, 0x14, 0xef, 0xf2, 0x2e, 0x7c, 0x80, 0xa2, 0x6b, 0x20, 0x74, 0x00, 0xff, 0x12,
-	0x3e, 0x6a, 0x86, 0xda, 0x60, 0xe3, 0x2d, 0x66, 0xcc, 0x8d, 0xb0, 0x4b, 0xb8, 0xa0, 0x44, 0xdc,
-	0x09, 0xeb, 0x50, 0x68, 0xc1, 0x5b, 0x3a, 0xc7, 0xde, 0x35, 0x82, 0x0f, 0x39, 0xab, 0x8a, 0x73,
-	0xdb, 0x74, 0x93, 0xd3, 0x63, 0xd7, 0xd9, 0x45, 0x73, 0x07, 0x8a, 0x33, 0xec, 0x11, 0x38, 0x7d,
-	0x1d, 0x0f, 0x86, 0x14, 0x71, 0xa0, 0xd4, 0x09, 0x1d, 0x61, 0xe6, 0xa7, 0x33, 0xb8, 0x9b, 0x8d,
-	0x4e, 0x3e, 0x45, 0x78, 0x30, 0x24, 0x86, 0x91, 0x37, 0x33, 0x86, 0x48, 0x97, 0x8c, 0xf3, 0x6f,
-	0xb5, 0x6b, 0xee, 0x52, 0x17, 0x6e, 0x7d, 0xf0, 0xb7, 0xff, 0x7c, 0xd8, 0x75, 0x8d, 0x5c, 0x61,
-	0x2a, 0xfc, 0xb4, 0xef, 0xb7, 0x8b, 0xa0, 0x7a, 0x0f, 0x76, 0xc5, 0x1d, 0x38, 0x10, 0xee, 0x16,
-	0x77, 0xd8, 0x11, 0x70, 0x97, 0xfc, 0x16, 0x61, 0x12, 0xf2, 0xbe, 0xa0, 0x69, 0x7c, 0xbc, 0x12,
-	0x45, 0x63, 0x3e, 0x5e, 0xc9, 0x42, 0xb0, 0x50, 0x60, 0xbc, 0x26, 0xc9, 0x39, 0x3e, 0x5e, 0xe4,
-	0x73, 0x84, 0x5f, 0x89, 0xb2, 0x00, 0x8d, 0x8e, 0x2c, 0xb7, 0x87, 0x26, 0x28, 0x37, 0xe6, 0x6f,
-	0xef, 0xd1, 0x0b, 0x50, 0x7b, 0x93, 0x51, 0xbb, 0x4c, 0xe6, 0xf8, 0xa8, 0x81, 0x39, 0x64, 0x6e,
-	0x97, 0xfc, 0x17, 0xe1, 0xd1, 0x60, 0xdd, 0xfa, 0x88, 0x2e, 0x71, 0x42, 0x4c, 0x93, 0x55, 0xf3,
-	0xcb, 0x7b, 0x73, 0x02, 0x34, 0x6f, 0x32, 0x9a, 0x57, 0xc9, 0xe5, 0x04, 0x9a, 0xaa, 0x9e, 0xcc,
-	0x52, 0x54, 0xcb, 0xbb, 0xe4, 0x37, 0x08, 0x0f, 0x45, 0x88, 0x72, 0xd7, 0x65, 0xbc, 0xba, 0xc9,
-	0x5d, 0x97, 0x09, 0x8a, 0x65, 0x66, 0x5d, 0x06, 0x59, 0x59, 0xe4, 0x13, 0x84, 0x07, 0x82, 0xbe,
-	0xc8, 0x55, 0x1e, 0x08, 0xb1, 0x6b, 0x67, 0xfe, 0x5a, 0x3b, 0xa6, 0x80, 0x7c, 0x91, 0x21, 0xbf,
-	0x41, 0xae, 0x71, 0x21, 0xf7, 0x25, 0xa2, 0xb8, 0x03, 0x8b, 0xf2, 0x2e, 0xf9, 0x7b, 0x33, 0x25,
-	0x3e, 0x3d, 0xea, 0x26, 0xe7, 0x1a, 0x96, 0x24, 0xd2, 0xe5, 0x6f, 0xb5, 0xef, 0x00, 0xc8, 0xbd,
-	0xc5, 0xc8, 0x5d, 0x21, 0xf3, 0xe9, 0xe4, 0x9a, 0x96, 0xc5, 0x1d, 0xdf, 0xa7, 0x5d, 0xf2, 0x19,
-	0xc2, 0xc7, 0x63, 0x55, 0x4c, 0x72, 0xab, 0x85, 0x90, 0xc7, 0xea, 0xa8, 0xf9, 0x85, 0x3d, 0x78,
-	0x68, 0x2d, 0x77, 0x41, 0xeb, 0x10, 0xc5, 0x4f, 0x10, 0x1e, 0x8e, 0x8c, 0xe2, 0xcc, 0xa8, 0x9b,
-	0xad, 0x4d, 0x89, 0x36, 0xd3, 0x97, 0xa6, 0x9b, 0x0a, 0x17, 0x18, 0xbf, 0x29, 0x32, 0xc9, 0xcb,
-	0x8f, 0xfc, 0x0c, 0x35, 0x95, 0x3a, 0x32, 0xcf, 0x59, 0x3f, 0x21, 0x49, 0x31, 0x7f, 0xb9, 0x65,
-	0x3b, 0xc0, 0x5b, 0x64, 0x78, 0xdf, 0x20, 0x13, 0x09, 0x78, 0x15, 0x30, 0x70, 0x52, 0x50, 0xa6,
-	0xf5, 0x5d, 0xf2, 0x63, 0x84, 0xfb, 0x3d, 0x2f, 0x4e, 0xcc, 0xe7, 0x39, 0x43, 0xd6, 0x16, 0xe2,
-	0x18, 0x61, 0x53, 0x98, 0x60, 0x88, 0x5f, 0x23, 0xa7, 0x32, 0x10, 0x93, 0x5f, 0x21, 0x7c, 0x34,
-	0x7c, 0xea, 0x26, 0xd7, 0x79, 0x86, 0x4d, 0xb8, 0x02, 0xe4, 0x6f, 0xb4, 0x67, 0xcc, 0x19, 0x6a,
-	0x39, 0x8c, 0xf5, 0x0f, 0x08, 0xf7, 0xfb, 0x0e, 0xd6, 0x7c, 0x7b, 0x7f, 0xd6, 0x01, 0x9e, 0x6f,
-	0xef, 0xcf, 0x3c, 0xdd, 0x0b, 0x53, 0x8c, 0xcd, 0xeb, 0x44, 0x48, 0x60, 0xe3, 0xbb, 0x8c, 0x90,
-	0xa7, 0x28, 0xa2, 0x5d, 0x72, 0x9f, 0x36, 0xe3, 0x95, 0x57, 0xee, 0xd3, 0x66, 0x82, 0x9a, 0x2a,
-	0xcc, 0x33, 0xf8, 0x17, 0x48, 0x21, 0x01, 0xbe, 0x16, 0xb4, 0x6b, 0x94, 0xbf, 0x73, 0xc6, 0x0c,
-	0xf9, 0x6c, 0x65, 0x2f, 0xdf, 0x0b, 0x9b, 0x64, 0x6d, 0x38, 0x73, 0x2f, 0x0f, 0xb1, 0x21, 0x3f,
-	0x44, 0xf8, 0x20, 0x5b, 0x7c, 0x66, 0x38, 0xc3, 0xe8, 0x5f, 0x24, 0x67, 0x5b, 0xb2, 0x01, 0x84,
-	0xe7, 0x19, 0xc2, 0xb3, 0xe4, 0x4c, 0x52, 0xf1, 0xc3, 0x4e, 0xc6, 0x82, 0xfc, 0x73, 0x84, 0xfb,
-	0x7d, 0x9a, 0x30, 0xdf, 0x39, 0x23, 0x56, 0x47, 0x6e, 0x0f, 0xec, 0x1c, 0x03, 0x5b, 0x24, 0xd3,
-	0xa9, 0x60, 0x23, 0xf7, 0x8f, 0x1f, 0x20, 0x7c, 0xc8, 0xdb, 0x8a, 0x66, 0x38, 0x33, 0xda, 0x72,
-	0x60, 0x43, 0xca, 0xaf, 0x70, 0x86, 0x61, 0x3d, 0x49, 0x5e, 0x4d, 0xc1, 0x4a, 0x3e, 0x76, 0x26,
-	0x60, 0x50, 0x6f, 0x22, 0x5c, 0x27, 0xb0, 0x78, 0xd5, 0x36, 0x7f, 0xbd, 0x2d, 0x5b, 0xde, 0x95,
-	0xc3, 0x07, 0xf2, 0x7f, 0x08, 0x8f, 0xa7, 0x0b, 0x65, 0x64, 0xb5, 0x0d, 0x2c, 0xf1, 0x8a, 0x5d,
-	0xfe, 0xcb, 0x9d, 0x70, 0x05, 0x2c, 0xaf, 0x32, 0x96, 0xb3, 0xe4, 0x62, 0x36, 0xcb, 0x30, 0xa3,
-	0x8f, 0x11, 0x1e, 0x08, 0xfe, 0xa7, 0x17, 0xdf, 0x0c, 0x88, 0xfd, 0xdf, 0x31, 0xbe, 0x93, 0x76,
-	0xfc, 0x3f, 0x96, 0x09, 0xd3, 0x8c, 0xc4, 0x04, 0x39, 0x9b, 0x40, 0xe2, 0xfd, 0x20, 0x4a, 0x07,
-	0x78, 0x50, 0x75, 0xe3, 0x03, 0x1e, 0xab, 0xe3, 0xf1, 0x01, 0x8f, 0x17, 0xf9, 0x32, 0x81, 0x6b,
-	0x41, 0x94, 0xce, 0x51, 0x21, 0x2c, 0x0a, 0xf1, 0x1d, 0x15, 0x12, 0xe4, 0x2b, 0xbe, 0xa3, 0x42,
-	0x92, 0xb4, 0x95, 0x79, 0x54, 0x08, 0x0b, 0x55, 0x61, 0x02, 0xec, 0xc7, 0x82, 0x96, 0x09, 0xf8,
-	0x7f, 0xb1, 0x68, 0x99, 0x40, 0xe0, 0xf7, 0x89, 0x56, 0x08, 0xb8, 0x58, 0xff, 0x8c, 0xf0, 0xe1,
-	0x07, 0x35, 0x7b, 0xa3, 0xbe, 0x4f, 0xd4, 0x28, 0x0e, 0x69, 0xa3, 0x81, 0x35, 0x66, 0x2b, 0xf8,
-	0xa5, 0xab, 0xaf, 0x35, 0xba, 0xec, 0x03, 0x1d, 0x2a, 0x6b, 0x07, 0xf6, 0x33, 0x22, 0xff, 0x46,
-	0xf8, 0x44, 0x08, 0xff, 0xbe, 0x54, 0xa0, 0xae, 0x31, 0x52, 0x97, 0xc8, 0x0c, 0x07, 0xa9, 0xb0,
-	0xfc, 0xe4, 0xde, 0x94, 0xe3, 0x28, 0xee, 0x23, 0xed, 0xe9, 0x06, 0x23, 0x38, 0x4f, 0x2e, 0x25,
-	0xde, 0x27, 0x13, 0xf8, 0x31, 0xe1, 0xe9, 0x17, 0x4c, 0xb3, 0x69, 0xab, 0x0a, 0x5f, 0x92, 0xea,
-	0x94, 0xb5, 0xf9, 0xfb, 0xf8, 0x90, 0x67, 0x80, 0x7e, 0x7f, 0x09, 0x34, 0xd7, 0x19, 0x83, 0x39,
-	0x32, 0x9b, 0xc2, 0x20, 0x51, 0x9d, 0xf9, 0x27, 0xc2, 0x24, 0x48, 0x69, 0xff, 0x48, 0x33, 0xd9,
-	0x32, 0x67, 0x18, 0x77, 0x88, 0xdc, 0xef, 0x99, 0xa6, 0xe6, 0xef, 0xb4, 0x4f, 0x44, 0x99, 0xac,
-	0xd3, 0x40, 0x90, 0x59, 0xbe, 0xfb, 0x5b, 0x9f, 0x7f, 0x34, 0x85, 0x16, 0x57, 0x9e, 0x3e, 0x1f,
-	0x47, 0xcf, 0x9e, 0x8f, 0xa3, 0xcf, 0x9e, 0x8f, 0xa3, 0xef, 0xbd, 0x18, 0x3f, 0xf0, 0xec, 0xc5,
-	0xf8, 0x81, 0x7f, 0xbc, 0x18, 0x3f, 0xf0, 0x68, 0x5a, 0x51, 0xed, 0x4a, 0x6d, 0xb3, 0x20, 0x1b,
-	0x5b, 0x7e, 0x8f, 0xba, 0x51, 0xa6, 0xc5, 0xba, 0xdf, 0xb1, 0xfd, 0xa4, 0x4a, 0xad, 0xcd, 0x1e,
-	0x76, 0x17, 0x9e, 0xfd, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xa3, 0x7e, 0xf5, 0x1e, 0x32,
-	0x00, 0x00,
+	// 2541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdd, 0x6f, 0xd4, 0xd8,
+	0x15, 0xe7, 0x32, 0x04, 0x92, 0x13, 0x20, 0xe4, 0x12, 0xc8, 0x60, 0x20, 0xb0, 0x66, 0x21, 0xd9,
+	0xb0, 0x99, 0x81, 0x40, 0x02, 0x04, 0x16, 0x48, 0x02, 0xf9, 0xa8, 0x02, 0x64, 0xa7, 0x51, 0xa9,
+	0xb6, 0x55, 0x47, 0x8e, 0xe7, 0xee, 0x8c, 0x8b, 0x63, 0xcf, 0xda, 0x1e, 0x12, 0x36, 0x8a, 0xd4,
+	0xae, 0xd4, 0x87, 0xbe, 0x55, 0xda, 0x87, 0xbe, 0xf4, 0xb5, 0x1f, 0x0f, 0x7d, 0xd8, 0x87, 0x76,
+	0x5f, 0xaa, 0x56, 0xea, 0x37, 0x2a, 0xad, 0x44, 0xb7, 0x52, 0x55, 0xf5, 0xa1, 0xda, 0x42, 0xb7,
+	0xfb, 0xde, 0xbf, 0xa0, 0xf2, 0xf5, 0xf1, 0x8c, 0xed, 0xb1, 0x3d, 0x1e, 0x67, 0x90, 0xb2, 0x4f,
+	0x19, 0xdf, 0x7b, 0x7f, 0xe7, 0x9e, 0xdf, 0x39, 0xf7, 0xe3, 0xf8, 0xe7, 0xc0, 0x1b, 0xef, 0x33,
+	0x4b, 0x92, 0x2b, 0x92, 0xa2, 0xe5, 0xf9, 0x2f, 0xdd, 0x60, 0x79, 0xd9, 0xd0, 0x4d, 0xd3, 0x69,
+	0x7b, 0xaf, 0xc6, 0x8c, 0x27, 0xb9, 0xaa, 0xa1, 0x5b, 0x3a, 0x3d, 0x59, 0x1f, 0x9a, 0x73, 0x87,
+	0xe6, 0x1a, 0x43, 0x85, 0x51, 0x59, 0x37, 0xd7, 0x74, 0x33, 0xbf, 0x2a, 0x99, 0xcc, 0xc1, 0xe5,
+	0x1f, 0x5f, 0x5c, 0x65, 0x96, 0x74, 0x31, 0x5f, 0x95, 0xca, 0x8a, 0x26, 0x59, 0x8a, 0xae, 0x39,
+	0xa6, 0x84, 0xf1, 0xf8, 0x59, 0xf9, 0xcf, 0x22, 0xff, 0x5d, 0xb4, 0x36, 0x10, 0x33, 0x16, 0x8f,
+	0x29, 0x4b, 0x66, 0xb1, 0x6a, 0x28, 0x32, 0xc3, 0xe1, 0x57, 0xe3, 0x87, 0x2b, 0xda, 0xaa, 0x5e,
+	0xd3, 0x4a, 0xc5, 0x8a, 0x64, 0x56, 0x8a, 0x96, 0x5e, 0x94, 0xe5, 0xfa, 0x44, 0x97, 0x92, 0x21,
+	0x2d, 0x43, 0x92, 0x1f, 0x31, 0x03, 0x41, 0x13, 0xf1, 0x20, 0x55, 0x32, 0xad, 0xe2, 0xaa, 0xaa,
+	0xcb, 0x8f, 0x8a, 0x15, 0xa6, 0x94, 0x2b, 0x16, 0xc2, 0x2e, 0xc7, 0xc3, 0xf4, 0x9a, 0x15, 0x36,
+	0xd9, 0x64, 0x3c, 0xca, 0x90, 0x2c, 0x56, 0x54, 0x95, 0x35, 0xc5, 0x62, 0x46, 0xf1, 0x5d, 0x55,
+	0x2a, 0x9b, 0x88, 0x1b, 0x28, 0xeb, 0x65, 0x9d, 0xff, 0xcc, 0xdb, 0xbf, 0xb0, 0xf5, 0x44, 0x59,
+	0xd7, 0xcb, 0x2a, 0xcb, 0x4b, 0x55, 0x25, 0x2f, 0x69, 0x9a, 0x6e, 0xf1, 0x4c, 0xb9, 0x98, 0x41,
+	0x4c, 0xeb, 0x9a, 0x59, 0xce, 0x3f, 0xbe, 0x68, 0xff, 0x71, 0x3a, 0xc4, 0x13, 0x20, 0xbc, 0x6d,
+	0x67, 0xf9, 0x1d, 0x66, 0x49, 0xd3, 0xb2, 0xac, 0xd7, 0x34, 0x4b, 0xd1, 0xca, 0x05, 0xf6, 0x5e,
+	0x8d, 0x99, 0x96, 0x78, 0x0f, 0x8e, 0x87, 0xf6, 0x9a, 0x55, 0x5d, 0x33, 0x19, 0xcd, 0xc1, 0x61,
+	0x69, 0x55, 0x37, 0x2c, 0x56, 0x2a, 0xda, 0x0c, 0x8a, 0xd2, 0x9a, 0x3d, 0x22, 0x4b, 0x4e, 0x93,
+	0x91, 0x9e, 0x42, 0x3f, 0x76, 0x71, 0x2c, 0xef, 0x10, 0x97, 0x61, 0x88, 0x9b, 0x9b, 0x67, 0xd6,
+	0x03, 0x8c, 0xc9, 0x8a, 0x13, 0x12, 0x9c, 0x90, 0x66, 0x61, 0x1f, 0x67, 0xbf, 0x78, 0x87, 0x5b,
+	0xc9, 0x14, 0xdc, 0x47, 0x3a, 0x00, 0x5d, 0x9a, 0xae, 0xc9, 0x2c, 0xbb, 0xfb, 0x34, 0x19, 0xd9,
+	0x53, 0x70, 0x1e, 0xc4, 0x6f, 0x13, 0x38, 0x15, 0x69, 0x12, 0xbd, 0xfc, 0x06, 0xf4, 0xe9, 0xfe,
+	0x2e, 0x6e, 0xbb, 0x77, 0x3c, 0x97, 0x8b, 0xdd, 0x0b, 0xb9, 0x80, 0xc1, 0x99, 0x3d, 0x4f, 0xff,
+	0x75, 0x6a, 0x57, 0x21, 0x68, 0x4c, 0xac, 0x20, 0xab, 0x69, 0x55, 0x8d, 0x60, 0x35, 0x07, 0xd0,
+	0xd8, 0x3c, 0x38, 0xf9, 0xb9, 0x9c, 0x93, 0x92, 0x9c, 0xbd, 0xd3, 0x72, 0xce, 0x0e, 0xc5, 0x9d,
+	0x96, 0x5b, 0x96, 0xca, 0x0c, 0xb1, 0x05, 0x0f, 0x52, 0xfc, 0x93, 0xcb, 0x36, 0x6c, 0xaa, 0x38,
+	0xb6, 0x99, 0x8e, 0xb1, 0xa5, 0xf3, 0x3e, 0x2e, 0xbb, 0x39, 0x97, 0xe1, 0x96, 0x5c, 0x1c, 0xe7,
+	0x7c, 0x64, 0xbe, 0x43, 0xe0, 0x6c, 0x04, 0x99, 0x99, 0x27, 0xb3, 0xb6, 0x4b, 0x6e, 0xf8, 0x06,
+	0xa0, 0x8b, 0xbb, 0x88, 0x4b, 0xc2, 0x79, 0xa0, 0x73, 0x21, 0x8e, 0xa4, 0x09, 0xea, 0x5f, 0x09,
+	0x9c, 0x6b, 0xe5, 0xc7, 0x17, 0x2d, 0xb6, 0xdf, 0x25, 0xf0, 0xba, 0xcb, 0x69, 0x51, 0x8b, 0x09,
+	0xed, 0x31, 0xe8, 0x76, 0x0e, 0x68, 0xa5, 0xe4, 0xdf, 0x70, 0xa5, 0x8e, 0xc5, 0xf7, 0x2f, 0x9e,
+	0x3c, 0x47, 0xf8, 0x82, 0xe1, 0xfd, 0x1a, 0x1c, 0x54, 0xb4, 0x90, 0xe8, 0x8e, 0xb5, 0x88, 0xee,
+	0xa2, 0x16, 0x12, 0xdc, 0x80, 0xa9, 0xce, 0xc5, 0xd6, 0xb3, 0xdd, 0xfd, 0x13, 0x9b, 0x9d, 0xde,
+	0xee, 0x7f, 0xf4, 0x6c, 0xf7, 0xa6, 0xa9, 0xbe, 0x50, 0x31, 0xbb, 0x03, 0xa7, 0xdd, 0x53, 0x1a,
+	0x27, 0x5e, 0x90, 0xcc, 0xca, 0x8a, 0x3e, 0x2b, 0x5b, 0x1b, 0x6e, 0xd4, 0x4e, 0x43, 0xaf, 0xd2,
+	0xe8, 0xc3, 0x4b, 0xc4, 0xdb, 0x64, 0xaf, 0xea, 0xd7, 0x62, 0xcc, 0x60, 0x44, 0x4a, 0xd0, 0xaf,
+	0x04, 0x3b, 0x31, 0x09, 0x17, 0x92, 0x05, 0xa5, 0x81, 0xc3, 0xb8, 0x34, 0x1b, 0x14, 0xef, 0xa2,
+	0x2b, 0x4d, 0x90, 0x3b, 0x92, 0x25, 0x25, 0xa7, 0xb4, 0x05, 0x62, 0x9c, 0x19, 0xa4, 0xf4, 0x10,
+	0x0e, 0xcc, 0xda, 0x5e, 0xf2, 0xed, 0xb2, 0xb2, 0x61, 0x62, 0x8e, 0xcf, 0xb7, 0xa0, 0xe3, 0xc5,
+	0x20, 0x13, 0xbf, 0x1d, 0xf1, 0x9b, 0x70, 0x3a, 0xb0, 0xc0, 0x9a, 0xf3, 0xd2, 0xa9, 0xd5, 0xfc,
+	0x89, 0x9b, 0xbd, 0xf0, 0xc9, 0xe2, 0xb3, 0x97, 0xe9, 0x68, 0xf6, 0x3a, 0xb7, 0xb0, 0xf3, 0x30,
+	0xe8, 0xae, 0xc8, 0x79, 0xc9, 0x5c, 0x36, 0x14, 0x99, 0x79, 0x6e, 0x2d, 0x45, 0x2b, 0xb1, 0x0d,
+	0x4c, 0xbb, 0xf3, 0x20, 0x16, 0x21, 0xdb, 0x0c, 0x40, 0xee, 0xb3, 0xd0, 0xed, 0xb6, 0x61, 0x9c,
+	0x87, 0x5b, 0x50, 0xae, 0x9b, 0xa8, 0x03, 0x45, 0x09, 0x3d, 0x9a, 0x56, 0xd5, 0xa0, 0x47, 0x9d,
+	0xca, 0xe4, 0x4f, 0x08, 0x64, 0x9b, 0xe7, 0x08, 0x25, 0x91, 0x49, 0x45, 0xa2, 0x73, 0xf9, 0x99,
+	0x6c, 0x54, 0x9c, 0x4b, 0x92, 0x69, 0xcd, 0xd8, 0xb5, 0xfb, 0x02, 0x2f, 0xdd, 0xe3, 0xd3, 0xb4,
+	0x09, 0xa7, 0x22, 0x71, 0x48, 0xf4, 0xab, 0xd0, 0x17, 0xe8, 0x4a, 0x58, 0x56, 0x06, 0x0d, 0x06,
+	0xcd, 0x78, 0x6f, 0x98, 0x08, 0xa7, 0x3b, 0x95, 0xc9, 0xdf, 0x79, 0x6e, 0x98, 0xb6, 0x78, 0x66,
+	0x3a, 0xc0, 0xb3, 0x73, 0x59, 0x3e, 0x0f, 0x87, 0xdd, 0x6c, 0x79, 0x4f, 0xae, 0xf0, 0xd4, 0x2e,
+	0x81, 0xe0, 0x1d, 0x3c, 0xf3, 0xe4, 0xbe, 0xae, 0xc9, 0x2c, 0xed, 0x0b, 0x48, 0x19, 0x06, 0xfc,
+	0x53, 0x63, 0xd4, 0x1e, 0xc0, 0x7e, 0xef, 0x51, 0x8b, 0x39, 0x6a, 0xe7, 0xc4, 0x2e, 0xf8, 0x0c,
+	0x88, 0x9b, 0xc8, 0x71, 0x5a, 0x55, 0x5f, 0xc1, 0xe9, 0x4c, 0x4f, 0x40, 0x4f, 0x4d, 0xd3, 0x8d,
+	0x12, 0x33, 0x58, 0x89, 0x33, 0xec, 0x2e, 0x34, 0x1a, 0xc4, 0x8f, 0x08, 0x0c, 0xf8, 0x67, 0x8f,
+	0xa4, 0x99, 0xd9, 0x16, 0xcd, 0xce, 0xad, 0x89, 0xfb, 0xf8, 0xea, 0xba, 0xa4, 0x98, 0xd6, 0x32,
+	0xd3, 0x4a, 0x8a, 0x56, 0xf6, 0xc6, 0x2d, 0xa6, 0xf0, 0x1d, 0x80, 0x2e, 0xfe, 0xda, 0xcd, 0x67,
+	0x3f, 0x50, 0x70, 0x1e, 0xc4, 0x0f, 0x09, 0x9c, 0x08, 0x37, 0xf8, 0xaa, 0x42, 0x21, 0xc2, 0x7e,
+	0x4b, 0xb7, 0x24, 0x15, 0x27, 0xc3, 0x75, 0xe7, 0x6b, 0x13, 0x27, 0x20, 0x5b, 0x77, 0x6a, 0x81,
+	0xa9, 0xa5, 0x64, 0x14, 0x45, 0x15, 0x8e, 0x85, 0xc0, 0x5e, 0x11, 0x11, 0x71, 0x09, 0x23, 0x57,
+	0x90, 0x2c, 0xb6, 0xe4, 0x08, 0x1a, 0x8b, 0x5a, 0xb5, 0xe6, 0x3d, 0x82, 0x9d, 0x80, 0x13, 0x4f,
+	0xc0, 0xe9, 0x51, 0xd8, 0xbb, 0xae, 0x68, 0x25, 0x7d, 0x9d, 0x13, 0xcf, 0x14, 0xf0, 0x49, 0xfc,
+	0x2c, 0x03, 0x27, 0x23, 0xcc, 0x21, 0x81, 0xa3, 0xb0, 0xb7, 0xd2, 0x38, 0x90, 0x33, 0x05, 0x7c,
+	0xa2, 0xf7, 0x61, 0xbf, 0x2d, 0x10, 0x99, 0xc5, 0x35, 0xc5, 0x34, 0xf9, 0x32, 0x6f, 0x9b, 0x58,
+	0x2f, 0x37, 0x70, 0x8f, 0xe3, 0xe9, 0x32, 0x1c, 0x70, 0xec, 0x55, 0x31, 0x43, 0x99, 0x14, 0x91,
+	0xe2, 0x16, 0x30, 0x9d, 0xf4, 0x0c, 0x1c, 0xe0, 0xe9, 0xad, 0x5b, 0xdc, 0xd3, 0x9c, 0x73, 0x3a,
+	0x02, 0x87, 0xaa, 0xb6, 0x10, 0xe5, 0xcc, 0xfd, 0x58, 0x52, 0x6b, 0x2c, 0xdb, 0xc5, 0x4f, 0xb8,
+	0x83, 0x76, 0xbb, 0x9d, 0x4b, 0xf3, 0x2b, 0x76, 0xab, 0xad, 0xcf, 0xa0, 0x21, 0xdf, 0xe0, 0xbd,
+	0x8e, 0x3e, 0x53, 0x6d, 0x2c, 0x62, 0x1c, 0x7f, 0x1d, 0x04, 0x55, 0x5f, 0x67, 0xa6, 0x55, 0xf4,
+	0xc2, 0x50, 0xeb, 0xca, 0xee, 0xe3, 0xc1, 0x1c, 0x74, 0x46, 0x78, 0x76, 0xc0, 0x82, 0x1b, 0xdd,
+	0x7d, 0xab, 0x35, 0xf9, 0x11, 0xb3, 0xcc, 0x6c, 0x77, 0xa2, 0xfb, 0xa1, 0x9e, 0xbf, 0x19, 0x0e,
+	0xc3, 0x6a, 0xcd, 0x35, 0x22, 0xce, 0xc0, 0x68, 0xd8, 0x7e, 0x7b, 0xa8, 0x58, 0x15, 0x45, 0xab,
+	0x63, 0x63, 0xd7, 0x90, 0xf8, 0xeb, 0xdd, 0x70, 0x3e, 0x91, 0x11, 0x5c, 0x39, 0x6f, 0xc3, 0x41,
+	0xbf, 0x6a, 0x99, 0x6a, 0xf1, 0xcb, 0x9e, 0xa7, 0xe6, 0x94, 0x86, 0x6c, 0x63, 0x3a, 0x09, 0x83,
+	0x72, 0xcd, 0x30, 0x98, 0x66, 0x15, 0xd7, 0x15, 0xab, 0x52, 0x32, 0xa4, 0xf5, 0x22, 0x2e, 0xfe,
+	0x0c, 0x8f, 0xfa, 0x11, 0xec, 0x7e, 0x88, 0xbd, 0x0f, 0x79, 0x27, 0x1d, 0x87, 0x23, 0x4d, 0x38,
+	0x43, 0xb2, 0x18, 0x5f, 0x37, 0x3d, 0x85, 0xc3, 0x01, 0x94, 0x4d, 0xd8, 0x5e, 0x14, 0x0d, 0x69,
+	0xb1, 0xc8, 0x36, 0x64, 0xc6, 0x4a, 0xac, 0xc4, 0x57, 0x50, 0x77, 0xa1, 0xdf, 0x70, 0x63, 0x72,
+	0x17, 0x3b, 0xea, 0x0a, 0xa1, 0x7d, 0x7b, 0xdb, 0x5a, 0x9e, 0xaf, 0x12, 0x11, 0x27, 0xe0, 0x78,
+	0x68, 0x6f, 0x63, 0x2b, 0x2e, 0xf8, 0xb6, 0xa2, 0xf3, 0x24, 0xae, 0xe0, 0x91, 0x30, 0xab, 0x6b,
+	0x8f, 0x99, 0x61, 0x97, 0xc2, 0x2b, 0xba, 0x0d, 0x6f, 0xba, 0x86, 0x9b, 0x4e, 0x67, 0x01, 0xba,
+	0xcb, 0x92, 0xb9, 0x54, 0x3f, 0xa0, 0x7b, 0x0a, 0xf5, 0x67, 0xf1, 0x87, 0x04, 0x4e, 0x46, 0x98,
+	0x45, 0x7f, 0xde, 0x84, 0x7e, 0x57, 0x74, 0x99, 0x97, 0xcc, 0x45, 0xcd, 0xee, 0x74, 0xf5, 0xca,
+	0xa6, 0x0e, 0x7b, 0x34, 0x57, 0x49, 0x65, 0x5d, 0x9d, 0x63, 0x0c, 0x47, 0xef, 0xc6, 0xdd, 0x13,
+	0xec, 0xa0, 0x23, 0xd0, 0x67, 0xff, 0xf5, 0x16, 0x4a, 0x19, 0x9e, 0xeb, 0x60, 0xb3, 0x38, 0x8c,
+	0x8a, 0xc8, 0x3d, 0x66, 0x9a, 0x52, 0x99, 0x2d, 0x4b, 0xa6, 0xa9, 0x68, 0xe5, 0xe5, 0x86, 0x45,
+	0x37, 0xba, 0x73, 0x70, 0xae, 0xd5, 0x40, 0x24, 0x76, 0x02, 0x7a, 0xde, 0x65, 0xcc, 0x47, 0xa8,
+	0xd1, 0x20, 0x0e, 0x35, 0x9f, 0xc0, 0x73, 0xb6, 0xa2, 0xec, 0xce, 0xf3, 0x01, 0x81, 0x93, 0x11,
+	0x03, 0xd0, 0xbe, 0x04, 0x87, 0x8c, 0x40, 0x1f, 0x56, 0x1b, 0xf9, 0xa4, 0xdb, 0x1c, 0x61, 0xb8,
+	0xcf, 0x9b, 0xcc, 0x89, 0xb7, 0xe1, 0x8c, 0xf7, 0x5d, 0xd8, 0x83, 0xfb, 0xb2, 0x25, 0x59, 0x2c,
+	0xc1, 0xb5, 0xf6, 0x23, 0x57, 0xf6, 0x8a, 0x34, 0x81, 0x6c, 0x0a, 0xd0, 0x65, 0xda, 0x0d, 0x48,
+	0x61, 0x32, 0xd9, 0x9b, 0x65, 0xd0, 0x1c, 0x32, 0x71, 0x4c, 0xd1, 0x51, 0xe8, 0xaf, 0x30, 0xb5,
+	0xe4, 0x1c, 0x99, 0xbc, 0xd4, 0x64, 0x26, 0xbf, 0x62, 0x7a, 0x0a, 0x7d, 0x15, 0xbc, 0x63, 0x17,
+	0x9d, 0x66, 0x71, 0x19, 0xf7, 0x94, 0x5f, 0x85, 0x49, 0x50, 0x9b, 0x0c, 0xc2, 0x3e, 0xfb, 0x40,
+	0xb6, 0xd5, 0x04, 0x67, 0x1d, 0xee, 0xb5, 0x36, 0xb8, 0x90, 0xb0, 0x09, 0xc7, 0x43, 0x2d, 0x22,
+	0xe1, 0xaf, 0x43, 0x5f, 0xe0, 0x8b, 0x07, 0x52, 0xef, 0x84, 0x4e, 0x34, 0xfe, 0x6c, 0x02, 0xba,
+	0xf8, 0xec, 0xf4, 0x13, 0x02, 0x7d, 0x01, 0xb1, 0x93, 0xbe, 0xd5, 0x62, 0x8a, 0xf8, 0x4f, 0x02,
+	0xc2, 0xcd, 0xb4, 0x70, 0x87, 0xba, 0x78, 0xfb, 0x83, 0xbf, 0xfd, 0xe7, 0xc3, 0xdd, 0x53, 0xf4,
+	0x2a, 0xff, 0xca, 0x32, 0xe6, 0xf9, 0x36, 0xe5, 0xff, 0x3a, 0x83, 0xb8, 0xfc, 0x26, 0x16, 0xfc,
+	0x5b, 0xf9, 0x4d, 0x5e, 0xe2, 0x6f, 0xd1, 0xdf, 0x12, 0xa0, 0x01, 0xeb, 0xd3, 0xaa, 0x9a, 0x8c,
+	0x57, 0xe4, 0x47, 0x01, 0xe1, 0x66, 0x5a, 0x38, 0xf2, 0xca, 0x71, 0x5e, 0x23, 0xf4, 0x5c, 0x32,
+	0x5e, 0xf4, 0x73, 0x02, 0xc7, 0x9a, 0x59, 0xa0, 0x06, 0x4b, 0xef, 0xa4, 0xf3, 0xc6, 0x2f, 0x27,
+	0x0b, 0x77, 0xb7, 0x69, 0x05, 0xa9, 0xbd, 0xc5, 0xa9, 0x5d, 0xa1, 0x13, 0xc9, 0xa8, 0x21, 0x1c,
+	0x33, 0xb7, 0x45, 0xff, 0x4b, 0x20, 0xbb, 0xa8, 0x45, 0x10, 0x9d, 0x4d, 0xe8, 0x62, 0x9c, 0x6c,
+	0x2e, 0xdc, 0xd9, 0x9e, 0x11, 0xa4, 0x79, 0x8b, 0xd3, 0xbc, 0x46, 0xaf, 0x44, 0xd0, 0x54, 0xb4,
+	0x68, 0x96, 0x45, 0xa5, 0xb4, 0x45, 0x7f, 0x43, 0xa0, 0x7f, 0x51, 0x4b, 0xbb, 0x2e, 0xc3, 0xd5,
+	0x6b, 0xe1, 0x66, 0x5a, 0x78, 0xc2, 0x75, 0xe9, 0x67, 0x65, 0xd2, 0x67, 0x04, 0x0e, 0xfa, 0x6d,
+	0xd1, 0x6b, 0x49, 0x5c, 0x08, 0x3d, 0x3b, 0x85, 0xa9, 0x34, 0x50, 0xf4, 0x7c, 0x86, 0x7b, 0x7e,
+	0x83, 0x4e, 0x25, 0xf2, 0xdc, 0x93, 0x88, 0xfc, 0x26, 0x1e, 0xca, 0x5b, 0xf4, 0xef, 0x8d, 0x94,
+	0x78, 0xf4, 0xc6, 0x5b, 0x09, 0xcf, 0xb0, 0x28, 0x11, 0x56, 0xb8, 0x9d, 0xde, 0x00, 0x92, 0xbb,
+	0xc9, 0xc9, 0x5d, 0xa5, 0x93, 0xf1, 0xe4, 0x1a, 0xc8, 0xfc, 0xa6, 0xa7, 0x69, 0x8b, 0x7e, 0x4a,
+	0xe0, 0x48, 0xa8, 0x4a, 0x4d, 0x6f, 0xb7, 0x11, 0xf2, 0x50, 0x9d, 0x5c, 0x98, 0xde, 0x86, 0x85,
+	0xf6, 0x72, 0xe7, 0x47, 0x07, 0x28, 0x3e, 0x23, 0x30, 0xd0, 0x34, 0x8b, 0xbd, 0xa3, 0x6e, 0xb5,
+	0xb7, 0x25, 0x52, 0xa6, 0x2f, 0x4e, 0x17, 0x17, 0x2f, 0x70, 0x7e, 0xa3, 0x74, 0x24, 0x29, 0x3f,
+	0xfa, 0x53, 0xd2, 0x50, 0x62, 0xe9, 0x64, 0xc2, 0xf5, 0x13, 0x90, 0x8c, 0x85, 0x2b, 0x6d, 0xe3,
+	0xd0, 0xdf, 0x3c, 0xf7, 0xf7, 0x0d, 0x3a, 0x1c, 0xe1, 0x6f, 0x19, 0x01, 0x76, 0x0a, 0x4a, 0x6c,
+	0x63, 0x8b, 0xfe, 0x98, 0x40, 0xaf, 0x6b, 0xc5, 0x8e, 0xf9, 0x64, 0xc2, 0x90, 0xa5, 0xf2, 0x38,
+	0x44, 0xb8, 0x16, 0x87, 0xb9, 0xc7, 0xaf, 0xd1, 0x53, 0x2d, 0x3c, 0xa6, 0xbf, 0x22, 0x70, 0x28,
+	0xf8, 0x82, 0x41, 0xaf, 0x27, 0x99, 0x36, 0xe2, 0x6d, 0x47, 0xb8, 0x91, 0x0e, 0x9c, 0x30, 0xd4,
+	0x72, 0xd0, 0xd7, 0x3f, 0x10, 0xe8, 0xf5, 0xbc, 0x43, 0x24, 0xbb, 0xfb, 0x5b, 0xbd, 0xab, 0x08,
+	0x77, 0xb7, 0x69, 0x05, 0xd9, 0x8c, 0x72, 0x36, 0xaf, 0x53, 0x31, 0x82, 0x8d, 0xe7, 0xbd, 0x8b,
+	0x3e, 0x25, 0x4d, 0xda, 0x74, 0xe2, 0x6a, 0x33, 0x5c, 0x59, 0x17, 0x6e, 0xa6, 0x85, 0xa3, 0xfb,
+	0x93, 0xdc, 0xfd, 0x0b, 0x34, 0x17, 0xe1, 0xbe, 0xea, 0xc7, 0xd5, 0x97, 0xbf, 0x5d, 0x63, 0x06,
+	0x6c, 0xb6, 0x73, 0x97, 0x6f, 0x87, 0x4d, 0xb4, 0xf6, 0xdf, 0xf2, 0x2e, 0x0f, 0xb0, 0xa1, 0x3f,
+	0x20, 0xb0, 0x87, 0x1f, 0x3e, 0xe3, 0x09, 0xc3, 0xe8, 0x3d, 0x24, 0x2f, 0xb5, 0x85, 0x41, 0x0f,
+	0xcf, 0x73, 0x0f, 0xcf, 0xd2, 0x33, 0x51, 0x8b, 0x1f, 0x6f, 0x32, 0x1e, 0xe4, 0x9f, 0x11, 0xe8,
+	0xf5, 0x68, 0xfe, 0xf4, 0x5a, 0x1b, 0x33, 0xfa, 0xbf, 0x13, 0xa4, 0x73, 0x76, 0x82, 0x3b, 0x9b,
+	0xa7, 0x63, 0xb1, 0xce, 0x36, 0xbd, 0x7f, 0x7c, 0x9f, 0xc0, 0x3e, 0xf7, 0x2a, 0x1a, 0x4f, 0x98,
+	0xd1, 0xb6, 0x03, 0x1b, 0x50, 0xf6, 0xc5, 0x33, 0xdc, 0xd7, 0x93, 0xf4, 0x78, 0x8c, 0xaf, 0xf4,
+	0x63, 0x7b, 0x03, 0xfa, 0xa5, 0x35, 0x9a, 0xa8, 0x02, 0x0b, 0x57, 0xe5, 0x85, 0xeb, 0xa9, 0xb0,
+	0x49, 0x4f, 0x0e, 0x8f, 0x93, 0xff, 0x23, 0x30, 0x14, 0xaf, 0x09, 0xd2, 0xc5, 0x14, 0xbe, 0x84,
+	0x8b, 0x93, 0xc2, 0x97, 0x3a, 0x61, 0x0a, 0x59, 0x5e, 0xe3, 0x2c, 0x2f, 0xd1, 0x8b, 0xad, 0x59,
+	0x06, 0x19, 0x7d, 0x4c, 0xe0, 0xa0, 0xff, 0x3f, 0xf9, 0x92, 0xed, 0x80, 0xd0, 0xff, 0x0d, 0x14,
+	0xa6, 0xd2, 0x40, 0x91, 0xc4, 0x18, 0x27, 0x31, 0x4c, 0xcf, 0x46, 0x90, 0x78, 0xdf, 0xef, 0xa5,
+	0xed, 0xb8, 0x5f, 0x60, 0x4c, 0xe6, 0x78, 0xa8, 0x64, 0x29, 0x4c, 0xa5, 0x81, 0x26, 0x74, 0x5c,
+	0xf5, 0x7b, 0x69, 0x97, 0x0a, 0x41, 0xfd, 0x2b, 0x59, 0xa9, 0x10, 0xa1, 0xd4, 0x09, 0x37, 0xd2,
+	0x81, 0x13, 0x96, 0x0a, 0x41, 0x4d, 0x2e, 0x48, 0x80, 0x7f, 0x67, 0x69, 0x9b, 0x80, 0xf7, 0x63,
+	0x8f, 0x70, 0x23, 0x1d, 0xb8, 0x7d, 0x02, 0x8e, 0xaf, 0x9f, 0x11, 0x18, 0x8c, 0x90, 0xef, 0xe8,
+	0x4c, 0x1b, 0xaf, 0x1d, 0x11, 0x6a, 0xa4, 0x30, 0xbb, 0x2d, 0x1b, 0xc8, 0x6a, 0x9a, 0xb3, 0xba,
+	0x4e, 0xaf, 0xc5, 0x17, 0xf7, 0x41, 0xbc, 0x57, 0x0a, 0xf8, 0x39, 0x81, 0xfd, 0xde, 0xaf, 0x79,
+	0xf4, 0x4a, 0xd2, 0x33, 0x27, 0xf0, 0xd9, 0x50, 0xb8, 0xda, 0x3e, 0x10, 0x69, 0x8c, 0x73, 0x1a,
+	0x6f, 0xd2, 0xd1, 0x08, 0x1a, 0xae, 0x0a, 0xea, 0xf5, 0xfb, 0xcf, 0x04, 0xf6, 0x3f, 0xa8, 0x59,
+	0x2b, 0x1b, 0x3b, 0x44, 0x2d, 0x4c, 0x20, 0x3d, 0xd5, 0x7d, 0x0d, 0xb9, 0xaa, 0x7f, 0xe9, 0xe8,
+	0x9f, 0xf5, 0x21, 0x3b, 0x40, 0x27, 0x6c, 0x55, 0x21, 0x79, 0x19, 0xd1, 0x7f, 0x13, 0x38, 0x1a,
+	0xf0, 0x7f, 0x47, 0x2a, 0x84, 0x53, 0x9c, 0xd4, 0x65, 0x3a, 0x9e, 0x80, 0x54, 0x50, 0x1e, 0x74,
+	0x94, 0x8c, 0x30, 0x8a, 0x3b, 0x48, 0x1b, 0xbc, 0xc1, 0x09, 0x4e, 0xd2, 0xcb, 0x91, 0x47, 0x42,
+	0x04, 0x3f, 0xbe, 0xab, 0x7e, 0xc1, 0x35, 0xb5, 0x54, 0xab, 0xf0, 0x15, 0xa9, 0x82, 0xad, 0x8a,
+	0x33, 0x0f, 0x1f, 0xfa, 0x1c, 0xbd, 0xdf, 0x59, 0x02, 0xda, 0x75, 0xce, 0x60, 0x82, 0x5e, 0x8a,
+	0x61, 0x10, 0xa9, 0x9e, 0xfd, 0x93, 0x00, 0xf5, 0x53, 0xda, 0x39, 0xd2, 0x59, 0x6b, 0x19, 0x3a,
+	0xe8, 0x77, 0x80, 0xdc, 0xef, 0xb9, 0xe6, 0xe9, 0x1d, 0xb4, 0x43, 0x44, 0xb3, 0x56, 0xd5, 0x9a,
+	0x9f, 0x99, 0xd0, 0xf5, 0xad, 0xcf, 0x3f, 0x1a, 0x25, 0x33, 0xf3, 0x4f, 0x5f, 0x0c, 0x91, 0xe7,
+	0x2f, 0x86, 0xc8, 0xa7, 0x2f, 0x86, 0xc8, 0xf7, 0x5e, 0x0e, 0xed, 0x7a, 0xfe, 0x72, 0x68, 0xd7,
+	0x3f, 0x5e, 0x0e, 0xed, 0x7a, 0x67, 0xac, 0xac, 0x58, 0x95, 0xda, 0x6a, 0x4e, 0xd6, 0xd7, 0xbc,
+	0x16, 0x35, 0xbd, 0xc4, 0xf2, 0x1b, 0x5e, 0xc3, 0xd6, 0x93, 0x2a, 0x33, 0x57, 0xf7, 0x72, 0xad,
+	0xe2, 0xd2, 0xff, 0x07, 0x00, 0xcd, 0xc8, 0xea, 0x61, 0x9e, 0x35, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2582,6 +2789,10 @@ type QueryClient interface {
 	RateLimiterFlags(ctx context.Context, in *QueryRateLimiterFlagsRequest, opts ...grpc.CallOption) (*QueryRateLimiterFlagsResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(ctx context.Context, in *QueryRateLimiterInputRequest, opts ...grpc.CallOption) (*QueryRateLimiterInputResponse, error)
+	// Queries the inbound rate limiter state of a chain and its held cctxs.
+	InboundRateLimiterState(ctx context.Context, in *QueryInboundRateLimiterStateRequest, opts ...grpc.CallOption) (*QueryInboundRateLimiterStateResponse, error)
+	// Queries the held cctxs of a chain waiting for release or reject.
+	ListHeldCctx(ctx context.Context, in *QueryListHeldCctxRequest, opts ...grpc.CallOption) (*QueryListHeldCctxResponse, error)
 	// Deprecated(v17): use OutboundTracker
 	OutTxTracker(ctx context.Context, in *QueryGetOutboundTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutboundTrackerResponse, error)
 	// Deprecated(v17): use OutboundTrackerAll
@@ -2824,6 +3035,24 @@ func (c *queryClient) RateLimiterInput(ctx context.Context, in *QueryRateLimiter
 	return out, nil
 }
 
+func (c *queryClient) InboundRateLimiterState(ctx context.Context, in *QueryInboundRateLimiterStateRequest, opts ...grpc.CallOption) (*QueryInboundRateLimiterStateResponse, error) {
+	out := new(QueryInboundRateLimiterStateResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InboundRateLimiterState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListHeldCctx(ctx context.Context, in *QueryListHeldCctxRequest, opts ...grpc.CallOption) (*QueryListHeldCctxResponse, error) {
+	out := new(QueryListHeldCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ListHeldCctx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) OutTxTracker(ctx context.Context, in *QueryGetOutboundTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutboundTrackerResponse, error) {
 	out := new(QueryGetOutboundTrackerResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTracker", in, out, opts...)
@@ -2939,6 +3168,10 @@ type QueryServer interface {
 	RateLimiterFlags(context.Context, *QueryRateLimiterFlagsRequest) (*QueryRateLimiterFlagsResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(context.Context, *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error)
+	// Queries the inbound rate limiter state of a chain and its held cctxs.
+	InboundRateLimiterState(context.Context, *QueryInboundRateLimiterStateRequest) (*QueryInboundRateLimiterStateResponse, error)
+	// Queries the held cctxs of a chain waiting for release or reject.
+	ListHeldCctx(context.Context, *QueryListHeldCctxRequest) (*QueryListHeldCctxResponse, error)
 	// Deprecated(v17): use OutboundTracker
 	OutTxTracker(context.Context, *QueryGetOutboundTrackerRequest) (*QueryGetOutboundTrackerResponse, error)
 	// Deprecated(v17): use OutboundTrackerAll
@@ -3033,6 +3266,12 @@ func (*UnimplementedQueryServer) RateLimiterFlags(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) RateLimiterInput(ctx context.Context, req *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterInput not implemented")
 }
+func (*UnimplementedQueryServer) InboundRateLimiterState(ctx context.Context, req *QueryInboundRateLimiterStateRequest) (*QueryInboundRateLimiterStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InboundRateLimiterState not implemented")
+}
+func (*UnimplementedQueryServer) ListHeldCctx(ctx context.Context, req *QueryListHeldCctxRequest) (*QueryListHeldCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHeldCctx not implemented")
+}
 func (*UnimplementedQueryServer) OutTxTracker(ctx context.Context, req *QueryGetOutboundTrackerRequest) (*QueryGetOutboundTrackerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OutTxTracker not implemented")
 }
@@ -3494,6 +3733,42 @@ func _Query_RateLimiterInput_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InboundRateLimiterState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInboundRateLimiterStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InboundRateLimiterState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InboundRateLimiterState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InboundRateLimiterState(ctx, req.(*QueryInboundRateLimiterStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListHeldCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListHeldCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListHeldCctx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ListHeldCctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListHeldCctx(ctx, req.(*QueryListHeldCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_OutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetOutboundTrackerRequest)
 	if err := dec(in); err != nil {
@@ -3738,6 +4013,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RateLimiterInput",
 			Handler:    _Query_RateLimiterInput_Handler,
 		},
+		{
+			MethodName: "InboundRateLimiterState",
+			Handler:    _Query_InboundRateLimiterState_Handler,
+		},
+		{
+			MethodName: "ListHeldCctx",
+			Handler:    _Query_ListHeldCctx_Handler,
+		},
 		{
 			MethodName: "OutTxTracker",
 			Handler:    _Query_OutTxTracker_Handler,
@@ -5019,7 +5302,7 @@ func (m *QueryListPendingCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRateLimiterInputRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListHeldCctxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5029,30 +5312,25 @@ func (m *QueryRateLimiterInputRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRateLimiterInputRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListHeldCctxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRateLimiterInputRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListHeldCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Window != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRateLimiterInputResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListHeldCctxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5062,58 +5340,20 @@ func (m *QueryRateLimiterInputResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRateLimiterInputResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListHeldCctxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRateLimiterInputResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListHeldCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LowestPendingCctxHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LowestPendingCctxHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.PendingCctxsValue) > 0 {
-		i -= len(m.PendingCctxsValue)
-		copy(dAtA[i:], m.PendingCctxsValue)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingCctxsValue)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.PastCctxsValue) > 0 {
-		i -= len(m.PastCctxsValue)
-		copy(dAtA[i:], m.PastCctxsValue)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PastCctxsValue)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TotalPending != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.CctxsPending) > 0 {
-		for iNdEx := len(m.CctxsPending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CctxsPending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.CctxsMissed) > 0 {
-		for iNdEx := len(m.CctxsMissed) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CctxsMissed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5121,18 +5361,140 @@ func (m *QueryRateLimiterInputResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPendingCctxWithinRateLimitRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRateLimiterInputRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimiterInputRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimiterInputRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimiterInputResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimiterInputResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimiterInputResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.LowestPendingCctxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowestPendingCctxHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PendingCctxsValue) > 0 {
+		i -= len(m.PendingCctxsValue)
+		copy(dAtA[i:], m.PendingCctxsValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingCctxsValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PastCctxsValue) > 0 {
+		i -= len(m.PastCctxsValue)
+		copy(dAtA[i:], m.PastCctxsValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PastCctxsValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalPending != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CctxsPending) > 0 {
+		for iNdEx := len(m.CctxsPending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CctxsPending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CctxsMissed) > 0 {
+		for iNdEx := len(m.CctxsMissed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CctxsMissed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingCctxWithinRateLimitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5461,6 +5823,76 @@ func (m *QueryRateLimiterFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInboundRateLimiterStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInboundRateLimiterStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInboundRateLimiterStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInboundRateLimiterStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInboundRateLimiterStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInboundRateLimiterStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeldCctxIndexes) > 0 {
+		for iNdEx := len(m.HeldCctxIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HeldCctxIndexes[iNdEx])
+			copy(dAtA[i:], m.HeldCctxIndexes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.HeldCctxIndexes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryInboundTrackerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6031,6 +6463,33 @@ func (m *QueryListPendingCctxResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryListHeldCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryListHeldCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryRateLimiterInputRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6081,6 +6540,12 @@ func (m *QueryRateLimiterInputResponse) Size() (n int) {
 	if m.LowestPendingCctxHeight != 0 {
 		n += 1 + sovQuery(uint64(m.LowestPendingCctxHeight))
 	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6223,6 +6688,35 @@ func (m *QueryRateLimiterFlagsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInboundRateLimiterStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryInboundRateLimiterStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.HeldCctxIndexes) > 0 {
+		for _, s := range m.HeldCctxIndexes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryInboundTrackerRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9379,7 +9873,7 @@ func (m *QueryListPendingCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimiterInputRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListHeldCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9402,17 +9896,17 @@ func (m *QueryRateLimiterInputRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimiterInputRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListHeldCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimiterInputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListHeldCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Limit = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9422,20 +9916,173 @@ func (m *QueryRateLimiterInputRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Window = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListHeldCctxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListHeldCctxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListHeldCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimiterInputRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimiterInputRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimiterInputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
@@ -9685,6 +10332,40 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, RateLimitBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10567,6 +11248,190 @@ func (m *QueryRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInboundRateLimiterStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInboundRateLimiterStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInboundRateLimiterStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInboundRateLimiterStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInboundRateLimiterStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInboundRateLimiterStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldCctxIndexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldCctxIndexes = append(m.HeldCctxIndexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryInboundTrackerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
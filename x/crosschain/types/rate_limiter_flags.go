@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -36,9 +37,123 @@ func (r RateLimiterFlags) Validate() error {
 		}
 	}
 
+	seenChains := make(map[int64]bool)
+	for _, limit := range r.ChainRateLimits {
+		// check no duplicated chain rate limit
+		if seenChains[limit.ChainId] {
+			return fmt.Errorf("duplicated chain rate limit: %d", limit.ChainId)
+		}
+		seenChains[limit.ChainId] = true
+
+		if err := validateRateLimit(limit.Window, limit.Rate); err != nil {
+			return fmt.Errorf("invalid rate limit for chain %d: %w", limit.ChainId, err)
+		}
+	}
+
+	seenAssets := make(map[string]bool)
+	for _, limit := range r.AssetRateLimits {
+		// check no duplicated asset rate limit
+		if seenAssets[strings.ToLower(limit.Zrc20)] {
+			return fmt.Errorf("duplicated asset rate limit: %s", limit.Zrc20)
+		}
+		seenAssets[strings.ToLower(limit.Zrc20)] = true
+
+		// check address is valid
+		if !ethcommon.IsHexAddress(limit.Zrc20) {
+			return fmt.Errorf("invalid zrc20 address (%s)", limit.Zrc20)
+		}
+
+		if err := validateRateLimit(limit.Window, limit.Rate); err != nil {
+			return fmt.Errorf("invalid rate limit for zrc20 %s: %w", limit.Zrc20, err)
+		}
+	}
+
+	seenInboundChains := make(map[int64]bool)
+	for _, limit := range r.InboundRateLimits {
+		// check no duplicated inbound rate limit
+		if seenInboundChains[limit.ChainId] {
+			return fmt.Errorf("duplicated inbound rate limit: %d", limit.ChainId)
+		}
+		seenInboundChains[limit.ChainId] = true
+
+		if err := validateRateLimit(limit.Window, limit.Rate); err != nil {
+			return fmt.Errorf("invalid inbound rate limit for chain %d: %w", limit.ChainId, err)
+		}
+	}
+
+	seenThresholdChains := make(map[int64]bool)
+	for _, threshold := range r.HeldCctxThresholds {
+		// check no duplicated held cctx threshold
+		if seenThresholdChains[threshold.ChainId] {
+			return fmt.Errorf("duplicated held cctx threshold: %d", threshold.ChainId)
+		}
+		seenThresholdChains[threshold.ChainId] = true
+
+		if threshold.Threshold.IsNil() {
+			return fmt.Errorf("threshold is nil for chain: %d", threshold.ChainId)
+		}
+	}
+
+	return nil
+}
+
+// IsGlobalRateLimitSet returns true if the global rate limit is set, i.e. its window and rate are positive
+func (r RateLimiterFlags) IsGlobalRateLimitSet() bool {
+	return r.Window > 0 && !r.Rate.IsNil() && !r.Rate.IsZero()
+}
+
+// GetInboundRateLimit returns the inbound rate limit of the given chain
+func (r RateLimiterFlags) GetInboundRateLimit(chainID int64) (ChainRateLimit, bool) {
+	for _, limit := range r.InboundRateLimits {
+		if limit.ChainId == chainID {
+			return limit, true
+		}
+	}
+	return ChainRateLimit{}, false
+}
+
+// GetHeldCctxThreshold returns the value above which the withdrawals to the given chain are held
+func (r RateLimiterFlags) GetHeldCctxThreshold(chainID int64) (sdkmath.Uint, bool) {
+	for _, threshold := range r.HeldCctxThresholds {
+		if threshold.ChainId == chainID {
+			return threshold.Threshold, true
+		}
+	}
+	return sdkmath.Uint{}, false
+}
+
+// validateRateLimit checks that the window and rate of a per-chain or per-asset rate limit are positive
+func validateRateLimit(window int64, rate sdkmath.Uint) error {
+	if window <= 0 {
+		return fmt.Errorf("window must be positive: %d", window)
+	}
+	if rate.IsNil() || rate.IsZero() {
+		return errors.New("rate must be positive")
+	}
 	return nil
 }
 
+// Consume counts the value in the current window of the inbound rate limiter if it's within the rate limit,
+// a new window starts once the current one is over.
+// A value larger than the whole window limit is counted in an empty window, taking it up entirely,
+// otherwise it could never be counted and would block the inbounds held after it.
+func (s *InboundRateLimiterState) Consume(limit ChainRateLimit, value sdkmath.Uint, height int64) bool {
+	if s.WindowStartHeight == 0 || s.WindowValue.IsNil() || height >= s.WindowStartHeight+limit.Window {
+		s.WindowStartHeight = height
+		s.WindowValue = sdkmath.ZeroUint()
+	}
+
+	// #nosec G115 window is positive
+	windowLimit := limit.Rate.MulUint64(uint64(limit.Window))
+	windowValue := s.WindowValue.Add(value)
+	if windowValue.GT(windowLimit) && !s.WindowValue.IsZero() {
+		return false
+	}
+
+	s.WindowValue = windowValue
+	return true
+}
+
 // GetConversionRate returns the conversion rate for the given zrc20
 func (r RateLimiterFlags) GetConversionRate(zrc20 string) (sdkmath.LegacyDec, bool) {
 	for _, conversion := range r.Conversions {
@@ -49,6 +164,26 @@ func (r RateLimiterFlags) GetConversionRate(zrc20 string) (sdkmath.LegacyDec, bo
 	return sdkmath.LegacyNewDec(0), false
 }
 
+// Contains returns true if the cctx is counted in the bucket,
+// i.e. it's a withdrawal to the chain of the bucket and, for an asset bucket, of the asset of the bucket
+func (b RateLimitBucket) Contains(cctx *CrossChainTx) bool {
+	if cctx.GetCurrentOutboundParam().ReceiverChainId != b.ChainId {
+		return false
+	}
+
+	// chain bucket
+	if b.Zrc20 == "" {
+		return true
+	}
+
+	if cctx.InboundParams.CoinType != b.CoinType {
+		return false
+	}
+
+	// the gas asset is identified by the chain
+	return b.CoinType == coin.CoinType_Gas || strings.EqualFold(cctx.InboundParams.Asset, b.Asset)
+}
+
 // BuildAssetRateMapFromList builds maps (foreign chain id -> asset -> rate) from a list of gas and erc20 asset rates
 // The 1st map: foreign chain id -> gas coin asset rate
 // The 2nd map: foreign chain id -> erc20 asset -> erc20 coin asset rate
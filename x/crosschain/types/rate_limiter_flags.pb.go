@@ -33,6 +33,20 @@ type RateLimiterFlags struct {
 	Rate cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.Uint" json:"rate"`
 	// conversion in azeta per token
 	Conversions []Conversion `protobuf:"bytes,4,rep,name=conversions,proto3" json:"conversions"`
+	// optional rate limits of the withdrawals to a given chain,
+	// enforced in addition to the global rate limit
+	ChainRateLimits []ChainRateLimit `protobuf:"bytes,5,rep,name=chain_rate_limits,json=chainRateLimits,proto3" json:"chain_rate_limits"`
+	// optional rate limits of the withdrawals of a given zrc20,
+	// enforced in addition to the global rate limit
+	AssetRateLimits []AssetRateLimit `protobuf:"bytes,6,rep,name=asset_rate_limits,json=assetRateLimits,proto3" json:"asset_rate_limits"`
+	// optional rate limits of the deposits from a given chain, enforced
+	// regardless of the enabled flag, the deposits over the limit are held
+	// until the window frees up
+	InboundRateLimits []ChainRateLimit `protobuf:"bytes,7,rep,name=inbound_rate_limits,json=inboundRateLimits,proto3" json:"inbound_rate_limits"`
+	// optional thresholds of the withdrawals to a given chain, enforced
+	// regardless of the enabled flag, the withdrawals over the threshold are
+	// held until released or rejected by the authority
+	HeldCctxThresholds []HeldCctxThreshold `protobuf:"bytes,8,rep,name=held_cctx_thresholds,json=heldCctxThresholds,proto3" json:"held_cctx_thresholds"`
 }
 
 func (m *RateLimiterFlags) Reset()         { *m = RateLimiterFlags{} }
@@ -89,6 +103,195 @@ func (m *RateLimiterFlags) GetConversions() []Conversion {
 	return nil
 }
 
+func (m *RateLimiterFlags) GetChainRateLimits() []ChainRateLimit {
+	if m != nil {
+		return m.ChainRateLimits
+	}
+	return nil
+}
+
+func (m *RateLimiterFlags) GetAssetRateLimits() []AssetRateLimit {
+	if m != nil {
+		return m.AssetRateLimits
+	}
+	return nil
+}
+
+func (m *RateLimiterFlags) GetInboundRateLimits() []ChainRateLimit {
+	if m != nil {
+		return m.InboundRateLimits
+	}
+	return nil
+}
+
+func (m *RateLimiterFlags) GetHeldCctxThresholds() []HeldCctxThreshold {
+	if m != nil {
+		return m.HeldCctxThresholds
+	}
+	return nil
+}
+
+// ChainRateLimit is the rate limit of the withdrawals to a foreign chain
+type ChainRateLimit struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// rate in azeta per block
+	Rate cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.Uint" json:"rate"`
+}
+
+func (m *ChainRateLimit) Reset()         { *m = ChainRateLimit{} }
+func (m *ChainRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ChainRateLimit) ProtoMessage()    {}
+func (*ChainRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{1}
+}
+func (m *ChainRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRateLimit.Merge(m, src)
+}
+func (m *ChainRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRateLimit proto.InternalMessageInfo
+
+func (m *ChainRateLimit) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainRateLimit) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// AssetRateLimit is the rate limit of the withdrawals of a zrc20,
+// the value of the withdrawals is priced with the conversion of the zrc20
+type AssetRateLimit struct {
+	Zrc20 string `protobuf:"bytes,1,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// rate in azeta per block
+	Rate cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.Uint" json:"rate"`
+}
+
+func (m *AssetRateLimit) Reset()         { *m = AssetRateLimit{} }
+func (m *AssetRateLimit) String() string { return proto.CompactTextString(m) }
+func (*AssetRateLimit) ProtoMessage()    {}
+func (*AssetRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{2}
+}
+func (m *AssetRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetRateLimit.Merge(m, src)
+}
+func (m *AssetRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetRateLimit proto.InternalMessageInfo
+
+func (m *AssetRateLimit) GetZrc20() string {
+	if m != nil {
+		return m.Zrc20
+	}
+	return ""
+}
+
+func (m *AssetRateLimit) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// HeldCctxThreshold is the value above which the withdrawals to a foreign chain
+// are held, the value of the withdrawals is priced with the conversions
+type HeldCctxThreshold struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// threshold in azeta
+	Threshold cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Uint" json:"threshold"`
+}
+
+func (m *HeldCctxThreshold) Reset()         { *m = HeldCctxThreshold{} }
+func (m *HeldCctxThreshold) String() string { return proto.CompactTextString(m) }
+func (*HeldCctxThreshold) ProtoMessage()    {}
+func (*HeldCctxThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{3}
+}
+func (m *HeldCctxThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldCctxThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldCctxThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeldCctxThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldCctxThreshold.Merge(m, src)
+}
+func (m *HeldCctxThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldCctxThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldCctxThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldCctxThreshold proto.InternalMessageInfo
+
+func (m *HeldCctxThreshold) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
 type Conversion struct {
 	Zrc20 string                      `protobuf:"bytes,1,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
 	Rate  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
@@ -98,7 +301,7 @@ func (m *Conversion) Reset()         { *m = Conversion{} }
 func (m *Conversion) String() string { return proto.CompactTextString(m) }
 func (*Conversion) ProtoMessage()    {}
 func (*Conversion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c435f4c2dabc0eb, []int{1}
+	return fileDescriptor_9c435f4c2dabc0eb, []int{4}
 }
 func (m *Conversion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +349,7 @@ func (m *AssetRate) Reset()         { *m = AssetRate{} }
 func (m *AssetRate) String() string { return proto.CompactTextString(m) }
 func (*AssetRate) ProtoMessage()    {}
 func (*AssetRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c435f4c2dabc0eb, []int{2}
+	return fileDescriptor_9c435f4c2dabc0eb, []int{5}
 }
 func (m *AssetRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,10 +406,190 @@ func (m *AssetRate) GetCoinType() coin.CoinType {
 	return coin.CoinType_Zeta
 }
 
+// RateLimitBucket is the usage of a per-chain or per-asset rate limit
+type RateLimitBucket struct {
+	// foreign chain of the bucket, also set for an asset bucket
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// zrc20 of an asset bucket, empty for a chain bucket
+	Zrc20 string `protobuf:"bytes,2,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
+	// coin type and asset on the foreign chain of an asset bucket
+	CoinType coin.CoinType `protobuf:"varint,3,opt,name=coin_type,json=coinType,proto3,enum=zetachain.zetacore.pkg.coin.CoinType" json:"coin_type,omitempty"`
+	Asset    string        `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,5,opt,name=window,proto3" json:"window,omitempty"`
+	// rate in azeta per block
+	Rate cosmossdk_io_math.Uint `protobuf:"bytes,6,opt,name=rate,proto3,customtype=cosmossdk.io/math.Uint" json:"rate"`
+	// the total value in azeta of the past cctxs of the bucket within its window
+	PastCctxsValue string `protobuf:"bytes,7,opt,name=past_cctxs_value,json=pastCctxsValue,proto3" json:"past_cctxs_value,omitempty"`
+	// the total value in azeta of the pending cctxs of the bucket
+	PendingCctxsValue string `protobuf:"bytes,8,opt,name=pending_cctxs_value,json=pendingCctxsValue,proto3" json:"pending_cctxs_value,omitempty"`
+	// the lowest height of the pending cctxs of the bucket
+	LowestPendingCctxHeight int64 `protobuf:"varint,9,opt,name=lowest_pending_cctx_height,json=lowestPendingCctxHeight,proto3" json:"lowest_pending_cctx_height,omitempty"`
+}
+
+func (m *RateLimitBucket) Reset()         { *m = RateLimitBucket{} }
+func (m *RateLimitBucket) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucket) ProtoMessage()    {}
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{6}
+}
+func (m *RateLimitBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucket.Merge(m, src)
+}
+func (m *RateLimitBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitBucket proto.InternalMessageInfo
+
+func (m *RateLimitBucket) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *RateLimitBucket) GetZrc20() string {
+	if m != nil {
+		return m.Zrc20
+	}
+	return ""
+}
+
+func (m *RateLimitBucket) GetCoinType() coin.CoinType {
+	if m != nil {
+		return m.CoinType
+	}
+	return coin.CoinType_Zeta
+}
+
+func (m *RateLimitBucket) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *RateLimitBucket) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *RateLimitBucket) GetPastCctxsValue() string {
+	if m != nil {
+		return m.PastCctxsValue
+	}
+	return ""
+}
+
+func (m *RateLimitBucket) GetPendingCctxsValue() string {
+	if m != nil {
+		return m.PendingCctxsValue
+	}
+	return ""
+}
+
+func (m *RateLimitBucket) GetLowestPendingCctxHeight() int64 {
+	if m != nil {
+		return m.LowestPendingCctxHeight
+	}
+	return 0
+}
+
+// InboundRateLimiterState is the state of the inbound rate limiter of a chain
+type InboundRateLimiterState struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// if tripped, all the deposits from the chain are held
+	CircuitBreakerTripped bool `protobuf:"varint,2,opt,name=circuit_breaker_tripped,json=circuitBreakerTripped,proto3" json:"circuit_breaker_tripped,omitempty"`
+	// first block of the current window
+	WindowStartHeight int64 `protobuf:"varint,3,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// the total value in azeta of the deposits within the current window
+	WindowValue cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=window_value,json=windowValue,proto3,customtype=cosmossdk.io/math.Uint" json:"window_value"`
+}
+
+func (m *InboundRateLimiterState) Reset()         { *m = InboundRateLimiterState{} }
+func (m *InboundRateLimiterState) String() string { return proto.CompactTextString(m) }
+func (*InboundRateLimiterState) ProtoMessage()    {}
+func (*InboundRateLimiterState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{7}
+}
+func (m *InboundRateLimiterState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundRateLimiterState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundRateLimiterState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InboundRateLimiterState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundRateLimiterState.Merge(m, src)
+}
+func (m *InboundRateLimiterState) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundRateLimiterState) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundRateLimiterState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundRateLimiterState proto.InternalMessageInfo
+
+func (m *InboundRateLimiterState) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *InboundRateLimiterState) GetCircuitBreakerTripped() bool {
+	if m != nil {
+		return m.CircuitBreakerTripped
+	}
+	return false
+}
+
+func (m *InboundRateLimiterState) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RateLimiterFlags)(nil), "zetachain.zetacore.crosschain.RateLimiterFlags")
+	proto.RegisterType((*ChainRateLimit)(nil), "zetachain.zetacore.crosschain.ChainRateLimit")
+	proto.RegisterType((*AssetRateLimit)(nil), "zetachain.zetacore.crosschain.AssetRateLimit")
+	proto.RegisterType((*HeldCctxThreshold)(nil), "zetachain.zetacore.crosschain.HeldCctxThreshold")
 	proto.RegisterType((*Conversion)(nil), "zetachain.zetacore.crosschain.Conversion")
 	proto.RegisterType((*AssetRate)(nil), "zetachain.zetacore.crosschain.AssetRate")
+	proto.RegisterType((*RateLimitBucket)(nil), "zetachain.zetacore.crosschain.RateLimitBucket")
+	proto.RegisterType((*InboundRateLimiterState)(nil), "zetachain.zetacore.crosschain.InboundRateLimiterState")
 }
 
 func init() {
@@ -214,35 +597,56 @@ func init() {
 }
 
 var fileDescriptor_9c435f4c2dabc0eb = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x6b, 0x13, 0x41,
-	0x18, 0xcd, 0x34, 0x69, 0x4d, 0xa6, 0x28, 0x32, 0x94, 0xb2, 0x44, 0xdc, 0x2e, 0x11, 0x65, 0x3d,
-	0x74, 0x56, 0x22, 0xe8, 0xd9, 0x54, 0x14, 0xa1, 0x17, 0x07, 0xbd, 0xe8, 0x21, 0x4c, 0x66, 0xc7,
-	0xcd, 0x90, 0xec, 0x7c, 0xcb, 0xce, 0x68, 0x4d, 0x7f, 0x85, 0x3f, 0xab, 0xe0, 0xa5, 0x07, 0x0f,
-	0xe2, 0xa1, 0x48, 0xf2, 0x47, 0x64, 0x66, 0x92, 0x6d, 0xc1, 0x20, 0x78, 0x09, 0xdf, 0x0b, 0xef,
-	0xed, 0x7b, 0xdf, 0x9b, 0x0f, 0x3f, 0x3b, 0x97, 0x96, 0x8b, 0x29, 0x57, 0x3a, 0xf3, 0x13, 0xd4,
-	0x32, 0x13, 0x35, 0x18, 0x13, 0xfe, 0xab, 0xb9, 0x95, 0xe3, 0xb9, 0x2a, 0x95, 0x95, 0xf5, 0xf8,
-	0xd3, 0x9c, 0x17, 0x86, 0x56, 0x35, 0x58, 0x20, 0xf7, 0x1b, 0x1d, 0xdd, 0xe8, 0xe8, 0xb5, 0xae,
-	0x7f, 0x50, 0x40, 0x01, 0x9e, 0x99, 0xb9, 0x29, 0x88, 0xfa, 0x8f, 0xb6, 0x98, 0x55, 0xb3, 0x22,
-	0x13, 0xa0, 0xb4, 0xff, 0x09, 0xbc, 0xc1, 0x77, 0x84, 0xef, 0x32, 0x6e, 0xe5, 0x69, 0x30, 0x7e,
-	0xe5, 0x7c, 0x49, 0x84, 0x6f, 0x49, 0xcd, 0x27, 0x73, 0x99, 0x47, 0x28, 0x41, 0x69, 0x97, 0x6d,
-	0x20, 0x39, 0xc4, 0x7b, 0x67, 0x4a, 0xe7, 0x70, 0x16, 0xed, 0x24, 0x28, 0x6d, 0xb3, 0x35, 0x22,
-	0x43, 0xdc, 0x71, 0xf9, 0xa3, 0x76, 0x82, 0xd2, 0xde, 0x28, 0xbe, 0xb8, 0x3a, 0x6a, 0xfd, 0xba,
-	0x3a, 0x3a, 0x14, 0x60, 0x4a, 0x30, 0x26, 0x9f, 0x51, 0x05, 0x59, 0xc9, 0xed, 0x94, 0xbe, 0x57,
-	0xda, 0x32, 0xcf, 0x25, 0x6f, 0xf1, 0xbe, 0x00, 0xfd, 0x45, 0xd6, 0x46, 0x81, 0x36, 0x51, 0x27,
-	0x69, 0xa7, 0xfb, 0xc3, 0xc7, 0xf4, 0x9f, 0xdb, 0xd2, 0x93, 0x46, 0x31, 0xea, 0x38, 0x17, 0x76,
-	0xf3, 0x1b, 0x83, 0x8f, 0x18, 0x5f, 0x13, 0xc8, 0x01, 0xde, 0x3d, 0xaf, 0xc5, 0xf0, 0x89, 0x5f,
-	0xa2, 0xc7, 0x02, 0x20, 0xcf, 0xd7, 0x51, 0x77, 0x7c, 0xd4, 0x07, 0xeb, 0xa8, 0xf7, 0xfe, 0x8e,
-	0x7a, 0x2a, 0x0b, 0x2e, 0x16, 0x2f, 0xa5, 0x08, 0x79, 0x07, 0x3f, 0x10, 0xee, 0xbd, 0x30, 0x46,
-	0x5a, 0xd7, 0x97, 0xeb, 0xc8, 0x27, 0x7a, 0x13, 0x3a, 0x6a, 0xb3, 0x0d, 0x74, 0xb6, 0xdc, 0xd1,
-	0x82, 0x03, 0x0b, 0x80, 0xf4, 0x71, 0x37, 0x97, 0x42, 0x95, 0x7c, 0x6e, 0x7c, 0x4b, 0xb7, 0x59,
-	0x83, 0xc9, 0x08, 0xf7, 0xdc, 0x93, 0x8c, 0xed, 0xa2, 0x92, 0x51, 0x27, 0x41, 0xe9, 0x9d, 0xe1,
-	0xc3, 0x6d, 0x3d, 0x54, 0xb3, 0x82, 0xfa, 0xb7, 0x3b, 0x01, 0xa5, 0xdf, 0x2d, 0x2a, 0xc9, 0xba,
-	0x62, 0x3d, 0x35, 0x6b, 0xed, 0xfe, 0xe7, 0x5a, 0xa3, 0xd7, 0x17, 0xcb, 0x18, 0x5d, 0x2e, 0x63,
-	0xf4, 0x7b, 0x19, 0xa3, 0x6f, 0xab, 0xb8, 0x75, 0xb9, 0x8a, 0x5b, 0x3f, 0x57, 0x71, 0xeb, 0xc3,
-	0x71, 0xa1, 0xec, 0xf4, 0xf3, 0x84, 0x0a, 0x28, 0xfd, 0x11, 0x1d, 0x87, 0x7b, 0xd2, 0x90, 0xcb,
-	0xec, 0xeb, 0xcd, 0xd3, 0x75, 0xb9, 0xcd, 0x64, 0xcf, 0x5f, 0xd4, 0xd3, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xa7, 0x71, 0x3a, 0xc0, 0xe8, 0x02, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0xf3, 0x9f, 0xe1, 0xde, 0x40, 0x4c, 0x2e, 0xf8, 0xa6, 0x6a, 0x88, 0x52, 0xb5, 0x4a,
+	0x17, 0x38, 0x28, 0x95, 0xe8, 0xa2, 0xdd, 0x10, 0xaa, 0x16, 0x24, 0x16, 0xad, 0xa1, 0x5d, 0xb4,
+	0x0b, 0xcb, 0x19, 0x4f, 0xed, 0x51, 0x1c, 0x8f, 0xe5, 0x99, 0x10, 0xe0, 0x29, 0xfa, 0x58, 0x2c,
+	0x59, 0x74, 0x51, 0x55, 0x15, 0xad, 0xe0, 0x41, 0x5a, 0xcd, 0x8c, 0xe3, 0xc4, 0x40, 0xc3, 0x8f,
+	0xd8, 0x44, 0x73, 0xe6, 0xfc, 0x7c, 0xe7, 0xfb, 0x7c, 0x66, 0x26, 0x60, 0xfd, 0x08, 0x31, 0x0b,
+	0xba, 0x16, 0xf6, 0xdb, 0x62, 0x45, 0x42, 0xd4, 0x86, 0x21, 0xa1, 0x54, 0xee, 0x85, 0x16, 0x43,
+	0xa6, 0x87, 0x07, 0x98, 0xa1, 0xd0, 0xfc, 0xec, 0x59, 0x0e, 0xd5, 0x83, 0x90, 0x30, 0xa2, 0x3e,
+	0x8c, 0xf3, 0xf4, 0x71, 0x9e, 0x3e, 0xc9, 0xab, 0x55, 0x1d, 0xe2, 0x10, 0x11, 0xd9, 0xe6, 0x2b,
+	0x99, 0x54, 0x7b, 0x72, 0x05, 0x58, 0xd0, 0x77, 0xda, 0x90, 0x60, 0x5f, 0xfc, 0xc8, 0xb8, 0xe6,
+	0x8f, 0x2c, 0x58, 0x30, 0x2c, 0x86, 0x76, 0x24, 0xf0, 0x6b, 0x8e, 0xab, 0x6a, 0xa0, 0x80, 0x7c,
+	0xab, 0xe7, 0x21, 0x5b, 0x53, 0x1a, 0x4a, 0xab, 0x68, 0x8c, 0x4d, 0x75, 0x09, 0xe4, 0x47, 0xd8,
+	0xb7, 0xc9, 0x48, 0x4b, 0x37, 0x94, 0x56, 0xc6, 0x88, 0x2c, 0xb5, 0x03, 0xb2, 0xbc, 0x7f, 0x2d,
+	0xd3, 0x50, 0x5a, 0xa5, 0x6e, 0xfd, 0xf8, 0x74, 0x25, 0xf5, 0xfd, 0x74, 0x65, 0x09, 0x12, 0x3a,
+	0x20, 0x94, 0xda, 0x7d, 0x1d, 0x93, 0xf6, 0xc0, 0x62, 0xae, 0xfe, 0x1e, 0xfb, 0xcc, 0x10, 0xb1,
+	0xea, 0x3b, 0x30, 0x07, 0x89, 0xbf, 0x8f, 0x42, 0x8a, 0x89, 0x4f, 0xb5, 0x6c, 0x23, 0xd3, 0x9a,
+	0xeb, 0x3c, 0xd5, 0x67, 0xb2, 0xd5, 0x37, 0xe3, 0x8c, 0x6e, 0x96, 0xa3, 0x18, 0xd3, 0x35, 0x54,
+	0x13, 0x54, 0x44, 0x98, 0x39, 0x11, 0x93, 0x6a, 0x39, 0x51, 0x78, 0xf5, 0xba, 0xc2, 0xfc, 0x37,
+	0x56, 0x22, 0x2a, 0x3e, 0x0f, 0x13, 0xbb, 0x02, 0xc0, 0xa2, 0x14, 0xb1, 0x04, 0x40, 0xfe, 0x46,
+	0x00, 0x1b, 0x3c, 0xef, 0x12, 0x80, 0x95, 0xd8, 0xa5, 0x2a, 0x04, 0x8b, 0xd8, 0xef, 0x91, 0xa1,
+	0x6f, 0x27, 0x20, 0x0a, 0x77, 0xe7, 0x50, 0x89, 0xea, 0x4d, 0x81, 0xb8, 0xa0, 0xea, 0x22, 0xcf,
+	0x36, 0x21, 0x64, 0x07, 0x26, 0x73, 0x43, 0x44, 0x5d, 0xe2, 0xd9, 0x54, 0x2b, 0x0a, 0x94, 0xb5,
+	0x6b, 0x50, 0xb6, 0x90, 0x67, 0x6f, 0x42, 0x76, 0xb0, 0x37, 0x4e, 0x8c, 0x80, 0x54, 0xf7, 0xa2,
+	0x83, 0x36, 0x47, 0xa0, 0x9c, 0x6c, 0x4a, 0xfd, 0x1f, 0x14, 0xe5, 0x27, 0xc2, 0x72, 0xb8, 0x32,
+	0x46, 0x41, 0xd8, 0xdb, 0xf7, 0x3a, 0x5c, 0xcd, 0x10, 0x94, 0x93, 0x82, 0xab, 0x55, 0x90, 0x3b,
+	0x0a, 0x61, 0x67, 0x4d, 0xa0, 0x96, 0x0c, 0x69, 0xdc, 0x2b, 0xa6, 0x07, 0x2a, 0x97, 0xb4, 0x99,
+	0xc5, 0xf7, 0x25, 0x28, 0xc5, 0xe2, 0x6b, 0xe9, 0x1b, 0x01, 0x4d, 0x12, 0x9a, 0x9f, 0x00, 0x98,
+	0x1c, 0x86, 0xbf, 0xb0, 0x7b, 0x1e, 0xb1, 0x90, 0xc5, 0x1f, 0x45, 0xc5, 0x1f, 0x5c, 0x2e, 0xbe,
+	0x83, 0x1c, 0x0b, 0x1e, 0xbe, 0x42, 0x30, 0xa2, 0xf2, 0x55, 0x01, 0xa5, 0x58, 0x3f, 0x7e, 0x1f,
+	0x44, 0x3d, 0x5f, 0xa4, 0x50, 0x05, 0x39, 0x31, 0xc1, 0x12, 0xc1, 0x90, 0x86, 0x5a, 0x03, 0x45,
+	0x1b, 0x41, 0x3c, 0xb0, 0x3c, 0x2a, 0x04, 0xfc, 0xd7, 0x88, 0x6d, 0xb5, 0x0b, 0x4a, 0xfc, 0xfa,
+	0x31, 0xd9, 0x61, 0x80, 0xb4, 0x6c, 0x43, 0x69, 0x95, 0x3b, 0x8f, 0xaf, 0x1a, 0xb8, 0xa0, 0xef,
+	0xe8, 0x3c, 0x50, 0xdf, 0x24, 0xd8, 0xdf, 0x3b, 0x0c, 0x90, 0x51, 0x84, 0xd1, 0x2a, 0xa6, 0x95,
+	0xbb, 0x2d, 0xad, 0xdf, 0x69, 0x30, 0x3f, 0x39, 0x1f, 0x43, 0xd8, 0x47, 0x33, 0x07, 0x32, 0x16,
+	0x35, 0x3d, 0x2d, 0x6a, 0x82, 0x41, 0xe6, 0x6e, 0x0c, 0x62, 0xdd, 0xb2, 0xd3, 0xba, 0x4d, 0x86,
+	0x31, 0x77, 0xe5, 0x30, 0xe6, 0x6f, 0x71, 0xbb, 0xb6, 0xc0, 0x42, 0x60, 0x51, 0x26, 0xce, 0x38,
+	0x35, 0xf7, 0x2d, 0x6f, 0x88, 0xb4, 0x82, 0x00, 0x2b, 0xf3, 0x7d, 0x3e, 0xa4, 0xf4, 0x03, 0xdf,
+	0x55, 0x75, 0xb0, 0x18, 0x20, 0xdf, 0xc6, 0xbe, 0x93, 0x08, 0x2e, 0x8a, 0xe0, 0x4a, 0xe4, 0x9a,
+	0x8a, 0x7f, 0x01, 0x6a, 0x1e, 0x19, 0x21, 0xca, 0xcc, 0xe9, 0x34, 0xd3, 0x45, 0xd8, 0x71, 0x99,
+	0x56, 0x12, 0x9d, 0x2f, 0xcb, 0x88, 0xb7, 0x93, 0xe4, 0x2d, 0xe1, 0x6e, 0xfe, 0x54, 0xc0, 0xf2,
+	0xf6, 0x85, 0x0b, 0x09, 0x85, 0xbb, 0x8c, 0xb7, 0x3c, 0xe3, 0x4b, 0xac, 0x83, 0x65, 0x88, 0x43,
+	0x38, 0xc4, 0xcc, 0xec, 0x85, 0xc8, 0xea, 0xa3, 0xd0, 0x64, 0x21, 0x0e, 0x02, 0x24, 0x0f, 0x4e,
+	0xd1, 0xf8, 0x2f, 0x72, 0x77, 0xa5, 0x77, 0x4f, 0x3a, 0x39, 0x37, 0xa9, 0xa1, 0x49, 0x99, 0x15,
+	0xb2, 0x71, 0x93, 0x19, 0x51, 0xbd, 0x22, 0x5d, 0xbb, 0xdc, 0x23, 0xdb, 0x53, 0x37, 0xc0, 0x3f,
+	0x51, 0xbc, 0x14, 0x21, 0x7b, 0x23, 0xc5, 0xe7, 0x64, 0x8e, 0x90, 0xa7, 0xfb, 0xe6, 0xf8, 0xac,
+	0xae, 0x9c, 0x9c, 0xd5, 0x95, 0x5f, 0x67, 0x75, 0xe5, 0xcb, 0x79, 0x3d, 0x75, 0x72, 0x5e, 0x4f,
+	0x7d, 0x3b, 0xaf, 0xa7, 0x3e, 0xae, 0x3a, 0x98, 0xb9, 0xc3, 0x9e, 0x0e, 0xc9, 0x40, 0x3c, 0xca,
+	0xab, 0xf2, 0x7d, 0xf6, 0x89, 0x8d, 0xda, 0x07, 0xd3, 0x7f, 0x05, 0xf8, 0x64, 0xd1, 0x5e, 0x5e,
+	0xbc, 0xd0, 0xcf, 0xfe, 0x0c, 0x00, 0x90, 0x9e, 0x03, 0xb7, 0x38, 0x08, 0x00, 0x00,
 }
 
 func (m *RateLimiterFlags) Marshal() (dAtA []byte, err error) {
@@ -265,10 +669,10 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conversions) > 0 {
-		for iNdEx := len(m.Conversions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HeldCctxThresholds) > 0 {
+		for iNdEx := len(m.HeldCctxThresholds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Conversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HeldCctxThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -276,25 +680,81 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x42
 		}
 	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.InboundRateLimits) > 0 {
+		for iNdEx := len(m.InboundRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Window != 0 {
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Enabled {
+	if len(m.AssetRateLimits) > 0 {
+		for iNdEx := len(m.AssetRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChainRateLimits) > 0 {
+		for iNdEx := len(m.ChainRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Conversions) > 0 {
+		for iNdEx := len(m.Conversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
 		i--
 		if m.Enabled {
 			dAtA[i] = 1
@@ -307,6 +767,132 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldCctxThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldCctxThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldCctxThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Conversion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -402,86 +988,1294 @@ func (m *AssetRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRateLimiterFlags(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRateLimiterFlags(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RateLimitBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RateLimiterFlags) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RateLimitBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Enabled {
-		n += 2
+	if m.LowestPendingCctxHeight != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.LowestPendingCctxHeight))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Window != 0 {
-		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	if len(m.PendingCctxsValue) > 0 {
+		i -= len(m.PendingCctxsValue)
+		copy(dAtA[i:], m.PendingCctxsValue)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.PendingCctxsValue)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovRateLimiterFlags(uint64(l))
-	if len(m.Conversions) > 0 {
-		for _, e := range m.Conversions {
-			l = e.Size()
-			n += 1 + l + sovRateLimiterFlags(uint64(l))
+	if len(m.PastCctxsValue) > 0 {
+		i -= len(m.PastCctxsValue)
+		copy(dAtA[i:], m.PastCctxsValue)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.PastCctxsValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x32
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Conversion) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InboundRateLimiterState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Zrc20)
-	if l > 0 {
-		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *InboundRateLimiterState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundRateLimiterState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WindowValue.Size()
+		i -= size
+		if _, err := m.WindowValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CircuitBreakerTripped {
+		i--
+		if m.CircuitBreakerTripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRateLimiterFlags(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRateLimiterFlags(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RateLimiterFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	if len(m.Conversions) > 0 {
+		for _, e := range m.Conversions {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	if len(m.ChainRateLimits) > 0 {
+		for _, e := range m.ChainRateLimits {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	if len(m.AssetRateLimits) > 0 {
+		for _, e := range m.AssetRateLimits {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	if len(m.InboundRateLimits) > 0 {
+		for _, e := range m.InboundRateLimits {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	if len(m.HeldCctxThresholds) > 0 {
+		for _, e := range m.HeldCctxThresholds {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *AssetRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *HeldCctxThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *Conversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *AssetRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Decimals))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.CoinType))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *RateLimitBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.CoinType))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	l = len(m.PastCctxsValue)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	l = len(m.PendingCctxsValue)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.LowestPendingCctxHeight != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.LowestPendingCctxHeight))
+	}
+	return n
+}
+
+func (m *InboundRateLimiterState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	if m.CircuitBreakerTripped {
+		n += 2
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.WindowStartHeight))
+	}
+	l = m.WindowValue.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func sovRateLimiterFlags(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimiterFlags(x uint64) (n int) {
+	return sovRateLimiterFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimiterFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conversions = append(m.Conversions, Conversion{})
+			if err := m.Conversions[len(m.Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRateLimits = append(m.ChainRateLimits, ChainRateLimit{})
+			if err := m.ChainRateLimits[len(m.ChainRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetRateLimits = append(m.AssetRateLimits, AssetRateLimit{})
+			if err := m.AssetRateLimits[len(m.AssetRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundRateLimits = append(m.InboundRateLimits, ChainRateLimit{})
+			if err := m.InboundRateLimits[len(m.InboundRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldCctxThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldCctxThresholds = append(m.HeldCctxThresholds, HeldCctxThreshold{})
+			if err := m.HeldCctxThresholds[len(m.HeldCctxThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldCctxThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldCctxThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldCctxThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Conversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Conversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Conversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= coin.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovRateLimiterFlags(uint64(l))
-	return n
-}
 
-func (m *AssetRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
-	}
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovRateLimiterFlags(uint64(l))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovRateLimiterFlags(uint64(m.Decimals))
-	}
-	if m.CoinType != 0 {
-		n += 1 + sovRateLimiterFlags(uint64(m.CoinType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovRateLimiterFlags(uint64(l))
-	return n
-}
-
-func sovRateLimiterFlags(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRateLimiterFlags(x uint64) (n int) {
-	return sovRateLimiterFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
+func (m *RateLimitBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -504,17 +2298,17 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimiterFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var v int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -524,17 +2318,16 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
 			}
-			m.Window = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -544,14 +2337,46 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Window |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= coin.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -579,15 +2404,32 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -597,79 +2439,29 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRateLimiterFlags
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRateLimiterFlags
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conversions = append(m.Conversions, Conversion{})
-			if err := m.Conversions[len(m.Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRateLimiterFlags
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Conversion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRateLimiterFlags
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Conversion: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Conversion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastCctxsValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -697,11 +2489,11 @@ func (m *Conversion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			m.PastCctxsValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCctxsValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -729,10 +2521,27 @@ func (m *Conversion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PendingCctxsValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestPendingCctxHeight", wireType)
+			}
+			m.LowestPendingCctxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowestPendingCctxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
@@ -754,7 +2563,7 @@ func (m *Conversion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetRate) Unmarshal(dAtA []byte) error {
+func (m *InboundRateLimiterState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -777,10 +2586,10 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: InboundRateLimiterState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InboundRateLimiterState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -803,10 +2612,10 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerTripped", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -816,29 +2625,17 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRateLimiterFlags
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRateLimiterFlags
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.CircuitBreakerTripped = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
 			}
-			m.Decimals = 0
+			m.WindowStartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -848,33 +2645,14 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				m.WindowStartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
-			}
-			m.CoinType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRateLimiterFlags
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoinType |= coin.CoinType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -902,7 +2680,7 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WindowValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
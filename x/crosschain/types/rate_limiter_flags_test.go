@@ -111,6 +111,133 @@ func TestRateLimiterFlags_Validate(t *testing.T) {
 			},
 			isErr: true,
 		},
+		{
+			name: "valid chain and asset rate limits",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				Window:  42,
+				Rate:    sdkmath.NewUint(42),
+				ChainRateLimits: []types.ChainRateLimit{
+					{ChainId: chains.Ethereum.ChainId, Window: 10, Rate: sdkmath.NewUint(1)},
+					{ChainId: chains.SolanaMainnet.ChainId, Window: 20, Rate: sdkmath.NewUint(2)},
+				},
+				AssetRateLimits: []types.AssetRateLimit{
+					{Zrc20: sample.EthAddress().String(), Window: 10, Rate: sdkmath.NewUint(1)},
+				},
+			},
+		},
+		{
+			name: "duplicated chain rate limit",
+			flags: types.RateLimiterFlags{
+				ChainRateLimits: []types.ChainRateLimit{
+					{ChainId: chains.Ethereum.ChainId, Window: 10, Rate: sdkmath.NewUint(1)},
+					{ChainId: chains.Ethereum.ChainId, Window: 20, Rate: sdkmath.NewUint(2)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "chain rate limit with zero window",
+			flags: types.RateLimiterFlags{
+				ChainRateLimits: []types.ChainRateLimit{
+					{ChainId: chains.Ethereum.ChainId, Window: 0, Rate: sdkmath.NewUint(1)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "chain rate limit with nil rate",
+			flags: types.RateLimiterFlags{
+				ChainRateLimits: []types.ChainRateLimit{
+					{ChainId: chains.Ethereum.ChainId, Window: 10},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "duplicated asset rate limit",
+			flags: types.RateLimiterFlags{
+				AssetRateLimits: []types.AssetRateLimit{
+					{Zrc20: duplicatedAddress, Window: 10, Rate: sdkmath.NewUint(1)},
+					{Zrc20: strings.ToUpper(duplicatedAddress), Window: 10, Rate: sdkmath.NewUint(1)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "asset rate limit with invalid zrc20 address",
+			flags: types.RateLimiterFlags{
+				AssetRateLimits: []types.AssetRateLimit{
+					{Zrc20: "invalid", Window: 10, Rate: sdkmath.NewUint(1)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "asset rate limit with zero rate",
+			flags: types.RateLimiterFlags{
+				AssetRateLimits: []types.AssetRateLimit{
+					{Zrc20: sample.EthAddress().String(), Window: 10, Rate: sdkmath.NewUint(0)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "valid inbound rate limits",
+			flags: types.RateLimiterFlags{
+				InboundRateLimits: []types.ChainRateLimit{
+					{ChainId: chains.Ethereum.ChainId, Window: 10, Rate: sdkmath.NewUint(1)},
+					{ChainId: chains.BitcoinMainnet.ChainId, Window: 10, Rate: sdkmath.NewUint(1)},
+				},
+			},
+		},
+		{
+			name: "duplicated inbound rate limit",
+			flags: types.RateLimiterFlags{
+				InboundRateLimits: []types.ChainRateLimit{
+					{ChainId: chains.Ethereum.ChainId, Window: 10, Rate: sdkmath.NewUint(1)},
+					{ChainId: chains.Ethereum.ChainId, Window: 20, Rate: sdkmath.NewUint(2)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "inbound rate limit with zero rate",
+			flags: types.RateLimiterFlags{
+				InboundRateLimits: []types.ChainRateLimit{
+					{ChainId: chains.Ethereum.ChainId, Window: 10, Rate: sdkmath.NewUint(0)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "valid held cctx thresholds",
+			flags: types.RateLimiterFlags{
+				HeldCctxThresholds: []types.HeldCctxThreshold{
+					{ChainId: chains.Ethereum.ChainId, Threshold: sdkmath.NewUint(1000)},
+					{ChainId: chains.BitcoinMainnet.ChainId, Threshold: sdkmath.NewUint(1000)},
+				},
+			},
+		},
+		{
+			name: "duplicated held cctx threshold",
+			flags: types.RateLimiterFlags{
+				HeldCctxThresholds: []types.HeldCctxThreshold{
+					{ChainId: chains.Ethereum.ChainId, Threshold: sdkmath.NewUint(1000)},
+					{ChainId: chains.Ethereum.ChainId, Threshold: sdkmath.NewUint(2000)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "held cctx threshold with nil threshold",
+			flags: types.RateLimiterFlags{
+				HeldCctxThresholds: []types.HeldCctxThreshold{
+					{ChainId: chains.Ethereum.ChainId},
+				},
+			},
+			isErr: true,
+		},
 	}
 
 	for _, tc := range tt {
@@ -126,6 +253,78 @@ func TestRateLimiterFlags_Validate(t *testing.T) {
 
 }
 
+func TestRateLimiterFlags_IsGlobalRateLimitSet(t *testing.T) {
+	require.True(t, types.RateLimiterFlags{Window: 10, Rate: sdkmath.NewUint(1)}.IsGlobalRateLimitSet())
+	require.False(t, types.RateLimiterFlags{Window: 0, Rate: sdkmath.NewUint(1)}.IsGlobalRateLimitSet())
+	require.False(t, types.RateLimiterFlags{Window: 10, Rate: sdkmath.NewUint(0)}.IsGlobalRateLimitSet())
+	require.False(t, types.RateLimiterFlags{Window: 10}.IsGlobalRateLimitSet())
+}
+
+func TestRateLimiterFlags_GetInboundRateLimit(t *testing.T) {
+	flags := types.RateLimiterFlags{
+		InboundRateLimits: []types.ChainRateLimit{
+			{ChainId: chains.Ethereum.ChainId, Window: 10, Rate: sdkmath.NewUint(1)},
+		},
+	}
+
+	limit, found := flags.GetInboundRateLimit(chains.Ethereum.ChainId)
+	require.True(t, found)
+	require.Equal(t, flags.InboundRateLimits[0], limit)
+
+	_, found = flags.GetInboundRateLimit(chains.BitcoinMainnet.ChainId)
+	require.False(t, found)
+}
+
+func TestRateLimiterFlags_GetHeldCctxThreshold(t *testing.T) {
+	flags := types.RateLimiterFlags{
+		HeldCctxThresholds: []types.HeldCctxThreshold{
+			{ChainId: chains.Ethereum.ChainId, Threshold: sdkmath.NewUint(1000)},
+		},
+	}
+
+	threshold, found := flags.GetHeldCctxThreshold(chains.Ethereum.ChainId)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewUint(1000), threshold)
+
+	_, found = flags.GetHeldCctxThreshold(chains.BitcoinMainnet.ChainId)
+	require.False(t, found)
+}
+
+func TestInboundRateLimiterState_Consume(t *testing.T) {
+	// the window of 10 blocks allows 100
+	limit := types.ChainRateLimit{ChainId: chains.Ethereum.ChainId, Window: 10, Rate: sdkmath.NewUint(10)}
+	state := types.InboundRateLimiterState{ChainId: chains.Ethereum.ChainId}
+
+	// a new window starts
+	require.True(t, state.Consume(limit, sdkmath.NewUint(60), 100))
+	require.EqualValues(t, 100, state.WindowStartHeight)
+	require.Equal(t, sdkmath.NewUint(60), state.WindowValue)
+
+	// the value is counted up to the limit
+	require.True(t, state.Consume(limit, sdkmath.NewUint(40), 105))
+	require.Equal(t, sdkmath.NewUint(100), state.WindowValue)
+
+	// the value over the limit is not counted
+	require.False(t, state.Consume(limit, sdkmath.NewUint(1), 109))
+	require.EqualValues(t, 100, state.WindowStartHeight)
+	require.Equal(t, sdkmath.NewUint(100), state.WindowValue)
+
+	// a new window starts once the current one is over
+	require.True(t, state.Consume(limit, sdkmath.NewUint(1), 110))
+	require.EqualValues(t, 110, state.WindowStartHeight)
+	require.Equal(t, sdkmath.NewUint(1), state.WindowValue)
+
+	// a value larger than the window limit is not counted in a non-empty window
+	require.False(t, state.Consume(limit, sdkmath.NewUint(150), 115))
+	require.Equal(t, sdkmath.NewUint(1), state.WindowValue)
+
+	// but it takes up an empty window entirely
+	require.True(t, state.Consume(limit, sdkmath.NewUint(150), 120))
+	require.EqualValues(t, 120, state.WindowStartHeight)
+	require.Equal(t, sdkmath.NewUint(150), state.WindowValue)
+	require.False(t, state.Consume(limit, sdkmath.NewUint(1), 125))
+}
+
 func TestRateLimiterFlags_GetConversionRate(t *testing.T) {
 	dec, err := sdkmath.LegacyNewDecFromStr("0.00042")
 	require.NoError(t, err)
@@ -384,3 +583,66 @@ func TestConvertCctxValue(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitBucket_Contains(t *testing.T) {
+	ethChainID := chains.Ethereum.ChainId
+	btcChainID := chains.BitcoinMainnet.ChainId
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+	usdt := sample.EthAddress().Hex()
+
+	// newCctx creates a withdrawal cctx to the given chain
+	newCctx := func(chainID int64, coinType coin.CoinType, asset string) *types.CrossChainTx {
+		return sample.CustomCctxsInBlockRange(
+			t,
+			1,
+			1,
+			zetaChainID,
+			chainID,
+			coinType,
+			asset,
+			1000,
+			types.CctxStatus_PendingOutbound,
+		)[0]
+	}
+
+	chainBucket := types.RateLimitBucket{ChainId: ethChainID}
+	gasBucket := types.RateLimitBucket{
+		ChainId:  ethChainID,
+		Zrc20:    sample.EthAddress().Hex(),
+		CoinType: coin.CoinType_Gas,
+	}
+	erc20Bucket := types.RateLimitBucket{
+		ChainId:  ethChainID,
+		Zrc20:    sample.EthAddress().Hex(),
+		CoinType: coin.CoinType_ERC20,
+		Asset:    strings.ToLower(usdt),
+	}
+
+	tt := []struct {
+		name     string
+		bucket   types.RateLimitBucket
+		cctx     *types.CrossChainTx
+		expected bool
+	}{
+		{"chain bucket contains gas withdrawal", chainBucket, newCctx(ethChainID, coin.CoinType_Gas, ""), true},
+		{"chain bucket contains erc20 withdrawal", chainBucket, newCctx(ethChainID, coin.CoinType_ERC20, usdt), true},
+		{"chain bucket skips other chain", chainBucket, newCctx(btcChainID, coin.CoinType_Gas, ""), false},
+		{"gas bucket contains gas withdrawal", gasBucket, newCctx(ethChainID, coin.CoinType_Gas, ""), true},
+		{"gas bucket skips erc20 withdrawal", gasBucket, newCctx(ethChainID, coin.CoinType_ERC20, usdt), false},
+		{"gas bucket skips other chain", gasBucket, newCctx(btcChainID, coin.CoinType_Gas, ""), false},
+		{"erc20 bucket contains erc20 withdrawal", erc20Bucket, newCctx(ethChainID, coin.CoinType_ERC20, usdt), true},
+		{
+			"erc20 bucket skips other erc20",
+			erc20Bucket,
+			newCctx(ethChainID, coin.CoinType_ERC20, sample.EthAddress().Hex()),
+			false,
+		},
+		{"erc20 bucket skips gas withdrawal", erc20Bucket, newCctx(ethChainID, coin.CoinType_Gas, ""), false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.bucket.Contains(tc.cctx))
+		})
+	}
+}
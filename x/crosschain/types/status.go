@@ -67,14 +67,28 @@ func stateTransitionMap() map[CctxStatus][]CctxStatus {
 	stateTransitionMap[CctxStatus_PendingInbound] = []CctxStatus{
 		CctxStatus_PendingOutbound,
 		CctxStatus_Aborted,
-		CctxStatus_OutboundMined, // EVM Deposit
-		CctxStatus_PendingRevert, // EVM Deposit contract call reverted; should refund
+		CctxStatus_OutboundMined,           // EVM Deposit
+		CctxStatus_PendingRevert,           // EVM Deposit contract call reverted; should refund
+		CctxStatus_PendingInboundRateLimit, // held by the inbound rate limiter
+	}
+	// a held inbound is processed as a new inbound once released
+	stateTransitionMap[CctxStatus_PendingInboundRateLimit] = []CctxStatus{
+		CctxStatus_PendingOutbound,
+		CctxStatus_Aborted,
+		CctxStatus_OutboundMined,
+		CctxStatus_PendingRevert,
 	}
 	stateTransitionMap[CctxStatus_PendingOutbound] = []CctxStatus{
 		CctxStatus_Aborted,
 		CctxStatus_PendingRevert,
 		CctxStatus_OutboundMined,
 		CctxStatus_Reverted,
+		CctxStatus_PendingOutboundHeld, // value over the held threshold of the receiver chain
+	}
+	// a held outbound is either released, or rejected and processed as a failed outbound
+	stateTransitionMap[CctxStatus_PendingOutboundHeld] = []CctxStatus{
+		CctxStatus_PendingOutbound,
+		CctxStatus_Aborted,
 	}
 
 	stateTransitionMap[CctxStatus_PendingRevert] = []CctxStatus{
@@ -99,6 +113,8 @@ func (c CctxStatus) IsTerminal() bool {
 // CctxStatus_PendingInbound
 // CctxStatus_PendingOutbound
 // CctxStatus_PendingRevert
+// CctxStatus_PendingInboundRateLimit
+// CctxStatus_PendingOutboundHeld
 func (c CctxStatus) IsPending() bool {
 	return !c.IsTerminal()
 }
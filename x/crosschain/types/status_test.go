@@ -49,6 +49,30 @@ func TestStatus_ValidateTransition(t *testing.T) {
 			types.CctxStatus_PendingRevert,
 			true,
 		},
+		{
+			"Valid - PendingInbound to PendingInboundRateLimit",
+			types.CctxStatus_PendingInbound,
+			types.CctxStatus_PendingInboundRateLimit,
+			true,
+		},
+		{
+			"Valid - PendingInboundRateLimit to PendingOutbound",
+			types.CctxStatus_PendingInboundRateLimit,
+			types.CctxStatus_PendingOutbound,
+			true,
+		},
+		{
+			"Valid - PendingInboundRateLimit to OutboundMined",
+			types.CctxStatus_PendingInboundRateLimit,
+			types.CctxStatus_OutboundMined,
+			true,
+		},
+		{
+			"Invalid - PendingInboundRateLimit to Reverted",
+			types.CctxStatus_PendingInboundRateLimit,
+			types.CctxStatus_Reverted,
+			false,
+		},
 
 		{"Valid - PendingOutbound to Aborted", types.CctxStatus_PendingOutbound, types.CctxStatus_Aborted, true},
 		{
@@ -64,6 +88,30 @@ func TestStatus_ValidateTransition(t *testing.T) {
 			true,
 		},
 		{"Valid - PendingOutbound to Reverted", types.CctxStatus_PendingOutbound, types.CctxStatus_Reverted, true},
+		{
+			"Valid - PendingOutbound to PendingOutboundHeld",
+			types.CctxStatus_PendingOutbound,
+			types.CctxStatus_PendingOutboundHeld,
+			true,
+		},
+		{
+			"Valid - PendingOutboundHeld to PendingOutbound",
+			types.CctxStatus_PendingOutboundHeld,
+			types.CctxStatus_PendingOutbound,
+			true,
+		},
+		{
+			"Valid - PendingOutboundHeld to Aborted",
+			types.CctxStatus_PendingOutboundHeld,
+			types.CctxStatus_Aborted,
+			true,
+		},
+		{
+			"Invalid - PendingOutboundHeld to OutboundMined",
+			types.CctxStatus_PendingOutboundHeld,
+			types.CctxStatus_OutboundMined,
+			false,
+		},
 
 		{"Valid - PendingRevert to Aborted", types.CctxStatus_PendingRevert, types.CctxStatus_Aborted, true},
 		{
@@ -169,6 +217,8 @@ func TestCctxStatus_IsTerminalStatus(t *testing.T) {
 		{"Reverted", types.CctxStatus_Reverted, true},
 		{"Aborted", types.CctxStatus_Aborted, true},
 		{"PendingRevert", types.CctxStatus_PendingRevert, false},
+		{"PendingInboundRateLimit", types.CctxStatus_PendingInboundRateLimit, false},
+		{"PendingOutboundHeld", types.CctxStatus_PendingOutboundHeld, false},
 	}
 
 	for _, tc := range tests {
@@ -190,6 +240,8 @@ func TestCctxStatus_IsPendingStatus(t *testing.T) {
 		{"Reverted", types.CctxStatus_Reverted, false},
 		{"Aborted", types.CctxStatus_Aborted, false},
 		{"PendingRevert", types.CctxStatus_PendingRevert, true},
+		{"PendingInboundRateLimit", types.CctxStatus_PendingInboundRateLimit, true},
+		{"PendingOutboundHeld", types.CctxStatus_PendingOutboundHeld, true},
 	}
 
 	for _, tc := range tests {
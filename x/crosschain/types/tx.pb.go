@@ -214,13 +214,15 @@ var xxx_messageInfo_MsgUpdateTssAddressResponse proto.InternalMessageInfo
 // The index used for the saving the tracker to the store is of the format
 // <chain_id>-<tx_hash>
 type MsgAddInboundTracker struct {
-	Creator   string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId   int64         `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	TxHash    string        `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	CoinType  coin.CoinType `protobuf:"varint,4,opt,name=coin_type,json=coinType,proto3,enum=zetachain.zetacore.pkg.coin.CoinType" json:"coin_type,omitempty"`
-	Proof     *proofs.Proof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`                          // Deprecated: Do not use.
-	BlockHash string        `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"` // Deprecated: Do not use.
-	TxIndex   int64         `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`      // Deprecated: Do not use.
+	Creator  string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId  int64         `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TxHash   string        `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	CoinType coin.CoinType `protobuf:"varint,4,opt,name=coin_type,json=coinType,proto3,enum=zetachain.zetacore.pkg.coin.CoinType" json:"coin_type,omitempty"`
+	// proof of the inbound transaction against a block header of the light
+	// client, required if the creator is neither an observer nor authorized
+	Proof     *proofs.Proof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	BlockHash string        `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxIndex   int64         `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 }
 
 func (m *MsgAddInboundTracker) Reset()         { *m = MsgAddInboundTracker{} }
@@ -284,7 +286,6 @@ func (m *MsgAddInboundTracker) GetCoinType() coin.CoinType {
 	return coin.CoinType_Zeta
 }
 
-// Deprecated: Do not use.
 func (m *MsgAddInboundTracker) GetProof() *proofs.Proof {
 	if m != nil {
 		return m.Proof
@@ -292,7 +293,6 @@ func (m *MsgAddInboundTracker) GetProof() *proofs.Proof {
 	return nil
 }
 
-// Deprecated: Do not use.
 func (m *MsgAddInboundTracker) GetBlockHash() string {
 	if m != nil {
 		return m.BlockHash
@@ -300,7 +300,6 @@ func (m *MsgAddInboundTracker) GetBlockHash() string {
 	return ""
 }
 
-// Deprecated: Do not use.
 func (m *MsgAddInboundTracker) GetTxIndex() int64 {
 	if m != nil {
 		return m.TxIndex
@@ -1113,9 +1112,9 @@ type MsgVoteInbound struct {
 	SenderChainId int64  `protobuf:"varint,3,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
 	Receiver      string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	ReceiverChain int64  `protobuf:"varint,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
-	// string zeta_burnt = 6;
+	//  string zeta_burnt = 6;
 	Amount cosmossdk_io_math.Uint `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
-	// string mMint = 7;
+	//  string mMint = 7;
 	Message            string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
 	InboundHash        string `protobuf:"bytes,9,opt,name=inbound_hash,json=inboundHash,proto3" json:"inbound_hash,omitempty"`
 	InboundBlockHeight uint64 `protobuf:"varint,10,opt,name=inbound_block_height,json=inboundBlockHeight,proto3" json:"inbound_block_height,omitempty"`
@@ -1834,6 +1833,365 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) GetCctxIndex() string {
 	return ""
 }
 
+// MsgTripInboundCircuitBreaker defines a message to hold all the deposits from
+// a chain
+type MsgTripInboundCircuitBreaker struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgTripInboundCircuitBreaker) Reset()         { *m = MsgTripInboundCircuitBreaker{} }
+func (m *MsgTripInboundCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgTripInboundCircuitBreaker) ProtoMessage()    {}
+func (*MsgTripInboundCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{30}
+}
+func (m *MsgTripInboundCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTripInboundCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTripInboundCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTripInboundCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTripInboundCircuitBreaker.Merge(m, src)
+}
+func (m *MsgTripInboundCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTripInboundCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTripInboundCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTripInboundCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgTripInboundCircuitBreaker) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTripInboundCircuitBreaker) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type MsgTripInboundCircuitBreakerResponse struct {
+}
+
+func (m *MsgTripInboundCircuitBreakerResponse) Reset()         { *m = MsgTripInboundCircuitBreakerResponse{} }
+func (m *MsgTripInboundCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTripInboundCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgTripInboundCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{31}
+}
+func (m *MsgTripInboundCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTripInboundCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTripInboundCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTripInboundCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTripInboundCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgTripInboundCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTripInboundCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTripInboundCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTripInboundCircuitBreakerResponse proto.InternalMessageInfo
+
+// MsgResetInboundCircuitBreaker defines a message to resume the deposits from
+// a chain, the held deposits are released within the inbound rate limit
+type MsgResetInboundCircuitBreaker struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgResetInboundCircuitBreaker) Reset()         { *m = MsgResetInboundCircuitBreaker{} }
+func (m *MsgResetInboundCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgResetInboundCircuitBreaker) ProtoMessage()    {}
+func (*MsgResetInboundCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{32}
+}
+func (m *MsgResetInboundCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetInboundCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetInboundCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetInboundCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetInboundCircuitBreaker.Merge(m, src)
+}
+func (m *MsgResetInboundCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetInboundCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetInboundCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetInboundCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgResetInboundCircuitBreaker) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgResetInboundCircuitBreaker) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type MsgResetInboundCircuitBreakerResponse struct {
+}
+
+func (m *MsgResetInboundCircuitBreakerResponse) Reset()         { *m = MsgResetInboundCircuitBreakerResponse{} }
+func (m *MsgResetInboundCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetInboundCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgResetInboundCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{33}
+}
+func (m *MsgResetInboundCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetInboundCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetInboundCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetInboundCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetInboundCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgResetInboundCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetInboundCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetInboundCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetInboundCircuitBreakerResponse proto.InternalMessageInfo
+
+// MsgReleaseHeldCctx defines a message to approve the outbound of a held cctx
+type MsgReleaseHeldCctx struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+}
+
+func (m *MsgReleaseHeldCctx) Reset()         { *m = MsgReleaseHeldCctx{} }
+func (m *MsgReleaseHeldCctx) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseHeldCctx) ProtoMessage()    {}
+func (*MsgReleaseHeldCctx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{34}
+}
+func (m *MsgReleaseHeldCctx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseHeldCctx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseHeldCctx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseHeldCctx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseHeldCctx.Merge(m, src)
+}
+func (m *MsgReleaseHeldCctx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseHeldCctx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseHeldCctx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseHeldCctx proto.InternalMessageInfo
+
+func (m *MsgReleaseHeldCctx) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgReleaseHeldCctx) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+type MsgReleaseHeldCctxResponse struct {
+}
+
+func (m *MsgReleaseHeldCctxResponse) Reset()         { *m = MsgReleaseHeldCctxResponse{} }
+func (m *MsgReleaseHeldCctxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseHeldCctxResponse) ProtoMessage()    {}
+func (*MsgReleaseHeldCctxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{35}
+}
+func (m *MsgReleaseHeldCctxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseHeldCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseHeldCctxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseHeldCctxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseHeldCctxResponse.Merge(m, src)
+}
+func (m *MsgReleaseHeldCctxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseHeldCctxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseHeldCctxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseHeldCctxResponse proto.InternalMessageInfo
+
+// MsgRejectHeldCctx defines a message to reject the outbound of a held cctx,
+// the cctx is reverted
+type MsgRejectHeldCctx struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+}
+
+func (m *MsgRejectHeldCctx) Reset()         { *m = MsgRejectHeldCctx{} }
+func (m *MsgRejectHeldCctx) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectHeldCctx) ProtoMessage()    {}
+func (*MsgRejectHeldCctx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{36}
+}
+func (m *MsgRejectHeldCctx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectHeldCctx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectHeldCctx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectHeldCctx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectHeldCctx.Merge(m, src)
+}
+func (m *MsgRejectHeldCctx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectHeldCctx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectHeldCctx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectHeldCctx proto.InternalMessageInfo
+
+func (m *MsgRejectHeldCctx) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRejectHeldCctx) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+type MsgRejectHeldCctxResponse struct {
+}
+
+func (m *MsgRejectHeldCctxResponse) Reset()         { *m = MsgRejectHeldCctxResponse{} }
+func (m *MsgRejectHeldCctxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectHeldCctxResponse) ProtoMessage()    {}
+func (*MsgRejectHeldCctxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{37}
+}
+func (m *MsgRejectHeldCctxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectHeldCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectHeldCctxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectHeldCctxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectHeldCctxResponse.Merge(m, src)
+}
+func (m *MsgRejectHeldCctxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectHeldCctxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectHeldCctxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectHeldCctxResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMigrateTssFunds)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFunds")
 	proto.RegisterType((*MsgMigrateTssFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFundsResponse")
@@ -1865,6 +2223,14 @@ func init() {
 	proto.RegisterType((*MsgMigrateERC20CustodyFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateERC20CustodyFundsResponse")
 	proto.RegisterType((*MsgUpdateERC20CustodyPauseStatus)(nil), "zetachain.zetacore.crosschain.MsgUpdateERC20CustodyPauseStatus")
 	proto.RegisterType((*MsgUpdateERC20CustodyPauseStatusResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateERC20CustodyPauseStatusResponse")
+	proto.RegisterType((*MsgTripInboundCircuitBreaker)(nil), "zetachain.zetacore.crosschain.MsgTripInboundCircuitBreaker")
+	proto.RegisterType((*MsgTripInboundCircuitBreakerResponse)(nil), "zetachain.zetacore.crosschain.MsgTripInboundCircuitBreakerResponse")
+	proto.RegisterType((*MsgResetInboundCircuitBreaker)(nil), "zetachain.zetacore.crosschain.MsgResetInboundCircuitBreaker")
+	proto.RegisterType((*MsgResetInboundCircuitBreakerResponse)(nil), "zetachain.zetacore.crosschain.MsgResetInboundCircuitBreakerResponse")
+	proto.RegisterType((*MsgReleaseHeldCctx)(nil), "zetachain.zetacore.crosschain.MsgReleaseHeldCctx")
+	proto.RegisterType((*MsgReleaseHeldCctxResponse)(nil), "zetachain.zetacore.crosschain.MsgReleaseHeldCctxResponse")
+	proto.RegisterType((*MsgRejectHeldCctx)(nil), "zetachain.zetacore.crosschain.MsgRejectHeldCctx")
+	proto.RegisterType((*MsgRejectHeldCctxResponse)(nil), "zetachain.zetacore.crosschain.MsgRejectHeldCctxResponse")
 }
 
 func init() {
@@ -1872,133 +2238,143 @@ func init() {
 }
 
 var fileDescriptor_15f0860550897740 = []byte{
-	// 2008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0xdc, 0xd6,
-	0x11, 0x37, 0x65, 0x7d, 0xec, 0xce, 0x4a, 0xb2, 0x44, 0xeb, 0x83, 0xa6, 0xa2, 0x4f, 0xd7, 0x86,
-	0x60, 0xd8, 0x2b, 0x47, 0x4e, 0x95, 0xd4, 0x29, 0x9c, 0x5a, 0x9b, 0xd8, 0x15, 0x90, 0x8d, 0x05,
-	0x46, 0x4e, 0xdb, 0x20, 0x28, 0xc1, 0x25, 0x9f, 0x28, 0x42, 0xbb, 0x7c, 0x2c, 0xdf, 0xdb, 0xcd,
-	0xae, 0x5b, 0xa0, 0x45, 0xd0, 0x02, 0x01, 0x0a, 0xf4, 0x03, 0xed, 0xa9, 0xf7, 0x02, 0xed, 0x2d,
-	0xc7, 0xfe, 0x09, 0x39, 0xf4, 0x90, 0x63, 0xd1, 0x83, 0x51, 0xd8, 0x07, 0x9f, 0xda, 0x43, 0xff,
-	0x82, 0x82, 0xf3, 0x1e, 0x29, 0x2e, 0xf7, 0x53, 0x2b, 0x18, 0xe8, 0x45, 0xcb, 0x37, 0x6f, 0x7e,
-	0x33, 0xf3, 0x66, 0xe6, 0x0d, 0x67, 0x28, 0xb8, 0xf9, 0x8c, 0x70, 0xcb, 0x3e, 0xb1, 0x3c, 0x7f,
-	0x07, 0x9f, 0x68, 0x48, 0x76, 0xec, 0x90, 0x32, 0x26, 0x68, 0xbc, 0x59, 0x0c, 0x42, 0xca, 0xa9,
-	0xba, 0x9a, 0xf0, 0x15, 0x63, 0xbe, 0xe2, 0x19, 0x9f, 0xbe, 0xe0, 0x52, 0x97, 0x22, 0xe7, 0x4e,
-	0xf4, 0x24, 0x40, 0xfa, 0xad, 0x2e, 0xc2, 0x83, 0x53, 0x77, 0x07, 0x49, 0x4c, 0xfe, 0x48, 0xde,
-	0x9b, 0xbd, 0x78, 0xa9, 0xe7, 0xe3, 0x9f, 0x01, 0x32, 0x83, 0x90, 0xd2, 0x63, 0x26, 0x7f, 0x24,
-	0xef, 0x5e, 0xff, 0xc3, 0x85, 0x16, 0x27, 0x66, 0xd5, 0xab, 0x79, 0x9c, 0x84, 0xe6, 0x71, 0xd5,
-	0x72, 0x63, 0xdc, 0x6e, 0x7f, 0x1c, 0x3e, 0x9a, 0xf8, 0x6c, 0xc6, 0x0e, 0xd2, 0x97, 0x6d, 0xca,
-	0x6a, 0x94, 0xed, 0xd4, 0x98, 0xbb, 0xd3, 0x78, 0x33, 0xfa, 0x11, 0x1b, 0x5b, 0xbf, 0x55, 0x40,
-	0x2d, 0x33, 0xb7, 0xec, 0xb9, 0x91, 0xbe, 0x23, 0xc6, 0x1e, 0xd5, 0x7d, 0x87, 0xa9, 0x1a, 0x4c,
-	0xd9, 0x21, 0xb1, 0x38, 0x0d, 0x35, 0x65, 0x43, 0xd9, 0xce, 0x1b, 0xf1, 0x52, 0xbd, 0x06, 0x39,
-	0x21, 0xdb, 0x73, 0xb4, 0xb1, 0x0d, 0x65, 0xfb, 0xb2, 0x31, 0x85, 0xeb, 0x03, 0x47, 0xdd, 0x83,
-	0x49, 0xab, 0x46, 0xeb, 0x3e, 0xd7, 0x2e, 0x47, 0x98, 0xfd, 0xb5, 0xaf, 0x9f, 0xaf, 0x5f, 0xfa,
-	0xe7, 0xf3, 0xf5, 0x25, 0xa1, 0x9c, 0x39, 0xa7, 0x45, 0x8f, 0xee, 0xd4, 0x2c, 0x7e, 0x52, 0x7c,
-	0xea, 0xf9, 0xdc, 0x90, 0xdc, 0xf7, 0xa7, 0xbf, 0x78, 0xf5, 0xd5, 0xad, 0x58, 0xc1, 0xd6, 0x1b,
-	0xa0, 0x77, 0x1a, 0x64, 0x10, 0x16, 0x50, 0x9f, 0x91, 0xad, 0xcf, 0xe0, 0x6a, 0x99, 0xb9, 0x4f,
-	0x03, 0x47, 0x6c, 0x3e, 0x74, 0x9c, 0x90, 0xb0, 0x7e, 0xf6, 0xae, 0x02, 0x70, 0xc6, 0xcc, 0xa0,
-	0x5e, 0x39, 0x25, 0x2d, 0xb4, 0x38, 0x6f, 0xe4, 0x39, 0x63, 0x87, 0x48, 0xc8, 0xe8, 0x5e, 0x85,
-	0x95, 0x2e, 0xd2, 0x13, 0xe5, 0x7f, 0x1b, 0x83, 0x85, 0x32, 0x73, 0x1f, 0x3a, 0xce, 0x81, 0x5f,
-	0xa1, 0x75, 0xdf, 0x39, 0x0a, 0x2d, 0xfb, 0x94, 0x84, 0xa3, 0xb9, 0x6b, 0x19, 0xa6, 0x78, 0xd3,
-	0x3c, 0xb1, 0xd8, 0x89, 0xf0, 0x97, 0x31, 0xc9, 0x9b, 0xdf, 0xb7, 0xd8, 0x89, 0xba, 0x0f, 0xf9,
-	0x28, 0xa5, 0x4c, 0xde, 0x0a, 0x88, 0x36, 0xbe, 0xa1, 0x6c, 0xcf, 0xee, 0xde, 0x28, 0x76, 0xc9,
-	0xf0, 0xe0, 0xd4, 0x2d, 0x62, 0xee, 0x95, 0xa8, 0xe7, 0x1f, 0xb5, 0x02, 0x62, 0xe4, 0x6c, 0xf9,
-	0xa4, 0x3e, 0x80, 0x09, 0x4c, 0x36, 0x6d, 0x62, 0x43, 0xd9, 0x2e, 0xec, 0x7e, 0xab, 0x17, 0x5e,
-	0x66, 0xe4, 0x61, 0xf4, 0xb3, 0x3f, 0xa6, 0x29, 0x86, 0x80, 0xa9, 0x9b, 0x00, 0x95, 0x2a, 0xb5,
-	0x4f, 0x85, 0x7d, 0x93, 0x18, 0xcf, 0x68, 0x3b, 0x8f, 0x54, 0x34, 0x73, 0x15, 0x72, 0xbc, 0x69,
-	0x7a, 0xbe, 0x43, 0x9a, 0xda, 0x54, 0x74, 0x34, 0x64, 0x98, 0xe2, 0xcd, 0x83, 0x88, 0x94, 0xf1,
-	0xec, 0x1a, 0xbc, 0xd1, 0xcd, 0x73, 0x89, 0x6b, 0xeb, 0xb0, 0x5c, 0x66, 0xae, 0x41, 0x6a, 0xb4,
-	0x41, 0x5e, 0xa7, 0x73, 0x33, 0x66, 0x6d, 0xc2, 0x7a, 0x0f, 0xb5, 0x89, 0x65, 0x7f, 0x1e, 0x83,
-	0xf9, 0x32, 0x73, 0x7f, 0x70, 0xe2, 0x71, 0x52, 0xf5, 0x18, 0xff, 0xc0, 0x28, 0xed, 0xde, 0xed,
-	0x63, 0xd4, 0x75, 0x98, 0x21, 0xa1, 0xbd, 0x7b, 0xd7, 0xb4, 0x44, 0xf6, 0xc8, 0x9c, 0x9b, 0x46,
-	0x62, 0x9c, 0xaf, 0x69, 0xcb, 0x2f, 0xb7, 0x5b, 0xae, 0xc2, 0xb8, 0x6f, 0xd5, 0x44, 0xe0, 0xf3,
-	0x06, 0x3e, 0xab, 0x4b, 0x30, 0xc9, 0x5a, 0xb5, 0x0a, 0xad, 0x62, 0x38, 0xf3, 0x86, 0x5c, 0xa9,
-	0x3a, 0xe4, 0x1c, 0x62, 0x7b, 0x35, 0xab, 0xca, 0x30, 0x46, 0x33, 0x46, 0xb2, 0x56, 0x57, 0x20,
-	0xef, 0x5a, 0x4c, 0x54, 0x10, 0x11, 0x1f, 0x23, 0xe7, 0x5a, 0xec, 0xc3, 0x68, 0xad, 0x96, 0x60,
-	0xa6, 0xea, 0xfd, 0xa4, 0xee, 0x39, 0x1e, 0x6f, 0x99, 0xb6, 0x15, 0x68, 0xb9, 0xa1, 0x6e, 0xec,
-	0x74, 0x02, 0x2a, 0x59, 0x41, 0xc6, 0x95, 0x26, 0x5c, 0xeb, 0x70, 0x53, 0xec, 0xc4, 0xc8, 0x29,
-	0xcf, 0xda, 0x9c, 0x22, 0x9c, 0x36, 0xfd, 0x2c, 0xed, 0x94, 0x55, 0x00, 0xdb, 0x4e, 0x52, 0x4a,
-	0x5e, 0xd5, 0x88, 0x82, 0x09, 0xb5, 0xf5, 0xeb, 0x31, 0x58, 0x14, 0x39, 0xf4, 0xa4, 0xce, 0x2f,
-	0x9e, 0x21, 0x0b, 0x30, 0xe1, 0x53, 0xdf, 0x26, 0xe8, 0xff, 0x71, 0x43, 0x2c, 0xd2, 0x79, 0x33,
-	0xde, 0x76, 0x29, 0xff, 0xdf, 0x2e, 0xd4, 0x03, 0x58, 0xed, 0xea, 0x8c, 0xc4, 0xe5, 0xab, 0x00,
-	0x1e, 0x33, 0x43, 0x4c, 0x6d, 0x07, 0xfd, 0x92, 0x33, 0xf2, 0x1e, 0x13, 0xb9, 0xee, 0x6c, 0x31,
-	0xd0, 0x92, 0xcc, 0x7f, 0x7d, 0xfe, 0xcc, 0x18, 0xbd, 0x05, 0x1b, 0xbd, 0x94, 0x26, 0xf7, 0xed,
-	0xef, 0x0a, 0x5c, 0x29, 0x33, 0xf7, 0x13, 0xca, 0xc9, 0x63, 0x8b, 0x1d, 0x86, 0x9e, 0x4d, 0x46,
-	0x36, 0x28, 0x88, 0xd0, 0xb1, 0x41, 0xb8, 0x50, 0x37, 0x61, 0x3a, 0x08, 0x3d, 0x1a, 0x46, 0x89,
-	0x7f, 0x4c, 0x08, 0x46, 0x62, 0xdc, 0x28, 0xc4, 0xb4, 0x47, 0x04, 0x59, 0x44, 0xa8, 0xfc, 0x7a,
-	0xad, 0x42, 0x42, 0x4c, 0x84, 0x71, 0xa3, 0x80, 0xb4, 0x8f, 0x90, 0xa4, 0xea, 0x30, 0xc9, 0xea,
-	0x41, 0x50, 0x6d, 0x89, 0x0b, 0x89, 0x81, 0x92, 0x94, 0xcc, 0x91, 0xaf, 0x61, 0x61, 0x4b, 0x9f,
-	0x26, 0x39, 0xe9, 0x7f, 0x26, 0x93, 0x93, 0xc6, 0xce, 0xe8, 0x73, 0xd2, 0x15, 0xc0, 0xbb, 0x20,
-	0xf2, 0x47, 0x5c, 0x8e, 0x5c, 0x44, 0xc0, 0xd4, 0x79, 0x0b, 0x96, 0x68, 0x85, 0x91, 0xb0, 0x41,
-	0x1c, 0x93, 0x4a, 0x59, 0xe9, 0xea, 0xb7, 0x10, 0xef, 0xc6, 0x8a, 0x10, 0x55, 0x82, 0xb5, 0x4e,
-	0x94, 0xcc, 0x52, 0xe2, 0xb9, 0x27, 0x5c, 0x1e, 0x7d, 0x25, 0x8b, 0xde, 0xc7, 0x9c, 0x45, 0x16,
-	0xf5, 0x5d, 0xd0, 0x3b, 0x85, 0x44, 0x95, 0xa7, 0xce, 0x88, 0xa3, 0x01, 0x0a, 0x58, 0xce, 0x0a,
-	0x78, 0x6c, 0xb1, 0xa7, 0x8c, 0x38, 0x2a, 0x85, 0x1b, 0x9d, 0x60, 0x72, 0x7c, 0x4c, 0x6c, 0xee,
-	0x35, 0x08, 0x8a, 0x11, 0x31, 0x2c, 0xa0, 0x9b, 0x57, 0x65, 0x7d, 0x5a, 0xec, 0xac, 0x4f, 0x07,
-	0x3e, 0x37, 0x36, 0xb3, 0x6a, 0x3e, 0x88, 0x25, 0x25, 0x99, 0x74, 0x38, 0x58, 0xa1, 0xa8, 0x98,
-	0xd3, 0x68, 0x78, 0x5f, 0x89, 0xa2, 0x94, 0xfe, 0x18, 0x66, 0x1b, 0x56, 0xb5, 0x4e, 0xcc, 0x90,
-	0xd8, 0xc4, 0x8b, 0xee, 0x9a, 0x48, 0x89, 0xb7, 0xfb, 0xd7, 0xd2, 0xff, 0x3e, 0x5f, 0x5f, 0x6c,
-	0x59, 0xb5, 0xea, 0xfd, 0xad, 0x76, 0xf4, 0x96, 0x31, 0x83, 0x04, 0x43, 0xae, 0xd5, 0xf7, 0x61,
-	0x92, 0x71, 0x8b, 0xd7, 0x45, 0x85, 0x9f, 0xdd, 0xbd, 0xdd, 0xb3, 0x15, 0x10, 0x0d, 0xab, 0x04,
-	0x7e, 0x8c, 0x18, 0x43, 0x62, 0xd5, 0x1b, 0x30, 0x9b, 0x1c, 0x17, 0x19, 0xe5, 0x2b, 0x61, 0x26,
-	0xa6, 0x96, 0x22, 0xa2, 0x7a, 0x1b, 0xd4, 0x84, 0x2d, 0x6a, 0x9b, 0xc4, 0x8d, 0xce, 0xa1, 0x2f,
-	0xe6, 0xe2, 0x9d, 0x23, 0xc6, 0x3e, 0xc2, 0x62, 0xd9, 0xd6, 0xa8, 0xe4, 0x47, 0x6b, 0x54, 0x3e,
-	0x83, 0x79, 0x9b, 0xfa, 0xc7, 0x5e, 0x58, 0xb3, 0xb8, 0x47, 0x7d, 0xb3, 0x46, 0x1d, 0xa2, 0xcd,
-	0xa0, 0xac, 0x9d, 0x62, 0xdf, 0xb6, 0xbe, 0x58, 0x4a, 0xe1, 0xca, 0xd4, 0x21, 0xc6, 0x9c, 0x9d,
-	0xa1, 0xf4, 0xbc, 0x8b, 0x71, 0x38, 0x93, 0xbb, 0xf8, 0x65, 0x0e, 0x66, 0xe5, 0x9e, 0xec, 0x03,
-	0xfa, 0x5c, 0xc5, 0xe8, 0x75, 0x4c, 0x7c, 0x87, 0x84, 0xf2, 0x1e, 0xca, 0x95, 0x7a, 0x13, 0xae,
-	0x88, 0x27, 0x33, 0xf3, 0x72, 0x9f, 0x11, 0xe4, 0x92, 0xac, 0x4c, 0x3a, 0xe4, 0x64, 0xb8, 0x43,
-	0xf9, 0x96, 0x49, 0xd6, 0x51, 0xa0, 0xe2, 0x67, 0x19, 0xa8, 0x09, 0x21, 0x22, 0xa6, 0x8a, 0x40,
-	0x9d, 0xf5, 0xda, 0x93, 0xe7, 0xe9, 0xb5, 0xa3, 0x43, 0xd5, 0x08, 0x63, 0x96, 0x2b, 0xa2, 0x9a,
-	0x37, 0xe2, 0x65, 0x54, 0xf5, 0x3c, 0x3f, 0x55, 0x38, 0xf2, 0xb8, 0x5d, 0x90, 0x34, 0xac, 0x17,
-	0x77, 0x61, 0x21, 0x66, 0x69, 0xab, 0x12, 0xe2, 0x92, 0xab, 0x72, 0x2f, 0x5d, 0x1c, 0xda, 0x9a,
-	0x90, 0x02, 0xb2, 0x9d, 0x35, 0x21, 0x6d, 0xe9, 0x33, 0x3d, 0x5a, 0xfa, 0xac, 0x40, 0x9e, 0x37,
-	0x4d, 0x1a, 0x7a, 0xae, 0xe7, 0x63, 0xda, 0xe4, 0x8d, 0x1c, 0x6f, 0x3e, 0xc1, 0x75, 0xf4, 0x06,
-	0xb0, 0x18, 0x23, 0x5c, 0x9b, 0xc5, 0x0d, 0xb1, 0x50, 0xd7, 0xa1, 0x40, 0x1a, 0xc4, 0xe7, 0xf2,
-	0x4d, 0x7b, 0x05, 0xad, 0x02, 0x24, 0xe1, 0x8b, 0x56, 0x0d, 0xe1, 0x1a, 0x0e, 0x47, 0x36, 0xad,
-	0x9a, 0x36, 0xf5, 0x79, 0x68, 0xd9, 0xdc, 0x6c, 0x90, 0x90, 0x79, 0xd4, 0xd7, 0xe6, 0xd0, 0xce,
-	0xbd, 0x01, 0xa9, 0x79, 0x28, 0xf1, 0x25, 0x09, 0xff, 0x44, 0xa0, 0x8d, 0xe5, 0xa0, 0xfb, 0x86,
-	0xfa, 0xa3, 0x28, 0xec, 0x0d, 0x12, 0x72, 0x93, 0x06, 0x51, 0xf6, 0x32, 0x6d, 0x1e, 0xfb, 0x8c,
-	0xdb, 0x03, 0x14, 0x19, 0x08, 0x7a, 0x22, 0x30, 0xfb, 0xe3, 0x51, 0x16, 0x44, 0xa9, 0x92, 0x22,
-	0xaa, 0x65, 0x98, 0xb6, 0xad, 0x6a, 0x35, 0x11, 0xac, 0xa2, 0xe0, 0x5b, 0x83, 0x2e, 0x97, 0x55,
-	0xad, 0x4a, 0x09, 0x46, 0xc1, 0x3e, 0x5b, 0xa8, 0x77, 0xe0, 0xaa, 0xc7, 0xcc, 0xf4, 0x94, 0x19,
-	0xed, 0x6a, 0x57, 0xb1, 0xc1, 0x98, 0xf3, 0x58, 0x29, 0xda, 0xc1, 0x24, 0x8d, 0x44, 0xa4, 0xca,
-	0xd7, 0x42, 0xef, 0xf2, 0x95, 0xd2, 0x2b, 0x6f, 0x5f, 0xa6, 0x7c, 0x75, 0xad, 0x12, 0x8b, 0xaf,
-	0xa7, 0x4a, 0x68, 0xb0, 0xd4, 0x5e, 0x09, 0x92, 0x22, 0xf1, 0x29, 0x4e, 0x02, 0x0f, 0x2b, 0x34,
-	0xe4, 0x1f, 0xf3, 0xba, 0x7d, 0x5a, 0x2a, 0x1d, 0xfd, 0xb0, 0xff, 0xe8, 0xd9, 0xa7, 0x9f, 0xcd,
-	0x68, 0x5d, 0xc1, 0xf6, 0xb9, 0x5d, 0x76, 0xa2, 0xf8, 0x97, 0x0a, 0x0e, 0x9e, 0x06, 0x39, 0xae,
-	0xfb, 0x0e, 0xf2, 0x10, 0xe7, 0x42, 0xca, 0x45, 0x99, 0x89, 0xa4, 0x25, 0x1d, 0xb9, 0x68, 0x14,
-	0x66, 0x04, 0x55, 0xb6, 0xe4, 0x5d, 0x87, 0xb8, 0x0e, 0x2b, 0x12, 0x33, 0xff, 0xa2, 0xe0, 0x21,
-	0xc4, 0xfc, 0x6c, 0x58, 0x9c, 0x7c, 0x28, 0x3e, 0x5f, 0x3c, 0xaa, 0x5a, 0x6e, 0xbf, 0x19, 0xdd,
-	0x06, 0xb5, 0xf3, 0x6b, 0x07, 0xda, 0x5c, 0x18, 0x18, 0xde, 0xac, 0x1a, 0x79, 0x07, 0xe6, 0xc2,
-	0x0c, 0x3d, 0x73, 0x94, 0xeb, 0xb0, 0xd9, 0xd3, 0xd2, 0xe4, 0x3c, 0xff, 0x56, 0xf0, 0x7b, 0x80,
-	0xfc, 0x16, 0x81, 0x13, 0x4d, 0xa9, 0xce, 0x38, 0x75, 0x5a, 0x17, 0xf8, 0x4a, 0x52, 0x84, 0xab,
-	0x3e, 0xf9, 0xdc, 0xb4, 0x85, 0xa0, 0x8c, 0xfb, 0xe7, 0x7d, 0xf2, 0xb9, 0x54, 0x11, 0x4f, 0x45,
-	0x1d, 0xf3, 0xe4, 0x78, 0x97, 0x79, 0xf2, 0xec, 0x75, 0x30, 0x71, 0x81, 0x4f, 0x2f, 0xef, 0xc3,
-	0xf5, 0x3e, 0xc7, 0x4d, 0x4f, 0x16, 0xa9, 0xd4, 0x52, 0xb2, 0x73, 0xda, 0x4f, 0xb1, 0xc9, 0x17,
-	0xae, 0x4d, 0x0b, 0x39, 0xb4, 0xea, 0x4c, 0xb6, 0x25, 0xa3, 0x37, 0xf4, 0x91, 0x0c, 0xf4, 0x55,
-	0xce, 0x10, 0x8b, 0xcc, 0x11, 0x0e, 0x60, 0x7b, 0x90, 0xf2, 0x21, 0xcf, 0xb1, 0xfb, 0x87, 0x39,
-	0xb8, 0x5c, 0x66, 0xae, 0xfa, 0xa5, 0x02, 0x6a, 0x97, 0xa1, 0xf3, 0xad, 0x01, 0x89, 0xd9, 0x75,
-	0x3a, 0xd3, 0xbf, 0x3b, 0x0a, 0x2a, 0xb1, 0xf8, 0x57, 0x0a, 0xcc, 0x77, 0x7e, 0x7d, 0xba, 0x37,
-	0x94, 0xcc, 0x76, 0x90, 0xfe, 0xee, 0x08, 0xa0, 0xc4, 0x8e, 0xdf, 0x28, 0xb0, 0xd0, 0xf5, 0x5b,
-	0xcd, 0xde, 0x60, 0xa9, 0xdd, 0x70, 0xfa, 0x83, 0xd1, 0x70, 0x89, 0x41, 0xbf, 0x57, 0x60, 0xb1,
-	0xfb, 0x2c, 0xfb, 0xf6, 0xb0, 0x92, 0xb3, 0x91, 0x7a, 0x6f, 0x44, 0x60, 0x62, 0x53, 0x03, 0xa6,
-	0xdb, 0x86, 0xd8, 0xe2, 0x60, 0x81, 0x69, 0x7e, 0x7d, 0xef, 0x7c, 0xfc, 0x59, 0xbd, 0xc9, 0x48,
-	0x39, 0xa4, 0xde, 0x98, 0x7f, 0x58, 0xbd, 0xd9, 0x16, 0x5a, 0x65, 0x50, 0x48, 0xb7, 0xcf, 0x77,
-	0x86, 0x13, 0x23, 0xd9, 0xf5, 0x6f, 0x9f, 0x8b, 0x3d, 0x51, 0xfa, 0x33, 0x98, 0xcd, 0x7c, 0x99,
-	0xbb, 0x3b, 0x58, 0x50, 0x3b, 0x42, 0x7f, 0xe7, 0xbc, 0x88, 0x44, 0xfb, 0x17, 0x0a, 0xcc, 0x75,
-	0x7c, 0x8b, 0xde, 0x1d, 0x2c, 0x2e, 0x8b, 0xd1, 0xef, 0x9f, 0x1f, 0x93, 0x18, 0xf1, 0x73, 0xb8,
-	0x92, 0xfd, 0x7c, 0xff, 0xe6, 0x60, 0x71, 0x19, 0x88, 0xfe, 0x9d, 0x73, 0x43, 0xd2, 0x31, 0xc8,
-	0xf4, 0x44, 0x43, 0xc4, 0xa0, 0x1d, 0x31, 0x4c, 0x0c, 0xba, 0xf7, 0x46, 0x58, 0x13, 0x3b, 0x1b,
-	0xa3, 0x7b, 0xc3, 0xdc, 0xde, 0x0c, 0x68, 0x98, 0x9a, 0xd8, 0xb3, 0xf9, 0x51, 0xff, 0xa8, 0xc0,
-	0x52, 0x8f, 0xce, 0xe7, 0x9d, 0x61, 0xa3, 0x9b, 0x45, 0xea, 0xdf, 0x1b, 0x15, 0x99, 0x98, 0xf5,
-	0x27, 0x05, 0xb4, 0x9e, 0x0d, 0xcc, 0xfd, 0xa1, 0x83, 0xde, 0x81, 0xd5, 0xf7, 0x47, 0xc7, 0x26,
-	0xc6, 0xfd, 0x55, 0x81, 0xd5, 0xfe, 0x8d, 0xc2, 0x7b, 0xc3, 0x3a, 0xa0, 0x87, 0x00, 0xfd, 0xf1,
-	0x05, 0x05, 0xc4, 0xb6, 0xea, 0x13, 0xbf, 0x78, 0xf5, 0xd5, 0x2d, 0x65, 0xff, 0xf1, 0xd7, 0x2f,
-	0xd6, 0x94, 0x6f, 0x5e, 0xac, 0x29, 0xff, 0x7a, 0xb1, 0xa6, 0xfc, 0xee, 0xe5, 0xda, 0xa5, 0x6f,
-	0x5e, 0xae, 0x5d, 0xfa, 0xc7, 0xcb, 0xb5, 0x4b, 0x9f, 0xde, 0x71, 0x3d, 0x7e, 0x52, 0xaf, 0x14,
-	0x6d, 0x5a, 0xc3, 0x7f, 0xcc, 0xdd, 0x11, 0xff, 0x8f, 0xf3, 0xa9, 0x43, 0x76, 0x9a, 0x6d, 0xff,
-	0xb6, 0x6c, 0x05, 0x84, 0x55, 0x26, 0x71, 0x14, 0xbc, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x64, 0xc4, 0x12, 0xbe, 0xe4, 0x1c, 0x00, 0x00,
+	// 2164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0x65, 0x49, 0xde, 0x7d, 0x2b, 0xc9, 0x12, 0xad, 0x8f, 0x15, 0x15, 0x7d, 0x3a, 0x76,
+	0x05, 0xc3, 0x5e, 0xc9, 0x72, 0xaa, 0x38, 0x72, 0xe0, 0xd4, 0x5a, 0xc7, 0x8e, 0x80, 0x6c, 0x2c,
+	0x30, 0x72, 0xda, 0xa6, 0x41, 0x09, 0x2e, 0x39, 0xa2, 0xa6, 0xda, 0xe5, 0xb0, 0x9c, 0x59, 0x65,
+	0xe5, 0x16, 0x68, 0x11, 0xb4, 0x40, 0x80, 0x02, 0xfd, 0x00, 0x7a, 0x2a, 0x50, 0xa0, 0x97, 0x02,
+	0x2d, 0xd0, 0x43, 0xfe, 0x8c, 0x1c, 0x7a, 0xc8, 0xa5, 0x40, 0xd1, 0x83, 0x51, 0xd8, 0x87, 0x9c,
+	0xda, 0x43, 0xff, 0x82, 0x82, 0x33, 0xc3, 0x11, 0x97, 0xfb, 0xbd, 0x8a, 0x2f, 0x16, 0xe7, 0xf1,
+	0xfd, 0xde, 0x7b, 0xf3, 0xbe, 0xf8, 0x66, 0xd6, 0x70, 0xfd, 0x19, 0x62, 0xb6, 0x73, 0x64, 0x63,
+	0x7f, 0x83, 0x3f, 0x91, 0x10, 0x6d, 0x38, 0x21, 0xa1, 0x54, 0xd0, 0x58, 0xbd, 0x10, 0x84, 0x84,
+	0x11, 0x7d, 0x51, 0xf1, 0x15, 0x62, 0xbe, 0xc2, 0x19, 0x9f, 0x31, 0xed, 0x11, 0x8f, 0x70, 0xce,
+	0x8d, 0xe8, 0x49, 0x80, 0x8c, 0x1b, 0x2d, 0x84, 0x07, 0xc7, 0xde, 0x06, 0x27, 0x51, 0xf9, 0x47,
+	0xf2, 0x5e, 0x6f, 0xc7, 0x4b, 0xb0, 0xcf, 0xff, 0xe9, 0x22, 0x33, 0x08, 0x09, 0x39, 0xa4, 0xf2,
+	0x8f, 0xe4, 0xdd, 0xee, 0xbc, 0xb9, 0xd0, 0x66, 0xc8, 0xaa, 0xe0, 0x2a, 0x66, 0x28, 0xb4, 0x0e,
+	0x2b, 0xb6, 0x17, 0xe3, 0xb6, 0x3a, 0xe3, 0xf8, 0xa3, 0xc5, 0x9f, 0xad, 0xd8, 0x41, 0xc6, 0x9c,
+	0x43, 0x68, 0x95, 0xd0, 0x8d, 0x2a, 0xf5, 0x36, 0x4e, 0x6e, 0x47, 0x7f, 0xc4, 0x8b, 0xb5, 0xdf,
+	0x68, 0xa0, 0x97, 0xa8, 0x57, 0xc2, 0x5e, 0xa4, 0xef, 0x80, 0xd2, 0x47, 0x35, 0xdf, 0xa5, 0x7a,
+	0x1e, 0x2e, 0x39, 0x21, 0xb2, 0x19, 0x09, 0xf3, 0xda, 0x8a, 0xb6, 0x9e, 0x35, 0xe3, 0xa5, 0x3e,
+	0x0f, 0x19, 0x21, 0x1b, 0xbb, 0xf9, 0xa1, 0x15, 0x6d, 0xfd, 0xa2, 0x79, 0x89, 0xaf, 0xf7, 0x5c,
+	0x7d, 0x1b, 0x46, 0xed, 0x2a, 0xa9, 0xf9, 0x2c, 0x7f, 0x31, 0xc2, 0xec, 0x2e, 0x7d, 0xf9, 0x7c,
+	0xf9, 0xc2, 0xbf, 0x9e, 0x2f, 0xcf, 0x0a, 0xe5, 0xd4, 0x3d, 0x2e, 0x60, 0xb2, 0x51, 0xb5, 0xd9,
+	0x51, 0xe1, 0x29, 0xf6, 0x99, 0x29, 0xb9, 0x77, 0xc6, 0x3e, 0xfb, 0xfa, 0x8b, 0x1b, 0xb1, 0x82,
+	0xb5, 0xd7, 0xc0, 0x68, 0x36, 0xc8, 0x44, 0x34, 0x20, 0x3e, 0x45, 0x6b, 0x9f, 0xc0, 0x95, 0x12,
+	0xf5, 0x9e, 0x06, 0xae, 0x78, 0xf9, 0xc0, 0x75, 0x43, 0x44, 0x3b, 0xd9, 0xbb, 0x08, 0xc0, 0x28,
+	0xb5, 0x82, 0x5a, 0xf9, 0x18, 0x9d, 0x72, 0x8b, 0xb3, 0x66, 0x96, 0x51, 0xba, 0xcf, 0x09, 0x29,
+	0xdd, 0x8b, 0xb0, 0xd0, 0x42, 0xba, 0x52, 0xfe, 0xb7, 0x21, 0x98, 0x2e, 0x51, 0xef, 0x81, 0xeb,
+	0xee, 0xf9, 0x65, 0x52, 0xf3, 0xdd, 0x83, 0xd0, 0x76, 0x8e, 0x51, 0x38, 0x98, 0xbb, 0xe6, 0xe0,
+	0x12, 0xab, 0x5b, 0x47, 0x36, 0x3d, 0x12, 0xfe, 0x32, 0x47, 0x59, 0xfd, 0x3d, 0x9b, 0x1e, 0xe9,
+	0xbb, 0x90, 0x8d, 0x52, 0xca, 0x62, 0xa7, 0x01, 0xca, 0x0f, 0xaf, 0x68, 0xeb, 0x13, 0x5b, 0xd7,
+	0x0a, 0x2d, 0x32, 0x3c, 0x38, 0xf6, 0x0a, 0x3c, 0xf7, 0x8a, 0x04, 0xfb, 0x07, 0xa7, 0x01, 0x32,
+	0x33, 0x8e, 0x7c, 0xd2, 0x77, 0x60, 0x84, 0x27, 0x5b, 0x7e, 0x64, 0x45, 0x5b, 0xcf, 0x6d, 0xbd,
+	0xde, 0x0e, 0x2f, 0x33, 0x72, 0x3f, 0xfa, 0x63, 0x0a, 0x48, 0xe4, 0xb2, 0x72, 0x85, 0x38, 0xc7,
+	0xc2, 0xb6, 0x51, 0xe1, 0x32, 0x4e, 0xe1, 0xe6, 0xcd, 0x43, 0x86, 0xd5, 0x2d, 0xec, 0xbb, 0xa8,
+	0x9e, 0xbf, 0x24, 0xb6, 0xc4, 0xea, 0x7b, 0xd1, 0x32, 0xe5, 0xcd, 0x25, 0x78, 0xad, 0x95, 0xb7,
+	0x94, 0x3b, 0x6b, 0x30, 0x57, 0xa2, 0x9e, 0x89, 0xaa, 0xe4, 0x04, 0xbd, 0x4a, 0x87, 0xa6, 0xcc,
+	0x5a, 0x85, 0xe5, 0x36, 0x6a, 0x95, 0x65, 0x7f, 0x1e, 0x82, 0xa9, 0x12, 0xf5, 0xbe, 0x7b, 0x84,
+	0x19, 0xaa, 0x60, 0xca, 0xde, 0x35, 0x8b, 0x5b, 0x9b, 0x1d, 0x8c, 0xba, 0x0a, 0xe3, 0x28, 0x74,
+	0xb6, 0x36, 0x2d, 0x5b, 0x64, 0x8c, 0xcc, 0xb3, 0x31, 0x4e, 0x8c, 0x73, 0x34, 0x69, 0xf9, 0xc5,
+	0x46, 0xcb, 0x75, 0x18, 0xf6, 0xed, 0xaa, 0x08, 0x76, 0xd6, 0xe4, 0xcf, 0xfa, 0x2c, 0x8c, 0xd2,
+	0xd3, 0x6a, 0x99, 0x54, 0x78, 0x08, 0xb3, 0xa6, 0x5c, 0xe9, 0x06, 0x64, 0x5c, 0xe4, 0xe0, 0xaa,
+	0x5d, 0xa1, 0x3c, 0x36, 0xe3, 0xa6, 0x5a, 0xeb, 0x0b, 0x90, 0xf5, 0x6c, 0x2a, 0xba, 0x86, 0x8c,
+	0x4d, 0xc6, 0xb3, 0xe9, 0xfb, 0xd1, 0x5a, 0x2f, 0xc2, 0x78, 0x05, 0xff, 0xb8, 0x86, 0x5d, 0xcc,
+	0x4e, 0x2d, 0xc7, 0x0e, 0xf2, 0x99, 0x9e, 0xaa, 0x74, 0x4c, 0x81, 0x8a, 0x76, 0x90, 0x72, 0xa5,
+	0x05, 0xf3, 0x4d, 0x6e, 0x8a, 0x9d, 0x18, 0x39, 0xe5, 0x59, 0x83, 0x53, 0x84, 0xd3, 0xc6, 0x9e,
+	0x25, 0x9d, 0xb2, 0x08, 0xe0, 0x38, 0x2a, 0x9d, 0x64, 0x79, 0x3a, 0x8e, 0x4c, 0xa8, 0xb5, 0x5f,
+	0x0d, 0xc1, 0x8c, 0xc8, 0xa1, 0x27, 0x35, 0x76, 0xfe, 0x0c, 0x99, 0x86, 0x11, 0x9f, 0xf8, 0x0e,
+	0xe2, 0xfe, 0x1f, 0x36, 0xc5, 0x22, 0x99, 0x37, 0xc3, 0x0d, 0x85, 0x78, 0x7f, 0x80, 0x22, 0xda,
+	0x1d, 0xca, 0x6b, 0x71, 0x21, 0xad, 0x36, 0x17, 0x12, 0x7f, 0x9d, 0x28, 0xa6, 0xc5, 0x74, 0x31,
+	0x71, 0x86, 0x36, 0x05, 0x75, 0x1f, 0x16, 0x5b, 0x3a, 0x43, 0xb9, 0x7c, 0x11, 0x00, 0x53, 0x2b,
+	0xe4, 0xa9, 0xed, 0x72, 0xbf, 0x64, 0xcc, 0x2c, 0xa6, 0x22, 0xd7, 0xdd, 0x35, 0x0a, 0x79, 0x95,
+	0xf9, 0xaf, 0xce, 0x9f, 0x29, 0xa3, 0xd7, 0x60, 0xa5, 0x9d, 0x52, 0x55, 0x6f, 0x7f, 0xd7, 0xe0,
+	0x72, 0x89, 0x7a, 0x1f, 0x11, 0x86, 0x1e, 0xdb, 0x74, 0x3f, 0xc4, 0x0e, 0x1a, 0xd8, 0xa0, 0x20,
+	0xc4, 0x67, 0x06, 0xf1, 0x85, 0xbe, 0x0a, 0x63, 0x41, 0x88, 0x49, 0x18, 0x25, 0xfe, 0x21, 0x42,
+	0x3c, 0x12, 0xc3, 0x66, 0x2e, 0xa6, 0x3d, 0x42, 0x9c, 0x45, 0x84, 0xca, 0xaf, 0x55, 0xcb, 0x28,
+	0xe4, 0x89, 0x30, 0x6c, 0xe6, 0x38, 0xed, 0x03, 0x4e, 0xd2, 0x0d, 0x18, 0xa5, 0xb5, 0x20, 0xa8,
+	0x9c, 0x8a, 0x82, 0xe4, 0x81, 0x92, 0x94, 0xd4, 0x96, 0xe7, 0x61, 0x2e, 0xb5, 0x1b, 0xb5, 0xd3,
+	0xff, 0x8e, 0xaa, 0x9d, 0xc6, 0xce, 0xe8, 0xb0, 0xd3, 0x05, 0xe0, 0xb5, 0x20, 0xf2, 0x47, 0x14,
+	0x47, 0x26, 0x22, 0xf0, 0xd4, 0x79, 0x03, 0x66, 0x49, 0x99, 0xa2, 0xf0, 0x04, 0xb9, 0x16, 0x91,
+	0xb2, 0x92, 0xdd, 0x6f, 0x3a, 0x7e, 0x1b, 0x2b, 0xe2, 0xa8, 0x22, 0x2c, 0x35, 0xa3, 0x64, 0x96,
+	0x22, 0xec, 0x1d, 0x31, 0xb9, 0xf5, 0x85, 0x34, 0x7a, 0x97, 0xe7, 0x2c, 0x67, 0xd1, 0xef, 0x81,
+	0xd1, 0x2c, 0x24, 0xea, 0x3c, 0x35, 0x8a, 0xdc, 0x3c, 0x70, 0x01, 0x73, 0x69, 0x01, 0x8f, 0x6d,
+	0xfa, 0x94, 0x22, 0x57, 0x27, 0x70, 0xad, 0x19, 0x8c, 0x0e, 0x0f, 0x91, 0xc3, 0xf0, 0x09, 0xe2,
+	0x62, 0x44, 0x0c, 0x73, 0xdc, 0xcd, 0x8b, 0xb2, 0x3f, 0xcd, 0x34, 0xf7, 0xa7, 0x3d, 0x9f, 0x99,
+	0xab, 0x69, 0x35, 0xef, 0xc6, 0x92, 0x54, 0x26, 0xed, 0x77, 0x57, 0x28, 0x3a, 0xe6, 0x18, 0x37,
+	0xbc, 0xa3, 0x44, 0xd1, 0x4a, 0x7f, 0x08, 0x13, 0x27, 0x76, 0xa5, 0x86, 0xac, 0x10, 0x39, 0x08,
+	0x47, 0xb5, 0x26, 0x52, 0xe2, 0xcd, 0xce, 0xbd, 0xf4, 0x7f, 0xcf, 0x97, 0x67, 0x4e, 0xed, 0x6a,
+	0x65, 0x67, 0xad, 0x11, 0xbd, 0x66, 0x8e, 0x73, 0x82, 0x29, 0xd7, 0xfa, 0x43, 0x18, 0xa5, 0xcc,
+	0x66, 0x35, 0xd1, 0xe1, 0x27, 0xb6, 0x6e, 0xb6, 0xfd, 0xfc, 0x8b, 0x21, 0x55, 0x02, 0x3f, 0xe4,
+	0x18, 0x53, 0x62, 0xf5, 0x6b, 0x30, 0xa1, 0xb6, 0xcb, 0x19, 0xe5, 0x27, 0x61, 0x3c, 0xa6, 0x16,
+	0x23, 0xa2, 0x7e, 0x13, 0x74, 0xc5, 0x16, 0x8d, 0x4a, 0xa2, 0xa2, 0x33, 0xdc, 0x17, 0x93, 0xf1,
+	0x9b, 0x03, 0x4a, 0x3f, 0x88, 0xe8, 0x8d, 0xc3, 0x49, 0x76, 0xb0, 0xe1, 0xe4, 0x13, 0x98, 0x72,
+	0x88, 0x7f, 0x88, 0xc3, 0xaa, 0xcd, 0x30, 0xf1, 0xad, 0x2a, 0x71, 0x51, 0x7e, 0x9c, 0xcb, 0xda,
+	0x28, 0x74, 0x1c, 0xe5, 0x0b, 0xc5, 0x04, 0xae, 0x44, 0x5c, 0x64, 0x4e, 0x3a, 0x29, 0x4a, 0xdb,
+	0x5a, 0x8c, 0xc3, 0xa9, 0x6a, 0xf1, 0xf3, 0x0c, 0x4c, 0xc8, 0x77, 0x7b, 0x7e, 0xb7, 0x52, 0x8c,
+	0x3e, 0xc7, 0xc8, 0x77, 0x51, 0x28, 0xeb, 0x50, 0xae, 0xf4, 0xeb, 0x70, 0x59, 0x3c, 0x59, 0xa9,
+	0x8f, 0xfb, 0xb8, 0x20, 0x17, 0x65, 0x67, 0x32, 0x20, 0x23, 0xc3, 0x1d, 0xca, 0xaf, 0x8c, 0x5a,
+	0x47, 0x81, 0x8a, 0x9f, 0x65, 0xa0, 0x46, 0x84, 0x88, 0x98, 0x2a, 0x02, 0x75, 0x36, 0x5f, 0x8f,
+	0xf6, 0x33, 0x5f, 0x47, 0x9b, 0xaa, 0x22, 0x4a, 0x6d, 0x4f, 0x44, 0x35, 0x6b, 0xc6, 0xcb, 0xa8,
+	0xeb, 0x61, 0x3f, 0xd1, 0x38, 0xb2, 0xfc, 0x75, 0x0e, 0xfb, 0x67, 0xfd, 0x62, 0x13, 0xa6, 0xb1,
+	0xdf, 0xa2, 0x4b, 0x88, 0x22, 0xd7, 0xb1, 0xdf, 0xd4, 0x1c, 0x1a, 0x86, 0x90, 0x1c, 0x67, 0x3b,
+	0x1b, 0x42, 0x1a, 0xd2, 0x67, 0x6c, 0xb0, 0xf4, 0x59, 0x80, 0x2c, 0xab, 0x5b, 0x24, 0xc4, 0x1e,
+	0xf6, 0x79, 0xda, 0x64, 0xcd, 0x0c, 0xab, 0x3f, 0xe1, 0xeb, 0xe8, 0x0b, 0x60, 0x53, 0x8a, 0x58,
+	0x7e, 0x82, 0xbf, 0x10, 0x0b, 0x7d, 0x19, 0x72, 0xe8, 0x04, 0xf9, 0x4c, 0x7e, 0x69, 0x2f, 0x73,
+	0xab, 0x80, 0x93, 0xf8, 0x87, 0x56, 0x0f, 0x61, 0x9e, 0x1f, 0x88, 0x1c, 0x52, 0xb1, 0x1c, 0xe2,
+	0xb3, 0xd0, 0x76, 0x98, 0x75, 0x82, 0x42, 0x8a, 0x89, 0x9f, 0x9f, 0xe4, 0x76, 0x6e, 0x77, 0x49,
+	0xcd, 0x7d, 0x89, 0x2f, 0x4a, 0xf8, 0x47, 0x02, 0x6d, 0xce, 0x05, 0xad, 0x5f, 0xe8, 0xdf, 0x8f,
+	0xc2, 0x7e, 0x82, 0x42, 0x66, 0x91, 0x20, 0xca, 0x5e, 0x9a, 0x9f, 0xe2, 0x73, 0xc6, 0xcd, 0x2e,
+	0x8a, 0x4c, 0x0e, 0x7a, 0x22, 0x30, 0xbb, 0xc3, 0x51, 0x16, 0x44, 0xa9, 0x92, 0x20, 0xea, 0x25,
+	0x18, 0x73, 0xec, 0x4a, 0x45, 0x09, 0xd6, 0xb9, 0xe0, 0x1b, 0xdd, 0x8a, 0xcb, 0xae, 0x54, 0xa4,
+	0x04, 0x33, 0xe7, 0x9c, 0x2d, 0xf4, 0x5b, 0x70, 0x05, 0x53, 0x2b, 0x79, 0xb2, 0x8c, 0xde, 0xe6,
+	0xaf, 0xf0, 0x01, 0x63, 0x12, 0xd3, 0x62, 0xf4, 0x86, 0x27, 0x69, 0x24, 0x22, 0xd1, 0xbe, 0xa6,
+	0xdb, 0xb7, 0xaf, 0x84, 0x5e, 0x59, 0x7d, 0xa9, 0xf6, 0xd5, 0xb2, 0x4b, 0xcc, 0xbc, 0x9a, 0x2e,
+	0x91, 0x87, 0xd9, 0xc6, 0x4e, 0xa0, 0x9a, 0xc4, 0xc7, 0xfc, 0x24, 0xf0, 0xa0, 0x4c, 0x42, 0xf6,
+	0x21, 0xab, 0x39, 0xc7, 0xc5, 0xe2, 0xc1, 0xf7, 0x3a, 0x1f, 0x37, 0x3b, 0xcc, 0xb3, 0x29, 0xad,
+	0x0b, 0x30, 0xdf, 0x24, 0x5b, 0x29, 0xfe, 0x85, 0xc6, 0x0f, 0x9b, 0x26, 0x3a, 0xac, 0xf9, 0x2e,
+	0xe7, 0x41, 0xee, 0xb9, 0x94, 0x8b, 0x36, 0x13, 0x49, 0x53, 0x13, 0xb9, 0x18, 0x14, 0xc6, 0x05,
+	0x55, 0x8e, 0xe4, 0x2d, 0x0f, 0x71, 0x4d, 0x56, 0x28, 0x33, 0xff, 0xa2, 0xc1, 0xbc, 0x3a, 0x33,
+	0x9b, 0x36, 0x43, 0xef, 0x8b, 0x2b, 0x8b, 0x47, 0xd1, 0x8d, 0x45, 0x07, 0x5b, 0x1d, 0xd0, 0x9b,
+	0x6f, 0x38, 0xb8, 0xcd, 0xb9, 0xae, 0xe1, 0x4d, 0xab, 0x91, 0x35, 0x30, 0x19, 0xa6, 0xe8, 0xa9,
+	0xad, 0x5c, 0x85, 0xd5, 0xb6, 0x96, 0xaa, 0xfd, 0xfc, 0x47, 0x83, 0x85, 0xb3, 0xfb, 0x07, 0x7e,
+	0xa2, 0x29, 0xd6, 0x28, 0x23, 0xee, 0xe9, 0x39, 0x6e, 0x46, 0x0a, 0x70, 0xc5, 0x47, 0x9f, 0x5a,
+	0x8e, 0x10, 0x94, 0x72, 0xff, 0x94, 0x8f, 0x3e, 0x95, 0x2a, 0xe2, 0x53, 0x51, 0xd3, 0x79, 0x72,
+	0xb8, 0xc5, 0x79, 0xf2, 0xec, 0x73, 0x30, 0x72, 0x8e, 0xeb, 0x96, 0x87, 0x70, 0xb5, 0xc3, 0x76,
+	0x93, 0x27, 0x8b, 0x44, 0x6a, 0x69, 0xe9, 0x73, 0xda, 0x4f, 0x60, 0x45, 0xb9, 0x36, 0x29, 0x64,
+	0xdf, 0xae, 0x51, 0x39, 0x96, 0x0c, 0x3e, 0xd0, 0x47, 0x32, 0xb8, 0xaf, 0x32, 0xa6, 0x58, 0xa4,
+	0xb6, 0xb0, 0x07, 0xeb, 0xdd, 0x94, 0xf7, 0xba, 0x0f, 0x9b, 0x67, 0xfb, 0x41, 0x88, 0x03, 0xd9,
+	0x07, 0x8a, 0x38, 0x74, 0x6a, 0x98, 0xed, 0x86, 0xc8, 0x1e, 0xf4, 0x94, 0x94, 0xb2, 0xf6, 0x3a,
+	0xbc, 0xde, 0x49, 0x85, 0x4a, 0xc4, 0x32, 0x3f, 0xec, 0x99, 0x88, 0x22, 0xf6, 0xca, 0x6c, 0xf9,
+	0x16, 0x5c, 0xeb, 0xa8, 0x43, 0x19, 0xf3, 0x03, 0x7e, 0x4b, 0x68, 0xa2, 0x0a, 0xb2, 0x29, 0x7a,
+	0x0f, 0x55, 0xdc, 0xa2, 0xc3, 0xea, 0xdf, 0x54, 0x1b, 0x14, 0x37, 0x7e, 0x29, 0xe1, 0xa9, 0x06,
+	0x6c, 0xa2, 0x1f, 0x21, 0x87, 0x7d, 0xd3, 0x9a, 0x45, 0x03, 0x6e, 0x94, 0x1d, 0x2b, 0xde, 0xfa,
+	0xc7, 0x34, 0x5c, 0x2c, 0x51, 0x4f, 0xff, 0x5c, 0x03, 0xbd, 0xc5, 0x05, 0xc4, 0x1b, 0x5d, 0x9a,
+	0x54, 0xcb, 0x93, 0xba, 0xf1, 0xf6, 0x20, 0x28, 0x95, 0xbd, 0xbf, 0xd4, 0x60, 0xaa, 0xf9, 0xf6,
+	0xf1, 0x4e, 0x4f, 0x32, 0x1b, 0x41, 0xc6, 0xbd, 0x01, 0x40, 0xca, 0x8e, 0x5f, 0x6b, 0x30, 0xdd,
+	0xf2, 0xde, 0x6e, 0xbb, 0xbb, 0xd4, 0x56, 0x38, 0xe3, 0xfe, 0x60, 0x38, 0x65, 0xd0, 0xef, 0x34,
+	0x98, 0x69, 0x7d, 0xaf, 0xf1, 0x66, 0xaf, 0x92, 0xd3, 0x91, 0x7a, 0x67, 0x40, 0xa0, 0xb2, 0xe9,
+	0x04, 0xc6, 0x1a, 0x2e, 0x34, 0x0a, 0xdd, 0x05, 0x26, 0xf9, 0x8d, 0xed, 0xfe, 0xf8, 0xd3, 0x7a,
+	0xd5, 0xf5, 0x42, 0x8f, 0x7a, 0x63, 0x7e, 0x63, 0xbb, 0x3f, 0x7e, 0xa5, 0x97, 0x42, 0x2e, 0x79,
+	0x94, 0xba, 0xd5, 0x9b, 0x18, 0xc9, 0x6e, 0x7c, 0xbb, 0x2f, 0x76, 0xa5, 0xf4, 0xa7, 0x30, 0x91,
+	0xba, 0xa5, 0xdd, 0xec, 0x2e, 0xa8, 0x11, 0x61, 0xdc, 0xed, 0x17, 0xa1, 0xb4, 0x7f, 0xa6, 0xc1,
+	0x64, 0xd3, 0x6f, 0x11, 0x5b, 0xdd, 0xc5, 0xa5, 0x31, 0xc6, 0x4e, 0xff, 0x18, 0x65, 0xc4, 0xcf,
+	0xe0, 0x72, 0xfa, 0xe7, 0x9b, 0xdb, 0xdd, 0xc5, 0xa5, 0x20, 0xc6, 0x5b, 0x7d, 0x43, 0x92, 0x31,
+	0x48, 0xcd, 0xc7, 0x3d, 0xc4, 0xa0, 0x11, 0x61, 0xdc, 0xed, 0x17, 0xd1, 0xd0, 0x13, 0x9b, 0x87,
+	0xe4, 0x3b, 0xbd, 0x54, 0x6f, 0x0a, 0x64, 0xdc, 0x1b, 0x00, 0xa4, 0xec, 0xf8, 0xbd, 0x06, 0xb3,
+	0x6d, 0xa6, 0xe0, 0xbb, 0xbd, 0x46, 0x37, 0x8d, 0x34, 0xbe, 0x33, 0x28, 0x52, 0x99, 0xf5, 0x07,
+	0x0d, 0xf2, 0x6d, 0x87, 0xd9, 0x9d, 0x9e, 0x83, 0xde, 0x84, 0x35, 0x76, 0x07, 0xc7, 0x2a, 0xe3,
+	0xfe, 0xaa, 0xc1, 0x62, 0xe7, 0xa1, 0xf1, 0x9d, 0x5e, 0x1d, 0xd0, 0x46, 0x80, 0xf1, 0xf8, 0x9c,
+	0x02, 0x94, 0xad, 0x7f, 0xd4, 0x60, 0xbe, 0xfd, 0x60, 0xd8, 0x43, 0xea, 0xb4, 0x05, 0x1b, 0xc5,
+	0x73, 0x80, 0x95, 0x7d, 0x7f, 0xd2, 0xc0, 0xe8, 0x30, 0x2d, 0xbe, 0xdd, 0x4b, 0x6e, 0xb7, 0x43,
+	0x1b, 0x0f, 0xcf, 0x83, 0x4e, 0x76, 0xaa, 0xf4, 0x08, 0x79, 0xbb, 0x17, 0xc1, 0x0d, 0x10, 0xe3,
+	0xad, 0xbe, 0x21, 0xc9, 0x4e, 0x95, 0x1a, 0x24, 0x37, 0x7b, 0x11, 0x96, 0x44, 0x18, 0x77, 0xfb,
+	0x45, 0xc4, 0xda, 0x8d, 0x91, 0x9f, 0x7f, 0xfd, 0xc5, 0x0d, 0x6d, 0xf7, 0xf1, 0x97, 0x2f, 0x96,
+	0xb4, 0xaf, 0x5e, 0x2c, 0x69, 0xff, 0x7e, 0xb1, 0xa4, 0xfd, 0xf6, 0xe5, 0xd2, 0x85, 0xaf, 0x5e,
+	0x2e, 0x5d, 0xf8, 0xe7, 0xcb, 0xa5, 0x0b, 0x1f, 0xdf, 0xf2, 0x30, 0x3b, 0xaa, 0x95, 0x0b, 0x0e,
+	0xa9, 0xf2, 0x9f, 0xf6, 0x6f, 0x89, 0x5f, 0xf4, 0x7d, 0xe2, 0xa2, 0x8d, 0x7a, 0xc3, 0x7f, 0x7c,
+	0x38, 0x0d, 0x10, 0x2d, 0x8f, 0xf2, 0x8b, 0xa5, 0x3b, 0xff, 0x1f, 0x00, 0x59, 0x51, 0x7f, 0xf5,
+	0x26, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2028,6 +2404,10 @@ type MsgClient interface {
 	UpdateRateLimiterFlags(ctx context.Context, in *MsgUpdateRateLimiterFlags, opts ...grpc.CallOption) (*MsgUpdateRateLimiterFlagsResponse, error)
 	MigrateERC20CustodyFunds(ctx context.Context, in *MsgMigrateERC20CustodyFunds, opts ...grpc.CallOption) (*MsgMigrateERC20CustodyFundsResponse, error)
 	UpdateERC20CustodyPauseStatus(ctx context.Context, in *MsgUpdateERC20CustodyPauseStatus, opts ...grpc.CallOption) (*MsgUpdateERC20CustodyPauseStatusResponse, error)
+	TripInboundCircuitBreaker(ctx context.Context, in *MsgTripInboundCircuitBreaker, opts ...grpc.CallOption) (*MsgTripInboundCircuitBreakerResponse, error)
+	ResetInboundCircuitBreaker(ctx context.Context, in *MsgResetInboundCircuitBreaker, opts ...grpc.CallOption) (*MsgResetInboundCircuitBreakerResponse, error)
+	ReleaseHeldCctx(ctx context.Context, in *MsgReleaseHeldCctx, opts ...grpc.CallOption) (*MsgReleaseHeldCctxResponse, error)
+	RejectHeldCctx(ctx context.Context, in *MsgRejectHeldCctx, opts ...grpc.CallOption) (*MsgRejectHeldCctxResponse, error)
 }
 
 type msgClient struct {
@@ -2173,6 +2553,42 @@ func (c *msgClient) UpdateERC20CustodyPauseStatus(ctx context.Context, in *MsgUp
 	return out, nil
 }
 
+func (c *msgClient) TripInboundCircuitBreaker(ctx context.Context, in *MsgTripInboundCircuitBreaker, opts ...grpc.CallOption) (*MsgTripInboundCircuitBreakerResponse, error) {
+	out := new(MsgTripInboundCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/TripInboundCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetInboundCircuitBreaker(ctx context.Context, in *MsgResetInboundCircuitBreaker, opts ...grpc.CallOption) (*MsgResetInboundCircuitBreakerResponse, error) {
+	out := new(MsgResetInboundCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/ResetInboundCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseHeldCctx(ctx context.Context, in *MsgReleaseHeldCctx, opts ...grpc.CallOption) (*MsgReleaseHeldCctxResponse, error) {
+	out := new(MsgReleaseHeldCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/ReleaseHeldCctx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectHeldCctx(ctx context.Context, in *MsgRejectHeldCctx, opts ...grpc.CallOption) (*MsgRejectHeldCctxResponse, error) {
+	out := new(MsgRejectHeldCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/RejectHeldCctx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddOutboundTracker(context.Context, *MsgAddOutboundTracker) (*MsgAddOutboundTrackerResponse, error)
@@ -2190,6 +2606,10 @@ type MsgServer interface {
 	UpdateRateLimiterFlags(context.Context, *MsgUpdateRateLimiterFlags) (*MsgUpdateRateLimiterFlagsResponse, error)
 	MigrateERC20CustodyFunds(context.Context, *MsgMigrateERC20CustodyFunds) (*MsgMigrateERC20CustodyFundsResponse, error)
 	UpdateERC20CustodyPauseStatus(context.Context, *MsgUpdateERC20CustodyPauseStatus) (*MsgUpdateERC20CustodyPauseStatusResponse, error)
+	TripInboundCircuitBreaker(context.Context, *MsgTripInboundCircuitBreaker) (*MsgTripInboundCircuitBreakerResponse, error)
+	ResetInboundCircuitBreaker(context.Context, *MsgResetInboundCircuitBreaker) (*MsgResetInboundCircuitBreakerResponse, error)
+	ReleaseHeldCctx(context.Context, *MsgReleaseHeldCctx) (*MsgReleaseHeldCctxResponse, error)
+	RejectHeldCctx(context.Context, *MsgRejectHeldCctx) (*MsgRejectHeldCctxResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2241,6 +2661,18 @@ func (*UnimplementedMsgServer) MigrateERC20CustodyFunds(ctx context.Context, req
 func (*UnimplementedMsgServer) UpdateERC20CustodyPauseStatus(ctx context.Context, req *MsgUpdateERC20CustodyPauseStatus) (*MsgUpdateERC20CustodyPauseStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateERC20CustodyPauseStatus not implemented")
 }
+func (*UnimplementedMsgServer) TripInboundCircuitBreaker(ctx context.Context, req *MsgTripInboundCircuitBreaker) (*MsgTripInboundCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripInboundCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) ResetInboundCircuitBreaker(ctx context.Context, req *MsgResetInboundCircuitBreaker) (*MsgResetInboundCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetInboundCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseHeldCctx(ctx context.Context, req *MsgReleaseHeldCctx) (*MsgReleaseHeldCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHeldCctx not implemented")
+}
+func (*UnimplementedMsgServer) RejectHeldCctx(ctx context.Context, req *MsgRejectHeldCctx) (*MsgRejectHeldCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectHeldCctx not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2516,6 +2948,78 @@ func _Msg_UpdateERC20CustodyPauseStatus_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TripInboundCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTripInboundCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TripInboundCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/TripInboundCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TripInboundCircuitBreaker(ctx, req.(*MsgTripInboundCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetInboundCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetInboundCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetInboundCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/ResetInboundCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetInboundCircuitBreaker(ctx, req.(*MsgResetInboundCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseHeldCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseHeldCctx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseHeldCctx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/ReleaseHeldCctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseHeldCctx(ctx, req.(*MsgReleaseHeldCctx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectHeldCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectHeldCctx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectHeldCctx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/RejectHeldCctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectHeldCctx(ctx, req.(*MsgRejectHeldCctx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.crosschain.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2580,6 +3084,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateERC20CustodyPauseStatus",
 			Handler:    _Msg_UpdateERC20CustodyPauseStatus_Handler,
 		},
+		{
+			MethodName: "TripInboundCircuitBreaker",
+			Handler:    _Msg_TripInboundCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ResetInboundCircuitBreaker",
+			Handler:    _Msg_ResetInboundCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ReleaseHeldCctx",
+			Handler:    _Msg_ReleaseHeldCctx_Handler,
+		},
+		{
+			MethodName: "RejectHeldCctx",
+			Handler:    _Msg_RejectHeldCctx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/crosschain/tx.proto",
@@ -3907,151 +4427,387 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTripInboundCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgMigrateTssFunds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTripInboundCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTripInboundCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateTssFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTripInboundCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateTssAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TssPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgTripInboundCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTripInboundCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddInboundTracker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CoinType != 0 {
-		n += 1 + sovTx(uint64(m.CoinType))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTx(uint64(m.TxIndex))
+func (m *MsgResetInboundCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddInboundTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgResetInboundCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveInboundTracker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgResetInboundCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveInboundTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgResetInboundCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetInboundCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetInboundCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWhitelistERC20) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReleaseHeldCctx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseHeldCctx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseHeldCctx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseHeldCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseHeldCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseHeldCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectHeldCctx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectHeldCctx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectHeldCctx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectHeldCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectHeldCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectHeldCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMigrateTssFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateTssFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTssAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddInboundTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovTx(uint64(m.CoinType))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *MsgAddInboundTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveInboundTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveInboundTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWhitelistERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Erc20Address)
 	if l > 0 {
@@ -4501,14 +5257,116 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgTripInboundCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	return n
 }
-func (m *MsgMigrateTssFunds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *MsgTripInboundCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetInboundCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *MsgResetInboundCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseHeldCctx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseHeldCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectHeldCctx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectHeldCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMigrateTssFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -7723,7 +8581,788 @@ func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundAbortedCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRateLimiterFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRateLimiterFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimiterFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimiterFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRateLimiterFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRateLimiterFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateERC20CustodyFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateERC20CustodyFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateERC20CustodyFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCustodyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCustodyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateERC20CustodyFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateERC20CustodyFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateERC20CustodyFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateERC20CustodyPauseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateERC20CustodyPauseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateERC20CustodyPauseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pause = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateERC20CustodyPauseStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateERC20CustodyPauseStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateERC20CustodyPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTripInboundCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTripInboundCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTripInboundCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7751,8 +9390,27 @@ func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7774,7 +9432,7 @@ func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundAbortedCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTripInboundCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7797,10 +9455,10 @@ func (m *MsgRefundAbortedCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTripInboundCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTripInboundCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7824,7 +9482,7 @@ func (m *MsgRefundAbortedCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimiterFlags) Unmarshal(dAtA []byte) error {
+func (m *MsgResetInboundCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7847,10 +9505,10 @@ func (m *MsgUpdateRateLimiterFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimiterFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetInboundCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetInboundCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7886,10 +9544,10 @@ func (m *MsgUpdateRateLimiterFlags) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimiterFlags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7899,25 +9557,11 @@ func (m *MsgUpdateRateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RateLimiterFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7939,7 +9583,7 @@ func (m *MsgUpdateRateLimiterFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetInboundCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7962,10 +9606,10 @@ func (m *MsgUpdateRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimiterFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetInboundCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimiterFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetInboundCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7989,7 +9633,7 @@ func (m *MsgUpdateRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateERC20CustodyFunds) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseHeldCctx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8012,10 +9656,10 @@ func (m *MsgMigrateERC20CustodyFunds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateERC20CustodyFunds: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseHeldCctx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateERC20CustodyFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseHeldCctx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8051,91 +9695,8 @@ func (m *MsgMigrateERC20CustodyFunds) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCustodyAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewCustodyAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8163,9 +9724,7 @@ func (m *MsgMigrateERC20CustodyFunds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8188,7 +9747,7 @@ func (m *MsgMigrateERC20CustodyFunds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateERC20CustodyFundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseHeldCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8211,44 +9770,12 @@ func (m *MsgMigrateERC20CustodyFundsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateERC20CustodyFundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseHeldCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateERC20CustodyFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseHeldCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8270,7 +9797,7 @@ func (m *MsgMigrateERC20CustodyFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateERC20CustodyPauseStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectHeldCctx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8293,10 +9820,10 @@ func (m *MsgUpdateERC20CustodyPauseStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateERC20CustodyPauseStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectHeldCctx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateERC20CustodyPauseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectHeldCctx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8332,10 +9859,10 @@ func (m *MsgUpdateERC20CustodyPauseStatus) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8345,31 +9872,24 @@ func (m *MsgUpdateERC20CustodyPauseStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Pause = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8391,7 +9911,7 @@ func (m *MsgUpdateERC20CustodyPauseStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateERC20CustodyPauseStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectHeldCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8414,44 +9934,12 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateERC20CustodyPauseStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectHeldCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateERC20CustodyPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectHeldCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
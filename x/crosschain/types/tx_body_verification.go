@@ -8,9 +8,14 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	eth "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	tontools "github.com/tonkeeper/tongo/ton"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
+	proofston "github.com/zeta-chain/node/pkg/proofs/ton"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -27,6 +32,10 @@ func VerifyInboundBody(
 	// https://github.com/zeta-chain/node/issues/2385
 	if chains.IsEVMChain(msg.ChainId, []chains.Chain{}) {
 		return verifyInboundBodyEVM(msg, txBytes, chainParams, tss)
+	} else if chains.IsTONChain(msg.ChainId, []chains.Chain{}) {
+		return verifyInboundBodyTON(msg, txBytes, chainParams)
+	} else if chains.IsSolanaChain(msg.ChainId, []chains.Chain{}) {
+		return verifyInboundBodySolana(msg, txBytes, chainParams)
 	}
 
 	// TODO: implement verifyInboundBodyBTC
@@ -79,6 +88,72 @@ func verifyInboundBodyEVM(
 	return nil
 }
 
+// verifyInboundBodyTON validates the tx hash and that the transaction is a transaction of the gateway account.
+func verifyInboundBodyTON(msg MsgAddInboundTracker, txBytes []byte, chainParams observertypes.ChainParams) error {
+	if msg.CoinType != coin.CoinType_Gas {
+		return fmt.Errorf("coin type not supported %s", msg.CoinType)
+	}
+	gateway, err := tontools.ParseAccountID(chainParams.GatewayAddress)
+	if err != nil {
+		return fmt.Errorf("invalid gateway address %s", chainParams.GatewayAddress)
+	}
+
+	// the proof of a transaction of the masterchain block only can't be the proof of a transaction of a basechain gateway
+	isMasterchainTx := msg.Proof.GetTonProof() != nil && msg.Proof.GetTonProof().IsMasterchainTx()
+	if isMasterchainTx != (gateway.Workchain == proofston.MasterchainID) {
+		return fmt.Errorf("transaction is not in the workchain of the gateway %d", gateway.Workchain)
+	}
+
+	cells, err := boc.DeserializeBoc(txBytes)
+	if err != nil || len(cells) != 1 {
+		return fmt.Errorf("failed to deserialize transaction")
+	}
+	var tx tlb.Transaction
+	if err := tlb.Unmarshal(cells[0], &tx); err != nil {
+		return fmt.Errorf("failed to unmarshal transaction %s", err.Error())
+	}
+
+	// tx hash is encoded as "lt:hash"
+	txHash := fmt.Sprintf("%d:%s", tx.Lt, tx.Hash().Hex())
+	if txHash != msg.TxHash {
+		return fmt.Errorf("invalid hash, want tx hash %s, got %s", txHash, msg.TxHash)
+	}
+	if tontools.Bits256(tx.AccountAddr) != gateway.Address {
+		return fmt.Errorf("transaction account is not the gateway %s", gateway.ToRaw())
+	}
+	return nil
+}
+
+// verifyInboundBodySolana validates the tx signature and that the gateway program is invoked by the transaction.
+func verifyInboundBodySolana(msg MsgAddInboundTracker, txBytes []byte, chainParams observertypes.ChainParams) error {
+	if msg.CoinType != coin.CoinType_Gas && msg.CoinType != coin.CoinType_ERC20 {
+		return fmt.Errorf("coin type not supported %s", msg.CoinType)
+	}
+	gateway, err := solana.PublicKeyFromBase58(chainParams.GatewayAddress)
+	if err != nil {
+		return fmt.Errorf("invalid gateway address %s", chainParams.GatewayAddress)
+	}
+
+	tx, err := solana.TransactionFromBytes(txBytes)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal transaction %s", err.Error())
+	}
+	if len(tx.Signatures) == 0 {
+		return fmt.Errorf("transaction not signed")
+	}
+	if tx.Signatures[0].String() != msg.TxHash {
+		return fmt.Errorf("invalid hash, want tx hash %s, got %s", tx.Signatures[0], msg.TxHash)
+	}
+
+	for _, instruction := range tx.Message.Instructions {
+		programID, err := tx.Message.Program(instruction.ProgramIDIndex)
+		if err == nil && programID.Equals(gateway) {
+			return nil
+		}
+	}
+	return fmt.Errorf("transaction doesn't invoke the gateway program %s", gateway)
+}
+
 // VerifyOutboundBody verifies the tx body for an outbound
 func VerifyOutboundBody(msg MsgAddOutboundTracker, txBytes []byte, tss observertypes.QueryGetTssAddressResponse) error {
 	// verify message against transaction body
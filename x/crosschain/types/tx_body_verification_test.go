@@ -1,13 +1,22 @@
 package types_test
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 
+	"github.com/gagliardetto/solana-go"
 	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	tontools "github.com/tonkeeper/tongo/ton"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/proofs"
+	"github.com/zeta-chain/node/pkg/proofs/ton"
 	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/testutil/testdata"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
@@ -155,6 +164,213 @@ func TestVerifyInboundBody(t *testing.T) {
 	}
 }
 
+func TestVerifyInboundBodyTON(t *testing.T) {
+	tx, txBytes := sampleTONTx(t)
+	txHash := fmt.Sprintf("%d:%s", tx.Lt, tx.Hash().Hex())
+	gateway := tontools.AccountID{Workchain: ton.MasterchainID, Address: tontools.Bits256(tx.AccountAddr)}
+	masterchainProof := proofs.NewTONProof(ton.NewProof(nil, nil, txBytes))
+	shardProof := proofs.NewTONProof(ton.NewProof(nil, []byte{1}, txBytes))
+
+	// NOTE: errContains == "" means no error
+	for _, tc := range []struct {
+		desc        string
+		msg         types.MsgAddInboundTracker
+		txBytes     []byte
+		chainParams observertypes.ChainParams
+		errContains string
+	}{
+		{
+			desc: "valid gateway transaction",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.TONMainnet.ChainId,
+				TxHash:   txHash,
+				CoinType: coin.CoinType_Gas,
+				Proof:    masterchainProof,
+			},
+			txBytes:     txBytes,
+			chainParams: observertypes.ChainParams{GatewayAddress: gateway.ToRaw()},
+		},
+		{
+			desc: "coin type not supported",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.TONMainnet.ChainId,
+				TxHash:   txHash,
+				CoinType: coin.CoinType_ERC20,
+				Proof:    masterchainProof,
+			},
+			txBytes:     txBytes,
+			chainParams: observertypes.ChainParams{GatewayAddress: gateway.ToRaw()},
+			errContains: "coin type not supported",
+		},
+		{
+			desc: "transaction not in the workchain of the gateway",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.TONMainnet.ChainId,
+				TxHash:   txHash,
+				CoinType: coin.CoinType_Gas,
+				Proof:    shardProof,
+			},
+			txBytes:     txBytes,
+			chainParams: observertypes.ChainParams{GatewayAddress: gateway.ToRaw()},
+			errContains: "not in the workchain of the gateway",
+		},
+		{
+			desc: "txHash doesn't correspond",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.TONMainnet.ChainId,
+				TxHash:   fmt.Sprintf("%d:%s", tx.Lt+1, tx.Hash().Hex()),
+				CoinType: coin.CoinType_Gas,
+				Proof:    masterchainProof,
+			},
+			txBytes:     txBytes,
+			chainParams: observertypes.ChainParams{GatewayAddress: gateway.ToRaw()},
+			errContains: "invalid hash",
+		},
+		{
+			desc: "transaction of another account",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.TONMainnet.ChainId,
+				TxHash:   txHash,
+				CoinType: coin.CoinType_Gas,
+				Proof:    masterchainProof,
+			},
+			txBytes: txBytes,
+			chainParams: observertypes.ChainParams{
+				GatewayAddress: tontools.AccountID{Workchain: ton.MasterchainID}.ToRaw(),
+			},
+			errContains: "not the gateway",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.VerifyInboundBody(tc.msg, tc.txBytes, tc.chainParams, observertypes.QueryGetTssAddressResponse{})
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestVerifyInboundBodySolana(t *testing.T) {
+	gateway := sample.SolanaAddress(t)
+	tx, txBytes := sampleSolanaTx(t, solana.MustPublicKeyFromBase58(gateway))
+
+	// NOTE: errContains == "" means no error
+	for _, tc := range []struct {
+		desc        string
+		msg         types.MsgAddInboundTracker
+		txBytes     []byte
+		chainParams observertypes.ChainParams
+		errContains string
+	}{
+		{
+			desc: "valid gateway transaction",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.SolanaMainnet.ChainId,
+				TxHash:   tx.Signatures[0].String(),
+				CoinType: coin.CoinType_Gas,
+			},
+			txBytes:     txBytes,
+			chainParams: observertypes.ChainParams{GatewayAddress: gateway},
+		},
+		{
+			desc: "txBytes can't be unmarshaled",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.SolanaMainnet.ChainId,
+				TxHash:   tx.Signatures[0].String(),
+				CoinType: coin.CoinType_Gas,
+			},
+			txBytes:     []byte("invalid"),
+			chainParams: observertypes.ChainParams{GatewayAddress: gateway},
+			errContains: "failed to unmarshal transaction",
+		},
+		{
+			desc: "txHash doesn't correspond",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.SolanaMainnet.ChainId,
+				TxHash:   solana.Signature{}.String(),
+				CoinType: coin.CoinType_Gas,
+			},
+			txBytes:     txBytes,
+			chainParams: observertypes.ChainParams{GatewayAddress: gateway},
+			errContains: "invalid hash",
+		},
+		{
+			desc: "gateway program not invoked",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.SolanaMainnet.ChainId,
+				TxHash:   tx.Signatures[0].String(),
+				CoinType: coin.CoinType_ERC20,
+			},
+			txBytes:     txBytes,
+			chainParams: observertypes.ChainParams{GatewayAddress: sample.SolanaAddress(t)},
+			errContains: "doesn't invoke the gateway program",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.VerifyInboundBody(tc.msg, tc.txBytes, tc.chainParams, observertypes.QueryGetTssAddressResponse{})
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// sampleTONTx returns the first transaction of the TON test block and its BoC
+func sampleTONTx(t *testing.T) (tlb.Transaction, []byte) {
+	cells, err := boc.DeserializeBoc(testdata.ReadTONMasterchainBlock(t))
+	require.NoError(t, err)
+	var block tlb.Block
+	require.NoError(t, tlb.Unmarshal(cells[0], &block))
+	cells[0].ResetCounters()
+	tx := block.AllTransactions()[0]
+	txHash := tx.Hash()
+
+	var find func(c *boc.Cell) *boc.Cell
+	find = func(c *boc.Cell) *boc.Cell {
+		h, err := c.Hash()
+		require.NoError(t, err)
+		if bytes.Equal(h, txHash[:]) {
+			return c
+		}
+		for _, ref := range c.Refs() {
+			if found := find(ref); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	txCell := find(cells[0])
+	require.NotNil(t, txCell)
+	txBytes, err := txCell.ToBoc()
+	require.NoError(t, err)
+	return *tx, txBytes
+}
+
+// sampleSolanaTx returns a signed transaction invoking the given program
+func sampleSolanaTx(t *testing.T, programID solana.PublicKey) (*solana.Transaction, []byte) {
+	privKey, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{solana.NewInstruction(programID, solana.AccountMetaSlice{
+			solana.Meta(privKey.PublicKey()).WRITE().SIGNER(),
+		}, []byte{1})},
+		solana.Hash{},
+		solana.TransactionPayer(privKey.PublicKey()),
+	)
+	require.NoError(t, err)
+	_, err = tx.Sign(func(solana.PublicKey) *solana.PrivateKey { return &privKey })
+	require.NoError(t, err)
+
+	txBytes, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return tx, txBytes
+}
+
 func TestVerifyOutboundBody(t *testing.T) {
 
 	sampleTo := sample.EthAddress()
@@ -108,7 +108,6 @@ func DistributeObserverRewards(
 	params types.Params,
 ) error {
 	var (
-		slashAmount    = params.ObserverSlashAmount
 		maturityBlocks = params.BallotMaturityBlocks
 		maturedBallots []string
 	)
@@ -130,14 +129,14 @@ func DistributeObserverRewards(
 	}
 
 	// We have some matured ballots, we now need to process them
-	// Processing Step 1: Distribute the rewards
+	// Processing Step 1: Distribute the rewards and track the missed votes of the observers
 	// Final distribution list is the list of ObserverEmissions, which will be emitted as events
 	finalDistributionList := distributeRewardsForMaturedBallots(
 		ctx,
 		emissionsKeeper,
 		maturedBallots,
 		amount,
-		slashAmount,
+		params,
 	)
 
 	// Processing Step 2: Emit the observer emissions
@@ -160,21 +159,32 @@ func distributeRewardsForMaturedBallots(
 	keeper keeper.Keeper,
 	maturedBallots []string,
 	amount sdkmath.Int,
-	slashAmount sdkmath.Int,
+	params types.Params,
 ) []*types.ObserverEmission {
 	var (
+		slashAmount          = params.ObserverSlashAmount
 		rewardsDistributeMap = map[string]int64{}
 		totalRewardsUnits    = int64(0)
 	)
 	ballots := make([]observertypes.Ballot, 0, len(maturedBallots))
 	for _, ballotIdentifier := range maturedBallots {
 		ballot, found := keeper.GetObserverKeeper().GetBallot(ctx, ballotIdentifier)
-		if !found {
+		// the emergency halt ballots are not rewarded, the observers are not expected to vote on them
+		if !found || ballot.ObservationType == observertypes.ObservationType_ChainHalt {
 			continue
 		}
 		ballots = append(ballots, ballot)
 		totalRewardsUnits += ballot.BuildRewardsDistribution(rewardsDistributeMap)
 	}
+
+	// the observers missing too many votes are jailed, their missed votes on these ballots are still slashed
+	keeper.GetObserverKeeper().UpdateObserverLiveness(
+		ctx,
+		ballots,
+		params.ObserverLivenessWindow,
+		params.ObserverMaxMissedRatio,
+		params.ObserverJailDuration,
+	)
 	rewardPerUnit := sdkmath.ZeroInt()
 	if totalRewardsUnits > 0 && amount.IsPositive() {
 		rewardPerUnit = amount.Quo(sdkmath.NewInt(totalRewardsUnits))
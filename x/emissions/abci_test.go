@@ -598,6 +598,114 @@ func TestDistributeObserverRewards(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("observers missing too many votes are jailed", func(t *testing.T) {
+		k, ctx, sk, zk := keepertest.EmissionsKeeper(t)
+		zk.ObserverKeeper.SetObserverSet(ctx, observerSet)
+
+		totalRewardCoins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, emissionstypes.BlockReward.TruncateInt()))
+		require.NoError(t, sk.BankKeeper.MintCoins(ctx, emissionstypes.ModuleName, totalRewardCoins))
+
+		params := emissionstypes.DefaultParams()
+		params.ObserverLivenessWindow = 2
+		params.ObserverJailDuration = 10
+		setEmissionsParams(t, ctx, *k, params)
+
+		// the first observer misses both ballots, the second one misses one ballot
+		ballotIdentifiers := []string{}
+		for i := 0; i < 2; i++ {
+			votes := []observertypes.VoteType{
+				observertypes.VoteType_NotYetVoted,
+				observertypes.VoteType_SuccessObservation,
+				observertypes.VoteType_SuccessObservation,
+				observertypes.VoteType_SuccessObservation,
+			}
+			if i == 0 {
+				votes[1] = observertypes.VoteType_NotYetVoted
+			}
+			ballot := observertypes.Ballot{
+				BallotIdentifier: "ballot" + string(rune(i)),
+				BallotStatus:     observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+				VoterList:        observerSet.ObserverList,
+				Votes:            votes,
+			}
+			zk.ObserverKeeper.SetBallot(ctx, &ballot)
+			ballotIdentifiers = append(ballotIdentifiers, ballot.BallotIdentifier)
+		}
+		zk.ObserverKeeper.SetBallotList(ctx, &observertypes.BallotListForHeight{
+			Height:           0,
+			BallotsIndexList: ballotIdentifiers,
+		})
+		ctx = ctx.WithBlockHeight(100)
+
+		err := emissions.DistributeObserverRewards(ctx, sdkmath.NewInt(100), *k, params)
+		require.NoError(t, err)
+
+		liveness, found := zk.ObserverKeeper.GetObserverLiveness(ctx, observerSet.ObserverList[0])
+		require.True(t, found)
+		require.True(t, liveness.Jailed)
+		require.EqualValues(t, 110, liveness.JailedUntil)
+		require.False(t, zk.ObserverKeeper.IsAddressPartOfObserverSet(ctx, observerSet.ObserverList[0]))
+
+		liveness, found = zk.ObserverKeeper.GetObserverLiveness(ctx, observerSet.ObserverList[1])
+		require.True(t, found)
+		require.False(t, liveness.Jailed)
+		require.EqualValues(t, 1, liveness.MissedVotesCounter)
+		require.True(t, zk.ObserverKeeper.IsAddressPartOfObserverSet(ctx, observerSet.ObserverList[1]))
+	})
+
+	t.Run("observers are not rewarded nor jailed for the chain halt ballots", func(t *testing.T) {
+		k, ctx, sk, zk := keepertest.EmissionsKeeper(t)
+		zk.ObserverKeeper.SetObserverSet(ctx, observerSet)
+
+		totalRewardCoins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, emissionstypes.BlockReward.TruncateInt()))
+		require.NoError(t, sk.BankKeeper.MintCoins(ctx, emissionstypes.ModuleName, totalRewardCoins))
+
+		for _, observer := range observerSet.ObserverList {
+			k.SetWithdrawableEmission(ctx, emissionstypes.WithdrawableEmissions{
+				Address: observer,
+				Amount:  sdkmath.NewInt(100),
+			})
+		}
+
+		params := emissionstypes.DefaultParams()
+		params.ObserverLivenessWindow = 1
+		params.ObserverJailDuration = 10
+		setEmissionsParams(t, ctx, *k, params)
+
+		// only the first observer voted for the halt
+		ballot := observertypes.Ballot{
+			BallotIdentifier: observertypes.HaltChainBallotIdentifier(1),
+			ObservationType:  observertypes.ObservationType_ChainHalt,
+			BallotStatus:     observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+			VoterList:        observerSet.ObserverList,
+			Votes: []observertypes.VoteType{
+				observertypes.VoteType_SuccessObservation,
+				observertypes.VoteType_NotYetVoted,
+				observertypes.VoteType_NotYetVoted,
+				observertypes.VoteType_NotYetVoted,
+			},
+		}
+		zk.ObserverKeeper.SetBallot(ctx, &ballot)
+		zk.ObserverKeeper.SetBallotList(ctx, &observertypes.BallotListForHeight{
+			Height:           0,
+			BallotsIndexList: []string{ballot.BallotIdentifier},
+		})
+		ctx = ctx.WithBlockHeight(100)
+
+		err := emissions.DistributeObserverRewards(ctx, sdkmath.NewInt(100), *k, params)
+		require.NoError(t, err)
+
+		for _, observer := range observerSet.ObserverList {
+			observerEmission, found := k.GetWithdrawableEmission(ctx, observer)
+			require.True(t, found)
+			require.EqualValues(t, 100, observerEmission.Amount.Int64())
+
+			_, found = zk.ObserverKeeper.GetObserverLiveness(ctx, observer)
+			require.False(t, found)
+			require.True(t, zk.ObserverKeeper.IsAddressPartOfObserverSet(ctx, observer))
+		}
+	})
 }
 
 // setEmissionsParams sets the emissions params in the store without validation
@@ -25,6 +25,9 @@ func TestKeeper_GetParams(t *testing.T) {
 				ObserverSlashAmount:         sdkmath.NewInt(100000000000000000),
 				BallotMaturityBlocks:        int64(emissionstypes.BallotMaturityBlocks),
 				BlockRewardAmount:           emissionstypes.BlockReward,
+				ObserverLivenessWindow:      1000,
+				ObserverMaxMissedRatio:      emissionstypes.ObserverMaxMissedRatio,
+				ObserverJailDuration:        100,
 			},
 			constainsErr: "",
 		},
@@ -45,6 +45,7 @@ func TestMigrate(t *testing.T) {
 		legacyParams.ObserverSlashAmount = sdkmath.NewInt(100000000000000000)
 		legacyParams.BallotMaturityBlocks = 100
 		legacyParams.BlockRewardAmount = types.BlockReward
+		legacyParams.ObserverMaxMissedRatio = sdkmath.LegacyZeroDec()
 		require.Equal(t, legacyParams, params)
 	})
 
@@ -61,6 +62,7 @@ func TestMigrate(t *testing.T) {
 		legacyParams = types.DefaultParams()
 		legacyParams.ObserverSlashAmount = sdkmath.NewInt(100000000000000000)
 		legacyParams.BallotMaturityBlocks = 100
+		legacyParams.ObserverMaxMissedRatio = sdkmath.LegacyZeroDec()
 		require.Equal(t, legacyParams, params)
 	})
 
@@ -25,6 +25,13 @@ type ObserverKeeper interface {
 	GetSupportedChains(ctx sdk.Context) []chains.Chain
 	GetNodeAccount(ctx sdk.Context, address string) (observertypes.NodeAccount, bool)
 	GetAllNodeAccount(ctx sdk.Context) []observertypes.NodeAccount
+	UpdateObserverLiveness(
+		ctx sdk.Context,
+		ballots []observertypes.Ballot,
+		window int64,
+		maxMissedRatio sdkmath.LegacyDec,
+		jailDuration int64,
+	)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
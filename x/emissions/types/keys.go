@@ -52,4 +52,8 @@ var (
 	// BallotMaturityBlocks is amount of blocks needed for ballot to mature
 	// by default is set to 100
 	BallotMaturityBlocks = 100
+
+	// ObserverMaxMissedRatio is the ratio of missed ballots in the liveness window above which an observer is jailed
+	// by default it is set to 0.5, the liveness tracking is disabled by default with a window of 0
+	ObserverMaxMissedRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
 )
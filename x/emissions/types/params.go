@@ -16,6 +16,9 @@ func NewParams() Params {
 		ObserverSlashAmount:         ObserverSlashAmount,
 		BallotMaturityBlocks:        int64(BallotMaturityBlocks),
 		BlockRewardAmount:           BlockReward,
+		ObserverLivenessWindow:      0,
+		ObserverMaxMissedRatio:      ObserverMaxMissedRatio,
+		ObserverJailDuration:        0,
 	}
 }
 
@@ -41,6 +44,15 @@ func (p Params) Validate() error {
 	if err := validateBlockRewardsAmount(p.BlockRewardAmount); err != nil {
 		return err
 	}
+	if err := validateObserverLivenessWindow(p.ObserverLivenessWindow); err != nil {
+		return err
+	}
+	if err := validateObserverMaxMissedRatio(p.ObserverMaxMissedRatio); err != nil {
+		return err
+	}
+	if err := validateObserverJailDuration(p.ObserverJailDuration); err != nil {
+		return err
+	}
 	return validateObserverSlashAmount(p.ObserverSlashAmount)
 }
 
@@ -138,3 +150,44 @@ func validateBlockRewardsAmount(i interface{}) error {
 	}
 	return nil
 }
+
+func validateObserverLivenessWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("observer liveness window must not be negative")
+	}
+	return nil
+}
+
+// validateObserverMaxMissedRatio validates the max missed ratio, the ratio can be nil for params set before
+// the liveness tracking was introduced, the observers are then never jailed
+func validateObserverMaxMissedRatio(i interface{}) error {
+	v, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("observer max missed ratio must not be negative")
+	}
+	if v.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("observer max missed ratio cannot be more than 1")
+	}
+	return nil
+}
+
+func validateObserverJailDuration(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("observer jail duration must not be negative")
+	}
+	return nil
+}
@@ -33,6 +33,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 //	ObserverSlashAmount:         100000000000000000,
 //	BallotMaturityBlocks:        100,
 //	BlockRewardAmount:           9620949074074074074.074070733466756687,
+//	ObserverLivenessWindow:      0,
+//	ObserverMaxMissedRatio:      "0.5",
+//	ObserverJailDuration:        0,
 type Params struct {
 	ValidatorEmissionPercentage string                      `protobuf:"bytes,5,opt,name=validator_emission_percentage,json=validatorEmissionPercentage,proto3" json:"validator_emission_percentage,omitempty"`
 	ObserverEmissionPercentage  string                      `protobuf:"bytes,6,opt,name=observer_emission_percentage,json=observerEmissionPercentage,proto3" json:"observer_emission_percentage,omitempty"`
@@ -40,6 +43,15 @@ type Params struct {
 	ObserverSlashAmount         cosmossdk_io_math.Int       `protobuf:"bytes,9,opt,name=observer_slash_amount,json=observerSlashAmount,proto3,customtype=cosmossdk.io/math.Int" json:"observer_slash_amount"`
 	BallotMaturityBlocks        int64                       `protobuf:"varint,10,opt,name=ballot_maturity_blocks,json=ballotMaturityBlocks,proto3" json:"ballot_maturity_blocks,omitempty"`
 	BlockRewardAmount           cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=block_reward_amount,json=blockRewardAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"block_reward_amount"`
+	// number of matured ballots over which the missed votes of the observers
+	// are tracked, 0 disables the tracking
+	ObserverLivenessWindow int64 `protobuf:"varint,12,opt,name=observer_liveness_window,json=observerLivenessWindow,proto3" json:"observer_liveness_window,omitempty"`
+	// an observer missing more than this ratio of the ballots in the window is
+	// jailed and removed from the observer set
+	ObserverMaxMissedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=observer_max_missed_ratio,json=observerMaxMissedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"observer_max_missed_ratio"`
+	// number of blocks a jailed observer has to wait before rejoining the
+	// observer set
+	ObserverJailDuration int64 `protobuf:"varint,14,opt,name=observer_jail_duration,json=observerJailDuration,proto3" json:"observer_jail_duration,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -102,6 +114,20 @@ func (m *Params) GetBallotMaturityBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetObserverLivenessWindow() int64 {
+	if m != nil {
+		return m.ObserverLivenessWindow
+	}
+	return 0
+}
+
+func (m *Params) GetObserverJailDuration() int64 {
+	if m != nil {
+		return m.ObserverJailDuration
+	}
+	return 0
+}
+
 // Deprecated (v20): Do not use. Use Params Instead
 type LegacyParams struct {
 	MaxBondFactor               string                `protobuf:"bytes,1,opt,name=max_bond_factor,json=maxBondFactor,proto3" json:"max_bond_factor,omitempty"`
@@ -221,41 +247,45 @@ func init() {
 }
 
 var fileDescriptor_259272924aec0acf = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
+	// 607 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
-	0x14, 0xc5, 0xe3, 0x7f, 0xd2, 0xfc, 0x93, 0xa1, 0xd0, 0xd6, 0xfd, 0x90, 0xd5, 0x0f, 0xa7, 0x2a,
-	0x08, 0x15, 0x04, 0xf6, 0x02, 0x16, 0x88, 0x15, 0xa4, 0x50, 0x89, 0x0a, 0xa4, 0xe2, 0xb0, 0x62,
-	0x63, 0x5d, 0xdb, 0x83, 0x33, 0x6a, 0x66, 0x26, 0x9a, 0x99, 0x84, 0x84, 0xa7, 0x60, 0xc9, 0x92,
-	0x77, 0x81, 0x45, 0x97, 0x5d, 0x22, 0x16, 0x15, 0x4a, 0x5e, 0x04, 0xe5, 0x8e, 0x1d, 0x15, 0x11,
-	0xf6, 0x88, 0x9d, 0x35, 0xe7, 0x77, 0x8e, 0xce, 0x8c, 0xaf, 0x2e, 0xb9, 0xf3, 0x81, 0x1a, 0x48,
-	0xbb, 0xc0, 0x44, 0x88, 0x5f, 0x52, 0xd1, 0x90, 0x72, 0xa6, 0x35, 0x93, 0x42, 0x87, 0x7d, 0x50,
-	0xc0, 0x75, 0xd0, 0x57, 0xd2, 0x48, 0x77, 0x77, 0x8e, 0x06, 0x25, 0x1a, 0xcc, 0xd1, 0xed, 0x8d,
-	0x5c, 0xe6, 0x12, 0xc1, 0x70, 0xf6, 0x65, 0x3d, 0x07, 0x5f, 0xaa, 0xa4, 0x7e, 0x8a, 0x21, 0x6e,
-	0x9b, 0xec, 0x0d, 0xa1, 0xc7, 0x32, 0x30, 0x52, 0xc5, 0xa5, 0x2f, 0xee, 0x53, 0x95, 0x52, 0x61,
-	0x20, 0xa7, 0xde, 0xd2, 0xbe, 0x73, 0xd8, 0x8c, 0x76, 0xe6, 0xd0, 0xf3, 0x82, 0x39, 0x9d, 0x23,
-	0xee, 0x13, 0xb2, 0x2b, 0x13, 0x4d, 0xd5, 0x90, 0x2e, 0x8e, 0xa8, 0x63, 0xc4, 0x76, 0xc9, 0x2c,
-	0x48, 0x38, 0x22, 0xbe, 0xd1, 0x3a, 0xd6, 0x2c, 0x17, 0x7f, 0xc8, 0xf8, 0xdf, 0xd6, 0x30, 0x5a,
-	0x77, 0x10, 0x5a, 0x10, 0xf2, 0x9a, 0x6c, 0xce, 0x6b, 0xe8, 0x1e, 0xe8, 0x6e, 0x0c, 0x5c, 0x0e,
-	0x84, 0xf1, 0x9a, 0x33, 0x6f, 0x7b, 0xef, 0xfc, 0xb2, 0x55, 0xf9, 0x7e, 0xd9, 0xda, 0x4c, 0xa5,
-	0xe6, 0x52, 0xeb, 0xec, 0x2c, 0x60, 0x32, 0xe4, 0x60, 0xba, 0xc1, 0x0b, 0x61, 0xa2, 0xf5, 0xd2,
-	0xdb, 0x99, 0x59, 0x9f, 0xa2, 0xd3, 0x7d, 0x48, 0xb6, 0x12, 0xe8, 0xf5, 0xa4, 0x89, 0x39, 0x98,
-	0x81, 0x62, 0x66, 0x1c, 0x27, 0x3d, 0x99, 0x9e, 0x69, 0x8f, 0xec, 0x3b, 0x87, 0xd5, 0x68, 0xc3,
-	0xaa, 0xaf, 0x0a, 0xb1, 0x8d, 0x9a, 0xdb, 0x21, 0xeb, 0x48, 0xc5, 0x8a, 0xbe, 0x07, 0x95, 0x95,
-	0x35, 0xae, 0x61, 0x8d, 0x9b, 0x45, 0x8d, 0x9d, 0xdf, 0x6b, 0xbc, 0xa4, 0x39, 0xa4, 0xe3, 0x67,
-	0x34, 0x8d, 0xd6, 0xd0, 0x1f, 0xa1, 0xdd, 0x56, 0x79, 0x5c, 0xfb, 0xf4, 0xb9, 0x55, 0x39, 0xa9,
-	0x35, 0x9c, 0xd5, 0xa5, 0x93, 0x5a, 0xa3, 0xb1, 0xda, 0x3c, 0xf8, 0x5a, 0x23, 0xcb, 0xd6, 0x52,
-	0xfc, 0xcb, 0xdb, 0x64, 0x85, 0xc3, 0x28, 0x4e, 0xa4, 0xc8, 0xe2, 0x77, 0x90, 0x1a, 0xa9, 0x3c,
-	0x07, 0x9f, 0xed, 0x3a, 0x87, 0x51, 0x5b, 0x8a, 0xec, 0x18, 0x0f, 0x91, 0x63, 0xe2, 0x17, 0xee,
-	0xbf, 0x82, 0x63, 0xe2, 0x0a, 0x77, 0x8b, 0xdc, 0x80, 0x61, 0x6e, 0x6f, 0x1c, 0x1b, 0xc6, 0xa9,
-	0x57, 0x45, 0x6c, 0x19, 0x86, 0x39, 0x5e, 0xf5, 0x0d, 0xe3, 0xd4, 0xbd, 0x4b, 0xd6, 0x0c, 0xa8,
-	0x9c, 0x1a, 0x1b, 0xa8, 0xc0, 0x30, 0xe9, 0xd5, 0x10, 0x5c, 0xb1, 0xc2, 0x2c, 0x32, 0x9a, 0x1d,
-	0xff, 0x4b, 0xd3, 0xf6, 0x88, 0x78, 0xd9, 0x00, 0x2f, 0x2b, 0x8a, 0x47, 0x8c, 0x53, 0x29, 0xb4,
-	0x01, 0x61, 0xbc, 0x06, 0xda, 0xb7, 0x4a, 0xdd, 0x3e, 0xe7, 0x51, 0xa1, 0xfe, 0x35, 0x73, 0x6a,
-	0x47, 0xaa, 0x7d, 0x7c, 0x3e, 0xf1, 0x9d, 0x8b, 0x89, 0xef, 0xfc, 0x98, 0xf8, 0xce, 0xc7, 0xa9,
-	0x5f, 0xb9, 0x98, 0xfa, 0x95, 0x6f, 0x53, 0xbf, 0xf2, 0xf6, 0x5e, 0xce, 0x4c, 0x77, 0x90, 0x04,
-	0xa9, 0xe4, 0xb8, 0x86, 0xee, 0xdb, 0x8d, 0x24, 0x64, 0x46, 0xc3, 0xd1, 0x95, 0x7d, 0x64, 0xc6,
-	0x7d, 0xaa, 0x93, 0x3a, 0xee, 0x96, 0x07, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x4c, 0x06,
-	0x07, 0xbc, 0x04, 0x00, 0x00,
+	0x14, 0xc5, 0xe3, 0x7f, 0xd3, 0xfe, 0xd3, 0xa1, 0x9f, 0xee, 0x87, 0x4c, 0x3f, 0xdc, 0xaa, 0x20,
+	0x54, 0x10, 0x24, 0x0b, 0x58, 0x54, 0xac, 0x20, 0x2d, 0x95, 0xa8, 0x5a, 0xa9, 0xb8, 0x48, 0x48,
+	0x2c, 0x18, 0x5d, 0xdb, 0x83, 0x33, 0xd4, 0x9e, 0x89, 0x66, 0x26, 0x69, 0xca, 0x53, 0x20, 0xb1,
+	0x61, 0xc9, 0xc3, 0xb0, 0xe8, 0xb2, 0x4b, 0xc4, 0xa2, 0x42, 0xed, 0x8b, 0x20, 0xdf, 0xb1, 0xad,
+	0x22, 0x82, 0xc4, 0x12, 0xb1, 0xb3, 0x72, 0x7e, 0xe7, 0xe4, 0xf8, 0xce, 0xf8, 0x92, 0xbb, 0xef,
+	0x99, 0x81, 0xa8, 0x03, 0x5c, 0xb4, 0xf0, 0x49, 0x2a, 0xd6, 0x62, 0x19, 0xd7, 0x9a, 0x4b, 0xa1,
+	0x5b, 0x5d, 0x50, 0x90, 0xe9, 0x66, 0x57, 0x49, 0x23, 0xdd, 0x95, 0x0a, 0x6d, 0x96, 0x68, 0xb3,
+	0x42, 0x97, 0xe6, 0x13, 0x99, 0x48, 0x04, 0x5b, 0xf9, 0x93, 0xf5, 0x6c, 0x7c, 0x1c, 0x25, 0x63,
+	0x87, 0x18, 0xe2, 0xb6, 0xc9, 0x6a, 0x1f, 0x52, 0x1e, 0x83, 0x91, 0x8a, 0x96, 0x3e, 0xda, 0x65,
+	0x2a, 0x62, 0xc2, 0x40, 0xc2, 0xbc, 0xd1, 0x75, 0x67, 0x73, 0x3c, 0x58, 0xae, 0xa0, 0x67, 0x05,
+	0x73, 0x58, 0x21, 0xee, 0x13, 0xb2, 0x22, 0x43, 0xcd, 0x54, 0x9f, 0x0d, 0x8f, 0x18, 0xc3, 0x88,
+	0xa5, 0x92, 0x19, 0x92, 0xb0, 0x4d, 0x7c, 0xa3, 0x35, 0xd5, 0x3c, 0x11, 0xbf, 0xc9, 0xf8, 0xdf,
+	0xd6, 0x30, 0x5a, 0x1f, 0x21, 0x34, 0x24, 0xe4, 0x05, 0x59, 0xa8, 0x6a, 0xe8, 0x14, 0x74, 0x87,
+	0x42, 0x26, 0x7b, 0xc2, 0x78, 0xe3, 0xb9, 0xb7, 0xbd, 0x7a, 0x76, 0xb1, 0x56, 0xfb, 0x76, 0xb1,
+	0xb6, 0x10, 0x49, 0x9d, 0x49, 0xad, 0xe3, 0xe3, 0x26, 0x97, 0xad, 0x0c, 0x4c, 0xa7, 0xf9, 0x5c,
+	0x98, 0x60, 0xae, 0xf4, 0x1e, 0xe5, 0xd6, 0xa7, 0xe8, 0x74, 0x1f, 0x91, 0xc5, 0x10, 0xd2, 0x54,
+	0x1a, 0x9a, 0x81, 0xe9, 0x29, 0x6e, 0x4e, 0x69, 0x98, 0xca, 0xe8, 0x58, 0x7b, 0x64, 0xdd, 0xd9,
+	0x1c, 0x09, 0xe6, 0xad, 0x7a, 0x50, 0x88, 0x6d, 0xd4, 0xdc, 0x23, 0x32, 0x87, 0x14, 0x55, 0xec,
+	0x04, 0x54, 0x5c, 0xd6, 0xb8, 0x81, 0x35, 0x6e, 0x15, 0x35, 0x96, 0x7f, 0xad, 0xb1, 0xcf, 0x12,
+	0x88, 0x4e, 0x77, 0x58, 0x14, 0xcc, 0xa2, 0x3f, 0x40, 0x7b, 0x51, 0x65, 0x8b, 0x78, 0xd5, 0xdb,
+	0xa5, 0xbc, 0xcf, 0x04, 0xd3, 0x9a, 0x9e, 0x70, 0x11, 0xcb, 0x13, 0x6f, 0x02, 0xcb, 0x2c, 0x96,
+	0xfa, 0x7e, 0x21, 0xbf, 0x42, 0xd5, 0x7d, 0x43, 0x6e, 0x56, 0xce, 0x0c, 0x06, 0x34, 0x9f, 0x1c,
+	0x8b, 0xa9, 0x02, 0xc3, 0xa5, 0x37, 0xf9, 0xe7, 0xa5, 0xaa, 0xfc, 0x03, 0x18, 0x1c, 0x60, 0x46,
+	0x90, 0x47, 0xe4, 0x43, 0xaa, 0xf2, 0xdf, 0x01, 0x4f, 0x69, 0xdc, 0xc3, 0x6c, 0xe1, 0x4d, 0xd9,
+	0x21, 0x95, 0xea, 0x1e, 0xf0, 0x74, 0xa7, 0xd0, 0x1e, 0xd7, 0x3f, 0x7d, 0x5e, 0xab, 0xed, 0xd5,
+	0x1b, 0xce, 0xcc, 0xe8, 0x5e, 0xbd, 0xd1, 0x98, 0x19, 0xdf, 0xf8, 0x52, 0x27, 0x13, 0xf6, 0xdf,
+	0x8a, 0xbb, 0x79, 0x87, 0x4c, 0xe7, 0x7d, 0x43, 0x29, 0x62, 0xfa, 0x16, 0x22, 0x23, 0x95, 0xe7,
+	0xe0, 0x35, 0x98, 0xcc, 0x60, 0xd0, 0x96, 0x22, 0xde, 0xc5, 0x1f, 0x91, 0xe3, 0xe2, 0x27, 0xee,
+	0xbf, 0x82, 0xe3, 0xe2, 0x1a, 0x77, 0x9b, 0x4c, 0x41, 0x3f, 0xb1, 0x27, 0x48, 0x0d, 0xcf, 0x98,
+	0x37, 0x82, 0xd8, 0x04, 0xf4, 0x13, 0x3c, 0xba, 0x97, 0x3c, 0x63, 0xee, 0x3d, 0x32, 0x6b, 0x40,
+	0x25, 0xcc, 0xd8, 0x40, 0x3b, 0xa6, 0x3a, 0x82, 0xd3, 0x56, 0xc8, 0x23, 0xed, 0xab, 0xff, 0x43,
+	0x5f, 0xcf, 0x16, 0xf1, 0xca, 0x73, 0x2b, 0x86, 0x48, 0x23, 0x29, 0xb4, 0x01, 0x61, 0xbc, 0x06,
+	0xda, 0x17, 0x4b, 0xdd, 0x8e, 0x73, 0xbb, 0x50, 0xff, 0x9a, 0xef, 0xce, 0x5e, 0xa9, 0xf6, 0xee,
+	0xd9, 0xa5, 0xef, 0x9c, 0x5f, 0xfa, 0xce, 0xf7, 0x4b, 0xdf, 0xf9, 0x70, 0xe5, 0xd7, 0xce, 0xaf,
+	0xfc, 0xda, 0xd7, 0x2b, 0xbf, 0xf6, 0xfa, 0x7e, 0xc2, 0x4d, 0xa7, 0x17, 0x36, 0x23, 0x99, 0xe1,
+	0x5a, 0x7d, 0x60, 0x37, 0xac, 0x90, 0x31, 0x6b, 0x0d, 0xae, 0xed, 0x57, 0x73, 0xda, 0x65, 0x3a,
+	0x1c, 0xc3, 0x5d, 0xf9, 0xf0, 0xc7, 0x00, 0x2a, 0xb2, 0xe3, 0xa4, 0x8c, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -278,6 +308,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ObserverJailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ObserverJailDuration))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.ObserverMaxMissedRatio.Size()
+		i -= size
+		if _, err := m.ObserverMaxMissedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.ObserverLivenessWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ObserverLivenessWindow))
+		i--
+		dAtA[i] = 0x60
+	}
 	{
 		size := m.BlockRewardAmount.Size()
 		i -= size
@@ -457,6 +507,14 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.BlockRewardAmount.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.ObserverLivenessWindow != 0 {
+		n += 1 + sovParams(uint64(m.ObserverLivenessWindow))
+	}
+	l = m.ObserverMaxMissedRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ObserverJailDuration != 0 {
+		n += 1 + sovParams(uint64(m.ObserverJailDuration))
+	}
 	return n
 }
 
@@ -724,6 +782,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLivenessWindow", wireType)
+			}
+			m.ObserverLivenessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverLivenessWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverMaxMissedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObserverMaxMissedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverJailDuration", wireType)
+			}
+			m.ObserverJailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverJailDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -22,6 +22,9 @@ func TestNewParams(t *testing.T) {
 	)
 	require.Equal(t, int64(100), params.BallotMaturityBlocks, "BallotMaturityBlocks should be set to 100")
 	require.Equal(t, BlockReward, params.BlockRewardAmount, "BlockRewardAmount should be set to 0")
+	require.Equal(t, int64(0), params.ObserverLivenessWindow, "ObserverLivenessWindow should be set to 0")
+	require.Equal(t, ObserverMaxMissedRatio, params.ObserverMaxMissedRatio, "ObserverMaxMissedRatio should be set to 0.5")
+	require.Equal(t, int64(0), params.ObserverJailDuration, "ObserverJailDuration should be set to 0")
 }
 
 func TestDefaultParams(t *testing.T) {
@@ -76,6 +79,28 @@ func TestValidateBlockRewardAmount(t *testing.T) {
 	require.NoError(t, validateBlockRewardsAmount(BlockReward))
 }
 
+func TestValidateObserverLivenessWindow(t *testing.T) {
+	require.Error(t, validateObserverLivenessWindow("10"))
+	require.Error(t, validateObserverLivenessWindow(int64(-100)))
+	require.NoError(t, validateObserverLivenessWindow(int64(0)))
+	require.NoError(t, validateObserverLivenessWindow(int64(100)))
+}
+
+func TestValidateObserverMaxMissedRatio(t *testing.T) {
+	require.Error(t, validateObserverMaxMissedRatio("0.50"))
+	require.Error(t, validateObserverMaxMissedRatio(sdkmath.LegacyMustNewDecFromStr("-0.50")))
+	require.Error(t, validateObserverMaxMissedRatio(sdkmath.LegacyMustNewDecFromStr("1.01")))
+	require.NoError(t, validateObserverMaxMissedRatio(sdkmath.LegacyDec{}))
+	require.NoError(t, validateObserverMaxMissedRatio(sdkmath.LegacyZeroDec()))
+	require.NoError(t, validateObserverMaxMissedRatio(ObserverMaxMissedRatio))
+}
+
+func TestValidateObserverJailDuration(t *testing.T) {
+	require.Error(t, validateObserverJailDuration("10"))
+	require.Error(t, validateObserverJailDuration(int64(-100)))
+	require.NoError(t, validateObserverJailDuration(int64(100)))
+}
+
 func TestValidate(t *testing.T) {
 	t.Run("should validate", func(t *testing.T) {
 		params := NewParams()
@@ -117,6 +142,20 @@ func TestValidate(t *testing.T) {
 		params.BlockRewardAmount = sdkmath.LegacyMustNewDecFromStr("-1.30")
 		require.ErrorContains(t, params.Validate(), "block reward amount must not be negative")
 	})
+
+	t.Run("should error for invalid observer liveness params", func(t *testing.T) {
+		params := NewParams()
+		params.ObserverLivenessWindow = -100
+		require.ErrorContains(t, params.Validate(), "observer liveness window must not be negative")
+
+		params = NewParams()
+		params.ObserverMaxMissedRatio = sdkmath.LegacyMustNewDecFromStr("1.30")
+		require.ErrorContains(t, params.Validate(), "observer max missed ratio cannot be more than 1")
+
+		params = NewParams()
+		params.ObserverJailDuration = -100
+		require.ErrorContains(t, params.Validate(), "observer jail duration must not be negative")
+	})
 }
 func TestParamsString(t *testing.T) {
 	params := DefaultParams()
@@ -28,6 +28,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdGasStabilityPoolBalances(),
 		CmdSystemContract(),
 		CmdQueryCodeHash(),
+		CmdActivePrecompiles(),
 	)
 
 	return cmd
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func CmdActivePrecompiles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-precompiles",
+		Short: "query the stateful precompiled contracts enabled on ZEVM",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ActivePrecompiles(context.Background(), &types.QueryActivePrecompilesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func CmdUpdatePrecompileStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-precompile-status [precompile-address] [enabled]",
+		Short:   "Broadcast message UpdatePrecompileStatus to enable or disable a stateful precompiled contract",
+		Example: `zetacored tx fungible update-precompile-status 0x0000000000000000000000000000000000000066 true`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdatePrecompileStatus(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				enabled,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package fungible
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/zeta-chain/node/x/fungible/keeper"
 	"github.com/zeta-chain/node/x/fungible/types"
@@ -18,6 +19,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if genState.SystemContract != nil {
 		k.SetSystemContract(ctx, *genState.SystemContract)
 	}
+	// Enable the stateful precompiled contracts
+	for _, elem := range genState.EnabledPrecompiles {
+		k.SetPrecompileStatus(ctx, ethcommon.HexToAddress(elem), true)
+	}
 }
 
 // ExportGenesis returns the fungible module's exported genesis.
@@ -32,5 +37,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.SystemContract = &system
 	}
 
+	for _, address := range k.GetEnabledPrecompiles(ctx) {
+		genesis.EnabledPrecompiles = append(genesis.EnabledPrecompiles, address.Hex())
+	}
+
 	return &genesis
 }
@@ -20,6 +20,10 @@ func TestGenesis(t *testing.T) {
 			sample.ForeignCoins(t, sample.EthAddress().String()),
 		},
 		SystemContract: sample.SystemContract(),
+		EnabledPrecompiles: []string{
+			"0x0000000000000000000000000000000000000065",
+			"0x0000000000000000000000000000000000000066",
+		},
 	}
 
 	// Init and export
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// ActivePrecompiles returns the addresses of the stateful precompiled contracts enabled on ZEVM
+func (k Keeper) ActivePrecompiles(
+	c context.Context,
+	req *types.QueryActivePrecompilesRequest,
+) (*types.QueryActivePrecompilesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addresses := []string{}
+	for _, address := range k.GetEnabledPrecompiles(ctx) {
+		addresses = append(addresses, address.Hex())
+	}
+
+	return &types.QueryActivePrecompilesResponse{Addresses: addresses}, nil
+}
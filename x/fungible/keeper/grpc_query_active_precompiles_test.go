@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestKeeper_ActivePrecompiles(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		res, err := k.ActivePrecompiles(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("should return empty list if no precompile is enabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		res, err := k.ActivePrecompiles(ctx, &types.QueryActivePrecompilesRequest{})
+		require.NoError(t, err)
+		require.Empty(t, res.Addresses)
+	})
+
+	t.Run("should return enabled precompiles", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		addr := ethcommon.HexToAddress("0x0000000000000000000000000000000000000066")
+		k.SetPrecompileStatus(ctx, addr, true)
+
+		res, err := k.ActivePrecompiles(ctx, &types.QueryActivePrecompilesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []string{addr.Hex()}, res.Addresses)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/zeta-chain/node/x/fungible/types"
 )
@@ -21,6 +22,9 @@ type (
 		bankKeeper      types.BankKeeper
 		observerKeeper  types.ObserverKeeper
 		authorityKeeper types.AuthorityKeeper
+
+		// registeredPrecompiles are the addresses of the stateful precompiled contracts installed in ZEVM
+		registeredPrecompiles []ethcommon.Address
 	}
 )
 
@@ -73,3 +77,9 @@ func (k Keeper) GetObserverKeeper() types.ObserverKeeper {
 func (k Keeper) GetAuthorityKeeper() types.AuthorityKeeper {
 	return k.authorityKeeper
 }
+
+// SetRegisteredPrecompiles sets the addresses of the stateful precompiled contracts installed in ZEVM,
+// only the status of these contracts can be updated
+func (k *Keeper) SetRegisteredPrecompiles(addresses []ethcommon.Address) {
+	k.registeredPrecompiles = addresses
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// UpdatePrecompileStatus enables or disables a stateful precompiled contract on ZEVM
+// Authorized: admin policy group groupAdmin.
+func (k msgServer) UpdatePrecompileStatus(
+	goCtx context.Context,
+	msg *types.MsgUpdatePrecompileStatus,
+) (*types.MsgUpdatePrecompileStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	precompileAddress := ethcommon.HexToAddress(msg.PrecompileAddress)
+	if !k.IsPrecompileRegistered(precompileAddress) {
+		return nil, cosmoserrors.Wrapf(types.ErrPrecompileNotRegistered, "address %s", msg.PrecompileAddress)
+	}
+
+	// the precompile list is read from the store when the EVM is instantiated
+	// the new status takes effect for the next EVM transactions
+	k.SetPrecompileStatus(ctx, precompileAddress, msg.Enabled)
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventPrecompileStatusUpdated{
+			MsgTypeUrl:        sdk.MsgTypeURL(&types.MsgUpdatePrecompileStatus{}),
+			PrecompileAddress: msg.PrecompileAddress,
+			Enabled:           msg.Enabled,
+			Signer:            msg.Creator,
+		},
+	)
+	if err != nil {
+		k.Logger(ctx).Error("failed to emit event",
+			"event", "EventPrecompileStatusUpdated",
+			"error", err.Error(),
+		)
+		return nil, cosmoserrors.Wrapf(types.ErrEmitEvent, "failed to emit event (%s)", err.Error())
+	}
+
+	return &types.MsgUpdatePrecompileStatusResponse{}, nil
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/fungible/keeper"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestKeeper_UpdatePrecompileStatus(t *testing.T) {
+	precompileAddress := "0x0000000000000000000000000000000000000066"
+
+	t.Run("can enable and disable a precompile", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		k.SetRegisteredPrecompiles([]ethcommon.Address{ethcommon.HexToAddress(precompileAddress)})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+
+		// ACT
+		msg := types.NewMsgUpdatePrecompileStatus(admin, precompileAddress, true)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.UpdatePrecompileStatus(ctx, msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.True(t, k.IsPrecompileEnabled(ctx, ethcommon.HexToAddress(precompileAddress)))
+
+		// ACT
+		msg = types.NewMsgUpdatePrecompileStatus(admin, precompileAddress, false)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err = msgServer.UpdatePrecompileStatus(ctx, msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.False(t, k.IsPrecompileEnabled(ctx, ethcommon.HexToAddress(precompileAddress)))
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+
+		msg := types.NewMsgUpdatePrecompileStatus(admin, precompileAddress, true)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+
+		// ACT
+		_, err := msgServer.UpdatePrecompileStatus(ctx, msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+		require.False(t, k.IsPrecompileEnabled(ctx, ethcommon.HexToAddress(precompileAddress)))
+	})
+	t.Run("should fail if the precompile is not registered", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		k.SetRegisteredPrecompiles([]ethcommon.Address{ethcommon.HexToAddress(precompileAddress)})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+
+		unknownAddress := sample.EthAddress().Hex()
+		msg := types.NewMsgUpdatePrecompileStatus(admin, unknownAddress, true)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+
+		// ACT
+		_, err := msgServer.UpdatePrecompileStatus(ctx, msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrPrecompileNotRegistered)
+		require.False(t, k.IsPrecompileEnabled(ctx, ethcommon.HexToAddress(unknownAddress)))
+	})
+}
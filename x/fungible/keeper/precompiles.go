@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// SetPrecompileStatus enables or disables the stateful precompiled contract at the given address
+func (k Keeper) SetPrecompileStatus(ctx sdk.Context, address ethcommon.Address, enabled bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EnabledPrecompileKey))
+	if enabled {
+		store.Set(address.Bytes(), []byte{1})
+		return
+	}
+	store.Delete(address.Bytes())
+}
+
+// IsPrecompileRegistered returns true if a stateful precompiled contract is installed in ZEVM at the given address
+func (k Keeper) IsPrecompileRegistered(address ethcommon.Address) bool {
+	for _, registered := range k.registeredPrecompiles {
+		if registered == address {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPrecompileEnabled returns true if the stateful precompiled contract at the given address is enabled
+func (k Keeper) IsPrecompileEnabled(ctx sdk.Context, address ethcommon.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EnabledPrecompileKey))
+	return store.Has(address.Bytes())
+}
+
+// GetEnabledPrecompiles returns the addresses of all the enabled stateful precompiled contracts
+func (k Keeper) GetEnabledPrecompiles(ctx sdk.Context) (list []ethcommon.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EnabledPrecompileKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, ethcommon.BytesToAddress(iterator.Key()))
+	}
+
+	return list
+}
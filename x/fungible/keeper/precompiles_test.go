@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+)
+
+func TestKeeper_SetPrecompileStatus(t *testing.T) {
+	t.Run("should enable and disable precompiles", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		addr1 := ethcommon.HexToAddress("0x0000000000000000000000000000000000000065")
+		addr2 := ethcommon.HexToAddress("0x0000000000000000000000000000000000000066")
+
+		// disabled by default
+		require.False(t, k.IsPrecompileEnabled(ctx, addr1))
+		require.Empty(t, k.GetEnabledPrecompiles(ctx))
+
+		// enable both
+		k.SetPrecompileStatus(ctx, addr1, true)
+		k.SetPrecompileStatus(ctx, addr2, true)
+		require.True(t, k.IsPrecompileEnabled(ctx, addr1))
+		require.True(t, k.IsPrecompileEnabled(ctx, addr2))
+		require.Equal(t, []ethcommon.Address{addr1, addr2}, k.GetEnabledPrecompiles(ctx))
+
+		// disable one
+		k.SetPrecompileStatus(ctx, addr1, false)
+		require.False(t, k.IsPrecompileEnabled(ctx, addr1))
+		require.Equal(t, []ethcommon.Address{addr2}, k.GetEnabledPrecompiles(ctx))
+	})
+}
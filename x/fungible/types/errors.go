@@ -50,4 +50,5 @@ var (
 		1138,
 		"error depositing ZETA to fungible module account",
 	)
+	ErrPrecompileNotRegistered = cosmoserrors.Register(ModuleName, 1139, "precompile not registered")
 )
@@ -664,6 +664,74 @@ func (m *EventGatewayContractUpdated) GetSigner() string {
 	return ""
 }
 
+type EventPrecompileStatusUpdated struct {
+	MsgTypeUrl        string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	PrecompileAddress string `protobuf:"bytes,2,opt,name=precompile_address,json=precompileAddress,proto3" json:"precompile_address,omitempty"`
+	Enabled           bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Signer            string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventPrecompileStatusUpdated) Reset()         { *m = EventPrecompileStatusUpdated{} }
+func (m *EventPrecompileStatusUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPrecompileStatusUpdated) ProtoMessage()    {}
+func (*EventPrecompileStatusUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e6611815bc2713b, []int{8}
+}
+func (m *EventPrecompileStatusUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPrecompileStatusUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPrecompileStatusUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPrecompileStatusUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPrecompileStatusUpdated.Merge(m, src)
+}
+func (m *EventPrecompileStatusUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPrecompileStatusUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPrecompileStatusUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPrecompileStatusUpdated proto.InternalMessageInfo
+
+func (m *EventPrecompileStatusUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventPrecompileStatusUpdated) GetPrecompileAddress() string {
+	if m != nil {
+		return m.PrecompileAddress
+	}
+	return ""
+}
+
+func (m *EventPrecompileStatusUpdated) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *EventPrecompileStatusUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventSystemContractUpdated)(nil), "zetachain.zetacore.fungible.EventSystemContractUpdated")
 	proto.RegisterType((*EventZRC20Deployed)(nil), "zetachain.zetacore.fungible.EventZRC20Deployed")
@@ -673,6 +741,7 @@ func init() {
 	proto.RegisterType((*EventSystemContractsDeployed)(nil), "zetachain.zetacore.fungible.EventSystemContractsDeployed")
 	proto.RegisterType((*EventBytecodeUpdated)(nil), "zetachain.zetacore.fungible.EventBytecodeUpdated")
 	proto.RegisterType((*EventGatewayContractUpdated)(nil), "zetachain.zetacore.fungible.EventGatewayContractUpdated")
+	proto.RegisterType((*EventPrecompileStatusUpdated)(nil), "zetachain.zetacore.fungible.EventPrecompileStatusUpdated")
 }
 
 func init() {
@@ -680,56 +749,59 @@ func init() {
 }
 
 var fileDescriptor_1e6611815bc2713b = []byte{
-	// 771 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xad, 0x9b, 0x36, 0x6d, 0xa6, 0x3f, 0x49, 0xad, 0xe8, 0x93, 0xbf, 0x14, 0x45, 0x55, 0xa0,
-	0x10, 0x0a, 0x24, 0x55, 0x78, 0x02, 0x5a, 0xda, 0x82, 0xc4, 0x02, 0x05, 0x0a, 0x52, 0x37, 0xd6,
-	0xc4, 0xbe, 0x75, 0xac, 0xda, 0x33, 0x96, 0x67, 0x12, 0xd7, 0x7d, 0x0a, 0x96, 0x3c, 0x04, 0x4f,
-	0x00, 0x2f, 0xc0, 0xb2, 0x12, 0x1b, 0x96, 0xa8, 0x7d, 0x09, 0x96, 0x68, 0xc6, 0x63, 0xc7, 0x2e,
-	0x6d, 0xd5, 0xb2, 0x40, 0x62, 0x13, 0xcd, 0x9d, 0x9c, 0xb9, 0xf7, 0xdc, 0xe3, 0x73, 0x67, 0x50,
-	0xfb, 0x04, 0x38, 0xb6, 0x86, 0xd8, 0x25, 0x5d, 0xb9, 0xa2, 0x21, 0x74, 0x0f, 0x47, 0xc4, 0x71,
-	0x07, 0x1e, 0x74, 0x61, 0x0c, 0x84, 0xb3, 0x4e, 0x10, 0x52, 0x4e, 0xf5, 0xd5, 0x0c, 0xd9, 0x49,
-	0x91, 0x9d, 0x14, 0xd9, 0xb8, 0x77, 0x5d, 0x1a, 0x7e, 0x9c, 0xa4, 0x68, 0xd4, 0x1d, 0xea, 0x50,
-	0xb9, 0xec, 0x8a, 0x95, 0xda, 0xbd, 0x7f, 0xc9, 0xd9, 0xe0, 0xc8, 0xe9, 0x5a, 0xd4, 0x25, 0xf2,
-	0x27, 0xc1, 0xb5, 0x3e, 0x6b, 0xa8, 0xb1, 0x23, 0x18, 0xbd, 0x89, 0x19, 0x07, 0x7f, 0x9b, 0x12,
-	0x1e, 0x62, 0x8b, 0xef, 0x07, 0x36, 0xe6, 0x60, 0xeb, 0x6b, 0x68, 0xd1, 0x67, 0x8e, 0xc9, 0xe3,
-	0x00, 0xcc, 0x51, 0xe8, 0x19, 0xda, 0x9a, 0xd6, 0xae, 0xf4, 0x91, 0xcf, 0x9c, 0xb7, 0x71, 0x00,
-	0xfb, 0xa1, 0xa7, 0x6f, 0xa2, 0x3a, 0x81, 0xc8, 0xb4, 0xd4, 0x41, 0x13, 0xdb, 0x76, 0x08, 0x8c,
-	0x19, 0xd3, 0x12, 0xa9, 0x13, 0x88, 0xd2, 0x9c, 0xcf, 0x92, 0x7f, 0xc4, 0x09, 0xea, 0xd9, 0xbf,
-	0x9f, 0x28, 0x25, 0x27, 0xa8, 0x67, 0x5f, 0x3c, 0xf1, 0x1f, 0x2a, 0x33, 0xd7, 0x21, 0x10, 0x1a,
-	0x33, 0x12, 0xa3, 0xa2, 0xd6, 0xa7, 0x69, 0xa4, 0x4b, 0xf2, 0x07, 0xfd, 0xed, 0xde, 0xe6, 0x73,
-	0x08, 0x3c, 0x1a, 0xdf, 0x88, 0xf4, 0xff, 0x68, 0x5e, 0x6a, 0x63, 0xba, 0xb6, 0x24, 0x5a, 0xea,
-	0xcf, 0xc9, 0xf8, 0xa5, 0xad, 0x37, 0xd0, 0x7c, 0xca, 0x4c, 0x31, 0xca, 0x62, 0x5d, 0x47, 0x33,
-	0x04, 0xfb, 0xa0, 0x58, 0xc8, 0xb5, 0xe4, 0x16, 0xfb, 0x03, 0xea, 0x19, 0xb3, 0x8a, 0x9b, 0x8c,
-	0x44, 0x1e, 0x1b, 0x2c, 0xd7, 0xc7, 0x1e, 0x33, 0xca, 0xb2, 0x44, 0x16, 0xeb, 0x5b, 0xa8, 0x22,
-	0x3e, 0x81, 0x64, 0x68, 0xcc, 0xad, 0x69, 0xed, 0xe5, 0xde, 0x7a, 0xe7, 0x12, 0x27, 0x04, 0x47,
-	0x4e, 0x47, 0x7e, 0xab, 0x6d, 0xea, 0x12, 0xc1, 0x5d, 0x70, 0x49, 0x56, 0x7a, 0x1d, 0xcd, 0x42,
-	0x68, 0xf5, 0x36, 0x8d, 0x79, 0x59, 0x36, 0x09, 0xf4, 0x55, 0x54, 0x71, 0x30, 0x33, 0x3d, 0xd7,
-	0x77, 0xb9, 0x51, 0x49, 0xca, 0x3a, 0x98, 0xbd, 0x12, 0x71, 0xeb, 0xe7, 0x34, 0xba, 0x33, 0x91,
-	0xeb, 0xbd, 0xcb, 0x87, 0x76, 0x88, 0xa3, 0x5d, 0x80, 0x9b, 0x7f, 0xed, 0x6b, 0x84, 0x2b, 0x34,
-	0x55, 0xfa, 0xb3, 0xa6, 0xee, 0xa2, 0xa5, 0x13, 0xd1, 0x47, 0xe6, 0x89, 0x44, 0xe9, 0x45, 0xb9,
-	0x99, 0xba, 0xa1, 0x8d, 0x6a, 0xc2, 0x3f, 0x91, 0xe2, 0x6f, 0x1e, 0x02, 0x28, 0xed, 0x97, 0xa9,
-	0x67, 0xe7, 0xda, 0x12, 0x48, 0xe1, 0xcd, 0x02, 0xb2, 0x9c, 0x20, 0x09, 0x44, 0x79, 0xe4, 0xc4,
-	0x61, 0x73, 0x79, 0x87, 0xe9, 0x2d, 0xb4, 0x24, 0x6a, 0x4d, 0x34, 0x4d, 0xd4, 0x5e, 0xa0, 0x9e,
-	0xbd, 0xa7, 0x64, 0x15, 0x18, 0x51, 0xa5, 0xa8, 0x7b, 0xa5, 0xbf, 0x40, 0x20, 0x4a, 0x31, 0xad,
-	0x11, 0xaa, 0x4d, 0x94, 0x7f, 0x8d, 0x47, 0xec, 0x46, 0x6a, 0x3f, 0x40, 0xd5, 0x82, 0x1c, 0x20,
-	0xc6, 0xaa, 0x24, 0xe8, 0xe7, 0x05, 0x81, 0xfc, 0x80, 0x94, 0x0a, 0x03, 0x12, 0xe5, 0xe7, 0x63,
-	0x9f, 0x04, 0x7f, 0xad, 0xf0, 0xc7, 0xd4, 0x6a, 0xc5, 0x6b, 0x85, 0xdd, 0x62, 0x46, 0x1f, 0x23,
-	0x7d, 0x44, 0x5c, 0x16, 0xe1, 0xc0, 0x1c, 0xf7, 0xcc, 0x43, 0x6c, 0x71, 0x1a, 0xc6, 0xea, 0x5a,
-	0xa9, 0xa9, 0x7f, 0xde, 0xf5, 0x76, 0x93, 0x7d, 0x31, 0x0e, 0x91, 0xb0, 0x98, 0xe2, 0x91, 0x04,
-	0xfa, 0x06, 0x5a, 0xc9, 0xe5, 0x08, 0xe9, 0x88, 0x67, 0x77, 0x48, 0x35, 0x4b, 0xd1, 0x97, 0xdb,
-	0xfa, 0x3a, 0x5a, 0xb6, 0x28, 0x21, 0x20, 0xf2, 0x99, 0x27, 0x30, 0xf6, 0x95, 0xa9, 0x96, 0xb2,
-	0xdd, 0x03, 0x18, 0xfb, 0x42, 0x1a, 0x26, 0x7b, 0xca, 0x2e, 0xb0, 0xd4, 0x52, 0xac, 0xd0, 0xea,
-	0x55, 0x96, 0x6a, 0x7d, 0xd3, 0x50, 0x5d, 0x4a, 0xb3, 0x15, 0x73, 0xb0, 0xa8, 0x7d, 0x8b, 0xe9,
-	0x7b, 0x88, 0x6a, 0x57, 0xdc, 0xb3, 0x55, 0xeb, 0xc2, 0x95, 0xb9, 0x81, 0x56, 0x84, 0x29, 0x07,
-	0xaa, 0x86, 0x39, 0xc4, 0x6c, 0xa8, 0xb4, 0xa9, 0x12, 0x88, 0xd2, 0xda, 0x2f, 0x30, 0x1b, 0x0a,
-	0xac, 0x30, 0x79, 0x11, 0xab, 0x54, 0xa2, 0x9e, 0x5d, 0xc0, 0x4e, 0xba, 0x9a, 0x2d, 0x74, 0xf5,
-	0x45, 0x43, 0xab, 0xb2, 0xab, 0x3d, 0xcc, 0x21, 0xc2, 0xf1, 0x3f, 0xf5, 0x90, 0x6c, 0xed, 0x7c,
-	0x3d, 0x6b, 0x6a, 0xa7, 0x67, 0x4d, 0xed, 0xc7, 0x59, 0x53, 0xfb, 0x70, 0xde, 0x9c, 0x3a, 0x3d,
-	0x6f, 0x4e, 0x7d, 0x3f, 0x6f, 0x4e, 0x1d, 0x3c, 0x72, 0x5c, 0x3e, 0x1c, 0x0d, 0x3a, 0x16, 0xf5,
-	0xe5, 0x43, 0xfa, 0x24, 0x79, 0x53, 0x09, 0xb5, 0xa1, 0x7b, 0x9c, 0x7b, 0x8d, 0xe3, 0x00, 0xd8,
-	0xa0, 0x2c, 0xdf, 0xd4, 0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xfc, 0xcd, 0xad, 0x00,
-	0x08, 0x00, 0x00,
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x9b, 0x6d, 0xfe, 0xcc, 0x6e, 0x9b, 0x74, 0x14, 0x21, 0x93, 0xa2, 0xa8, 0x0a, 0x2c,
+	0x84, 0x85, 0x4d, 0xaa, 0xf0, 0x09, 0x68, 0xd9, 0x5d, 0x90, 0x38, 0xac, 0xb2, 0x14, 0xa4, 0x5e,
+	0xac, 0x89, 0xe7, 0xd5, 0xb1, 0xd6, 0x9e, 0xb1, 0x3c, 0xe3, 0x78, 0xdd, 0x4f, 0xc1, 0x91, 0x2b,
+	0x77, 0x3e, 0x01, 0x7c, 0x01, 0x8e, 0x2b, 0x71, 0xe1, 0x88, 0xda, 0x2f, 0xc1, 0x11, 0xcd, 0x78,
+	0xec, 0xd8, 0xa5, 0xad, 0x52, 0x0e, 0x48, 0x7b, 0x89, 0xe6, 0x8d, 0x7f, 0xf3, 0xde, 0xef, 0xfd,
+	0xfc, 0x7b, 0xe3, 0xa0, 0xf1, 0x05, 0x48, 0xe2, 0x2e, 0x89, 0xcf, 0xa6, 0x7a, 0xc5, 0x63, 0x98,
+	0x9e, 0x27, 0xcc, 0xf3, 0x17, 0x01, 0x4c, 0x61, 0x05, 0x4c, 0x8a, 0x49, 0x14, 0x73, 0xc9, 0xf1,
+	0x41, 0x89, 0x9c, 0x14, 0xc8, 0x49, 0x81, 0x1c, 0x7c, 0x74, 0x57, 0x1a, 0xf9, 0x26, 0x4f, 0x31,
+	0xe8, 0x7b, 0xdc, 0xe3, 0x7a, 0x39, 0x55, 0x2b, 0xb3, 0xfb, 0xf1, 0x0d, 0x67, 0xa3, 0xd7, 0xde,
+	0xd4, 0xe5, 0x3e, 0xd3, 0x3f, 0x39, 0x6e, 0xf4, 0xab, 0x85, 0x06, 0xcf, 0x14, 0xa3, 0x57, 0x99,
+	0x90, 0x10, 0x9e, 0x70, 0x26, 0x63, 0xe2, 0xca, 0xd3, 0x88, 0x12, 0x09, 0x14, 0x1f, 0xa2, 0x47,
+	0xa1, 0xf0, 0x1c, 0x99, 0x45, 0xe0, 0x24, 0x71, 0x60, 0x5b, 0x87, 0xd6, 0xb8, 0x33, 0x47, 0xa1,
+	0xf0, 0xbe, 0xcb, 0x22, 0x38, 0x8d, 0x03, 0x7c, 0x84, 0xfa, 0x0c, 0x52, 0xc7, 0x35, 0x07, 0x1d,
+	0x42, 0x69, 0x0c, 0x42, 0xd8, 0xdb, 0x1a, 0x89, 0x19, 0xa4, 0x45, 0xce, 0x2f, 0xf3, 0x27, 0xea,
+	0x04, 0x0f, 0xe8, 0xbf, 0x4f, 0x34, 0xf2, 0x13, 0x3c, 0xa0, 0xd7, 0x4f, 0xbc, 0x87, 0x9a, 0xc2,
+	0xf7, 0x18, 0xc4, 0xf6, 0x03, 0x8d, 0x31, 0xd1, 0xe8, 0x97, 0x6d, 0x84, 0x35, 0xf9, 0xb3, 0xf9,
+	0xc9, 0xec, 0xe8, 0x2b, 0x88, 0x02, 0x9e, 0x6d, 0x44, 0xfa, 0x7d, 0xd4, 0xd6, 0xda, 0x38, 0x3e,
+	0xd5, 0x44, 0x1b, 0xf3, 0x96, 0x8e, 0xbf, 0xa1, 0x78, 0x80, 0xda, 0x05, 0x33, 0xc3, 0xa8, 0x8c,
+	0x31, 0x46, 0x0f, 0x18, 0x09, 0xc1, 0xb0, 0xd0, 0x6b, 0xcd, 0x2d, 0x0b, 0x17, 0x3c, 0xb0, 0x77,
+	0x0c, 0x37, 0x1d, 0xa9, 0x3c, 0x14, 0x5c, 0x3f, 0x24, 0x81, 0xb0, 0x9b, 0xba, 0x44, 0x19, 0xe3,
+	0x63, 0xd4, 0x51, 0xaf, 0x40, 0x33, 0xb4, 0x5b, 0x87, 0xd6, 0x78, 0x6f, 0xf6, 0x78, 0x72, 0x83,
+	0x13, 0xa2, 0xd7, 0xde, 0x44, 0xbf, 0xab, 0x13, 0xee, 0x33, 0xc5, 0x5d, 0x71, 0xc9, 0x57, 0xb8,
+	0x8f, 0x76, 0x20, 0x76, 0x67, 0x47, 0x76, 0x5b, 0x97, 0xcd, 0x03, 0x7c, 0x80, 0x3a, 0x1e, 0x11,
+	0x4e, 0xe0, 0x87, 0xbe, 0xb4, 0x3b, 0x79, 0x59, 0x8f, 0x88, 0x6f, 0x55, 0x3c, 0xfa, 0x7b, 0x1b,
+	0x7d, 0xb0, 0x96, 0xeb, 0x07, 0x5f, 0x2e, 0x69, 0x4c, 0xd2, 0xe7, 0x00, 0x9b, 0xbf, 0xed, 0x3b,
+	0x84, 0xab, 0x35, 0xd5, 0xf8, 0x6f, 0x4d, 0x7d, 0x88, 0x76, 0x2f, 0x54, 0x1f, 0xa5, 0x27, 0x72,
+	0xa5, 0x1f, 0xe9, 0xcd, 0xc2, 0x0d, 0x63, 0xd4, 0x53, 0xfe, 0x49, 0x0d, 0x7f, 0xe7, 0x1c, 0xc0,
+	0x68, 0xbf, 0xc7, 0x03, 0x5a, 0x69, 0x4b, 0x21, 0x95, 0x37, 0x6b, 0xc8, 0x66, 0x8e, 0x64, 0x90,
+	0x56, 0x91, 0x6b, 0x87, 0xb5, 0xaa, 0x0e, 0xc3, 0x23, 0xb4, 0xab, 0x6a, 0xad, 0x35, 0xcd, 0xd5,
+	0x7e, 0xc8, 0x03, 0xfa, 0xc2, 0xc8, 0xaa, 0x30, 0xaa, 0x4a, 0x5d, 0xf7, 0xce, 0xfc, 0x21, 0x83,
+	0xb4, 0xc0, 0x8c, 0x12, 0xd4, 0x5b, 0x2b, 0xff, 0x92, 0x24, 0x62, 0x23, 0xb5, 0x3f, 0x41, 0xdd,
+	0x9a, 0x1c, 0xa0, 0xc6, 0xaa, 0xa1, 0xe8, 0x57, 0x05, 0x81, 0xea, 0x80, 0x34, 0x6a, 0x03, 0x92,
+	0x56, 0xe7, 0xe3, 0x94, 0x45, 0xff, 0x5b, 0xe1, 0x9f, 0x0a, 0xab, 0xd5, 0xaf, 0x15, 0x71, 0x8f,
+	0x19, 0xfd, 0x1c, 0xe1, 0x84, 0xf9, 0x22, 0x25, 0x91, 0xb3, 0x9a, 0x39, 0xe7, 0xc4, 0x95, 0x3c,
+	0xce, 0xcc, 0xb5, 0xd2, 0x33, 0x4f, 0xbe, 0x9f, 0x3d, 0xcf, 0xf7, 0xd5, 0x38, 0xa4, 0xca, 0x62,
+	0x86, 0x47, 0x1e, 0xe0, 0x27, 0x68, 0xbf, 0x92, 0x23, 0xe6, 0x89, 0x2c, 0xef, 0x90, 0x6e, 0x99,
+	0x62, 0xae, 0xb7, 0xf1, 0x63, 0xb4, 0xe7, 0x72, 0xc6, 0x40, 0xe5, 0x73, 0x2e, 0x60, 0x15, 0x1a,
+	0x53, 0xed, 0x96, 0xbb, 0x67, 0xb0, 0x0a, 0x95, 0x34, 0x42, 0xf7, 0x54, 0x5e, 0x60, 0x85, 0xa5,
+	0x44, 0xad, 0xd5, 0xdb, 0x2c, 0x35, 0xfa, 0xc3, 0x42, 0x7d, 0x2d, 0xcd, 0x71, 0x26, 0xc1, 0xe5,
+	0xf4, 0x1e, 0xd3, 0xf7, 0x29, 0xea, 0xdd, 0x72, 0xcf, 0x76, 0xdd, 0x6b, 0x57, 0xe6, 0x13, 0xb4,
+	0xaf, 0x4c, 0xb9, 0x30, 0x35, 0x9c, 0x25, 0x11, 0x4b, 0xa3, 0x4d, 0x97, 0x41, 0x5a, 0xd4, 0xfe,
+	0x9a, 0x88, 0xa5, 0xc2, 0x2a, 0x93, 0xd7, 0xb1, 0x46, 0x25, 0x1e, 0xd0, 0x1a, 0x76, 0xdd, 0xd5,
+	0x4e, 0xad, 0xab, 0xdf, 0x2c, 0x74, 0xa0, 0xbb, 0x7a, 0x41, 0x24, 0xa4, 0x24, 0x7b, 0xb7, 0x3e,
+	0x24, 0x3f, 0x5b, 0xc6, 0xae, 0x2f, 0x63, 0x70, 0x79, 0x18, 0xf9, 0x01, 0xbc, 0x92, 0x44, 0x26,
+	0x62, 0x73, 0xfa, 0x4f, 0x11, 0x8e, 0xca, 0xc3, 0xd7, 0xc8, 0xef, 0xaf, 0x9f, 0x14, 0x4c, 0x6c,
+	0xd4, 0x02, 0x46, 0x16, 0x01, 0x50, 0x4d, 0xb7, 0x3d, 0x2f, 0xc2, 0xdb, 0x38, 0x1e, 0x3f, 0xfb,
+	0xfd, 0x72, 0x68, 0xbd, 0xbd, 0x1c, 0x5a, 0x7f, 0x5d, 0x0e, 0xad, 0x1f, 0xaf, 0x86, 0x5b, 0x6f,
+	0xaf, 0x86, 0x5b, 0x7f, 0x5e, 0x0d, 0xb7, 0xce, 0x3e, 0xf3, 0x7c, 0xb9, 0x4c, 0x16, 0x13, 0x97,
+	0x87, 0xfa, 0x63, 0xff, 0x34, 0xff, 0xee, 0x33, 0x4e, 0x61, 0xfa, 0xa6, 0xf2, 0x8f, 0x21, 0x8b,
+	0x40, 0x2c, 0x9a, 0xfa, 0xbb, 0xff, 0xc5, 0x3f, 0x03, 0x00, 0xa9, 0xd5, 0xce, 0xa0, 0xa4, 0x08,
+	0x00, 0x00,
 }
 
 func (m *EventSystemContractUpdated) Marshal() (dAtA []byte, err error) {
@@ -1216,6 +1288,60 @@ func (m *EventGatewayContractUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPrecompileStatusUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPrecompileStatusUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPrecompileStatusUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PrecompileAddress) > 0 {
+		i -= len(m.PrecompileAddress)
+		copy(dAtA[i:], m.PrecompileAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PrecompileAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1473,6 +1599,30 @@ func (m *EventGatewayContractUpdated) Size() (n int) {
 	return n
 }
 
+func (m *EventPrecompileStatusUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PrecompileAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3209,6 +3359,172 @@ func (m *EventGatewayContractUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPrecompileStatusUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPrecompileStatusUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPrecompileStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrecompileAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 // DefaultGenesis returns the default fungible genesis state
@@ -26,5 +28,19 @@ func (gs GenesisState) Validate() error {
 		foreignCoinsIndexMap[index] = struct{}{}
 	}
 
+	// Check for invalid or duplicated enabled precompiles
+	enabledPrecompilesMap := make(map[ethcommon.Address]struct{})
+
+	for _, elem := range gs.EnabledPrecompiles {
+		if !ethcommon.IsHexAddress(elem) {
+			return fmt.Errorf("invalid enabled precompile address %s", elem)
+		}
+		address := ethcommon.HexToAddress(elem)
+		if _, ok := enabledPrecompilesMap[address]; ok {
+			return fmt.Errorf("duplicated enabled precompile %s", elem)
+		}
+		enabledPrecompilesMap[address] = struct{}{}
+	}
+
 	return nil
 }
@@ -31,6 +31,10 @@ func TestGenesisState_Validate(t *testing.T) {
 						Zrc20ContractAddress: "1",
 					},
 				},
+				EnabledPrecompiles: []string{
+					"0x0000000000000000000000000000000000000065",
+					"0x0000000000000000000000000000000000000066",
+				},
 			},
 			valid: true,
 		},
@@ -48,6 +52,23 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid enabled precompile",
+			genState: &types.GenesisState{
+				EnabledPrecompiles: []string{"invalid"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated enabled precompiles",
+			genState: &types.GenesisState{
+				EnabledPrecompiles: []string{
+					"0x0000000000000000000000000000000000000065",
+					"0x0000000000000000000000000000000000000065",
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -33,4 +33,7 @@ var (
 
 const (
 	SystemContractKey = "SystemContract-value-"
+
+	// EnabledPrecompileKey is the prefix to store the stateful precompiled contracts enabled on ZEVM
+	EnabledPrecompileKey = "EnabledPrecompile-value-"
 )
@@ -0,0 +1,59 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const TypeMsgUpdatePrecompileStatus = "update_precompile_status"
+
+var _ sdk.Msg = &MsgUpdatePrecompileStatus{}
+
+func NewMsgUpdatePrecompileStatus(creator string, precompileAddress string, enabled bool) *MsgUpdatePrecompileStatus {
+	return &MsgUpdatePrecompileStatus{
+		Creator:           creator,
+		PrecompileAddress: precompileAddress,
+		Enabled:           enabled,
+	}
+}
+
+func (msg *MsgUpdatePrecompileStatus) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdatePrecompileStatus) Type() string {
+	return TypeMsgUpdatePrecompileStatus
+}
+
+func (msg *MsgUpdatePrecompileStatus) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdatePrecompileStatus) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdatePrecompileStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// check if the precompile address is valid
+	if !ethcommon.IsHexAddress(msg.PrecompileAddress) ||
+		ethcommon.HexToAddress(msg.PrecompileAddress) == (ethcommon.Address{}) {
+		return cosmoserrors.Wrapf(
+			sdkerrors.ErrInvalidAddress,
+			"invalid precompile address (%s)",
+			msg.PrecompileAddress,
+		)
+	}
+
+	return nil
+}
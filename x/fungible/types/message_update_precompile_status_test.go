@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestMsgUpdatePrecompileStatus_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdatePrecompileStatus
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdatePrecompileStatus("invalid_address", sample.EthAddress().String(), true),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid precompile address",
+			msg:  types.NewMsgUpdatePrecompileStatus(sample.AccAddress(), "invalid_address", true),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero precompile address",
+			msg: types.NewMsgUpdatePrecompileStatus(
+				sample.AccAddress(),
+				"0x0000000000000000000000000000000000000000",
+				true,
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdatePrecompileStatus(sample.AccAddress(), sample.EthAddress().String(), false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdatePrecompileStatus_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgUpdatePrecompileStatus
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgUpdatePrecompileStatus{
+				Creator: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgUpdatePrecompileStatus{
+				Creator: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdatePrecompileStatus_Type(t *testing.T) {
+	msg := types.MsgUpdatePrecompileStatus{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUpdatePrecompileStatus, msg.Type())
+}
+
+func TestMsgUpdatePrecompileStatus_Route(t *testing.T) {
+	msg := types.MsgUpdatePrecompileStatus{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdatePrecompileStatus_GetSignBytes(t *testing.T) {
+	msg := types.MsgUpdatePrecompileStatus{
+		Creator: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
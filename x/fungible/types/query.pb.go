@@ -701,6 +701,86 @@ func (m *QueryCodeHashResponse) GetCodeHash() string {
 	return ""
 }
 
+type QueryActivePrecompilesRequest struct {
+}
+
+func (m *QueryActivePrecompilesRequest) Reset()         { *m = QueryActivePrecompilesRequest{} }
+func (m *QueryActivePrecompilesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActivePrecompilesRequest) ProtoMessage()    {}
+func (*QueryActivePrecompilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd9a7c9e94d3c90, []int{14}
+}
+func (m *QueryActivePrecompilesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActivePrecompilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActivePrecompilesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActivePrecompilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActivePrecompilesRequest.Merge(m, src)
+}
+func (m *QueryActivePrecompilesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActivePrecompilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActivePrecompilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActivePrecompilesRequest proto.InternalMessageInfo
+
+type QueryActivePrecompilesResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryActivePrecompilesResponse) Reset()         { *m = QueryActivePrecompilesResponse{} }
+func (m *QueryActivePrecompilesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActivePrecompilesResponse) ProtoMessage()    {}
+func (*QueryActivePrecompilesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd9a7c9e94d3c90, []int{15}
+}
+func (m *QueryActivePrecompilesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActivePrecompilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActivePrecompilesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActivePrecompilesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActivePrecompilesResponse.Merge(m, src)
+}
+func (m *QueryActivePrecompilesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActivePrecompilesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActivePrecompilesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActivePrecompilesResponse proto.InternalMessageInfo
+
+func (m *QueryActivePrecompilesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetForeignCoinsRequest)(nil), "zetachain.zetacore.fungible.QueryGetForeignCoinsRequest")
 	proto.RegisterType((*QueryGetForeignCoinsResponse)(nil), "zetachain.zetacore.fungible.QueryGetForeignCoinsResponse")
@@ -717,6 +797,8 @@ func init() {
 	proto.RegisterType((*QueryAllGasStabilityPoolBalanceResponse_Balance)(nil), "zetachain.zetacore.fungible.QueryAllGasStabilityPoolBalanceResponse.Balance")
 	proto.RegisterType((*QueryCodeHashRequest)(nil), "zetachain.zetacore.fungible.QueryCodeHashRequest")
 	proto.RegisterType((*QueryCodeHashResponse)(nil), "zetachain.zetacore.fungible.QueryCodeHashResponse")
+	proto.RegisterType((*QueryActivePrecompilesRequest)(nil), "zetachain.zetacore.fungible.QueryActivePrecompilesRequest")
+	proto.RegisterType((*QueryActivePrecompilesResponse)(nil), "zetachain.zetacore.fungible.QueryActivePrecompilesResponse")
 }
 
 func init() {
@@ -724,63 +806,67 @@ func init() {
 }
 
 var fileDescriptor_9cd9a7c9e94d3c90 = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xd1, 0x4e, 0xdb, 0x48,
-	0x14, 0x8d, 0x61, 0xd9, 0x84, 0x81, 0x65, 0xa5, 0x11, 0x2b, 0x58, 0xc3, 0x26, 0xbb, 0x16, 0x0b,
-	0x6c, 0x60, 0x3d, 0x24, 0xac, 0xb4, 0x2c, 0x1b, 0x55, 0x4d, 0xd2, 0x42, 0x2b, 0xf5, 0x81, 0x86,
-	0xa7, 0xf6, 0x25, 0x9a, 0xd8, 0x83, 0x63, 0xc9, 0xf1, 0x84, 0x8c, 0x13, 0x91, 0x46, 0x48, 0x55,
-	0xbf, 0xa0, 0x52, 0x3f, 0xa1, 0x3f, 0x50, 0xf5, 0xa5, 0x2f, 0xfd, 0x00, 0x1e, 0x91, 0x2a, 0x55,
-	0xed, 0x4b, 0xd5, 0x86, 0x4a, 0xfd, 0x8c, 0x56, 0x19, 0x8f, 0x4d, 0x12, 0xec, 0x24, 0x0d, 0x4f,
-	0xf1, 0x8c, 0xef, 0xb9, 0xf7, 0x9c, 0x7b, 0x67, 0x8e, 0x03, 0xd6, 0x1e, 0x11, 0x07, 0x6b, 0x65,
-	0x6c, 0xda, 0x88, 0x3f, 0xd1, 0x1a, 0x41, 0x47, 0x75, 0xdb, 0x30, 0x4b, 0x16, 0x41, 0xc7, 0x75,
-	0x52, 0x6b, 0xaa, 0xd5, 0x1a, 0x75, 0x28, 0x5c, 0xf2, 0x03, 0x55, 0x2f, 0x50, 0xf5, 0x02, 0xe5,
-	0xa4, 0x46, 0x59, 0x85, 0x32, 0x54, 0xc2, 0x4c, 0xa0, 0x50, 0x23, 0x55, 0x22, 0x0e, 0x4e, 0xa1,
-	0x2a, 0x36, 0x4c, 0x1b, 0x3b, 0x26, 0xb5, 0xdd, 0x44, 0x32, 0x1a, 0x54, 0xf1, 0x88, 0xd6, 0x88,
-	0x69, 0xd8, 0x45, 0x8d, 0x9a, 0x36, 0x13, 0x80, 0xd4, 0x20, 0x00, 0x6b, 0x32, 0x87, 0x54, 0x8a,
-	0x1a, 0xb5, 0x9d, 0x1a, 0xd6, 0x1c, 0x01, 0x99, 0x37, 0xa8, 0x41, 0xf9, 0x23, 0xea, 0x3c, 0x89,
-	0xdd, 0x65, 0x83, 0x52, 0xc3, 0x22, 0x08, 0x57, 0x4d, 0x84, 0x6d, 0x9b, 0x3a, 0x9c, 0x96, 0x57,
-	0x66, 0x41, 0x68, 0xa8, 0x30, 0x03, 0x35, 0x52, 0x9d, 0x1f, 0xf7, 0x85, 0xb2, 0x0d, 0x96, 0xee,
-	0x77, 0x24, 0xed, 0x13, 0x67, 0xcf, 0xa5, 0x97, 0xef, 0xb0, 0x2b, 0x90, 0xe3, 0x3a, 0x61, 0x0e,
-	0x9c, 0x07, 0x53, 0xa6, 0xad, 0x93, 0x93, 0x45, 0xe9, 0x77, 0x69, 0x7d, 0xba, 0xe0, 0x2e, 0x14,
-	0x06, 0x96, 0x83, 0x41, 0xac, 0x4a, 0x6d, 0x46, 0xe0, 0x21, 0x98, 0x3d, 0xea, 0xda, 0xe7, 0xe0,
-	0x99, 0xf4, 0x5f, 0xea, 0x80, 0x2e, 0xab, 0xdd, 0x89, 0x72, 0x3f, 0x9c, 0x7d, 0x48, 0x44, 0x0a,
-	0x3d, 0x49, 0x14, 0x22, 0x98, 0x66, 0x2d, 0x2b, 0x88, 0xe9, 0x1e, 0x00, 0x97, 0xd3, 0x10, 0x15,
-	0x57, 0x55, 0x57, 0xb6, 0xda, 0x19, 0x9d, 0xea, 0x0e, 0x5c, 0x8c, 0x4e, 0x3d, 0xc0, 0x06, 0x11,
-	0xd8, 0x42, 0x17, 0x52, 0x79, 0x2d, 0x09, 0x71, 0x57, 0xea, 0x84, 0x8a, 0x9b, 0xbc, 0xb6, 0x38,
-	0xb8, 0xdf, 0xc3, 0x7e, 0x82, 0xb3, 0x5f, 0x1b, 0xca, 0xde, 0x65, 0xd4, 0x43, 0x3f, 0x01, 0x7e,
-	0xf3, 0x46, 0x73, 0xc8, 0x4f, 0x4f, 0x5e, 0x1c, 0x1e, 0xa1, 0x55, 0x69, 0x81, 0x78, 0x58, 0x80,
-	0x10, 0xf8, 0x00, 0xcc, 0xf5, 0xbe, 0x11, 0xdd, 0xdc, 0x18, 0x28, 0xb1, 0x17, 0x22, 0x44, 0xf6,
-	0x25, 0x52, 0xfe, 0x00, 0x09, 0xaf, 0xf8, 0x3e, 0x66, 0x87, 0x0e, 0x2e, 0x99, 0x96, 0xe9, 0x34,
-	0x0f, 0x28, 0xb5, 0xb2, 0xba, 0x5e, 0x23, 0x8c, 0x29, 0xc7, 0x60, 0x6d, 0x48, 0x88, 0x4f, 0xf4,
-	0x4f, 0x30, 0xe7, 0x76, 0xa8, 0x88, 0xdd, 0x37, 0xe2, 0x94, 0xfe, 0xe4, 0xee, 0x8a, 0x70, 0x98,
-	0x00, 0x33, 0xa4, 0x51, 0xf1, 0x63, 0x26, 0x78, 0x0c, 0x20, 0x8d, 0x8a, 0x57, 0x32, 0x13, 0xce,
-	0x2a, 0x87, 0x2d, 0x6c, 0x6b, 0x04, 0xfe, 0x0a, 0x62, 0x5c, 0x78, 0xd1, 0xd4, 0x79, 0x91, 0xc9,
-	0x42, 0x94, 0xaf, 0xef, 0xea, 0x4a, 0x3e, 0x9c, 0xb0, 0x40, 0xfb, 0x84, 0x17, 0x41, 0xb4, 0xe4,
-	0x6e, 0x09, 0x16, 0xde, 0xd2, 0x6f, 0x4c, 0xd6, 0xb2, 0x42, 0x92, 0x28, 0xef, 0x25, 0x51, 0x28,
-	0x3c, 0xc6, 0x2f, 0x64, 0x83, 0x98, 0xc8, 0xec, 0x9d, 0xcf, 0x7b, 0x03, 0x87, 0x37, 0x62, 0x5e,
-	0x55, 0xac, 0xc5, 0x74, 0xfd, 0x1a, 0xf2, 0x0d, 0x10, 0x1d, 0xde, 0xa9, 0x01, 0xf2, 0xb7, 0xc0,
-	0x3c, 0xa7, 0x90, 0xa7, 0x3a, 0xb9, 0x83, 0x59, 0xd9, 0xbb, 0xd4, 0x8b, 0x20, 0xda, 0x3b, 0x5a,
-	0x6f, 0xa9, 0xfc, 0x03, 0x7e, 0xe9, 0x43, 0x08, 0xe9, 0x4b, 0x60, 0x5a, 0xa3, 0x3a, 0x29, 0x96,
-	0x31, 0x2b, 0x0b, 0x50, 0x4c, 0x13, 0x41, 0xe9, 0xaf, 0x00, 0x4c, 0x71, 0x18, 0x7c, 0x25, 0x81,
-	0xd9, 0xee, 0x5b, 0x09, 0x77, 0x86, 0x37, 0x28, 0xd8, 0x23, 0xe5, 0xff, 0xc6, 0x40, 0xba, 0x64,
-	0x95, 0xf4, 0x93, 0x37, 0x9f, 0x9f, 0x4d, 0x6c, 0xc2, 0x24, 0x37, 0xff, 0xbf, 0xdd, 0xef, 0x40,
-	0xf0, 0xf7, 0x02, 0xb5, 0xb8, 0xf7, 0x9e, 0xc2, 0x97, 0x12, 0xf8, 0xb9, 0x3b, 0x59, 0xd6, 0xb2,
-	0x46, 0x21, 0x1f, 0x6c, 0x9b, 0xa3, 0x90, 0x0f, 0x31, 0x42, 0x25, 0xc9, 0xc9, 0xaf, 0x40, 0x65,
-	0x38, 0xf9, 0x4e, 0xbb, 0xfb, 0xbc, 0x00, 0xee, 0x8e, 0xd4, 0xb6, 0x40, 0x13, 0x93, 0xff, 0x1f,
-	0x0b, 0x2b, 0x78, 0x6f, 0x72, 0xde, 0xab, 0x70, 0x25, 0x90, 0x77, 0xdf, 0x37, 0x17, 0xbe, 0x95,
-	0xc0, 0x42, 0x88, 0x11, 0xc1, 0xcc, 0x48, 0x34, 0x42, 0xd0, 0xf2, 0xad, 0xeb, 0xa0, 0x7d, 0x35,
-	0xff, 0x72, 0x35, 0x29, 0x88, 0x02, 0xd5, 0x18, 0x98, 0x15, 0x99, 0x07, 0x2f, 0x56, 0x29, 0xb5,
-	0x3c, 0x1f, 0x84, 0x9f, 0x02, 0x84, 0x79, 0x97, 0x78, 0x3c, 0x61, 0x02, 0x3d, 0xa6, 0xb0, 0x3e,
-	0xaf, 0x51, 0x72, 0x5c, 0x58, 0x06, 0xee, 0x8e, 0x2a, 0x4c, 0x98, 0x09, 0x6a, 0x79, 0xfe, 0x73,
-	0x0a, 0xdb, 0x12, 0x90, 0x43, 0xea, 0x74, 0xae, 0x4d, 0xe6, 0x3a, 0xa6, 0x38, 0x8a, 0xcc, 0xe1,
-	0x96, 0xaa, 0xdc, 0xe4, 0x32, 0x77, 0xe1, 0x4e, 0xb7, 0xcc, 0xab, 0x7f, 0x05, 0xc3, 0xf5, 0xc2,
-	0xe7, 0x12, 0x88, 0x79, 0x36, 0x08, 0x53, 0xc3, 0x49, 0xf5, 0x99, 0xac, 0x9c, 0xfe, 0x1e, 0x88,
-	0x60, 0xbd, 0xc5, 0x59, 0x27, 0xe1, 0x7a, 0xe0, 0x70, 0x7c, 0x03, 0x46, 0x2d, 0x71, 0xda, 0x4e,
-	0xe5, 0xa9, 0xc7, 0x5f, 0x5e, 0x24, 0xa5, 0xdc, 0xed, 0xb3, 0x76, 0x5c, 0x3a, 0x6f, 0xc7, 0xa5,
-	0x8f, 0xed, 0xb8, 0xf4, 0xf4, 0x22, 0x1e, 0x39, 0xbf, 0x88, 0x47, 0xde, 0x5d, 0xc4, 0x23, 0x0f,
-	0x37, 0x0c, 0xd3, 0x29, 0xd7, 0x4b, 0xaa, 0x46, 0x2b, 0xdd, 0x49, 0x6d, 0xaa, 0x13, 0x74, 0x72,
-	0x99, 0xdb, 0x69, 0x56, 0x09, 0x2b, 0xfd, 0xc8, 0xff, 0xbd, 0x6e, 0x7f, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0x8f, 0x9d, 0x05, 0x74, 0xe2, 0x0b, 0x00, 0x00,
+	// 952 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa6, 0x84, 0x24, 0xaf, 0xa1, 0x88, 0x51, 0x50, 0xc3, 0x26, 0x38, 0xb0, 0x2a, 0x75,
+	0xeb, 0x96, 0x9d, 0x3a, 0x45, 0xa2, 0x4d, 0xa3, 0x0a, 0x27, 0xa1, 0x01, 0x89, 0x43, 0x70, 0x4e,
+	0x70, 0xb1, 0xc6, 0xbb, 0x93, 0xf5, 0x4a, 0xeb, 0x9d, 0x8d, 0x67, 0x63, 0x35, 0x44, 0x91, 0x10,
+	0xbf, 0x00, 0x89, 0x9f, 0xc0, 0x1f, 0x40, 0x5c, 0xb8, 0x80, 0xb8, 0xf6, 0x58, 0x09, 0x09, 0xc1,
+	0x05, 0x41, 0x82, 0xc4, 0xdf, 0x40, 0x9e, 0x7d, 0xb3, 0xb1, 0xdd, 0x5d, 0xaf, 0x6b, 0x9f, 0xbc,
+	0x33, 0xfb, 0xbe, 0xf7, 0xbe, 0xef, 0xbd, 0xd9, 0x6f, 0x0c, 0xe5, 0xaf, 0x78, 0xcc, 0x9c, 0x16,
+	0xf3, 0x43, 0xaa, 0x9e, 0x44, 0x87, 0xd3, 0xc3, 0xe3, 0xd0, 0xf3, 0x9b, 0x01, 0xa7, 0x47, 0xc7,
+	0xbc, 0x73, 0x62, 0x47, 0x1d, 0x11, 0x0b, 0xb2, 0x9a, 0x06, 0xda, 0x3a, 0xd0, 0xd6, 0x81, 0x66,
+	0xc5, 0x11, 0xb2, 0x2d, 0x24, 0x6d, 0x32, 0x89, 0x28, 0xda, 0xad, 0x36, 0x79, 0xcc, 0xaa, 0x34,
+	0x62, 0x9e, 0x1f, 0xb2, 0xd8, 0x17, 0x61, 0x92, 0xc8, 0xa4, 0xa3, 0x2a, 0x1e, 0x8a, 0x0e, 0xf7,
+	0xbd, 0xb0, 0xe1, 0x08, 0x3f, 0x94, 0x08, 0xa8, 0x8e, 0x02, 0xc8, 0x13, 0x19, 0xf3, 0x76, 0xc3,
+	0x11, 0x61, 0xdc, 0x61, 0x4e, 0x8c, 0x90, 0x65, 0x4f, 0x78, 0x42, 0x3d, 0xd2, 0xde, 0x13, 0xee,
+	0xae, 0x79, 0x42, 0x78, 0x01, 0xa7, 0x2c, 0xf2, 0x29, 0x0b, 0x43, 0x11, 0x2b, 0x5a, 0xba, 0xcc,
+	0x75, 0xd4, 0xd0, 0x96, 0x1e, 0xed, 0x56, 0x7b, 0x3f, 0xc9, 0x0b, 0xeb, 0x3e, 0xac, 0x7e, 0xde,
+	0x93, 0xb4, 0xc7, 0xe3, 0x27, 0x09, 0xbd, 0x9d, 0x1e, 0xbb, 0x3a, 0x3f, 0x3a, 0xe6, 0x32, 0x26,
+	0xcb, 0x30, 0xe7, 0x87, 0x2e, 0x7f, 0xba, 0x62, 0xbc, 0x63, 0xdc, 0x5a, 0xac, 0x27, 0x0b, 0x4b,
+	0xc2, 0x5a, 0x36, 0x48, 0x46, 0x22, 0x94, 0x9c, 0x1c, 0xc0, 0xd2, 0x61, 0xdf, 0xbe, 0x02, 0x5f,
+	0xdd, 0xb8, 0x6d, 0x8f, 0xe8, 0xb2, 0xdd, 0x9f, 0x68, 0xfb, 0x95, 0x67, 0x7f, 0xad, 0xcf, 0xd4,
+	0x07, 0x92, 0x58, 0x1c, 0x99, 0xd6, 0x82, 0x20, 0x8b, 0xe9, 0x13, 0x80, 0xcb, 0x69, 0x60, 0xc5,
+	0x9b, 0x76, 0x22, 0xdb, 0xee, 0x8d, 0xce, 0x4e, 0x06, 0x8e, 0xa3, 0xb3, 0xf7, 0x99, 0xc7, 0x11,
+	0x5b, 0xef, 0x43, 0x5a, 0x3f, 0x1b, 0xb0, 0x96, 0x5d, 0x27, 0x57, 0xdc, 0x95, 0xa9, 0xc5, 0x91,
+	0xbd, 0x01, 0xf6, 0xb3, 0x8a, 0x7d, 0xb9, 0x90, 0x7d, 0xc2, 0x68, 0x80, 0xfe, 0x3a, 0xbc, 0xad,
+	0x47, 0x73, 0xa0, 0x4e, 0xcf, 0x0e, 0x1e, 0x1e, 0xd4, 0x6a, 0x9d, 0x42, 0x29, 0x2f, 0x00, 0x05,
+	0x7e, 0x01, 0xd7, 0x06, 0xdf, 0x60, 0x37, 0xef, 0x8c, 0x94, 0x38, 0x08, 0x41, 0x91, 0x43, 0x89,
+	0xac, 0x77, 0x61, 0x5d, 0x17, 0xdf, 0x63, 0xf2, 0x20, 0x66, 0x4d, 0x3f, 0xf0, 0xe3, 0x93, 0x7d,
+	0x21, 0x82, 0x9a, 0xeb, 0x76, 0xb8, 0x94, 0xd6, 0x11, 0x94, 0x0b, 0x42, 0x52, 0xa2, 0xef, 0xc1,
+	0xb5, 0xa4, 0x43, 0x0d, 0x96, 0xbc, 0xc1, 0x53, 0xfa, 0x5a, 0xb2, 0x8b, 0xe1, 0x64, 0x1d, 0xae,
+	0xf2, 0x6e, 0x3b, 0x8d, 0x99, 0x55, 0x31, 0xc0, 0xbb, 0x6d, 0x5d, 0x72, 0x2b, 0x9f, 0xd5, 0x36,
+	0x0b, 0x58, 0xe8, 0x70, 0xf2, 0x16, 0x2c, 0x28, 0xe1, 0x0d, 0xdf, 0x55, 0x45, 0xae, 0xd4, 0xe7,
+	0xd5, 0xfa, 0x53, 0xd7, 0xda, 0x81, 0x72, 0x01, 0x3a, 0x25, 0xbc, 0x02, 0xf3, 0xcd, 0x64, 0x0b,
+	0x59, 0xe8, 0x65, 0xda, 0x98, 0x5a, 0x10, 0xe4, 0x24, 0xb1, 0xfe, 0x34, 0xa0, 0x5c, 0x10, 0x93,
+	0x16, 0x0a, 0x61, 0x01, 0x33, 0xeb, 0xf3, 0xf9, 0xd9, 0xc8, 0xe1, 0x8d, 0x99, 0xd7, 0xc6, 0x35,
+	0x4e, 0x37, 0xad, 0x61, 0x3e, 0x86, 0xf9, 0xe2, 0x4e, 0x8d, 0x90, 0x7f, 0x0f, 0x96, 0x15, 0x85,
+	0x1d, 0xe1, 0xf2, 0x4f, 0x98, 0x6c, 0xe9, 0x8f, 0x7a, 0x05, 0xe6, 0x07, 0x47, 0xab, 0x97, 0xd6,
+	0x07, 0xf0, 0xe6, 0x10, 0x02, 0xa5, 0xaf, 0xc2, 0xa2, 0x23, 0x5c, 0xde, 0x68, 0x31, 0xd9, 0x42,
+	0xd0, 0x82, 0x83, 0x41, 0xe9, 0xd7, 0x51, 0x73, 0x62, 0xbf, 0xcb, 0xf7, 0x3b, 0xdc, 0x11, 0xed,
+	0xc8, 0x0f, 0xb8, 0x76, 0x11, 0xeb, 0x31, 0x94, 0xf2, 0x02, 0x30, 0xff, 0x1a, 0x2c, 0x22, 0x07,
+	0xec, 0xed, 0x62, 0xfd, 0x72, 0x63, 0xe3, 0xd7, 0x25, 0x98, 0x53, 0x09, 0xc8, 0x4f, 0x06, 0x2c,
+	0xf5, 0x7f, 0xf6, 0xe4, 0x41, 0xf1, 0x04, 0xb2, 0x4d, 0xd8, 0x7c, 0x38, 0x01, 0x32, 0x61, 0x6b,
+	0x6d, 0x7c, 0xf3, 0xdb, 0xbf, 0xdf, 0xcd, 0xde, 0x25, 0x15, 0x75, 0xbb, 0xbc, 0x9f, 0x5c, 0x34,
+	0xd9, 0x17, 0x12, 0x3d, 0x55, 0xe6, 0x7e, 0x46, 0x7e, 0x34, 0xe0, 0xf5, 0xfe, 0x64, 0xb5, 0x20,
+	0x18, 0x87, 0x7c, 0xb6, 0x2f, 0x9b, 0x0f, 0x27, 0x40, 0x22, 0xf9, 0x8a, 0x22, 0x7f, 0x83, 0x58,
+	0xc5, 0xe4, 0x7b, 0xed, 0x1e, 0x32, 0x1b, 0xb2, 0x39, 0x56, 0xdb, 0x32, 0x5d, 0xd2, 0x7c, 0x34,
+	0x11, 0x16, 0x79, 0xdf, 0x55, 0xbc, 0x6f, 0x92, 0x1b, 0x99, 0xbc, 0x87, 0x2e, 0x75, 0xf2, 0xbb,
+	0x01, 0xd7, 0x73, 0x9c, 0x8e, 0x6c, 0x8d, 0x45, 0x23, 0x07, 0x6d, 0xee, 0x4e, 0x83, 0x4e, 0xd5,
+	0x7c, 0xa8, 0xd4, 0x54, 0x09, 0xcd, 0x54, 0xe3, 0x31, 0xd9, 0x90, 0x1a, 0xde, 0x88, 0x84, 0x08,
+	0xb4, 0xd1, 0x92, 0x7f, 0x32, 0x84, 0x69, 0x97, 0x98, 0x4c, 0x18, 0xa2, 0xcd, 0xdd, 0x69, 0xd0,
+	0xa9, 0xb0, 0x6d, 0x25, 0x6c, 0x8b, 0x6c, 0x8e, 0x2b, 0x0c, 0xdd, 0x8a, 0x9e, 0x6a, 0x83, 0x3b,
+	0x23, 0xe7, 0x06, 0x98, 0x39, 0x75, 0x7a, 0x9f, 0xcd, 0xd6, 0x34, 0xae, 0x6b, 0xee, 0x4e, 0x83,
+	0x4e, 0x65, 0x7e, 0xa4, 0x64, 0x6e, 0x92, 0x07, 0xfd, 0x32, 0x5f, 0xfc, 0xaf, 0x99, 0xaf, 0x97,
+	0x7c, 0x6f, 0xc0, 0x82, 0xf6, 0x59, 0x52, 0x2d, 0x26, 0x35, 0xe4, 0xe2, 0xe6, 0xc6, 0xcb, 0x40,
+	0x90, 0xf5, 0x3d, 0xc5, 0xba, 0x42, 0x6e, 0x65, 0x0e, 0x27, 0x75, 0x78, 0x7a, 0x8a, 0xa7, 0xed,
+	0x8c, 0xfc, 0x62, 0xc0, 0x1b, 0x2f, 0xd8, 0xf6, 0x38, 0x26, 0x90, 0x77, 0x19, 0x98, 0x8f, 0x26,
+	0xc2, 0xa2, 0x00, 0xaa, 0x04, 0xdc, 0x26, 0xe5, 0x4c, 0x01, 0x4c, 0xe1, 0x1a, 0xd1, 0x25, 0xd0,
+	0x9c, 0xfb, 0xfa, 0xbf, 0x1f, 0x2a, 0xc6, 0xf6, 0xc7, 0xcf, 0xce, 0x4b, 0xc6, 0xf3, 0xf3, 0x92,
+	0xf1, 0xf7, 0x79, 0xc9, 0xf8, 0xf6, 0xa2, 0x34, 0xf3, 0xfc, 0xa2, 0x34, 0xf3, 0xc7, 0x45, 0x69,
+	0xe6, 0xcb, 0x3b, 0x9e, 0x1f, 0xb7, 0x8e, 0x9b, 0xb6, 0x23, 0xda, 0xfd, 0x39, 0x43, 0xe1, 0x72,
+	0xfa, 0xf4, 0x32, 0x75, 0x7c, 0x12, 0x71, 0xd9, 0x7c, 0x55, 0xfd, 0xbd, 0xbf, 0xff, 0xff, 0x00,
+	0x4d, 0x2c, 0x8c, 0x05, 0x03, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -809,6 +895,8 @@ type QueryClient interface {
 	GasStabilityPoolBalanceAll(ctx context.Context, in *QueryAllGasStabilityPoolBalance, opts ...grpc.CallOption) (*QueryAllGasStabilityPoolBalanceResponse, error)
 	// Code hash query the code hash of a contract.
 	CodeHash(ctx context.Context, in *QueryCodeHashRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
+	// Queries the stateful precompiled contracts enabled on ZEVM.
+	ActivePrecompiles(ctx context.Context, in *QueryActivePrecompilesRequest, opts ...grpc.CallOption) (*QueryActivePrecompilesResponse, error)
 }
 
 type queryClient struct {
@@ -882,6 +970,15 @@ func (c *queryClient) CodeHash(ctx context.Context, in *QueryCodeHashRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ActivePrecompiles(ctx context.Context, in *QueryActivePrecompilesRequest, opts ...grpc.CallOption) (*QueryActivePrecompilesResponse, error) {
+	out := new(QueryActivePrecompilesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Query/ActivePrecompiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a ForeignCoins by index.
@@ -898,6 +995,8 @@ type QueryServer interface {
 	GasStabilityPoolBalanceAll(context.Context, *QueryAllGasStabilityPoolBalance) (*QueryAllGasStabilityPoolBalanceResponse, error)
 	// Code hash query the code hash of a contract.
 	CodeHash(context.Context, *QueryCodeHashRequest) (*QueryCodeHashResponse, error)
+	// Queries the stateful precompiled contracts enabled on ZEVM.
+	ActivePrecompiles(context.Context, *QueryActivePrecompilesRequest) (*QueryActivePrecompilesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -925,6 +1024,9 @@ func (*UnimplementedQueryServer) GasStabilityPoolBalanceAll(ctx context.Context,
 func (*UnimplementedQueryServer) CodeHash(ctx context.Context, req *QueryCodeHashRequest) (*QueryCodeHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CodeHash not implemented")
 }
+func (*UnimplementedQueryServer) ActivePrecompiles(ctx context.Context, req *QueryActivePrecompilesRequest) (*QueryActivePrecompilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivePrecompiles not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1056,6 +1158,24 @@ func _Query_CodeHash_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ActivePrecompiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivePrecompilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActivePrecompiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.fungible.Query/ActivePrecompiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActivePrecompiles(ctx, req.(*QueryActivePrecompilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.fungible.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1088,6 +1208,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CodeHash",
 			Handler:    _Query_CodeHash_Handler,
 		},
+		{
+			MethodName: "ActivePrecompiles",
+			Handler:    _Query_ActivePrecompiles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/fungible/query.proto",
@@ -1569,6 +1693,61 @@ func (m *QueryCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryActivePrecompilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivePrecompilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivePrecompilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivePrecompilesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivePrecompilesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivePrecompilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1773,6 +1952,30 @@ func (m *QueryCodeHashResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryActivePrecompilesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivePrecompilesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2997,6 +3200,138 @@ func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryActivePrecompilesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivePrecompilesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivePrecompilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivePrecompilesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivePrecompilesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivePrecompilesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
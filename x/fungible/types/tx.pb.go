@@ -1115,6 +1115,102 @@ func (m *MsgUpdateZRC20NameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateZRC20NameResponse proto.InternalMessageInfo
 
+type MsgUpdatePrecompileStatus struct {
+	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PrecompileAddress string `protobuf:"bytes,2,opt,name=precompile_address,json=precompileAddress,proto3" json:"precompile_address,omitempty"`
+	Enabled           bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgUpdatePrecompileStatus) Reset()         { *m = MsgUpdatePrecompileStatus{} }
+func (m *MsgUpdatePrecompileStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrecompileStatus) ProtoMessage()    {}
+func (*MsgUpdatePrecompileStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bea9688d1d01113, []int{22}
+}
+func (m *MsgUpdatePrecompileStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrecompileStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrecompileStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrecompileStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrecompileStatus.Merge(m, src)
+}
+func (m *MsgUpdatePrecompileStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrecompileStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrecompileStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePrecompileStatus proto.InternalMessageInfo
+
+func (m *MsgUpdatePrecompileStatus) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdatePrecompileStatus) GetPrecompileAddress() string {
+	if m != nil {
+		return m.PrecompileAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdatePrecompileStatus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgUpdatePrecompileStatusResponse struct {
+}
+
+func (m *MsgUpdatePrecompileStatusResponse) Reset()         { *m = MsgUpdatePrecompileStatusResponse{} }
+func (m *MsgUpdatePrecompileStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrecompileStatusResponse) ProtoMessage()    {}
+func (*MsgUpdatePrecompileStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bea9688d1d01113, []int{23}
+}
+func (m *MsgUpdatePrecompileStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrecompileStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrecompileStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrecompileStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrecompileStatusResponse.Merge(m, src)
+}
+func (m *MsgUpdatePrecompileStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrecompileStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrecompileStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePrecompileStatusResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeploySystemContracts)(nil), "zetachain.zetacore.fungible.MsgDeploySystemContracts")
 	proto.RegisterType((*MsgDeploySystemContractsResponse)(nil), "zetachain.zetacore.fungible.MsgDeploySystemContractsResponse")
@@ -1138,6 +1234,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateGatewayContractResponse)(nil), "zetachain.zetacore.fungible.MsgUpdateGatewayContractResponse")
 	proto.RegisterType((*MsgUpdateZRC20Name)(nil), "zetachain.zetacore.fungible.MsgUpdateZRC20Name")
 	proto.RegisterType((*MsgUpdateZRC20NameResponse)(nil), "zetachain.zetacore.fungible.MsgUpdateZRC20NameResponse")
+	proto.RegisterType((*MsgUpdatePrecompileStatus)(nil), "zetachain.zetacore.fungible.MsgUpdatePrecompileStatus")
+	proto.RegisterType((*MsgUpdatePrecompileStatusResponse)(nil), "zetachain.zetacore.fungible.MsgUpdatePrecompileStatusResponse")
 }
 
 func init() {
@@ -1145,79 +1243,83 @@ func init() {
 }
 
 var fileDescriptor_7bea9688d1d01113 = []byte{
-	// 1152 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xd6, 0xf9, 0xf9, 0x1a, 0x27, 0xf9, 0x8e, 0xd2, 0x64, 0xb3, 0x89, 0x9c, 0xd6, 0xcd,
-	0xb7, 0x84, 0x00, 0x76, 0x6b, 0x5a, 0x22, 0x10, 0x6d, 0x85, 0x4d, 0xd2, 0x22, 0xd5, 0x08, 0x19,
-	0x5a, 0xa4, 0x5c, 0xcc, 0x64, 0x77, 0xb2, 0x5e, 0xc5, 0xde, 0x59, 0x76, 0xd6, 0x75, 0x5d, 0x2e,
-	0x05, 0x6e, 0x48, 0x95, 0x2a, 0xf8, 0x3f, 0x50, 0xff, 0x8c, 0x1e, 0x7b, 0x44, 0x08, 0x55, 0x28,
-	0x39, 0xf4, 0xc6, 0x81, 0x03, 0x67, 0x34, 0xb3, 0xbb, 0xd3, 0xf5, 0x7a, 0xd7, 0x76, 0x12, 0x71,
-	0x49, 0x76, 0xc6, 0xef, 0xf3, 0xe6, 0x33, 0xef, 0x7d, 0xde, 0x7b, 0xbb, 0xb0, 0xf1, 0x98, 0x78,
-	0x58, 0x6f, 0x60, 0xcb, 0x2e, 0x8a, 0x27, 0xea, 0x92, 0xe2, 0x41, 0xdb, 0x36, 0xad, 0xfd, 0x26,
-	0x29, 0x7a, 0x8f, 0x0a, 0x8e, 0x4b, 0x3d, 0x8a, 0x56, 0xa5, 0x55, 0x21, 0xb4, 0x2a, 0x84, 0x56,
-	0xda, 0xa2, 0x49, 0x4d, 0x2a, 0xec, 0x8a, 0xfc, 0xc9, 0x87, 0x68, 0x57, 0x12, 0x1c, 0x3b, 0x87,
-	0x66, 0x51, 0xa7, 0x96, 0x2d, 0xfe, 0x04, 0x76, 0xcb, 0x3a, 0x65, 0x2d, 0xca, 0x8a, 0x2d, 0x66,
-	0x16, 0x1f, 0x5e, 0xe3, 0xff, 0xfc, 0x1f, 0xf2, 0x65, 0x50, 0xab, 0xcc, 0xfc, 0x94, 0x38, 0x4d,
-	0xda, 0xfd, 0xb2, 0xcb, 0x3c, 0xd2, 0xaa, 0x50, 0xdb, 0x73, 0xb1, 0xee, 0x31, 0xa4, 0xc2, 0x94,
-	0xee, 0x12, 0xec, 0x51, 0x57, 0x55, 0x2e, 0x2a, 0x9b, 0x33, 0xb5, 0x70, 0xf9, 0xd1, 0xec, 0x0f,
-	0xaf, 0x9f, 0x6f, 0x85, 0xab, 0xfc, 0x1f, 0x0a, 0x5c, 0x4c, 0x73, 0x52, 0x23, 0xcc, 0xa1, 0x36,
-	0x23, 0x68, 0x0b, 0x16, 0xda, 0xb6, 0xc5, 0x3a, 0xd8, 0x79, 0x50, 0xda, 0xc5, 0xba, 0x47, 0xdd,
-	0x6e, 0xe0, 0xb5, 0x6f, 0x1f, 0x2d, 0xc2, 0x44, 0x87, 0x5f, 0x47, 0x3d, 0x27, 0x0c, 0xfc, 0x05,
-	0xda, 0x84, 0x79, 0x69, 0x59, 0xa3, 0x6d, 0x8f, 0xb8, 0x6a, 0x46, 0xfc, 0x1e, 0xdf, 0x46, 0x1b,
-	0x90, 0xd5, 0xa9, 0x6d, 0x13, 0xee, 0x6d, 0x6f, 0xe7, 0x41, 0x55, 0x1d, 0x17, 0x76, 0xbd, 0x9b,
-	0xe8, 0x0a, 0xcc, 0xb1, 0x1e, 0xb2, 0xea, 0x84, 0x30, 0x8b, 0xed, 0xe6, 0xff, 0x51, 0x60, 0xa5,
-	0xca, 0xcc, 0xfb, 0x8e, 0x81, 0x3d, 0xb2, 0x57, 0xab, 0x94, 0xae, 0x7e, 0x6d, 0x79, 0x0d, 0xc3,
-	0xc5, 0x9d, 0x5d, 0x42, 0xd2, 0x83, 0x84, 0x2e, 0x43, 0xf6, 0xb1, 0xab, 0x97, 0xae, 0xd6, 0xb1,
-	0x61, 0xb8, 0x84, 0xb1, 0xe0, 0x36, 0xb3, 0x62, 0xf3, 0x13, 0x7f, 0x0f, 0xdd, 0x85, 0x05, 0x9b,
-	0x74, 0xea, 0x9d, 0xc0, 0x63, 0xfd, 0x80, 0x10, 0x75, 0x92, 0xdb, 0x95, 0x73, 0x2f, 0x5e, 0xad,
-	0x8f, 0xfd, 0xfe, 0x6a, 0x7d, 0xc9, 0x4f, 0x1d, 0x33, 0x0e, 0x0b, 0x16, 0x2d, 0xb6, 0xb0, 0xd7,
-	0x28, 0xdc, 0xb7, 0x6c, 0xaf, 0x36, 0x67, 0x93, 0x4e, 0x94, 0x48, 0x19, 0xb2, 0xdc, 0x93, 0x89,
-	0x59, 0xbd, 0x69, 0xb5, 0x2c, 0x4f, 0x9d, 0x1a, 0xc9, 0xcd, 0x79, 0x9b, 0x74, 0xee, 0x60, 0x76,
-	0x8f, 0x43, 0x62, 0x79, 0xbd, 0x0c, 0x97, 0x52, 0xef, 0x1d, 0xe6, 0x35, 0xff, 0x44, 0x81, 0x65,
-	0x69, 0xd5, 0x9b, 0xfc, 0x01, 0xb1, 0xb9, 0x09, 0xab, 0x9c, 0xac, 0x1f, 0xe9, 0xba, 0x1e, 0x00,
-	0x62, 0x91, 0x52, 0x6d, 0xd2, 0xe9, 0xf5, 0x18, 0x44, 0x2d, 0xc6, 0xf3, 0x12, 0xac, 0xa7, 0x30,
-	0x90, 0x2c, 0xff, 0x3e, 0x07, 0x9a, 0x94, 0xe8, 0x6e, 0x50, 0x53, 0x15, 0x6a, 0xd9, 0xe2, 0x5e,
-	0x03, 0x88, 0x2e, 0xc2, 0xc4, 0x0e, 0x37, 0x09, 0xa5, 0x28, 0x16, 0x68, 0x13, 0x16, 0x0e, 0xa8,
-	0x4b, 0x2c, 0xd3, 0xae, 0x8b, 0xe2, 0xab, 0x5b, 0x86, 0xd0, 0x62, 0xa6, 0x36, 0x17, 0xec, 0x57,
-	0xf8, 0xf6, 0x67, 0x06, 0xd2, 0x60, 0xda, 0x20, 0xba, 0xd5, 0xc2, 0x4d, 0x26, 0x54, 0x98, 0xad,
-	0xc9, 0x35, 0x42, 0x30, 0x6e, 0xe3, 0x16, 0x09, 0x64, 0x27, 0x9e, 0xd1, 0x12, 0x4c, 0xb2, 0x6e,
-	0x6b, 0x9f, 0x36, 0x7d, 0x15, 0xd4, 0x82, 0x15, 0x2a, 0xc3, 0x0c, 0x2f, 0xe7, 0xba, 0xd7, 0x75,
-	0x88, 0xc8, 0xec, 0x5c, 0xe9, 0xff, 0x85, 0x84, 0x7e, 0xe1, 0x1c, 0x9a, 0x05, 0x51, 0xf7, 0xfc,
-	0x72, 0x5f, 0x75, 0x1d, 0x52, 0x9b, 0xd6, 0x83, 0x27, 0xb4, 0x0a, 0x33, 0x6f, 0xd4, 0x31, 0x2d,
-	0xe8, 0x4e, 0x9b, 0x41, 0xea, 0x51, 0x05, 0xb2, 0x4d, 0xeb, 0xdb, 0xb6, 0x65, 0x58, 0x5e, 0xb7,
-	0xae, 0x63, 0x47, 0x9d, 0x91, 0xf2, 0x51, 0x06, 0xc8, 0x67, 0x56, 0x82, 0x2a, 0xd8, 0x89, 0xe5,
-	0xe5, 0x16, 0xe4, 0xd3, 0x63, 0x2e, 0x1b, 0x83, 0x0a, 0x53, 0x61, 0xda, 0x83, 0xd8, 0x07, 0xcb,
-	0xbc, 0x0e, 0x8b, 0x55, 0x66, 0xd6, 0x48, 0x8b, 0x3e, 0x24, 0xbb, 0x41, 0x58, 0xa9, 0x65, 0x9f,
-	0xb1, 0xe4, 0x62, 0x24, 0x73, 0xb0, 0x96, 0x74, 0x88, 0x54, 0xce, 0xcf, 0xd1, 0xea, 0x0f, 0x75,
-	0x55, 0xee, 0x7a, 0x44, 0xa7, 0xc6, 0xa0, 0xea, 0x7f, 0x1b, 0x16, 0x52, 0x64, 0x3d, 0xaf, 0xf7,
-	0xaa, 0x19, 0xe5, 0xfd, 0xca, 0xe5, 0x0e, 0xeb, 0x0d, 0xcc, 0x1a, 0x41, 0x5b, 0xe3, 0x95, 0x59,
-	0xa1, 0x06, 0xb9, 0x8b, 0x59, 0x63, 0x40, 0x65, 0xc6, 0x39, 0x49, 0xe6, 0xbf, 0x2a, 0x42, 0xf3,
-	0x91, 0xfa, 0xbd, 0x17, 0xc9, 0xd5, 0x59, 0x1b, 0x57, 0x9f, 0x5e, 0x32, 0x23, 0xb5, 0x9b, 0x41,
-	0x7a, 0xd9, 0x10, 0x7a, 0x49, 0xe1, 0x2b, 0xaf, 0xf5, 0x0d, 0x64, 0xab, 0xcc, 0xfc, 0x02, 0xb7,
-	0x19, 0x19, 0x56, 0xbc, 0x6f, 0xc1, 0x7c, 0xcf, 0x45, 0x08, 0xbf, 0x4a, 0x86, 0xb7, 0xf8, 0xe8,
-	0x55, 0x48, 0x5c, 0x12, 0xcb, 0x70, 0xa1, 0xe7, 0x04, 0x79, 0xf4, 0x3e, 0xcc, 0x73, 0x82, 0xb6,
-	0xf3, 0x1f, 0x1e, 0xbe, 0xe2, 0xb7, 0xd3, 0xc8, 0x19, 0xf2, 0xf8, 0x1f, 0x15, 0x31, 0xac, 0xfd,
-	0x00, 0xdd, 0xc1, 0x1e, 0xe9, 0xe0, 0xee, 0x08, 0xbd, 0xf6, 0x36, 0xac, 0xf9, 0x83, 0x41, 0x00,
-	0xd2, 0x9a, 0xed, 0x8a, 0x98, 0x03, 0x3d, 0x3e, 0x93, 0x0b, 0x26, 0x2f, 0x86, 0x7d, 0x22, 0x09,
-	0xc9, 0xf4, 0xa9, 0x02, 0xa8, 0x37, 0x95, 0x9f, 0xf3, 0xe6, 0x76, 0x46, 0xc9, 0x85, 0xfd, 0x32,
-	0x93, 0xd8, 0x2f, 0xc7, 0xa3, 0xfd, 0x32, 0xc6, 0x79, 0x2d, 0x5e, 0x09, 0x9c, 0x4e, 0xc8, 0xb6,
-	0xf4, 0xd7, 0x79, 0xc8, 0x54, 0x99, 0x89, 0x9e, 0x2a, 0x70, 0x21, 0xf9, 0x4d, 0xe8, 0x46, 0x61,
-	0xc0, 0xab, 0x59, 0x21, 0xed, 0xdd, 0x47, 0xbb, 0x79, 0x2a, 0x98, 0xec, 0x8c, 0xbf, 0x28, 0xb0,
-	0x9c, 0x36, 0xb1, 0xb6, 0x47, 0x73, 0xdd, 0x07, 0xd4, 0x6e, 0x9f, 0x12, 0x28, 0x59, 0x7d, 0xaf,
-	0xc0, 0xff, 0xfa, 0x7b, 0xf2, 0xb5, 0x61, 0x6e, 0xfb, 0x20, 0xda, 0x87, 0x27, 0x86, 0x48, 0x0e,
-	0x3f, 0x29, 0xb0, 0x98, 0xf8, 0xc6, 0x71, 0x7d, 0x98, 0xcf, 0x24, 0x94, 0xf6, 0xf1, 0x69, 0x50,
-	0x92, 0xcc, 0x33, 0x05, 0x96, 0x52, 0xc6, 0xc3, 0x07, 0xa3, 0x39, 0x8e, 0xe3, 0xb4, 0x5b, 0xa7,
-	0xc3, 0x25, 0x50, 0xea, 0x7b, 0x5f, 0x1d, 0x91, 0x52, 0x1c, 0x37, 0x2a, 0xa5, 0xb4, 0xf7, 0x44,
-	0x21, 0xe6, 0xb4, 0x51, 0xb4, 0x7d, 0x02, 0xdf, 0x51, 0xe0, 0x70, 0x31, 0x0f, 0x19, 0x26, 0xa8,
-	0x09, 0x10, 0x99, 0x24, 0x5b, 0xc3, 0xdc, 0xbd, 0xb1, 0xd5, 0x4a, 0xa3, 0xdb, 0xca, 0xd3, 0x5c,
-	0x98, 0xed, 0x19, 0x1e, 0xef, 0x0e, 0xa5, 0x1f, 0xb1, 0xd6, 0xae, 0x9f, 0xc4, 0x5a, 0x9e, 0xc9,
-	0x9b, 0x5a, 0xf2, 0xc4, 0xb8, 0x31, 0x5a, 0xf0, 0x62, 0xb0, 0xe1, 0x4d, 0x6d, 0xe0, 0x68, 0x40,
-	0xdf, 0xc1, 0x7c, 0x7c, 0x2c, 0x14, 0x4f, 0x90, 0x45, 0x0e, 0xd0, 0xb6, 0x4f, 0x08, 0x08, 0x0f,
-	0xd7, 0x26, 0x9e, 0xbc, 0x7e, 0xbe, 0xa5, 0x94, 0x77, 0x5e, 0x1c, 0xe5, 0x94, 0x97, 0x47, 0x39,
-	0xe5, 0xcf, 0xa3, 0x9c, 0xf2, 0xec, 0x38, 0x37, 0xf6, 0xf2, 0x38, 0x37, 0xf6, 0xdb, 0x71, 0x6e,
-	0x6c, 0xef, 0x1d, 0xd3, 0xf2, 0x1a, 0xed, 0xfd, 0x82, 0x4e, 0x5b, 0xe2, 0x83, 0xfa, 0x3d, 0xff,
-	0xdb, 0xda, 0xa6, 0x06, 0x29, 0x3e, 0x8a, 0x7c, 0xb2, 0x77, 0x1d, 0xc2, 0xf6, 0x27, 0xc5, 0x27,
-	0xf4, 0xfb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x13, 0xe0, 0xad, 0x0d, 0xde, 0x0f, 0x00, 0x00,
+	// 1204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x6f, 0xdb, 0xd4,
+	0x17, 0xaf, 0x97, 0xf5, 0xd7, 0xf9, 0x36, 0x6d, 0x77, 0xd5, 0xb5, 0xae, 0x5b, 0xa5, 0x5d, 0xd6,
+	0xef, 0x28, 0x85, 0x25, 0x5b, 0xd8, 0xa8, 0x40, 0xb4, 0x15, 0x09, 0xed, 0x86, 0xb4, 0xa0, 0x29,
+	0x63, 0x43, 0xea, 0x4b, 0xb8, 0xb1, 0x6f, 0x1d, 0xab, 0x89, 0xaf, 0xf1, 0x75, 0x96, 0x65, 0xbc,
+	0x6c, 0xf0, 0x86, 0x98, 0x34, 0xc1, 0xff, 0x81, 0xf6, 0x67, 0xec, 0x71, 0x8f, 0x08, 0xa1, 0x09,
+	0xb5, 0x0f, 0x7b, 0xe7, 0x81, 0x67, 0xe4, 0x6b, 0xfb, 0x36, 0x76, 0xec, 0x34, 0x4d, 0xc5, 0x4b,
+	0xe2, 0x7b, 0x7d, 0x3e, 0xe7, 0x7e, 0xce, 0xef, 0x2b, 0xc3, 0xda, 0x53, 0xe2, 0x60, 0xb5, 0x8e,
+	0x0d, 0x33, 0xcf, 0x9f, 0xa8, 0x4d, 0xf2, 0x07, 0x2d, 0x53, 0x37, 0x6a, 0x0d, 0x92, 0x77, 0x9e,
+	0xe4, 0x2c, 0x9b, 0x3a, 0x14, 0x2d, 0x09, 0xa9, 0x5c, 0x20, 0x95, 0x0b, 0xa4, 0x94, 0x39, 0x9d,
+	0xea, 0x94, 0xcb, 0xe5, 0xdd, 0x27, 0x0f, 0xa2, 0x5c, 0x8b, 0x51, 0x6c, 0x1d, 0xea, 0x79, 0x95,
+	0x1a, 0x26, 0xff, 0xf1, 0xe5, 0x16, 0x54, 0xca, 0x9a, 0x94, 0xe5, 0x9b, 0x4c, 0xcf, 0x3f, 0xbe,
+	0xe9, 0xfe, 0x79, 0x2f, 0xb2, 0x45, 0x90, 0xcb, 0x4c, 0xff, 0x82, 0x58, 0x0d, 0xda, 0x79, 0xd0,
+	0x61, 0x0e, 0x69, 0x96, 0xa8, 0xe9, 0xd8, 0x58, 0x75, 0x18, 0x92, 0x61, 0x5c, 0xb5, 0x09, 0x76,
+	0xa8, 0x2d, 0x4b, 0xab, 0xd2, 0xfa, 0x64, 0x25, 0x58, 0x7e, 0x3a, 0xf5, 0xc3, 0xbb, 0x57, 0x1b,
+	0xc1, 0x2a, 0xfb, 0xa7, 0x04, 0xab, 0x49, 0x4a, 0x2a, 0x84, 0x59, 0xd4, 0x64, 0x04, 0x6d, 0xc0,
+	0x6c, 0xcb, 0x34, 0x58, 0x1b, 0x5b, 0x8f, 0x0a, 0x7b, 0x58, 0x75, 0xa8, 0xdd, 0xf1, 0xb5, 0xf6,
+	0xec, 0xa3, 0x39, 0x18, 0x6d, 0xbb, 0xe6, 0xc8, 0x17, 0xb8, 0x80, 0xb7, 0x40, 0xeb, 0x30, 0x23,
+	0x24, 0x2b, 0xb4, 0xe5, 0x10, 0x5b, 0x4e, 0xf1, 0xf7, 0xd1, 0x6d, 0xb4, 0x06, 0x69, 0x95, 0x9a,
+	0x26, 0x71, 0xb5, 0xed, 0xef, 0x3e, 0x2a, 0xcb, 0x17, 0xb9, 0x5c, 0x78, 0x13, 0x5d, 0x83, 0x69,
+	0x16, 0x22, 0x2b, 0x8f, 0x72, 0xb1, 0xc8, 0x6e, 0xf6, 0x1f, 0x09, 0x16, 0xcb, 0x4c, 0x7f, 0x68,
+	0x69, 0xd8, 0x21, 0xfb, 0x95, 0x52, 0xe1, 0xc6, 0x37, 0x86, 0x53, 0xd7, 0x6c, 0xdc, 0xde, 0x23,
+	0x24, 0xd9, 0x49, 0xe8, 0x2a, 0xa4, 0x9f, 0xda, 0x6a, 0xe1, 0x46, 0x15, 0x6b, 0x9a, 0x4d, 0x18,
+	0xf3, 0xad, 0x99, 0xe2, 0x9b, 0x9f, 0x7b, 0x7b, 0xe8, 0x2e, 0xcc, 0x9a, 0xa4, 0x5d, 0x6d, 0xfb,
+	0x1a, 0xab, 0x07, 0x84, 0xc8, 0x63, 0xae, 0x5c, 0x31, 0xf3, 0xfa, 0xed, 0xca, 0xc8, 0x1f, 0x6f,
+	0x57, 0xe6, 0xbd, 0xd0, 0x31, 0xed, 0x30, 0x67, 0xd0, 0x7c, 0x13, 0x3b, 0xf5, 0xdc, 0x43, 0xc3,
+	0x74, 0x2a, 0xd3, 0x26, 0x69, 0x77, 0x13, 0x29, 0x42, 0xda, 0xd5, 0xa4, 0x63, 0x56, 0x6d, 0x18,
+	0x4d, 0xc3, 0x91, 0xc7, 0x07, 0x52, 0xf3, 0x3f, 0x93, 0xb4, 0xef, 0x60, 0x76, 0xcf, 0x85, 0x44,
+	0xe2, 0x7a, 0x15, 0xae, 0x24, 0xda, 0x1d, 0xc4, 0x35, 0xfb, 0x4c, 0x82, 0x05, 0x21, 0x15, 0x0e,
+	0x7e, 0x1f, 0xdf, 0x6c, 0xc1, 0x92, 0x4b, 0xd6, 0xf3, 0x74, 0x55, 0xf5, 0x01, 0x11, 0x4f, 0xc9,
+	0x26, 0x69, 0x87, 0x35, 0xfa, 0x5e, 0x8b, 0xf0, 0xbc, 0x02, 0x2b, 0x09, 0x0c, 0x04, 0xcb, 0xbf,
+	0x2f, 0x80, 0x22, 0x52, 0x74, 0xcf, 0xaf, 0xa9, 0x12, 0x35, 0x4c, 0x6e, 0x57, 0x1f, 0xa2, 0x73,
+	0x30, 0xba, 0xeb, 0x8a, 0x04, 0xa9, 0xc8, 0x17, 0x68, 0x1d, 0x66, 0x0f, 0xa8, 0x4d, 0x0c, 0xdd,
+	0xac, 0xf2, 0xe2, 0xab, 0x1a, 0x1a, 0xcf, 0xc5, 0x54, 0x65, 0xda, 0xdf, 0x2f, 0xb9, 0xdb, 0x5f,
+	0x6a, 0x48, 0x81, 0x09, 0x8d, 0xa8, 0x46, 0x13, 0x37, 0x18, 0xcf, 0xc2, 0x74, 0x45, 0xac, 0x11,
+	0x82, 0x8b, 0x26, 0x6e, 0x12, 0x3f, 0xed, 0xf8, 0x33, 0x9a, 0x87, 0x31, 0xd6, 0x69, 0xd6, 0x68,
+	0xc3, 0xcb, 0x82, 0x8a, 0xbf, 0x42, 0x45, 0x98, 0x74, 0xcb, 0xb9, 0xea, 0x74, 0x2c, 0xc2, 0x23,
+	0x3b, 0x5d, 0xf8, 0x7f, 0x2e, 0xa6, 0x5f, 0x58, 0x87, 0x7a, 0x8e, 0xd7, 0xbd, 0x6b, 0xdc, 0xd7,
+	0x1d, 0x8b, 0x54, 0x26, 0x54, 0xff, 0x09, 0x2d, 0xc1, 0xe4, 0x49, 0x76, 0x4c, 0x70, 0xba, 0x13,
+	0xba, 0x1f, 0x7a, 0x54, 0x82, 0x74, 0xc3, 0xf8, 0xae, 0x65, 0x68, 0x86, 0xd3, 0xa9, 0xaa, 0xd8,
+	0x92, 0x27, 0x45, 0xfa, 0x48, 0x7d, 0xd2, 0x67, 0x4a, 0x80, 0x4a, 0xd8, 0x8a, 0xc4, 0x65, 0x1b,
+	0xb2, 0xc9, 0x3e, 0x17, 0x8d, 0x41, 0x86, 0xf1, 0x20, 0xec, 0xbe, 0xef, 0xfd, 0x65, 0x56, 0x85,
+	0xb9, 0x32, 0xd3, 0x2b, 0xa4, 0x49, 0x1f, 0x93, 0x3d, 0xdf, 0xad, 0xd4, 0x30, 0xcf, 0x59, 0x72,
+	0x11, 0x92, 0x19, 0x58, 0x8e, 0x3b, 0x44, 0x64, 0xce, 0x2f, 0xdd, 0xd5, 0x1f, 0xe4, 0x55, 0xb1,
+	0xe3, 0x10, 0x95, 0x6a, 0xfd, 0xaa, 0xff, 0x7d, 0x98, 0x4d, 0x48, 0xeb, 0x19, 0x35, 0x9c, 0xcd,
+	0x28, 0xeb, 0x55, 0xae, 0xab, 0xb0, 0x5a, 0xc7, 0xac, 0xee, 0xb7, 0x35, 0xb7, 0x32, 0x4b, 0x54,
+	0x23, 0x77, 0x31, 0xab, 0xf7, 0xa9, 0xcc, 0x28, 0x27, 0xc1, 0xfc, 0x37, 0x09, 0x14, 0x21, 0xc5,
+	0x7d, 0x7e, 0xaf, 0x2b, 0x56, 0xe7, 0x6d, 0x5c, 0x3d, 0xf9, 0x92, 0x1a, 0xa8, 0xdd, 0xf4, 0xcb,
+	0x97, 0x35, 0xc8, 0x26, 0xf3, 0x15, 0x66, 0x7d, 0x0b, 0xe9, 0x32, 0xd3, 0xef, 0xe3, 0x16, 0x23,
+	0xa7, 0x15, 0xef, 0x7b, 0x30, 0x13, 0x32, 0x84, 0xb8, 0xa6, 0xa4, 0xdc, 0x16, 0xdf, 0x6d, 0x0a,
+	0x89, 0xa6, 0xc4, 0x02, 0x5c, 0x0e, 0x9d, 0x20, 0x8e, 0xae, 0xc1, 0x8c, 0x4b, 0xd0, 0xb4, 0xfe,
+	0xc3, 0xc3, 0x17, 0x61, 0x21, 0x72, 0x86, 0x38, 0xfe, 0x47, 0x09, 0x64, 0xe1, 0xa0, 0x3b, 0xd8,
+	0x21, 0x6d, 0xdc, 0x19, 0xa0, 0xd7, 0xee, 0xc0, 0xb2, 0x37, 0x18, 0x38, 0x20, 0xa9, 0xd9, 0x2e,
+	0xf2, 0x39, 0x10, 0xd2, 0x19, 0x5f, 0x30, 0x59, 0x58, 0x4d, 0x22, 0x21, 0x98, 0xbe, 0x90, 0x00,
+	0x85, 0x43, 0xf9, 0x95, 0xdb, 0xdc, 0xce, 0x99, 0x72, 0x41, 0xbf, 0x4c, 0xc5, 0xf6, 0xcb, 0x8b,
+	0xdd, 0xfd, 0x32, 0xc2, 0x79, 0x19, 0x94, 0x5e, 0x3a, 0x82, 0xed, 0xcf, 0xdd, 0x25, 0x7e, 0xdf,
+	0x26, 0x2a, 0x6d, 0x5a, 0x46, 0x83, 0x3c, 0x70, 0xb0, 0xd3, 0xea, 0x73, 0x0b, 0x42, 0xd7, 0x01,
+	0x59, 0x42, 0x3a, 0xc2, 0xfc, 0xd2, 0xc9, 0x9b, 0x80, 0xbe, 0x0c, 0xe3, 0xc4, 0xc4, 0xb5, 0x06,
+	0xf1, 0x66, 0xc5, 0x44, 0x25, 0x58, 0xf6, 0x29, 0xee, 0x28, 0x9b, 0x80, 0x73, 0xe1, 0x79, 0x1a,
+	0x52, 0x65, 0xa6, 0xa3, 0x17, 0x12, 0x5c, 0x8e, 0xbf, 0xbd, 0xdd, 0xce, 0xf5, 0xb9, 0x4e, 0xe6,
+	0x92, 0xee, 0x6b, 0xca, 0xd6, 0x50, 0x30, 0xd1, 0xcd, 0x7f, 0x95, 0x60, 0x21, 0x69, 0xca, 0x6e,
+	0x0e, 0xa6, 0xba, 0x07, 0xa8, 0xec, 0x0c, 0x09, 0x14, 0xac, 0x9e, 0x4b, 0x70, 0xa9, 0x77, 0x8e,
+	0xdc, 0x3c, 0x4d, 0x6d, 0x0f, 0x44, 0xf9, 0xe4, 0xcc, 0x10, 0xc1, 0xe1, 0x27, 0x09, 0xe6, 0x62,
+	0x6f, 0x49, 0xb7, 0x4e, 0xd3, 0x19, 0x87, 0x52, 0x3e, 0x1b, 0x06, 0x25, 0xc8, 0xbc, 0x94, 0x60,
+	0x3e, 0x61, 0xa4, 0x7d, 0x3c, 0x98, 0xe2, 0x28, 0x4e, 0xd9, 0x1e, 0x0e, 0x17, 0x43, 0xa9, 0xe7,
+	0x8e, 0x3d, 0x20, 0xa5, 0x28, 0x4e, 0xd9, 0x1e, 0x0e, 0x17, 0x4a, 0xe6, 0xa4, 0xf1, 0xb9, 0x79,
+	0x06, 0xdd, 0xdd, 0x40, 0x65, 0x67, 0x48, 0xa0, 0x60, 0xd5, 0x00, 0xe8, 0x9a, 0x7e, 0x1b, 0xa7,
+	0xa9, 0x3b, 0x91, 0x55, 0x0a, 0x83, 0xcb, 0x8a, 0xd3, 0x6c, 0x98, 0x0a, 0x0d, 0xbc, 0x0f, 0x4f,
+	0xa5, 0xdf, 0x25, 0xad, 0xdc, 0x3a, 0x8b, 0xb4, 0x38, 0xd3, 0x6d, 0x6a, 0xf1, 0x53, 0xee, 0xf6,
+	0x60, 0xce, 0x8b, 0xc0, 0x94, 0xad, 0xa1, 0x60, 0x82, 0xcf, 0xf7, 0x30, 0x13, 0x1d, 0x65, 0xf9,
+	0x33, 0x44, 0xd1, 0x05, 0x28, 0x9b, 0x67, 0x04, 0xc4, 0xd4, 0x45, 0xcf, 0x68, 0x1a, 0xb0, 0x2e,
+	0xa2, 0x38, 0x65, 0x7b, 0x38, 0x5c, 0x40, 0x49, 0x19, 0x7d, 0xf6, 0xee, 0xd5, 0x86, 0x54, 0xdc,
+	0x7d, 0x7d, 0x94, 0x91, 0xde, 0x1c, 0x65, 0xa4, 0xbf, 0x8e, 0x32, 0xd2, 0xcb, 0xe3, 0xcc, 0xc8,
+	0x9b, 0xe3, 0xcc, 0xc8, 0xef, 0xc7, 0x99, 0x91, 0xfd, 0x0f, 0x74, 0xc3, 0xa9, 0xb7, 0x6a, 0x39,
+	0x95, 0x36, 0xf9, 0x77, 0x89, 0xeb, 0xde, 0x27, 0x0a, 0x93, 0x6a, 0x24, 0xff, 0xa4, 0xeb, 0xcb,
+	0x47, 0xc7, 0x22, 0xac, 0x36, 0xc6, 0xbf, 0x44, 0x7c, 0xf4, 0xef, 0x00, 0x09, 0x99, 0xa1, 0x6c,
+	0x25, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1243,6 +1345,7 @@ type MsgClient interface {
 	UnpauseZRC20(ctx context.Context, in *MsgUnpauseZRC20, opts ...grpc.CallOption) (*MsgUnpauseZRC20Response, error)
 	UpdateGatewayContract(ctx context.Context, in *MsgUpdateGatewayContract, opts ...grpc.CallOption) (*MsgUpdateGatewayContractResponse, error)
 	UpdateZRC20Name(ctx context.Context, in *MsgUpdateZRC20Name, opts ...grpc.CallOption) (*MsgUpdateZRC20NameResponse, error)
+	UpdatePrecompileStatus(ctx context.Context, in *MsgUpdatePrecompileStatus, opts ...grpc.CallOption) (*MsgUpdatePrecompileStatusResponse, error)
 }
 
 type msgClient struct {
@@ -1352,6 +1455,15 @@ func (c *msgClient) UpdateZRC20Name(ctx context.Context, in *MsgUpdateZRC20Name,
 	return out, nil
 }
 
+func (c *msgClient) UpdatePrecompileStatus(ctx context.Context, in *MsgUpdatePrecompileStatus, opts ...grpc.CallOption) (*MsgUpdatePrecompileStatusResponse, error) {
+	out := new(MsgUpdatePrecompileStatusResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Msg/UpdatePrecompileStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	DeploySystemContracts(context.Context, *MsgDeploySystemContracts) (*MsgDeploySystemContractsResponse, error)
@@ -1365,6 +1477,7 @@ type MsgServer interface {
 	UnpauseZRC20(context.Context, *MsgUnpauseZRC20) (*MsgUnpauseZRC20Response, error)
 	UpdateGatewayContract(context.Context, *MsgUpdateGatewayContract) (*MsgUpdateGatewayContractResponse, error)
 	UpdateZRC20Name(context.Context, *MsgUpdateZRC20Name) (*MsgUpdateZRC20NameResponse, error)
+	UpdatePrecompileStatus(context.Context, *MsgUpdatePrecompileStatus) (*MsgUpdatePrecompileStatusResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1404,6 +1517,9 @@ func (*UnimplementedMsgServer) UpdateGatewayContract(ctx context.Context, req *M
 func (*UnimplementedMsgServer) UpdateZRC20Name(ctx context.Context, req *MsgUpdateZRC20Name) (*MsgUpdateZRC20NameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateZRC20Name not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePrecompileStatus(ctx context.Context, req *MsgUpdatePrecompileStatus) (*MsgUpdatePrecompileStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrecompileStatus not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1607,6 +1723,24 @@ func _Msg_UpdateZRC20Name_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePrecompileStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePrecompileStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePrecompileStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.fungible.Msg/UpdatePrecompileStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePrecompileStatus(ctx, req.(*MsgUpdatePrecompileStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.fungible.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1655,6 +1789,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateZRC20Name",
 			Handler:    _Msg_UpdateZRC20Name_Handler,
 		},
+		{
+			MethodName: "UpdatePrecompileStatus",
+			Handler:    _Msg_UpdatePrecompileStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/fungible/tx.proto",
@@ -2456,6 +2594,76 @@ func (m *MsgUpdateZRC20NameResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdatePrecompileStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrecompileStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrecompileStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PrecompileAddress) > 0 {
+		i -= len(m.PrecompileAddress)
+		copy(dAtA[i:], m.PrecompileAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrecompileAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePrecompileStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrecompileStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrecompileStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2819,6 +3027,35 @@ func (m *MsgUpdateZRC20NameResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdatePrecompileStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrecompileAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdatePrecompileStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5163,6 +5400,190 @@ func (m *MsgUpdateZRC20NameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdatePrecompileStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrecompileAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePrecompileStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
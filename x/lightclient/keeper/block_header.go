@@ -90,11 +90,16 @@ func (k Keeper) CheckNewBlockHeader(
 	// validate block height as it's not part of the header itself
 	chainState, found := k.GetChainState(ctx, chainID)
 	if found && chainState.EarliestHeight > 0 && chainState.EarliestHeight < height {
-		if height != chainState.LatestHeight+1 {
+		// the parent is the latest block, heights can be skipped on some chains (e.g. Solana slots)
+		parentHeight, err := header.ParentHeight(height)
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrInvalidHeight, err.Error())
+		}
+		if parentHeight != chainState.LatestHeight {
 			return nil, cosmoserrors.Wrap(types.ErrInvalidHeight, fmt.Sprintf(
-				"invalid block height: wanted %d, got %d",
-				chainState.LatestHeight+1,
-				height,
+				"invalid block height: wanted parent height %d, got %d",
+				chainState.LatestHeight,
+				parentHeight,
 			))
 		}
 		_, found = k.GetBlockHeader(ctx, parentHash)
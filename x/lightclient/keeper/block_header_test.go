@@ -7,10 +7,12 @@ import (
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/gagliardetto/solana-go"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/proofs"
+	solanaproofs "github.com/zeta-chain/node/pkg/proofs/solana"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/lightclient/types"
@@ -251,6 +253,50 @@ func TestKeeper_CheckNewBlockHeader(t *testing.T) {
 		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
 		require.ErrorIs(t, err, types.ErrNoParentHash)
 	})
+
+	t.Run("should succeed if solana block follows skipped slots", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.SolanaDevnet.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+
+		parentHash := solana.HashFromBytes(sample.Hash().Bytes())
+		blockHash := solana.HashFromBytes(sample.Hash().Bytes())
+		header := proofs.NewSolanaHeader(
+			solanaproofs.NewHeader(100, 97, blockHash, parentHash, ctx.BlockTime().Unix()),
+		)
+		k.SetBlockHeader(ctx, proofs.BlockHeader{
+			Height:  97,
+			Hash:    parentHash[:],
+			ChainId: chains.SolanaDevnet.ChainId,
+		})
+		k.SetChainState(ctx, types.ChainState{
+			ChainId:         chains.SolanaDevnet.ChainId,
+			LatestHeight:    97,
+			EarliestHeight:  10,
+			LatestBlockHash: parentHash[:],
+		})
+
+		res, err := k.CheckNewBlockHeader(ctx, chains.SolanaDevnet.ChainId, blockHash[:], 100, header)
+		require.NoError(t, err)
+		require.Equal(t, parentHash[:], res)
+
+		// the parent slot must be the latest block
+		k.SetChainState(ctx, types.ChainState{
+			ChainId:         chains.SolanaDevnet.ChainId,
+			LatestHeight:    99,
+			EarliestHeight:  10,
+			LatestBlockHash: parentHash[:],
+		})
+		_, err = k.CheckNewBlockHeader(ctx, chains.SolanaDevnet.ChainId, blockHash[:], 100, header)
+		require.ErrorIs(t, err, types.ErrInvalidHeight)
+	})
 }
 
 func TestKeeper_AddBlockHeader(t *testing.T) {
@@ -42,6 +42,9 @@ func (k Keeper) VerifyProof(
 		return nil, cosmoserror.Wrapf(types.ErrBlockHeaderNotFound, "block header not found %s", blockHash)
 	}
 
+	// consume the gas of the verification before computing it
+	ctx.GasMeter().ConsumeGas(proof.VerificationGas(), "verify proof")
+
 	// verify merkle proof
 	txBytes, err := proof.Verify(res.Header, int(txIndex))
 	if err != nil {
@@ -36,5 +36,29 @@ func DefaultHeaderSupportedChains() []HeaderSupportedChain {
 			ChainId: chains.Goerli.ChainId,
 			Enabled: false,
 		},
+		{
+			ChainId: chains.TONMainnet.ChainId,
+			Enabled: false,
+		},
+		{
+			ChainId: chains.TONTestnet.ChainId,
+			Enabled: false,
+		},
+		{
+			ChainId: chains.TONLocalnet.ChainId,
+			Enabled: false,
+		},
+		{
+			ChainId: chains.SolanaMainnet.ChainId,
+			Enabled: false,
+		},
+		{
+			ChainId: chains.SolanaDevnet.ChainId,
+			Enabled: false,
+		},
+		{
+			ChainId: chains.SolanaLocalnet.ChainId,
+			Enabled: false,
+		},
 	}
 }
@@ -25,6 +25,18 @@ func TestDefaultVerificationFlags(t *testing.T) {
 				require.False(t, f.Enabled)
 			case chains.Goerli.ChainId:
 				require.False(t, f.Enabled)
+			case chains.TONMainnet.ChainId:
+				require.False(t, f.Enabled)
+			case chains.TONTestnet.ChainId:
+				require.False(t, f.Enabled)
+			case chains.TONLocalnet.ChainId:
+				require.False(t, f.Enabled)
+			case chains.SolanaMainnet.ChainId:
+				require.False(t, f.Enabled)
+			case chains.SolanaDevnet.ChainId:
+				require.False(t, f.Enabled)
+			case chains.SolanaLocalnet.ChainId:
+				require.False(t, f.Enabled)
 			default:
 				require.False(t, f.Enabled, "unexpected chain id")
 			}
@@ -45,6 +45,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdGetTssFundsMigrator(),
 		CmdShowOperationalFlags(),
 		CmdAllBallots(),
+		CmdListObserverLiveness(),
+		CmdShowObserverLiveness(),
 	)
 
 	return cmd
@@ -34,6 +34,9 @@ func GetTxCmd() *cobra.Command {
 		CmdDisableFastConfirmation(),
 		CmdUpdateGasPriceIncreaseFlags(),
 		CmdUpdateOperationalFlags(),
+		CmdRejoinObserverSet(),
+		CmdVoteHaltChain(),
+		CmdUnhaltChain(),
 	)
 
 	return cmd
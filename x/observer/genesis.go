@@ -131,6 +131,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetNonceToCctx(ctx, elem)
 	}
 	k.SetOperationalFlags(ctx, genState.OperationalFlags)
+
+	for _, elem := range genState.ObserverLiveness {
+		k.SetObserverLiveness(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the observer module's exported genesis.
@@ -204,5 +208,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		ChainNonces:       k.GetAllChainNonces(ctx),
 		NonceToCctx:       k.GetAllNonceToCctx(ctx),
 		OperationalFlags:  of,
+		ObserverLiveness:  k.GetAllObserverLiveness(ctx),
 	}
 }
@@ -43,6 +43,10 @@ func TestGenesis(t *testing.T) {
 			NonceToCctx:      sample.NonceToCctxList(t, "sample", 20),
 			TssHistory:       []types.TSS{sample.Tss()},
 			OperationalFlags: sample.OperationalFlags(),
+			ObserverLiveness: []types.ObserverLiveness{
+				sample.ObserverLiveness(sample.AccAddress()),
+				sample.ObserverLiveness(sample.AccAddress()),
+			},
 		}
 
 		// Init and export
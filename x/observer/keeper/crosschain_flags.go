@@ -57,3 +57,35 @@ func (k Keeper) DisableInboundOnly(ctx sdk.Context) {
 	flags.IsInboundEnabled = false
 	k.SetCrosschainFlags(ctx, flags)
 }
+
+// IsChainHalted returns true if the chain has been halted by an emergency vote of the observers
+func (k Keeper) IsChainHalted(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		return false
+	}
+	return flags.IsChainHalted(chainID)
+}
+
+// AddHaltedChain disables the inbounds and outbounds of the chain
+func (k Keeper) AddHaltedChain(ctx sdk.Context, chainID int64) {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		flags = *types.DefaultCrosschainFlags()
+		flags.GasPriceIncreaseFlags = nil
+	}
+	if flags.HaltChain(chainID) {
+		k.SetCrosschainFlags(ctx, flags)
+	}
+}
+
+// RemoveHaltedChain enables again the inbounds and outbounds of a halted chain,
+// it returns false if the chain is not halted
+func (k Keeper) RemoveHaltedChain(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found || !flags.UnhaltChain(chainID) {
+		return false
+	}
+	k.SetCrosschainFlags(ctx, flags)
+	return true
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -82,3 +83,46 @@ func TestKeeper_DisableInboundOnly(t *testing.T) {
 	enabled = k.IsInboundEnabled(ctx)
 	require.False(t, enabled)
 }
+
+func TestKeeper_HaltedChains(t *testing.T) {
+	t.Run("should halt a chain if flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		require.False(t, k.IsChainHalted(ctx, 1))
+
+		k.AddHaltedChain(ctx, 1)
+		require.True(t, k.IsChainHalted(ctx, 1))
+		require.False(t, k.IsChainHalted(ctx, 2))
+		require.True(t, k.IsInboundEnabled(ctx))
+		require.True(t, k.IsOutboundEnabled(ctx))
+	})
+
+	t.Run("should halt and unhalt chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		gasPriceIncreaseFlags := sample.GasPriceIncreaseFlags()
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+			IsInboundEnabled:      true,
+			GasPriceIncreaseFlags: &gasPriceIncreaseFlags,
+		})
+
+		k.AddHaltedChain(ctx, 1)
+		k.AddHaltedChain(ctx, 2)
+		k.AddHaltedChain(ctx, 1)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.Equal(t, []int64{1, 2}, flags.HaltedChains)
+		require.True(t, flags.IsInboundEnabled)
+		require.False(t, flags.IsOutboundEnabled)
+		require.Equal(t, gasPriceIncreaseFlags, *flags.GasPriceIncreaseFlags)
+
+		require.True(t, k.RemoveHaltedChain(ctx, 1))
+		require.False(t, k.RemoveHaltedChain(ctx, 1))
+		require.False(t, k.IsChainHalted(ctx, 1))
+		require.True(t, k.IsChainHalted(ctx, 2))
+	})
+
+	t.Run("should not unhalt a chain if flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		require.False(t, k.RemoveHaltedChain(ctx, 1))
+	})
+}
@@ -63,3 +63,25 @@ func EmitEventAddObserver(
 		ctx.Logger().Error("Error emitting EmitEventAddObserver :", err)
 	}
 }
+
+func EmitEventObserverJailed(ctx sdk.Context, observerAddress string, missedVotes, jailedUntil int64) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverJailed{
+		ObserverAddress:    observerAddress,
+		MissedVotesCounter: missedVotes,
+		JailedUntil:        jailedUntil,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverJailed :", err)
+	}
+}
+
+func EmitEventObserverRejoined(ctx sdk.Context, observerCount uint64, observerAddress string) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverRejoined{
+		MsgTypeUrl:             sdk.MsgTypeURL(&types.MsgRejoinObserverSet{}),
+		ObserverAddress:        observerAddress,
+		ObserverLastBlockCount: observerCount,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverRejoined :", err)
+	}
+}
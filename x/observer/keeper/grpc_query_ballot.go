@@ -46,9 +46,11 @@ func (k Keeper) BallotByIdentifier(
 
 	votersList := make([]*types.VoterList, len(ballot.VoterList))
 	for i, voterAddress := range ballot.VoterList {
+		index := ballot.GetVoterIndex(voterAddress)
 		voter := types.VoterList{
 			VoterAddress: voterAddress,
-			VoteType:     ballot.Votes[ballot.GetVoterIndex(voterAddress)],
+			VoteType:     ballot.Votes[index],
+			Weight:       ballot.GetVoterWeight(index),
 		}
 		votersList[i] = &voter
 	}
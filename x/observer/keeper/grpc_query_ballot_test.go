@@ -131,6 +131,7 @@ func TestKeeper_BallotByIdentifier(t *testing.T) {
 				{
 					VoterAddress: voter,
 					VoteType:     types.VoteType_SuccessObservation,
+					Weight:       1,
 				},
 			},
 			ObservationType: ballot.ObservationType,
@@ -138,6 +139,40 @@ func TestKeeper_BallotByIdentifier(t *testing.T) {
 		}, res)
 	})
 
+	t.Run("should return ballot with voter weights if stake weighted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		voter1 := sample.AccAddress()
+		voter2 := sample.AccAddress()
+		ballot := types.Ballot{
+			Index:            "index",
+			BallotIdentifier: "index",
+			VoterList:        []string{voter1, voter2},
+			Votes:            []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_NotYetVoted},
+			BallotStatus:     types.BallotStatus_BallotInProgress,
+			VoterWeights:     []int64{10, 30},
+		}
+		k.SetBallot(ctx, &ballot)
+
+		res, err := k.BallotByIdentifier(wctx, &types.QueryBallotByIdentifierRequest{
+			BallotIdentifier: "index",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []*types.VoterList{
+			{
+				VoterAddress: voter1,
+				VoteType:     types.VoteType_SuccessObservation,
+				Weight:       10,
+			},
+			{
+				VoterAddress: voter2,
+				VoteType:     types.VoteType_NotYetVoted,
+				Weight:       30,
+			},
+		}, res.Voters)
+	})
+
 	t.Run("should return 100 ballots if more exist and limit is not provided", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
 		wctx := sdk.WrapSDKContext(ctx)
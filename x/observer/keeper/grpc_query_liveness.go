@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// ObserverLiveness returns the missed votes of an observer
+func (k Keeper) ObserverLiveness(
+	c context.Context,
+	req *types.QueryGetObserverLivenessRequest,
+) (*types.QueryGetObserverLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	liveness, found := k.GetObserverLiveness(ctx, req.ObserverAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetObserverLivenessResponse{ObserverLiveness: liveness}, nil
+}
+
+// ObserverLivenessAll returns the missed votes of all observers
+func (k Keeper) ObserverLivenessAll(
+	c context.Context,
+	req *types.QueryAllObserverLivenessRequest,
+) (*types.QueryAllObserverLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var livenessList []types.ObserverLiveness
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var liveness types.ObserverLiveness
+		if err := k.cdc.Unmarshal(value, &liveness); err != nil {
+			return err
+		}
+		livenessList = append(livenessList, liveness)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllObserverLivenessResponse{ObserverLiveness: livenessList, Pagination: pageRes}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_ObserverLiveness(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverLiveness(ctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverLiveness(ctx, &types.QueryGetObserverLivenessRequest{
+			ObserverAddress: sample.AccAddress(),
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the liveness of the observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		liveness := sample.ObserverLiveness(sample.AccAddress())
+		k.SetObserverLiveness(ctx, liveness)
+
+		res, err := k.ObserverLiveness(ctx, &types.QueryGetObserverLivenessRequest{
+			ObserverAddress: liveness.ObserverAddress,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryGetObserverLivenessResponse{ObserverLiveness: liveness}, res)
+	})
+}
+
+func TestKeeper_ObserverLivenessAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverLivenessAll(ctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the liveness of all observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		list := []types.ObserverLiveness{
+			sample.ObserverLiveness(sample.AccAddress()),
+			sample.ObserverLiveness(sample.AccAddress()),
+			sample.ObserverLiveness(sample.AccAddress()),
+		}
+		for _, liveness := range list {
+			k.SetObserverLiveness(ctx, liveness)
+		}
+
+		res, err := k.ObserverLivenessAll(ctx, &types.QueryAllObserverLivenessRequest{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, list, res.ObserverLiveness)
+
+		res, err = k.ObserverLivenessAll(ctx, &types.QueryAllObserverLivenessRequest{
+			Pagination: &query.PageRequest{Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.ObserverLiveness, 2)
+		require.NotNil(t, res.Pagination.NextKey)
+	})
+}
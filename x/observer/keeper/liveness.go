@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// SetObserverLiveness sets the liveness of an observer in the store
+func (k Keeper) SetObserverLiveness(ctx sdk.Context, liveness types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	b := k.cdc.MustMarshal(&liveness)
+	store.Set([]byte(liveness.ObserverAddress), b)
+}
+
+// GetObserverLiveness returns the liveness of an observer
+func (k Keeper) GetObserverLiveness(ctx sdk.Context, address string) (val types.ObserverLiveness, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	b := store.Get([]byte(address))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllObserverLiveness returns the liveness of all observers
+func (k Keeper) GetAllObserverLiveness(ctx sdk.Context) (list []types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ObserverLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return list
+}
+
+// UpdateObserverLiveness records the votes of the observers of the observer set on the matured ballots.
+// An observer missing more than the max missed ratio of the ballots in the window is jailed:
+// it is removed from the observer set and can rejoin it after the jail duration.
+// The last observer of the observer set is never jailed.
+func (k Keeper) UpdateObserverLiveness(
+	ctx sdk.Context,
+	ballots []types.Ballot,
+	window int64,
+	maxMissedRatio sdkmath.LegacyDec,
+	jailDuration int64,
+) {
+	if window <= 0 {
+		return
+	}
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found {
+		return
+	}
+	isObserver := make(map[string]bool, len(observerSet.ObserverList))
+	for _, observer := range observerSet.ObserverList {
+		isObserver[observer] = true
+	}
+
+	// the observers are updated in the order of the observer set to remain deterministic
+	livenessMap := make(map[string]*types.ObserverLiveness, len(observerSet.ObserverList))
+	for _, ballot := range ballots {
+		for i, voter := range ballot.VoterList {
+			if !isObserver[voter] || i >= len(ballot.Votes) {
+				continue
+			}
+			liveness, ok := livenessMap[voter]
+			if !ok {
+				val, found := k.GetObserverLiveness(ctx, voter)
+				if !found {
+					val = types.ObserverLiveness{ObserverAddress: voter}
+				}
+				liveness = &val
+				livenessMap[voter] = liveness
+			}
+			liveness.AddVote(window, ballot.Votes[i] == types.VoteType_NotYetVoted)
+		}
+	}
+
+	for _, observer := range observerSet.ObserverList {
+		liveness, ok := livenessMap[observer]
+		if !ok {
+			continue
+		}
+		if liveness.ExceedsMaxMissedRatio(window, maxMissedRatio) {
+			k.jailObserver(ctx, liveness, jailDuration)
+		}
+		k.SetObserverLiveness(ctx, *liveness)
+	}
+}
+
+// jailObserver removes the observer from the observer set and marks it as jailed
+// The observer count is updated so the observer set change doesn't disable the inbounds, the jailed observer
+// remains part of the current TSS
+func (k Keeper) jailObserver(ctx sdk.Context, liveness *types.ObserverLiveness, jailDuration int64) {
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found || observerSet.Len() <= 1 {
+		return
+	}
+
+	missedVotes := liveness.MissedVotesCounter
+	liveness.Jail(ctx.BlockHeight() + jailDuration)
+
+	k.RemoveObserverFromSet(ctx, liveness.ObserverAddress)
+	observerSet, _ = k.GetObserverSet(ctx)
+	k.SetLastObserverCount(ctx, &types.LastObserverCount{
+		Count:            observerSet.LenUint(),
+		LastChangeHeight: ctx.BlockHeight(),
+	})
+
+	EmitEventObserverJailed(ctx, liveness.ObserverAddress, missedVotes, liveness.JailedUntil)
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// livenessBallot returns a ballot of the observers where the given observers didn't vote
+func livenessBallot(observers []string, missing ...string) types.Ballot {
+	votes := make([]types.VoteType, len(observers))
+	for i, observer := range observers {
+		votes[i] = types.VoteType_SuccessObservation
+		for _, m := range missing {
+			if m == observer {
+				votes[i] = types.VoteType_NotYetVoted
+			}
+		}
+	}
+	return types.Ballot{
+		VoterList:    observers,
+		Votes:        votes,
+		BallotStatus: types.BallotStatus_BallotFinalized_SuccessObservation,
+	}
+}
+
+func TestKeeper_GetAllObserverLiveness(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	list := []types.ObserverLiveness{
+		sample.ObserverLiveness(sample.AccAddress()),
+		sample.ObserverLiveness(sample.AccAddress()),
+	}
+	for _, liveness := range list {
+		k.SetObserverLiveness(ctx, liveness)
+	}
+
+	liveness, found := k.GetObserverLiveness(ctx, list[0].ObserverAddress)
+	require.True(t, found)
+	require.Equal(t, list[0], liveness)
+	require.ElementsMatch(t, list, k.GetAllObserverLiveness(ctx))
+
+	_, found = k.GetObserverLiveness(ctx, sample.AccAddress())
+	require.False(t, found)
+}
+
+func TestKeeper_UpdateObserverLiveness(t *testing.T) {
+	ratio := sdkmath.LegacyNewDecWithPrec(5, 1)
+
+	t.Run("should do nothing if the window is disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observers := []string{sample.AccAddress(), sample.AccAddress()}
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+
+		k.UpdateObserverLiveness(ctx, []types.Ballot{livenessBallot(observers, observers[0])}, 0, ratio, 10)
+
+		require.Empty(t, k.GetAllObserverLiveness(ctx))
+	})
+
+	t.Run("should record the missed votes of the observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observers := []string{sample.AccAddress(), sample.AccAddress()}
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		// a voter not in the observer set is not tracked
+		removed := sample.AccAddress()
+		voters := append([]string{removed}, observers...)
+
+		k.UpdateObserverLiveness(ctx, []types.Ballot{
+			livenessBallot(voters, observers[0], removed),
+			livenessBallot(voters),
+		}, 10, ratio, 10)
+
+		liveness, found := k.GetObserverLiveness(ctx, observers[0])
+		require.True(t, found)
+		require.EqualValues(t, 2, liveness.IndexOffset)
+		require.EqualValues(t, 1, liveness.MissedVotesCounter)
+		require.False(t, liveness.Jailed)
+
+		liveness, found = k.GetObserverLiveness(ctx, observers[1])
+		require.True(t, found)
+		require.EqualValues(t, 2, liveness.IndexOffset)
+		require.EqualValues(t, 0, liveness.MissedVotesCounter)
+
+		_, found = k.GetObserverLiveness(ctx, removed)
+		require.False(t, found)
+	})
+
+	t.Run("should jail the observer exceeding the max missed ratio", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		observers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+		_, err := k.AddObserverToSet(ctx, observers[0])
+		require.NoError(t, err)
+		_, err = k.AddObserverToSet(ctx, observers[1])
+		require.NoError(t, err)
+		_, err = k.AddObserverToSet(ctx, observers[2])
+		require.NoError(t, err)
+
+		ballots := make([]types.Ballot, 10)
+		for i := range ballots {
+			ballots[i] = livenessBallot(observers, observers[0])
+		}
+		k.UpdateObserverLiveness(ctx, ballots, 10, ratio, 50)
+
+		liveness, found := k.GetObserverLiveness(ctx, observers[0])
+		require.True(t, found)
+		require.True(t, liveness.Jailed)
+		require.EqualValues(t, 150, liveness.JailedUntil)
+		require.EqualValues(t, 0, liveness.MissedVotesCounter)
+		require.False(t, k.IsAddressPartOfObserverSet(ctx, observers[0]))
+
+		// the observer count is updated with the observer set
+		count, found := k.GetLastObserverCount(ctx)
+		require.True(t, found)
+		require.EqualValues(t, 2, count.Count)
+
+		liveness, found = k.GetObserverLiveness(ctx, observers[1])
+		require.True(t, found)
+		require.False(t, liveness.Jailed)
+		require.True(t, k.IsAddressPartOfObserverSet(ctx, observers[1]))
+	})
+
+	t.Run("should not jail the last observer of the observer set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observers := []string{sample.AccAddress()}
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+
+		ballots := make([]types.Ballot, 10)
+		for i := range ballots {
+			ballots[i] = livenessBallot(observers, observers[0])
+		}
+		k.UpdateObserverLiveness(ctx, ballots, 10, ratio, 50)
+
+		liveness, found := k.GetObserverLiveness(ctx, observers[0])
+		require.True(t, found)
+		require.False(t, liveness.Jailed)
+		require.EqualValues(t, 10, liveness.MissedVotesCounter)
+		require.True(t, k.IsAddressPartOfObserverSet(ctx, observers[0]))
+	})
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// RejoinObserverSet adds back to the observer set an observer jailed for missing too many votes,
+// once its jail duration has elapsed.
+// Authorized: the jailed observer.
+func (k msgServer) RejoinObserverSet(
+	goCtx context.Context,
+	msg *types.MsgRejoinObserverSet,
+) (*types.MsgRejoinObserverSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	liveness, found := k.GetObserverLiveness(ctx, msg.Creator)
+	if !found || !liveness.Jailed {
+		return nil, errorsmod.Wrapf(types.ErrObserverNotJailed, "observer %s", msg.Creator)
+	}
+	if ctx.BlockHeight() < liveness.JailedUntil {
+		return nil, errorsmod.Wrapf(
+			types.ErrObserverStillJailed,
+			"observer %s can rejoin at height %d",
+			msg.Creator,
+			liveness.JailedUntil,
+		)
+	}
+
+	// the observer should still be a validator, not jailed and bonded
+	if err := k.IsValidator(ctx, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotValidator, err.Error())
+	}
+	if _, found := k.GetNodeAccount(ctx, msg.Creator); !found {
+		return nil, errorsmod.Wrapf(types.ErrNodeAccountNotFound, "observer %s", msg.Creator)
+	}
+
+	// the observer is still part of the current TSS, the observer count is updated without disabling the inbounds
+	count, err := k.AddObserverToSet(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	liveness.Jailed = false
+	liveness.JailedUntil = 0
+	k.SetObserverLiveness(ctx, liveness)
+
+	EmitEventObserverRejoined(ctx, count, msg.Creator)
+
+	return &types.MsgRejoinObserverSetResponse{}, nil
+}
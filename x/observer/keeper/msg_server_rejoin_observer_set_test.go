@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// setJailedObserver sets a bonded validator jailed as an observer until the given height
+func setJailedObserver(t *testing.T, k *keeper.Keeper, ctx sdk.Context, jailedUntil int64) string {
+	// #nosec G404 test purpose - weak randomness is not an issue here
+	r := rand.New(rand.NewSource(9))
+	validator := sample.Validator(t, r)
+	validator.Status = stakingtypes.Bonded
+	require.NoError(t, k.GetStakingKeeper().SetValidator(ctx, validator))
+
+	accAddress, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+	require.NoError(t, err)
+	observer := accAddress.String()
+
+	k.SetNodeAccount(ctx, types.NodeAccount{Operator: observer})
+	k.SetObserverLiveness(ctx, types.ObserverLiveness{
+		ObserverAddress: observer,
+		Jailed:          true,
+		JailedUntil:     jailedUntil,
+	})
+	return observer
+}
+
+func TestMsgServer_RejoinObserverSet(t *testing.T) {
+	t.Run("should rejoin the observer set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(100)
+		other := sample.AccAddress()
+		_, err := k.AddObserverToSet(ctx, other)
+		require.NoError(t, err)
+		observer := setJailedObserver(t, k, ctx, 100)
+
+		_, err = srv.RejoinObserverSet(ctx, types.NewMsgRejoinObserverSet(observer))
+		require.NoError(t, err)
+
+		require.True(t, k.IsAddressPartOfObserverSet(ctx, observer))
+		count, found := k.GetLastObserverCount(ctx)
+		require.True(t, found)
+		require.EqualValues(t, 2, count.Count)
+		liveness, found := k.GetObserverLiveness(ctx, observer)
+		require.True(t, found)
+		require.False(t, liveness.Jailed)
+		require.EqualValues(t, 0, liveness.JailedUntil)
+	})
+
+	t.Run("should fail if the observer is not jailed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+		k.SetObserverLiveness(ctx, types.ObserverLiveness{ObserverAddress: observer})
+
+		_, err := srv.RejoinObserverSet(ctx, types.NewMsgRejoinObserverSet(observer))
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+
+		_, err = srv.RejoinObserverSet(ctx, types.NewMsgRejoinObserverSet(sample.AccAddress()))
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+
+	t.Run("should fail if the jail duration has not elapsed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(99)
+		observer := setJailedObserver(t, k, ctx, 100)
+
+		_, err := srv.RejoinObserverSet(ctx, types.NewMsgRejoinObserverSet(observer))
+		require.ErrorIs(t, err, types.ErrObserverStillJailed)
+		require.False(t, k.IsAddressPartOfObserverSet(ctx, observer))
+	})
+
+	t.Run("should fail if the observer is no longer a bonded validator", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+		k.SetNodeAccount(ctx, types.NodeAccount{Operator: observer})
+		k.SetObserverLiveness(ctx, types.ObserverLiveness{ObserverAddress: observer, Jailed: true})
+
+		_, err := srv.RejoinObserverSet(ctx, types.NewMsgRejoinObserverSet(observer))
+		require.ErrorIs(t, err, types.ErrNotValidator)
+		require.False(t, k.IsAddressPartOfObserverSet(ctx, observer))
+	})
+
+	t.Run("should fail if the observer has no node account", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := setJailedObserver(t, k, ctx, 0)
+		k.RemoveNodeAccount(ctx, observer)
+
+		_, err := srv.RejoinObserverSet(ctx, types.NewMsgRejoinObserverSet(observer))
+		require.ErrorIs(t, err, types.ErrNodeAccountNotFound)
+		require.False(t, k.IsAddressPartOfObserverSet(ctx, observer))
+	})
+}
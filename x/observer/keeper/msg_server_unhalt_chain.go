@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UnhaltChain enables again the inbounds and outbounds of a chain halted by an emergency vote of the observers.
+// The halt ballot of the chain is removed so the observers can vote again for a halt.
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) UnhaltChain(
+	goCtx context.Context,
+	msg *types.MsgUnhaltChain,
+) (*types.MsgUnhaltChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	if !k.RemoveHaltedChain(ctx, msg.ChainId) {
+		return nil, errors.Wrapf(types.ErrChainNotHalted, "chain id %d", msg.ChainId)
+	}
+	k.DeleteBallot(ctx, types.HaltChainBallotIdentifier(msg.ChainId))
+
+	err = ctx.EventManager().EmitTypedEvents(&types.EventChainUnhalted{
+		MsgTypeUrl: sdk.MsgTypeURL(&types.MsgUnhaltChain{}),
+		ChainId:    msg.ChainId,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventChainUnhalted :", err)
+	}
+
+	return &types.MsgUnhaltChainResponse{}, nil
+}
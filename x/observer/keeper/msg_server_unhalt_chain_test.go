@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_UnhaltChain(t *testing.T) {
+	t.Run("should error if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		k.AddHaltedChain(ctx, 1)
+
+		msg := types.NewMsgUnhaltChain(sample.AccAddress(), 1)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+
+		_, err := srv.UnhaltChain(ctx, msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+		require.True(t, k.IsChainHalted(ctx, 1))
+	})
+
+	t.Run("should error if chain not halted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		k.AddHaltedChain(ctx, 2)
+
+		msg := types.NewMsgUnhaltChain(sample.AccAddress(), 1)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+
+		_, err := srv.UnhaltChain(ctx, msg)
+		require.ErrorIs(t, err, types.ErrChainNotHalted)
+	})
+
+	t.Run("should unhalt the chain and remove its halt ballot", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		k.AddHaltedChain(ctx, 1)
+		k.AddHaltedChain(ctx, 2)
+		k.SetBallot(ctx, &types.Ballot{
+			BallotIdentifier: types.HaltChainBallotIdentifier(1),
+			ObservationType:  types.ObservationType_ChainHalt,
+			BallotStatus:     types.BallotStatus_BallotFinalized_SuccessObservation,
+		})
+
+		msg := types.NewMsgUnhaltChain(sample.AccAddress(), 1)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+
+		_, err := srv.UnhaltChain(ctx, msg)
+		require.NoError(t, err)
+		require.False(t, k.IsChainHalted(ctx, 1))
+		require.True(t, k.IsChainHalted(ctx, 2))
+
+		_, found := k.GetBallot(ctx, types.HaltChainBallotIdentifier(1))
+		require.False(t, found)
+	})
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cctypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+const voteHaltChainID = "Vote Halt Chain"
+
+// VoteHaltChain votes for the emergency halt of a connected chain, for instance if an observer
+// detects a reorg deeper than the confirmations or a drained gateway.
+//
+// The ballot uses the emergency halt threshold of the chain params, once it is reached the inbounds and outbounds of
+// the chain are disabled. The chain can only be enabled again by the authority with MsgUnhaltChain.
+//
+// Only observers are authorized to broadcast this message.
+func (k msgServer) VoteHaltChain(
+	goCtx context.Context,
+	msg *types.MsgVoteHaltChain,
+) (*types.MsgVoteHaltChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	observationChain, found := k.GetSupportedChainFromChainID(ctx, msg.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrapf(cctypes.ErrUnsupportedChain, "%s, ChainID %d", voteHaltChainID, msg.ChainId)
+	}
+
+	if ok := k.IsNonTombstonedObserver(ctx, msg.Creator); !ok {
+		return nil, sdkerrors.Wrap(types.ErrNotObserver, voteHaltChainID)
+	}
+
+	if k.IsChainHalted(ctx, msg.ChainId) {
+		return nil, sdkerrors.Wrapf(types.ErrChainHalted, "%s, ChainID %d", voteHaltChainID, msg.ChainId)
+	}
+
+	ballot, isNew, err := k.FindBallot(ctx, msg.Digest(), observationChain, types.ObservationType_ChainHalt)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, voteHaltChainID)
+	}
+	if isNew {
+		ballot.BallotThreshold = types.DefaultEmergencyHaltBallotThreshold
+		if chainParams, found := k.GetChainParamsByChainID(ctx, msg.ChainId); found {
+			ballot.BallotThreshold = chainParams.EmergencyHaltThreshold()
+		}
+		EmitEventBallotCreated(ctx, ballot, msg.Digest(), observationChain.String())
+	}
+
+	ballot, err = k.AddVoteToBallot(ctx, ballot, msg.Creator, types.VoteType_SuccessObservation)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "%s, BallotIdentifier %v", voteHaltChainID, ballot.BallotIdentifier)
+	}
+	ctx.Logger().Info(fmt.Sprintf("%s | Voter: %s, ChainID: %d, reason: %s",
+		voteHaltChainID, msg.Creator, msg.ChainId, msg.Reason))
+
+	ballot, isFinalized := k.CheckIfFinalizingVote(ctx, ballot)
+	if !isFinalized {
+		// Return nil here to add vote to ballot and commit state.
+		return &types.MsgVoteHaltChainResponse{
+			BallotCreated: isNew,
+			VoteFinalized: false,
+		}, nil
+	}
+
+	// Ballot is finalized: exactly when threshold vote is in.
+	k.AddHaltedChain(ctx, msg.ChainId)
+
+	err = ctx.EventManager().EmitTypedEvents(&types.EventChainHalted{
+		MsgTypeUrl:       sdk.MsgTypeURL(&types.MsgVoteHaltChain{}),
+		ChainId:          msg.ChainId,
+		BallotIdentifier: ballot.BallotIdentifier,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventChainHalted :", err)
+	}
+
+	return &types.MsgVoteHaltChainResponse{
+		BallotCreated: isNew,
+		VoteFinalized: true,
+	}, nil
+}
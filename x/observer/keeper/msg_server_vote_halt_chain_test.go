@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/ptr"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_VoteHaltChain(t *testing.T) {
+	t.Run("should error if supported chain not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		res, err := srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(sample.AccAddress(), 1, "reorg"))
+		require.ErrorIs(t, err, crosschaintypes.ErrUnsupportedChain)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if not an observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		setSupportedChain(ctx, *k, chainID)
+
+		res, err := srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(sample.AccAddress(), chainID, "reorg"))
+		require.ErrorIs(t, err, types.ErrNotObserver)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if chain already halted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMocksAll)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		observer := sample.AccAddress()
+		mockHaltChainVoters(t, k)
+		setSupportedChain(ctx, *k, chainID)
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{observer}})
+		k.AddHaltedChain(ctx, chainID)
+
+		res, err := srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(observer, chainID, "reorg"))
+		require.ErrorIs(t, err, types.ErrChainHalted)
+		require.Nil(t, res)
+	})
+
+	t.Run("should halt the chain once the emergency threshold is reached", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMocksAll)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		observers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+		mockHaltChainVoters(t, k)
+		setSupportedChain(ctx, *k, chainID)
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
+
+		res, err := srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(observers[0], chainID, "reorg"))
+		require.NoError(t, err)
+		require.Equal(t, &types.MsgVoteHaltChainResponse{BallotCreated: true, VoteFinalized: false}, res)
+
+		ballot, found := k.GetBallot(ctx, types.HaltChainBallotIdentifier(chainID))
+		require.True(t, found)
+		require.Equal(t, types.ObservationType_ChainHalt, ballot.ObservationType)
+		require.Equal(t, types.DefaultEmergencyHaltBallotThreshold, ballot.BallotThreshold)
+
+		// two thirds of the observers are below the emergency threshold
+		res, err = srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(observers[1], chainID, "gateway drained"))
+		require.NoError(t, err)
+		require.Equal(t, &types.MsgVoteHaltChainResponse{BallotCreated: false, VoteFinalized: false}, res)
+		require.False(t, k.IsChainHalted(ctx, chainID))
+
+		// can't vote twice
+		_, err = srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(observers[1], chainID, "reorg"))
+		require.ErrorIs(t, err, types.ErrUnableToAddVote)
+
+		res, err = srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(observers[2], chainID, "reorg"))
+		require.NoError(t, err)
+		require.Equal(t, &types.MsgVoteHaltChainResponse{BallotCreated: false, VoteFinalized: true}, res)
+		require.True(t, k.IsChainHalted(ctx, chainID))
+
+		// the global flags are unchanged
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsInboundEnabled)
+		require.True(t, flags.IsOutboundEnabled)
+		require.Equal(t, []int64{chainID}, flags.HaltedChains)
+	})
+
+	t.Run("should use the emergency halt threshold of the chain params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMocksAll)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		observers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+		mockHaltChainVoters(t, k)
+		chainParams := sample.ChainParamsSupported(chainID)
+		chainParams.EmergencyHaltBallotThreshold = ptr.Ptr(sdkmath.LegacyMustNewDecFromStr("0.5"))
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
+
+		res, err := srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(observers[0], chainID, "reorg"))
+		require.NoError(t, err)
+		require.Equal(t, &types.MsgVoteHaltChainResponse{BallotCreated: true, VoteFinalized: false}, res)
+
+		ballot, found := k.GetBallot(ctx, types.HaltChainBallotIdentifier(chainID))
+		require.True(t, found)
+		require.Equal(t, *chainParams.EmergencyHaltBallotThreshold, ballot.BallotThreshold)
+
+		// two thirds of the observers are above the chain params threshold
+		res, err = srv.VoteHaltChain(ctx, types.NewMsgVoteHaltChain(observers[1], chainID, "reorg"))
+		require.NoError(t, err)
+		require.Equal(t, &types.MsgVoteHaltChainResponse{BallotCreated: false, VoteFinalized: true}, res)
+		require.True(t, k.IsChainHalted(ctx, chainID))
+	})
+}
+
+// mockHaltChainVoters mocks the keepers so any observer is a non tombstoned validator
+func mockHaltChainVoters(t *testing.T, k *keeper.Keeper) {
+	stakingMock := keepertest.GetObserverStakingMock(t, k)
+	slashingMock := keepertest.GetObserverSlashingMock(t, k)
+	authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+	keepertest.MockGetChainListEmpty(&authorityMock.Mock)
+	stakingMock.MockGetValidator(sample.Validator(t, sample.Rand()))
+	slashingMock.MockIsTombstoned(false)
+}
@@ -24,6 +24,11 @@ func (k Keeper) VoteOnInboundBallot(
 		return false, false, types.ErrInboundDisabled
 	}
 
+	// the inbounds of a chain halted by the observers are no longer processed
+	if k.IsChainHalted(ctx, senderChainID) {
+		return false, false, sdkerrors.Wrapf(types.ErrChainHalted, "ChainID %d", senderChainID)
+	}
+
 	// makes sure we are getting only supported chains
 	// if a chain support has been turned on using gov proposal
 	// this function returns nil
@@ -36,6 +36,26 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 		require.ErrorIs(t, err, types.ErrInboundDisabled)
 	})
 
+	t.Run("fail if sender chain halted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+			IsInboundEnabled: true,
+			HaltedChains:     []int64{getValidEthChainIDWithIndex(t, 0)},
+		})
+
+		_, _, err := k.VoteOnInboundBallot(
+			ctx,
+			getValidEthChainIDWithIndex(t, 0),
+			chains.ZetaChainPrivnet.ChainId,
+			coin.CoinType_ERC20,
+			sample.AccAddress(),
+			"index",
+			"inTxHash",
+		)
+		require.ErrorIs(t, err, types.ErrChainHalted)
+	})
+
 	t.Run("fail if sender chain not supported", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
 
@@ -24,6 +24,11 @@ func (k Keeper) VoteOnOutboundBallot(
 	/* EDGE CASE : Params updated in during the finalization process
 	   i.e Inbound has been finalized but outbound is still pending
 	*/
+	// the outbounds of a chain halted by the observers are no longer processed
+	if k.IsChainHalted(ctx, outTxChainID) {
+		return false, false, ballot, "", sdkerrors.Wrapf(observertypes.ErrChainHalted, "ChainID %d", outTxChainID)
+	}
+
 	observationChain, found := k.GetSupportedChainFromChainID(ctx, outTxChainID)
 	if !found {
 		return false, false, ballot, "", observertypes.ErrSupportedChains
@@ -49,6 +49,24 @@ func TestKeeper_VoteOnOutboundBallot(t *testing.T) {
 		require.ErrorIs(t, err, types.ErrSupportedChains)
 	})
 
+	t.Run("fail if chain halted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+			IsOutboundEnabled: true,
+			HaltedChains:      []int64{getValidEthChainIDWithIndex(t, 0)},
+		})
+
+		_, _, _, _, err := k.VoteOnOutboundBallot(
+			ctx,
+			"index",
+			getValidEthChainIDWithIndex(t, 0),
+			chains.ReceiveStatus_success,
+			sample.AccAddress(),
+		)
+		require.ErrorIs(t, err, types.ErrChainHalted)
+	})
+
 	t.Run("fail if receive status is invalid", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
 
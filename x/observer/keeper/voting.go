@@ -85,12 +85,39 @@ func (k Keeper) FindBallot(
 			BallotStatus:         types.BallotStatus_BallotInProgress,
 			BallotCreationHeight: ctx.BlockHeight(),
 		}
+		if cp.StakeWeightedVoting {
+			ballot.VoterWeights = k.GetObserverWeights(ctx, observerSet.ObserverList)
+		}
 		isNew = true
 		k.AddBallotToList(ctx, ballot)
 	}
 	return
 }
 
+// GetObserverWeights returns the weights of the observers for a stake weighted ballot,
+// the weight of an observer is the consensus power of its validator, 0 if the validator is not bonded.
+// It returns nil if the observers have no weight, the observers then have the same weight on the ballot.
+func (k Keeper) GetObserverWeights(ctx sdk.Context, observers []string) []int64 {
+	weights := make([]int64, len(observers))
+	total := int64(0)
+	for i, observer := range observers {
+		valAddress, err := types.GetOperatorAddressFromAccAddress(observer)
+		if err != nil {
+			continue
+		}
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddress)
+		if err != nil || validator.Jailed || !validator.IsBonded() {
+			continue
+		}
+		weights[i] = validator.ConsensusPower(sdk.DefaultPowerReduction)
+		total += weights[i]
+	}
+	if total == 0 {
+		return nil
+	}
+	return weights
+}
+
 func (k Keeper) IsValidator(ctx sdk.Context, creator string) error {
 	valAddress, err := types.GetOperatorAddressFromAccAddress(creator)
 	if err != nil {
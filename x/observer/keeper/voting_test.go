@@ -320,6 +320,62 @@ func TestKeeper_FindBallot(t *testing.T) {
 		}, types.ObservationType_InboundTx)
 		require.Error(t, err)
 	})
+
+	t.Run("should create a ballot without weights if not stake weighted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		setSupportedChain(ctx, *k, chainID)
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{sample.AccAddress()}})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{ChainId: chainID}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Empty(t, ballot.VoterWeights)
+	})
+
+	t.Run("should create a ballot with the weights of the observers if stake weighted", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.ObserverKeeper(t)
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		chainParams := sample.ChainParams(chainID)
+		chainParams.IsSupported = true
+		chainParams.StakeWeightedVoting = true
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+
+		// a bonded validator, an unbonded validator and an observer without validator
+		r := rand.New(rand.NewSource(9))
+		observers := make([]string, 3)
+		for i, status := range []stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Unbonded} {
+			validator := sample.Validator(t, r)
+			validator.Status = status
+			validator.Tokens = sdk.TokensFromConsensusPower(int64(10*(i+1)), sdk.DefaultPowerReduction)
+			require.NoError(t, sdkk.StakingKeeper.SetValidator(ctx, validator))
+			accAddress, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+			require.NoError(t, err)
+			observers[i] = accAddress.String()
+		}
+		observers[2] = sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{ChainId: chainID}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, []int64{10, 0, 0}, ballot.VoterWeights)
+	})
+
+	t.Run("should create a ballot without weights if the observers have no weight", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		chainParams := sample.ChainParams(chainID)
+		chainParams.IsSupported = true
+		chainParams.StakeWeightedVoting = true
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{sample.AccAddress()}})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{ChainId: chainID}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Empty(t, ballot.VoterWeights)
+	})
 }
 
 func TestKeeper_VoteOnBallot(t *testing.T) {
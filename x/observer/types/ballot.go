@@ -43,24 +43,42 @@ func (m Ballot) GetVoterIndex(address string) int {
 	return index
 }
 
+// IsStakeWeighted returns true if the votes of the ballot are weighted by the voter weights
+func (m Ballot) IsStakeWeighted() bool {
+	return len(m.VoterWeights) > 0 && len(m.VoterWeights) == len(m.VoterList)
+}
+
+// GetVoterWeight returns the weight of the vote of the voter at the given index in the `VoterList`,
+// the voters have a weight of 1 if the ballot is not stake weighted
+func (m Ballot) GetVoterWeight(index int) int64 {
+	if !m.IsStakeWeighted() {
+		return 1
+	}
+	return m.VoterWeights[index]
+}
+
 // IsFinalizingVote checks sets the ballot to a final status if enough votes have been added
 // If it has already been finalized it returns false
 // It enough votes have not been added it returns false
+// The votes are weighted by the voter weights if the ballot is stake weighted
 func (m Ballot) IsFinalizingVote() (Ballot, bool) {
 	if m.BallotStatus != BallotStatus_BallotInProgress {
 		return m, false
 	}
-	success, failure := sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()
-	total := sdkmath.LegacyNewDec(int64(len(m.VoterList)))
-	if total.IsZero() {
+	success, failure, total := sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()
+	for i := range m.VoterList {
+		total = total.Add(sdkmath.LegacyNewDec(m.GetVoterWeight(i)))
+	}
+	if !total.IsPositive() {
 		return m, false
 	}
-	for _, vote := range m.Votes {
+	for i, vote := range m.Votes {
+		weight := sdkmath.LegacyNewDec(m.GetVoterWeight(i))
 		if vote == VoteType_SuccessObservation {
-			success = success.Add(sdkmath.LegacyOneDec())
+			success = success.Add(weight)
 		}
 		if vote == VoteType_FailureObservation {
-			failure = failure.Add(sdkmath.LegacyOneDec())
+			failure = failure.Add(weight)
 		}
 	}
 	if failure.IsPositive() {
@@ -87,30 +105,53 @@ func CreateVotes(listSize int) []VoteType {
 	return voterList
 }
 
+// GetVoterRewardUnits returns the reward units of the voter at the given index in the `VoterList`
+// The units of a stake weighted ballot are the fraction of the total weight held by the voter scaled to the
+// number of voters, rounded to the nearest integer, so they are comparable to the 1 unit per voter of other ballots
+func (m Ballot) GetVoterRewardUnits(index int) int64 {
+	if !m.IsStakeWeighted() {
+		return 1
+	}
+	total := sdkmath.ZeroInt()
+	for _, weight := range m.VoterWeights {
+		total = total.Add(sdkmath.NewInt(weight))
+	}
+	if !total.IsPositive() {
+		return 0
+	}
+	return sdkmath.LegacyNewDec(m.VoterWeights[index]).
+		MulInt64(int64(len(m.VoterList))).
+		QuoInt(total).
+		RoundInt64()
+}
+
 // BuildRewardsDistribution builds the rewards distribution map for the ballot
 // It returns the total rewards units which account for the observer block rewards
+// A voter earns or loses as many units as returned by GetVoterRewardUnits
 func (m Ballot) BuildRewardsDistribution(rewardsMap map[string]int64) int64 {
 	totalRewardUnits := int64(0)
 	switch m.BallotStatus {
 	case BallotStatus_BallotFinalized_SuccessObservation:
 		for _, address := range m.VoterList {
-			vote := m.Votes[m.GetVoterIndex(address)]
-			if vote == VoteType_SuccessObservation {
-				rewardsMap[address] = rewardsMap[address] + 1
-				totalRewardUnits++
+			index := m.GetVoterIndex(address)
+			units := m.GetVoterRewardUnits(index)
+			if m.Votes[index] == VoteType_SuccessObservation {
+				rewardsMap[address] = rewardsMap[address] + units
+				totalRewardUnits += units
 				continue
 			}
-			rewardsMap[address] = rewardsMap[address] - 1
+			rewardsMap[address] = rewardsMap[address] - units
 		}
 	case BallotStatus_BallotFinalized_FailureObservation:
 		for _, address := range m.VoterList {
-			vote := m.Votes[m.GetVoterIndex(address)]
-			if vote == VoteType_FailureObservation {
-				rewardsMap[address] = rewardsMap[address] + 1
-				totalRewardUnits++
+			index := m.GetVoterIndex(address)
+			units := m.GetVoterRewardUnits(index)
+			if m.Votes[index] == VoteType_FailureObservation {
+				rewardsMap[address] = rewardsMap[address] + units
+				totalRewardUnits += units
 				continue
 			}
-			rewardsMap[address] = rewardsMap[address] - 1
+			rewardsMap[address] = rewardsMap[address] - units
 		}
 	}
 	return totalRewardUnits
@@ -92,6 +92,9 @@ type Ballot struct {
 	BallotThreshold      cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ballot_threshold"`
 	BallotStatus         BallotStatus                `protobuf:"varint,7,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
 	BallotCreationHeight int64                       `protobuf:"varint,8,opt,name=ballot_creation_height,json=ballotCreationHeight,proto3" json:"ballot_creation_height,omitempty"`
+	// weights of the voters in the voter list, snapshotted at the ballot
+	// creation, the voters have the same weight if empty
+	VoterWeights []int64 `protobuf:"varint,9,rep,packed,name=voter_weights,json=voterWeights,proto3" json:"voter_weights,omitempty"`
 }
 
 func (m *Ballot) Reset()         { *m = Ballot{} }
@@ -176,6 +179,13 @@ func (m *Ballot) GetBallotCreationHeight() int64 {
 	return 0
 }
 
+func (m *Ballot) GetVoterWeights() []int64 {
+	if m != nil {
+		return m.VoterWeights
+	}
+	return nil
+}
+
 type BallotListForHeight struct {
 	Height           int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	BallotsIndexList []string `protobuf:"bytes,2,rep,name=ballots_index_list,json=ballotsIndexList,proto3" json:"ballots_index_list,omitempty"`
@@ -240,41 +250,43 @@ func init() {
 }
 
 var fileDescriptor_18c7141b763f2e87 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0x26, 0x34, 0x4b, 0x69, 0xc2, 0x12, 0x45, 0x56, 0x2a, 0xdc, 0x28, 0x08, 0x64,
-	0xd2, 0x62, 0x4b, 0x85, 0x1b, 0xb7, 0x00, 0x11, 0x91, 0xaa, 0x00, 0x6e, 0x05, 0x02, 0x0e, 0x96,
-	0x63, 0x0f, 0xf6, 0x0a, 0xc7, 0x1b, 0xed, 0x6e, 0xaa, 0x26, 0x4f, 0xc1, 0x43, 0x70, 0xe0, 0x51,
-	0x7a, 0xec, 0x09, 0x21, 0x0e, 0x15, 0x4a, 0x5e, 0x04, 0x79, 0xd7, 0x6e, 0x53, 0x29, 0xca, 0x6d,
-	0x67, 0xe6, 0xfb, 0xbe, 0xf9, 0xdb, 0x41, 0xe6, 0x1c, 0x84, 0xe7, 0x47, 0x1e, 0x49, 0x6c, 0xf9,
-	0xa2, 0x0c, 0x6c, 0x3a, 0xe2, 0xc0, 0xce, 0x80, 0xd9, 0x23, 0x2f, 0x8e, 0xa9, 0xb0, 0x26, 0x8c,
-	0x0a, 0x8a, 0xf7, 0xae, 0x91, 0x56, 0x8e, 0xb4, 0x72, 0x64, 0xab, 0x11, 0xd2, 0x90, 0x4a, 0x9c,
-	0x9d, 0xbe, 0x14, 0xa5, 0xd5, 0xdd, 0x24, 0x9e, 0x3f, 0x14, 0xb6, 0xf3, 0xbb, 0x84, 0x2a, 0x3d,
-	0x99, 0x0f, 0x37, 0x50, 0x99, 0x24, 0x01, 0x9c, 0xeb, 0x5a, 0x5b, 0x33, 0xab, 0x8e, 0x32, 0xf0,
-	0x01, 0xba, 0xaf, 0xea, 0x71, 0x49, 0x00, 0x89, 0x20, 0xdf, 0x08, 0x30, 0xbd, 0x28, 0x11, 0x75,
-	0x15, 0x18, 0x5c, 0xfb, 0xf1, 0x43, 0x84, 0xce, 0xa8, 0x00, 0xe6, 0xc6, 0x84, 0x0b, 0xbd, 0xd4,
-	0x2e, 0x99, 0x55, 0xa7, 0x2a, 0x3d, 0xc7, 0x84, 0x0b, 0xfc, 0x12, 0x95, 0x53, 0x83, 0xeb, 0x5b,
-	0xed, 0x92, 0xb9, 0x7b, 0xf4, 0xd8, 0xda, 0xd0, 0x9b, 0xf5, 0x91, 0x0a, 0x38, 0x9d, 0x4d, 0xc0,
-	0x51, 0x1c, 0xfc, 0x09, 0xd5, 0x55, 0xcc, 0x13, 0x84, 0x26, 0xae, 0x98, 0x4d, 0x40, 0x2f, 0xb7,
-	0x35, 0x73, 0xf7, 0xe8, 0x70, 0xa3, 0xce, 0xbb, 0x1b, 0x92, 0x94, 0xab, 0xd1, 0xdb, 0x0e, 0x3c,
-	0x44, 0x59, 0x23, 0xae, 0x88, 0x18, 0xf0, 0x88, 0xc6, 0x81, 0x5e, 0x49, 0x1b, 0xec, 0x3d, 0xba,
-	0xb8, 0xda, 0x2f, 0xfc, 0xbd, 0xda, 0xdf, 0xf3, 0x29, 0x1f, 0x53, 0xce, 0x83, 0xef, 0x16, 0xa1,
-	0xf6, 0xd8, 0x13, 0x91, 0x75, 0x0c, 0xa1, 0xe7, 0xcf, 0x5e, 0x83, 0xef, 0xd4, 0x14, 0xf9, 0x34,
-	0xe7, 0xe2, 0x21, 0xba, 0x97, 0xe9, 0x71, 0xe1, 0x89, 0x29, 0xd7, 0xef, 0xc8, 0x2a, 0x9f, 0x6e,
-	0xac, 0x52, 0xed, 0xe0, 0x44, 0x12, 0x9c, 0x9d, 0xd1, 0x8a, 0x85, 0x5f, 0xa0, 0x66, 0xa6, 0xe7,
-	0x33, 0x50, 0xcd, 0x47, 0x40, 0xc2, 0x48, 0xe8, 0xdb, 0x6d, 0xcd, 0x2c, 0x39, 0x0d, 0x15, 0x7d,
-	0x95, 0x05, 0xdf, 0xca, 0x58, 0xe7, 0x2b, 0x7a, 0xa0, 0x34, 0xd3, 0xc9, 0xf7, 0x29, 0x53, 0x6e,
-	0xdc, 0x44, 0x95, 0x8c, 0xac, 0x49, 0x72, 0x66, 0xe1, 0x43, 0x84, 0x95, 0x0c, 0x77, 0xe5, 0xde,
-	0xd5, 0x06, 0x8b, 0x72, 0x83, 0xd9, 0x78, 0xf8, 0x20, 0x0d, 0xa4, 0x72, 0xdd, 0x0f, 0x68, 0x3b,
-	0x5f, 0x0f, 0x6e, 0x22, 0x7c, 0x32, 0xf5, 0x7d, 0xe0, 0x7c, 0x65, 0xd2, 0xf5, 0x42, 0xea, 0xef,
-	0x7b, 0x24, 0x9e, 0x32, 0x58, 0xf5, 0x6b, 0xb8, 0x86, 0xee, 0x0e, 0xa9, 0xf8, 0x0c, 0x22, 0x55,
-	0x08, 0xea, 0xc5, 0xd6, 0xd6, 0xaf, 0x9f, 0x86, 0xd6, 0x9d, 0xa3, 0x9d, 0xd5, 0x19, 0xe0, 0x27,
-	0xa8, 0xa3, 0xec, 0x3e, 0x49, 0xbc, 0x98, 0xcc, 0x21, 0x70, 0xd7, 0xa6, 0x59, 0x83, 0x5b, 0x9b,
-	0xb6, 0x81, 0xea, 0x0a, 0x37, 0x48, 0xde, 0x33, 0x1a, 0x32, 0xe0, 0x3c, 0xcf, 0xdd, 0x7b, 0x73,
-	0xb1, 0x30, 0xb4, 0xcb, 0x85, 0xa1, 0xfd, 0x5b, 0x18, 0xda, 0x8f, 0xa5, 0x51, 0xb8, 0x5c, 0x1a,
-	0x85, 0x3f, 0x4b, 0xa3, 0xf0, 0xe5, 0x20, 0x24, 0x22, 0x9a, 0x8e, 0x2c, 0x9f, 0x8e, 0xe5, 0x2d,
-	0x3d, 0x53, 0x67, 0x95, 0xd0, 0x00, 0xec, 0xf3, 0x9b, 0xa3, 0x4a, 0x3f, 0x23, 0x1f, 0x55, 0xe4,
-	0x49, 0x3d, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x59, 0x7b, 0x9c, 0x5c, 0xdd, 0x03, 0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xe3, 0x26, 0x5f, 0x33, 0x5f, 0xda, 0x84, 0x21, 0x8a, 0xac, 0x54, 0xb8, 0x56, 0x2a,
+	0x90, 0x49, 0x8b, 0x2d, 0x15, 0x76, 0xec, 0x02, 0x44, 0x44, 0xaa, 0x02, 0xb8, 0x15, 0x15, 0xb0,
+	0xb0, 0xfc, 0x33, 0xd8, 0x23, 0x1c, 0x4f, 0x34, 0x33, 0x29, 0x4d, 0x9e, 0x82, 0x87, 0x60, 0xc1,
+	0xa3, 0x74, 0xd9, 0x25, 0x62, 0x51, 0xa1, 0xe4, 0x31, 0xd8, 0x20, 0xcf, 0xd8, 0x6d, 0x90, 0xa2,
+	0xec, 0xe6, 0xde, 0x7b, 0xee, 0x39, 0x73, 0xe7, 0xcc, 0x05, 0xe6, 0x1c, 0x71, 0x2f, 0x88, 0x3d,
+	0x9c, 0xda, 0xe2, 0x44, 0x28, 0xb2, 0x89, 0xcf, 0x10, 0xbd, 0x40, 0xd4, 0xf6, 0xbd, 0x24, 0x21,
+	0xdc, 0x9a, 0x50, 0xc2, 0x09, 0xdc, 0xbb, 0x45, 0x5a, 0x05, 0xd2, 0x2a, 0x90, 0x9d, 0x56, 0x44,
+	0x22, 0x22, 0x70, 0x76, 0x76, 0x92, 0x2d, 0x9d, 0xde, 0x26, 0xf2, 0xe2, 0x20, 0xb1, 0xdd, 0x3f,
+	0x2a, 0xa8, 0xf6, 0x85, 0x1e, 0x6c, 0x81, 0x0a, 0x4e, 0x43, 0x74, 0xa9, 0x29, 0x86, 0x62, 0xd6,
+	0x1c, 0x19, 0xc0, 0x43, 0x70, 0x4f, 0xde, 0xc7, 0xc5, 0x21, 0x4a, 0x39, 0xfe, 0x8c, 0x11, 0xd5,
+	0xca, 0x02, 0xd1, 0x94, 0x85, 0xe1, 0x6d, 0x1e, 0x3e, 0x00, 0xe0, 0x82, 0x70, 0x44, 0xdd, 0x04,
+	0x33, 0xae, 0xa9, 0x86, 0x6a, 0xd6, 0x9c, 0x9a, 0xc8, 0x9c, 0x60, 0xc6, 0xe1, 0x73, 0x50, 0xc9,
+	0x02, 0xa6, 0x6d, 0x19, 0xaa, 0xb9, 0x7b, 0xfc, 0xd0, 0xda, 0x30, 0x9b, 0xf5, 0x9e, 0x70, 0x74,
+	0x36, 0x9b, 0x20, 0x47, 0xf6, 0xc0, 0x73, 0xd0, 0x94, 0x35, 0x8f, 0x63, 0x92, 0xba, 0x7c, 0x36,
+	0x41, 0x5a, 0xc5, 0x50, 0xcc, 0xdd, 0xe3, 0xa3, 0x8d, 0x3c, 0x6f, 0xee, 0x9a, 0x04, 0x5d, 0x83,
+	0xfc, 0x9b, 0x80, 0x23, 0x90, 0x0f, 0xe2, 0xf2, 0x98, 0x22, 0x16, 0x93, 0x24, 0xd4, 0xaa, 0xd9,
+	0x80, 0xfd, 0x83, 0xab, 0x9b, 0xfd, 0xd2, 0xaf, 0x9b, 0xfd, 0xbd, 0x80, 0xb0, 0x31, 0x61, 0x2c,
+	0xfc, 0x62, 0x61, 0x62, 0x8f, 0x3d, 0x1e, 0x5b, 0x27, 0x28, 0xf2, 0x82, 0xd9, 0x4b, 0x14, 0x38,
+	0x0d, 0xd9, 0x7c, 0x56, 0xf4, 0xc2, 0x11, 0xd8, 0xc9, 0xf9, 0x18, 0xf7, 0xf8, 0x94, 0x69, 0xff,
+	0x89, 0x5b, 0x3e, 0xde, 0x78, 0x4b, 0xe9, 0xc1, 0xa9, 0x68, 0x70, 0xea, 0xfe, 0x4a, 0x04, 0x9f,
+	0x81, 0x76, 0xce, 0x17, 0x50, 0x24, 0x87, 0x8f, 0x11, 0x8e, 0x62, 0xae, 0x6d, 0x1b, 0x8a, 0xa9,
+	0x3a, 0x2d, 0x59, 0x7d, 0x91, 0x17, 0x5f, 0x8b, 0x1a, 0x3c, 0x00, 0x3b, 0xd2, 0x8a, 0xaf, 0x22,
+	0x66, 0x5a, 0xcd, 0x50, 0x4d, 0xd5, 0xa9, 0x8b, 0xe4, 0xb9, 0xcc, 0x75, 0x3f, 0x81, 0xfb, 0x52,
+	0x38, 0xb3, 0x67, 0x40, 0x68, 0xde, 0xdb, 0x06, 0xd5, 0x5c, 0x41, 0x11, 0x0a, 0x79, 0x04, 0x8f,
+	0x00, 0x94, 0x5a, 0xcc, 0x15, 0x9f, 0x43, 0xda, 0x5c, 0x16, 0x36, 0xe7, 0x6f, 0xc8, 0x86, 0x59,
+	0x21, 0xa3, 0xeb, 0xbd, 0x03, 0xdb, 0x85, 0x87, 0xb0, 0x0d, 0xe0, 0xe9, 0x34, 0x08, 0x10, 0x63,
+	0x2b, 0x76, 0x34, 0x4b, 0x59, 0x7e, 0xe0, 0xe1, 0x64, 0x4a, 0xd1, 0x6a, 0x5e, 0x81, 0x0d, 0xf0,
+	0xff, 0x88, 0xf0, 0x0f, 0x88, 0x67, 0x0c, 0x61, 0xb3, 0xdc, 0xd9, 0xfa, 0xf1, 0x5d, 0x57, 0x7a,
+	0x73, 0x50, 0x5f, 0x7d, 0x28, 0xf8, 0x08, 0x74, 0x65, 0x3c, 0xc0, 0xa9, 0x97, 0xe0, 0x39, 0x0a,
+	0xdd, 0xb5, 0x32, 0x6b, 0x70, 0x6b, 0x65, 0x5b, 0xa0, 0x29, 0x71, 0xc3, 0xf4, 0x2d, 0x25, 0x11,
+	0x45, 0x8c, 0x15, 0xda, 0xfd, 0x57, 0x57, 0x0b, 0x5d, 0xb9, 0x5e, 0xe8, 0xca, 0xef, 0x85, 0xae,
+	0x7c, 0x5b, 0xea, 0xa5, 0xeb, 0xa5, 0x5e, 0xfa, 0xb9, 0xd4, 0x4b, 0x1f, 0x0f, 0x23, 0xcc, 0xe3,
+	0xa9, 0x6f, 0x05, 0x64, 0x2c, 0x16, 0xee, 0x89, 0xdc, 0xbd, 0x94, 0x84, 0xc8, 0xbe, 0xbc, 0xdb,
+	0xbc, 0xec, 0xc7, 0x32, 0xbf, 0x2a, 0xf6, 0xee, 0xe9, 0xdf, 0x01, 0x00, 0x4f, 0xea, 0x0d, 0xf9,
+	0x02, 0x04, 0x00, 0x00,
 }
 
 func (m *Ballot) Marshal() (dAtA []byte, err error) {
@@ -297,6 +309,25 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoterWeights) > 0 {
+		dAtA2 := make([]byte, len(m.VoterWeights)*10)
+		var j1 int
+		for _, num1 := range m.VoterWeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBallot(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.BallotCreationHeight != 0 {
 		i = encodeVarintBallot(dAtA, i, uint64(m.BallotCreationHeight))
 		i--
@@ -323,20 +354,20 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if len(m.Votes) > 0 {
-		dAtA2 := make([]byte, len(m.Votes)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.Votes)*10)
+		var j3 int
 		for _, num := range m.Votes {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintBallot(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintBallot(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -452,6 +483,13 @@ func (m *Ballot) Size() (n int) {
 	if m.BallotCreationHeight != 0 {
 		n += 1 + sovBallot(uint64(m.BallotCreationHeight))
 	}
+	if len(m.VoterWeights) > 0 {
+		l = 0
+		for _, e := range m.VoterWeights {
+			l += sovBallot(uint64(e))
+		}
+		n += 1 + sovBallot(uint64(l)) + l
+	}
 	return n
 }
 
@@ -764,6 +802,82 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBallot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VoterWeights = append(m.VoterWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBallot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBallot
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBallot
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoterWeights) == 0 {
+					m.VoterWeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBallot
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoterWeights = append(m.VoterWeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterWeights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBallot(dAtA[iNdEx:])
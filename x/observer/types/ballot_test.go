@@ -417,13 +417,94 @@ func TestBallot_IsFinalizingVote(t *testing.T) {
 	}
 }
 
+func TestBallot_IsFinalizingVoteStakeWeighted(t *testing.T) {
+	t.Run("finalized once the weight of the votes reaches the threshold", func(t *testing.T) {
+		ballot := Ballot{
+			BallotStatus:    BallotStatus_BallotInProgress,
+			BallotThreshold: sdkmath.LegacyMustNewDecFromStr("0.66"),
+			VoterList:       []string{"Observer1", "Observer2", "Observer3", "Observer4"},
+			Votes:           CreateVotes(4),
+			VoterWeights:    []int64{10, 10, 10, 70},
+		}
+
+		// 3 of the 4 voters don't have enough weight
+		ballot.Votes[0] = VoteType_SuccessObservation
+		ballot.Votes[1] = VoteType_SuccessObservation
+		ballot.Votes[2] = VoteType_SuccessObservation
+		ballot, isFinalizingVote := ballot.IsFinalizingVote()
+		require.False(t, isFinalizingVote)
+		require.Equal(t, BallotStatus_BallotInProgress, ballot.BallotStatus)
+
+		ballot.Votes[3] = VoteType_SuccessObservation
+		ballot, isFinalizingVote = ballot.IsFinalizingVote()
+		require.True(t, isFinalizingVote)
+		require.Equal(t, BallotStatus_BallotFinalized_SuccessObservation, ballot.BallotStatus)
+	})
+
+	t.Run("finalized by a single voter with enough weight", func(t *testing.T) {
+		ballot := Ballot{
+			BallotStatus:    BallotStatus_BallotInProgress,
+			BallotThreshold: sdkmath.LegacyMustNewDecFromStr("0.66"),
+			VoterList:       []string{"Observer1", "Observer2", "Observer3", "Observer4"},
+			Votes:           CreateVotes(4),
+			VoterWeights:    []int64{10, 10, 10, 70},
+		}
+
+		ballot.Votes[3] = VoteType_FailureObservation
+		ballot, isFinalizingVote := ballot.IsFinalizingVote()
+		require.True(t, isFinalizingVote)
+		require.Equal(t, BallotStatus_BallotFinalized_FailureObservation, ballot.BallotStatus)
+	})
+
+	t.Run("not finalized if the voters have no weight", func(t *testing.T) {
+		ballot := Ballot{
+			BallotStatus:    BallotStatus_BallotInProgress,
+			BallotThreshold: sdkmath.LegacyMustNewDecFromStr("0.66"),
+			VoterList:       []string{"Observer1", "Observer2"},
+			Votes:           []VoteType{VoteType_SuccessObservation, VoteType_SuccessObservation},
+			VoterWeights:    []int64{0, 0},
+		}
+
+		ballot, isFinalizingVote := ballot.IsFinalizingVote()
+		require.False(t, isFinalizingVote)
+		require.Equal(t, BallotStatus_BallotInProgress, ballot.BallotStatus)
+	})
+}
+
+func TestBallot_GetVoterWeight(t *testing.T) {
+	ballot := Ballot{
+		VoterList: []string{"Observer1", "Observer2"},
+	}
+	require.False(t, ballot.IsStakeWeighted())
+	require.EqualValues(t, 1, ballot.GetVoterWeight(1))
+
+	ballot.VoterWeights = []int64{10, 20}
+	require.True(t, ballot.IsStakeWeighted())
+	require.EqualValues(t, 20, ballot.GetVoterWeight(1))
+}
+
+func TestBallot_GetVoterRewardUnits(t *testing.T) {
+	ballot := Ballot{VoterList: []string{"Observer1", "Observer2", "Observer3"}}
+	require.EqualValues(t, 1, ballot.GetVoterRewardUnits(0))
+
+	ballot.VoterWeights = []int64{10, 20, 70}
+	require.EqualValues(t, 0, ballot.GetVoterRewardUnits(0))
+	require.EqualValues(t, 1, ballot.GetVoterRewardUnits(1))
+	require.EqualValues(t, 2, ballot.GetVoterRewardUnits(2))
+
+	ballot.VoterWeights = []int64{0, 0, 0}
+	require.EqualValues(t, 0, ballot.GetVoterRewardUnits(0))
+}
+
 func Test_BuildRewardsDistribution(t *testing.T) {
 	tt := []struct {
-		name         string
-		voterList    []string
-		votes        []VoteType
-		ballotStatus BallotStatus
-		expectedMap  map[string]int64
+		name          string
+		voterList     []string
+		votes         []VoteType
+		voterWeights  []int64
+		ballotStatus  BallotStatus
+		expectedMap   map[string]int64
+		expectedUnits int64
 	}{
 		{
 			name:      "BallotFinalized_SuccessObservation",
@@ -441,6 +522,7 @@ func Test_BuildRewardsDistribution(t *testing.T) {
 				"Observer3": 1,
 				"Observer4": -1,
 			},
+			expectedUnits: 3,
 		},
 		{
 			name:      "BallotFinalized_FailureObservation",
@@ -458,6 +540,43 @@ func Test_BuildRewardsDistribution(t *testing.T) {
 				"Observer3": 1,
 				"Observer4": 1,
 			},
+			expectedUnits: 2,
+		},
+		{
+			name:      "BallotFinalized_SuccessObservation stake weighted",
+			voterList: []string{"Observer1", "Observer2", "Observer3", "Observer4"},
+			votes: []VoteType{
+				VoteType_SuccessObservation,
+				VoteType_SuccessObservation,
+				VoteType_FailureObservation,
+				VoteType_NotYetVoted,
+			},
+			voterWeights: []int64{10, 30, 20, 40},
+			ballotStatus: BallotStatus_BallotFinalized_SuccessObservation,
+			expectedMap: map[string]int64{
+				"Observer1": 0,
+				"Observer2": 1,
+				"Observer3": -1,
+				"Observer4": -2,
+			},
+			expectedUnits: 1,
+		},
+		{
+			name:      "BallotFinalized_FailureObservation stake weighted with large weights",
+			voterList: []string{"Observer1", "Observer2", "Observer3"},
+			votes: []VoteType{
+				VoteType_FailureObservation,
+				VoteType_SuccessObservation,
+				VoteType_NotYetVoted,
+			},
+			voterWeights: []int64{6_000_000, 2_000_000, 2_000_000},
+			ballotStatus: BallotStatus_BallotFinalized_FailureObservation,
+			expectedMap: map[string]int64{
+				"Observer1": 2,
+				"Observer2": -1,
+				"Observer3": -1,
+			},
+			expectedUnits: 2,
 		},
 	}
 	for _, test := range tt {
@@ -471,10 +590,12 @@ func Test_BuildRewardsDistribution(t *testing.T) {
 				ObservationType:  0,
 				BallotThreshold:  sdkmath.LegacyDec{},
 				BallotStatus:     test.ballotStatus,
+				VoterWeights:     test.voterWeights,
 			}
 			rewardsMap := map[string]int64{}
-			ballot.BuildRewardsDistribution(rewardsMap)
+			units := ballot.BuildRewardsDistribution(rewardsMap)
 			require.Equal(t, test.expectedMap, rewardsMap)
+			require.Equal(t, test.expectedUnits, units)
 		})
 	}
 }
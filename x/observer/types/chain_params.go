@@ -15,6 +15,12 @@ import (
 var (
 	DefaultMinObserverDelegation = sdkmath.LegacyMustNewDecFromStr("1000000000000000000000")
 	DefaultBallotThreshold       = sdkmath.LegacyMustNewDecFromStr("0.66")
+
+	// DefaultEmergencyHaltBallotThreshold is the threshold of the ballots voting the emergency halt of a chain
+	// if not set in the chain params. A halt stops all the cctxs of the chain and only the admin policy can undo it,
+	// so it requires a supermajority of the observers well above the default ballot threshold: a single observer
+	// or a small group (e.g. sharing a faulty RPC node) can't halt a chain.
+	DefaultEmergencyHaltBallotThreshold = sdkmath.LegacyMustNewDecFromStr("0.8")
 )
 
 // Validate checks that the ConfirmationParams is valid
@@ -140,6 +146,12 @@ func (cp ChainParams) Validate() error {
 		)
 	}
 
+	// the Sui gateway is identified by its package ID and the ID of the gateway object,
+	// the package ID alone (previous format) only allows to observe inbounds
+	if chains.IsSuiChain(cp.ChainId, nil) && cp.GatewayAddress != "" && !validSuiGatewayAddress(cp.GatewayAddress) {
+		return fmt.Errorf("invalid Sui GatewayAddress %s", cp.GatewayAddress)
+	}
+
 	if cp.BallotThreshold.IsNil() || cp.BallotThreshold.GT(sdkmath.LegacyOneDec()) {
 		return ErrParamsThreshold
 	}
@@ -148,9 +160,24 @@ func (cp ChainParams) Validate() error {
 		return ErrParamsMinObserverDelegation
 	}
 
+	// the emergency halt threshold is optional, the default one is used if not set
+	if threshold := cp.EmergencyHaltBallotThreshold; threshold != nil &&
+		(threshold.IsNil() || !threshold.IsPositive() || threshold.GT(sdkmath.LegacyOneDec())) {
+		return ErrParamsThreshold
+	}
+
 	return nil
 }
 
+// EmergencyHaltThreshold returns the threshold of the ballots voting the emergency halt of the chain
+// It falls back to DefaultEmergencyHaltBallotThreshold if the threshold is not set.
+func (cp ChainParams) EmergencyHaltThreshold() sdkmath.LegacyDec {
+	if cp.EmergencyHaltBallotThreshold == nil || cp.EmergencyHaltBallotThreshold.IsNil() {
+		return DefaultEmergencyHaltBallotThreshold
+	}
+	return *cp.EmergencyHaltBallotThreshold
+}
+
 // InboundConfirmationSafe returns the safe number of confirmation for inbound observation.
 func (cp ChainParams) InboundConfirmationSafe() uint64 {
 	return cp.ConfirmationParams.SafeInboundCount
@@ -186,6 +213,21 @@ func validChainContractAddress(address string) bool {
 	return ethchains.IsHexAddress(address)
 }
 
+// validSuiGatewayAddress checks the Sui gateway address is a pair "$packageID,$gatewayObjectID" or a package ID
+// it mirrors the address parsing of the Sui gateway without importing the contracts package
+func validSuiGatewayAddress(address string) bool {
+	parts := strings.Split(address, ",")
+	if len(parts) > 2 {
+		return false
+	}
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			return false
+		}
+	}
+	return true
+}
+
 // GetDefaultChainParams returns a list of default chain params
 // TODO: remove this function
 // https://github.com/zeta-chain/node-private/issues/100
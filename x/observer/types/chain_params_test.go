@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	. "gopkg.in/check.v1"
 
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/ptr"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/observer/types"
 )
@@ -326,6 +328,27 @@ func (s *UpdateChainParamsSuite) TestCoreContractAddresses() {
 	require.Error(s.T(), cp.Validate())
 }
 
+func (s *UpdateChainParamsSuite) TestSuiGatewayAddress() {
+	cp := *s.evmParams
+	cp.ChainId = chains.SuiMainnet.ChainId
+
+	cp.GatewayAddress = ""
+	require.NoError(s.T(), cp.Validate())
+
+	cp.GatewayAddress = "0x1,0x2"
+	require.NoError(s.T(), cp.Validate())
+
+	// package ID only
+	cp.GatewayAddress = "0x1"
+	require.NoError(s.T(), cp.Validate())
+
+	cp.GatewayAddress = "0x1,"
+	require.Error(s.T(), cp.Validate())
+
+	cp.GatewayAddress = "0x1,0x2,0x3"
+	require.Error(s.T(), cp.Validate())
+}
+
 func Test_InboundConfirmationSafe(t *testing.T) {
 	cp := sample.ChainParams(1)
 
@@ -378,6 +401,20 @@ func Test_OutboundConfirmationFast(t *testing.T) {
 	})
 }
 
+func TestChainParams_EmergencyHaltThreshold(t *testing.T) {
+	t.Run("should return default threshold if not set", func(t *testing.T) {
+		cp := sample.ChainParams(1)
+		cp.EmergencyHaltBallotThreshold = nil
+		require.Equal(t, types.DefaultEmergencyHaltBallotThreshold, cp.EmergencyHaltThreshold())
+	})
+
+	t.Run("should return chain params threshold if set", func(t *testing.T) {
+		cp := sample.ChainParams(1)
+		cp.EmergencyHaltBallotThreshold = ptr.Ptr(sdkmath.LegacyMustNewDecFromStr("0.8"))
+		require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.8"), cp.EmergencyHaltThreshold())
+	})
+}
+
 func (s *UpdateChainParamsSuite) Validate(params *types.ChainParams) {
 	cp := copyParams(params)
 	cp.ConfirmationCount = 0
@@ -454,6 +491,18 @@ func (s *UpdateChainParamsSuite) Validate(params *types.ChainParams) {
 	require.Error(s.T(), cp.Validate())
 	cp.MinObserverDelegation = sdkmath.LegacyMustNewDecFromStr("0.9")
 	require.NoError(s.T(), cp.Validate())
+
+	cp = copyParams(params)
+	cp.EmergencyHaltBallotThreshold = nil
+	require.NoError(s.T(), cp.Validate())
+	cp.EmergencyHaltBallotThreshold = &sdkmath.LegacyDec{}
+	require.Error(s.T(), cp.Validate())
+	cp.EmergencyHaltBallotThreshold = ptr.Ptr(sdkmath.LegacyZeroDec())
+	require.Error(s.T(), cp.Validate())
+	cp.EmergencyHaltBallotThreshold = ptr.Ptr(sdkmath.LegacyMustNewDecFromStr("1.2"))
+	require.Error(s.T(), cp.Validate())
+	cp.EmergencyHaltBallotThreshold = ptr.Ptr(sdkmath.LegacyMustNewDecFromStr("0.9"))
+	require.NoError(s.T(), cp.Validate())
 }
 
 // copyParams creates a deep copy of the given ChainParams.
@@ -23,6 +23,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateGasPriceIncreaseFlags{}, "observer/UpdateGasPriceIncreaseFlags", nil)
 	cdc.RegisterConcrete(&MsgUpdateOperationalFlags{}, "observer/UpdateOperationalFlags", nil)
 	cdc.RegisterConcrete(&MsgUpdateOperationalChainParams{}, "observer/UpdateOperationalChainParams", nil)
+	cdc.RegisterConcrete(&MsgRejoinObserverSet{}, "observer/RejoinObserverSet", nil)
+	cdc.RegisterConcrete(&MsgVoteHaltChain{}, "observer/VoteHaltChain", nil)
+	cdc.RegisterConcrete(&MsgUnhaltChain{}, "observer/UnhaltChain", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -42,6 +45,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateGasPriceIncreaseFlags{},
 		&MsgUpdateOperationalFlags{},
 		&MsgUpdateOperationalChainParams{},
+		&MsgRejoinObserverSet{},
+		&MsgVoteHaltChain{},
+		&MsgUnhaltChain{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
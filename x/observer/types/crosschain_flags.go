@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 var DefaultGasPriceIncreaseFlags = GasPriceIncreaseFlags{
 	// EpochLength is the number of blocks in an epoch before triggering a gas price increase
@@ -29,3 +32,26 @@ func DefaultCrosschainFlags() *CrosschainFlags {
 		GasPriceIncreaseFlags: &DefaultGasPriceIncreaseFlags,
 	}
 }
+
+// IsChainHalted returns true if the chain has been halted by the observers
+func (m *CrosschainFlags) IsChainHalted(chainID int64) bool {
+	return slices.Contains(m.GetHaltedChains(), chainID)
+}
+
+// HaltChain adds the chain to the halted chains, it returns false if the chain is already halted
+func (m *CrosschainFlags) HaltChain(chainID int64) bool {
+	if m.IsChainHalted(chainID) {
+		return false
+	}
+	m.HaltedChains = append(m.HaltedChains, chainID)
+	return true
+}
+
+// UnhaltChain removes the chain from the halted chains, it returns false if the chain is not halted
+func (m *CrosschainFlags) UnhaltChain(chainID int64) bool {
+	if !m.IsChainHalted(chainID) {
+		return false
+	}
+	m.HaltedChains = slices.DeleteFunc(m.HaltedChains, func(id int64) bool { return id == chainID })
+	return true
+}
@@ -111,6 +111,9 @@ type CrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags *GasPriceIncreaseFlags `protobuf:"bytes,3,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
+	// chains halted by an emergency vote of the observers, the inbounds and
+	// outbounds of these chains are disabled
+	HaltedChains []int64 `protobuf:"varint,4,rep,packed,name=haltedChains,proto3" json:"haltedChains,omitempty"`
 }
 
 func (m *CrosschainFlags) Reset()         { *m = CrosschainFlags{} }
@@ -167,6 +170,13 @@ func (m *CrosschainFlags) GetGasPriceIncreaseFlags() *GasPriceIncreaseFlags {
 	return nil
 }
 
+func (m *CrosschainFlags) GetHaltedChains() []int64 {
+	if m != nil {
+		return m.HaltedChains
+	}
+	return nil
+}
+
 type LegacyCrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
@@ -238,34 +248,35 @@ func init() {
 }
 
 var fileDescriptor_f617dc4ef266f323 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x53, 0x4d, 0x6f, 0xd4, 0x30,
-	0x10, 0x8d, 0xb7, 0x80, 0x2a, 0xaf, 0xaa, 0x82, 0x61, 0x45, 0x28, 0x52, 0x1a, 0xed, 0x29, 0xe2,
-	0xc3, 0x41, 0xe1, 0xc2, 0xb9, 0xa5, 0xa0, 0x48, 0x45, 0xac, 0x72, 0xe4, 0x82, 0x1c, 0x67, 0xea,
-	0x44, 0x4a, 0xed, 0x95, 0xed, 0x54, 0x59, 0x7e, 0x05, 0x47, 0xc4, 0x2f, 0xea, 0xb1, 0x17, 0x24,
-	0x24, 0x24, 0x40, 0xbb, 0x7f, 0x04, 0xd5, 0x61, 0x0b, 0xbb, 0x1b, 0xf8, 0x01, 0xbd, 0x4d, 0xe6,
-	0xbd, 0x97, 0x67, 0xcf, 0xf3, 0xe0, 0xe4, 0x03, 0x58, 0xc6, 0x4b, 0x56, 0xc9, 0xd8, 0x55, 0x4a,
-	0x43, 0xac, 0x72, 0x03, 0xfa, 0x0c, 0x74, 0xcc, 0xb5, 0x32, 0xc6, 0x81, 0xef, 0x4f, 0x6a, 0x26,
-	0x0c, 0x9d, 0x6a, 0x65, 0x15, 0x79, 0x78, 0xa5, 0xa1, 0x4b, 0x0d, 0x5d, 0x6a, 0xf6, 0xee, 0x09,
-	0x25, 0x94, 0xe3, 0xc5, 0x97, 0x55, 0x27, 0xd9, 0x0b, 0x84, 0x52, 0xa2, 0x86, 0xd8, 0x7d, 0xe5,
-	0xcd, 0x49, 0x5c, 0x34, 0x9a, 0xd9, 0x4a, 0xc9, 0x0e, 0x1f, 0x7f, 0x1e, 0xe0, 0xd1, 0x6b, 0x66,
-	0x26, 0xba, 0xe2, 0x90, 0x4a, 0xae, 0x81, 0x19, 0x78, 0x75, 0x69, 0x49, 0x42, 0x3c, 0x84, 0xa9,
-	0xe2, 0xe5, 0x31, 0x48, 0x61, 0x4b, 0x1f, 0x85, 0x28, 0xda, 0xca, 0xfe, 0x6e, 0x91, 0x14, 0xef,
-	0x68, 0xb0, 0x7a, 0x96, 0x4a, 0x0b, 0xfa, 0x8c, 0xd5, 0xfe, 0x20, 0x44, 0xd1, 0x30, 0x79, 0x40,
-	0x3b, 0x4f, 0xba, 0xf4, 0xa4, 0x2f, 0x7f, 0x7b, 0x1e, 0x6c, 0x9f, 0x7f, 0xdf, 0xf7, 0x3e, 0xfd,
-	0xd8, 0x47, 0xd9, 0xaa, 0x92, 0xbc, 0xc0, 0xf7, 0xc5, 0xda, 0x29, 0x26, 0xa0, 0x39, 0x48, 0xeb,
-	0x6f, 0x85, 0x28, 0xda, 0xc9, 0xfe, 0x05, 0x93, 0x67, 0xf8, 0xee, 0x3a, 0xf4, 0x86, 0xb5, 0xfe,
-	0x0d, 0xa7, 0xea, 0x83, 0x48, 0x84, 0x77, 0x4f, 0x59, 0x3b, 0x01, 0x59, 0x54, 0x52, 0x1c, 0x72,
-	0xdb, 0x1a, 0xff, 0xa6, 0x63, 0xaf, 0xb7, 0xc7, 0x5f, 0x10, 0xde, 0x3d, 0xbc, 0x8a, 0xa2, 0x1b,
-	0xcb, 0x23, 0x7c, 0xbb, 0x32, 0xa9, 0xcc, 0x55, 0x23, 0x8b, 0x23, 0xc9, 0xf2, 0x1a, 0x0a, 0x37,
-	0x9b, 0xed, 0x6c, 0xa3, 0x4f, 0x9e, 0xe0, 0x3b, 0x95, 0x79, 0xdb, 0xd8, 0x15, 0xf2, 0xc0, 0x91,
-	0x37, 0x01, 0x52, 0xe2, 0x91, 0xe8, 0x4b, 0xc2, 0x4d, 0x60, 0x98, 0x24, 0xf4, 0x3f, 0xe9, 0xd3,
-	0xde, 0x0c, 0xb3, 0xfe, 0x1f, 0x8e, 0xbf, 0x21, 0x3c, 0x3a, 0x06, 0xc1, 0xf8, 0xec, 0x1a, 0xde,
-	0xee, 0xe0, 0xe8, 0x7c, 0x1e, 0xa0, 0x8b, 0x79, 0x80, 0x7e, 0xce, 0x03, 0xf4, 0x71, 0x11, 0x78,
-	0x17, 0x8b, 0xc0, 0xfb, 0xba, 0x08, 0xbc, 0x77, 0x8f, 0x45, 0x65, 0xcb, 0x26, 0xa7, 0x5c, 0x9d,
-	0xba, 0xa5, 0x7b, 0xda, 0xed, 0x9f, 0x54, 0x05, 0xc4, 0xed, 0x9f, 0xed, 0xb3, 0xb3, 0x29, 0x98,
-	0xfc, 0x96, 0x7b, 0xbe, 0xcf, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x51, 0x22, 0x6b, 0xce, 0xa9,
-	0x03, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0xad, 0xdb, 0x81, 0x26, 0x97, 0x69, 0x60, 0xa8, 0x08, 0x43, 0xca, 0xa2, 0x9e, 0x22, 0xfe,
+	0x38, 0xa8, 0x5c, 0x38, 0xaf, 0x0c, 0x14, 0x69, 0x88, 0x2a, 0x47, 0x2e, 0xc8, 0x71, 0x7e, 0x73,
+	0x22, 0x65, 0x76, 0x65, 0x3b, 0x53, 0xca, 0xa7, 0xe0, 0x88, 0xf8, 0x44, 0x3b, 0xee, 0x88, 0x84,
+	0x04, 0xa8, 0xfd, 0x14, 0xdc, 0x50, 0x1d, 0x3a, 0x68, 0x1b, 0xf8, 0x00, 0xbb, 0x39, 0xbf, 0xf7,
+	0x9e, 0x5f, 0xf4, 0x9e, 0x7f, 0x78, 0xf4, 0x01, 0x2c, 0xe3, 0x39, 0x2b, 0x64, 0xe4, 0x4e, 0x4a,
+	0x43, 0xa4, 0x52, 0x03, 0xfa, 0x1c, 0x74, 0xc4, 0xb5, 0x32, 0xc6, 0x81, 0xef, 0x4f, 0x4b, 0x26,
+	0x0c, 0x9d, 0x6a, 0x65, 0x15, 0x79, 0x78, 0xa5, 0xa1, 0x2b, 0x0d, 0x5d, 0x69, 0x0e, 0xee, 0x09,
+	0x25, 0x94, 0xe3, 0x45, 0xcb, 0x53, 0x23, 0x39, 0xf0, 0x85, 0x52, 0xa2, 0x84, 0xc8, 0x7d, 0xa5,
+	0xd5, 0x69, 0x94, 0x55, 0x9a, 0xd9, 0x42, 0xc9, 0x06, 0x1f, 0x7e, 0xee, 0xe2, 0xc1, 0x6b, 0x66,
+	0x26, 0xba, 0xe0, 0x10, 0x4b, 0xae, 0x81, 0x19, 0x78, 0xb5, 0xb4, 0x24, 0x01, 0xee, 0xc3, 0x54,
+	0xf1, 0xfc, 0x04, 0xa4, 0xb0, 0xb9, 0x87, 0x02, 0x14, 0xf6, 0x92, 0xbf, 0x47, 0x24, 0xc6, 0x7b,
+	0x1a, 0xac, 0x9e, 0xc5, 0xd2, 0x82, 0x3e, 0x67, 0xa5, 0xd7, 0x0d, 0x50, 0xd8, 0x1f, 0x3d, 0xa0,
+	0x8d, 0x27, 0x5d, 0x79, 0xd2, 0x97, 0xbf, 0x3d, 0x8f, 0x76, 0x2f, 0xbe, 0x1d, 0x76, 0x3e, 0x7d,
+	0x3f, 0x44, 0xc9, 0xba, 0x92, 0xbc, 0xc0, 0xf7, 0xc5, 0xc6, 0x5f, 0x4c, 0x40, 0x73, 0x90, 0xd6,
+	0xeb, 0x05, 0x28, 0xdc, 0x4b, 0xfe, 0x05, 0x93, 0x67, 0xf8, 0xee, 0x26, 0xf4, 0x86, 0xd5, 0xde,
+	0x8e, 0x53, 0xb5, 0x41, 0x24, 0xc4, 0xfb, 0x67, 0xac, 0x9e, 0x80, 0xcc, 0x0a, 0x29, 0xc6, 0xdc,
+	0xd6, 0xc6, 0xbb, 0xe1, 0xd8, 0x9b, 0xe3, 0xe1, 0x4f, 0x84, 0xf7, 0xc7, 0x57, 0x55, 0x34, 0xb1,
+	0x3c, 0xc2, 0xb7, 0x0b, 0x13, 0xcb, 0x54, 0x55, 0x32, 0x3b, 0x96, 0x2c, 0x2d, 0x21, 0x73, 0xd9,
+	0xec, 0x26, 0x5b, 0x73, 0xf2, 0x04, 0xdf, 0x29, 0xcc, 0xdb, 0xca, 0xae, 0x91, 0xbb, 0x8e, 0xbc,
+	0x0d, 0x90, 0x1c, 0x0f, 0x44, 0x5b, 0x13, 0x2e, 0x81, 0xfe, 0x68, 0x44, 0xff, 0xd3, 0x3e, 0x6d,
+	0xed, 0x30, 0x69, 0xbf, 0x90, 0x0c, 0xf1, 0xad, 0x9c, 0x95, 0x16, 0xb2, 0xf1, 0xf2, 0x36, 0xe3,
+	0xed, 0x04, 0xbd, 0xb0, 0x97, 0xac, 0xcd, 0x86, 0x5f, 0x11, 0x1e, 0x9c, 0x80, 0x60, 0x7c, 0x76,
+	0x0d, 0x13, 0x38, 0x3a, 0xbe, 0x98, 0xfb, 0xe8, 0x72, 0xee, 0xa3, 0x1f, 0x73, 0x1f, 0x7d, 0x5c,
+	0xf8, 0x9d, 0xcb, 0x85, 0xdf, 0xf9, 0xb2, 0xf0, 0x3b, 0xef, 0x1e, 0x8b, 0xc2, 0xe6, 0x55, 0x4a,
+	0xb9, 0x3a, 0x73, 0x8b, 0xf9, 0xb4, 0xd9, 0x51, 0xa9, 0x32, 0x88, 0xea, 0x3f, 0x1b, 0x6a, 0x67,
+	0x53, 0x30, 0xe9, 0x4d, 0xf7, 0xc4, 0x9f, 0xff, 0x1a, 0x00, 0xb8, 0x31, 0xb5, 0xab, 0xcd, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
@@ -339,6 +350,25 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HaltedChains) > 0 {
+		dAtA3 := make([]byte, len(m.HaltedChains)*10)
+		var j2 int
+		for _, num1 := range m.HaltedChains {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.GasPriceIncreaseFlags != nil {
 		{
 			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -479,6 +509,13 @@ func (m *CrosschainFlags) Size() (n int) {
 		l = m.GasPriceIncreaseFlags.Size()
 		n += 1 + l + sovCrosschainFlags(uint64(l))
 	}
+	if len(m.HaltedChains) > 0 {
+		l = 0
+		for _, e := range m.HaltedChains {
+			l += sovCrosschainFlags(uint64(e))
+		}
+		n += 1 + sovCrosschainFlags(uint64(l)) + l
+	}
 	return n
 }
 
@@ -771,6 +808,82 @@ func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCrosschainFlags
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HaltedChains = append(m.HaltedChains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCrosschainFlags
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCrosschainFlags
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCrosschainFlags
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.HaltedChains) == 0 {
+					m.HaltedChains = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCrosschainFlags
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HaltedChains = append(m.HaltedChains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedChains", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -17,3 +17,21 @@ func TestDefaultDefaultCrosschainFlags(t *testing.T) {
 		GasPriceIncreaseFlags: &types.DefaultGasPriceIncreaseFlags,
 	}, defaultCrosschainFlags)
 }
+
+func TestCrosschainFlags_HaltChain(t *testing.T) {
+	flags := types.DefaultCrosschainFlags()
+	require.False(t, flags.IsChainHalted(1))
+
+	require.True(t, flags.HaltChain(1))
+	require.True(t, flags.HaltChain(2))
+	require.False(t, flags.HaltChain(1))
+	require.True(t, flags.IsChainHalted(1))
+	require.True(t, flags.IsChainHalted(2))
+	require.Equal(t, []int64{1, 2}, flags.HaltedChains)
+
+	require.True(t, flags.UnhaltChain(1))
+	require.False(t, flags.UnhaltChain(1))
+	require.False(t, flags.IsChainHalted(1))
+	require.True(t, flags.IsChainHalted(2))
+	require.Equal(t, []int64{2}, flags.HaltedChains)
+}
@@ -76,4 +76,8 @@ var (
 		1141,
 		"minimum version is not a valid semver string",
 	)
+	ErrObserverNotJailed   = errorsmod.Register(ModuleName, 1142, "observer is not jailed")
+	ErrObserverStillJailed = errorsmod.Register(ModuleName, 1143, "observer is still jailed")
+	ErrChainHalted         = errorsmod.Register(ModuleName, 1144, "chain is halted")
+	ErrChainNotHalted      = errorsmod.Register(ModuleName, 1145, "chain is not halted")
 )
@@ -407,6 +407,238 @@ func (m *EventGasPriceIncreaseFlagsUpdated) GetGasPriceIncreaseFlags() *GasPrice
 	return nil
 }
 
+type EventObserverJailed struct {
+	ObserverAddress    string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	MissedVotesCounter int64  `protobuf:"varint,2,opt,name=missed_votes_counter,json=missedVotesCounter,proto3" json:"missed_votes_counter,omitempty"`
+	JailedUntil        int64  `protobuf:"varint,3,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *EventObserverJailed) Reset()         { *m = EventObserverJailed{} }
+func (m *EventObserverJailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverJailed) ProtoMessage()    {}
+func (*EventObserverJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{6}
+}
+func (m *EventObserverJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverJailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverJailed.Merge(m, src)
+}
+func (m *EventObserverJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverJailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverJailed proto.InternalMessageInfo
+
+func (m *EventObserverJailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *EventObserverJailed) GetMissedVotesCounter() int64 {
+	if m != nil {
+		return m.MissedVotesCounter
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+type EventObserverRejoined struct {
+	MsgTypeUrl             string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ObserverAddress        string `protobuf:"bytes,2,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	ObserverLastBlockCount uint64 `protobuf:"varint,3,opt,name=observer_last_block_count,json=observerLastBlockCount,proto3" json:"observer_last_block_count,omitempty"`
+}
+
+func (m *EventObserverRejoined) Reset()         { *m = EventObserverRejoined{} }
+func (m *EventObserverRejoined) String() string { return proto.CompactTextString(m) }
+func (*EventObserverRejoined) ProtoMessage()    {}
+func (*EventObserverRejoined) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{7}
+}
+func (m *EventObserverRejoined) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverRejoined) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverRejoined.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverRejoined) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverRejoined.Merge(m, src)
+}
+func (m *EventObserverRejoined) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverRejoined) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverRejoined.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverRejoined proto.InternalMessageInfo
+
+func (m *EventObserverRejoined) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventObserverRejoined) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *EventObserverRejoined) GetObserverLastBlockCount() uint64 {
+	if m != nil {
+		return m.ObserverLastBlockCount
+	}
+	return 0
+}
+
+type EventChainHalted struct {
+	MsgTypeUrl       string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ChainId          int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BallotIdentifier string `protobuf:"bytes,3,opt,name=ballot_identifier,json=ballotIdentifier,proto3" json:"ballot_identifier,omitempty"`
+}
+
+func (m *EventChainHalted) Reset()         { *m = EventChainHalted{} }
+func (m *EventChainHalted) String() string { return proto.CompactTextString(m) }
+func (*EventChainHalted) ProtoMessage()    {}
+func (*EventChainHalted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{8}
+}
+func (m *EventChainHalted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainHalted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainHalted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainHalted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainHalted.Merge(m, src)
+}
+func (m *EventChainHalted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainHalted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainHalted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainHalted proto.InternalMessageInfo
+
+func (m *EventChainHalted) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventChainHalted) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventChainHalted) GetBallotIdentifier() string {
+	if m != nil {
+		return m.BallotIdentifier
+	}
+	return ""
+}
+
+type EventChainUnhalted struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ChainId    int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *EventChainUnhalted) Reset()         { *m = EventChainUnhalted{} }
+func (m *EventChainUnhalted) String() string { return proto.CompactTextString(m) }
+func (*EventChainUnhalted) ProtoMessage()    {}
+func (*EventChainUnhalted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{9}
+}
+func (m *EventChainUnhalted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainUnhalted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainUnhalted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainUnhalted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainUnhalted.Merge(m, src)
+}
+func (m *EventChainUnhalted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainUnhalted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainUnhalted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainUnhalted proto.InternalMessageInfo
+
+func (m *EventChainUnhalted) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventChainUnhalted) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventBallotCreated)(nil), "zetachain.zetacore.observer.EventBallotCreated")
 	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "zetachain.zetacore.observer.EventKeygenBlockUpdated")
@@ -414,6 +646,10 @@ func init() {
 	proto.RegisterType((*EventCCTXDisabled)(nil), "zetachain.zetacore.observer.EventCCTXDisabled")
 	proto.RegisterType((*EventCCTXEnabled)(nil), "zetachain.zetacore.observer.EventCCTXEnabled")
 	proto.RegisterType((*EventGasPriceIncreaseFlagsUpdated)(nil), "zetachain.zetacore.observer.EventGasPriceIncreaseFlagsUpdated")
+	proto.RegisterType((*EventObserverJailed)(nil), "zetachain.zetacore.observer.EventObserverJailed")
+	proto.RegisterType((*EventObserverRejoined)(nil), "zetachain.zetacore.observer.EventObserverRejoined")
+	proto.RegisterType((*EventChainHalted)(nil), "zetachain.zetacore.observer.EventChainHalted")
+	proto.RegisterType((*EventChainUnhalted)(nil), "zetachain.zetacore.observer.EventChainUnhalted")
 }
 
 func init() {
@@ -421,44 +657,52 @@ func init() {
 }
 
 var fileDescriptor_067e682d8234d605 = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0xdb, 0x7e, 0x9f, 0x60, 0x53, 0x68, 0x62, 0x51, 0xea, 0x06, 0xc9, 0xb4, 0x91, 0x90,
-	0x4a, 0x0b, 0x8e, 0x14, 0x4e, 0x20, 0x2e, 0x24, 0x84, 0x12, 0x81, 0x68, 0x15, 0xb5, 0x12, 0xe2,
-	0x62, 0xad, 0xed, 0xa9, 0x6d, 0xc5, 0xd9, 0x8d, 0x76, 0xd7, 0x85, 0x70, 0xe7, 0x0a, 0x5c, 0xf9,
-	0x15, 0xfc, 0x0d, 0x8e, 0x3d, 0x72, 0xe0, 0x80, 0x92, 0x3f, 0x82, 0x76, 0xd7, 0x76, 0x82, 0x12,
-	0x45, 0xb9, 0x71, 0x5b, 0xcd, 0xbc, 0xf7, 0xf6, 0xbd, 0x59, 0x7b, 0xd0, 0xc1, 0x47, 0x10, 0xd8,
-	0x8f, 0x70, 0x4c, 0x1a, 0xea, 0x44, 0x19, 0x34, 0xa8, 0xc7, 0x81, 0x5d, 0x02, 0x6b, 0xc0, 0x25,
-	0x10, 0xc1, 0x9d, 0x21, 0xa3, 0x82, 0x9a, 0x77, 0x0a, 0xa4, 0x93, 0x23, 0x9d, 0x1c, 0x59, 0xbb,
-	0x15, 0xd2, 0x90, 0x2a, 0x5c, 0x43, 0x9e, 0x34, 0xa5, 0xd6, 0x5c, 0x26, 0xee, 0x33, 0xca, 0xb9,
-	0x6a, 0xba, 0x17, 0x09, 0x0e, 0xb3, 0x6b, 0x6a, 0x87, 0xcb, 0x38, 0xf9, 0x41, 0x63, 0xeb, 0xbf,
-	0x0c, 0x64, 0x76, 0xa4, 0xc7, 0x16, 0x4e, 0x12, 0x2a, 0xda, 0x0c, 0xb0, 0x80, 0xc0, 0xdc, 0x43,
-	0x9b, 0x03, 0x1e, 0xba, 0x62, 0x34, 0x04, 0x37, 0x65, 0x89, 0x65, 0xec, 0x19, 0x07, 0xd7, 0x7b,
-	0x68, 0xc0, 0xc3, 0xb3, 0xd1, 0x10, 0xce, 0x59, 0x62, 0x1e, 0xa1, 0xaa, 0xa7, 0x28, 0x6e, 0x1c,
-	0x00, 0x11, 0xf1, 0x45, 0x0c, 0xcc, 0x5a, 0x53, 0xb0, 0x8a, 0x6e, 0x74, 0x8b, 0xba, 0x79, 0x1f,
-	0x55, 0xf4, 0xbd, 0x58, 0xc4, 0x94, 0xb8, 0x11, 0xe6, 0x91, 0xb5, 0xae, 0xb0, 0x5b, 0x33, 0xf5,
-	0x97, 0x98, 0x47, 0x52, 0x77, 0x16, 0xaa, 0x62, 0x58, 0x1b, 0x5a, 0x77, 0xa6, 0xd1, 0x96, 0x75,
-	0xf3, 0x2e, 0x2a, 0x67, 0x26, 0xa4, 0x53, 0xeb, 0x3f, 0xed, 0x52, 0x97, 0xa4, 0xd1, 0xfa, 0x27,
-	0x03, 0xed, 0xa8, 0x78, 0xaf, 0x60, 0x14, 0x02, 0x69, 0x25, 0xd4, 0xef, 0x9f, 0x0f, 0x83, 0x15,
-	0x33, 0xee, 0xa3, 0xcd, 0xbe, 0xe2, 0xb9, 0x9e, 0x24, 0x66, 0xf1, 0xca, 0xfd, 0xa9, 0x96, 0x79,
-	0x0f, 0xdd, 0xcc, 0x20, 0xc3, 0xd4, 0xeb, 0xc3, 0x88, 0x67, 0xb9, 0x6e, 0xe8, 0xea, 0xa9, 0x2e,
-	0xd6, 0xbf, 0xad, 0xa1, 0x6d, 0xe5, 0xe3, 0x0d, 0xbc, 0x3f, 0xc9, 0x5e, 0xe0, 0x59, 0x10, 0xac,
-	0xe4, 0xa2, 0x18, 0x1e, 0x30, 0x17, 0x07, 0x01, 0x03, 0xce, 0x33, 0x27, 0x5b, 0x74, 0x2a, 0x25,
-	0xcb, 0xe6, 0x53, 0x54, 0x53, 0x2f, 0x9e, 0xc4, 0x40, 0x84, 0x1b, 0x32, 0x4c, 0x04, 0x40, 0x41,
-	0xd2, 0xce, 0xac, 0x29, 0xe2, 0x58, 0x03, 0x72, 0xf6, 0x13, 0xb4, 0xbb, 0x80, 0xad, 0x73, 0x65,
-	0x4f, 0xb0, 0x33, 0x47, 0xd6, 0x09, 0xcd, 0xc7, 0x68, 0xb7, 0x30, 0x99, 0x60, 0x2e, 0xf4, 0xc4,
-	0x5c, 0x9f, 0xa6, 0x44, 0xa8, 0x77, 0xd9, 0xe8, 0xdd, 0xce, 0x01, 0xaf, 0x31, 0x17, 0x6a, 0x7a,
-	0x6d, 0xd9, 0xad, 0x7f, 0x31, 0x50, 0x55, 0xcd, 0xa6, 0xdd, 0x3e, 0x7b, 0xfb, 0x3c, 0xe6, 0xd8,
-	0x4b, 0x56, 0x9a, 0xcb, 0x21, 0xaa, 0xc4, 0xbc, 0x4b, 0x3c, 0x9a, 0x92, 0xa0, 0x43, 0x14, 0x4b,
-	0xcd, 0xe5, 0x5a, 0x6f, 0xae, 0x6e, 0x3e, 0x40, 0xd5, 0x98, 0x9f, 0xa4, 0xe2, 0x2f, 0xf0, 0xba,
-	0x02, 0xcf, 0x37, 0xea, 0x9f, 0x0d, 0x54, 0x29, 0x1c, 0xe5, 0x12, 0xff, 0xd2, 0xd0, 0x77, 0x03,
-	0xed, 0x2b, 0x43, 0xc7, 0x98, 0x9f, 0xb2, 0xd8, 0x87, 0x2e, 0xf1, 0x19, 0x60, 0x0e, 0x2f, 0xe4,
-	0x6f, 0xbf, 0xfa, 0x07, 0x1d, 0xa1, 0xed, 0x70, 0x91, 0x82, 0xb2, 0x59, 0x6e, 0x36, 0x9d, 0x25,
-	0x0b, 0xca, 0x59, 0x78, 0x77, 0x6f, 0xb1, 0x60, 0xab, 0xf3, 0x63, 0x6c, 0x1b, 0x57, 0x63, 0xdb,
-	0xf8, 0x3d, 0xb6, 0x8d, 0xaf, 0x13, 0xbb, 0x74, 0x35, 0xb1, 0x4b, 0x3f, 0x27, 0x76, 0xe9, 0xdd,
-	0x51, 0x18, 0x8b, 0x28, 0xf5, 0x1c, 0x9f, 0x0e, 0xd4, 0x7a, 0x7a, 0xa8, 0x37, 0x15, 0xa1, 0x01,
-	0x34, 0x3e, 0x4c, 0xf7, 0x94, 0x4c, 0xc1, 0xbd, 0xff, 0xd5, 0x96, 0x7a, 0xf4, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xfb, 0x7d, 0x42, 0xa2, 0x64, 0x05, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xae, 0x9b, 0xde, 0x7b, 0x7b, 0x27, 0xbd, 0x97, 0xd4, 0xb4, 0x34, 0x0d, 0x52, 0x68, 0x2d,
+	0x21, 0x95, 0x16, 0x12, 0x54, 0x56, 0x20, 0x36, 0x34, 0x84, 0x36, 0x80, 0x68, 0x89, 0x1a, 0x84,
+	0xd8, 0x58, 0xe3, 0xf8, 0xd4, 0x9e, 0xc6, 0x99, 0x89, 0x66, 0xc6, 0x85, 0x20, 0xb6, 0x6c, 0x81,
+	0x0d, 0x0b, 0x56, 0x3c, 0x02, 0xaf, 0xc1, 0xb2, 0x4b, 0x16, 0x2c, 0x50, 0xfb, 0x22, 0x68, 0x66,
+	0xec, 0x24, 0x55, 0xad, 0x28, 0x12, 0x48, 0xec, 0xec, 0x73, 0xbe, 0xef, 0x9c, 0xef, 0xfc, 0xcc,
+	0x0c, 0x5a, 0x7b, 0x0d, 0x12, 0xb7, 0x43, 0x4c, 0x68, 0x55, 0x7f, 0x31, 0x0e, 0x55, 0xe6, 0x09,
+	0xe0, 0x47, 0xc0, 0xab, 0x70, 0x04, 0x54, 0x8a, 0x4a, 0x8f, 0x33, 0xc9, 0xec, 0xcb, 0x03, 0x64,
+	0x25, 0x45, 0x56, 0x52, 0x64, 0x69, 0x21, 0x60, 0x01, 0xd3, 0xb8, 0xaa, 0xfa, 0x32, 0x94, 0xd2,
+	0xe6, 0xb8, 0xe0, 0x6d, 0xce, 0x84, 0xd0, 0x4e, 0xf7, 0x20, 0xc2, 0x41, 0x92, 0xa6, 0xb4, 0x3e,
+	0x8e, 0x93, 0x7e, 0x18, 0xac, 0xf3, 0xdd, 0x42, 0x76, 0x5d, 0x69, 0xdc, 0xc2, 0x51, 0xc4, 0x64,
+	0x8d, 0x03, 0x96, 0xe0, 0xdb, 0x2b, 0x68, 0xae, 0x2b, 0x02, 0x57, 0xf6, 0x7b, 0xe0, 0xc6, 0x3c,
+	0x2a, 0x5a, 0x2b, 0xd6, 0xda, 0xbf, 0x4d, 0xd4, 0x15, 0xc1, 0x7e, 0xbf, 0x07, 0x2d, 0x1e, 0xd9,
+	0x1b, 0x68, 0xde, 0xd3, 0x14, 0x97, 0xf8, 0x40, 0x25, 0x39, 0x20, 0xc0, 0x8b, 0xd3, 0x1a, 0x56,
+	0x30, 0x8e, 0xc6, 0xc0, 0x6e, 0x5f, 0x43, 0x05, 0x93, 0x17, 0x4b, 0xc2, 0xa8, 0x1b, 0x62, 0x11,
+	0x16, 0x73, 0x1a, 0x7b, 0x61, 0xc4, 0xbe, 0x83, 0x45, 0xa8, 0xe2, 0x8e, 0x42, 0x75, 0x19, 0xc5,
+	0x19, 0x13, 0x77, 0xc4, 0x51, 0x53, 0x76, 0xfb, 0x0a, 0xca, 0x27, 0x22, 0x94, 0xd2, 0xe2, 0x5f,
+	0x46, 0xa5, 0x31, 0x29, 0xa1, 0xce, 0x5b, 0x0b, 0x2d, 0xe9, 0xf2, 0x1e, 0x41, 0x3f, 0x00, 0xba,
+	0x15, 0xb1, 0x76, 0xa7, 0xd5, 0xf3, 0x27, 0xac, 0x71, 0x15, 0xcd, 0x75, 0x34, 0xcf, 0xf5, 0x14,
+	0x31, 0x29, 0x2f, 0xdf, 0x19, 0xc6, 0xb2, 0xaf, 0xa2, 0xff, 0x13, 0x48, 0x2f, 0xf6, 0x3a, 0xd0,
+	0x17, 0x49, 0x5d, 0xff, 0x19, 0xeb, 0x9e, 0x31, 0x3a, 0x9f, 0xa6, 0xd1, 0xa2, 0xd6, 0xf1, 0x04,
+	0x5e, 0xee, 0x26, 0x13, 0xb8, 0xe7, 0xfb, 0x13, 0xa9, 0x18, 0x34, 0x0f, 0xb8, 0x8b, 0x7d, 0x9f,
+	0x83, 0x10, 0xc5, 0xe9, 0xd1, 0xe6, 0xe9, 0x50, 0xca, 0x6c, 0xdf, 0x45, 0x25, 0x3d, 0xf1, 0x88,
+	0x00, 0x95, 0x6e, 0xc0, 0x31, 0x95, 0x00, 0x03, 0x92, 0x51, 0x56, 0x1c, 0x22, 0xb6, 0x0d, 0x20,
+	0x65, 0xdf, 0x41, 0xcb, 0x19, 0x6c, 0x53, 0x57, 0x32, 0x82, 0xa5, 0x73, 0x64, 0x53, 0xa1, 0x7d,
+	0x1b, 0x2d, 0x0f, 0x44, 0x46, 0x58, 0x48, 0xd3, 0x31, 0xb7, 0xcd, 0x62, 0x2a, 0xf5, 0x5c, 0x66,
+	0x9a, 0x97, 0x52, 0xc0, 0x63, 0x2c, 0xa4, 0xee, 0x5e, 0x4d, 0x79, 0x9d, 0xf7, 0x16, 0x9a, 0xd7,
+	0xbd, 0xa9, 0xd5, 0xf6, 0x9f, 0xdf, 0x27, 0x02, 0x7b, 0xd1, 0x44, 0x7d, 0x59, 0x47, 0x05, 0x22,
+	0x1a, 0xd4, 0x63, 0x31, 0xf5, 0xeb, 0x54, 0xb3, 0x74, 0x5f, 0x66, 0x9b, 0xe7, 0xec, 0xf6, 0x75,
+	0x34, 0x4f, 0xc4, 0x6e, 0x2c, 0xcf, 0x80, 0x73, 0x1a, 0x7c, 0xde, 0xe1, 0xbc, 0xb3, 0x50, 0x61,
+	0xa0, 0xa8, 0x4e, 0xff, 0xbc, 0xa0, 0x2f, 0x16, 0x5a, 0xd5, 0x82, 0xb6, 0xb1, 0xd8, 0xe3, 0xa4,
+	0x0d, 0x0d, 0xda, 0xe6, 0x80, 0x05, 0x3c, 0x50, 0xc7, 0x7e, 0xf2, 0x85, 0x0e, 0xd1, 0x62, 0x90,
+	0x15, 0x41, 0xcb, 0xcc, 0x6f, 0x6e, 0x56, 0xc6, 0x5c, 0x50, 0x95, 0xcc, 0xdc, 0xcd, 0xec, 0x80,
+	0xce, 0x47, 0x0b, 0x5d, 0xd4, 0x8a, 0xd3, 0x6d, 0x7f, 0x88, 0x89, 0xaa, 0x3b, 0x6b, 0x99, 0xad,
+	0xec, 0x65, 0xbe, 0x89, 0x16, 0xba, 0x44, 0x08, 0xf0, 0xdd, 0x23, 0x26, 0x41, 0x98, 0x5d, 0x4a,
+	0x2e, 0x99, 0x5c, 0xd3, 0x36, 0xbe, 0x67, 0xca, 0x55, 0x33, 0x1e, 0x75, 0x5e, 0x0f, 0x75, 0x1a,
+	0x37, 0xa6, 0x92, 0x44, 0xba, 0x9f, 0xb9, 0x66, 0xde, 0xd8, 0x5a, 0xca, 0xe4, 0x7c, 0xb6, 0xd0,
+	0xe2, 0x19, 0x5d, 0x4d, 0x38, 0x64, 0x84, 0xfe, 0xee, 0x83, 0x38, 0xf6, 0x38, 0xe4, 0xc6, 0x1e,
+	0x87, 0x37, 0xe9, 0xee, 0xa9, 0x31, 0xec, 0xe0, 0x68, 0xb2, 0xc9, 0x2e, 0xa3, 0x59, 0xf3, 0x10,
+	0x10, 0x3f, 0x69, 0xd0, 0x3f, 0xfa, 0xbf, 0xe1, 0x67, 0xdf, 0xd4, 0xb9, 0xec, 0x9b, 0xda, 0x79,
+	0x8a, 0xec, 0x61, 0xf6, 0x16, 0x0d, 0x7f, 0x3d, 0xff, 0x56, 0xfd, 0xeb, 0x49, 0xd9, 0x3a, 0x3e,
+	0x29, 0x5b, 0x3f, 0x4e, 0xca, 0xd6, 0x87, 0xd3, 0xf2, 0xd4, 0xf1, 0x69, 0x79, 0xea, 0xdb, 0x69,
+	0x79, 0xea, 0xc5, 0x46, 0x40, 0x64, 0x18, 0x7b, 0x95, 0x36, 0xeb, 0xea, 0x97, 0xea, 0x86, 0x79,
+	0xb4, 0x28, 0xf3, 0xa1, 0xfa, 0x6a, 0xf8, 0x64, 0xa9, 0xb4, 0xc2, 0xfb, 0x5b, 0x3f, 0x58, 0xb7,
+	0x7e, 0x0e, 0x00, 0xb8, 0x11, 0x61, 0x15, 0x6f, 0x07, 0x00, 0x00,
 }
 
 func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
@@ -761,73 +1005,232 @@ func (m *EventGasPriceIncreaseFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventObserverJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventBallotCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventObserverJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObservationHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ObservationChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MissedVotesCounter != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedVotesCounter))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BallotType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventKeygenBlockUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventObserverRejoined) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverRejoined) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverRejoined) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ObserverLastBlockCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ObserverLastBlockCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.KeygenBlock)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.KeygenPubkeys)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNewObserverAdded) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventChainHalted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *EventChainHalted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainHalted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainUnhalted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainUnhalted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainUnhalted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBallotCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventKeygenBlockUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeygenBlock)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeygenPubkeys)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewObserverAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.MsgTypeUrl)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -905,6 +1308,81 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Size() (n int) {
 	return n
 }
 
+func (m *EventObserverJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MissedVotesCounter != 0 {
+		n += 1 + sovEvents(uint64(m.MissedVotesCounter))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *EventObserverRejoined) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ObserverLastBlockCount != 0 {
+		n += 1 + sovEvents(uint64(m.ObserverLastBlockCount))
+	}
+	return n
+}
+
+func (m *EventChainHalted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainUnhalted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1072,7 +1550,484 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+			}
+			m.ObserverLastBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTXDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTXDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1082,24 +2037,12 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BallotType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1121,7 +2064,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1144,10 +2087,10 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCCTXEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCCTXEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1183,10 +2126,10 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1196,29 +2139,17 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsInboundEnabled = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1228,24 +2159,12 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1267,7 +2186,7 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1290,10 +2209,10 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1330,9 +2249,9 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1342,27 +2261,81 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObserverJailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventObserverJailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventObserverJailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1390,13 +2363,13 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotesCounter", wireType)
 			}
-			var stringLen uint64
+			m.MissedVotesCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1406,29 +2379,16 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedVotesCounter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
-			m.ObserverLastBlockCount = 0
+			m.JailedUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1438,7 +2398,7 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				m.JailedUntil |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1464,7 +2424,7 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventObserverRejoined) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1487,10 +2447,10 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCCTXDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverRejoined: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCCTXDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverRejoined: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1526,10 +2486,10 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1539,17 +2499,29 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
 			}
-			var v int
+			m.ObserverLastBlockCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1559,12 +2531,11 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1586,7 +2557,7 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventChainHalted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,10 +2580,10 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCCTXEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainHalted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCCTXEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainHalted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1649,9 +2620,9 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var v int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1661,17 +2632,16 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1681,12 +2651,24 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1708,7 +2690,7 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventChainUnhalted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,10 +2713,10 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainUnhalted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainUnhalted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1770,10 +2752,10 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1783,28 +2765,11 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
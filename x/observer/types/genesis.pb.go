@@ -41,6 +41,7 @@ type GenesisState struct {
 	ChainNonces       []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
 	NonceToCctx       []NonceToCctx         `protobuf:"bytes,15,rep,name=nonce_to_cctx,json=nonceToCctx,proto3" json:"nonce_to_cctx"`
 	OperationalFlags  OperationalFlags      `protobuf:"bytes,16,opt,name=operational_flags,json=operationalFlags,proto3" json:"operational_flags"`
+	ObserverLiveness  []ObserverLiveness    `protobuf:"bytes,17,rep,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,6 +189,13 @@ func (m *GenesisState) GetOperationalFlags() OperationalFlags {
 	return OperationalFlags{}
 }
 
+func (m *GenesisState) GetObserverLiveness() []ObserverLiveness {
+	if m != nil {
+		return m.ObserverLiveness
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.observer.GenesisState")
 }
@@ -197,49 +205,51 @@ func init() {
 }
 
 var fileDescriptor_7679b0952a0823f4 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x4f, 0x13, 0x41,
-	0x1c, 0x6d, 0x05, 0x41, 0xa6, 0x40, 0x61, 0xf4, 0x30, 0xc1, 0xa4, 0x12, 0x8c, 0xb1, 0xa2, 0x6c,
-	0x49, 0xf5, 0x66, 0x3c, 0x08, 0x11, 0x34, 0x22, 0xea, 0x96, 0xc4, 0xc4, 0x03, 0xeb, 0x74, 0x3a,
-	0x2c, 0x1b, 0xb7, 0x33, 0xcd, 0xce, 0xd4, 0x80, 0x9f, 0x82, 0x8f, 0xc5, 0x91, 0xa3, 0x27, 0x63,
-	0xe0, 0x8b, 0x98, 0xfd, 0xcd, 0x4c, 0xdb, 0xed, 0x61, 0xd8, 0xdb, 0xe4, 0x37, 0xef, 0xbd, 0xfc,
-	0xfe, 0x3e, 0xf4, 0xec, 0x37, 0xd7, 0x94, 0x9d, 0xd2, 0x44, 0xb4, 0xe0, 0x25, 0x33, 0xde, 0x92,
-	0x5d, 0xc5, 0xb3, 0x5f, 0x3c, 0x6b, 0xc5, 0x5c, 0x70, 0x95, 0xa8, 0x60, 0x90, 0x49, 0x2d, 0xf1,
-	0xc3, 0x11, 0x34, 0x70, 0xd0, 0xc0, 0x41, 0xd7, 0x1e, 0xc4, 0x32, 0x96, 0x80, 0x6b, 0xe5, 0x2f,
-	0x43, 0x59, 0x6b, 0xfa, 0xd4, 0xbb, 0x34, 0x4d, 0xa5, 0xb6, 0xc8, 0xa7, 0x5e, 0x64, 0x4a, 0xfb,
-	0xdc, 0x02, 0x03, 0x1f, 0x10, 0xe2, 0x91, 0x90, 0x82, 0x71, 0x9b, 0xf5, 0x5a, 0xdb, 0x8b, 0xcf,
-	0xa4, 0x52, 0x86, 0x74, 0x92, 0xd2, 0x58, 0x95, 0x49, 0xfb, 0x27, 0x3f, 0x8f, 0xb9, 0x28, 0x93,
-	0x8d, 0x90, 0x3d, 0x1e, 0x51, 0xc6, 0xe4, 0x50, 0xb8, 0x32, 0x5b, 0x7e, 0xbc, 0x60, 0x3c, 0xd2,
-	0x32, 0x62, 0x4c, 0x9f, 0x59, 0xc2, 0xa6, 0x8f, 0xe0, 0x1e, 0x65, 0xd2, 0x1e, 0xd0, 0x8c, 0xf6,
-	0x5d, 0x81, 0xdb, 0x5e, 0x24, 0x17, 0xbd, 0x44, 0xc4, 0xc5, 0x36, 0x3e, 0xf1, 0x31, 0xb4, 0x72,
-	0xb0, 0x57, 0xb7, 0xc0, 0xa2, 0x93, 0xa1, 0xe8, 0xa9, 0xa8, 0x9f, 0xc4, 0x19, 0xd5, 0xd2, 0x25,
-	0xbe, 0xe5, 0x2d, 0x72, 0xc0, 0x33, 0xaa, 0x13, 0x29, 0x68, 0x6a, 0xe0, 0x1b, 0x17, 0x08, 0x2d,
-	0xee, 0x9b, 0xd5, 0xec, 0x68, 0xaa, 0x39, 0x7e, 0x83, 0xe6, 0xcd, 0x32, 0x29, 0x52, 0x5d, 0x9f,
-	0x69, 0xd6, 0xda, 0x8f, 0x03, 0xcf, 0xae, 0x06, 0x3b, 0x80, 0x0d, 0x1d, 0x07, 0x1f, 0xa0, 0x05,
-	0xf7, 0xa7, 0xc8, 0x9d, 0xf5, 0x6a, 0xb3, 0xd6, 0x6e, 0x7a, 0x05, 0x3e, 0xdb, 0x47, 0x87, 0xeb,
-	0x9d, 0xd9, 0xcb, 0xbf, 0x8f, 0x2a, 0xe1, 0x58, 0x00, 0x87, 0xa8, 0x9e, 0x0f, 0xfe, 0xad, 0x99,
-	0xfb, 0x41, 0xa2, 0x34, 0x99, 0x81, 0xa4, 0xfc, 0x9a, 0x87, 0x63, 0x4e, 0x38, 0x2d, 0x80, 0xbf,
-	0xa1, 0x95, 0xe9, 0x55, 0x25, 0xb3, 0x90, 0xe8, 0x0b, 0xaf, 0xe8, 0xee, 0x88, 0xb4, 0x97, 0x73,
-	0xc2, 0x3a, 0x2b, 0x06, 0xf0, 0x6b, 0x34, 0x67, 0x16, 0x83, 0xdc, 0x05, 0x39, 0x7f, 0xe3, 0xbe,
-	0x00, 0x34, 0xb4, 0x94, 0x9c, 0x6c, 0x8e, 0x81, 0xcc, 0x95, 0x20, 0x7f, 0x04, 0x68, 0x68, 0x29,
-	0xf8, 0x18, 0xdd, 0x4f, 0xa9, 0xd2, 0x91, 0xfb, 0x8f, 0xa0, 0x5a, 0x32, 0x0f, 0x4a, 0x81, 0x57,
-	0xe9, 0x80, 0x2a, 0xed, 0x46, 0xb0, 0x0b, 0x0d, 0x5b, 0x4d, 0xa7, 0x43, 0xf8, 0x18, 0xad, 0x9a,
-	0x6e, 0x99, 0x64, 0xa3, 0x34, 0x1f, 0xc4, 0xbd, 0x32, 0x3d, 0xcb, 0xe3, 0xa6, 0xd2, 0xbc, 0xf7,
-	0x76, 0xc0, 0x75, 0x56, 0x0c, 0xe3, 0x36, 0x9a, 0xd1, 0x4a, 0x91, 0x05, 0x50, 0x5c, 0xf7, 0x2a,
-	0x1e, 0x75, 0x3a, 0x61, 0x0e, 0xc6, 0xfb, 0xa8, 0x96, 0xdf, 0xc0, 0x69, 0xa2, 0xb4, 0xcc, 0xce,
-	0x09, 0x82, 0xb5, 0xb8, 0x95, 0x6b, 0x33, 0x40, 0x5a, 0xa9, 0xf7, 0x86, 0x89, 0x7b, 0x08, 0xbb,
-	0x63, 0x1a, 0xdd, 0x92, 0x22, 0x35, 0xd0, 0xdb, 0xf6, 0xeb, 0x29, 0xb5, 0x37, 0x14, 0xbd, 0x4f,
-	0x96, 0xf4, 0x41, 0x9c, 0x48, 0xab, 0xbf, 0xa2, 0x8b, 0x5f, 0x79, 0xba, 0x08, 0x9c, 0xd7, 0xf4,
-	0x6e, 0x11, 0xd4, 0x37, 0xfc, 0x97, 0x95, 0xc3, 0xdd, 0x49, 0x00, 0xd7, 0xae, 0xef, 0x72, 0xd1,
-	0x54, 0xc8, 0x12, 0x88, 0x6d, 0xfa, 0xb7, 0xcd, 0x50, 0x0e, 0x81, 0x61, 0x45, 0x97, 0x06, 0x93,
-	0x41, 0xfc, 0x15, 0x2d, 0x4e, 0x5a, 0x3e, 0x59, 0x2e, 0x71, 0x68, 0x30, 0xdf, 0x82, 0x68, 0x8d,
-	0x8d, 0x43, 0x38, 0x44, 0x4b, 0x05, 0x1f, 0x26, 0xf5, 0x52, 0xc7, 0x2b, 0x18, 0x3f, 0x92, 0xbb,
-	0x4c, 0x9f, 0x39, 0x4d, 0x31, 0x0e, 0xe1, 0x1f, 0x68, 0x75, 0xc2, 0xc5, 0xec, 0xfd, 0xae, 0xc0,
-	0xe6, 0x6c, 0xf9, 0x8d, 0x66, 0xcc, 0x82, 0x7b, 0x75, 0xa3, 0x92, 0xd3, 0xf1, 0x77, 0x97, 0xd7,
-	0x8d, 0xea, 0xd5, 0x75, 0xa3, 0xfa, 0xef, 0xba, 0x51, 0xbd, 0xb8, 0x69, 0x54, 0xae, 0x6e, 0x1a,
-	0x95, 0x3f, 0x37, 0x8d, 0xca, 0xf7, 0xe7, 0x71, 0xa2, 0x4f, 0x87, 0xdd, 0x80, 0xc9, 0x3e, 0x98,
-	0xeb, 0x96, 0xf1, 0xd9, 0xdc, 0x61, 0x5a, 0x67, 0x13, 0xde, 0x7c, 0x3e, 0xe0, 0xaa, 0x3b, 0x07,
-	0x06, 0xfb, 0xf2, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xd7, 0x9f, 0xa0, 0x16, 0x08, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0x13, 0x3d,
+	0x14, 0x4d, 0xbe, 0xf4, 0x6b, 0xa9, 0xd3, 0x36, 0x8d, 0x61, 0x61, 0x15, 0x29, 0x8c, 0x8a, 0x10,
+	0xa1, 0xd0, 0x49, 0x15, 0xd8, 0x21, 0x16, 0xb4, 0xa2, 0x05, 0x11, 0x0a, 0x4c, 0x2a, 0x21, 0xb1,
+	0xe8, 0xe0, 0x38, 0xee, 0x74, 0xc4, 0xc4, 0x8e, 0xc6, 0x4e, 0xd5, 0xf2, 0x14, 0x3c, 0x56, 0x97,
+	0x5d, 0xb2, 0x42, 0xa8, 0x79, 0x03, 0x9e, 0x00, 0x8d, 0x7f, 0x92, 0x4c, 0x16, 0xce, 0xec, 0xac,
+	0x3b, 0xe7, 0x1c, 0x5f, 0xdf, 0x7b, 0xcf, 0x1d, 0xf0, 0xe4, 0x07, 0x95, 0x98, 0x9c, 0xe3, 0x98,
+	0xb5, 0xd4, 0x89, 0xa7, 0xb4, 0xc5, 0x7b, 0x82, 0xa6, 0x17, 0x34, 0x6d, 0x45, 0x94, 0x51, 0x11,
+	0x0b, 0x7f, 0x98, 0x72, 0xc9, 0xe1, 0xfd, 0x09, 0xd4, 0xb7, 0x50, 0xdf, 0x42, 0xb7, 0xee, 0x45,
+	0x3c, 0xe2, 0x0a, 0xd7, 0xca, 0x4e, 0x9a, 0xb2, 0xd5, 0x74, 0xa9, 0xf7, 0x70, 0x92, 0x70, 0x69,
+	0x90, 0x8f, 0x9d, 0xc8, 0x04, 0x0f, 0xa8, 0x01, 0xfa, 0x2e, 0xa0, 0x8a, 0x87, 0x8c, 0x33, 0x42,
+	0x4d, 0xd6, 0x5b, 0x6d, 0x27, 0x3e, 0xe5, 0x42, 0x68, 0xd2, 0x59, 0x82, 0x23, 0x51, 0x24, 0xed,
+	0xef, 0xf4, 0x2a, 0xa2, 0xcc, 0x20, 0x77, 0x5c, 0xc8, 0x24, 0xbe, 0xa0, 0x8c, 0x0a, 0x51, 0x24,
+	0x73, 0xc6, 0xfb, 0x34, 0xc4, 0x84, 0xf0, 0x11, 0xb3, 0x25, 0x69, 0xb9, 0xf1, 0x8c, 0xd0, 0x50,
+	0xf2, 0x90, 0x10, 0x79, 0x59, 0x24, 0x19, 0x7b, 0x28, 0xf2, 0xc4, 0x21, 0x4e, 0xf1, 0xc0, 0xa6,
+	0xbd, 0xe7, 0x44, 0x52, 0xd6, 0x8f, 0x59, 0x94, 0x2f, 0xf9, 0x23, 0x17, 0x43, 0x4e, 0xea, 0xf1,
+	0x62, 0x01, 0x2c, 0x3c, 0x1b, 0xb1, 0xbe, 0x08, 0x07, 0x71, 0x94, 0x62, 0xc9, 0x6d, 0xe2, 0xbb,
+	0xce, 0x47, 0x0e, 0x69, 0x8a, 0x65, 0xcc, 0x19, 0x4e, 0x34, 0x7c, 0xfb, 0x2f, 0x00, 0x6b, 0x47,
+	0x7a, 0x8c, 0xbb, 0x12, 0x4b, 0x0a, 0x5f, 0x81, 0x15, 0x3d, 0x78, 0x02, 0x95, 0xbd, 0x4a, 0xb3,
+	0xda, 0x7e, 0xe8, 0x3b, 0xe6, 0xda, 0xdf, 0x57, 0xd8, 0xc0, 0x72, 0x60, 0x07, 0xac, 0xda, 0x6f,
+	0x02, 0xfd, 0xe7, 0x95, 0x9b, 0xd5, 0x76, 0xd3, 0x29, 0xf0, 0xd1, 0x1c, 0xba, 0x54, 0xee, 0x2f,
+	0x5d, 0xff, 0x7e, 0x50, 0x0a, 0xa6, 0x02, 0x30, 0x00, 0xb5, 0xac, 0xf1, 0xaf, 0x75, 0xdf, 0x3b,
+	0xb1, 0x90, 0xa8, 0xe2, 0x55, 0x16, 0x6a, 0x1e, 0x4f, 0x39, 0xc1, 0xbc, 0x00, 0xfc, 0x02, 0x36,
+	0xe7, 0xc7, 0x1a, 0x2d, 0xa9, 0x44, 0x9f, 0x39, 0x45, 0x0f, 0x26, 0xa4, 0xc3, 0x8c, 0x13, 0xd4,
+	0x48, 0x3e, 0x00, 0x5f, 0x82, 0x65, 0x3d, 0x18, 0xe8, 0x7f, 0xaf, 0xbc, 0xb0, 0x70, 0x9f, 0x14,
+	0x34, 0x30, 0x94, 0x8c, 0xac, 0x8d, 0x83, 0x96, 0x0b, 0x90, 0xdf, 0x2b, 0x68, 0x60, 0x28, 0xf0,
+	0x14, 0xdc, 0x4d, 0xb0, 0x90, 0xa1, 0xfd, 0x1e, 0xaa, 0xd7, 0xa2, 0x15, 0xa5, 0xe4, 0x3b, 0x95,
+	0x3a, 0x58, 0x48, 0xdb, 0x82, 0x03, 0x55, 0xb0, 0x7a, 0x32, 0x1f, 0x82, 0xa7, 0xa0, 0xae, 0xab,
+	0xa5, 0x93, 0x0d, 0x93, 0xac, 0x11, 0x77, 0x8a, 0xd4, 0x2c, 0x8b, 0xeb, 0x97, 0x66, 0xb5, 0x37,
+	0x0d, 0xae, 0x91, 0x7c, 0x18, 0xb6, 0x41, 0x45, 0x0a, 0x81, 0x56, 0x95, 0xa2, 0xe7, 0x54, 0x3c,
+	0xe9, 0x76, 0x83, 0x0c, 0x0c, 0x8f, 0x40, 0x35, 0xf3, 0xc0, 0x79, 0x2c, 0x24, 0x4f, 0xaf, 0x10,
+	0xf0, 0x2a, 0x45, 0xb8, 0x26, 0x03, 0x20, 0x85, 0x78, 0xab, 0x99, 0xb0, 0x0f, 0xa0, 0x35, 0xd3,
+	0xc4, 0x4b, 0x02, 0x55, 0x95, 0xde, 0x9e, 0x5b, 0x4f, 0x88, 0xc3, 0x11, 0xeb, 0x7f, 0x30, 0xa4,
+	0x77, 0xec, 0x8c, 0x1b, 0xfd, 0x4d, 0x99, 0xff, 0x94, 0xa5, 0x0b, 0xd4, 0x96, 0xd6, 0xb5, 0x5b,
+	0x53, 0xea, 0xdb, 0x6e, 0x67, 0x65, 0x70, 0x6b, 0x09, 0xc5, 0x35, 0xe3, 0xbb, 0x91, 0x5f, 0x2a,
+	0x68, 0x5d, 0x89, 0xed, 0xb8, 0xa7, 0x4d, 0x53, 0x8e, 0x15, 0xc3, 0x88, 0xae, 0x0f, 0x67, 0x83,
+	0xf0, 0x33, 0x58, 0x9b, 0xfd, 0x3d, 0xa0, 0x8d, 0x02, 0x46, 0x53, 0xfd, 0xcd, 0x89, 0x56, 0xc9,
+	0x34, 0x04, 0x03, 0xb0, 0x9e, 0xdb, 0xc3, 0xa8, 0x56, 0xc8, 0xbc, 0x8c, 0xd0, 0x13, 0x7e, 0x40,
+	0xe4, 0xa5, 0xd5, 0x64, 0xd3, 0x10, 0xfc, 0x06, 0xea, 0x33, 0x5b, 0xcc, 0xf8, 0x77, 0x53, 0x4d,
+	0xce, 0xae, 0x7b, 0xd1, 0x4c, 0x59, 0xca, 0xaf, 0xb6, 0x55, 0x7c, 0x2e, 0xae, 0x6e, 0xb0, 0x46,
+	0xb2, 0xbf, 0x28, 0x54, 0xf7, 0x2a, 0x8b, 0x6f, 0x30, 0x87, 0x8e, 0x21, 0x4d, 0x6e, 0x98, 0x8f,
+	0xbf, 0xb9, 0xbe, 0x6d, 0x94, 0x6f, 0x6e, 0x1b, 0xe5, 0x3f, 0xb7, 0x8d, 0xf2, 0xcf, 0x71, 0xa3,
+	0x74, 0x33, 0x6e, 0x94, 0x7e, 0x8d, 0x1b, 0xa5, 0xaf, 0x4f, 0xa3, 0x58, 0x9e, 0x8f, 0x7a, 0x3e,
+	0xe1, 0x03, 0xb5, 0xbe, 0x77, 0xf5, 0x26, 0xcf, 0x76, 0x58, 0xeb, 0x72, 0x66, 0xfb, 0x5f, 0x0d,
+	0xa9, 0xe8, 0x2d, 0xab, 0x15, 0xfe, 0xfc, 0xdf, 0x00, 0x3c, 0x77, 0x29, 0x00, 0xa4, 0x08, 0x00,
 	0x00,
 }
 
@@ -263,6 +273,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ObserverLiveness) > 0 {
+		for iNdEx := len(m.ObserverLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	{
 		size, err := m.OperationalFlags.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -561,6 +587,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.OperationalFlags.Size()
 	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.ObserverLiveness) > 0 {
+		for _, e := range m.ObserverLiveness {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1150,6 +1182,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverLiveness = append(m.ObserverLiveness, ObserverLiveness{})
+			if err := m.ObserverLiveness[len(m.ObserverLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -92,6 +92,8 @@ const (
 	ParamsKey = "Params-value-"
 
 	OperationalFlagsKey = "OperationalFlags-value-"
+
+	ObserverLivenessKey = "ObserverLiveness-value-"
 )
 
 func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) string {
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// AddVote records a vote of the observer on a matured ballot in the liveness window
+// The window is reset if its size has changed since the previous vote
+func (m *ObserverLiveness) AddVote(window int64, missed bool) {
+	if window <= 0 {
+		return
+	}
+	if int64(len(m.MissedVotes)) != (window+7)/8 {
+		m.ResetWindow(window)
+	}
+
+	index := m.IndexOffset % window
+	byteIndex, mask := index/8, byte(1)<<(index%8)
+	previouslyMissed := m.MissedVotes[byteIndex]&mask != 0
+
+	switch {
+	case missed && !previouslyMissed:
+		m.MissedVotes[byteIndex] |= mask
+		m.MissedVotesCounter++
+	case !missed && previouslyMissed:
+		m.MissedVotes[byteIndex] &^= mask
+		m.MissedVotesCounter--
+	}
+	m.IndexOffset++
+}
+
+// ResetWindow clears the missed votes of the observer for a window of the given size
+func (m *ObserverLiveness) ResetWindow(window int64) {
+	m.IndexOffset = 0
+	m.MissedVotesCounter = 0
+	m.MissedVotes = make([]byte, (window+7)/8)
+}
+
+// ExceedsMaxMissedRatio returns true if the observer missed more than the max missed ratio of the ballots in the window
+// The ratio is only checked once the window is full, a nil ratio is never exceeded
+func (m ObserverLiveness) ExceedsMaxMissedRatio(window int64, maxMissedRatio sdkmath.LegacyDec) bool {
+	if window <= 0 || maxMissedRatio.IsNil() || m.IndexOffset < window {
+		return false
+	}
+	return sdkmath.LegacyNewDec(m.MissedVotesCounter).GT(maxMissedRatio.MulInt64(window))
+}
+
+// Jail marks the observer as jailed until the given height and clears its missed votes
+func (m *ObserverLiveness) Jail(jailedUntil int64) {
+	m.Jailed = true
+	m.JailedUntil = jailedUntil
+	m.IndexOffset = 0
+	m.MissedVotesCounter = 0
+	m.MissedVotes = nil
+}
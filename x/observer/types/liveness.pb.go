@@ -0,0 +1,531 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zetachain/zetacore/observer/liveness.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ObserverLiveness tracks the votes missed by an observer on the matured
+// ballots over a sliding window
+type ObserverLiveness struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	// number of matured ballots tracked for the observer, the position of the
+	// next ballot in the window is index_offset modulo the window size
+	IndexOffset int64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// number of ballots missed by the observer in the window
+	MissedVotesCounter int64 `protobuf:"varint,3,opt,name=missed_votes_counter,json=missedVotesCounter,proto3" json:"missed_votes_counter,omitempty"`
+	// bit array of the missed ballots in the window
+	MissedVotes []byte `protobuf:"bytes,4,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`
+	// the jailed observer is removed from the observer set until it rejoins
+	Jailed bool `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// height from which the jailed observer can rejoin the observer set
+	JailedUntil int64 `protobuf:"varint,6,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *ObserverLiveness) Reset()         { *m = ObserverLiveness{} }
+func (m *ObserverLiveness) String() string { return proto.CompactTextString(m) }
+func (*ObserverLiveness) ProtoMessage()    {}
+func (*ObserverLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_983fb36a74c70e3c, []int{0}
+}
+func (m *ObserverLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverLiveness.Merge(m, src)
+}
+func (m *ObserverLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverLiveness proto.InternalMessageInfo
+
+func (m *ObserverLiveness) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverLiveness) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetMissedVotesCounter() int64 {
+	if m != nil {
+		return m.MissedVotesCounter
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetMissedVotes() []byte {
+	if m != nil {
+		return m.MissedVotes
+	}
+	return nil
+}
+
+func (m *ObserverLiveness) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ObserverLiveness) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ObserverLiveness)(nil), "zetachain.zetacore.observer.ObserverLiveness")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/observer/liveness.proto", fileDescriptor_983fb36a74c70e3c)
+}
+
+var fileDescriptor_983fb36a74c70e3c = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xd1, 0x4a, 0xf3, 0x30,
+	0x14, 0xc7, 0x97, 0x6f, 0x9f, 0x43, 0xb3, 0x81, 0x23, 0x88, 0x04, 0x84, 0x50, 0xbd, 0xaa, 0x8a,
+	0xad, 0xe0, 0x13, 0xa8, 0x78, 0x27, 0x0c, 0x0a, 0x7a, 0xe1, 0x4d, 0xe9, 0x96, 0x33, 0x17, 0xe9,
+	0x92, 0x91, 0xa4, 0x65, 0xfa, 0x14, 0x3e, 0x96, 0x97, 0xbb, 0xf4, 0x52, 0xda, 0x17, 0xf0, 0x11,
+	0xa4, 0x49, 0xab, 0xbb, 0x3b, 0xe7, 0x77, 0x7e, 0xff, 0x10, 0xfe, 0xf8, 0xec, 0x0d, 0x6c, 0x36,
+	0x5b, 0x64, 0x42, 0xc6, 0x6e, 0x52, 0x1a, 0x62, 0x35, 0x35, 0xa0, 0x4b, 0xd0, 0x71, 0x2e, 0x4a,
+	0x90, 0x60, 0x4c, 0xb4, 0xd2, 0xca, 0x2a, 0x72, 0xf4, 0xeb, 0x46, 0x9d, 0x1b, 0x75, 0xee, 0xc9,
+	0x37, 0xc2, 0xe3, 0x49, 0xbb, 0xdc, 0xb7, 0x39, 0x72, 0x8a, 0xc7, 0x9d, 0x90, 0x66, 0x9c, 0x6b,
+	0x30, 0x86, 0xa2, 0x00, 0x85, 0x7b, 0xc9, 0x7e, 0xc7, 0xaf, 0x3d, 0x26, 0xc7, 0x78, 0x24, 0x24,
+	0x87, 0x75, 0xaa, 0xe6, 0x73, 0x03, 0x96, 0xfe, 0x0b, 0x50, 0xd8, 0x4f, 0x86, 0x8e, 0x4d, 0x1c,
+	0x22, 0x97, 0xf8, 0x60, 0x29, 0x8c, 0x01, 0x9e, 0x96, 0xca, 0x82, 0x49, 0x67, 0xaa, 0x90, 0x16,
+	0x34, 0xed, 0x3b, 0x95, 0xf8, 0xdb, 0x63, 0x73, 0xba, 0xf5, 0x97, 0xe6, 0xd1, 0xed, 0x04, 0xfd,
+	0x1f, 0xa0, 0x70, 0x94, 0x0c, 0xb7, 0x4c, 0x72, 0x88, 0x07, 0x2f, 0x99, 0xc8, 0x81, 0xd3, 0x9d,
+	0x00, 0x85, 0xbb, 0x49, 0xbb, 0x35, 0x51, 0x3f, 0xa5, 0x85, 0xb4, 0x22, 0xa7, 0x03, 0xff, 0x1f,
+	0xcf, 0x1e, 0x1a, 0x74, 0x73, 0xf7, 0x51, 0x31, 0xb4, 0xa9, 0x18, 0xfa, 0xaa, 0x18, 0x7a, 0xaf,
+	0x59, 0x6f, 0x53, 0xb3, 0xde, 0x67, 0xcd, 0x7a, 0x4f, 0xe7, 0xcf, 0xc2, 0x2e, 0x8a, 0x69, 0x34,
+	0x53, 0x4b, 0x57, 0xeb, 0x85, 0x6f, 0x58, 0x2a, 0x0e, 0xf1, 0xfa, 0xaf, 0x5f, 0xfb, 0xba, 0x02,
+	0x33, 0x1d, 0xb8, 0x76, 0xaf, 0x7e, 0x06, 0x00, 0x33, 0x27, 0x44, 0xb9, 0x8b, 0x01, 0x00, 0x00,
+}
+
+func (m *ObserverLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MissedVotes) > 0 {
+		i -= len(m.MissedVotes)
+		copy(dAtA[i:], m.MissedVotes)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.MissedVotes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MissedVotesCounter != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.MissedVotesCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiveness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ObserverLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovLiveness(uint64(m.IndexOffset))
+	}
+	if m.MissedVotesCounter != 0 {
+		n += 1 + sovLiveness(uint64(m.MissedVotesCounter))
+	}
+	l = len(m.MissedVotes)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovLiveness(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func sovLiveness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiveness(x uint64) (n int) {
+	return sovLiveness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ObserverLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotesCounter", wireType)
+			}
+			m.MissedVotesCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotesCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedVotes = append(m.MissedVotes[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedVotes == nil {
+				m.MissedVotes = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiveness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiveness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiveness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiveness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiveness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiveness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiveness = fmt.Errorf("proto: unexpected end of group")
+)
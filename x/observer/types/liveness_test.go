@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestObserverLiveness_AddVote(t *testing.T) {
+	t.Run("should count the missed votes in the window", func(t *testing.T) {
+		liveness := types.ObserverLiveness{ObserverAddress: sample.AccAddress()}
+
+		liveness.AddVote(10, true)
+		liveness.AddVote(10, false)
+		liveness.AddVote(10, true)
+
+		require.EqualValues(t, 3, liveness.IndexOffset)
+		require.EqualValues(t, 2, liveness.MissedVotesCounter)
+		require.Len(t, liveness.MissedVotes, 2)
+	})
+
+	t.Run("should slide the window", func(t *testing.T) {
+		liveness := types.ObserverLiveness{ObserverAddress: sample.AccAddress()}
+
+		// miss the whole window
+		for i := 0; i < 10; i++ {
+			liveness.AddVote(10, true)
+		}
+		require.EqualValues(t, 10, liveness.MissedVotesCounter)
+
+		// the votes replace the oldest missed votes
+		for i := 0; i < 4; i++ {
+			liveness.AddVote(10, false)
+		}
+		require.EqualValues(t, 14, liveness.IndexOffset)
+		require.EqualValues(t, 6, liveness.MissedVotesCounter)
+
+		// a missed vote replacing a missed vote doesn't change the counter
+		liveness.AddVote(10, true)
+		require.EqualValues(t, 6, liveness.MissedVotesCounter)
+	})
+
+	t.Run("should reset the window if the window size changed", func(t *testing.T) {
+		liveness := types.ObserverLiveness{ObserverAddress: sample.AccAddress()}
+		for i := 0; i < 5; i++ {
+			liveness.AddVote(10, true)
+		}
+
+		liveness.AddVote(100, true)
+
+		require.EqualValues(t, 1, liveness.IndexOffset)
+		require.EqualValues(t, 1, liveness.MissedVotesCounter)
+		require.Len(t, liveness.MissedVotes, 13)
+	})
+
+	t.Run("should do nothing if the window is disabled", func(t *testing.T) {
+		liveness := types.ObserverLiveness{ObserverAddress: sample.AccAddress()}
+
+		liveness.AddVote(0, true)
+
+		require.EqualValues(t, 0, liveness.IndexOffset)
+		require.EqualValues(t, 0, liveness.MissedVotesCounter)
+	})
+}
+
+func TestObserverLiveness_ExceedsMaxMissedRatio(t *testing.T) {
+	ratio := sdkmath.LegacyNewDecWithPrec(5, 1)
+
+	newLiveness := func(votes, missed int) types.ObserverLiveness {
+		liveness := types.ObserverLiveness{ObserverAddress: sample.AccAddress()}
+		for i := 0; i < votes; i++ {
+			liveness.AddVote(10, i < missed)
+		}
+		return liveness
+	}
+
+	t.Run("should return false if the window is not full", func(t *testing.T) {
+		require.False(t, newLiveness(9, 9).ExceedsMaxMissedRatio(10, ratio))
+	})
+
+	t.Run("should return false if the missed votes are below the ratio", func(t *testing.T) {
+		require.False(t, newLiveness(10, 5).ExceedsMaxMissedRatio(10, ratio))
+	})
+
+	t.Run("should return true if the missed votes are above the ratio", func(t *testing.T) {
+		require.True(t, newLiveness(10, 6).ExceedsMaxMissedRatio(10, ratio))
+	})
+
+	t.Run("should return false if the ratio is nil", func(t *testing.T) {
+		require.False(t, newLiveness(10, 10).ExceedsMaxMissedRatio(10, sdkmath.LegacyDec{}))
+	})
+
+	t.Run("should return false if the window is disabled", func(t *testing.T) {
+		require.False(t, newLiveness(10, 10).ExceedsMaxMissedRatio(0, ratio))
+	})
+}
+
+func TestObserverLiveness_Jail(t *testing.T) {
+	liveness := types.ObserverLiveness{ObserverAddress: sample.AccAddress()}
+	for i := 0; i < 10; i++ {
+		liveness.AddVote(10, true)
+	}
+
+	liveness.Jail(100)
+
+	require.True(t, liveness.Jailed)
+	require.EqualValues(t, 100, liveness.JailedUntil)
+	require.EqualValues(t, 0, liveness.IndexOffset)
+	require.EqualValues(t, 0, liveness.MissedVotesCounter)
+	require.Empty(t, liveness.MissedVotes)
+}
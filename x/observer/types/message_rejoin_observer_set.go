@@ -0,0 +1,46 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRejoinObserverSet = "rejoin_observer_set"
+
+var _ sdk.Msg = &MsgRejoinObserverSet{}
+
+func NewMsgRejoinObserverSet(creator string) *MsgRejoinObserverSet {
+	return &MsgRejoinObserverSet{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgRejoinObserverSet) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRejoinObserverSet) Type() string {
+	return TypeMsgRejoinObserverSet
+}
+
+func (msg *MsgRejoinObserverSet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRejoinObserverSet) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRejoinObserverSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
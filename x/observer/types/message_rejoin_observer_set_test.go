@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgRejoinObserverSet_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgRejoinObserverSet
+		err  require.ErrorAssertionFunc
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgRejoinObserverSet("invalid"),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+			},
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgRejoinObserverSet(sample.AccAddress()),
+			err:  require.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			tt.err(t, err)
+		})
+	}
+}
+
+func TestMsgRejoinObserverSet_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgRejoinObserverSet
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgRejoinObserverSet(signer),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgRejoinObserverSet("invalid"),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgRejoinObserverSet_Type(t *testing.T) {
+	msg := types.MsgRejoinObserverSet{}
+	require.Equal(t, types.TypeMsgRejoinObserverSet, msg.Type())
+}
+
+func TestMsgRejoinObserverSet_Route(t *testing.T) {
+	msg := types.MsgRejoinObserverSet{}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgRejoinObserverSet_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgRejoinObserverSet(sample.AccAddress())
+	require.NotPanics(t, func() {
+		bytes := msg.GetSignBytes()
+		require.NotEmpty(t, bytes)
+	})
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnhaltChain = "unhalt_chain"
+
+var _ sdk.Msg = &MsgUnhaltChain{}
+
+func NewMsgUnhaltChain(creator string, chainID int64) *MsgUnhaltChain {
+	return &MsgUnhaltChain{
+		Creator: creator,
+		ChainId: chainID,
+	}
+}
+
+func (msg *MsgUnhaltChain) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnhaltChain) Type() string {
+	return TypeMsgUnhaltChain
+}
+
+func (msg *MsgUnhaltChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnhaltChain) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnhaltChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
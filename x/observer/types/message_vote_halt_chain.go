@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	TypeMsgVoteHaltChain = "vote_halt_chain"
+
+	// MaxHaltReasonLength is the maximum length of the reason of a halt vote
+	MaxHaltReasonLength = 256
+)
+
+var _ sdk.Msg = &MsgVoteHaltChain{}
+
+func NewMsgVoteHaltChain(creator string, chainID int64, reason string) *MsgVoteHaltChain {
+	return &MsgVoteHaltChain{
+		Creator: creator,
+		ChainId: chainID,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgVoteHaltChain) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgVoteHaltChain) Type() string {
+	return TypeMsgVoteHaltChain
+}
+
+func (msg *MsgVoteHaltChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgVoteHaltChain) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgVoteHaltChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Reason == "" {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+
+	if len(msg.Reason) > MaxHaltReasonLength {
+		return cosmoserrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"reason length %d exceeds maximum %d",
+			len(msg.Reason),
+			MaxHaltReasonLength,
+		)
+	}
+
+	return nil
+}
+
+// Digest returns the identifier of the halt ballot of the chain,
+// the reason is not part of it so the observers vote on the same ballot whatever the reason they observed.
+func (msg *MsgVoteHaltChain) Digest() string {
+	return HaltChainBallotIdentifier(msg.ChainId)
+}
+
+// HaltChainBallotIdentifier returns the identifier of the halt ballot of the chain
+func HaltChainBallotIdentifier(chainID int64) string {
+	return crypto.Keccak256Hash([]byte(fmt.Sprintf("%d-halt-chain", chainID))).Hex()
+}
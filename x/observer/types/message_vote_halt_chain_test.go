@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgVoteHaltChain_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgVoteHaltChain
+		err  require.ErrorAssertionFunc
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgVoteHaltChain("invalid", 1, "reorg"),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+			},
+		},
+		{
+			name: "empty reason",
+			msg:  types.NewMsgVoteHaltChain(sample.AccAddress(), 1, ""),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+			},
+		},
+		{
+			name: "reason too long",
+			msg: types.NewMsgVoteHaltChain(
+				sample.AccAddress(),
+				1,
+				strings.Repeat("a", types.MaxHaltReasonLength+1),
+			),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+			},
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgVoteHaltChain(sample.AccAddress(), 1, "reorg"),
+			err:  require.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			tt.err(t, err)
+		})
+	}
+}
+
+func TestMsgVoteHaltChain_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgVoteHaltChain
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgVoteHaltChain(signer, 1, "reorg"),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgVoteHaltChain("invalid", 1, "reorg"),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgVoteHaltChain_Type(t *testing.T) {
+	msg := types.MsgVoteHaltChain{}
+	require.Equal(t, types.TypeMsgVoteHaltChain, msg.Type())
+}
+
+func TestMsgVoteHaltChain_Route(t *testing.T) {
+	msg := types.MsgVoteHaltChain{}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgVoteHaltChain_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgVoteHaltChain(sample.AccAddress(), 1, "reorg")
+	require.NotPanics(t, func() {
+		bytes := msg.GetSignBytes()
+		require.NotEmpty(t, bytes)
+	})
+}
+
+func TestMsgVoteHaltChain_Digest(t *testing.T) {
+	msg := types.NewMsgVoteHaltChain(sample.AccAddress(), 1, "reorg")
+
+	// the digest doesn't depend on the voter and the reason
+	other := types.NewMsgVoteHaltChain(sample.AccAddress(), 1, "gateway drained")
+	require.Equal(t, msg.Digest(), other.Digest())
+	require.Equal(t, types.HaltChainBallotIdentifier(1), msg.Digest())
+
+	// the digest depends on the chain
+	other = types.NewMsgVoteHaltChain(msg.Creator, 2, "reorg")
+	require.NotEqual(t, msg.Digest(), other.Digest())
+}
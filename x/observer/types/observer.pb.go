@@ -31,6 +31,7 @@ const (
 	ObservationType_OutboundTx        ObservationType = 2
 	ObservationType_TSSKeyGen         ObservationType = 3
 	ObservationType_TSSKeySign        ObservationType = 4
+	ObservationType_ChainHalt         ObservationType = 5
 )
 
 var ObservationType_name = map[int32]string{
@@ -39,6 +40,7 @@ var ObservationType_name = map[int32]string{
 	2: "OutboundTx",
 	3: "TSSKeyGen",
 	4: "TSSKeySign",
+	5: "ChainHalt",
 }
 
 var ObservationType_value = map[string]int32{
@@ -47,6 +49,7 @@ var ObservationType_value = map[string]int32{
 	"OutboundTx":        2,
 	"TSSKeyGen":         3,
 	"TSSKeySign":        4,
+	"ChainHalt":         5,
 }
 
 func (x ObservationType) String() string {
@@ -193,30 +196,31 @@ func init() {
 }
 
 var fileDescriptor_05af1bc65780862e = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x51, 0xcd, 0xaa, 0xd3, 0x40,
-	0x14, 0xce, 0xb4, 0x55, 0xb8, 0x73, 0xbd, 0xde, 0x74, 0xa8, 0x50, 0x2a, 0x84, 0x52, 0x37, 0xa5,
-	0x6a, 0x02, 0xfa, 0x04, 0x5a, 0x8a, 0x8a, 0x85, 0x42, 0xd2, 0x22, 0xb8, 0x29, 0x93, 0xe4, 0x98,
-	0x0c, 0x34, 0x33, 0x21, 0x73, 0x22, 0x8d, 0x4f, 0xe1, 0x43, 0xb8, 0xf0, 0x51, 0x5c, 0x76, 0xe9,
-	0x52, 0xda, 0x17, 0x91, 0x49, 0x9c, 0xba, 0xfb, 0xbe, 0xf3, 0xfd, 0x9c, 0xc5, 0x47, 0x17, 0xdf,
-	0x00, 0x79, 0x92, 0x73, 0x21, 0x83, 0x16, 0xa9, 0x0a, 0x02, 0x15, 0x6b, 0xa8, 0xbe, 0x42, 0x75,
-	0x05, 0x7e, 0x59, 0x29, 0x54, 0xec, 0xe9, 0xd5, 0xeb, 0x5b, 0xaf, 0x6f, 0x2d, 0x93, 0x51, 0xa6,
-	0x32, 0xd5, 0xfa, 0x02, 0x83, 0xba, 0xc8, 0xec, 0x15, 0xbd, 0xdd, 0xfc, 0x73, 0x44, 0x80, 0xec,
-	0x19, 0xbd, 0xb3, 0x81, 0xfd, 0x41, 0x68, 0x1c, 0x93, 0x69, 0x7f, 0x7e, 0x13, 0x3e, 0xb2, 0xc7,
-	0xb5, 0xd0, 0x38, 0xfb, 0x44, 0x87, 0x6b, 0xae, 0xd1, 0xe6, 0x96, 0xaa, 0x96, 0xc8, 0x46, 0xf4,
-	0x41, 0x62, 0xc0, 0x98, 0x4c, 0xc9, 0x7c, 0x10, 0x76, 0x84, 0xbd, 0xa0, 0xec, 0xc0, 0x35, 0xee,
-	0x93, 0x9c, 0xcb, 0x0c, 0xf6, 0x39, 0x88, 0x2c, 0xc7, 0x71, 0x6f, 0x4a, 0xe6, 0xfd, 0xd0, 0x35,
-	0xca, 0xb2, 0x15, 0xde, 0xb7, 0xf7, 0xc5, 0x81, 0xde, 0x77, 0xa5, 0x1c, 0x85, 0x92, 0xdb, 0xa6,
-	0x04, 0xf6, 0x84, 0x0e, 0x57, 0x45, 0x89, 0x8d, 0x7d, 0x66, 0x8e, 0xae, 0xc3, 0xee, 0xe8, 0xcd,
-	0x07, 0x19, 0xab, 0x5a, 0xa6, 0xdb, 0xa3, 0x4b, 0xd8, 0x63, 0x4a, 0x37, 0x35, 0x5a, 0xde, 0x33,
-	0xf2, 0x36, 0x8a, 0x3e, 0x42, 0xf3, 0x0e, 0xa4, 0xdb, 0x37, 0x72, 0x47, 0x23, 0x91, 0x49, 0x77,
-	0x30, 0x19, 0xfc, 0xfc, 0xe1, 0x91, 0xc5, 0x9a, 0x8e, 0x6c, 0xeb, 0xae, 0x4c, 0x39, 0x42, 0x08,
-	0x5c, 0x2b, 0x69, 0xc2, 0x3b, 0x99, 0xc2, 0x17, 0x21, 0x21, 0x75, 0x9d, 0x36, 0xac, 0x8a, 0x58,
-	0xa3, 0x32, 0x9c, 0xb0, 0x7b, 0x7a, 0xfb, 0x26, 0x2d, 0x84, 0xec, 0x32, 0x6e, 0xaf, 0x6b, 0x7b,
-	0xbb, 0xfa, 0x75, 0xf6, 0xc8, 0xe9, 0xec, 0x91, 0x3f, 0x67, 0x8f, 0x7c, 0xbf, 0x78, 0xce, 0xe9,
-	0xe2, 0x39, 0xbf, 0x2f, 0x9e, 0xf3, 0xf9, 0x79, 0x26, 0x30, 0xaf, 0x63, 0x3f, 0x51, 0x45, 0x3b,
-	0xe1, 0xcb, 0x6e, 0x4d, 0xa9, 0x52, 0x08, 0x8e, 0xff, 0xb7, 0xc4, 0xa6, 0x04, 0x1d, 0x3f, 0x6c,
-	0x67, 0x79, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0x31, 0x47, 0x22, 0x5f, 0xf7, 0x01, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x51, 0xdd, 0x8a, 0xd3, 0x40,
+	0x18, 0xcd, 0xb4, 0x5d, 0x61, 0x67, 0x5d, 0x77, 0x76, 0xa8, 0x50, 0x56, 0x08, 0x65, 0xbd, 0x29,
+	0x55, 0x13, 0xd0, 0x27, 0xd0, 0xb2, 0xb8, 0x62, 0x61, 0x21, 0xe9, 0x22, 0x78, 0x53, 0x26, 0xc9,
+	0x67, 0x32, 0xd0, 0xcc, 0x84, 0xcc, 0x17, 0x69, 0xf4, 0x25, 0x7c, 0x08, 0x2f, 0x7c, 0x14, 0x2f,
+	0x7b, 0xe9, 0xa5, 0xb4, 0x2f, 0x22, 0x93, 0x38, 0xdd, 0xbb, 0x73, 0xbe, 0xf3, 0x33, 0x30, 0x87,
+	0xce, 0xbf, 0x01, 0x8a, 0xb4, 0x10, 0x52, 0x85, 0x1d, 0xd2, 0x35, 0x84, 0x3a, 0x31, 0x50, 0x7f,
+	0x85, 0xfa, 0x08, 0x82, 0xaa, 0xd6, 0xa8, 0xf9, 0xb3, 0xa3, 0x37, 0x70, 0xde, 0xc0, 0x59, 0xae,
+	0xc6, 0xb9, 0xce, 0x75, 0xe7, 0x0b, 0x2d, 0xea, 0x23, 0xd7, 0xaf, 0xe9, 0xd9, 0xdd, 0x7f, 0x47,
+	0x0c, 0xc8, 0x9f, 0xd3, 0x73, 0x17, 0x58, 0x6f, 0xa4, 0xc1, 0x09, 0x99, 0x0e, 0x67, 0xa7, 0xd1,
+	0x63, 0x77, 0x5c, 0x4a, 0x83, 0xd7, 0x9f, 0xe8, 0xe5, 0x52, 0x18, 0x74, 0xb9, 0x85, 0x6e, 0x14,
+	0xf2, 0x31, 0x3d, 0x49, 0x2d, 0x98, 0x90, 0x29, 0x99, 0x8d, 0xa2, 0x9e, 0xf0, 0x97, 0x94, 0x6f,
+	0x84, 0xc1, 0x75, 0x5a, 0x08, 0x95, 0xc3, 0xba, 0x00, 0x99, 0x17, 0x38, 0x19, 0x4c, 0xc9, 0x6c,
+	0x18, 0x31, 0xab, 0x2c, 0x3a, 0xe1, 0xb6, 0xbb, 0xcf, 0xbf, 0xd3, 0x8b, 0xbe, 0x54, 0xa0, 0xd4,
+	0x6a, 0xd5, 0x56, 0xc0, 0x9f, 0xd2, 0xcb, 0x9b, 0xb2, 0xc2, 0xd6, 0x3d, 0x66, 0x8f, 0xcc, 0xe3,
+	0xe7, 0xf4, 0xf4, 0x83, 0x4a, 0x74, 0xa3, 0xb2, 0xd5, 0x96, 0x11, 0xfe, 0x84, 0xd2, 0xbb, 0x06,
+	0x1d, 0x1f, 0x58, 0x79, 0x15, 0xc7, 0x1f, 0xa1, 0x7d, 0x0f, 0x8a, 0x0d, 0xad, 0xdc, 0xd3, 0x58,
+	0xe6, 0x8a, 0x8d, 0xac, 0xbc, 0xb0, 0xbf, 0x74, 0x2b, 0x36, 0xc8, 0x4e, 0xae, 0x46, 0xbf, 0x7e,
+	0xfa, 0x64, 0xbe, 0xa4, 0x63, 0xf7, 0xc8, 0x7d, 0x95, 0x09, 0x84, 0x08, 0x84, 0xd1, 0xca, 0x9a,
+	0xef, 0x55, 0x06, 0x5f, 0xa4, 0x82, 0x8c, 0x79, 0x5d, 0x97, 0x2e, 0x13, 0x83, 0xda, 0x72, 0xc2,
+	0x2f, 0xe8, 0xd9, 0xdb, 0xac, 0x94, 0xaa, 0xcf, 0xb0, 0x41, 0xdf, 0xf6, 0xee, 0xe6, 0xf7, 0xde,
+	0x27, 0xbb, 0xbd, 0x4f, 0xfe, 0xee, 0x7d, 0xf2, 0xe3, 0xe0, 0x7b, 0xbb, 0x83, 0xef, 0xfd, 0x39,
+	0xf8, 0xde, 0xe7, 0x17, 0xb9, 0xc4, 0xa2, 0x49, 0x82, 0x54, 0x97, 0xdd, 0xa2, 0xaf, 0xfa, 0x71,
+	0x95, 0xce, 0x20, 0xdc, 0x3e, 0x4c, 0x8b, 0x6d, 0x05, 0x26, 0x79, 0xd4, 0xad, 0xf4, 0xe6, 0xdf,
+	0x00, 0x31, 0x7c, 0x33, 0xc8, 0x06, 0x02, 0x00, 0x00,
 }
 
 func (m *ObserverSet) Marshal() (dAtA []byte, err error) {
@@ -87,6 +87,12 @@ type ChainParams struct {
 	GatewayAddress              string                      `protobuf:"bytes,17,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
 	// Advanced confirmation parameters for chain to support fast observation
 	ConfirmationParams *ConfirmationParams `protobuf:"bytes,18,opt,name=confirmation_params,json=confirmationParams,proto3" json:"confirmation_params,omitempty"`
+	// The votes on the ballots of the chain are weighted by the bonded tokens of
+	// the observer validators, snapshotted at the ballot creation
+	StakeWeightedVoting bool `protobuf:"varint,19,opt,name=stake_weighted_voting,json=stakeWeightedVoting,proto3" json:"stake_weighted_voting,omitempty"`
+	// The threshold of the ballots voting the emergency halt of the chain, the
+	// default emergency halt threshold is used if not set
+	EmergencyHaltBallotThreshold *cosmossdk_io_math.LegacyDec `protobuf:"bytes,20,opt,name=emergency_halt_ballot_threshold,json=emergencyHaltBallotThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emergency_halt_ballot_threshold,omitempty"`
 }
 
 func (m *ChainParams) Reset()         { *m = ChainParams{} }
@@ -221,6 +227,13 @@ func (m *ChainParams) GetConfirmationParams() *ConfirmationParams {
 	return nil
 }
 
+func (m *ChainParams) GetStakeWeightedVoting() bool {
+	if m != nil {
+		return m.StakeWeightedVoting
+	}
+	return false
+}
+
 // Deprecated(v17)
 type Params struct {
 	// Deprecated(v17):Moved into the emissions module
@@ -278,49 +291,53 @@ func init() {
 }
 
 var fileDescriptor_e7fa4666eddf88e5 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdd, 0x6e, 0xd3, 0x3e,
-	0x18, 0xc6, 0x9b, 0x75, 0xff, 0x7d, 0xb8, 0x5b, 0xbb, 0xe5, 0x3f, 0x20, 0x5b, 0xa5, 0xae, 0x0c,
-	0x21, 0x22, 0x10, 0x09, 0x0c, 0x38, 0x83, 0x49, 0xb4, 0xe3, 0x60, 0x62, 0xc0, 0x94, 0x8d, 0x13,
-	0x90, 0x08, 0xae, 0x63, 0x12, 0xab, 0x49, 0xde, 0xca, 0x76, 0xb6, 0x95, 0xab, 0xe0, 0x02, 0xb8,
-	0xa0, 0x1d, 0xee, 0x10, 0x71, 0x30, 0xa1, 0xed, 0x46, 0x50, 0x1c, 0xa7, 0xeb, 0x3e, 0x34, 0x71,
-	0x16, 0xbf, 0xcf, 0xef, 0x79, 0xea, 0xda, 0x7e, 0x5f, 0x64, 0x7f, 0xa7, 0x12, 0x93, 0x08, 0xb3,
-	0xd4, 0x55, 0x5f, 0xc0, 0xa9, 0x0b, 0x3d, 0x41, 0xf9, 0x3e, 0xe5, 0xee, 0x00, 0x73, 0x9c, 0x08,
-	0x67, 0xc0, 0x41, 0x82, 0xd9, 0x1c, 0x91, 0x4e, 0x49, 0x3a, 0x25, 0xb9, 0xb2, 0x14, 0x42, 0x08,
-	0x8a, 0x73, 0xf3, 0xaf, 0xc2, 0xb2, 0xf2, 0xe2, 0xa6, 0x70, 0x02, 0xe9, 0x37, 0xc6, 0x13, 0x2c,
-	0x19, 0xa4, 0xfe, 0xf8, 0x2f, 0xad, 0x7d, 0x41, 0x8d, 0x6e, 0x6e, 0xda, 0x51, 0xc5, 0x6d, 0x26,
-	0xa4, 0xf9, 0x16, 0xcd, 0xa9, 0x1c, 0x0d, 0x5a, 0x46, 0xbb, 0x6a, 0xd7, 0xd6, 0x6d, 0xe7, 0x86,
-	0x3d, 0x39, 0x63, 0x19, 0x5e, 0x8d, 0x9c, 0x2f, 0xd6, 0x7e, 0x4e, 0xa3, 0xda, 0x98, 0x68, 0x2e,
-	0xa3, 0x99, 0x22, 0x9c, 0x05, 0x56, 0xad, 0x6d, 0xd8, 0x55, 0x6f, 0x5a, 0xad, 0xb7, 0x02, 0xf3,
-	0x29, 0x32, 0x2f, 0xec, 0x93, 0x40, 0x96, 0x4a, 0xcb, 0x68, 0x1b, 0xf6, 0x64, 0x67, 0xc2, 0x32,
-	0xbc, 0xc5, 0x71, 0xb5, 0x9b, 0x8b, 0xa6, 0x8d, 0x16, 0x42, 0x2c, 0xfc, 0x01, 0x67, 0x84, 0xfa,
-	0x92, 0x91, 0x3e, 0xe5, 0xd6, 0x44, 0x6e, 0xf0, 0xea, 0x21, 0x16, 0x3b, 0x79, 0x79, 0x4f, 0x55,
-	0xcd, 0xfb, 0xa8, 0xce, 0xd2, 0x1e, 0x64, 0x69, 0x50, 0x72, 0x55, 0xc5, 0xcd, 0xeb, 0xaa, 0xc6,
-	0x1e, 0xa0, 0x06, 0x64, 0xf2, 0x02, 0x37, 0x59, 0xe4, 0x95, 0x65, 0x0d, 0x3e, 0x44, 0x8b, 0x07,
-	0x58, 0x92, 0xc8, 0xcf, 0xe4, 0x21, 0x94, 0xe8, 0x7f, 0x0a, 0x6d, 0x28, 0xe1, 0xa3, 0x3c, 0x04,
-	0xcd, 0xbe, 0x42, 0xea, 0x3e, 0x7d, 0x09, 0x7d, 0x9a, 0xff, 0xad, 0x54, 0x72, 0x4c, 0xa4, 0x8f,
-	0x83, 0x80, 0x53, 0x21, 0xac, 0x99, 0xb6, 0x61, 0xcf, 0x7a, 0x56, 0x8e, 0xec, 0xe5, 0x44, 0x57,
-	0x03, 0xaf, 0x0b, 0xdd, 0x7c, 0x89, 0x56, 0x08, 0xa4, 0x29, 0x25, 0x12, 0xf8, 0x55, 0xf7, 0x6c,
-	0xe1, 0x1e, 0x11, 0x97, 0xdd, 0x5d, 0xd4, 0xa2, 0x9c, 0xac, 0x3f, 0xf1, 0x49, 0x26, 0x24, 0x04,
-	0xc3, 0xab, 0x09, 0x48, 0x25, 0x34, 0x15, 0xd5, 0x2d, 0xa0, 0x6b, 0xb6, 0x30, 0x3a, 0x16, 0x41,
-	0x22, 0x1a, 0x64, 0x31, 0xf5, 0x59, 0x2a, 0x29, 0xdf, 0xc7, 0xb1, 0x35, 0xa7, 0xee, 0xd1, 0x2a,
-	0x89, 0x5d, 0x0d, 0x6c, 0x69, 0xdd, 0xdc, 0x40, 0xcd, 0xab, 0xee, 0x18, 0xa0, 0x8f, 0x23, 0x8a,
-	0x03, 0x6b, 0x5e, 0xd9, 0x97, 0x2f, 0xdb, 0xb7, 0x4b, 0xc0, 0x7c, 0x8f, 0x16, 0x7a, 0x38, 0x8e,
-	0x41, 0xfa, 0x32, 0xe2, 0x54, 0x44, 0x10, 0x07, 0x56, 0x3d, 0xdf, 0x74, 0xe7, 0xde, 0xd1, 0xc9,
-	0x6a, 0xe5, 0xf7, 0xc9, 0x6a, 0x93, 0x80, 0x48, 0x40, 0x88, 0xa0, 0xef, 0x30, 0x70, 0x13, 0x2c,
-	0x23, 0x67, 0x9b, 0x86, 0x98, 0x0c, 0x37, 0x29, 0xf1, 0x1a, 0x85, 0x79, 0xaf, 0xf4, 0x9a, 0x9f,
-	0xd1, 0x9d, 0x84, 0xa5, 0x7e, 0xf9, 0x78, 0xfd, 0x80, 0xc6, 0x34, 0x54, 0xaf, 0xca, 0x6a, 0xfc,
-	0x7b, 0xec, 0xad, 0x84, 0xa5, 0x1f, 0x74, 0xc4, 0xe6, 0x28, 0xc1, 0xbc, 0x8b, 0xe6, 0x98, 0xf0,
-	0x45, 0x36, 0x18, 0x00, 0x97, 0x34, 0xb0, 0x16, 0xda, 0x86, 0x3d, 0xe3, 0xd5, 0x98, 0xd8, 0x2d,
-	0x4b, 0xf9, 0x23, 0x0b, 0xb1, 0xa4, 0x07, 0x78, 0x38, 0xba, 0x83, 0x45, 0x75, 0x07, 0x75, 0x5d,
-	0x2e, 0x8f, 0xfd, 0x2b, 0xfa, 0xff, 0x9a, 0xce, 0xb5, 0xcc, 0xb6, 0x61, 0xd7, 0xd6, 0xdd, 0x9b,
-	0x1b, 0x72, 0xcc, 0xa7, 0xfb, 0xf2, 0x42, 0x77, 0xe9, 0xf6, 0xdc, 0x40, 0x53, 0xba, 0x31, 0x9f,
-	0xa3, 0xdb, 0xfa, 0x90, 0x13, 0x2c, 0x33, 0xce, 0xe4, 0xd0, 0xef, 0xc5, 0x40, 0xfa, 0x42, 0x35,
-	0x4a, 0xd5, 0x5b, 0x2a, 0xd4, 0x77, 0x5a, 0xec, 0x28, 0xad, 0xf3, 0xe6, 0xe8, 0xb4, 0x65, 0x1c,
-	0x9f, 0xb6, 0x8c, 0x3f, 0xa7, 0x2d, 0xe3, 0xc7, 0x59, 0xab, 0x72, 0x7c, 0xd6, 0xaa, 0xfc, 0x3a,
-	0x6b, 0x55, 0x3e, 0x3d, 0x0a, 0x99, 0x8c, 0xb2, 0x9e, 0x43, 0x20, 0x51, 0x03, 0xe9, 0x71, 0x31,
-	0x9b, 0x52, 0x08, 0xa8, 0x7b, 0x78, 0x3e, 0x99, 0xe4, 0x70, 0x40, 0x45, 0x6f, 0x4a, 0x0d, 0xa3,
-	0x67, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x2b, 0x18, 0x7c, 0x22, 0x05, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x6e, 0xd3, 0x3a,
+	0x18, 0xc7, 0x9b, 0x75, 0x67, 0xa7, 0x73, 0xb7, 0x76, 0xf3, 0xb6, 0x73, 0xb2, 0x15, 0x75, 0x65,
+	0x08, 0x11, 0x81, 0x48, 0xa1, 0xc0, 0x1d, 0x4c, 0xa2, 0x1d, 0x12, 0x13, 0x03, 0xa6, 0x6c, 0x80,
+	0x04, 0x12, 0xc6, 0x75, 0x4c, 0x62, 0x9a, 0xc4, 0x95, 0xed, 0x6c, 0x2b, 0x4f, 0xc1, 0x03, 0xf1,
+	0x00, 0xbb, 0xdc, 0x25, 0xe2, 0x62, 0x42, 0xdb, 0x8b, 0xa0, 0x38, 0x49, 0xd7, 0xad, 0xd3, 0xe0,
+	0x2e, 0xf9, 0xfe, 0xbf, 0xff, 0x3f, 0x5f, 0xfc, 0xd9, 0x06, 0xd6, 0x57, 0xaa, 0x30, 0xf1, 0x31,
+	0x8b, 0x9a, 0xfa, 0x89, 0x0b, 0xda, 0xe4, 0x5d, 0x49, 0xc5, 0x1e, 0x15, 0xcd, 0x3e, 0x16, 0x38,
+	0x94, 0x76, 0x5f, 0x70, 0xc5, 0x61, 0x6d, 0x48, 0xda, 0x39, 0x69, 0xe7, 0xe4, 0xca, 0xa2, 0xc7,
+	0x3d, 0xae, 0xb9, 0x66, 0xf2, 0x94, 0x5a, 0x56, 0x1e, 0x5d, 0x15, 0x4e, 0x78, 0xf4, 0x99, 0x89,
+	0x10, 0x2b, 0xc6, 0x23, 0x34, 0xfa, 0xa5, 0xb5, 0x8f, 0xa0, 0xda, 0x49, 0x4c, 0xdb, 0xba, 0xb8,
+	0xc5, 0xa4, 0x82, 0x2f, 0xc0, 0x8c, 0xce, 0xc9, 0x40, 0xd3, 0x68, 0x14, 0xad, 0x72, 0xcb, 0xb2,
+	0xaf, 0xe8, 0xc9, 0x1e, 0xc9, 0x70, 0xca, 0xe4, 0xec, 0x65, 0xed, 0x7b, 0x09, 0x94, 0x47, 0x44,
+	0xb8, 0x0c, 0x4a, 0x69, 0x38, 0x73, 0xcd, 0x72, 0xc3, 0xb0, 0x8a, 0xce, 0xbf, 0xfa, 0x7d, 0xd3,
+	0x85, 0xf7, 0x01, 0x3c, 0xd7, 0x27, 0xe1, 0x71, 0xa4, 0x4c, 0xa3, 0x61, 0x58, 0x93, 0xed, 0x09,
+	0xd3, 0x70, 0xe6, 0x47, 0xd5, 0x4e, 0x22, 0x42, 0x0b, 0xcc, 0x79, 0x58, 0xa2, 0xbe, 0x60, 0x84,
+	0x22, 0xc5, 0x48, 0x8f, 0x0a, 0x73, 0x22, 0x31, 0x38, 0x15, 0x0f, 0xcb, 0xed, 0xa4, 0xbc, 0xab,
+	0xab, 0xf0, 0x26, 0xa8, 0xb0, 0xa8, 0xcb, 0xe3, 0xc8, 0xcd, 0xb9, 0xa2, 0xe6, 0x66, 0xb3, 0x6a,
+	0x86, 0xdd, 0x02, 0x55, 0x1e, 0xab, 0x73, 0xdc, 0x64, 0x9a, 0x97, 0x97, 0x33, 0xf0, 0x36, 0x98,
+	0xdf, 0xc7, 0x8a, 0xf8, 0x28, 0x56, 0x07, 0x3c, 0x47, 0xff, 0xd1, 0x68, 0x55, 0x0b, 0x6f, 0xd4,
+	0x01, 0xcf, 0xd8, 0x27, 0x40, 0xcf, 0x13, 0x29, 0xde, 0xa3, 0xc9, 0x6f, 0x45, 0x4a, 0x60, 0xa2,
+	0x10, 0x76, 0x5d, 0x41, 0xa5, 0x34, 0x4b, 0x0d, 0xc3, 0x9a, 0x76, 0xcc, 0x04, 0xd9, 0x4d, 0x88,
+	0x4e, 0x06, 0x3c, 0x4d, 0x75, 0xf8, 0x18, 0xac, 0x10, 0x1e, 0x45, 0x94, 0x28, 0x2e, 0xc6, 0xdd,
+	0xd3, 0xa9, 0x7b, 0x48, 0x5c, 0x74, 0x77, 0x40, 0x9d, 0x0a, 0xd2, 0xba, 0x87, 0x48, 0x2c, 0x15,
+	0x77, 0x07, 0xe3, 0x09, 0x40, 0x27, 0xd4, 0x34, 0xd5, 0x49, 0xa1, 0x4b, 0x5a, 0x18, 0x2e, 0x8b,
+	0x24, 0x3e, 0x75, 0xe3, 0x80, 0x22, 0x16, 0x29, 0x2a, 0xf6, 0x70, 0x60, 0xce, 0xe8, 0x39, 0x9a,
+	0x39, 0xb1, 0x93, 0x01, 0x9b, 0x99, 0x0e, 0xd7, 0x41, 0x6d, 0xdc, 0x1d, 0x70, 0xde, 0xc3, 0x3e,
+	0xc5, 0xae, 0x39, 0xab, 0xed, 0xcb, 0x17, 0xed, 0x5b, 0x39, 0x00, 0x5f, 0x81, 0xb9, 0x2e, 0x0e,
+	0x02, 0xae, 0x90, 0xf2, 0x05, 0x95, 0x3e, 0x0f, 0x5c, 0xb3, 0x92, 0x34, 0xdd, 0xbe, 0x71, 0x78,
+	0xbc, 0x5a, 0xf8, 0x79, 0xbc, 0x5a, 0x23, 0x5c, 0x86, 0x5c, 0x4a, 0xb7, 0x67, 0x33, 0xde, 0x0c,
+	0xb1, 0xf2, 0xed, 0x2d, 0xea, 0x61, 0x32, 0xd8, 0xa0, 0xc4, 0xa9, 0xa6, 0xe6, 0xdd, 0xdc, 0x0b,
+	0x3f, 0x80, 0xff, 0x43, 0x16, 0xa1, 0x7c, 0xf3, 0x22, 0x97, 0x06, 0xd4, 0xd3, 0xbb, 0xca, 0xac,
+	0xfe, 0x7d, 0xec, 0x52, 0xc8, 0xa2, 0xd7, 0x59, 0xc4, 0xc6, 0x30, 0x01, 0x5e, 0x07, 0x33, 0x4c,
+	0x22, 0x19, 0xf7, 0xfb, 0x5c, 0x28, 0xea, 0x9a, 0x73, 0x0d, 0xc3, 0x2a, 0x39, 0x65, 0x26, 0x77,
+	0xf2, 0x52, 0xb2, 0xc9, 0x3c, 0xac, 0xe8, 0x3e, 0x1e, 0x0c, 0x67, 0x30, 0xaf, 0x67, 0x50, 0xc9,
+	0xca, 0xf9, 0xb2, 0x7f, 0x02, 0x0b, 0x97, 0x9c, 0x5c, 0x13, 0x36, 0x0c, 0xab, 0xdc, 0x6a, 0x5e,
+	0x7d, 0x20, 0x47, 0x7c, 0xd9, 0xb9, 0x84, 0x64, 0xac, 0x06, 0x5b, 0x60, 0x49, 0x2a, 0xdc, 0xa3,
+	0x68, 0x9f, 0x32, 0xcf, 0x57, 0xd4, 0x45, 0x7b, 0x5c, 0xb1, 0xc8, 0x33, 0x17, 0x74, 0xdb, 0x0b,
+	0x5a, 0x7c, 0x97, 0x69, 0x6f, 0xb5, 0x04, 0xbf, 0x80, 0x55, 0x1a, 0x52, 0xe1, 0xd1, 0x88, 0x0c,
+	0x90, 0x8f, 0x03, 0x85, 0xc6, 0xa6, 0xb3, 0x38, 0x5c, 0x46, 0xe3, 0x4f, 0xcb, 0x78, 0x6d, 0x98,
+	0xf5, 0x1c, 0x07, 0xaa, 0x7d, 0x7e, 0x54, 0x6b, 0xeb, 0x60, 0x2a, 0xeb, 0xf4, 0x21, 0xf8, 0x2f,
+	0xfb, 0x4c, 0x88, 0x55, 0x2c, 0x98, 0x1a, 0xa0, 0x6e, 0xc0, 0x49, 0x4f, 0xea, 0x83, 0x5c, 0x74,
+	0x16, 0x53, 0xf5, 0x65, 0x26, 0xb6, 0xb5, 0xd6, 0x7e, 0x76, 0x78, 0x52, 0x37, 0x8e, 0x4e, 0xea,
+	0xc6, 0xaf, 0x93, 0xba, 0xf1, 0xed, 0xb4, 0x5e, 0x38, 0x3a, 0xad, 0x17, 0x7e, 0x9c, 0xd6, 0x0b,
+	0xef, 0xef, 0x78, 0x4c, 0xf9, 0x71, 0xd7, 0x26, 0x3c, 0xd4, 0x17, 0xe6, 0xdd, 0xf4, 0xee, 0x8c,
+	0xb8, 0x4b, 0x9b, 0x07, 0x67, 0x37, 0xa7, 0x1a, 0xf4, 0xa9, 0xec, 0x4e, 0xe9, 0xcb, 0xf2, 0xc1,
+	0xef, 0x01, 0x00, 0x42, 0xf0, 0x0d, 0xdb, 0xc2, 0x05, 0x00, 0x00,
 }
 
 func (m *ChainParamsList) Marshal() (dAtA []byte, err error) {
@@ -380,6 +397,32 @@ func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmergencyHaltBallotThreshold != nil {
+		{
+			size := m.EmergencyHaltBallotThreshold.Size()
+			i -= size
+			if _, err := m.EmergencyHaltBallotThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.StakeWeightedVoting {
+		i--
+		if m.StakeWeightedVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.ConfirmationParams != nil {
 		{
 			size, err := m.ConfirmationParams.MarshalToSizedBuffer(dAtA[:i])
@@ -610,6 +653,13 @@ func (m *ChainParams) Size() (n int) {
 		l = m.ConfirmationParams.Size()
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if m.StakeWeightedVoting {
+		n += 3
+	}
+	if m.EmergencyHaltBallotThreshold != nil {
+		l = m.EmergencyHaltBallotThreshold.Size()
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1148,6 +1198,62 @@ func (m *ChainParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeightedVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakeWeightedVoting = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyHaltBallotThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.EmergencyHaltBallotThreshold = &v
+			if err := m.EmergencyHaltBallotThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -33,6 +33,190 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryGetObserverLivenessRequest struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+}
+
+func (m *QueryGetObserverLivenessRequest) Reset()         { *m = QueryGetObserverLivenessRequest{} }
+func (m *QueryGetObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryGetObserverLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{0}
+}
+func (m *QueryGetObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetObserverLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetObserverLivenessRequest.Merge(m, src)
+}
+func (m *QueryGetObserverLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetObserverLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetObserverLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryGetObserverLivenessRequest) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+type QueryGetObserverLivenessResponse struct {
+	ObserverLiveness ObserverLiveness `protobuf:"bytes,1,opt,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
+}
+
+func (m *QueryGetObserverLivenessResponse) Reset()         { *m = QueryGetObserverLivenessResponse{} }
+func (m *QueryGetObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryGetObserverLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{1}
+}
+func (m *QueryGetObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetObserverLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetObserverLivenessResponse.Merge(m, src)
+}
+func (m *QueryGetObserverLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetObserverLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetObserverLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryGetObserverLivenessResponse) GetObserverLiveness() ObserverLiveness {
+	if m != nil {
+		return m.ObserverLiveness
+	}
+	return ObserverLiveness{}
+}
+
+type QueryAllObserverLivenessRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllObserverLivenessRequest) Reset()         { *m = QueryAllObserverLivenessRequest{} }
+func (m *QueryAllObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryAllObserverLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{2}
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllObserverLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObserverLivenessRequest.Merge(m, src)
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObserverLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllObserverLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryAllObserverLivenessRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllObserverLivenessResponse struct {
+	ObserverLiveness []ObserverLiveness  `protobuf:"bytes,1,rep,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllObserverLivenessResponse) Reset()         { *m = QueryAllObserverLivenessResponse{} }
+func (m *QueryAllObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryAllObserverLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{3}
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllObserverLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObserverLivenessResponse.Merge(m, src)
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObserverLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllObserverLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryAllObserverLivenessResponse) GetObserverLiveness() []ObserverLiveness {
+	if m != nil {
+		return m.ObserverLiveness
+	}
+	return nil
+}
+
+func (m *QueryAllObserverLivenessResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryBallotsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -41,7 +225,7 @@ func (m *QueryBallotsRequest) Reset()         { *m = QueryBallotsRequest{} }
 func (m *QueryBallotsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotsRequest) ProtoMessage()    {}
 func (*QueryBallotsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{0}
+	return fileDescriptor_25b2aa420449a0c0, []int{4}
 }
 func (m *QueryBallotsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -86,7 +270,7 @@ func (m *QueryBallotsResponse) Reset()         { *m = QueryBallotsResponse{} }
 func (m *QueryBallotsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotsResponse) ProtoMessage()    {}
 func (*QueryBallotsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{1}
+	return fileDescriptor_25b2aa420449a0c0, []int{5}
 }
 func (m *QueryBallotsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -136,7 +320,7 @@ func (m *QueryOperationalFlagsRequest) Reset()         { *m = QueryOperationalFl
 func (m *QueryOperationalFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOperationalFlagsRequest) ProtoMessage()    {}
 func (*QueryOperationalFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{2}
+	return fileDescriptor_25b2aa420449a0c0, []int{6}
 }
 func (m *QueryOperationalFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +357,7 @@ func (m *QueryOperationalFlagsResponse) Reset()         { *m = QueryOperationalF
 func (m *QueryOperationalFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOperationalFlagsResponse) ProtoMessage()    {}
 func (*QueryOperationalFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{3}
+	return fileDescriptor_25b2aa420449a0c0, []int{7}
 }
 func (m *QueryOperationalFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,7 +400,7 @@ func (m *QueryTssFundsMigratorInfoAllRequest) Reset()         { *m = QueryTssFun
 func (m *QueryTssFundsMigratorInfoAllRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoAllRequest) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{4}
+	return fileDescriptor_25b2aa420449a0c0, []int{8}
 }
 func (m *QueryTssFundsMigratorInfoAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,7 +437,7 @@ func (m *QueryTssFundsMigratorInfoAllResponse) Reset()         { *m = QueryTssFu
 func (m *QueryTssFundsMigratorInfoAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoAllResponse) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{5}
+	return fileDescriptor_25b2aa420449a0c0, []int{9}
 }
 func (m *QueryTssFundsMigratorInfoAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +481,7 @@ func (m *QueryTssFundsMigratorInfoRequest) Reset()         { *m = QueryTssFundsM
 func (m *QueryTssFundsMigratorInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoRequest) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{6}
+	return fileDescriptor_25b2aa420449a0c0, []int{10}
 }
 func (m *QueryTssFundsMigratorInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +525,7 @@ func (m *QueryTssFundsMigratorInfoResponse) Reset()         { *m = QueryTssFunds
 func (m *QueryTssFundsMigratorInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoResponse) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{7}
+	return fileDescriptor_25b2aa420449a0c0, []int{11}
 }
 func (m *QueryTssFundsMigratorInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +569,7 @@ func (m *QueryGetChainNoncesRequest) Reset()         { *m = QueryGetChainNoncesR
 func (m *QueryGetChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesRequest) ProtoMessage()    {}
 func (*QueryGetChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{8}
+	return fileDescriptor_25b2aa420449a0c0, []int{12}
 }
 func (m *QueryGetChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,7 +613,7 @@ func (m *QueryGetChainNoncesResponse) Reset()         { *m = QueryGetChainNonces
 func (m *QueryGetChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesResponse) ProtoMessage()    {}
 func (*QueryGetChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{9}
+	return fileDescriptor_25b2aa420449a0c0, []int{13}
 }
 func (m *QueryGetChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +657,7 @@ func (m *QueryAllChainNoncesRequest) Reset()         { *m = QueryAllChainNoncesR
 func (m *QueryAllChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesRequest) ProtoMessage()    {}
 func (*QueryAllChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{10}
+	return fileDescriptor_25b2aa420449a0c0, []int{14}
 }
 func (m *QueryAllChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +702,7 @@ func (m *QueryAllChainNoncesResponse) Reset()         { *m = QueryAllChainNonces
 func (m *QueryAllChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesResponse) ProtoMessage()    {}
 func (*QueryAllChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{11}
+	return fileDescriptor_25b2aa420449a0c0, []int{15}
 }
 func (m *QueryAllChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +753,7 @@ func (m *QueryAllPendingNoncesRequest) Reset()         { *m = QueryAllPendingNon
 func (m *QueryAllPendingNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesRequest) ProtoMessage()    {}
 func (*QueryAllPendingNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{12}
+	return fileDescriptor_25b2aa420449a0c0, []int{16}
 }
 func (m *QueryAllPendingNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,7 +798,7 @@ func (m *QueryAllPendingNoncesResponse) Reset()         { *m = QueryAllPendingNo
 func (m *QueryAllPendingNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesResponse) ProtoMessage()    {}
 func (*QueryAllPendingNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{13}
+	return fileDescriptor_25b2aa420449a0c0, []int{17}
 }
 func (m *QueryAllPendingNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +849,7 @@ func (m *QueryPendingNoncesByChainRequest) Reset()         { *m = QueryPendingNo
 func (m *QueryPendingNoncesByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainRequest) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{14}
+	return fileDescriptor_25b2aa420449a0c0, []int{18}
 }
 func (m *QueryPendingNoncesByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +893,7 @@ func (m *QueryPendingNoncesByChainResponse) Reset()         { *m = QueryPendingN
 func (m *QueryPendingNoncesByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainResponse) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{15}
+	return fileDescriptor_25b2aa420449a0c0, []int{19}
 }
 func (m *QueryPendingNoncesByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +936,7 @@ func (m *QueryGetTSSRequest) Reset()         { *m = QueryGetTSSRequest{} }
 func (m *QueryGetTSSRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSRequest) ProtoMessage()    {}
 func (*QueryGetTSSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{16}
+	return fileDescriptor_25b2aa420449a0c0, []int{20}
 }
 func (m *QueryGetTSSRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +973,7 @@ func (m *QueryGetTSSResponse) Reset()         { *m = QueryGetTSSResponse{} }
 func (m *QueryGetTSSResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSResponse) ProtoMessage()    {}
 func (*QueryGetTSSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{17}
+	return fileDescriptor_25b2aa420449a0c0, []int{21}
 }
 func (m *QueryGetTSSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +1017,7 @@ func (m *QueryGetTssAddressRequest) Reset()         { *m = QueryGetTssAddressReq
 func (m *QueryGetTssAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressRequest) ProtoMessage()    {}
 func (*QueryGetTssAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{18}
+	return fileDescriptor_25b2aa420449a0c0, []int{22}
 }
 func (m *QueryGetTssAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +1062,7 @@ func (m *QueryGetTssAddressResponse) Reset()         { *m = QueryGetTssAddressRe
 func (m *QueryGetTssAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressResponse) ProtoMessage()    {}
 func (*QueryGetTssAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{19}
+	return fileDescriptor_25b2aa420449a0c0, []int{23}
 }
 func (m *QueryGetTssAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -934,7 +1118,7 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) String() string {
 }
 func (*QueryGetTssAddressByFinalizedHeightRequest) ProtoMessage() {}
 func (*QueryGetTssAddressByFinalizedHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{20}
+	return fileDescriptor_25b2aa420449a0c0, []int{24}
 }
 func (m *QueryGetTssAddressByFinalizedHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -990,7 +1174,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) String() string {
 }
 func (*QueryGetTssAddressByFinalizedHeightResponse) ProtoMessage() {}
 func (*QueryGetTssAddressByFinalizedHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{21}
+	return fileDescriptor_25b2aa420449a0c0, []int{25}
 }
 func (m *QueryGetTssAddressByFinalizedHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1041,7 +1225,7 @@ func (m *QueryTssHistoryRequest) Reset()         { *m = QueryTssHistoryRequest{}
 func (m *QueryTssHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssHistoryRequest) ProtoMessage()    {}
 func (*QueryTssHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{22}
+	return fileDescriptor_25b2aa420449a0c0, []int{26}
 }
 func (m *QueryTssHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1270,7 @@ func (m *QueryTssHistoryResponse) Reset()         { *m = QueryTssHistoryResponse
 func (m *QueryTssHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssHistoryResponse) ProtoMessage()    {}
 func (*QueryTssHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{23}
+	return fileDescriptor_25b2aa420449a0c0, []int{27}
 }
 func (m *QueryTssHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1322,7 @@ func (m *QueryHasVotedRequest) Reset()         { *m = QueryHasVotedRequest{} }
 func (m *QueryHasVotedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedRequest) ProtoMessage()    {}
 func (*QueryHasVotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{24}
+	return fileDescriptor_25b2aa420449a0c0, []int{28}
 }
 func (m *QueryHasVotedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1189,7 +1373,7 @@ func (m *QueryHasVotedResponse) Reset()         { *m = QueryHasVotedResponse{} }
 func (m *QueryHasVotedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedResponse) ProtoMessage()    {}
 func (*QueryHasVotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{25}
+	return fileDescriptor_25b2aa420449a0c0, []int{29}
 }
 func (m *QueryHasVotedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,7 +1417,7 @@ func (m *QueryBallotByIdentifierRequest) Reset()         { *m = QueryBallotByIde
 func (m *QueryBallotByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBallotByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{26}
+	return fileDescriptor_25b2aa420449a0c0, []int{30}
 }
 func (m *QueryBallotByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1272,13 +1456,15 @@ func (m *QueryBallotByIdentifierRequest) GetBallotIdentifier() string {
 type VoterList struct {
 	VoterAddress string   `protobuf:"bytes,1,opt,name=voter_address,json=voterAddress,proto3" json:"voter_address,omitempty"`
 	VoteType     VoteType `protobuf:"varint,2,opt,name=vote_type,json=voteType,proto3,enum=zetachain.zetacore.observer.VoteType" json:"vote_type,omitempty"`
+	// weight of the vote, 1 if the ballot is not stake weighted
+	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
 func (m *VoterList) Reset()         { *m = VoterList{} }
 func (m *VoterList) String() string { return proto.CompactTextString(m) }
 func (*VoterList) ProtoMessage()    {}
 func (*VoterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{27}
+	return fileDescriptor_25b2aa420449a0c0, []int{31}
 }
 func (m *VoterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,6 +1507,13 @@ func (m *VoterList) GetVoteType() VoteType {
 	return VoteType_SuccessObservation
 }
 
+func (m *VoterList) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 type QueryBallotByIdentifierResponse struct {
 	BallotIdentifier string          `protobuf:"bytes,1,opt,name=ballot_identifier,json=ballotIdentifier,proto3" json:"ballot_identifier,omitempty"`
 	Voters           []*VoterList    `protobuf:"bytes,2,rep,name=voters,proto3" json:"voters,omitempty"`
@@ -1332,7 +1525,7 @@ func (m *QueryBallotByIdentifierResponse) Reset()         { *m = QueryBallotById
 func (m *QueryBallotByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBallotByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{28}
+	return fileDescriptor_25b2aa420449a0c0, []int{32}
 }
 func (m *QueryBallotByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1589,7 @@ func (m *QueryObserverSet) Reset()         { *m = QueryObserverSet{} }
 func (m *QueryObserverSet) String() string { return proto.CompactTextString(m) }
 func (*QueryObserverSet) ProtoMessage()    {}
 func (*QueryObserverSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{29}
+	return fileDescriptor_25b2aa420449a0c0, []int{33}
 }
 func (m *QueryObserverSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1626,7 @@ func (m *QueryObserverSetResponse) Reset()         { *m = QueryObserverSetRespon
 func (m *QueryObserverSetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryObserverSetResponse) ProtoMessage()    {}
 func (*QueryObserverSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{30}
+	return fileDescriptor_25b2aa420449a0c0, []int{34}
 }
 func (m *QueryObserverSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1476,7 +1669,7 @@ func (m *QuerySupportedChains) Reset()         { *m = QuerySupportedChains{} }
 func (m *QuerySupportedChains) String() string { return proto.CompactTextString(m) }
 func (*QuerySupportedChains) ProtoMessage()    {}
 func (*QuerySupportedChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{31}
+	return fileDescriptor_25b2aa420449a0c0, []int{35}
 }
 func (m *QuerySupportedChains) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1513,7 +1706,7 @@ func (m *QuerySupportedChainsResponse) Reset()         { *m = QuerySupportedChai
 func (m *QuerySupportedChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupportedChainsResponse) ProtoMessage()    {}
 func (*QuerySupportedChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{32}
+	return fileDescriptor_25b2aa420449a0c0, []int{36}
 }
 func (m *QuerySupportedChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1557,7 +1750,7 @@ func (m *QueryGetChainParamsForChainRequest) Reset()         { *m = QueryGetChai
 func (m *QueryGetChainParamsForChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsForChainRequest) ProtoMessage()    {}
 func (*QueryGetChainParamsForChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{33}
+	return fileDescriptor_25b2aa420449a0c0, []int{37}
 }
 func (m *QueryGetChainParamsForChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +1794,7 @@ func (m *QueryGetChainParamsForChainResponse) Reset()         { *m = QueryGetCha
 func (m *QueryGetChainParamsForChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsForChainResponse) ProtoMessage()    {}
 func (*QueryGetChainParamsForChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{34}
+	return fileDescriptor_25b2aa420449a0c0, []int{38}
 }
 func (m *QueryGetChainParamsForChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1644,7 +1837,7 @@ func (m *QueryGetChainParamsRequest) Reset()         { *m = QueryGetChainParamsR
 func (m *QueryGetChainParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsRequest) ProtoMessage()    {}
 func (*QueryGetChainParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{35}
+	return fileDescriptor_25b2aa420449a0c0, []int{39}
 }
 func (m *QueryGetChainParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1681,7 +1874,7 @@ func (m *QueryGetChainParamsResponse) Reset()         { *m = QueryGetChainParams
 func (m *QueryGetChainParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsResponse) ProtoMessage()    {}
 func (*QueryGetChainParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{36}
+	return fileDescriptor_25b2aa420449a0c0, []int{40}
 }
 func (m *QueryGetChainParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1725,7 +1918,7 @@ func (m *QueryGetNodeAccountRequest) Reset()         { *m = QueryGetNodeAccountR
 func (m *QueryGetNodeAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNodeAccountRequest) ProtoMessage()    {}
 func (*QueryGetNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{37}
+	return fileDescriptor_25b2aa420449a0c0, []int{41}
 }
 func (m *QueryGetNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1769,7 +1962,7 @@ func (m *QueryGetNodeAccountResponse) Reset()         { *m = QueryGetNodeAccount
 func (m *QueryGetNodeAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNodeAccountResponse) ProtoMessage()    {}
 func (*QueryGetNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{38}
+	return fileDescriptor_25b2aa420449a0c0, []int{42}
 }
 func (m *QueryGetNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1813,7 +2006,7 @@ func (m *QueryAllNodeAccountRequest) Reset()         { *m = QueryAllNodeAccountR
 func (m *QueryAllNodeAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllNodeAccountRequest) ProtoMessage()    {}
 func (*QueryAllNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{39}
+	return fileDescriptor_25b2aa420449a0c0, []int{43}
 }
 func (m *QueryAllNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1858,7 +2051,7 @@ func (m *QueryAllNodeAccountResponse) Reset()         { *m = QueryAllNodeAccount
 func (m *QueryAllNodeAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllNodeAccountResponse) ProtoMessage()    {}
 func (*QueryAllNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{40}
+	return fileDescriptor_25b2aa420449a0c0, []int{44}
 }
 func (m *QueryAllNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1908,7 +2101,7 @@ func (m *QueryGetCrosschainFlagsRequest) Reset()         { *m = QueryGetCrosscha
 func (m *QueryGetCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCrosschainFlagsRequest) ProtoMessage()    {}
 func (*QueryGetCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{41}
+	return fileDescriptor_25b2aa420449a0c0, []int{45}
 }
 func (m *QueryGetCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1945,7 +2138,7 @@ func (m *QueryGetCrosschainFlagsResponse) Reset()         { *m = QueryGetCrossch
 func (m *QueryGetCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCrosschainFlagsResponse) ProtoMessage()    {}
 func (*QueryGetCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{42}
+	return fileDescriptor_25b2aa420449a0c0, []int{46}
 }
 func (m *QueryGetCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1988,7 +2181,7 @@ func (m *QueryGetKeygenRequest) Reset()         { *m = QueryGetKeygenRequest{} }
 func (m *QueryGetKeygenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeygenRequest) ProtoMessage()    {}
 func (*QueryGetKeygenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{43}
+	return fileDescriptor_25b2aa420449a0c0, []int{47}
 }
 func (m *QueryGetKeygenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2025,7 +2218,7 @@ func (m *QueryGetKeygenResponse) Reset()         { *m = QueryGetKeygenResponse{}
 func (m *QueryGetKeygenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeygenResponse) ProtoMessage()    {}
 func (*QueryGetKeygenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{44}
+	return fileDescriptor_25b2aa420449a0c0, []int{48}
 }
 func (m *QueryGetKeygenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2068,7 +2261,7 @@ func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserver
 func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryShowObserverCountRequest) ProtoMessage()    {}
 func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{45}
+	return fileDescriptor_25b2aa420449a0c0, []int{49}
 }
 func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2105,7 +2298,7 @@ func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserve
 func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryShowObserverCountResponse) ProtoMessage()    {}
 func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{46}
+	return fileDescriptor_25b2aa420449a0c0, []int{50}
 }
 func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2149,7 +2342,7 @@ func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdent
 func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{47}
+	return fileDescriptor_25b2aa420449a0c0, []int{51}
 }
 func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2193,7 +2386,7 @@ func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIden
 func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{48}
+	return fileDescriptor_25b2aa420449a0c0, []int{52}
 }
 func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2237,7 +2430,7 @@ func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecord
 func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
 func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{49}
+	return fileDescriptor_25b2aa420449a0c0, []int{53}
 }
 func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2282,7 +2475,7 @@ func (m *QueryAllBlameRecordsResponse) Reset()         { *m = QueryAllBlameRecor
 func (m *QueryAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlameRecordsResponse) ProtoMessage()    {}
 func (*QueryAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{50}
+	return fileDescriptor_25b2aa420449a0c0, []int{54}
 }
 func (m *QueryAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2334,7 +2527,7 @@ func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByCh
 func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
 func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{51}
+	return fileDescriptor_25b2aa420449a0c0, []int{55}
 }
 func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2385,7 +2578,7 @@ func (m *QueryBlameByChainAndNonceResponse) Reset()         { *m = QueryBlameByC
 func (m *QueryBlameByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByChainAndNonceResponse) ProtoMessage()    {}
 func (*QueryBlameByChainAndNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{52}
+	return fileDescriptor_25b2aa420449a0c0, []int{56}
 }
 func (m *QueryBlameByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2422,6 +2615,10 @@ func (m *QueryBlameByChainAndNonceResponse) GetBlameInfo() []*Blame {
 }
 
 func init() {
+	proto.RegisterType((*QueryGetObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryGetObserverLivenessRequest")
+	proto.RegisterType((*QueryGetObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryGetObserverLivenessResponse")
+	proto.RegisterType((*QueryAllObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryAllObserverLivenessRequest")
+	proto.RegisterType((*QueryAllObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryAllObserverLivenessResponse")
 	proto.RegisterType((*QueryBallotsRequest)(nil), "zetachain.zetacore.observer.QueryBallotsRequest")
 	proto.RegisterType((*QueryBallotsResponse)(nil), "zetachain.zetacore.observer.QueryBallotsResponse")
 	proto.RegisterType((*QueryOperationalFlagsRequest)(nil), "zetachain.zetacore.observer.QueryOperationalFlagsRequest")
@@ -2482,162 +2679,169 @@ func init() {
 }
 
 var fileDescriptor_25b2aa420449a0c0 = []byte{
-	// 2465 bytes of a gzipped FileDescriptorProto
+	// 2588 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
-	0xf5, 0xf7, 0x5a, 0xb1, 0x2d, 0x8d, 0xfc, 0x83, 0x1a, 0xcb, 0xb6, 0x42, 0x2b, 0xb4, 0xbc, 0x92,
-	0x6d, 0x59, 0x96, 0xb8, 0x12, 0xed, 0x7c, 0xfd, 0x2b, 0x8e, 0x4d, 0xea, 0x6b, 0x49, 0x76, 0x12,
-	0xdb, 0x21, 0xdd, 0x06, 0x30, 0x9a, 0x32, 0x4b, 0x72, 0x48, 0x6e, 0xbd, 0xda, 0x61, 0x76, 0x56,
-	0x4a, 0x18, 0x55, 0x40, 0xda, 0x5b, 0x73, 0x28, 0x0a, 0xb4, 0x68, 0x6f, 0x45, 0x0f, 0xed, 0xb1,
-	0x40, 0x10, 0x20, 0x68, 0x80, 0xa2, 0x87, 0x9c, 0x9a, 0x43, 0x0f, 0x29, 0x5a, 0x14, 0x3d, 0xb5,
-	0x81, 0x5d, 0xa0, 0xff, 0x44, 0x0f, 0xc5, 0xce, 0xbc, 0x25, 0x77, 0x97, 0xbb, 0xcb, 0xa1, 0xcc,
-	0x9e, 0xc8, 0x9d, 0x9d, 0xf7, 0xe6, 0xf3, 0x79, 0x33, 0x6f, 0xe6, 0x33, 0x33, 0x8b, 0x2e, 0x7c,
-	0x44, 0x1c, 0xbd, 0xda, 0xd4, 0x0d, 0x4b, 0xe3, 0xff, 0xa8, 0x4d, 0x34, 0x5a, 0x61, 0xc4, 0xde,
-	0x26, 0xb6, 0xf6, 0xfe, 0x16, 0xb1, 0xdb, 0xd9, 0x96, 0x4d, 0x1d, 0x8a, 0x4f, 0x77, 0x2a, 0x66,
-	0xbd, 0x8a, 0x59, 0xaf, 0x62, 0x7a, 0xa1, 0x4a, 0xd9, 0x26, 0x65, 0x5a, 0x45, 0x67, 0x44, 0x58,
-	0x69, 0xdb, 0x2b, 0x15, 0xe2, 0xe8, 0x2b, 0x5a, 0x4b, 0x6f, 0x18, 0x96, 0xee, 0x18, 0xd4, 0x12,
-	0x8e, 0xd2, 0x93, 0x0d, 0xda, 0xa0, 0xfc, 0xaf, 0xe6, 0xfe, 0x83, 0xd2, 0xe9, 0x06, 0xa5, 0x0d,
-	0x93, 0x68, 0x7a, 0xcb, 0xd0, 0x74, 0xcb, 0xa2, 0x0e, 0x37, 0x61, 0xf0, 0x76, 0x3e, 0x09, 0x65,
-	0x45, 0x37, 0x4d, 0xea, 0x40, 0xcd, 0x44, 0x3e, 0x15, 0x53, 0xdf, 0x24, 0x50, 0x31, 0x9b, 0x54,
-	0x91, 0x97, 0x97, 0x2d, 0x6a, 0x55, 0x89, 0x07, 0x21, 0x97, 0x58, 0xdf, 0xa6, 0x8c, 0x09, 0xa3,
-	0xba, 0xa9, 0x37, 0xa4, 0x60, 0x3f, 0x25, 0xed, 0x06, 0xb1, 0x64, 0xd0, 0x58, 0xb4, 0x46, 0xca,
-	0x7a, 0xb5, 0x4a, 0xb7, 0x2c, 0x8f, 0xe6, 0x42, 0x52, 0x7d, 0xef, 0x8f, 0x0c, 0x8a, 0x96, 0x6e,
-	0xeb, 0x9b, 0x1e, 0xde, 0xe5, 0xc4, 0x9a, 0xc4, 0xaa, 0x19, 0x56, 0x23, 0x18, 0x95, 0x73, 0x49,
-	0x16, 0x0e, 0xf3, 0xaa, 0x2d, 0x25, 0xc2, 0x6d, 0x11, 0x9b, 0xf7, 0xb6, 0x6e, 0x26, 0xb0, 0x6b,
-	0x3d, 0x6d, 0x88, 0x6e, 0x61, 0xf0, 0xd3, 0xa7, 0x6e, 0xcb, 0xa6, 0xb4, 0xce, 0xe0, 0x07, 0xea,
-	0x5e, 0xe9, 0x83, 0xb6, 0x5c, 0xdf, 0xb2, 0x6a, 0xac, 0xbc, 0x69, 0x34, 0x6c, 0xdd, 0xa1, 0x5e,
-	0xfc, 0x4e, 0xc1, 0xe0, 0xde, 0x64, 0x0d, 0x6d, 0x7b, 0xc5, 0xfd, 0x11, 0x2f, 0xd4, 0x77, 0xd1,
-	0xf1, 0xb7, 0xdd, 0xb1, 0x5e, 0xe0, 0x03, 0x90, 0x15, 0xc9, 0xfb, 0x5b, 0x84, 0x39, 0x78, 0x0d,
-	0xa1, 0xee, 0xa0, 0x9f, 0x52, 0x66, 0x94, 0xf9, 0xf1, 0xdc, 0xf9, 0xac, 0x70, 0x92, 0x75, 0x33,
-	0x24, 0x2b, 0xf2, 0x0a, 0x32, 0x24, 0xfb, 0x48, 0x6f, 0x10, 0xb0, 0x2d, 0xfa, 0x2c, 0xd5, 0x5f,
-	0x2b, 0x68, 0x32, 0xe8, 0x9f, 0xb5, 0xa8, 0xc5, 0x08, 0x5e, 0x45, 0x87, 0xc4, 0x98, 0x67, 0x53,
-	0xca, 0xcc, 0xc8, 0xfc, 0x78, 0x6e, 0x36, 0x9b, 0x90, 0x9c, 0x59, 0x61, 0x5e, 0x78, 0xe9, 0xab,
-	0x7f, 0x9c, 0xd9, 0x57, 0xf4, 0x2c, 0xf1, 0x7a, 0x00, 0xe5, 0x7e, 0x8e, 0xf2, 0x42, 0x5f, 0x94,
-	0x02, 0x41, 0x00, 0x66, 0x06, 0x4d, 0x73, 0x94, 0x0f, 0xbb, 0xdd, 0xb8, 0xe6, 0xe6, 0x00, 0x50,
-	0x52, 0x7f, 0xa0, 0xa0, 0x57, 0x62, 0x2a, 0x00, 0x9f, 0xf7, 0xd0, 0x84, 0x6f, 0x0c, 0x88, 0x0c,
-	0x82, 0xb8, 0x2d, 0x25, 0x32, 0x0b, 0x7b, 0x04, 0x8e, 0x29, 0x1a, 0x2a, 0x57, 0xcf, 0xa1, 0x59,
-	0x0e, 0xe1, 0x31, 0x63, 0x6b, 0x6e, 0x17, 0xbf, 0x05, 0x3d, 0x7c, 0xcf, 0xaa, 0xd3, 0xbc, 0x69,
-	0x7a, 0x50, 0x7f, 0xac, 0xa0, 0xb9, 0xe4, 0x7a, 0x80, 0xb8, 0x8e, 0x8e, 0xf7, 0x0e, 0x17, 0xaf,
-	0x37, 0x96, 0x13, 0x31, 0x83, 0x6b, 0xbf, 0x67, 0x80, 0x3d, 0xe1, 0x84, 0x5a, 0x65, 0xea, 0x2d,
-	0x34, 0x13, 0x8b, 0xc7, 0x1b, 0x6e, 0x2f, 0xa3, 0x51, 0x31, 0xf3, 0x18, 0x35, 0x1e, 0xb4, 0x91,
-	0xe2, 0x21, 0xfe, 0x7c, 0xaf, 0xa6, 0xfe, 0x48, 0x41, 0x67, 0x13, 0xec, 0x81, 0x4c, 0x0d, 0xe1,
-	0x5e, 0x32, 0x10, 0xff, 0xbd, 0x72, 0x49, 0x85, 0xb9, 0xa8, 0x57, 0x51, 0x9a, 0x43, 0x59, 0x27,
-	0xce, 0xaa, 0xeb, 0xee, 0x01, 0x9f, 0x46, 0x24, 0x48, 0x50, 0x74, 0x3a, 0xd2, 0x10, 0xd0, 0x3f,
-	0x42, 0xe3, 0xbe, 0x62, 0x80, 0x3d, 0x9f, 0x08, 0xdb, 0x57, 0x1f, 0xe0, 0xfa, 0x5d, 0xa8, 0x35,
-	0x40, 0x9a, 0x37, 0xcd, 0x08, 0xa4, 0xc3, 0xca, 0xee, 0x2f, 0x14, 0xe0, 0x15, 0x6e, 0x26, 0x8e,
-	0xd7, 0xc8, 0x0b, 0xf2, 0x1a, 0x5e, 0xc6, 0xd7, 0x21, 0xe3, 0xf3, 0xa6, 0xf9, 0x48, 0x2c, 0x0a,
-	0xff, 0x9b, 0x10, 0x7d, 0xe9, 0xcd, 0x1c, 0xbd, 0x0d, 0x41, 0x90, 0xde, 0x41, 0x47, 0x83, 0xcb,
-	0x12, 0xc4, 0x69, 0x21, 0x31, 0x4e, 0x01, 0x5f, 0x10, 0xa9, 0x23, 0x2d, 0x7f, 0xe1, 0xf0, 0x62,
-	0xe5, 0x65, 0x70, 0xb0, 0xcd, 0x36, 0xef, 0x17, 0x89, 0xc1, 0xff, 0x7d, 0x48, 0xe0, 0x68, 0xf3,
-	0x84, 0x28, 0x28, 0x43, 0x88, 0x82, 0x3a, 0x89, 0xb0, 0x97, 0x7a, 0x8f, 0x4b, 0x25, 0x6f, 0x96,
-	0x7c, 0x08, 0xcb, 0x9e, 0x57, 0x0a, 0x28, 0xae, 0xa1, 0x91, 0xc7, 0xa5, 0x12, 0x34, 0x3d, 0x93,
-	0x3c, 0x6f, 0x94, 0x4a, 0xd0, 0xa0, 0x6b, 0xa2, 0xde, 0x45, 0x2f, 0x77, 0x1c, 0x32, 0x96, 0xaf,
-	0xd5, 0x6c, 0xc2, 0x3a, 0x83, 0x69, 0x1e, 0xa5, 0x2a, 0x86, 0x53, 0xa5, 0x86, 0x55, 0xee, 0x04,
-	0x69, 0x3f, 0x0f, 0xd2, 0x51, 0x28, 0x5f, 0x85, 0x58, 0xdd, 0xe9, 0xce, 0x30, 0x7e, 0x37, 0x00,
-	0x2f, 0x85, 0x46, 0x88, 0xd3, 0xe4, 0xf0, 0xc6, 0x8a, 0xee, 0x5f, 0xb7, 0xa4, 0xe2, 0x54, 0xb9,
-	0xb3, 0xb1, 0xa2, 0xfb, 0x57, 0xfd, 0x44, 0x41, 0x0b, 0xbd, 0x2e, 0x0a, 0xed, 0x35, 0xc3, 0xd2,
-	0x4d, 0xe3, 0x23, 0x52, 0xdb, 0x20, 0x46, 0xa3, 0xe9, 0x78, 0xd0, 0x72, 0xe8, 0x44, 0xdd, 0x7b,
-	0x53, 0x76, 0x59, 0x96, 0x9b, 0xfc, 0x3d, 0x74, 0xe2, 0xf1, 0xce, 0xcb, 0x27, 0xc4, 0xd1, 0x85,
-	0xe9, 0x00, 0x74, 0xde, 0x46, 0x97, 0xa4, 0xb0, 0x0c, 0xc0, 0xef, 0x3d, 0x74, 0xd2, 0x5b, 0x0e,
-	0x36, 0x0c, 0xe6, 0x50, 0xbb, 0x3d, 0xec, 0x94, 0xfd, 0x8d, 0x82, 0x4e, 0xf5, 0x34, 0x01, 0x08,
-	0xf3, 0x68, 0xd4, 0x5d, 0x67, 0x4c, 0x83, 0x39, 0x90, 0xa6, 0xb2, 0xa3, 0xe4, 0x90, 0xc3, 0xd8,
-	0x9b, 0x06, 0x73, 0x86, 0x97, 0x96, 0x4d, 0x90, 0x56, 0x1b, 0x3a, 0xfb, 0x36, 0x75, 0x48, 0xcd,
-	0x8b, 0xc3, 0x25, 0x34, 0x21, 0x04, 0x52, 0xd9, 0xa8, 0x11, 0xcb, 0x31, 0xea, 0x06, 0xb1, 0x21,
-	0xa6, 0x29, 0xf1, 0xe2, 0x5e, 0xa7, 0x1c, 0xcf, 0xa2, 0x23, 0xdb, 0xd4, 0x21, 0x76, 0x59, 0x17,
-	0x9d, 0x03, 0xa1, 0x3e, 0xcc, 0x0b, 0xa1, 0xc3, 0xd4, 0x2b, 0xe8, 0x44, 0xa8, 0x25, 0x08, 0xc7,
-	0x69, 0x34, 0xd6, 0xd4, 0x59, 0xd9, 0xad, 0x2c, 0xd2, 0x7e, 0xb4, 0x38, 0xda, 0x84, 0x4a, 0xea,
-	0x5b, 0x28, 0xe3, 0x93, 0x7e, 0x85, 0x76, 0xb7, 0xd5, 0xbd, 0x20, 0x55, 0x1d, 0x34, 0xe6, 0xfa,
-	0xb5, 0x79, 0x10, 0x7b, 0x60, 0x2b, 0xbd, 0xb0, 0x71, 0x01, 0x8d, 0xb9, 0xcf, 0x65, 0xa7, 0xdd,
-	0x22, 0x9c, 0xd7, 0xd1, 0xdc, 0xb9, 0xc4, 0xde, 0x72, 0xfd, 0x3f, 0x6e, 0xb7, 0x48, 0x71, 0x74,
-	0x1b, 0xfe, 0xa9, 0xbf, 0xdb, 0x8f, 0xce, 0xc4, 0xb2, 0x80, 0x28, 0x0c, 0x14, 0xf0, 0xd7, 0xd1,
-	0x41, 0x0e, 0xd2, 0x8d, 0xf4, 0x08, 0x1f, 0xa1, 0xfd, 0x10, 0x71, 0xc6, 0x45, 0xb0, 0xc2, 0xef,
-	0xa0, 0x94, 0x78, 0xcb, 0x07, 0x81, 0xe0, 0x36, 0xc2, 0xb9, 0x2d, 0x26, 0xeb, 0xcc, 0xae, 0x11,
-	0xa7, 0x78, 0x8c, 0x06, 0x0b, 0xf0, 0x03, 0x74, 0x04, 0x58, 0x30, 0x47, 0x77, 0xb6, 0xd8, 0xd4,
-	0x4b, 0xdc, 0xeb, 0x45, 0x09, 0x5d, 0x5e, 0xe2, 0x06, 0xc5, 0xc3, 0x15, 0xdf, 0x93, 0x8a, 0x51,
-	0x4a, 0x48, 0x66, 0xa8, 0x5b, 0x22, 0x8e, 0x7a, 0x0d, 0x4d, 0x85, 0xcb, 0x3a, 0x51, 0x9c, 0x46,
-	0x63, 0x9e, 0x5b, 0xb1, 0x04, 0x8e, 0x15, 0xbb, 0x05, 0xea, 0x49, 0x18, 0xec, 0xa5, 0xad, 0x56,
-	0x8b, 0xda, 0x0e, 0xa9, 0xf1, 0x29, 0x86, 0xa9, 0x15, 0x58, 0xc7, 0x43, 0xe5, 0x1d, 0xaf, 0x05,
-	0x74, 0x50, 0x6c, 0xb5, 0x20, 0x5d, 0xe7, 0xa2, 0xe8, 0xb4, 0x9e, 0x36, 0xb2, 0xb0, 0x21, 0xe3,
-	0xe6, 0x90, 0xb2, 0x60, 0xa9, 0xde, 0x46, 0x6a, 0x40, 0xbd, 0x3d, 0xe2, 0xfb, 0xcd, 0x35, 0x6a,
-	0xcb, 0xae, 0x80, 0x36, 0x48, 0xf7, 0x38, 0x07, 0x80, 0xf5, 0x0d, 0x74, 0x58, 0x78, 0x10, 0x1b,
-	0x5a, 0x79, 0x1d, 0x28, 0xfc, 0x15, 0xc7, 0xab, 0xdd, 0x07, 0x75, 0x3a, 0xa4, 0x55, 0xa1, 0x0e,
-	0xac, 0x7f, 0x56, 0x48, 0x90, 0x7a, 0x6f, 0x01, 0xc9, 0xc3, 0x48, 0x24, 0x8b, 0xb2, 0x48, 0xf8,
-	0x80, 0x0d, 0xa0, 0xc9, 0x75, 0xd1, 0x3c, 0xa0, 0x35, 0x92, 0x17, 0x07, 0x01, 0x5e, 0xe8, 0x26,
-	0xd1, 0x01, 0xc3, 0xaa, 0x91, 0x0f, 0x21, 0x69, 0xc4, 0x83, 0xfa, 0xbd, 0x2e, 0xc6, 0x80, 0x4d,
-	0x37, 0x5a, 0xfe, 0x43, 0x05, 0xa9, 0x68, 0xf9, 0xfd, 0x8c, 0x5b, 0xdd, 0x07, 0xbf, 0x5e, 0x8e,
-	0xc0, 0x37, 0xac, 0x95, 0xe5, 0x33, 0x9f, 0x5e, 0x8e, 0xa2, 0x74, 0x1f, 0x8d, 0xfb, 0x8a, 0xa5,
-	0xf4, 0x72, 0x80, 0x91, 0xef, 0x61, 0x78, 0xcb, 0xcc, 0x0c, 0x4c, 0xe3, 0xee, 0x50, 0xe9, 0x1c,
-	0x11, 0x05, 0x76, 0xc7, 0x1f, 0x2b, 0x30, 0x47, 0x46, 0x55, 0x01, 0x6a, 0xef, 0xa2, 0x54, 0xf8,
-	0x80, 0x49, 0x6e, 0x54, 0x05, 0xfd, 0x41, 0x66, 0x1e, 0xab, 0x06, 0x8b, 0xd5, 0x53, 0xb0, 0x42,
-	0xad, 0x13, 0xe7, 0x0d, 0x7e, 0x26, 0xe5, 0x61, 0xfb, 0x16, 0xc8, 0x05, 0xdf, 0x0b, 0x40, 0x74,
-	0x13, 0x1d, 0x14, 0xc7, 0x57, 0x80, 0x23, 0xf9, 0x00, 0x02, 0x8c, 0xc1, 0x44, 0x3d, 0x03, 0xaa,
-	0xbe, 0xd4, 0xa4, 0x1f, 0x78, 0x93, 0xd9, 0xaa, 0x6f, 0xc8, 0xb8, 0x31, 0xc9, 0xc4, 0xd5, 0x00,
-	0x00, 0xdf, 0x45, 0xc7, 0x4d, 0x9d, 0x39, 0x65, 0xaf, 0x8d, 0xb2, 0x7f, 0x1c, 0x67, 0x13, 0xd1,
-	0xbc, 0xa9, 0x33, 0x27, 0xe8, 0x74, 0xc2, 0x0c, 0x17, 0xa9, 0xf7, 0x01, 0x63, 0xc1, 0xd4, 0x37,
-	0x49, 0xd4, 0xf2, 0x7b, 0x11, 0xa5, 0xf8, 0x69, 0x62, 0xef, 0xb2, 0x75, 0x8c, 0x97, 0xfb, 0x16,
-	0xdf, 0xaa, 0xb7, 0x96, 0xf7, 0xfa, 0xea, 0x28, 0x23, 0x04, 0xce, 0xac, 0x3a, 0x05, 0x12, 0x6a,
-	0xf2, 0xda, 0xe1, 0x56, 0x2f, 0x8e, 0x89, 0xa6, 0xac, 0x3a, 0x55, 0x49, 0x37, 0x3b, 0xc4, 0x3b,
-	0x52, 0xa5, 0x76, 0x6d, 0xe8, 0x5b, 0xb2, 0x4f, 0x95, 0xee, 0xde, 0x2f, 0xd8, 0x0e, 0x50, 0x59,
-	0x0f, 0x51, 0x19, 0x91, 0xa3, 0x02, 0x63, 0xb3, 0x4b, 0x68, 0x78, 0x39, 0x58, 0x82, 0x1d, 0x18,
-	0x84, 0x9f, 0x4f, 0xb5, 0x79, 0xab, 0xc6, 0xb7, 0x38, 0xfd, 0xd7, 0x1f, 0x77, 0x7e, 0xe5, 0x9b,
-	0x2a, 0x50, 0xe9, 0xe2, 0x41, 0xad, 0xc3, 0xbe, 0x2c, 0xda, 0x69, 0x4c, 0xb7, 0x8e, 0x0c, 0xdc,
-	0xad, 0xb9, 0xff, 0x9c, 0x47, 0x07, 0x78, 0x43, 0xf8, 0x0f, 0x0a, 0x1a, 0xf5, 0x34, 0x24, 0x5e,
-	0x49, 0xf4, 0x12, 0xa5, 0x6c, 0xd3, 0xb9, 0x41, 0x4c, 0x04, 0x01, 0xf5, 0xfe, 0x0f, 0xff, 0xf2,
-	0xaf, 0x9f, 0xee, 0xff, 0x7f, 0x5c, 0xe0, 0xc7, 0xa5, 0x4b, 0xe2, 0xe4, 0xb4, 0x73, 0x60, 0xda,
-	0x51, 0xaf, 0xda, 0x4e, 0x8f, 0x84, 0xdb, 0xd5, 0x76, 0x02, 0x1a, 0x73, 0x17, 0xff, 0x4d, 0x41,
-	0xb8, 0x57, 0x07, 0xe2, 0x9b, 0xfd, 0x61, 0xc5, 0x6a, 0xe0, 0xf4, 0x6b, 0x7b, 0x33, 0x06, 0x76,
-	0x77, 0x39, 0xbb, 0xdb, 0xf8, 0x56, 0x24, 0x3b, 0xa0, 0x54, 0x69, 0xfb, 0x58, 0x45, 0x11, 0xc5,
-	0xbf, 0x54, 0xd0, 0xb8, 0x4f, 0x93, 0xe1, 0xa5, 0xfe, 0xa0, 0x7c, 0xd5, 0xd3, 0xaf, 0x0e, 0x54,
-	0xbd, 0x03, 0xfe, 0x22, 0x07, 0x3f, 0x8b, 0xcf, 0x46, 0x82, 0xef, 0x4c, 0x8b, 0x8c, 0x38, 0xf8,
-	0xb7, 0x0a, 0x3a, 0x16, 0x92, 0x78, 0x32, 0x03, 0x28, 0x64, 0x92, 0xbe, 0x3e, 0xb0, 0x49, 0x07,
-	0xec, 0x22, 0x07, 0x7b, 0x1e, 0xcf, 0x45, 0x82, 0x65, 0x21, 0x6c, 0xff, 0x54, 0xd0, 0xc9, 0x68,
-	0xb5, 0x87, 0x6f, 0xf7, 0xc7, 0x90, 0x28, 0x34, 0xd3, 0x77, 0xf6, 0xee, 0x00, 0xb8, 0x14, 0x38,
-	0x97, 0xd7, 0xf0, 0x8d, 0x48, 0x2e, 0x0d, 0xe2, 0x94, 0xfd, 0xea, 0xaf, 0x5c, 0xa7, 0xb6, 0x28,
-	0xd0, 0x76, 0xbc, 0x19, 0x66, 0x17, 0x7f, 0xa6, 0xa0, 0xa3, 0xc1, 0x66, 0xf0, 0xd5, 0x41, 0x81,
-	0x79, 0x8c, 0xae, 0x0d, 0x6e, 0x08, 0x4c, 0x96, 0x38, 0x93, 0x0b, 0xf8, 0x9c, 0x14, 0x13, 0x17,
-	0x74, 0x40, 0x24, 0xc9, 0x21, 0xee, 0x55, 0x84, 0x92, 0x88, 0x23, 0x34, 0x9e, 0xba, 0xcc, 0x11,
-	0x2f, 0xe0, 0xf9, 0x48, 0xc4, 0x3e, 0x4d, 0xaa, 0xed, 0x70, 0x19, 0xbc, 0xeb, 0x8e, 0xfd, 0xa3,
-	0x3e, 0x4f, 0x79, 0xd3, 0x94, 0xc1, 0x1d, 0xa9, 0x64, 0x65, 0x70, 0x47, 0x6b, 0x53, 0x75, 0x9e,
-	0xe3, 0x56, 0xf1, 0x4c, 0x3f, 0xdc, 0xf8, 0xf7, 0x0a, 0x3a, 0x16, 0x92, 0x6d, 0x32, 0x53, 0x64,
-	0xac, 0xbe, 0x94, 0x99, 0x22, 0xe3, 0x95, 0x67, 0x9f, 0x21, 0x12, 0x16, 0xa5, 0xf8, 0xe7, 0x0a,
-	0x3a, 0x28, 0xc4, 0x1e, 0xce, 0x49, 0xb5, 0x1b, 0xd0, 0x9b, 0xe9, 0xcb, 0x03, 0xd9, 0x00, 0xc4,
-	0x59, 0x0e, 0xf1, 0x15, 0x7c, 0x3a, 0x12, 0xa2, 0x90, 0x9c, 0xf8, 0x8f, 0x0a, 0x9a, 0xe8, 0x11,
-	0x93, 0xf8, 0x86, 0xc4, 0x8c, 0x16, 0xa3, 0x51, 0xd3, 0x37, 0xf7, 0x64, 0x0b, 0x98, 0xaf, 0x73,
-	0xcc, 0x97, 0xf1, 0x8a, 0x1f, 0x73, 0xef, 0x8d, 0x24, 0x6b, 0xd2, 0x0f, 0x42, 0x0a, 0x17, 0xff,
-	0x59, 0x41, 0x13, 0x3d, 0x42, 0x52, 0x86, 0x49, 0x9c, 0x92, 0x95, 0x61, 0x12, 0xab, 0x5c, 0xd5,
-	0x55, 0xce, 0xe4, 0x16, 0xbe, 0x19, 0xbd, 0x86, 0x72, 0xf5, 0x13, 0x5e, 0x42, 0x43, 0xb2, 0x79,
-	0xd7, 0x95, 0x36, 0x78, 0x9d, 0x38, 0x21, 0x49, 0x89, 0xe5, 0xf2, 0x2d, 0x42, 0xed, 0xca, 0x2c,
-	0x55, 0x31, 0xfa, 0x55, 0xcd, 0x71, 0x42, 0x8b, 0x78, 0x21, 0x76, 0x52, 0xd4, 0x4d, 0xb3, 0x2c,
-	0x38, 0xd8, 0x00, 0xf4, 0x1b, 0x05, 0x9d, 0xe0, 0xce, 0x58, 0x48, 0x09, 0xe2, 0x5b, 0xd2, 0xb1,
-	0x8d, 0x92, 0xa5, 0xe9, 0xd7, 0xf7, 0x6a, 0x0e, 0x64, 0x36, 0x38, 0x99, 0x02, 0xbe, 0x93, 0xdc,
-	0x3b, 0x22, 0x85, 0x75, 0xab, 0x26, 0xae, 0x0f, 0x7c, 0x2b, 0x95, 0xb6, 0xc3, 0x4b, 0x76, 0xf1,
-	0x97, 0x0a, 0x3a, 0x12, 0x38, 0x88, 0xc6, 0xff, 0x27, 0x95, 0xac, 0x3d, 0xe7, 0xf9, 0xe9, 0xab,
-	0x03, 0xdb, 0x01, 0x99, 0xdb, 0x9c, 0xcc, 0x75, 0x7c, 0x35, 0xb6, 0x67, 0x1c, 0xc6, 0x3c, 0xbd,
-	0xa9, 0xed, 0x84, 0x4f, 0xd9, 0x77, 0xf1, 0x2f, 0xf6, 0xa3, 0x4c, 0xf2, 0x61, 0x3a, 0x5e, 0x1f,
-	0x10, 0x5c, 0xdc, 0xd5, 0x40, 0x7a, 0xe3, 0xc5, 0x1d, 0x01, 0xed, 0x0a, 0xa7, 0xfd, 0x1d, 0xfc,
-	0x44, 0x86, 0x76, 0xb9, 0xc9, 0xcf, 0xdc, 0x8d, 0xaa, 0x6e, 0x6a, 0x3b, 0x91, 0x77, 0x13, 0xbb,
-	0x51, 0x91, 0xf9, 0x44, 0xe1, 0x77, 0x37, 0x58, 0x93, 0x43, 0xdd, 0xb9, 0x0a, 0x4a, 0x2f, 0xcb,
-	0x1b, 0x00, 0x9d, 0x19, 0x4e, 0x27, 0x8d, 0xa7, 0x22, 0xe9, 0xb8, 0x20, 0x7e, 0xa5, 0x20, 0xd4,
-	0xbd, 0x3d, 0xc0, 0x12, 0x8b, 0x42, 0xcf, 0x75, 0x46, 0xfa, 0xca, 0x60, 0x46, 0x80, 0xed, 0x02,
-	0xc7, 0x76, 0x16, 0x9f, 0x89, 0xc4, 0xe6, 0x74, 0x31, 0x7d, 0xae, 0xa0, 0x54, 0xe0, 0xfa, 0xcc,
-	0xd5, 0x15, 0x72, 0x93, 0x4e, 0xd4, 0x85, 0x69, 0xfa, 0xc6, 0x5e, 0x4c, 0x01, 0xf4, 0x02, 0x07,
-	0x3d, 0x87, 0xd5, 0x48, 0xd0, 0xc1, 0x5b, 0xcd, 0x3f, 0x29, 0x68, 0x32, 0xea, 0x26, 0x51, 0x66,
-	0x9e, 0x4a, 0xb8, 0xc0, 0x94, 0x99, 0xa7, 0x92, 0x2e, 0x30, 0xd5, 0x57, 0x39, 0x07, 0x0d, 0x2f,
-	0xf5, 0xe7, 0xe0, 0x97, 0xd1, 0x9f, 0x2b, 0x81, 0x3b, 0xf2, 0x41, 0x34, 0x74, 0x30, 0xfe, 0xd7,
-	0x06, 0x37, 0x04, 0xe4, 0x97, 0x39, 0xf2, 0x25, 0x7c, 0x29, 0x5a, 0x20, 0x75, 0x2d, 0xfc, 0xb8,
-	0x5d, 0x51, 0xea, 0x73, 0x26, 0x2f, 0x4a, 0xf7, 0x06, 0x3d, 0xfa, 0x03, 0x83, 0x3e, 0xa2, 0xd4,
-	0x07, 0xdd, 0x55, 0x4f, 0x93, 0x51, 0x5f, 0x90, 0xc8, 0x0c, 0x9b, 0x84, 0x2f, 0x57, 0x64, 0x86,
-	0x4d, 0xd2, 0x87, 0x2b, 0x7d, 0xb6, 0x03, 0x0d, 0x3e, 0xbf, 0x06, 0xac, 0xf1, 0x5f, 0x15, 0x74,
-	0x2a, 0xe6, 0xdb, 0x1e, 0x7c, 0x67, 0x6f, 0x68, 0xba, 0x9f, 0x0f, 0xa5, 0xf3, 0x2f, 0xe0, 0x01,
-	0x28, 0x5d, 0xe1, 0x94, 0xb2, 0x78, 0x31, 0x8e, 0x52, 0xde, 0x34, 0xc3, 0x3e, 0x18, 0xfe, 0x42,
-	0x41, 0xa9, 0xf0, 0xb7, 0x50, 0x32, 0xf3, 0x51, 0xcc, 0x27, 0x5b, 0x32, 0xf3, 0x51, 0xdc, 0xc7,
-	0x5c, 0x7d, 0xb6, 0x0c, 0xe1, 0x2f, 0xb3, 0xf0, 0xcf, 0x14, 0x74, 0x08, 0xbe, 0x6f, 0xc3, 0xcb,
-	0xb2, 0xc7, 0x39, 0x1d, 0xa0, 0x2b, 0x03, 0x58, 0x00, 0xbe, 0x39, 0x8e, 0x2f, 0x83, 0xa7, 0x13,
-	0x4e, 0x7d, 0x58, 0xfa, 0xc0, 0xc7, 0xff, 0xfe, 0x74, 0x41, 0x29, 0xdc, 0xfd, 0xea, 0x59, 0x46,
-	0xf9, 0xfa, 0x59, 0x46, 0xf9, 0xe6, 0x59, 0x46, 0xf9, 0xc9, 0xf3, 0xcc, 0xbe, 0xaf, 0x9f, 0x67,
-	0xf6, 0xfd, 0xfd, 0x79, 0x66, 0xdf, 0x93, 0x4b, 0x0d, 0xc3, 0x69, 0x6e, 0x55, 0xb2, 0x55, 0xba,
-	0xe9, 0x77, 0xe4, 0xee, 0xe5, 0xb4, 0x0f, 0x7d, 0x8b, 0x46, 0xbb, 0x45, 0x58, 0xe5, 0x20, 0xff,
-	0x5e, 0xf0, 0xf2, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x52, 0xab, 0x0d, 0xc9, 0x6d, 0x2b, 0x00,
-	0x00,
+	0x15, 0xf6, 0x5a, 0x89, 0x2c, 0x3d, 0xd9, 0x16, 0x35, 0x92, 0x7f, 0x64, 0xa5, 0x50, 0xf2, 0xca,
+	0x8e, 0x65, 0xd9, 0x22, 0x2d, 0xd9, 0xa9, 0x65, 0xcb, 0xbf, 0x48, 0xd7, 0x96, 0xed, 0x38, 0xb6,
+	0x43, 0xba, 0x0d, 0x60, 0x34, 0x65, 0x96, 0xe4, 0x90, 0xdc, 0x7a, 0xb5, 0xcb, 0x70, 0x56, 0x72,
+	0x14, 0x55, 0x40, 0x5a, 0xf4, 0xd2, 0xa0, 0x28, 0x0a, 0x34, 0x68, 0x6f, 0x45, 0x0f, 0xed, 0xb1,
+	0x40, 0x10, 0x20, 0x68, 0x80, 0xa2, 0x87, 0x9c, 0x9a, 0x43, 0x0b, 0xa4, 0x68, 0x51, 0xf4, 0xd4,
+	0x06, 0x76, 0x81, 0xfe, 0x1b, 0xc5, 0xce, 0xbc, 0x25, 0x77, 0x97, 0xcb, 0xe5, 0x90, 0x66, 0x4f,
+	0xe4, 0xce, 0xce, 0x7b, 0xf3, 0x7d, 0x6f, 0xe6, 0xcd, 0x7c, 0x3b, 0x33, 0x70, 0xf2, 0x03, 0xea,
+	0xe8, 0xa5, 0x9a, 0x6e, 0x58, 0x69, 0xfe, 0xcf, 0x6e, 0xd0, 0xb4, 0x5d, 0x64, 0xb4, 0xb1, 0x45,
+	0x1b, 0xe9, 0xf7, 0x36, 0x69, 0x63, 0x3b, 0x55, 0x6f, 0xd8, 0x8e, 0x4d, 0xa6, 0x9b, 0x15, 0x53,
+	0x5e, 0xc5, 0x94, 0x57, 0x51, 0x5d, 0x2c, 0xd9, 0x6c, 0xc3, 0x66, 0xe9, 0xa2, 0xce, 0xa8, 0xb0,
+	0x4a, 0x6f, 0x2d, 0x17, 0xa9, 0xa3, 0x2f, 0xa7, 0xeb, 0x7a, 0xd5, 0xb0, 0x74, 0xc7, 0xb0, 0x2d,
+	0xe1, 0x48, 0x9d, 0xaa, 0xda, 0x55, 0x9b, 0xff, 0x4d, 0xbb, 0xff, 0xb0, 0x74, 0xa6, 0x6a, 0xdb,
+	0x55, 0x93, 0xa6, 0xf5, 0xba, 0x91, 0xd6, 0x2d, 0xcb, 0x76, 0xb8, 0x09, 0xc3, 0xb7, 0x0b, 0x71,
+	0x28, 0x8b, 0xba, 0x69, 0xda, 0x0e, 0xd6, 0x8c, 0xe5, 0x53, 0x34, 0xf5, 0x0d, 0x8a, 0x15, 0x53,
+	0x71, 0x15, 0x79, 0x79, 0xc1, 0xb2, 0xad, 0x12, 0xf5, 0x20, 0xac, 0xc4, 0xd6, 0x6f, 0xd8, 0x8c,
+	0x09, 0xa3, 0x8a, 0xa9, 0x57, 0xa5, 0x60, 0x3f, 0xa1, 0xdb, 0x55, 0xea, 0x05, 0x65, 0x31, 0xae,
+	0xa6, 0x69, 0x6c, 0x51, 0x8b, 0x32, 0x26, 0x83, 0xdc, 0xb2, 0xcb, 0xb4, 0xa0, 0x97, 0x4a, 0xf6,
+	0xa6, 0xe5, 0xc8, 0xf8, 0xf6, 0xfe, 0xc8, 0x20, 0xae, 0xeb, 0x0d, 0x7d, 0xc3, 0x43, 0x71, 0x36,
+	0xb6, 0x26, 0xb5, 0xca, 0x86, 0x55, 0x0d, 0x46, 0xf0, 0x44, 0x9c, 0x85, 0xd3, 0xa4, 0xb7, 0x14,
+	0x0b, 0xb7, 0x4e, 0x1b, 0x7c, 0x64, 0xe8, 0x66, 0x0c, 0xbb, 0xfa, 0x93, 0xaa, 0xe8, 0x42, 0x86,
+	0x3f, 0x5d, 0xea, 0xd6, 0x1b, 0xb6, 0x5d, 0x61, 0xf8, 0x83, 0x75, 0xcf, 0x77, 0x41, 0x5b, 0xa8,
+	0x6c, 0x5a, 0x65, 0x56, 0xd8, 0x30, 0xaa, 0x0d, 0xdd, 0xb1, 0xbd, 0xf8, 0x1d, 0xc1, 0x44, 0xd8,
+	0x60, 0xd5, 0xf4, 0xd6, 0xb2, 0xfb, 0x23, 0x5e, 0x68, 0xf7, 0x60, 0xf6, 0x2d, 0x37, 0x2f, 0xd6,
+	0xa9, 0xf3, 0x00, 0xbd, 0xdc, 0xc3, 0x6e, 0xcd, 0xd1, 0xf7, 0x36, 0x29, 0x73, 0xc8, 0x29, 0x48,
+	0x78, 0x0d, 0x14, 0xf4, 0x72, 0xb9, 0x41, 0x19, 0x3b, 0xaa, 0xcc, 0x29, 0x0b, 0xa3, 0xb9, 0x71,
+	0xaf, 0x3c, 0x23, 0x8a, 0xb5, 0x1f, 0x29, 0x30, 0xd7, 0xd9, 0x1d, 0xab, 0xdb, 0x16, 0xa3, 0xe4,
+	0x5d, 0x98, 0x68, 0xfa, 0xf3, 0x86, 0x10, 0x77, 0x38, 0xb6, 0xb2, 0x94, 0x8a, 0xc9, 0xe6, 0x54,
+	0xd8, 0x63, 0xf6, 0xa5, 0x2f, 0xff, 0x35, 0xbb, 0x27, 0x97, 0xb0, 0x43, 0xe5, 0x9a, 0x81, 0xa4,
+	0x32, 0xa6, 0xd9, 0x89, 0xd4, 0x2d, 0x80, 0xd6, 0x0c, 0x80, 0xad, 0xbf, 0x96, 0x12, 0x51, 0x4a,
+	0xb9, 0xd3, 0x45, 0x4a, 0x4c, 0x32, 0x38, 0x5d, 0xa4, 0x1e, 0xea, 0x55, 0x8a, 0xb6, 0x39, 0x9f,
+	0xa5, 0xf6, 0x17, 0x8f, 0x71, 0x64, 0x5b, 0xf1, 0x8c, 0x87, 0x06, 0xc6, 0x98, 0xac, 0x07, 0xe8,
+	0xec, 0xe5, 0x74, 0x4e, 0x76, 0xa5, 0x23, 0xe0, 0x05, 0xf8, 0xbc, 0x03, 0x93, 0x9c, 0x4e, 0x96,
+	0x4f, 0x5e, 0x03, 0x0f, 0xd7, 0x6f, 0x14, 0x98, 0x0a, 0xfa, 0xc7, 0x10, 0xdd, 0x80, 0x7d, 0x62,
+	0xbe, 0xf4, 0x02, 0x33, 0x1f, 0x1b, 0x18, 0x61, 0x8e, 0xe1, 0xf0, 0x2c, 0x07, 0x17, 0x85, 0x24,
+	0xcc, 0x70, 0x94, 0x0f, 0x5a, 0x69, 0x7d, 0xcb, 0x9d, 0x3f, 0x91, 0x92, 0xf6, 0x03, 0x05, 0x5e,
+	0xed, 0x50, 0xc1, 0xd7, 0xe5, 0xad, 0x77, 0x62, 0xf6, 0x95, 0x1b, 0xe4, 0x21, 0x8f, 0xcd, 0x2e,
+	0x0f, 0x95, 0x6b, 0x27, 0x60, 0x9e, 0x43, 0x78, 0xc4, 0xd8, 0x2d, 0x37, 0xe5, 0xdf, 0xc4, 0x8c,
+	0xbf, 0x63, 0x55, 0xec, 0x8c, 0x69, 0x7a, 0x50, 0x7f, 0xaa, 0xc0, 0xf1, 0xf8, 0x7a, 0x88, 0xb8,
+	0x02, 0x93, 0xed, 0xd3, 0x87, 0xd7, 0x1b, 0x67, 0x63, 0x31, 0xa3, 0x6b, 0xbf, 0x67, 0x84, 0x3d,
+	0xe1, 0x84, 0x5a, 0x65, 0xda, 0x15, 0x4c, 0x98, 0x28, 0x3c, 0xde, 0x70, 0x7b, 0x05, 0x46, 0xc4,
+	0xaa, 0x65, 0x94, 0x79, 0xd0, 0x86, 0x72, 0xfb, 0xf8, 0xf3, 0x9d, 0xb2, 0xf6, 0x63, 0x05, 0x8e,
+	0xc5, 0xd8, 0x23, 0x99, 0x32, 0x90, 0x76, 0x32, 0x18, 0xff, 0x7e, 0xb9, 0x24, 0xc2, 0x5c, 0xb4,
+	0x0b, 0xa0, 0x7a, 0xb3, 0xdd, 0x0d, 0xd7, 0xdd, 0x7d, 0xbe, 0xac, 0x48, 0x90, 0xb0, 0x61, 0x3a,
+	0xd2, 0x10, 0xd1, 0x3f, 0x84, 0x31, 0x5f, 0x31, 0xc2, 0x5e, 0x88, 0x85, 0xed, 0xab, 0x8f, 0x70,
+	0xfd, 0x2e, 0xb4, 0x32, 0x22, 0xcd, 0x98, 0x66, 0x04, 0xd2, 0x41, 0x65, 0xf7, 0xe7, 0x0a, 0x4c,
+	0x47, 0x36, 0xd3, 0x89, 0xd7, 0xd0, 0x0b, 0xf2, 0x1a, 0x5c, 0xc6, 0x57, 0x30, 0xe3, 0x33, 0xa6,
+	0xf9, 0x50, 0x88, 0x84, 0xff, 0x4f, 0x88, 0xbe, 0xf0, 0x66, 0x8e, 0xf6, 0x86, 0x30, 0x48, 0x6f,
+	0xc3, 0xc1, 0xa0, 0x4c, 0xc1, 0x38, 0x2d, 0xc6, 0xc6, 0x29, 0xe0, 0x0b, 0x23, 0x75, 0xa0, 0xee,
+	0x2f, 0x1c, 0x5c, 0xac, 0xbc, 0x0c, 0x0e, 0xb6, 0xb9, 0xcd, 0xfb, 0x45, 0x62, 0xf0, 0x7f, 0x1f,
+	0x8e, 0xc5, 0x98, 0xc7, 0x44, 0x41, 0x19, 0x40, 0x14, 0xb4, 0x29, 0x20, 0x5e, 0xea, 0x3d, 0xca,
+	0xe7, 0xbd, 0x59, 0xf2, 0x01, 0x4c, 0x06, 0x4a, 0x11, 0xc5, 0x2a, 0x0c, 0x3d, 0xca, 0xe7, 0xb1,
+	0xe9, 0xb9, 0xf8, 0x79, 0x23, 0x9f, 0xc7, 0x06, 0x5d, 0x13, 0xed, 0x26, 0xbc, 0xd2, 0x74, 0xc8,
+	0x18, 0xea, 0x23, 0x2f, 0x38, 0x0b, 0x90, 0x28, 0x1a, 0x4e, 0xc9, 0x36, 0xac, 0x42, 0x33, 0x48,
+	0x7b, 0x79, 0x90, 0x0e, 0x62, 0xf9, 0x0d, 0x8c, 0xd5, 0x75, 0x50, 0xa3, 0xdc, 0x20, 0xbc, 0x04,
+	0x0c, 0x51, 0xa7, 0x86, 0x62, 0xcc, 0xfd, 0xeb, 0x96, 0x14, 0x9d, 0x12, 0x77, 0x36, 0x9a, 0x73,
+	0xff, 0x6a, 0x1f, 0x29, 0xb0, 0xd8, 0xee, 0x22, 0xbb, 0x7d, 0xcb, 0xb0, 0x74, 0xd3, 0xf8, 0x80,
+	0x96, 0x6f, 0x53, 0xa3, 0x5a, 0x73, 0x3c, 0x68, 0x2b, 0x70, 0xa8, 0xe2, 0xbd, 0x29, 0xb8, 0x2c,
+	0x0b, 0x35, 0xfe, 0x1e, 0x3b, 0x71, 0xb2, 0xf9, 0xf2, 0x31, 0x75, 0x74, 0x61, 0xda, 0x03, 0x9d,
+	0xb7, 0xe0, 0xb4, 0x14, 0x96, 0x1e, 0xf8, 0xbd, 0x0b, 0x87, 0xbd, 0xe5, 0xe0, 0xb6, 0xc1, 0x1c,
+	0xbb, 0xb1, 0x3d, 0xe8, 0x94, 0xfd, 0xad, 0x02, 0x47, 0xda, 0x9a, 0x40, 0x84, 0x19, 0x18, 0x71,
+	0xd7, 0x19, 0xd3, 0x60, 0x0e, 0xa6, 0xa9, 0xec, 0x28, 0xd9, 0xe7, 0x30, 0x76, 0xcf, 0x60, 0xce,
+	0xe0, 0xd2, 0xb2, 0x86, 0xd2, 0xea, 0xb6, 0xce, 0xbe, 0x6d, 0x3b, 0xb4, 0xec, 0xc5, 0xe1, 0x34,
+	0x4c, 0x08, 0x81, 0x54, 0x30, 0xca, 0xd4, 0x72, 0x8c, 0x8a, 0x41, 0x1b, 0x18, 0xd3, 0x84, 0x78,
+	0x71, 0xa7, 0x59, 0x4e, 0xe6, 0xe1, 0xc0, 0x96, 0xed, 0xf8, 0x94, 0xbe, 0x08, 0xf5, 0x7e, 0x5e,
+	0xe8, 0xc9, 0xfc, 0xf3, 0x70, 0x28, 0xd4, 0x12, 0x86, 0x63, 0x1a, 0x46, 0x6b, 0x3a, 0x2b, 0xb8,
+	0x95, 0x45, 0xda, 0x8f, 0xe4, 0x46, 0x6a, 0x58, 0x49, 0x7b, 0x13, 0x92, 0x3e, 0xe9, 0x97, 0xdd,
+	0x6e, 0xb5, 0xda, 0x0f, 0x52, 0xed, 0x27, 0x0a, 0x8c, 0xba, 0x8e, 0x1b, 0x3c, 0x8a, 0x6d, 0xb8,
+	0x95, 0x76, 0xdc, 0x24, 0x0b, 0xa3, 0xee, 0x73, 0xc1, 0xd9, 0xae, 0x53, 0x4e, 0xec, 0xe0, 0xca,
+	0x89, 0xd8, 0xee, 0x72, 0xfd, 0x3f, 0xda, 0xae, 0xd3, 0xdc, 0xc8, 0x16, 0xfe, 0x23, 0x87, 0x61,
+	0xf8, 0xa9, 0xc8, 0x88, 0x21, 0x3e, 0xc4, 0xf1, 0x49, 0xfb, 0xfd, 0x5e, 0x98, 0xed, 0x48, 0x0f,
+	0xc3, 0xd3, 0x53, 0x4f, 0x5c, 0x85, 0x61, 0x0e, 0xde, 0xed, 0x82, 0x21, 0x3e, 0x74, 0xbb, 0x21,
+	0xe5, 0x91, 0xc8, 0xa1, 0x15, 0x79, 0xdb, 0xfb, 0x6c, 0xe3, 0xa3, 0x43, 0x70, 0x1e, 0xe2, 0x9c,
+	0xcf, 0x48, 0x7c, 0x73, 0x70, 0x23, 0x4e, 0x7d, 0xdc, 0x0e, 0x16, 0x90, 0xfb, 0x70, 0x00, 0x59,
+	0x30, 0x47, 0x77, 0x36, 0xd9, 0xd1, 0x97, 0xb8, 0xd7, 0x53, 0x12, 0x82, 0x3d, 0xcf, 0x0d, 0x72,
+	0xfb, 0x8b, 0xbe, 0x27, 0x8d, 0x40, 0x42, 0x68, 0x69, 0xac, 0x9b, 0xa7, 0x8e, 0xb6, 0x0a, 0x47,
+	0xc3, 0x65, 0xcd, 0x28, 0xce, 0xc0, 0xa8, 0xe7, 0x56, 0xac, 0x8d, 0xa3, 0xb9, 0x56, 0x81, 0x76,
+	0x18, 0xb3, 0x20, 0xbf, 0x59, 0xaf, 0xdb, 0x0d, 0x87, 0x96, 0xf9, 0xdc, 0xc3, 0xb4, 0x22, 0xcc,
+	0x44, 0x95, 0x37, 0xbd, 0x66, 0x61, 0x98, 0x63, 0xf7, 0x96, 0xdb, 0xe3, 0x51, 0x74, 0xea, 0x4f,
+	0xaa, 0x29, 0x51, 0x4b, 0x08, 0x13, 0xcc, 0x65, 0xb4, 0xd4, 0xae, 0x81, 0x16, 0x90, 0x75, 0x0f,
+	0xf9, 0xc6, 0xc4, 0x2d, 0xbb, 0x21, 0xbb, 0x34, 0x36, 0x60, 0x3e, 0xd6, 0x01, 0x62, 0x7d, 0x03,
+	0xf6, 0x0b, 0x0f, 0x62, 0xe7, 0x43, 0x5e, 0x20, 0x0a, 0x7f, 0xb9, 0xb1, 0x52, 0xeb, 0x41, 0x9b,
+	0x09, 0x89, 0x58, 0xac, 0x83, 0x0b, 0xa3, 0x05, 0xd3, 0x91, 0x6f, 0x11, 0xc9, 0x83, 0x48, 0x24,
+	0x67, 0x64, 0x91, 0xf0, 0x01, 0x1b, 0x40, 0xb3, 0xd2, 0x42, 0x73, 0xdf, 0x2e, 0xd3, 0x8c, 0xd8,
+	0x31, 0xf2, 0x42, 0x37, 0x05, 0x2f, 0x1b, 0x56, 0x99, 0xbe, 0x8f, 0x49, 0x23, 0x1e, 0xb4, 0xef,
+	0xc1, 0x74, 0xa4, 0x4d, 0x2b, 0x5a, 0xfe, 0xdd, 0x27, 0xa9, 0x68, 0xf9, 0xfd, 0x8c, 0x59, 0xad,
+	0x07, 0xbf, 0x90, 0x8e, 0xc0, 0x37, 0xa8, 0x25, 0xe7, 0x53, 0x9f, 0x90, 0x8e, 0xa2, 0x74, 0x17,
+	0xc6, 0x7c, 0xc5, 0x52, 0x42, 0x3a, 0xc0, 0xc8, 0xf7, 0x30, 0xb8, 0xf5, 0x67, 0x0e, 0x92, 0xcd,
+	0xa1, 0xd2, 0xdc, 0x77, 0x0c, 0x7c, 0x36, 0x7f, 0xa8, 0xc0, 0x6c, 0xc7, 0x2a, 0x48, 0xed, 0x1d,
+	0x48, 0x84, 0x77, 0x2d, 0xe5, 0x46, 0x55, 0xd0, 0x1f, 0x66, 0xe6, 0x78, 0x29, 0x58, 0xac, 0x1d,
+	0xc1, 0xa5, 0x6b, 0x9d, 0x3a, 0x6f, 0xf0, 0x8d, 0x4e, 0x0f, 0xdb, 0xb7, 0xe0, 0x70, 0xf8, 0x05,
+	0x22, 0x5a, 0x83, 0x61, 0xb1, 0x27, 0x8a, 0x38, 0xe2, 0x77, 0x26, 0xd0, 0x18, 0x4d, 0xb4, 0x59,
+	0x94, 0xfb, 0xf9, 0x9a, 0xfd, 0xd4, 0x9b, 0xcc, 0x6e, 0xf8, 0x86, 0x8c, 0x1b, 0x93, 0x64, 0xa7,
+	0x1a, 0x08, 0xe0, 0xbb, 0x30, 0x69, 0xea, 0xcc, 0x29, 0x34, 0xf7, 0x90, 0xfc, 0xe3, 0x38, 0x15,
+	0x8b, 0xe6, 0x9e, 0xce, 0x9c, 0xa0, 0xd3, 0x09, 0x33, 0x5c, 0xa4, 0xdd, 0x45, 0x8c, 0x59, 0x77,
+	0x1b, 0x3a, 0x6a, 0x5d, 0x3e, 0x05, 0x09, 0xbe, 0x45, 0xdd, 0xbe, 0x6c, 0x8d, 0xf3, 0x72, 0xdf,
+	0xaa, 0x5c, 0xf2, 0x16, 0xf9, 0x76, 0x5f, 0x4d, 0xc9, 0x04, 0xe8, 0xcc, 0xaa, 0xd8, 0x48, 0x42,
+	0x8b, 0x5f, 0x3b, 0xdc, 0xea, 0xb9, 0x51, 0xd1, 0x94, 0x55, 0xb1, 0x35, 0xda, 0xca, 0x0e, 0xf1,
+	0x8e, 0x96, 0xec, 0x46, 0x79, 0xe0, 0xdf, 0x6a, 0x9f, 0x28, 0x30, 0x13, 0xdd, 0x0e, 0x52, 0x59,
+	0x0f, 0x51, 0x19, 0x92, 0xa3, 0x82, 0x63, 0xb3, 0x45, 0x68, 0x70, 0x39, 0x98, 0xc7, 0x4f, 0x33,
+	0x0c, 0x3f, 0x9f, 0x6a, 0x33, 0x56, 0x99, 0x7f, 0xfb, 0x74, 0x5f, 0x7f, 0xdc, 0xf9, 0x95, 0x7f,
+	0x6d, 0xa1, 0x7c, 0x17, 0x0f, 0x5a, 0x05, 0x8e, 0xc5, 0x38, 0xed, 0xd0, 0xad, 0x43, 0x3d, 0x77,
+	0xeb, 0xca, 0xc7, 0x8b, 0xf0, 0x32, 0x6f, 0x88, 0xfc, 0x51, 0x81, 0x11, 0x4f, 0x5c, 0x92, 0xe5,
+	0x58, 0x2f, 0x51, 0x92, 0x57, 0x5d, 0xe9, 0xc5, 0x44, 0x10, 0xd0, 0xee, 0xfe, 0xf0, 0x6f, 0xff,
+	0xf9, 0xf9, 0xde, 0x6f, 0x92, 0x2c, 0xdf, 0x57, 0x5f, 0xe2, 0xc6, 0xad, 0x9d, 0xf5, 0xa6, 0xac,
+	0x4d, 0xef, 0xb4, 0x49, 0xb8, 0xdd, 0xf4, 0x4e, 0x40, 0x7b, 0xee, 0x92, 0x7f, 0x28, 0x40, 0xda,
+	0x75, 0x20, 0x59, 0xeb, 0x0e, 0xab, 0xa3, 0x38, 0x56, 0x2f, 0xf7, 0x67, 0x8c, 0xec, 0x6e, 0x72,
+	0x76, 0xd7, 0xc8, 0x95, 0x48, 0x76, 0x48, 0xa9, 0xb8, 0xed, 0x63, 0x15, 0x45, 0x94, 0xfc, 0x4a,
+	0x81, 0x31, 0x9f, 0x26, 0x23, 0x4b, 0xdd, 0x41, 0xf9, 0xaa, 0xab, 0xaf, 0xf7, 0x54, 0xbd, 0x09,
+	0xfe, 0x14, 0x07, 0x3f, 0x4f, 0x8e, 0x45, 0x82, 0xf7, 0xfe, 0x14, 0x18, 0x75, 0xc8, 0xef, 0x14,
+	0x18, 0x0f, 0x49, 0x3c, 0x99, 0x01, 0x14, 0x32, 0x51, 0x2f, 0xf6, 0x6c, 0xd2, 0x04, 0x7b, 0x86,
+	0x83, 0x7d, 0x8d, 0x1c, 0x8f, 0x04, 0xcb, 0x42, 0xd8, 0xfe, 0xad, 0xc0, 0xe1, 0x68, 0xb5, 0x47,
+	0xae, 0x75, 0xc7, 0x10, 0x2b, 0x34, 0xd5, 0xeb, 0xfd, 0x3b, 0x40, 0x2e, 0x59, 0xce, 0xe5, 0x32,
+	0xb9, 0x14, 0xc9, 0xa5, 0x4a, 0x9d, 0x82, 0x5f, 0xfd, 0x15, 0x2a, 0x76, 0x43, 0x14, 0xa4, 0x77,
+	0xbc, 0x19, 0x66, 0x97, 0x7c, 0xaa, 0xc0, 0xc1, 0x60, 0x33, 0xe4, 0x42, 0xaf, 0xc0, 0x3c, 0x46,
+	0xab, 0xbd, 0x1b, 0x22, 0x93, 0x25, 0xce, 0xe4, 0x24, 0x39, 0x21, 0xc5, 0xc4, 0x05, 0x1d, 0x10,
+	0x49, 0x72, 0x88, 0xdb, 0x15, 0xa1, 0xba, 0xda, 0xbb, 0x21, 0x22, 0x3e, 0xcb, 0x11, 0x2f, 0x92,
+	0x85, 0x48, 0xc4, 0x3e, 0x4d, 0x9a, 0xde, 0xe1, 0x32, 0x78, 0xd7, 0x1d, 0xfb, 0x07, 0x7d, 0x9e,
+	0x32, 0xa6, 0x29, 0x83, 0x3b, 0x52, 0xc9, 0xaa, 0xab, 0xbd, 0x1b, 0x22, 0xee, 0x05, 0x8e, 0x5b,
+	0x23, 0x73, 0xdd, 0x70, 0x93, 0x3f, 0x28, 0x30, 0x1e, 0x92, 0x6d, 0x64, 0x4d, 0xae, 0x87, 0x23,
+	0xf5, 0xa5, 0x7a, 0xb9, 0x3f, 0x63, 0xa9, 0x21, 0x12, 0x16, 0xa5, 0xe4, 0x17, 0x0a, 0x0c, 0x0b,
+	0xb1, 0x47, 0x56, 0xa4, 0xda, 0x0d, 0xe8, 0x4d, 0xf5, 0x5c, 0x4f, 0x36, 0x08, 0x71, 0x9e, 0x43,
+	0x7c, 0x95, 0x4c, 0x47, 0x42, 0x14, 0x92, 0x93, 0xfc, 0x49, 0x81, 0x89, 0x36, 0x31, 0x49, 0x2e,
+	0x49, 0xcc, 0x68, 0x1d, 0x34, 0xaa, 0xba, 0xd6, 0x97, 0x2d, 0x62, 0xbe, 0xc8, 0x31, 0x9f, 0x23,
+	0xcb, 0x7e, 0xcc, 0xed, 0x47, 0xd7, 0xac, 0x66, 0x3f, 0x0d, 0x29, 0x5c, 0xf2, 0x57, 0x05, 0x26,
+	0xda, 0x84, 0xa4, 0x0c, 0x93, 0x4e, 0x4a, 0x56, 0x5d, 0xeb, 0xcb, 0x16, 0x99, 0xdc, 0xe0, 0x4c,
+	0xae, 0x90, 0xb5, 0xe8, 0x35, 0x94, 0xab, 0x9f, 0xf0, 0x12, 0x1a, 0x92, 0xcd, 0xbb, 0xae, 0xb4,
+	0x21, 0xeb, 0xd4, 0x09, 0x49, 0x4a, 0x22, 0x97, 0x6f, 0x11, 0x6a, 0x57, 0xbd, 0xd8, 0x87, 0x25,
+	0x12, 0x5a, 0xe1, 0x84, 0xce, 0x90, 0xc5, 0x8e, 0x93, 0xa2, 0x6e, 0x9a, 0x05, 0xc1, 0xa1, 0x81,
+	0x40, 0xbf, 0x56, 0xe0, 0x10, 0x77, 0xc6, 0x42, 0x4a, 0x90, 0x5c, 0x91, 0x8e, 0x6d, 0x94, 0x2c,
+	0x55, 0xaf, 0xf6, 0x6b, 0x8e, 0x64, 0x6e, 0x73, 0x32, 0x59, 0x72, 0x3d, 0xbe, 0x77, 0x44, 0x0a,
+	0xeb, 0x56, 0x59, 0x9c, 0x2b, 0xf8, 0x56, 0xaa, 0xf4, 0x0e, 0x2f, 0xd9, 0x25, 0x5f, 0x28, 0x70,
+	0x20, 0xb0, 0x43, 0x4d, 0xbe, 0x21, 0x95, 0xac, 0x6d, 0x1b, 0xfd, 0xea, 0x85, 0x9e, 0xed, 0x90,
+	0xcc, 0x35, 0x4e, 0xe6, 0x22, 0xb9, 0xd0, 0xb1, 0x67, 0xdc, 0x6d, 0x67, 0xd4, 0x9b, 0xe9, 0x9d,
+	0xf0, 0xf6, 0xfb, 0x2e, 0xf9, 0xe5, 0x5e, 0x48, 0xc6, 0xef, 0xb2, 0x93, 0xf5, 0x1e, 0xc1, 0x75,
+	0x3a, 0x33, 0x50, 0x6f, 0xbf, 0xb8, 0x23, 0xa4, 0x5d, 0xe4, 0xb4, 0xbf, 0x43, 0x1e, 0xcb, 0xd0,
+	0x2e, 0xd4, 0xf8, 0x66, 0xbc, 0x51, 0xd2, 0xcd, 0xf4, 0x4e, 0xe4, 0xa1, 0xc5, 0x6e, 0x54, 0x64,
+	0x3e, 0x52, 0xf8, 0xa1, 0x0e, 0x49, 0xcb, 0xa1, 0x6e, 0x9e, 0x11, 0xa9, 0x67, 0xe5, 0x0d, 0x90,
+	0xce, 0x1c, 0xa7, 0xa3, 0x92, 0xa3, 0x91, 0x74, 0x5c, 0x10, 0xbf, 0x56, 0x00, 0x5a, 0xc7, 0x0a,
+	0x44, 0x62, 0x51, 0x68, 0x3b, 0xe7, 0x50, 0xcf, 0xf7, 0x66, 0x84, 0xd8, 0x4e, 0x72, 0x6c, 0xc7,
+	0xc8, 0x6c, 0x24, 0x36, 0xa7, 0x85, 0xe9, 0x33, 0x05, 0x12, 0x81, 0x73, 0x35, 0x57, 0x57, 0xc8,
+	0x4d, 0x3a, 0x51, 0x27, 0xa9, 0xea, 0xa5, 0x7e, 0x4c, 0x11, 0xf4, 0x22, 0x07, 0x7d, 0x9c, 0x68,
+	0x91, 0xa0, 0x83, 0xc7, 0x9d, 0x7f, 0x56, 0x60, 0x2a, 0xea, 0x88, 0x51, 0x66, 0x9e, 0x8a, 0x39,
+	0xd9, 0x54, 0xaf, 0xf6, 0x6b, 0x8e, 0x1c, 0x5e, 0xe7, 0x1c, 0xd2, 0x64, 0xa9, 0x3b, 0x07, 0xbf,
+	0x8c, 0xfe, 0x4c, 0x09, 0x1c, 0x9e, 0xf7, 0xa2, 0xa1, 0x83, 0xf1, 0x5f, 0xed, 0xdd, 0x10, 0x91,
+	0x9f, 0xe3, 0xc8, 0x97, 0xc8, 0xe9, 0x68, 0x81, 0xd4, 0xb2, 0xf0, 0xe3, 0x76, 0x45, 0xa9, 0xcf,
+	0x99, 0xbc, 0x28, 0xed, 0x0f, 0x7a, 0xf4, 0xcd, 0x83, 0x2e, 0xa2, 0xd4, 0x07, 0xdd, 0x55, 0x4f,
+	0x53, 0x51, 0x57, 0x4b, 0x64, 0x86, 0x4d, 0xcc, 0x95, 0x16, 0xf5, 0x6a, 0xbf, 0xe6, 0x52, 0x9f,
+	0x03, 0x55, 0xea, 0x84, 0xad, 0xc9, 0xdf, 0x15, 0x38, 0xd2, 0xe1, 0xd2, 0x0f, 0xb9, 0xde, 0x1f,
+	0x9a, 0xd6, 0xbd, 0x22, 0x35, 0xf3, 0x02, 0x1e, 0x90, 0xd2, 0x79, 0x4e, 0x29, 0x45, 0xce, 0x74,
+	0xa2, 0x94, 0x31, 0xcd, 0xb0, 0x0f, 0x46, 0x3e, 0x57, 0x20, 0x11, 0xbe, 0x24, 0x25, 0x33, 0x1f,
+	0x75, 0xb8, 0xcb, 0xa5, 0x5e, 0xea, 0xc7, 0x54, 0xea, 0x93, 0x21, 0x7c, 0x65, 0x8b, 0x7c, 0xac,
+	0xc0, 0x3e, 0xbc, 0xf8, 0x46, 0xce, 0xca, 0x6e, 0xe7, 0x34, 0x81, 0x2e, 0xf7, 0x60, 0x81, 0xf8,
+	0x8e, 0x73, 0x7c, 0x49, 0x32, 0x13, 0xb3, 0xeb, 0xc3, 0x5c, 0x99, 0x9d, 0x08, 0xdf, 0x34, 0x24,
+	0x72, 0xdf, 0x52, 0x1d, 0xae, 0x57, 0xaa, 0x57, 0xfa, 0xb4, 0x46, 0xdc, 0xd7, 0x39, 0xee, 0x4b,
+	0x64, 0x35, 0x76, 0xc3, 0xc7, 0x33, 0x4b, 0xef, 0x84, 0xef, 0xa7, 0x72, 0x0d, 0x37, 0x19, 0x76,
+	0xef, 0x0e, 0xfc, 0xcb, 0x52, 0x53, 0xc8, 0x0b, 0xd0, 0x8a, 0xb9, 0x07, 0xda, 0x6d, 0xb8, 0x84,
+	0xcc, 0xd4, 0x97, 0x3f, 0xfc, 0xef, 0x27, 0x8b, 0x4a, 0xf6, 0xe6, 0x97, 0xcf, 0x92, 0xca, 0x57,
+	0xcf, 0x92, 0xca, 0xd7, 0xcf, 0x92, 0xca, 0xcf, 0x9e, 0x27, 0xf7, 0x7c, 0xf5, 0x3c, 0xb9, 0xe7,
+	0x9f, 0xcf, 0x93, 0x7b, 0x1e, 0x9f, 0xae, 0x1a, 0x4e, 0x6d, 0xb3, 0x98, 0x2a, 0xd9, 0x1b, 0x7e,
+	0x8f, 0x96, 0x5d, 0xa6, 0xe9, 0xf7, 0x5b, 0x8e, 0xdd, 0xa3, 0x5f, 0x56, 0x1c, 0xe6, 0x17, 0x7e,
+	0xcf, 0xfd, 0x6f, 0x00, 0xae, 0xd2, 0x04, 0x74, 0x5a, 0x2f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2698,6 +2902,10 @@ type QueryClient interface {
 	OperationalFlags(ctx context.Context, in *QueryOperationalFlagsRequest, opts ...grpc.CallOption) (*QueryOperationalFlagsResponse, error)
 	// Query all ballots
 	Ballots(ctx context.Context, in *QueryBallotsRequest, opts ...grpc.CallOption) (*QueryBallotsResponse, error)
+	// Queries the missed votes of an observer
+	ObserverLiveness(ctx context.Context, in *QueryGetObserverLivenessRequest, opts ...grpc.CallOption) (*QueryGetObserverLivenessResponse, error)
+	// Queries the missed votes of all observers
+	ObserverLivenessAll(ctx context.Context, in *QueryAllObserverLivenessRequest, opts ...grpc.CallOption) (*QueryAllObserverLivenessResponse, error)
 }
 
 type queryClient struct {
@@ -2942,6 +3150,24 @@ func (c *queryClient) Ballots(ctx context.Context, in *QueryBallotsRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ObserverLiveness(ctx context.Context, in *QueryGetObserverLivenessRequest, opts ...grpc.CallOption) (*QueryGetObserverLivenessResponse, error) {
+	out := new(QueryGetObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ObserverLivenessAll(ctx context.Context, in *QueryAllObserverLivenessRequest, opts ...grpc.CallOption) (*QueryAllObserverLivenessResponse, error) {
+	out := new(QueryAllObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLivenessAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Query if a voter has voted for a ballot
@@ -2990,6 +3216,10 @@ type QueryServer interface {
 	OperationalFlags(context.Context, *QueryOperationalFlagsRequest) (*QueryOperationalFlagsResponse, error)
 	// Query all ballots
 	Ballots(context.Context, *QueryBallotsRequest) (*QueryBallotsResponse, error)
+	// Queries the missed votes of an observer
+	ObserverLiveness(context.Context, *QueryGetObserverLivenessRequest) (*QueryGetObserverLivenessResponse, error)
+	// Queries the missed votes of all observers
+	ObserverLivenessAll(context.Context, *QueryAllObserverLivenessRequest) (*QueryAllObserverLivenessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -3074,6 +3304,12 @@ func (*UnimplementedQueryServer) OperationalFlags(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) Ballots(ctx context.Context, req *QueryBallotsRequest) (*QueryBallotsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ballots not implemented")
 }
+func (*UnimplementedQueryServer) ObserverLiveness(ctx context.Context, req *QueryGetObserverLivenessRequest) (*QueryGetObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLiveness not implemented")
+}
+func (*UnimplementedQueryServer) ObserverLivenessAll(ctx context.Context, req *QueryAllObserverLivenessRequest) (*QueryAllObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLivenessAll not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3547,26 +3783,62 @@ func _Query_Ballots_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.observer.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HasVoted",
-			Handler:    _Query_HasVoted_Handler,
-		},
-		{
-			MethodName: "BallotByIdentifier",
-			Handler:    _Query_BallotByIdentifier_Handler,
-		},
-		{
-			MethodName: "ObserverSet",
-			Handler:    _Query_ObserverSet_Handler,
-		},
-		{
-			MethodName: "SupportedChains",
-			Handler:    _Query_SupportedChains_Handler,
-		},
+func _Query_ObserverLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLiveness(ctx, req.(*QueryGetObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObserverLivenessAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLivenessAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLivenessAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLivenessAll(ctx, req.(*QueryAllObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.observer.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HasVoted",
+			Handler:    _Query_HasVoted_Handler,
+		},
+		{
+			MethodName: "BallotByIdentifier",
+			Handler:    _Query_BallotByIdentifier_Handler,
+		},
+		{
+			MethodName: "ObserverSet",
+			Handler:    _Query_ObserverSet_Handler,
+		},
+		{
+			MethodName: "SupportedChains",
+			Handler:    _Query_SupportedChains_Handler,
+		},
 		{
 			MethodName: "GetChainParamsForChain",
 			Handler:    _Query_GetChainParamsForChain_Handler,
@@ -3655,11 +3927,166 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ballots",
 			Handler:    _Query_Ballots_Handler,
 		},
+		{
+			MethodName: "ObserverLiveness",
+			Handler:    _Query_ObserverLiveness_Handler,
+		},
+		{
+			MethodName: "ObserverLivenessAll",
+			Handler:    _Query_ObserverLivenessAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/observer/query.proto",
 }
 
+func (m *QueryGetObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ObserverLiveness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObserverLiveness) > 0 {
+		for iNdEx := len(m.ObserverLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBallotsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4606,6 +5033,11 @@ func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.VoteType != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
 		i--
@@ -5462,6 +5894,62 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryGetObserverLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetObserverLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObserverLiveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllObserverLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllObserverLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObserverLiveness) > 0 {
+		for _, e := range m.ObserverLiveness {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBallotsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5839,6 +6327,9 @@ func (m *VoterList) Size() (n int) {
 	if m.VoteType != 0 {
 		n += 1 + sovQuery(uint64(m.VoteType))
 	}
+	if m.Weight != 0 {
+		n += 1 + sovQuery(uint64(m.Weight))
+	}
 	return n
 }
 
@@ -6178,7 +6669,7 @@ func sovQuery(x uint64) (n int) {
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetObserverLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6201,17 +6692,17 @@ func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetObserverLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6221,27 +6712,23 @@ func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6264,7 +6751,7 @@ func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetObserverLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6287,15 +6774,15 @@ func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetObserverLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6322,13 +6809,388 @@ func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ballots = append(m.Ballots, Ballot{})
-			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObserverLiveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllObserverLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllObserverLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverLiveness = append(m.ObserverLiveness, ObserverLiveness{})
+			if err := m.ObserverLiveness[len(m.ObserverLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBallotsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBallotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBallotsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBallotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ballots = append(m.Ballots, Ballot{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
@@ -8589,6 +9451,25 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1565,6 +1565,293 @@ func (m *MsgDisableFastConfirmationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDisableFastConfirmationResponse proto.InternalMessageInfo
 
+// MsgRejoinObserverSet is used by an observer jailed for missing too many
+// votes to rejoin the observer set.
+type MsgRejoinObserverSet struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgRejoinObserverSet) Reset()         { *m = MsgRejoinObserverSet{} }
+func (m *MsgRejoinObserverSet) String() string { return proto.CompactTextString(m) }
+func (*MsgRejoinObserverSet) ProtoMessage()    {}
+func (*MsgRejoinObserverSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{30}
+}
+func (m *MsgRejoinObserverSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejoinObserverSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejoinObserverSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejoinObserverSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejoinObserverSet.Merge(m, src)
+}
+func (m *MsgRejoinObserverSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejoinObserverSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejoinObserverSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejoinObserverSet proto.InternalMessageInfo
+
+func (m *MsgRejoinObserverSet) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type MsgRejoinObserverSetResponse struct {
+}
+
+func (m *MsgRejoinObserverSetResponse) Reset()         { *m = MsgRejoinObserverSetResponse{} }
+func (m *MsgRejoinObserverSetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejoinObserverSetResponse) ProtoMessage()    {}
+func (*MsgRejoinObserverSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{31}
+}
+func (m *MsgRejoinObserverSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejoinObserverSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejoinObserverSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejoinObserverSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejoinObserverSetResponse.Merge(m, src)
+}
+func (m *MsgRejoinObserverSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejoinObserverSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejoinObserverSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejoinObserverSetResponse proto.InternalMessageInfo
+
+// MsgVoteHaltChain is used by an observer to vote for the emergency halt of a
+// connected chain. The inbounds and outbounds of the chain are disabled once
+// a supermajority of the observers voted.
+type MsgVoteHaltChain struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgVoteHaltChain) Reset()         { *m = MsgVoteHaltChain{} }
+func (m *MsgVoteHaltChain) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteHaltChain) ProtoMessage()    {}
+func (*MsgVoteHaltChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{32}
+}
+func (m *MsgVoteHaltChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteHaltChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteHaltChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteHaltChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteHaltChain.Merge(m, src)
+}
+func (m *MsgVoteHaltChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteHaltChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteHaltChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteHaltChain proto.InternalMessageInfo
+
+func (m *MsgVoteHaltChain) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgVoteHaltChain) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgVoteHaltChain) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MsgVoteHaltChainResponse struct {
+	BallotCreated bool `protobuf:"varint,1,opt,name=ballot_created,json=ballotCreated,proto3" json:"ballot_created,omitempty"`
+	VoteFinalized bool `protobuf:"varint,2,opt,name=vote_finalized,json=voteFinalized,proto3" json:"vote_finalized,omitempty"`
+}
+
+func (m *MsgVoteHaltChainResponse) Reset()         { *m = MsgVoteHaltChainResponse{} }
+func (m *MsgVoteHaltChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteHaltChainResponse) ProtoMessage()    {}
+func (*MsgVoteHaltChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{33}
+}
+func (m *MsgVoteHaltChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteHaltChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteHaltChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteHaltChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteHaltChainResponse.Merge(m, src)
+}
+func (m *MsgVoteHaltChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteHaltChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteHaltChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteHaltChainResponse proto.InternalMessageInfo
+
+func (m *MsgVoteHaltChainResponse) GetBallotCreated() bool {
+	if m != nil {
+		return m.BallotCreated
+	}
+	return false
+}
+
+func (m *MsgVoteHaltChainResponse) GetVoteFinalized() bool {
+	if m != nil {
+		return m.VoteFinalized
+	}
+	return false
+}
+
+// MsgUnhaltChain is used to enable again the inbounds and outbounds of a
+// chain halted by the observers.
+type MsgUnhaltChain struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgUnhaltChain) Reset()         { *m = MsgUnhaltChain{} }
+func (m *MsgUnhaltChain) String() string { return proto.CompactTextString(m) }
+func (*MsgUnhaltChain) ProtoMessage()    {}
+func (*MsgUnhaltChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{34}
+}
+func (m *MsgUnhaltChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnhaltChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnhaltChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnhaltChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnhaltChain.Merge(m, src)
+}
+func (m *MsgUnhaltChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnhaltChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnhaltChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnhaltChain proto.InternalMessageInfo
+
+func (m *MsgUnhaltChain) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnhaltChain) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type MsgUnhaltChainResponse struct {
+}
+
+func (m *MsgUnhaltChainResponse) Reset()         { *m = MsgUnhaltChainResponse{} }
+func (m *MsgUnhaltChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnhaltChainResponse) ProtoMessage()    {}
+func (*MsgUnhaltChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{35}
+}
+func (m *MsgUnhaltChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnhaltChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnhaltChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnhaltChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnhaltChainResponse.Merge(m, src)
+}
+func (m *MsgUnhaltChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnhaltChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnhaltChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnhaltChainResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateObserver)(nil), "zetachain.zetacore.observer.MsgUpdateObserver")
 	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "zetachain.zetacore.observer.MsgUpdateObserverResponse")
@@ -1596,6 +1883,12 @@ func init() {
 	proto.RegisterType((*MsgUpdateOperationalFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalFlagsResponse")
 	proto.RegisterType((*MsgDisableFastConfirmation)(nil), "zetachain.zetacore.observer.MsgDisableFastConfirmation")
 	proto.RegisterType((*MsgDisableFastConfirmationResponse)(nil), "zetachain.zetacore.observer.MsgDisableFastConfirmationResponse")
+	proto.RegisterType((*MsgRejoinObserverSet)(nil), "zetachain.zetacore.observer.MsgRejoinObserverSet")
+	proto.RegisterType((*MsgRejoinObserverSetResponse)(nil), "zetachain.zetacore.observer.MsgRejoinObserverSetResponse")
+	proto.RegisterType((*MsgVoteHaltChain)(nil), "zetachain.zetacore.observer.MsgVoteHaltChain")
+	proto.RegisterType((*MsgVoteHaltChainResponse)(nil), "zetachain.zetacore.observer.MsgVoteHaltChainResponse")
+	proto.RegisterType((*MsgUnhaltChain)(nil), "zetachain.zetacore.observer.MsgUnhaltChain")
+	proto.RegisterType((*MsgUnhaltChainResponse)(nil), "zetachain.zetacore.observer.MsgUnhaltChainResponse")
 }
 
 func init() {
@@ -1603,110 +1896,116 @@ func init() {
 }
 
 var fileDescriptor_eda6e3b1d16a4021 = []byte{
-	// 1634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0xd7, 0xdf, 0xcf, 0x96, 0x64, 0x33, 0x8e, 0x2d, 0xd3, 0x89, 0x92, 0xf5, 0x26, 0xb6,
-	0xec, 0x24, 0x56, 0xac, 0xec, 0x6e, 0x76, 0xb3, 0x41, 0x16, 0x89, 0x9d, 0xd8, 0xde, 0x8d, 0xe3,
-	0x80, 0x72, 0x82, 0xdd, 0x5c, 0xb8, 0x23, 0x72, 0x4c, 0x71, 0x4d, 0x71, 0x04, 0x0e, 0xe5, 0x8f,
-	0x14, 0x28, 0xda, 0x02, 0x3d, 0x34, 0x97, 0x06, 0xe8, 0xad, 0x97, 0x02, 0x05, 0x7a, 0xe9, 0x29,
-	0xc7, 0xfe, 0x07, 0xcd, 0x31, 0xe8, 0xa5, 0x3d, 0x15, 0x45, 0x72, 0xc8, 0xb5, 0xb7, 0x5e, 0x0b,
-	0x0e, 0x87, 0x23, 0x8a, 0x92, 0x29, 0xd9, 0x69, 0x4f, 0x92, 0xde, 0xfc, 0x7e, 0xef, 0x6b, 0x9e,
-	0xde, 0x7b, 0x24, 0x5c, 0x78, 0x8a, 0x3d, 0xa4, 0x57, 0x90, 0xe5, 0x14, 0xd8, 0x37, 0xe2, 0xe2,
-	0x02, 0x29, 0x53, 0xec, 0xee, 0x61, 0xb7, 0xe0, 0x1d, 0x2c, 0xd5, 0x5c, 0xe2, 0x11, 0x79, 0x46,
-	0xa0, 0x96, 0x42, 0xd4, 0x52, 0x88, 0x52, 0x26, 0x4c, 0x62, 0x12, 0x86, 0x2b, 0xf8, 0xdf, 0x02,
-	0x8a, 0x32, 0x9f, 0xa4, 0xb8, 0x6c, 0xa3, 0x2a, 0xe6, 0xc0, 0x62, 0x12, 0x50, 0x77, 0x09, 0xa5,
-	0xec, 0x50, 0xdb, 0xb1, 0x91, 0x49, 0x39, 0x67, 0x31, 0x89, 0x13, 0x7e, 0xe1, 0xd8, 0x7c, 0x12,
-	0xb6, 0x86, 0x5c, 0x54, 0x0d, 0xb5, 0x5e, 0x4d, 0x44, 0x62, 0xc7, 0xb0, 0x1c, 0x53, 0x73, 0x88,
-	0xa3, 0xe3, 0x90, 0x71, 0x31, 0x31, 0x7b, 0x34, 0x84, 0x5d, 0x49, 0x74, 0xb7, 0x86, 0x5d, 0xe4,
-	0x59, 0xc4, 0x41, 0x36, 0x87, 0xff, 0x25, 0x31, 0x23, 0xc4, 0xd9, 0xb1, 0xdc, 0x2a, 0x63, 0x68,
-	0x4d, 0xee, 0xb7, 0x4b, 0x4a, 0x6d, 0xd7, 0x2c, 0x30, 0x11, 0xe5, 0x1f, 0x1d, 0xb0, 0x35, 0x97,
-	0x90, 0x1d, 0xca, 0x3f, 0x38, 0x76, 0x4a, 0x27, 0xb4, 0x4a, 0x68, 0xa1, 0x4a, 0xcd, 0xc2, 0xde,
-	0xb2, 0xff, 0x11, 0x1c, 0xcc, 0xfe, 0x22, 0xc1, 0xf8, 0x26, 0x35, 0x1f, 0xd5, 0x0c, 0xe4, 0xe1,
-	0x2d, 0xee, 0xa0, 0x9c, 0x85, 0x41, 0xdd, 0xc5, 0xc8, 0x23, 0x6e, 0x56, 0x3a, 0x2f, 0xe5, 0x87,
-	0xd5, 0xf0, 0xa7, 0x7c, 0x15, 0x26, 0x88, 0x6d, 0x68, 0x61, 0x28, 0x1a, 0x32, 0x0c, 0x17, 0x53,
-	0x9a, 0xfd, 0x03, 0x83, 0xc9, 0xc4, 0x36, 0x42, 0x25, 0xb7, 0x83, 0x13, 0x9f, 0xe1, 0xe0, 0xfd,
-	0x56, 0x46, 0x6f, 0xc0, 0x70, 0xf0, 0x7e, 0x9c, 0xf1, 0x18, 0x52, 0x75, 0xe6, 0x8f, 0xe6, 0x62,
-	0x44, 0x89, 0x93, 0xed, 0x3b, 0x2f, 0xe5, 0xd3, 0xc5, 0xe5, 0xa5, 0x84, 0x0a, 0x5e, 0x0a, 0x95,
-	0x04, 0x91, 0xa8, 0x8c, 0xa8, 0x8e, 0xd6, 0x23, 0xbf, 0x6e, 0x8c, 0x7e, 0xf4, 0xf6, 0xc5, 0x62,
-	0x18, 0xc9, 0xec, 0x0c, 0x4c, 0xb7, 0x04, 0xae, 0x62, 0x5a, 0x23, 0x0e, 0xc5, 0xb3, 0xdf, 0x4b,
-	0x20, 0x6f, 0x52, 0xf3, 0x31, 0xf1, 0xf0, 0x1d, 0x9b, 0xe8, 0xbb, 0xeb, 0x18, 0x19, 0x89, 0x79,
-	0x99, 0x86, 0xa1, 0xa0, 0xc4, 0x2d, 0x83, 0xe5, 0xa2, 0x57, 0x1d, 0x64, 0xbf, 0x37, 0x0c, 0xf9,
-	0x2c, 0x40, 0xd9, 0xd7, 0xa1, 0x55, 0x10, 0xad, 0xb0, 0xb0, 0x47, 0xd5, 0x61, 0x26, 0x59, 0x47,
-	0xb4, 0x22, 0x4f, 0xc2, 0x40, 0x05, 0x5b, 0x66, 0xc5, 0x63, 0x61, 0xf6, 0xaa, 0xfc, 0x97, 0xbc,
-	0xe6, 0xcb, 0x7d, 0xab, 0xd9, 0xfe, 0xf3, 0x52, 0x7e, 0xa4, 0xb8, 0xd0, 0x2e, 0xfc, 0xda, 0x2e,
-	0xbb, 0x48, 0xff, 0xa2, 0x03, 0x17, 0x57, 0x91, 0x87, 0xee, 0xf4, 0xbd, 0xfc, 0xf1, 0x5c, 0x8f,
-	0xca, 0xe9, 0xb1, 0xb0, 0xff, 0x0f, 0x4a, 0x6b, 0x60, 0x61, 0xdc, 0xf2, 0x45, 0x48, 0x97, 0x91,
-	0x6d, 0x13, 0x4f, 0x63, 0x78, 0x6c, 0xb0, 0x38, 0x87, 0xd4, 0x54, 0x20, 0x5d, 0x09, 0x84, 0x3e,
-	0x6c, 0x8f, 0x78, 0x58, 0xdb, 0xb1, 0x1c, 0x64, 0x5b, 0x4f, 0x71, 0x10, 0xf3, 0x90, 0x9a, 0xf2,
-	0xa5, 0xf7, 0x42, 0xe1, 0xec, 0x33, 0x09, 0x26, 0x44, 0x8e, 0x57, 0x7c, 0xcf, 0x1f, 0xb2, 0x62,
-	0x4f, 0xc8, 0xe3, 0xbf, 0x60, 0x44, 0x6f, 0x00, 0x99, 0xda, 0x91, 0x62, 0x3e, 0xf1, 0xe6, 0x23,
-	0x8a, 0xd5, 0x28, 0x39, 0x16, 0x78, 0x0e, 0xce, 0xb4, 0xf3, 0x45, 0x5c, 0xf9, 0xcf, 0xbd, 0x70,
-	0xae, 0x51, 0x10, 0x8d, 0x3f, 0x74, 0x77, 0x7e, 0x27, 0xdc, 0x7f, 0x1e, 0xc6, 0x4c, 0x44, 0xb5,
-	0x9a, 0x6b, 0xe9, 0x58, 0xf3, 0x2c, 0x7d, 0x17, 0xbb, 0xac, 0x0a, 0xfa, 0xd4, 0xb4, 0x89, 0xe8,
-	0x43, 0x5f, 0xbc, 0xcd, 0xa4, 0x7e, 0x5a, 0x2d, 0xa7, 0x4c, 0xea, 0x8e, 0x11, 0xe2, 0xfa, 0x18,
-	0x2e, 0xc5, 0xa5, 0x1c, 0x36, 0x0f, 0x19, 0x52, 0xf7, 0x9a, 0x70, 0xfd, 0x81, 0xbe, 0x50, 0xcc,
-	0x81, 0x8b, 0x30, 0xbe, 0x8f, 0x3c, 0xbd, 0xa2, 0xd5, 0xbd, 0x03, 0x12, 0x42, 0x07, 0x18, 0x34,
-	0xc3, 0x0e, 0x1e, 0x79, 0x07, 0x84, 0x63, 0x6f, 0x82, 0x22, 0x94, 0x52, 0xbd, 0x82, 0x8d, 0xba,
-	0x8d, 0x35, 0xcb, 0xf1, 0xb0, 0xbb, 0x87, 0xec, 0xec, 0x20, 0x0b, 0x29, 0x1b, 0x22, 0x4a, 0x1c,
-	0xb0, 0xc1, 0xcf, 0xe5, 0x5b, 0x30, 0xd3, 0xca, 0xb6, 0x09, 0xd9, 0x45, 0x7e, 0x11, 0x66, 0x87,
-	0x18, 0x7d, 0x3a, 0x4e, 0xbf, 0x1f, 0x02, 0xe4, 0x1d, 0x38, 0xd5, 0xa6, 0x29, 0x66, 0x87, 0xd9,
-	0xf5, 0x17, 0x92, 0xaf, 0x3f, 0xc2, 0x0b, 0xae, 0x89, 0xd7, 0xbf, 0xac, 0xb7, 0x9c, 0xc4, 0x4a,
-	0x62, 0x01, 0xe6, 0x3b, 0xdc, 0xb8, 0xa8, 0x8e, 0xff, 0xb2, 0x4a, 0x56, 0x71, 0x95, 0xec, 0xe1,
-	0x77, 0xad, 0x88, 0xb6, 0x85, 0xd9, 0xa2, 0x5a, 0x98, 0xfe, 0x4e, 0x82, 0xf4, 0x26, 0x35, 0x6f,
-	0x1b, 0x46, 0x17, 0xfd, 0x79, 0x01, 0xc6, 0x8e, 0xe8, 0xcd, 0x19, 0x12, 0x6b, 0xb3, 0x37, 0x60,
-	0x9a, 0x65, 0xd3, 0xb6, 0xb0, 0xe3, 0x69, 0xa6, 0x8b, 0x1c, 0x0f, 0x63, 0xad, 0x56, 0x2f, 0xef,
-	0xe2, 0x43, 0xde, 0x9d, 0xa7, 0x1a, 0x80, 0xb5, 0xe0, 0xfc, 0x21, 0x3b, 0x96, 0x97, 0xe1, 0x34,
-	0x32, 0x0c, 0xcd, 0x21, 0x06, 0xd6, 0x90, 0xae, 0x93, 0xba, 0xe3, 0x69, 0xc4, 0xb1, 0x0f, 0x59,
-	0xc1, 0x0e, 0xa9, 0x32, 0x32, 0x8c, 0x07, 0xc4, 0xc0, 0xb7, 0x83, 0xa3, 0x2d, 0xc7, 0x3e, 0x8c,
-	0x05, 0x9d, 0x85, 0xc9, 0xe6, 0x98, 0x44, 0xb8, 0x9f, 0x4b, 0x30, 0x2a, 0x3a, 0x14, 0xaa, 0xe2,
-	0x93, 0xfd, 0xe9, 0xd6, 0xfc, 0xa6, 0x8b, 0xaa, 0x7e, 0x09, 0xef, 0x10, 0x16, 0xcd, 0x48, 0x71,
-	0x36, 0xb1, 0x8e, 0x98, 0x31, 0x5e, 0x3a, 0xc3, 0x8c, 0xbb, 0xe1, 0xec, 0x90, 0x98, 0xdb, 0x93,
-	0xac, 0x0c, 0x84, 0x6f, 0xc2, 0xe9, 0x2d, 0xc8, 0x88, 0x4a, 0xfa, 0x37, 0x3e, 0x34, 0xb1, 0x93,
-	0xe0, 0xf6, 0x04, 0xf4, 0xb3, 0xf6, 0xcf, 0x7d, 0x0e, 0x7e, 0xc4, 0x0c, 0x4d, 0xc3, 0x54, 0x4c,
-	0xa1, 0xb0, 0xf5, 0xb5, 0x04, 0xa7, 0x58, 0xc1, 0x50, 0xec, 0xb1, 0x7a, 0x79, 0xc0, 0xd6, 0x99,
-	0x93, 0xe5, 0x69, 0x0e, 0x32, 0xc1, 0x11, 0xdb, 0x89, 0x34, 0x9b, 0xec, 0xb3, 0x64, 0xf5, 0xaa,
-	0x29, 0x5d, 0xa8, 0xbe, 0x4f, 0xf6, 0xfd, 0x26, 0x16, 0xc5, 0x55, 0x2c, 0xb3, 0xc2, 0xe7, 0x55,
-	0xba, 0x01, 0x5c, 0xb7, 0xcc, 0x4a, 0x2c, 0x8e, 0xb3, 0x30, 0xd3, 0xc6, 0x57, 0x11, 0xcb, 0xb7,
-	0x12, 0x00, 0x4f, 0xe8, 0x76, 0xa9, 0x94, 0x10, 0xc2, 0x59, 0x00, 0x8f, 0xd2, 0xb0, 0x3a, 0x83,
-	0x8a, 0x1e, 0xf6, 0x28, 0xe5, 0xf5, 0x78, 0x19, 0xe4, 0x5d, 0x96, 0x25, 0xcd, 0xbf, 0x58, 0x8d,
-	0x0f, 0xd4, 0x20, 0x92, 0xb1, 0xe0, 0xe4, 0x09, 0xf6, 0xd0, 0x7a, 0x30, 0x5a, 0x57, 0x61, 0x80,
-	0x7a, 0xc8, 0xab, 0x53, 0xbe, 0x59, 0x5c, 0x3e, 0x6a, 0xb4, 0xf2, 0x7d, 0x4b, 0xc5, 0x3a, 0xb6,
-	0xf6, 0x70, 0x89, 0x71, 0x54, 0xce, 0x8d, 0x05, 0xfa, 0x49, 0x63, 0x63, 0xd8, 0x2e, 0x95, 0x7e,
-	0x9f, 0x81, 0xea, 0xc3, 0x78, 0x98, 0xb4, 0xae, 0xeb, 0xe1, 0x16, 0x35, 0xa4, 0xa6, 0x02, 0x69,
-	0x29, 0x10, 0xce, 0x7e, 0x2c, 0x41, 0x6a, 0x93, 0x9a, 0x77, 0x1d, 0x54, 0xb6, 0xf1, 0xca, 0xca,
-	0xf6, 0x7f, 0x12, 0x12, 0x7b, 0x01, 0x52, 0x98, 0xe1, 0x36, 0x82, 0x19, 0x13, 0x1a, 0x6e, 0x12,
-	0xca, 0x73, 0x90, 0x0e, 0x04, 0x5b, 0xbc, 0x85, 0x73, 0xc3, 0x31, 0x69, 0x2c, 0x27, 0x53, 0x70,
-	0xba, 0xc9, 0x0d, 0x71, 0xed, 0xcf, 0x82, 0x96, 0xb6, 0x6a, 0xd1, 0x2e, 0x3c, 0x9c, 0x83, 0xb4,
-	0x11, 0x00, 0x9b, 0x5d, 0x8c, 0x49, 0xe5, 0x3c, 0x64, 0xb8, 0x24, 0xe6, 0x64, 0x5c, 0xdc, 0xb6,
-	0x15, 0x45, 0x7c, 0x11, 0x6e, 0x7e, 0x23, 0x41, 0x4e, 0xfc, 0x0b, 0xd7, 0xf8, 0xac, 0xde, 0x70,
-	0x7c, 0x22, 0xc5, 0xf7, 0xfc, 0x67, 0x99, 0x04, 0xb7, 0x1d, 0x38, 0x6d, 0xb6, 0xa3, 0xf0, 0x9d,
-	0xa6, 0x98, 0xd8, 0x8c, 0xda, 0x1a, 0xe3, 0xcd, 0xa9, 0xbd, 0xda, 0x58, 0x50, 0x79, 0x98, 0x4b,
-	0xf6, 0x5c, 0x04, 0xf9, 0x95, 0x14, 0x5d, 0x84, 0x1b, 0x53, 0xb0, 0x53, 0x7c, 0xff, 0x83, 0xf1,
-	0xc8, 0x63, 0x4f, 0xf0, 0x68, 0xc7, 0x63, 0xbb, 0x92, 0xbc, 0xa9, 0xc7, 0x6c, 0xf0, 0xb0, 0xc6,
-	0x48, 0x4c, 0x1e, 0x8b, 0xe8, 0x4f, 0xf0, 0xc7, 0x23, 0xdd, 0x14, 0xc1, 0x68, 0x6c, 0xbb, 0xe5,
-	0x77, 0x79, 0x0f, 0x51, 0x2f, 0xba, 0x1d, 0xfc, 0x16, 0xc3, 0xfa, 0x02, 0xcc, 0x1e, 0x6d, 0x20,
-	0x74, 0xa3, 0xf8, 0x69, 0x06, 0x7a, 0x37, 0xa9, 0x29, 0x13, 0x18, 0x89, 0x8e, 0xed, 0x4b, 0x89,
-	0x79, 0x69, 0x9e, 0x87, 0xca, 0xb5, 0x63, 0x80, 0x45, 0xbb, 0x39, 0x80, 0x74, 0xec, 0x51, 0x6e,
-	0xa9, 0x93, 0x9a, 0x66, 0xbc, 0xf2, 0xd7, 0xe3, 0xe1, 0x85, 0xe5, 0x0f, 0x25, 0x18, 0x6f, 0x5d,
-	0xf4, 0x97, 0xbb, 0xd3, 0x16, 0xa1, 0x28, 0x7f, 0x3f, 0x36, 0xa5, 0xc9, 0x87, 0xd6, 0x15, 0xad,
-	0xa3, 0x0f, 0x2d, 0x94, 0xce, 0x3e, 0x1c, 0xb9, 0xad, 0xc9, 0x16, 0x0c, 0x37, 0x56, 0x97, 0x85,
-	0x4e, 0x7a, 0x04, 0x54, 0x59, 0xee, 0x1a, 0x2a, 0x4c, 0xb9, 0x30, 0xda, 0xb4, 0x71, 0x5c, 0xee,
-	0x2e, 0x73, 0x01, 0x5a, 0xf9, 0xf3, 0x71, 0xd0, 0xc2, 0xe6, 0x7b, 0x90, 0x89, 0x3f, 0x14, 0x17,
-	0xba, 0xf3, 0x5c, 0x10, 0x94, 0xeb, 0xc7, 0x24, 0x08, 0xe3, 0xef, 0xc3, 0x58, 0xcb, 0xd6, 0x73,
-	0xb5, 0xf3, 0x55, 0x35, 0x33, 0x94, 0xbf, 0x1d, 0x97, 0x21, 0xec, 0xeb, 0x30, 0x18, 0x6e, 0x2a,
-	0xf3, 0xdd, 0xc4, 0xb0, 0x5d, 0x2a, 0x29, 0x85, 0x2e, 0x81, 0xc2, 0x88, 0x0d, 0x10, 0x19, 0xdc,
-	0x8b, 0x9d, 0xe8, 0x0d, 0xac, 0x52, 0xec, 0x1e, 0x2b, 0xac, 0x11, 0x18, 0x89, 0x4e, 0xe1, 0x8e,
-	0x1d, 0x2a, 0x02, 0xee, 0xdc, 0xa1, 0xda, 0xcc, 0x54, 0xf9, 0x33, 0x09, 0xa6, 0x8e, 0xea, 0xcf,
-	0xd7, 0xbb, 0x54, 0x18, 0x27, 0x2a, 0xff, 0x3c, 0x21, 0x51, 0x78, 0xf5, 0x85, 0x04, 0x33, 0x49,
-	0x63, 0xfe, 0x1f, 0xdd, 0xfd, 0x59, 0xda, 0x92, 0x95, 0x95, 0x77, 0x20, 0x0b, 0x0f, 0x9f, 0x4b,
-	0x30, 0x79, 0xc4, 0x8c, 0xee, 0xb6, 0x65, 0xc7, 0x78, 0xca, 0xad, 0x93, 0xf1, 0x84, 0x4b, 0x5f,
-	0x4a, 0x70, 0x26, 0xf1, 0x75, 0xc9, 0xcd, 0x63, 0x1b, 0x88, 0x36, 0xe1, 0xd5, 0x77, 0x61, 0x87,
-	0x4e, 0x2a, 0xfd, 0x1f, 0xbc, 0x7d, 0xb1, 0x28, 0xdd, 0xb9, 0xfb, 0xf2, 0x75, 0x4e, 0x7a, 0xf5,
-	0x3a, 0x27, 0xfd, 0xf4, 0x3a, 0x27, 0x3d, 0x7f, 0x93, 0xeb, 0x79, 0xf5, 0x26, 0xd7, 0xf3, 0xc3,
-	0x9b, 0x5c, 0xcf, 0x93, 0x4b, 0xa6, 0xe5, 0x55, 0xea, 0xe5, 0x25, 0x9d, 0x54, 0xd9, 0x7b, 0xd4,
-	0x2b, 0xc1, 0x2b, 0x55, 0xff, 0xd1, 0xb6, 0x70, 0x10, 0x79, 0x13, 0x7c, 0x58, 0xc3, 0xb4, 0x3c,
-	0xc0, 0xde, 0x9a, 0x5e, 0xfb, 0x35, 0x00, 0x00, 0xff, 0xff, 0x13, 0x59, 0x01, 0x4c, 0x73, 0x17,
-	0x00, 0x00,
+	// 1744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0x36, 0xeb, 0xdf, 0xcf, 0x96, 0x6c, 0x73, 0x1d, 0x5b, 0xa6, 0x37, 0xda, 0xd4, 0xcd, 0xda,
+	0xb2, 0x37, 0x96, 0x62, 0xb9, 0xdb, 0x6d, 0xb6, 0x8b, 0x14, 0x89, 0xbd, 0xb1, 0xdd, 0xae, 0xe3,
+	0x80, 0x72, 0x82, 0x36, 0x17, 0x76, 0x44, 0x8e, 0x28, 0xc6, 0x14, 0x47, 0xe0, 0x50, 0xfe, 0x91,
+	0x02, 0x45, 0x5b, 0xa0, 0x87, 0xe6, 0x14, 0xa0, 0xb7, 0x5e, 0x0a, 0x14, 0xe8, 0xa5, 0xa7, 0x1c,
+	0xfb, 0x1f, 0x34, 0xc7, 0xa0, 0x97, 0xf6, 0x54, 0x14, 0xc9, 0x21, 0xd7, 0xde, 0x7a, 0xe8, 0xa5,
+	0xe0, 0x70, 0x38, 0xa2, 0x28, 0x89, 0x92, 0xec, 0xe6, 0x64, 0xe9, 0xcd, 0xf7, 0xbd, 0xf7, 0xbd,
+	0x99, 0xc7, 0x37, 0x8f, 0x16, 0xdc, 0x7c, 0x8e, 0x3d, 0xa4, 0x57, 0x91, 0xe5, 0x14, 0xd8, 0x27,
+	0xe2, 0xe2, 0x02, 0x29, 0x53, 0xec, 0x9e, 0x62, 0xb7, 0xe0, 0x9d, 0xe7, 0xeb, 0x2e, 0xf1, 0x88,
+	0xbc, 0x2c, 0x50, 0xf9, 0x10, 0x95, 0x0f, 0x51, 0xca, 0xbc, 0x49, 0x4c, 0xc2, 0x70, 0x05, 0xff,
+	0x53, 0x40, 0x51, 0xd6, 0x92, 0x1c, 0x97, 0x6d, 0x54, 0xc3, 0x1c, 0x58, 0x4c, 0x02, 0xea, 0x2e,
+	0xa1, 0x94, 0x2d, 0x6a, 0x15, 0x1b, 0x99, 0x94, 0x73, 0x36, 0x92, 0x38, 0xe1, 0x07, 0x8e, 0xcd,
+	0x25, 0x61, 0xeb, 0xc8, 0x45, 0xb5, 0xd0, 0xeb, 0xed, 0x44, 0x24, 0x76, 0x0c, 0xcb, 0x31, 0x35,
+	0x87, 0x38, 0x3a, 0x0e, 0x19, 0x9f, 0x26, 0xee, 0x1e, 0x0d, 0x61, 0x9b, 0x89, 0x72, 0xeb, 0xd8,
+	0x45, 0x9e, 0x45, 0x1c, 0x64, 0x73, 0xf8, 0xe7, 0x89, 0x3b, 0x42, 0x9c, 0x8a, 0xe5, 0xd6, 0x18,
+	0x43, 0x6b, 0x91, 0xdf, 0x69, 0x53, 0xea, 0x27, 0x66, 0x81, 0x99, 0x28, 0xff, 0xd3, 0x03, 0x5b,
+	0x77, 0x09, 0xa9, 0x50, 0xfe, 0x87, 0x63, 0x17, 0x75, 0x42, 0x6b, 0x84, 0x16, 0x6a, 0xd4, 0x2c,
+	0x9c, 0x6e, 0xf9, 0x7f, 0x82, 0x85, 0x95, 0xff, 0x48, 0x30, 0x77, 0x48, 0xcd, 0xc7, 0x75, 0x03,
+	0x79, 0xf8, 0x88, 0x0b, 0x94, 0x33, 0x30, 0xae, 0xbb, 0x18, 0x79, 0xc4, 0xcd, 0x48, 0x37, 0xa4,
+	0xdc, 0xa4, 0x1a, 0x7e, 0x95, 0x6f, 0xc3, 0x3c, 0xb1, 0x0d, 0x2d, 0x4c, 0x45, 0x43, 0x86, 0xe1,
+	0x62, 0x4a, 0x33, 0xdf, 0x62, 0x30, 0x99, 0xd8, 0x46, 0xe8, 0xe4, 0x5e, 0xb0, 0xe2, 0x33, 0x1c,
+	0x7c, 0xd6, 0xce, 0x18, 0x0e, 0x18, 0x0e, 0x3e, 0x8b, 0x33, 0x9e, 0x40, 0xaa, 0xc1, 0xf4, 0x68,
+	0x2e, 0x46, 0x94, 0x38, 0x99, 0x91, 0x1b, 0x52, 0x2e, 0x5d, 0xdc, 0xca, 0x27, 0x54, 0x70, 0x3e,
+	0x74, 0x12, 0x64, 0xa2, 0x32, 0xa2, 0x3a, 0xdd, 0x88, 0x7c, 0xfb, 0x72, 0xfa, 0xd7, 0xef, 0x5f,
+	0x6d, 0x84, 0x99, 0xac, 0x2c, 0xc3, 0x52, 0x5b, 0xe2, 0x2a, 0xa6, 0x75, 0xe2, 0x50, 0xbc, 0xf2,
+	0x77, 0x09, 0xe4, 0x43, 0x6a, 0x3e, 0x21, 0x1e, 0xbe, 0x6f, 0x13, 0xfd, 0x64, 0x1f, 0x23, 0x23,
+	0x71, 0x5f, 0x96, 0x60, 0x22, 0x28, 0x71, 0xcb, 0x60, 0x7b, 0x31, 0xac, 0x8e, 0xb3, 0xef, 0x07,
+	0x86, 0x7c, 0x1d, 0xa0, 0xec, 0xfb, 0xd0, 0xaa, 0x88, 0x56, 0x59, 0xda, 0xd3, 0xea, 0x24, 0xb3,
+	0xec, 0x23, 0x5a, 0x95, 0x17, 0x60, 0xac, 0x8a, 0x2d, 0xb3, 0xea, 0xb1, 0x34, 0x87, 0x55, 0xfe,
+	0x4d, 0xde, 0xf3, 0xed, 0x7e, 0xd4, 0xcc, 0xe8, 0x0d, 0x29, 0x37, 0x55, 0x5c, 0xef, 0x94, 0x7e,
+	0xfd, 0xc4, 0xcc, 0xf3, 0x83, 0x0e, 0x24, 0xee, 0x22, 0x0f, 0xdd, 0x1f, 0x79, 0xfd, 0xcf, 0x4f,
+	0x86, 0x54, 0x4e, 0x8f, 0xa5, 0xfd, 0x0c, 0x94, 0xf6, 0xc4, 0xc2, 0xbc, 0xe5, 0x4f, 0x21, 0x5d,
+	0x46, 0xb6, 0x4d, 0x3c, 0x8d, 0xe1, 0xb1, 0xc1, 0xf2, 0x9c, 0x50, 0x53, 0x81, 0x75, 0x27, 0x30,
+	0xfa, 0xb0, 0x53, 0xe2, 0x61, 0xad, 0x62, 0x39, 0xc8, 0xb6, 0x9e, 0xe3, 0x20, 0xe7, 0x09, 0x35,
+	0xe5, 0x5b, 0x1f, 0x84, 0xc6, 0x95, 0x17, 0x12, 0xcc, 0x8b, 0x3d, 0xde, 0xf1, 0x95, 0x3f, 0x62,
+	0xc5, 0x9e, 0xb0, 0x8f, 0x3f, 0x82, 0x29, 0xbd, 0x09, 0x64, 0x6e, 0xa7, 0x8a, 0xb9, 0xc4, 0x93,
+	0x8f, 0x38, 0x56, 0xa3, 0xe4, 0x58, 0xe2, 0x59, 0xf8, 0xb8, 0x93, 0x16, 0x71, 0xe4, 0xff, 0x1e,
+	0x86, 0x4f, 0x9a, 0x05, 0xd1, 0x7c, 0xa0, 0xfb, 0xd3, 0x9d, 0x70, 0xfe, 0x39, 0x98, 0x35, 0x11,
+	0xd5, 0xea, 0xae, 0xa5, 0x63, 0xcd, 0xb3, 0xf4, 0x13, 0xec, 0xb2, 0x2a, 0x18, 0x51, 0xd3, 0x26,
+	0xa2, 0x8f, 0x7c, 0xf3, 0x31, 0xb3, 0xfa, 0xdb, 0x6a, 0x39, 0x65, 0xd2, 0x70, 0x8c, 0x10, 0x37,
+	0xc2, 0x70, 0x29, 0x6e, 0xe5, 0xb0, 0x35, 0x98, 0x21, 0x0d, 0xaf, 0x05, 0x37, 0x1a, 0xf8, 0x0b,
+	0xcd, 0x1c, 0xb8, 0x01, 0x73, 0x67, 0xc8, 0xd3, 0xab, 0x5a, 0xc3, 0x3b, 0x27, 0x21, 0x74, 0x8c,
+	0x41, 0x67, 0xd8, 0xc2, 0x63, 0xef, 0x9c, 0x70, 0xec, 0x57, 0xa0, 0x08, 0xa7, 0x54, 0xaf, 0x62,
+	0xa3, 0x61, 0x63, 0xcd, 0x72, 0x3c, 0xec, 0x9e, 0x22, 0x3b, 0x33, 0xce, 0x52, 0xca, 0x84, 0x88,
+	0x12, 0x07, 0x1c, 0xf0, 0x75, 0xf9, 0x2e, 0x2c, 0xb7, 0xb3, 0x6d, 0x42, 0x4e, 0x90, 0x5f, 0x84,
+	0x99, 0x09, 0x46, 0x5f, 0x8a, 0xd3, 0xbf, 0x09, 0x01, 0x72, 0x05, 0x3e, 0xea, 0xd0, 0x14, 0x33,
+	0x93, 0xec, 0xf8, 0x0b, 0xc9, 0xc7, 0x1f, 0xe1, 0x05, 0xc7, 0xc4, 0xeb, 0x5f, 0xd6, 0xdb, 0x56,
+	0x62, 0x25, 0xb1, 0x0e, 0x6b, 0x3d, 0x4e, 0x5c, 0x54, 0xc7, 0x4f, 0x59, 0x25, 0xab, 0xb8, 0x46,
+	0x4e, 0xf1, 0x55, 0x2b, 0xa2, 0x63, 0x61, 0xb6, 0xb9, 0x16, 0xa1, 0xff, 0x26, 0x41, 0xfa, 0x90,
+	0x9a, 0xf7, 0x0c, 0xa3, 0x8f, 0xfe, 0xbc, 0x0e, 0xb3, 0x5d, 0x7a, 0xf3, 0x0c, 0x89, 0xb5, 0xd9,
+	0x2f, 0x61, 0x89, 0xed, 0xa6, 0x6d, 0x61, 0xc7, 0xd3, 0x4c, 0x17, 0x39, 0x1e, 0xc6, 0x5a, 0xbd,
+	0x51, 0x3e, 0xc1, 0x17, 0xbc, 0x3b, 0x2f, 0x36, 0x01, 0x7b, 0xc1, 0xfa, 0x23, 0xb6, 0x2c, 0x6f,
+	0xc1, 0x35, 0x64, 0x18, 0x9a, 0x43, 0x0c, 0xac, 0x21, 0x5d, 0x27, 0x0d, 0xc7, 0xd3, 0x88, 0x63,
+	0x5f, 0xb0, 0x82, 0x9d, 0x50, 0x65, 0x64, 0x18, 0x0f, 0x89, 0x81, 0xef, 0x05, 0x4b, 0x47, 0x8e,
+	0x7d, 0x11, 0x4b, 0x3a, 0x03, 0x0b, 0xad, 0x39, 0x89, 0x74, 0x7f, 0x2f, 0xc1, 0xb4, 0xe8, 0x50,
+	0xa8, 0x86, 0x2f, 0xf7, 0xd0, 0xed, 0xf9, 0x4d, 0x17, 0xd5, 0xfc, 0x12, 0xae, 0x10, 0x96, 0xcd,
+	0x54, 0x71, 0x25, 0xb1, 0x8e, 0x58, 0x30, 0x5e, 0x3a, 0x93, 0x8c, 0x7b, 0xe0, 0x54, 0x48, 0x4c,
+	0xf6, 0x02, 0xcc, 0x47, 0xb5, 0x09, 0xd1, 0x47, 0x30, 0x23, 0x2a, 0xe9, 0xc7, 0xf8, 0xc2, 0xc4,
+	0x4e, 0x82, 0xec, 0x79, 0x18, 0x65, 0xed, 0x9f, 0x6b, 0x0e, 0xbe, 0xc4, 0x02, 0x2d, 0xc1, 0x62,
+	0xcc, 0xa1, 0x88, 0xf5, 0x67, 0x09, 0x3e, 0x62, 0x05, 0x43, 0xb1, 0xc7, 0xea, 0xe5, 0x21, 0x1b,
+	0x67, 0x2e, 0xb7, 0x4f, 0xab, 0x30, 0x13, 0x2c, 0xb1, 0x99, 0x48, 0xb3, 0xc9, 0x19, 0xdb, 0xac,
+	0x61, 0x35, 0xa5, 0x0b, 0xd7, 0xdf, 0x90, 0x33, 0xbf, 0x89, 0x45, 0x71, 0x55, 0xcb, 0xac, 0xf2,
+	0xfb, 0x2a, 0xdd, 0x04, 0xee, 0x5b, 0x66, 0x35, 0x96, 0xc7, 0x75, 0x58, 0xee, 0xa0, 0x55, 0xe4,
+	0xf2, 0x57, 0x09, 0x80, 0x6f, 0xe8, 0x71, 0xa9, 0x94, 0x90, 0xc2, 0x75, 0x00, 0x8f, 0xd2, 0xb0,
+	0x3a, 0x83, 0x8a, 0x9e, 0xf4, 0x28, 0xe5, 0xf5, 0x78, 0x0b, 0xe4, 0x13, 0xb6, 0x4b, 0x9a, 0x7f,
+	0xb0, 0x1a, 0xbf, 0x50, 0x83, 0x4c, 0x66, 0x83, 0x95, 0xa7, 0xd8, 0x43, 0xfb, 0xcc, 0x2e, 0xef,
+	0xc2, 0x18, 0xf5, 0x90, 0xd7, 0xa0, 0x7c, 0xb2, 0xb8, 0xd5, 0xed, 0x6a, 0xe5, 0xf3, 0x96, 0x8a,
+	0x75, 0x6c, 0x9d, 0xe2, 0x12, 0xe3, 0xa8, 0x9c, 0x1b, 0x4b, 0xf4, 0xb7, 0xcd, 0x89, 0xe1, 0xb8,
+	0x54, 0xfa, 0x30, 0x17, 0xaa, 0x0f, 0xe3, 0x69, 0xd2, 0x86, 0xae, 0x87, 0x53, 0xd4, 0x84, 0x9a,
+	0x0a, 0xac, 0xa5, 0xc0, 0xb8, 0xf2, 0x1b, 0x09, 0x52, 0x87, 0xd4, 0xfc, 0xda, 0x41, 0x65, 0x1b,
+	0xef, 0xec, 0x1c, 0xff, 0x24, 0x61, 0x63, 0x6f, 0x42, 0x0a, 0x33, 0xdc, 0x41, 0x70, 0xc7, 0x84,
+	0x81, 0x5b, 0x8c, 0xf2, 0x2a, 0xa4, 0x03, 0xc3, 0x11, 0x6f, 0xe1, 0x3c, 0x70, 0xcc, 0x1a, 0xdb,
+	0x93, 0x45, 0xb8, 0xd6, 0x22, 0x43, 0x1c, 0xfb, 0x8b, 0xa0, 0xa5, 0xed, 0x5a, 0xb4, 0x0f, 0x85,
+	0xab, 0x90, 0x36, 0x2c, 0x1a, 0x51, 0xc3, 0x25, 0xc6, 0xac, 0x72, 0x0e, 0x66, 0xb8, 0x25, 0x26,
+	0x32, 0x6e, 0xee, 0xd8, 0x8a, 0x22, 0x5a, 0x84, 0xcc, 0xbf, 0x48, 0x90, 0x15, 0x4f, 0xe1, 0x1e,
+	0xbf, 0xab, 0x0f, 0x1c, 0x9f, 0x48, 0xf1, 0x03, 0xff, 0x5d, 0x26, 0x41, 0xb6, 0x03, 0xd7, 0xcc,
+	0x4e, 0x14, 0x3e, 0xd3, 0x14, 0x13, 0x9b, 0x51, 0xc7, 0x60, 0xbc, 0x39, 0x75, 0x76, 0x1b, 0x4b,
+	0x2a, 0x07, 0xab, 0xc9, 0xca, 0x45, 0x92, 0x7f, 0x92, 0x60, 0xa9, 0xd3, 0x2d, 0xd8, 0x2b, 0xbf,
+	0x9f, 0xc1, 0x5c, 0xe4, 0xb5, 0x47, 0xab, 0x44, 0x72, 0xdb, 0x4c, 0x9e, 0xd4, 0x63, 0x31, 0x78,
+	0x5a, 0xb3, 0x24, 0x66, 0x8f, 0x65, 0xf4, 0x1d, 0xf8, 0x76, 0x57, 0x99, 0x22, 0x19, 0x0d, 0x94,
+	0xe6, 0x59, 0x3e, 0x40, 0xd4, 0x8b, 0x4e, 0x07, 0xff, 0x8f, 0xcb, 0xfa, 0x26, 0xac, 0x74, 0x0f,
+	0x20, 0x64, 0xdc, 0xe5, 0xd3, 0xc2, 0x33, 0x62, 0x39, 0xe1, 0x05, 0x57, 0xc2, 0x5e, 0x77, 0x01,
+	0x5d, 0x46, 0x82, 0x18, 0x5f, 0xf8, 0xaf, 0xc1, 0x2c, 0xef, 0x35, 0xfb, 0xc8, 0x0e, 0x1a, 0xeb,
+	0xe5, 0xda, 0xff, 0x02, 0x8c, 0xf1, 0x77, 0xac, 0xe0, 0xc2, 0xe7, 0xdf, 0x62, 0x72, 0xaa, 0x90,
+	0x89, 0x87, 0xfb, 0x40, 0x6f, 0x0c, 0x25, 0xd6, 0x17, 0x1e, 0x3b, 0xd5, 0x2b, 0xa5, 0xd5, 0xf1,
+	0x01, 0x8f, 0x38, 0x0d, 0xc5, 0x17, 0xff, 0x3b, 0x07, 0xc3, 0x87, 0xd4, 0x94, 0x09, 0x4c, 0x45,
+	0xc7, 0xab, 0xcf, 0x12, 0xeb, 0xb7, 0x75, 0x6e, 0x51, 0xb6, 0x07, 0x00, 0x8b, 0x5d, 0x3b, 0x87,
+	0x74, 0xec, 0x95, 0x3b, 0xdf, 0xcb, 0x4d, 0x2b, 0x5e, 0xf9, 0xde, 0x60, 0x78, 0x11, 0xf9, 0x57,
+	0x12, 0xcc, 0xb5, 0xbf, 0x90, 0x6d, 0xf5, 0xe7, 0x2d, 0x42, 0x51, 0xee, 0x0c, 0x4c, 0x69, 0xd1,
+	0xd0, 0x3e, 0x4a, 0xf7, 0xd4, 0xd0, 0x46, 0x51, 0xee, 0x0c, 0x4c, 0x11, 0x1a, 0x2c, 0x98, 0x6c,
+	0x8e, 0x98, 0xeb, 0xbd, 0xfc, 0x08, 0xa8, 0xb2, 0xd5, 0x37, 0x54, 0x84, 0x72, 0x61, 0xba, 0x65,
+	0x32, 0xbc, 0xd5, 0xdf, 0xce, 0x05, 0x68, 0xe5, 0xbb, 0x83, 0xa0, 0x45, 0xcc, 0x9f, 0xc3, 0x4c,
+	0xfc, 0x9f, 0x17, 0x85, 0xfe, 0x94, 0x0b, 0x82, 0xf2, 0xc5, 0x80, 0x04, 0x11, 0xfc, 0x17, 0x30,
+	0xdb, 0x36, 0x9d, 0xde, 0xee, 0x7d, 0x54, 0xad, 0x0c, 0xe5, 0xfb, 0x83, 0x32, 0x44, 0x7c, 0x1d,
+	0xc6, 0xc3, 0x89, 0x72, 0xad, 0x9f, 0x1c, 0x8e, 0x4b, 0x25, 0xa5, 0xd0, 0x27, 0x50, 0x04, 0xb1,
+	0x01, 0x22, 0x03, 0xd6, 0x46, 0x2f, 0x7a, 0x13, 0xab, 0x14, 0xfb, 0xc7, 0x8a, 0x68, 0x04, 0xa6,
+	0xa2, 0xd3, 0x52, 0xcf, 0x0e, 0x15, 0x01, 0x2b, 0xdb, 0x03, 0x80, 0x45, 0xc0, 0xdf, 0x49, 0xb0,
+	0xd8, 0xed, 0x1e, 0xfd, 0xa2, 0x4f, 0x87, 0x71, 0xa2, 0xf2, 0xc3, 0x4b, 0x12, 0x85, 0xaa, 0x3f,
+	0x48, 0xb0, 0x9c, 0x34, 0x8e, 0xfd, 0xa0, 0xbf, 0x87, 0xa5, 0x23, 0x59, 0xd9, 0xb9, 0x02, 0x59,
+	0x28, 0x7c, 0x29, 0xc1, 0x42, 0x97, 0x59, 0xaa, 0xdf, 0x96, 0x1d, 0xe3, 0x29, 0x77, 0x2f, 0xc7,
+	0x13, 0x92, 0xfe, 0x28, 0xc1, 0xc7, 0x89, 0xff, 0xd6, 0xfa, 0x6a, 0xe0, 0x00, 0xd1, 0x26, 0xbc,
+	0x7b, 0x15, 0x76, 0xec, 0x4e, 0x88, 0x0f, 0x4c, 0x7d, 0xdc, 0x09, 0x31, 0x8a, 0x72, 0x67, 0x60,
+	0x8a, 0xd0, 0xd0, 0x80, 0x54, 0xeb, 0x4c, 0xb5, 0xd9, 0x4f, 0x53, 0x10, 0x70, 0xe5, 0xf3, 0x81,
+	0xe0, 0xd1, 0x67, 0x3b, 0x3a, 0xf1, 0xf4, 0x7c, 0xb6, 0x23, 0x60, 0x65, 0x7b, 0x00, 0x70, 0x18,
+	0x50, 0x19, 0xfd, 0xe5, 0xfb, 0x57, 0x1b, 0xd2, 0xfd, 0xaf, 0x5f, 0xbf, 0xcd, 0x4a, 0x6f, 0xde,
+	0x66, 0xa5, 0x7f, 0xbd, 0xcd, 0x4a, 0x2f, 0xdf, 0x65, 0x87, 0xde, 0xbc, 0xcb, 0x0e, 0xfd, 0xe3,
+	0x5d, 0x76, 0xe8, 0xe9, 0x67, 0xa6, 0xe5, 0x55, 0x1b, 0xe5, 0xbc, 0x4e, 0x6a, 0xec, 0xb7, 0x85,
+	0xcd, 0xe0, 0x67, 0x06, 0x87, 0x18, 0xb8, 0x70, 0x1e, 0xf9, 0x75, 0xe4, 0xa2, 0x8e, 0x69, 0x79,
+	0x8c, 0xfd, 0x92, 0xb0, 0xfd, 0xbf, 0x01, 0x00, 0xd8, 0xa9, 0x34, 0x95, 0x87, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1736,6 +2035,9 @@ type MsgClient interface {
 	UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpdateGasPriceIncreaseFlags, opts ...grpc.CallOption) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
 	UpdateOperationalFlags(ctx context.Context, in *MsgUpdateOperationalFlags, opts ...grpc.CallOption) (*MsgUpdateOperationalFlagsResponse, error)
 	UpdateOperationalChainParams(ctx context.Context, in *MsgUpdateOperationalChainParams, opts ...grpc.CallOption) (*MsgUpdateOperationalChainParamsResponse, error)
+	RejoinObserverSet(ctx context.Context, in *MsgRejoinObserverSet, opts ...grpc.CallOption) (*MsgRejoinObserverSetResponse, error)
+	VoteHaltChain(ctx context.Context, in *MsgVoteHaltChain, opts ...grpc.CallOption) (*MsgVoteHaltChainResponse, error)
+	UnhaltChain(ctx context.Context, in *MsgUnhaltChain, opts ...grpc.CallOption) (*MsgUnhaltChainResponse, error)
 }
 
 type msgClient struct {
@@ -1881,6 +2183,33 @@ func (c *msgClient) UpdateOperationalChainParams(ctx context.Context, in *MsgUpd
 	return out, nil
 }
 
+func (c *msgClient) RejoinObserverSet(ctx context.Context, in *MsgRejoinObserverSet, opts ...grpc.CallOption) (*MsgRejoinObserverSetResponse, error) {
+	out := new(MsgRejoinObserverSetResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/RejoinObserverSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteHaltChain(ctx context.Context, in *MsgVoteHaltChain, opts ...grpc.CallOption) (*MsgVoteHaltChainResponse, error) {
+	out := new(MsgVoteHaltChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteHaltChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnhaltChain(ctx context.Context, in *MsgUnhaltChain, opts ...grpc.CallOption) (*MsgUnhaltChainResponse, error) {
+	out := new(MsgUnhaltChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UnhaltChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
@@ -1898,6 +2227,9 @@ type MsgServer interface {
 	UpdateGasPriceIncreaseFlags(context.Context, *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
 	UpdateOperationalFlags(context.Context, *MsgUpdateOperationalFlags) (*MsgUpdateOperationalFlagsResponse, error)
 	UpdateOperationalChainParams(context.Context, *MsgUpdateOperationalChainParams) (*MsgUpdateOperationalChainParamsResponse, error)
+	RejoinObserverSet(context.Context, *MsgRejoinObserverSet) (*MsgRejoinObserverSetResponse, error)
+	VoteHaltChain(context.Context, *MsgVoteHaltChain) (*MsgVoteHaltChainResponse, error)
+	UnhaltChain(context.Context, *MsgUnhaltChain) (*MsgUnhaltChainResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1949,6 +2281,15 @@ func (*UnimplementedMsgServer) UpdateOperationalFlags(ctx context.Context, req *
 func (*UnimplementedMsgServer) UpdateOperationalChainParams(ctx context.Context, req *MsgUpdateOperationalChainParams) (*MsgUpdateOperationalChainParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperationalChainParams not implemented")
 }
+func (*UnimplementedMsgServer) RejoinObserverSet(ctx context.Context, req *MsgRejoinObserverSet) (*MsgRejoinObserverSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejoinObserverSet not implemented")
+}
+func (*UnimplementedMsgServer) VoteHaltChain(ctx context.Context, req *MsgVoteHaltChain) (*MsgVoteHaltChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteHaltChain not implemented")
+}
+func (*UnimplementedMsgServer) UnhaltChain(ctx context.Context, req *MsgUnhaltChain) (*MsgUnhaltChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhaltChain not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2224,6 +2565,60 @@ func _Msg_UpdateOperationalChainParams_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RejoinObserverSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejoinObserverSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejoinObserverSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/RejoinObserverSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejoinObserverSet(ctx, req.(*MsgRejoinObserverSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteHaltChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteHaltChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteHaltChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/VoteHaltChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteHaltChain(ctx, req.(*MsgVoteHaltChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnhaltChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnhaltChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnhaltChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UnhaltChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnhaltChain(ctx, req.(*MsgUnhaltChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.observer.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2288,6 +2683,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOperationalChainParams",
 			Handler:    _Msg_UpdateOperationalChainParams_Handler,
 		},
+		{
+			MethodName: "RejoinObserverSet",
+			Handler:    _Msg_RejoinObserverSet_Handler,
+		},
+		{
+			MethodName: "VoteHaltChain",
+			Handler:    _Msg_VoteHaltChain_Handler,
+		},
+		{
+			MethodName: "UnhaltChain",
+			Handler:    _Msg_UnhaltChain_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/observer/tx.proto",
@@ -3387,16 +3794,212 @@ func (m *MsgDisableFastConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRejoinObserverSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejoinObserverSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejoinObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejoinObserverSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejoinObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejoinObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteHaltChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteHaltChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteHaltChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteHaltChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteHaltChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteHaltChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteFinalized {
+		i--
+		if m.VoteFinalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BallotCreated {
+		i--
+		if m.BallotCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnhaltChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnhaltChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnhaltChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnhaltChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnhaltChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnhaltChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *MsgUpdateObserver) Size() (n int) {
 	if m == nil {
@@ -3853,63 +4456,145 @@ func (m *MsgDisableFastConfirmationResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRejoinObserverSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRejoinObserverSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+
+func (m *MsgVoteHaltChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteHaltChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BallotCreated {
+		n += 2
+	}
+	if m.VoteFinalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUnhaltChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *MsgUnhaltChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -5928,27 +6613,536 @@ func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.VoteFinalized = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenSuccess", wireType)
+			m.VoteFinalized = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeygenSuccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableCCTX: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableInbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableInbound = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOutbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableOutbound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableCCTX: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableInbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableInbound = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableOutbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableOutbound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.KeygenSuccess = bool(v != 0)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5970,7 +7164,7 @@ func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5993,10 +7187,10 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableCCTX: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOperationalFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOperationalFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6032,10 +7226,10 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableInbound", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationalFlags", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6045,32 +7239,25 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableInbound = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOutbound", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.EnableOutbound = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperationalFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6092,7 +7279,7 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6115,10 +7302,10 @@ func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOperationalFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOperationalFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6142,7 +7329,7 @@ func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6165,10 +7352,10 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableCCTX: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableFastConfirmation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableFastConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6205,29 +7392,9 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableInbound", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableInbound = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableOutbound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var v int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6237,12 +7404,11 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6264,7 +7430,7 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableFastConfirmationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6287,10 +7453,10 @@ func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableFastConfirmationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableFastConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6314,7 +7480,7 @@ func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
+func (m *MsgRejoinObserverSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6337,10 +7503,10 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejoinObserverSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejoinObserverSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6375,39 +7541,6 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6429,7 +7562,7 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejoinObserverSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6452,10 +7585,10 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejoinObserverSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejoinObserverSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6479,7 +7612,7 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteHaltChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6502,10 +7635,10 @@ func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOperationalFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteHaltChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOperationalFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteHaltChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6541,10 +7674,29 @@ func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationalFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6554,24 +7706,23 @@ func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OperationalFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6594,7 +7745,7 @@ func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteHaltChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6617,12 +7768,52 @@ func (m *MsgUpdateOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOperationalFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteHaltChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOperationalFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteHaltChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BallotCreated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VoteFinalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6644,7 +7835,7 @@ func (m *MsgUpdateOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
+func (m *MsgUnhaltChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6667,10 +7858,10 @@ func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableFastConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnhaltChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableFastConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnhaltChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6745,7 +7936,7 @@ func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableFastConfirmationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnhaltChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6768,10 +7959,10 @@ func (m *MsgDisableFastConfirmationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableFastConfirmationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnhaltChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableFastConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnhaltChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
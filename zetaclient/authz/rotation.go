@@ -0,0 +1,45 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/pkg/errors"
+
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// HotkeyRotationMsgs returns the messages rotating the hotkey of the granter:
+// the new grantee is granted all the zetaclient tx types and, if set, the grants of the old grantee are revoked.
+// The messages must be signed by the granter, i.e. the operator key.
+func HotkeyRotationMsgs(granter, newGrantee, oldGrantee sdk.AccAddress) ([]sdk.Msg, error) {
+	switch {
+	case granter.Empty():
+		return nil, errors.New("granter is empty")
+	case newGrantee.Empty():
+		return nil, errors.New("new grantee is empty")
+	case newGrantee.Equals(oldGrantee):
+		return nil, errors.New("new grantee is the old grantee")
+	}
+
+	txTypes := crosschaintypes.GetAllAuthzZetaclientTxTypes()
+	msgs := make([]sdk.Msg, 0, 2*len(txTypes))
+
+	for _, txType := range txTypes {
+		grant, err := sdkauthz.NewMsgGrant(granter, newGrantee, sdkauthz.NewGenericAuthorization(txType), nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to create grant for %s", txType)
+		}
+		msgs = append(msgs, grant)
+	}
+
+	if oldGrantee.Empty() {
+		return msgs, nil
+	}
+
+	for _, txType := range txTypes {
+		revoke := sdkauthz.NewMsgRevoke(granter, oldGrantee, txType)
+		msgs = append(msgs, &revoke)
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,61 @@
+package authz_test
+
+import (
+	"testing"
+
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/authz"
+)
+
+func TestHotkeyRotationMsgs(t *testing.T) {
+	var (
+		granter    = sample.Bech32AccAddress()
+		newGrantee = sample.Bech32AccAddress()
+		oldGrantee = sample.Bech32AccAddress()
+		txTypes    = crosschaintypes.GetAllAuthzZetaclientTxTypes()
+	)
+
+	t.Run("grants the new grantee and revokes the old grantee", func(t *testing.T) {
+		msgs, err := authz.HotkeyRotationMsgs(granter, newGrantee, oldGrantee)
+		require.NoError(t, err)
+		require.Len(t, msgs, 2*len(txTypes))
+
+		for i, txType := range txTypes {
+			grant, ok := msgs[i].(*sdkauthz.MsgGrant)
+			require.True(t, ok)
+			require.Equal(t, granter.String(), grant.Granter)
+			require.Equal(t, newGrantee.String(), grant.Grantee)
+			require.Nil(t, grant.Grant.Expiration)
+
+			authorization, err := grant.GetAuthorization()
+			require.NoError(t, err)
+			require.Equal(t, txType, authorization.MsgTypeURL())
+
+			revoke, ok := msgs[len(txTypes)+i].(*sdkauthz.MsgRevoke)
+			require.True(t, ok)
+			require.Equal(t, granter.String(), revoke.Granter)
+			require.Equal(t, oldGrantee.String(), revoke.Grantee)
+			require.Equal(t, txType, revoke.MsgTypeUrl)
+		}
+	})
+
+	t.Run("only grants without old grantee", func(t *testing.T) {
+		msgs, err := authz.HotkeyRotationMsgs(granter, newGrantee, nil)
+		require.NoError(t, err)
+		require.Len(t, msgs, len(txTypes))
+	})
+
+	t.Run("fails if the grantee is unchanged", func(t *testing.T) {
+		_, err := authz.HotkeyRotationMsgs(granter, newGrantee, newGrantee)
+		require.ErrorContains(t, err, "new grantee is the old grantee")
+	})
+
+	t.Run("fails without granter", func(t *testing.T) {
+		_, err := authz.HotkeyRotationMsgs(nil, newGrantee, oldGrantee)
+		require.ErrorContains(t, err, "granter is empty")
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/zeta-chain/node/zetaclient/db"
 	"github.com/zeta-chain/node/zetaclient/logs"
 	"github.com/zeta-chain/node/zetaclient/metrics"
+	"github.com/zeta-chain/node/zetaclient/rpcpool"
 	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 	"github.com/zeta-chain/node/zetaclient/zetacore"
 )
@@ -59,12 +60,21 @@ type Observer struct {
 
 	blockCache *lru.Cache
 
+	// inboundBlockTimes caches the time of the blocks containing inbounds, by block height
+	inboundBlockTimes *lru.Cache
+
 	// db is the database to persist data
 	db *db.DB
 
+	// votesPrunedAt is the unix time of the last pruning of the vote history
+	votesPrunedAt atomic.Int64
+
 	// ts is the telemetry server for metrics
 	ts *metrics.TelemetryServer
 
+	// rpcPool is the pool of RPC endpoints of the observed chain, nil if a single endpoint is configured
+	rpcPool *rpcpool.Pool
+
 	// logger contains the loggers used by observer
 	logger ObserverLogger
 
@@ -93,20 +103,26 @@ func NewObserver(
 		return nil, errors.Wrap(err, "error creating block cache")
 	}
 
+	inboundBlockTimes, err := lru.New(blockCacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating inbound block time cache")
+	}
+
 	return &Observer{
-		chain:            chain,
-		chainParams:      chainParams,
-		zetacoreClient:   zetacoreClient,
-		tss:              tss,
-		lastBlock:        0,
-		lastBlockScanned: 0,
-		lastTxScanned:    "",
-		ts:               ts,
-		db:               database,
-		blockCache:       blockCache,
-		mu:               &sync.Mutex{},
-		logger:           newObserverLogger(chain, logger),
-		stop:             make(chan struct{}),
+		chain:             chain,
+		chainParams:       chainParams,
+		zetacoreClient:    zetacoreClient,
+		tss:               tss,
+		lastBlock:         0,
+		lastBlockScanned:  0,
+		lastTxScanned:     "",
+		ts:                ts,
+		db:                database,
+		blockCache:        blockCache,
+		inboundBlockTimes: inboundBlockTimes,
+		mu:                &sync.Mutex{},
+		logger:            newObserverLogger(chain, logger),
+		stop:              make(chan struct{}),
 	}, nil
 }
 
@@ -239,6 +255,17 @@ func (ob *Observer) WithLastTxScanned(txHash string) *Observer {
 	return ob
 }
 
+// RPCPool returns the pool of RPC endpoints, nil if a single endpoint is configured.
+func (ob *Observer) RPCPool() *rpcpool.Pool {
+	return ob.rpcPool
+}
+
+// WithRPCPool set the pool of RPC endpoints.
+func (ob *Observer) WithRPCPool(pool *rpcpool.Pool) *Observer {
+	ob.rpcPool = pool
+	return ob
+}
+
 // BlockCache returns the block cache for the observer.
 func (ob *Observer) BlockCache() *lru.Cache {
 	return ob.blockCache
@@ -419,11 +446,31 @@ func (ob *Observer) PostVoteInbound(
 		ob.logger.Inbound.Info().Fields(lf).Msg("inbound detected: already voted on ballot")
 	default:
 		ob.logger.Inbound.Info().Fields(lf).Msgf("inbound detected: vote posted")
+		ob.RecordVote(ctx, clienttypes.ToInboundVoteSQLType(msg, ballot, zetaHash))
+		ob.reportInboundVoteLatency(msg.InboundBlockHeight)
 	}
 
 	return ballot, nil
 }
 
+// SetInboundBlockTime sets the time of the block containing inbounds at the given height.
+// It's used to measure the latency of the inbound votes.
+func (ob *Observer) SetInboundBlockTime(height uint64, blockTime time.Time) {
+	ob.inboundBlockTimes.Add(height, blockTime)
+}
+
+// reportInboundVoteLatency records the latency between the inbound block time and the vote as a metric
+func (ob *Observer) reportInboundVoteLatency(height uint64) {
+	value, ok := ob.inboundBlockTimes.Get(height)
+	if !ok {
+		return
+	}
+
+	if blockTime, ok := value.(time.Time); ok {
+		metrics.InboundVoteLatency.WithLabelValues(ob.chain.Name).Observe(time.Since(blockTime).Seconds())
+	}
+}
+
 // ReportBlockLatency records the latency between the current time
 // an the latest block time for a chain as a metric
 func (ob *Observer) ReportBlockLatency(latestBlockTime time.Time) {
@@ -432,6 +479,34 @@ func (ob *Observer) ReportBlockLatency(latestBlockTime time.Time) {
 	metrics.LatestBlockLatency.WithLabelValues(ob.chain.Name).Set(elapsedTime.Seconds())
 }
 
+// CheckRPCHealth runs the RPC health check and returns the latest block time.
+// If a pool of endpoints is configured, every endpoint is checked and the unhealthy ones are reported.
+func (ob *Observer) CheckRPCHealth(
+	ctx context.Context,
+	check func(ctx context.Context) (time.Time, error),
+) (time.Time, error) {
+	if ob.rpcPool == nil {
+		return check(ctx)
+	}
+
+	blockTime, err := ob.rpcPool.CheckHealth(ctx, check)
+
+	for _, status := range ob.rpcPool.Status() {
+		if status.Healthy && !status.Stale {
+			continue
+		}
+		ob.logger.Chain.Warn().
+			Str("rpc.endpoint", status.Endpoint).
+			Bool("rpc.healthy", status.Healthy).
+			Bool("rpc.stale", status.Stale).
+			Int("rpc.failures", status.Failures).
+			Time("rpc.block_time", status.BlockTime).
+			Msg("RPC endpoint is degraded")
+	}
+
+	return blockTime, err
+}
+
 // EnvVarLatestBlockByChain returns the environment variable for the last block by chain.
 func EnvVarLatestBlockByChain(chain chains.Chain) string {
 	return fmt.Sprintf("CHAIN_%d_SCAN_FROM_BLOCK", chain.ChainId)
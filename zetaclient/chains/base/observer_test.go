@@ -6,6 +6,10 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -20,7 +24,9 @@ import (
 	"github.com/zeta-chain/node/zetaclient/config"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
 	"github.com/zeta-chain/node/zetaclient/db"
+	"github.com/zeta-chain/node/zetaclient/metrics"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 )
 
 const (
@@ -526,6 +532,67 @@ func TestPostVoteInbound(t *testing.T) {
 		require.Equal(t, "sampleBallotIndex", ballot)
 	})
 
+	t.Run("should record posted vote in vote history", func(t *testing.T) {
+		// create observer
+		ob := newTestSuite(t, chains.Ethereum)
+
+		ob.zetacore.WithPostVoteInbound("sampleZetaTxHash", "sampleBallotIndex")
+
+		// post vote inbound
+		msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+		_, err := ob.PostVoteInbound(context.TODO(), &msg, 100000)
+		require.NoError(t, err)
+
+		// check vote history
+		votes, err := ob.DB().QueryVotes(db.VoteFilter{BallotIndex: "sampleBallotIndex"})
+		require.NoError(t, err)
+		require.Len(t, votes, 1)
+		require.Equal(t, clienttypes.VoteTypeInbound, votes[0].Type)
+		require.Equal(t, chains.Ethereum.ChainId, votes[0].ChainID)
+		require.Equal(t, msg.InboundHash, votes[0].TxHash)
+		require.Equal(t, msg.Amount.String(), votes[0].Amount)
+		require.Equal(t, "sampleZetaTxHash", votes[0].ZetaTxHash)
+	})
+
+	t.Run("should not record vote if already voted", func(t *testing.T) {
+		// create observer
+		ob := newTestSuite(t, chains.Ethereum)
+
+		ob.zetacore.WithPostVoteInbound("", "sampleBallotIndex")
+
+		// post vote inbound
+		msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+		_, err := ob.PostVoteInbound(context.TODO(), &msg, 100000)
+		require.NoError(t, err)
+
+		// check vote history
+		votes, err := ob.DB().QueryVotes(db.VoteFilter{})
+		require.NoError(t, err)
+		require.Empty(t, votes)
+	})
+
+	t.Run("should report the inbound vote latency", func(t *testing.T) {
+		// create observer
+		ob := newTestSuite(t, chains.Ethereum)
+
+		ob.zetacore.WithPostVoteInbound("sampleZetaTxHash", "sampleBallotIndex")
+
+		// set the time of the inbound block
+		msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+		ob.SetInboundBlockTime(msg.InboundBlockHeight, time.Now().Add(-time.Minute))
+
+		// post vote inbound
+		_, err := ob.PostVoteInbound(context.TODO(), &msg, 100000)
+		require.NoError(t, err)
+
+		// check the latency is reported
+		histogram := metrics.InboundVoteLatency.WithLabelValues(chains.Ethereum.Name).(prometheus.Histogram)
+		var m dto.Metric
+		require.NoError(t, histogram.Write(&m))
+		require.EqualValues(t, 1, m.GetHistogram().GetSampleCount())
+		require.GreaterOrEqual(t, m.GetHistogram().GetSampleSum(), time.Minute.Seconds())
+	})
+
 	t.Run("should not post vote if message basic validation fails", func(t *testing.T) {
 		// create observer
 		ob := newTestSuite(t, chains.Ethereum)
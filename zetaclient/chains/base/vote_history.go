@@ -0,0 +1,53 @@
+package base
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeta-chain/node/zetaclient/config"
+	zctx "github.com/zeta-chain/node/zetaclient/context"
+	"github.com/zeta-chain/node/zetaclient/logs"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
+)
+
+// votesPruneInterval is the minimum interval between two prunings of the vote history
+const votesPruneInterval = time.Hour
+
+// RecordVote saves a vote posted to zetacore into the vote history of the database
+// and prunes the votes older than the configured retention.
+// Failures are only logged as the vote history is informational.
+func (ob *Observer) RecordVote(ctx context.Context, vote *clienttypes.VoteSQLType) {
+	logger := ob.logger.Chain.With().
+		Str(logs.FieldMethod, "RecordVote").
+		Str(logs.FieldBallot, vote.BallotIndex).
+		Logger()
+
+	if err := ob.db.SaveVote(vote); err != nil {
+		logger.Error().Err(err).Msg("unable to record vote")
+		return
+	}
+
+	// prune expired votes at most once per interval
+	now := time.Now()
+	prunedAt := ob.votesPrunedAt.Load()
+	if now.Unix()-prunedAt < int64(votesPruneInterval.Seconds()) {
+		return
+	}
+	if !ob.votesPrunedAt.CompareAndSwap(prunedAt, now.Unix()) {
+		return
+	}
+
+	retention := time.Duration(config.DefaultVoteHistoryRetention) * 24 * time.Hour
+	if app, err := zctx.FromContext(ctx); err == nil {
+		retention = app.Config().GetVoteHistoryRetention()
+	}
+
+	pruned, err := ob.db.PruneVotes(now.Add(-retention))
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to prune vote history")
+		return
+	}
+	if pruned > 0 {
+		logger.Info().Int64("pruned", pruned).Msg("pruned vote history")
+	}
+}
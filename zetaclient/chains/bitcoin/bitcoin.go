@@ -3,6 +3,7 @@ package bitcoin
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -21,6 +22,10 @@ type Bitcoin struct {
 	scheduler *scheduler.Scheduler
 	observer  *observer.Observer
 	signer    *signer.Signer
+
+	// feeBumpActive is true while the fee of the last outbound is being bumped,
+	// the next outbound spends the nonce-mark of the replacement tx so it is not signed meanwhile
+	feeBumpActive atomic.Bool
 }
 
 func New(scheduler *scheduler.Scheduler, observer *observer.Observer, signer *signer.Signer) *Bitcoin {
@@ -67,11 +72,11 @@ func (b *Bitcoin) Start(ctx context.Context) error {
 	})
 
 	optInboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsInboundObservationEnabled()
+		return !app.IsInboundObservationEnabled() || app.IsChainHalted(b.observer.Chain().ChainId)
 	})
 
 	optOutboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsOutboundObservationEnabled()
+		return !app.IsOutboundObservationEnabled() || app.IsChainHalted(b.observer.Chain().ChainId)
 	})
 
 	optGenericSkipper := scheduler.Skipper(func() bool {
@@ -163,11 +168,35 @@ func (b *Bitcoin) scheduleCCTX(ctx context.Context) error {
 			b.outboundLogger(outboundID).Error().Err(err).Msg("Schedule CCTX: VoteOutboundIfConfirmed failed")
 			continue
 		case !continueKeysign:
+			// try bumping the fee of the last outbound if it's still pending in mempool
+			nextOutboundID := ""
+			if idx+1 < len(cctxList) {
+				nextOutboundID = base.OutboundIDFromCCTX(cctxList[idx+1])
+			}
+			if b.shouldBumpFee(outboundID, nextOutboundID, nonce, zetaHeight, scheduleInterval) {
+				b.feeBumpActive.Store(true)
+				go func() {
+					defer b.feeBumpActive.Store(false)
+					b.signer.TryBumpOutboundFee(
+						ctx,
+						cctx,
+						b.observer,
+						b.observer.ZetacoreClient(),
+						zetaHeight,
+					)
+				}()
+				continue
+			}
+
 			b.outboundLogger(outboundID).Info().Msg("Schedule CCTX: outbound already processed")
 			continue
 		case nonce > b.observer.GetPendingNonce():
 			// stop if the nonce being processed is higher than the pending nonce
 			return nil
+		case b.feeBumpActive.Load():
+			// stop until the fee bump of the previous outbound is done, its nonce-mark output is being replaced
+			b.outboundLogger(outboundID).Info().Msg("Schedule CCTX: waiting for fee bump of previous outbound")
+			return nil
 		case int64(idx) >= lookahead:
 			// stop if lookahead is reached 2 bitcoin confirmations span is 20 minutes on average.
 			// We look ahead up to 100 pending cctx to target TPM of 5.
@@ -195,6 +224,27 @@ func (b *Bitcoin) scheduleCCTX(ctx context.Context) error {
 	return nil
 }
 
+// shouldBumpFee returns true if the pending outbound should be checked for a fee bump (RBF/CPFP).
+// Only the last outbound can be replaced, otherwise its descendants would be evicted from mempool.
+// Nonce 0 is excluded as it has no nonce-mark input to prevent duplicate payments.
+// The outbound is not replaced while the next one is being signed, as it spends the nonce-mark of the outbound.
+func (b *Bitcoin) shouldBumpFee(outboundID, nextOutboundID string, nonce, zetaHeight, scheduleInterval uint64) bool {
+	switch {
+	case nonce == 0:
+		return false
+	case b.feeBumpActive.Load():
+		return false
+	case b.signer.IsOutboundActive(outboundID):
+		return false
+	case nextOutboundID != "" && b.signer.IsOutboundActive(nextOutboundID):
+		return false
+	case nonce%scheduleInterval != zetaHeight%scheduleInterval:
+		return false
+	default:
+		return b.observer.IsLastOutboundPending(nonce)
+	}
+}
+
 func (b *Bitcoin) updateChainParams(ctx context.Context) error {
 	// no changes for signer
 
@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -42,12 +43,14 @@ import (
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/logs"
 	"github.com/zeta-chain/node/zetaclient/metrics"
+	"github.com/zeta-chain/node/zetaclient/rpcpool"
 )
 
 // Client Bitcoin RPC client
 type Client struct {
 	hostURL    string
 	client     *http.Client
+	pool       *rpcpool.Pool
 	clientName string
 	isRegnet   bool
 	config     config.BTCConfig
@@ -70,6 +73,9 @@ const (
 	commandID = uint64(1)
 )
 
+// QuorumMethods are the methods that must be agreed on by the quorum of hosts
+var QuorumMethods = []string{"getblockhash", "getblock", "getblockheader", "getrawtransaction"}
+
 var _ client = (*Client)(nil)
 
 func WithHTTP(httpClient *http.Client) Opt {
@@ -101,6 +107,22 @@ func New(cfg config.BTCConfig, chainID int64, logger zerolog.Logger, opts ...Opt
 			Logger(),
 	}
 
+	// fail over between the hosts if several are configured
+	if hosts := cfg.AllRPCHosts(); len(hosts) > 1 {
+		urls := make([]string, len(hosts))
+		for i, host := range hosts {
+			urls[i] = normalizeHostURL(host, true)
+		}
+
+		pool, err := rpcpool.New(clientName, urls, rpcpool.WithQuorum(cfg.RPCQuorum, QuorumMethods...))
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create rpc pool")
+		}
+
+		c.pool = pool
+		c.client.Transport = pool
+	}
+
 	for _, opt := range opts {
 		opt(c)
 	}
@@ -108,6 +130,11 @@ func New(cfg config.BTCConfig, chainID int64, logger zerolog.Logger, opts ...Opt
 	return c, nil
 }
 
+// Pool returns the pool of RPC hosts, nil if a single host is configured
+func (c *Client) Pool() *rpcpool.Pool {
+	return c.pool
+}
+
 // send sends RPC command to the server via http post request
 func (c *Client) sendCommand(ctx context.Context, cmd any) (json.RawMessage, error) {
 	method, reqBody, err := c.marshalCmd(cmd)
@@ -184,6 +211,11 @@ func (c *Client) sendRequest(req *http.Request, method string) (out rawResponse,
 }
 
 func (c *Client) recordMetrics(method string, start time.Time, out rawResponse, err error) {
+	// the pool records the metrics of each host
+	if c.pool != nil {
+		return
+	}
+
 	dur := time.Since(start).Seconds()
 
 	status := "ok"
@@ -191,8 +223,13 @@ func (c *Client) recordMetrics(method string, start time.Time, out rawResponse,
 		status = "failed"
 	}
 
-	metrics.RPCClientCounter.WithLabelValues(status, c.clientName, method).Inc()
-	metrics.RPCClientDuration.WithLabelValues(c.clientName).Observe(dur)
+	endpoint := c.hostURL
+	if u, err := url.Parse(c.hostURL); err == nil {
+		endpoint = u.Host
+	}
+
+	metrics.RPCClientCounter.WithLabelValues(status, c.clientName, method, endpoint).Inc()
+	metrics.RPCClientDuration.WithLabelValues(c.clientName, endpoint).Observe(dur)
 }
 
 func (c *Client) marshalCmd(cmd any) (string, []byte, error) {
@@ -2,6 +2,7 @@ package observer
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -17,7 +18,6 @@ import (
 	"github.com/zeta-chain/node/pkg/memo"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/compliance"
-	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/logs"
 	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 )
@@ -53,15 +53,16 @@ type BTCInboundEvent struct {
 }
 
 // Category returns the category of the inbound event
-func (event *BTCInboundEvent) Category() clienttypes.InboundCategory {
+func (event *BTCInboundEvent) Category(ctx context.Context) clienttypes.InboundCategory {
 	// compliance check on sender and receiver addresses
-	if config.ContainRestrictedAddress(event.FromAddress, event.ToAddress) {
+	if compliance.ContainRestrictedAddress(ctx, event.FromAddress, event.ToAddress) {
 		return clienttypes.InboundCategoryRestricted
 	}
 
 	// compliance check on receiver, revert/abort addresses in standard memo
 	if event.MemoStd != nil {
-		if config.ContainRestrictedAddress(
+		if compliance.ContainRestrictedAddress(
+			ctx,
 			event.MemoStd.Receiver.Hex(),
 			event.MemoStd.RevertOptions.RevertAddress,
 			event.MemoStd.RevertOptions.AbortAddress,
@@ -159,10 +160,10 @@ func ValidateStandardMemo(memoStd memo.InboundMemo, chainID int64) error {
 }
 
 // IsEventProcessable checks if the inbound event is processable
-func (ob *Observer) IsEventProcessable(event BTCInboundEvent) bool {
+func (ob *Observer) IsEventProcessable(ctx context.Context, event BTCInboundEvent) bool {
 	logFields := map[string]any{logs.FieldTx: event.TxHash}
 
-	switch category := event.Category(); category {
+	switch category := event.Category(ctx); category {
 	case clienttypes.InboundCategoryProcessable:
 		return true
 	case clienttypes.InboundCategoryDonation:
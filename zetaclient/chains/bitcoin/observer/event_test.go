@@ -1,6 +1,7 @@
 package observer_test
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 	"testing"
@@ -112,7 +113,7 @@ func Test_Category(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := tt.event.Category()
+			result := tt.event.Category(context.Background())
 			require.Equal(t, tt.expected, result)
 		})
 	}
@@ -346,7 +347,7 @@ func Test_IsEventProcessable(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ob.IsEventProcessable(tt.event)
+			result := ob.IsEventProcessable(context.Background(), tt.event)
 			require.Equal(t, tt.result, result)
 		})
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -70,6 +71,7 @@ func (ob *Observer) observeInboundInBlockRange(ctx context.Context, startBlock,
 		if len(res.Block.Tx) <= 1 {
 			continue
 		}
+		ob.SetInboundBlockTime(blockNumber, time.Unix(res.Block.Time, 0))
 
 		// filter incoming txs to TSS address
 		tssAddress := ob.TSSAddressString()
@@ -91,7 +93,7 @@ func (ob *Observer) observeInboundInBlockRange(ctx context.Context, startBlock,
 
 		// post inbound vote message to zetacore
 		for _, event := range events {
-			msg := ob.GetInboundVoteFromBtcEvent(event)
+			msg := ob.GetInboundVoteFromBtcEvent(ctx, event)
 			if msg != nil {
 				_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 				if err != nil {
@@ -148,7 +150,7 @@ func FilterAndParseIncomingTx(
 //   - a valid MsgVoteInbound message, or
 //   - nil if no valid message can be created for whatever reasons:
 //     invalid data, not processable, invalid amount, etc.
-func (ob *Observer) GetInboundVoteFromBtcEvent(event *BTCInboundEvent) *types.MsgVoteInbound {
+func (ob *Observer) GetInboundVoteFromBtcEvent(ctx context.Context, event *BTCInboundEvent) *types.MsgVoteInbound {
 	// prepare logger fields
 	lf := map[string]any{
 		logs.FieldMethod: "GetInboundVoteFromBtcEvent",
@@ -163,7 +165,7 @@ func (ob *Observer) GetInboundVoteFromBtcEvent(event *BTCInboundEvent) *types.Ms
 	}
 
 	// check if the event is processable
-	if !ob.IsEventProcessable(*event) {
+	if !ob.IsEventProcessable(ctx, *event) {
 		return nil
 	}
 
@@ -212,7 +212,7 @@ func Test_GetInboundVoteFromBtcEvent(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			msg := ob.GetInboundVoteFromBtcEvent(tt.event)
+			msg := ob.GetInboundVoteFromBtcEvent(context.Background(), tt.event)
 			if tt.nilVote {
 				require.Nil(t, msg)
 			} else {
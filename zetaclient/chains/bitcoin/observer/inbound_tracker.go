@@ -87,7 +87,7 @@ func (ob *Observer) CheckReceiptForBtcTxHash(ctx context.Context, txHash string,
 		return "", errors.New("no btc deposit event found")
 	}
 
-	msg := ob.GetInboundVoteFromBtcEvent(event)
+	msg := ob.GetInboundVoteFromBtcEvent(ctx, event)
 	if msg == nil {
 		return "", errors.New("no message built for btc sent to TSS")
 	}
@@ -18,6 +18,8 @@ import (
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/compliance"
 	"github.com/zeta-chain/node/zetaclient/logs"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 	"github.com/zeta-chain/node/zetaclient/zetacore"
 )
 
@@ -212,6 +214,8 @@ func (ob *Observer) VoteOutboundIfConfirmed(ctx context.Context, cctx *crosschai
 			Msg("VoteOutboundIfConfirmed: error confirming bitcoin outbound")
 	} else if zetaHash != "" {
 		ob.Logger().Outbound.Info().Fields(logFields).Msgf("VoteOutboundIfConfirmed: confirmed Bitcoin outbound")
+		ob.RecordVote(ctx, clienttypes.ToOutboundVoteSQLType(msg, ballot, zetaHash))
+		metrics.OutboundConfirmed(ob.Chain().Name, msg.OutboundTssNonce)
 	}
 
 	return false, nil
@@ -352,6 +356,15 @@ func (ob *Observer) SetIncludedTx(nonce uint64, getTxResult *btcjson.GetTransact
 		if getTxResult.Confirmations > res.Confirmations {
 			ob.logger.Outbound.Info().Fields(lf).Msgf("bitcoin outbound got %d confirmations", getTxResult.Confirmations)
 		}
+	} else if nonce > 0 && res.Confirmations == 0 {
+		// for replacement hash:
+		//   - the prior outbound was replaced (RBF) while pending in mempool
+		//   - both txs spend the same nonce-mark UTXO (nonce - 1), so at most one of them can be mined
+		delete(ob.tssOutboundHashes, res.TxID)
+		ob.tssOutboundHashes[txHash] = true
+		ob.includedTxResults[outboundID] = getTxResult
+		lf["prior_outbound"] = res.TxID
+		ob.logger.Outbound.Info().Fields(lf).Msg("included replacement bitcoin outbound")
 	} else {
 		// for other hash:
 		// be alert for duplicate payment!!! As we got a new hash paying same cctx (for whatever reason).
@@ -363,6 +376,17 @@ func (ob *Observer) SetIncludedTx(nonce uint64, getTxResult *btcjson.GetTransact
 	}
 }
 
+// IsLastOutboundPending returns true if the given nonce is the last included outbound
+// and it is still pending in the mempool (0 confirmations), thus can be replaced by a higher fee.
+func (ob *Observer) IsLastOutboundPending(nonce uint64) bool {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	res, found := ob.includedTxResults[ob.OutboundID(nonce)]
+
+	return found && res.Confirmations == 0 && nonce+1 == ob.pendingNonce
+}
+
 // GetIncludedTx gets the receipt and transaction from memory
 func (ob *Observer) GetIncludedTx(nonce uint64) *btcjson.GetTransactionResult {
 	ob.Mu().Lock()
@@ -393,7 +417,7 @@ func (ob *Observer) checkTssOutboundResult(
 	}
 
 	// differentiate between normal and cancelled cctx
-	if compliance.IsCctxRestricted(cctx) || params.Amount.Uint64() < constant.BTCWithdrawalDustAmount {
+	if compliance.IsCctxRestricted(ctx, cctx) || params.Amount.Uint64() < constant.BTCWithdrawalDustAmount {
 		err = ob.checkTSSVoutCancelled(params, rawResult.Vout)
 		if err != nil {
 			return errors.Wrapf(
@@ -213,3 +213,43 @@ func TestCheckTSSVoutCancelled(t *testing.T) {
 		require.ErrorContains(t, err, "not match TSS address")
 	})
 }
+
+func TestSetIncludedTx(t *testing.T) {
+	t.Run("should replace pending outbound with RBF replacement", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t, nil)
+		ob.SetIncludedTx(5, &btcjson.GetTransactionResult{TxID: "original", Confirmations: 0})
+		require.True(t, ob.IsLastOutboundPending(5))
+		require.True(t, ob.IsTSSTransaction("original"))
+
+		// ACT
+		ob.SetIncludedTx(5, &btcjson.GetTransactionResult{TxID: "replacement", Confirmations: 0})
+
+		// ASSERT
+		require.Equal(t, "replacement", ob.GetIncludedTx(5).TxID)
+		require.False(t, ob.IsTSSTransaction("original"))
+		require.True(t, ob.IsTSSTransaction("replacement"))
+		require.Equal(t, uint64(6), ob.GetPendingNonce())
+	})
+
+	t.Run("should remove both outbounds if the prior one is already mined", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t, nil)
+		ob.SetIncludedTx(5, &btcjson.GetTransactionResult{TxID: "original", Confirmations: 1})
+		require.False(t, ob.IsLastOutboundPending(5))
+
+		// ACT
+		ob.SetIncludedTx(5, &btcjson.GetTransactionResult{TxID: "other", Confirmations: 0})
+
+		// ASSERT
+		require.Nil(t, ob.GetIncludedTx(5))
+		require.False(t, ob.IsTSSTransaction("original"))
+	})
+
+	t.Run("only the last outbound is pending for replacement", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t, nil)
+		ob.SetIncludedTx(5, &btcjson.GetTransactionResult{TxID: "tx5", Confirmations: 0})
+		ob.SetIncludedTx(6, &btcjson.GetTransactionResult{TxID: "tx6", Confirmations: 0})
+
+		require.False(t, ob.IsLastOutboundPending(5))
+		require.True(t, ob.IsLastOutboundPending(6))
+	})
+}
@@ -2,6 +2,7 @@ package observer
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -13,7 +14,9 @@ func (ob *Observer) CheckRPCStatus(ctx context.Context) error {
 		return errors.Wrap(err, "unable to get TSS BTC address")
 	}
 
-	blockTime, err := ob.rpc.Healthcheck(ctx, tssAddress)
+	blockTime, err := ob.CheckRPCHealth(ctx, func(ctx context.Context) (time.Time, error) {
+		return ob.rpc.Healthcheck(ctx, tssAddress)
+	})
 	switch {
 	case err != nil && !ob.isNodeEnabled():
 		// suppress error if node is disabled
@@ -1,6 +1,7 @@
 package signer
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strconv"
@@ -47,6 +48,7 @@ type OutboundData struct {
 
 // NewOutboundData creates OutboundData from the given CCTX.
 func NewOutboundData(
+	ctx context.Context,
 	cctx *types.CrossChainTx,
 	height uint64,
 	minRelayFee float64,
@@ -96,7 +98,7 @@ func NewOutboundData(
 	feeRate += satPerByte
 
 	// compliance check
-	restrictedCCTX := compliance.IsCctxRestricted(cctx)
+	restrictedCCTX := compliance.IsCctxRestricted(ctx, cctx)
 	if restrictedCCTX {
 		compliance.PrintComplianceLog(logger, loggerCompliance,
 			true, params.ReceiverChainId, cctx.Index, cctx.InboundParams.Sender, params.Receiver, "BTC")
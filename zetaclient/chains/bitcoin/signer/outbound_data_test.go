@@ -1,6 +1,7 @@
 package signer
 
 import (
+	"context"
 	"math"
 	"testing"
 
@@ -192,7 +193,14 @@ func Test_NewOutboundData(t *testing.T) {
 				tt.cctxModifier(tt.cctx)
 			}
 
-			outboundData, err := NewOutboundData(tt.cctx, tt.height, tt.minRelayFee, log.Logger, log.Logger)
+			outboundData, err := NewOutboundData(
+				context.Background(),
+				tt.cctx,
+				tt.height,
+				tt.minRelayFee,
+				log.Logger,
+				log.Logger,
+			)
 			if tt.errMsg != "" {
 				require.Nil(t, outboundData)
 				require.ErrorContains(t, err, tt.errMsg)
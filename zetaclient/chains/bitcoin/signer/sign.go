@@ -18,6 +18,7 @@ import (
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/common"
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/observer"
 	"github.com/zeta-chain/node/zetaclient/logs"
+	"github.com/zeta-chain/node/zetaclient/metrics"
 )
 
 const (
@@ -118,6 +119,7 @@ func AddTxInputs(tx *wire.MsgTx, utxos []btcjson.ListUnspentResult) ([]int64, er
 
 		outpoint := wire.NewOutPoint(hash, utxo.Vout)
 		txIn := wire.NewTxIn(outpoint, nil, nil)
+		txIn.Sequence = rbfTxInSequenceNum
 		tx.AddTxIn(txIn)
 
 		// store the amount for later signing use
@@ -223,6 +225,7 @@ func (signer *Signer) SignTx(
 	if err != nil {
 		return errors.Wrap(err, "SignBatch failed")
 	}
+	metrics.OutboundSigned(signer.Chain().Name, nonce)
 
 	// add witnesses to the tx
 	pkCompressed := signer.TSS().PubKey().Bytes(true)
@@ -0,0 +1,231 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/constant"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/common"
+)
+
+const (
+	// rbfTxInSequenceNum is the sequence number used by outbound inputs to signal replaceability (BIP-125)
+	rbfTxInSequenceNum = wire.MaxTxInSequenceNum - 2
+
+	// maxUnconfirmedAncestors is the maximum number of unconfirmed ancestors a replacement tx pays for.
+	// It is the default mempool ancestor limit of Bitcoin Core.
+	maxUnconfirmedAncestors = 25
+)
+
+// ErrFeeBumpNotNeeded is returned when the pending outbound already pays the fee rate of the cctx
+var ErrFeeBumpNotNeeded = errors.New("fee bump not needed")
+
+// SignRBFTx signs a replacement (BIP-125) of the pending outbound 'lastTx' that pays the fee rate of the cctx.
+//
+// The replacement spends exactly the same inputs as the pending outbound, so the previous nonce-mark UTXO
+// stays the 1st input and the nonce-mark output stays at index 0. Only the change output is reduced to pay
+// the additional fees. If the pending outbound has unconfirmed ancestors (e.g. stuck outbounds of previous nonces),
+// the replacement also pays for them, which makes it a child-pays-for-parent (CPFP) for the whole package.
+func (signer *Signer) SignRBFTx(
+	ctx context.Context,
+	txData *OutboundData,
+	lastTx *wire.MsgTx,
+	minRelayFee float64,
+) (*wire.MsgTx, error) {
+	// fees paid by the pending outbound
+	inAmounts, oldFee, err := signer.getTxFee(ctx, lastTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get fee of pending outbound")
+	}
+
+	// size and fees of unconfirmed ancestors
+	ancestorsVsize, ancestorsFee, err := signer.getUnconfirmedAncestors(ctx, lastTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get unconfirmed ancestors")
+	}
+
+	// the package (ancestors + replacement) should pay the fee rate of the cctx
+	txVsize := mempool.GetTxVirtualSize(btcutil.NewTx(lastTx))
+	newFee := txData.feeRate*(txVsize+ancestorsVsize) - ancestorsFee
+	if newFee <= oldFee {
+		return nil, ErrFeeBumpNotNeeded
+	}
+
+	// BIP-125 rule #4: the replacement must pay for its own bandwidth at the minimum relay fee rate
+	minFee := oldFee + common.FeeRateToSatPerByte(minRelayFee)*txVsize
+	if newFee < minFee {
+		newFee = minFee
+	}
+
+	// build the replacement tx
+	tx, err := signer.copyTxWithFeeBump(lastTx, txData.nonce, newFee-oldFee, txData.cancelTx)
+	if err != nil {
+		return nil, err
+	}
+
+	signer.Logger().Std.Info().
+		Uint64("tx.nonce", txData.nonce).
+		Str("tx.replaced", lastTx.TxID()).
+		Int64("tx.rate", txData.feeRate).
+		Int64("tx.old_fees", oldFee).
+		Int64("tx.new_fees", newFee).
+		Int64("tx.ancestors_vsize", ancestorsVsize).
+		Int64("tx.ancestors_fees", ancestorsFee).
+		Msg("signing bitcoin replacement outbound")
+
+	// sign the tx
+	if err := signer.SignTx(ctx, tx, inAmounts, txData.height, txData.nonce); err != nil {
+		return nil, errors.Wrap(err, "SignTx failed")
+	}
+
+	return tx, nil
+}
+
+// copyTxWithFeeBump copies the inputs and outputs of the tx and deducts the fee bump from the change output.
+// The outputs of an outbound are: [nonce-mark, payment (if not cancelled), change]
+func (signer *Signer) copyTxWithFeeBump(
+	lastTx *wire.MsgTx,
+	nonce uint64,
+	feeBump int64,
+	cancelTx bool,
+) (*wire.MsgTx, error) {
+	changeIndex := 2
+	if cancelTx {
+		changeIndex = 1
+	}
+	if len(lastTx.TxOut) != changeIndex+1 {
+		return nil, fmt.Errorf("no change output to pay fee bump of %d satoshis", feeBump)
+	}
+
+	payToSelfScript, err := signer.TSS().PubKey().BTCPayToAddrScript(signer.Chain().ChainId)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(lastTx.TxOut[changeIndex].PkScript, payToSelfScript) {
+		return nil, fmt.Errorf("change output %d is not paid to TSS", changeIndex)
+	}
+
+	// deduct the fee bump from the change
+	remainingSats := lastTx.TxOut[changeIndex].Value - feeBump
+	if remainingSats < constant.BTCWithdrawalDustAmount {
+		return nil, fmt.Errorf("change %d is not enough to pay fee bump of %d satoshis",
+			lastTx.TxOut[changeIndex].Value, feeBump)
+	} else if remainingSats == chains.NonceMarkAmount(nonce) {
+		signer.Logger().Std.Info().Msgf("adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
+		remainingSats--
+	}
+
+	tx := wire.NewMsgTx(lastTx.Version)
+	tx.LockTime = lastTx.LockTime
+	for _, in := range lastTx.TxIn {
+		txIn := wire.NewTxIn(wire.NewOutPoint(&in.PreviousOutPoint.Hash, in.PreviousOutPoint.Index), nil, nil)
+		txIn.Sequence = rbfTxInSequenceNum
+		tx.AddTxIn(txIn)
+	}
+	for i, out := range lastTx.TxOut {
+		value := out.Value
+		if i == changeIndex {
+			value = remainingSats
+		}
+		tx.AddTxOut(wire.NewTxOut(value, out.PkScript))
+	}
+
+	return tx, nil
+}
+
+// getTxFee returns the input amounts and the fee (in satoshis) paid by the given tx
+func (signer *Signer) getTxFee(ctx context.Context, tx *wire.MsgTx) ([]int64, int64, error) {
+	var (
+		inAmounts = make([]int64, len(tx.TxIn))
+		fee       int64
+	)
+
+	for i, in := range tx.TxIn {
+		prevTx, err := signer.rpc.GetRawTransaction(ctx, &in.PreviousOutPoint.Hash)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "unable to get previous tx %s", in.PreviousOutPoint.Hash)
+		}
+
+		prevOuts := prevTx.MsgTx().TxOut
+		if int(in.PreviousOutPoint.Index) >= len(prevOuts) {
+			return nil, 0, fmt.Errorf("invalid previous outpoint %s", in.PreviousOutPoint)
+		}
+
+		inAmounts[i] = prevOuts[in.PreviousOutPoint.Index].Value
+		fee += inAmounts[i]
+	}
+
+	for _, out := range tx.TxOut {
+		fee -= out.Value
+	}
+	if fee < 0 {
+		return nil, 0, fmt.Errorf("got negative fee: %d", fee)
+	}
+
+	return inAmounts, fee, nil
+}
+
+// getUnconfirmedAncestors returns the total vsize and fees of the unconfirmed ancestors of the given tx
+func (signer *Signer) getUnconfirmedAncestors(ctx context.Context, tx *wire.MsgTx) (int64, int64, error) {
+	var (
+		vsize   int64
+		fee     int64
+		count   int
+		visited = make(map[chainhash.Hash]bool)
+		queue   = make([]chainhash.Hash, 0, len(tx.TxIn))
+	)
+
+	for _, in := range tx.TxIn {
+		queue = append(queue, in.PreviousOutPoint.Hash)
+	}
+
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+
+		if visited[hash] {
+			continue
+		}
+		visited[hash] = true
+
+		rawResult, err := signer.rpc.GetRawTransactionVerbose(ctx, &hash)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "unable to get raw tx verbose %s", hash)
+		}
+		if rawResult.Confirmations > 0 {
+			continue
+		}
+
+		count++
+		if count > maxUnconfirmedAncestors {
+			return 0, 0, fmt.Errorf("too many unconfirmed ancestors, limit %d", maxUnconfirmedAncestors)
+		}
+
+		ancestor, err := signer.rpc.GetRawTransaction(ctx, &hash)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "unable to get raw tx %s", hash)
+		}
+
+		_, ancestorFee, err := signer.getTxFee(ctx, ancestor.MsgTx())
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "unable to get fee of ancestor %s", hash)
+		}
+
+		vsize += int64(rawResult.Vsize)
+		fee += ancestorFee
+
+		for _, in := range ancestor.MsgTx().TxIn {
+			queue = append(queue, in.PreviousOutPoint.Hash)
+		}
+	}
+
+	return vsize, fee, nil
+}
@@ -0,0 +1,210 @@
+package signer_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/signer"
+)
+
+func Test_SignRBFTx(t *testing.T) {
+	const (
+		nonce       = uint64(5)
+		minRelayFee = 0.00001 // 1 sat/vB
+		oldFeeRate  = int64(10)
+	)
+
+	net := &chaincfg.MainNetParams
+	chain := chains.BitcoinMainnet
+
+	// make sample cctx with given fee rate
+	mkTxData := func(t *testing.T, gasPrice string, cancelTx bool) *signer.OutboundData {
+		cctx := sample.CrossChainTx(t, "0x123")
+		cctx.InboundParams.CoinType = coin.CoinType_Gas
+		cctx.GetCurrentOutboundParam().GasPrice = gasPrice
+		cctx.GetCurrentOutboundParam().Receiver = sample.BTCAddressP2WPKH(t, sample.Rand(), net).String()
+		cctx.GetCurrentOutboundParam().ReceiverChainId = chain.ChainId
+		cctx.GetCurrentOutboundParam().Amount = sdkmath.NewUint(1e6)
+		cctx.GetCurrentOutboundParam().CallOptions = &crosschaintypes.CallOptions{GasLimit: 254}
+		cctx.GetCurrentOutboundParam().TssNonce = nonce
+		if cancelTx {
+			cctx.GetCurrentOutboundParam().Amount = sdkmath.NewUint(0)
+		}
+
+		txData, err := signer.NewOutboundData(context.Background(), cctx, 101, minRelayFee, zerolog.Nop(), zerolog.Nop())
+		require.NoError(t, err)
+		return txData
+	}
+
+	tests := []struct {
+		name             string
+		gasPrice         string
+		withChange       bool
+		cancelTx         bool
+		ancestorVsize    int64
+		ancestorFee      int64
+		expectedFeeRate  int64
+		expectNotNeeded  bool
+		expectedErrorMsg string
+	}{
+		{
+			name:            "should replace pending outbound with higher fee rate",
+			gasPrice:        "20",
+			withChange:      true,
+			expectedFeeRate: 21,
+		},
+		{
+			name:            "should pay for unconfirmed ancestor (CPFP)",
+			gasPrice:        "20",
+			withChange:      true,
+			ancestorVsize:   200,
+			ancestorFee:     200, // 1 sat/vB
+			expectedFeeRate: 21,
+		},
+		{
+			name:            "should replace cancelled outbound",
+			gasPrice:        "20",
+			withChange:      true,
+			cancelTx:        true,
+			expectedFeeRate: 21,
+		},
+		{
+			name:            "should skip if pending outbound pays enough fees",
+			gasPrice:        "5",
+			withChange:      true,
+			expectNotNeeded: true,
+		},
+		{
+			name:             "should fail if there is no change output",
+			gasPrice:         "20",
+			withChange:       false,
+			expectedErrorMsg: "no change output",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			s := newTestSuite(t, chain)
+			ctx := context.Background()
+			txData := mkTxData(t, tt.gasPrice, tt.cancelTx)
+
+			tssScript, err := s.TSS().PubKey().BTCPayToAddrScript(chain.ChainId)
+			require.NoError(t, err)
+
+			// previous outbound (owning the nonce-mark) and a big UTXO owned by TSS
+			grandParent := wire.NewMsgTx(wire.TxVersion)
+			grandParent.AddTxOut(wire.NewTxOut(chains.NonceMarkAmount(nonce-1)+tt.ancestorFee, tssScript))
+
+			prevOutbound := wire.NewMsgTx(wire.TxVersion)
+			prevOutbound.AddTxIn(wire.NewTxIn(wire.NewOutPoint(ptr(grandParent.TxHash()), 0), nil, nil))
+			prevOutbound.AddTxOut(wire.NewTxOut(chains.NonceMarkAmount(nonce-1), tssScript))
+
+			prevUTXO := wire.NewMsgTx(wire.TxVersion)
+			prevUTXO.AddTxIn(wire.NewTxIn(wire.NewOutPoint(ptr(sample.BtcHash()), 0), nil, nil))
+			prevUTXO.AddTxOut(wire.NewTxOut(1e7, tssScript))
+
+			// pending outbound: [nonce-mark, payment, change]
+			inValue := chains.NonceMarkAmount(nonce-1) + 1e7
+			lastTx := wire.NewMsgTx(wire.TxVersion)
+			lastTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(ptr(prevOutbound.TxHash()), 0), nil, nil))
+			lastTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(ptr(prevUTXO.TxHash()), 0), nil, nil))
+			lastTx.AddTxOut(wire.NewTxOut(chains.NonceMarkAmount(nonce), tssScript))
+			if !tt.cancelTx {
+				lastTx.AddTxOut(wire.NewTxOut(1e6, sample.BTCAddressP2WPKHScript(t, sample.Rand(), net)))
+			}
+			if tt.withChange {
+				lastTx.AddTxOut(wire.NewTxOut(0, tssScript))
+			}
+
+			// sign the pending outbound to get its real vsize, then let the last output pay the old fee rate
+			err = s.SignTx(ctx, lastTx, []int64{chains.NonceMarkAmount(nonce - 1), 1e7}, 100, nonce)
+			require.NoError(t, err)
+			vsize := mempool.GetTxVirtualSize(btcutil.NewTx(lastTx))
+			lastOut := lastTx.TxOut[len(lastTx.TxOut)-1]
+			lastOut.Value = inValue - (sumOutputs(lastTx) - lastOut.Value) - oldFeeRate*vsize
+			oldFee := inValue - sumOutputs(lastTx)
+
+			// mock RPC
+			for _, prevTx := range []*wire.MsgTx{grandParent, prevOutbound, prevUTXO} {
+				s.client.On("GetRawTransaction", mock.Anything, ptr(prevTx.TxHash())).
+					Maybe().
+					Return(btcutil.NewTx(prevTx), nil)
+			}
+			s.client.On("GetRawTransactionVerbose", mock.Anything, ptr(grandParent.TxHash())).
+				Maybe().
+				Return(&btcjson.TxRawResult{Confirmations: 1}, nil)
+			s.client.On("GetRawTransactionVerbose", mock.Anything, ptr(prevUTXO.TxHash())).
+				Maybe().
+				Return(&btcjson.TxRawResult{Confirmations: 1}, nil)
+
+			// the previous outbound may still be pending in mempool with a low fee rate
+			prevOutboundResult := &btcjson.TxRawResult{Confirmations: 1}
+			if tt.ancestorVsize > 0 {
+				prevOutboundResult = &btcjson.TxRawResult{Confirmations: 0, Vsize: int32(tt.ancestorVsize)}
+			}
+			s.client.On("GetRawTransactionVerbose", mock.Anything, ptr(prevOutbound.TxHash())).
+				Maybe().
+				Return(prevOutboundResult, nil)
+
+			// ACT
+			tx, err := s.SignRBFTx(ctx, txData, lastTx, minRelayFee)
+
+			// ASSERT
+			switch {
+			case tt.expectNotNeeded:
+				require.ErrorIs(t, err, signer.ErrFeeBumpNotNeeded)
+				return
+			case tt.expectedErrorMsg != "":
+				require.ErrorContains(t, err, tt.expectedErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			// same inputs with nonce-mark as the 1st input
+			require.Len(t, tx.TxIn, len(lastTx.TxIn))
+			for i := range tx.TxIn {
+				require.Equal(t, lastTx.TxIn[i].PreviousOutPoint, tx.TxIn[i].PreviousOutPoint)
+				require.Equal(t, uint32(wire.MaxTxInSequenceNum-2), tx.TxIn[i].Sequence)
+				require.Len(t, tx.TxIn[i].Witness, 2)
+			}
+
+			// same outputs except the change
+			require.Len(t, tx.TxOut, len(lastTx.TxOut))
+			for i := 0; i < len(tx.TxOut)-1; i++ {
+				require.Equal(t, lastTx.TxOut[i], tx.TxOut[i])
+			}
+
+			// the package pays the cctx fee rate
+			newFee := inValue - sumOutputs(tx)
+			require.Greater(t, newFee, oldFee)
+			require.Equal(t, tt.expectedFeeRate*(vsize+tt.ancestorVsize)-tt.ancestorFee, newFee)
+		})
+	}
+}
+
+func sumOutputs(tx *wire.MsgTx) int64 {
+	var total int64
+	for _, out := range tx.TxOut {
+		total += out.Value
+	}
+	return total
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
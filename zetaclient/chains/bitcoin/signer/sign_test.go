@@ -47,7 +47,7 @@ func Test_SignWithdrawTx(t *testing.T) {
 	// helper function to create tx data
 	mkTxData := func(height uint64, minRelayFee float64) signer.OutboundData {
 		cctx := mkCCTX(t)
-		txData, err := signer.NewOutboundData(cctx, height, minRelayFee, zerolog.Nop(), zerolog.Nop())
+		txData, err := signer.NewOutboundData(context.Background(), cctx, height, minRelayFee, zerolog.Nop(), zerolog.Nop())
 		require.NoError(t, err)
 		return *txData
 	}
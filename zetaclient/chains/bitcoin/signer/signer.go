@@ -33,6 +33,7 @@ const (
 type RPC interface {
 	GetNetworkInfo(ctx context.Context) (*btcjson.GetNetworkInfoResult, error)
 	GetRawTransaction(ctx context.Context, hash *chainhash.Hash) (*btcutil.Tx, error)
+	GetRawTransactionVerbose(ctx context.Context, hash *chainhash.Hash) (*btcjson.TxRawResult, error)
 	GetEstimatedFeeRate(ctx context.Context, confTarget int64) (int64, error)
 	SendRawTransaction(ctx context.Context, tx *wire.MsgTx, allowHighFees bool) (*chainhash.Hash, error)
 }
@@ -102,19 +103,14 @@ func (signer *Signer) TryProcessOutbound(
 	logger := signer.Logger().Std.With().Fields(lf).Logger()
 
 	// query network info to get minRelayFee (typically 1000 satoshis)
-	networkInfo, err := signer.rpc.GetNetworkInfo(ctx)
+	minRelayFee, err := signer.getMinRelayFee(ctx)
 	if err != nil {
-		logger.Error().Err(err).Msg("failed get bitcoin network info")
-		return
-	}
-	minRelayFee := networkInfo.RelayFee
-	if minRelayFee <= 0 {
-		logger.Error().Msgf("invalid minimum relay fee: %f", minRelayFee)
+		logger.Error().Err(err).Msg("failed to get minimum relay fee")
 		return
 	}
 
 	// setup outbound data
-	txData, err := NewOutboundData(cctx, height, minRelayFee, logger, signer.Logger().Compliance)
+	txData, err := NewOutboundData(ctx, cctx, height, minRelayFee, logger, signer.Logger().Compliance)
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to setup Bitcoin outbound data")
 		return
@@ -132,6 +128,97 @@ func (signer *Signer) TryProcessOutbound(
 	signer.BroadcastOutbound(ctx, signedTx, params.TssNonce, cctx, observer, zetacoreClient)
 }
 
+// TryBumpOutboundFee replaces the pending outbound of the given cctx with a higher fee (RBF/CPFP)
+// if the outbound (and its unconfirmed ancestors) pays less than the increased fee rate of the cctx.
+func (signer *Signer) TryBumpOutboundFee(
+	ctx context.Context,
+	cctx *types.CrossChainTx,
+	observer *observer.Observer,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
+) {
+	outboundID := base.OutboundIDFromCCTX(cctx)
+	signer.MarkOutbound(outboundID, true)
+
+	// end outbound process on panic
+	defer func() {
+		signer.MarkOutbound(outboundID, false)
+		if err := recover(); err != nil {
+			signer.Logger().Std.Error().Msgf("BTC TryBumpOutboundFee: %s, caught panic error: %v", cctx.Index, err)
+		}
+	}()
+
+	// prepare logger
+	params := cctx.GetCurrentOutboundParam()
+	logger := signer.Logger().Std.With().
+		Str(logs.FieldMethod, "TryBumpOutboundFee").
+		Str(logs.FieldCctx, cctx.Index).
+		Uint64(logs.FieldNonce, params.TssNonce).
+		Logger()
+
+	// only outbound pending in mempool can be replaced
+	lastResult := observer.GetIncludedTx(params.TssNonce)
+	if lastResult == nil || lastResult.Confirmations > 0 {
+		return
+	}
+	logger = logger.With().Str(logs.FieldTx, lastResult.TxID).Logger()
+
+	lastHash, err := chainhash.NewHashFromStr(lastResult.TxID)
+	if err != nil {
+		logger.Error().Err(err).Msg("invalid pending outbound hash")
+		return
+	}
+
+	lastTx, err := signer.rpc.GetRawTransaction(ctx, lastHash)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get pending outbound")
+		return
+	}
+
+	minRelayFee, err := signer.getMinRelayFee(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get minimum relay fee")
+		return
+	}
+
+	// setup outbound data with the (increased) fee rate of the cctx
+	txData, err := NewOutboundData(ctx, cctx, height, minRelayFee, logger, signer.Logger().Compliance)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to setup Bitcoin outbound data")
+		return
+	}
+
+	// sign replacement tx
+	signedTx, err := signer.SignRBFTx(ctx, txData, lastTx.MsgTx(), minRelayFee)
+	switch {
+	case errors.Is(err, ErrFeeBumpNotNeeded):
+		logger.Debug().Msg("pending outbound pays enough fees")
+		return
+	case err != nil:
+		logger.Error().Err(err).Msg("SignRBFTx failed")
+		return
+	}
+	logger.Info().Str("tx.replacement", signedTx.TxID()).Msg("SignRBFTx succeed")
+
+	// broadcast replacement outbound
+	signer.BroadcastOutbound(ctx, signedTx, params.TssNonce, cctx, observer, zetacoreClient)
+}
+
+// getMinRelayFee returns the minimum relay fee (in BTC/KB) of the Bitcoin node
+func (signer *Signer) getMinRelayFee(ctx context.Context) (float64, error) {
+	networkInfo, err := signer.rpc.GetNetworkInfo(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get bitcoin network info")
+	}
+
+	minRelayFee := networkInfo.RelayFee
+	if minRelayFee <= 0 {
+		return 0, errors.Errorf("invalid minimum relay fee: %f", minRelayFee)
+	}
+
+	return minRelayFee, nil
+}
+
 // BroadcastOutbound sends the signed transaction to the Bitcoin network
 func (signer *Signer) BroadcastOutbound(
 	ctx context.Context,
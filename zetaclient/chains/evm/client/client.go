@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -11,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/zeta-chain/node/zetaclient/metrics"
+	"github.com/zeta-chain/node/zetaclient/rpcpool"
 )
 
 type Client struct {
@@ -18,6 +20,15 @@ type Client struct {
 	ethtypes.Signer
 }
 
+// QuorumMethods are the methods that must be agreed on by the quorum of endpoints
+var QuorumMethods = []string{
+	"eth_getTransactionReceipt",
+	"eth_getTransactionByHash",
+	"eth_getBlockByNumber",
+	"eth_getBlockByHash",
+	"eth_getLogs",
+}
+
 // NewFromEndpoint new Client constructor based on endpoint URL.
 func NewFromEndpoint(ctx context.Context, endpoint string) (*Client, error) {
 	httpClient, err := metrics.GetInstrumentedHTTPClient(endpoint)
@@ -25,6 +36,15 @@ func NewFromEndpoint(ctx context.Context, endpoint string) (*Client, error) {
 		return nil, errors.Wrap(err, "unable to get instrumented HTTP client")
 	}
 
+	return dial(ctx, endpoint, httpClient)
+}
+
+// NewFromPool new Client constructor sending the requests through a pool of endpoints.
+func NewFromPool(ctx context.Context, pool *rpcpool.Pool) (*Client, error) {
+	return dial(ctx, pool.URL(), pool.HTTPClient())
+}
+
+func dial(ctx context.Context, endpoint string, httpClient *http.Client) (*Client, error) {
 	rpc, err := ethrpc.DialOptions(ctx, endpoint, ethrpc.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to dial EVM client (endpoint %q)", endpoint)
@@ -12,6 +12,7 @@ import (
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/scheduler"
 	"github.com/zeta-chain/node/pkg/ticker"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/evm/observer"
 	"github.com/zeta-chain/node/zetaclient/chains/evm/signer"
@@ -73,11 +74,11 @@ func (e *EVM) Start(ctx context.Context) error {
 	})
 
 	optInboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsInboundObservationEnabled()
+		return !app.IsInboundObservationEnabled() || app.IsChainHalted(e.observer.Chain().ChainId)
 	})
 
 	optOutboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsOutboundObservationEnabled()
+		return !app.IsOutboundObservationEnabled() || app.IsChainHalted(e.observer.Chain().ChainId)
 	})
 
 	optGenericSkipper := scheduler.Skipper(func() bool {
@@ -142,6 +143,9 @@ func (e *EVM) scheduleCCTX(ctx context.Context) error {
 		// #nosec G115 positive
 		scheduleInterval = uint64(e.observer.ChainParams().OutboundScheduleInterval)
 
+		// the interval of the chain params, shared by all the signers
+		baseScheduleInterval = scheduleInterval
+
 		// for critical pending outbound we reduce re-try interval
 		criticalInterval = uint64(10)
 
@@ -162,6 +166,12 @@ func (e *EVM) scheduleCCTX(ctx context.Context) error {
 		return errors.Wrap(err, "unable to get tracker set")
 	}
 
+	// outbounds ready for keysign are processed together once the whole list is scanned
+	var outbounds []*crosschaintypes.CrossChainTx
+	defer func() {
+		e.signer.TryProcessOutbounds(ctx, outbounds, e.observer.ZetacoreClient(), zetaHeight, baseScheduleInterval)
+	}()
+
 	for idx, cctx := range cctxList {
 		var (
 			params     = cctx.GetCurrentOutboundParam()
@@ -218,12 +228,7 @@ func (e *EVM) scheduleCCTX(ctx context.Context) error {
 
 		// otherwise, the normal interval is used
 		if nonce%scheduleInterval == zetaHeight%scheduleInterval {
-			go e.signer.TryProcessOutbound(
-				ctx,
-				cctx,
-				e.observer.ZetacoreClient(),
-				zetaHeight,
-			)
+			outbounds = append(outbounds, cctx)
 		}
 
 		// #nosec G115 always in range
@@ -10,6 +10,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum"
@@ -106,6 +107,11 @@ func (ob *Observer) ObserveInbound(ctx context.Context) error {
 		return err
 	}
 
+	// the confirmed blocks already scanned should never be reorged
+	if err := ob.CheckReorg(ctx); err != nil {
+		return errors.Wrap(err, "unable to check reorg")
+	}
+
 	// uncomment this line to stop observing inbound and test observation with inbound trackers
 	// https://github.com/zeta-chain/node/blob/3879b5ef8b418542c82a4383263604222f0605c6/e2e/e2etests/test_inbound_trackers.go#L19
 	// TODO: implement a better way to disable inbound observation
@@ -301,7 +307,7 @@ func (ob *Observer) observeZetaSent(
 		}
 		guard[event.Raw.TxHash.Hex()] = true
 
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(app, event)
+		msg := ob.BuildInboundVoteMsgForZetaSentEvent(ctx, app, event)
 		if msg == nil {
 			continue
 		}
@@ -385,7 +391,7 @@ func (ob *Observer) observeERC20Deposited(
 		}
 		guard[event.Raw.TxHash.Hex()] = true
 
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg := ob.BuildInboundVoteMsgForDepositedEvent(ctx, event, sender)
 		if msg != nil {
 			_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 			if err != nil {
@@ -450,7 +456,7 @@ func (ob *Observer) CheckAndVoteInboundTokenZeta(
 			// sanity check tx event
 			err = common.ValidateEvmTxLog(&event.Raw, addrConnector, tx.Hash, common.TopicsZetaSent)
 			if err == nil {
-				msg = ob.BuildInboundVoteMsgForZetaSentEvent(app, event)
+				msg = ob.BuildInboundVoteMsgForZetaSentEvent(ctx, app, event)
 			} else {
 				ob.Logger().Inbound.Error().Err(err).Msgf("CheckEvmTxLog error on inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 				return "", err
@@ -501,7 +507,7 @@ func (ob *Observer) CheckAndVoteInboundTokenERC20(
 			// sanity check tx event
 			err = common.ValidateEvmTxLog(&zetaDeposited.Raw, addrCustody, tx.Hash, common.TopicsDeposited)
 			if err == nil {
-				msg = ob.BuildInboundVoteMsgForDepositedEvent(zetaDeposited, sender)
+				msg = ob.BuildInboundVoteMsgForDepositedEvent(ctx, zetaDeposited, sender)
 			} else {
 				ob.Logger().Inbound.Error().Err(err).Msgf("CheckEvmTxLog error on inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 				return "", err
@@ -547,7 +553,7 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 	sender := ethcommon.HexToAddress(tx.From)
 
 	// build inbound vote message and post vote
-	msg := ob.BuildInboundVoteMsgForTokenSentToTSS(tx, sender, receipt.BlockNumber.Uint64())
+	msg := ob.BuildInboundVoteMsgForTokenSentToTSS(ctx, tx, sender, receipt.BlockNumber.Uint64())
 	if msg == nil {
 		// donation, restricted tx, etc.
 		ob.Logger().Inbound.Info().Msgf("no vote message built for inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
@@ -562,6 +568,7 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 
 // BuildInboundVoteMsgForDepositedEvent builds a inbound vote message for a Deposited event
 func (ob *Observer) BuildInboundVoteMsgForDepositedEvent(
+	ctx context.Context,
 	event *erc20custody.ERC20CustodyDeposited,
 	sender ethcommon.Address,
 ) *types.MsgVoteInbound {
@@ -571,7 +578,7 @@ func (ob *Observer) BuildInboundVoteMsgForDepositedEvent(
 	if err == nil && parsedAddress != (ethcommon.Address{}) {
 		maybeReceiver = parsedAddress.Hex()
 	}
-	if config.ContainRestrictedAddress(sender.Hex(), clienttypes.BytesToEthHex(event.Recipient), maybeReceiver) {
+	if compliance.ContainRestrictedAddress(ctx, sender.Hex(), clienttypes.BytesToEthHex(event.Recipient), maybeReceiver) {
 		compliance.PrintComplianceLog(
 			ob.Logger().Inbound,
 			ob.Logger().Compliance,
@@ -618,6 +625,7 @@ func (ob *Observer) BuildInboundVoteMsgForDepositedEvent(
 
 // BuildInboundVoteMsgForZetaSentEvent builds a inbound vote message for a ZetaSent event
 func (ob *Observer) BuildInboundVoteMsgForZetaSentEvent(
+	ctx context.Context,
 	appContext *zctx.AppContext,
 	event *zetaconnector.ZetaConnectorNonEthZetaSent,
 ) *types.MsgVoteInbound {
@@ -632,7 +640,7 @@ func (ob *Observer) BuildInboundVoteMsgForZetaSentEvent(
 
 	// compliance check
 	sender := event.ZetaTxSenderAddress.Hex()
-	if config.ContainRestrictedAddress(sender, destAddr, event.SourceTxOriginAddress.Hex()) {
+	if compliance.ContainRestrictedAddress(ctx, sender, destAddr, event.SourceTxOriginAddress.Hex()) {
 		compliance.PrintComplianceLog(ob.Logger().Inbound, ob.Logger().Compliance,
 			false, ob.Chain().ChainId, event.Raw.TxHash.Hex(), sender, destAddr, "Zeta")
 		return nil
@@ -671,6 +679,7 @@ func (ob *Observer) BuildInboundVoteMsgForZetaSentEvent(
 
 // BuildInboundVoteMsgForTokenSentToTSS builds a inbound vote message for a token sent to TSS
 func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
+	ctx context.Context,
 	tx *client.Transaction,
 	sender ethcommon.Address,
 	blockNumber uint64,
@@ -683,7 +692,7 @@ func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
 	if err == nil && parsedAddress != (ethcommon.Address{}) {
 		maybeReceiver = parsedAddress.Hex()
 	}
-	if config.ContainRestrictedAddress(sender.Hex(), maybeReceiver) {
+	if compliance.ContainRestrictedAddress(ctx, sender.Hex(), maybeReceiver) {
 		compliance.PrintComplianceLog(ob.Logger().Inbound, ob.Logger().Compliance,
 			false, ob.Chain().ChainId, tx.Hash, sender.Hex(), sender.Hex(), "Gas")
 		return nil
@@ -725,6 +734,8 @@ func (ob *Observer) ObserveTSSReceiveInBlock(ctx context.Context, blockNumber ui
 	if err != nil {
 		return errors.Wrapf(err, "error getting block %d for chain %d", blockNumber, ob.Chain().ChainId)
 	}
+	ob.SetInboundBlockTime(blockNumber, time.Unix(int64(block.Timestamp), 0))
+
 	for i := range block.Transactions {
 		tx := block.Transactions[i]
 		if ethcommon.HexToAddress(tx.To) == ob.TSS().PubKey().AddressEVM() {
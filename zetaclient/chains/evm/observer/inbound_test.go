@@ -1,6 +1,7 @@
 package observer_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"testing"
@@ -289,7 +290,7 @@ func Test_BuildInboundVoteMsgForZetaSentEvent(t *testing.T) {
 	}
 
 	t.Run("should return vote msg for archived ZetaSent event", func(t *testing.T) {
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(ob.appContext, event)
+		msg := ob.BuildInboundVoteMsgForZetaSentEvent(context.Background(), ob.appContext, event)
 		require.NotNil(t, msg)
 		require.Equal(t, cctx.InboundParams.BallotIndex, msg.Digest())
 	})
@@ -297,21 +298,21 @@ func Test_BuildInboundVoteMsgForZetaSentEvent(t *testing.T) {
 		sender := event.ZetaTxSenderAddress.Hex()
 		cfg.ComplianceConfig.RestrictedAddresses = []string{sender}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(ob.appContext, event)
+		msg := ob.BuildInboundVoteMsgForZetaSentEvent(context.Background(), ob.appContext, event)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg if receiver is restricted", func(t *testing.T) {
 		receiver := clienttypes.BytesToEthHex(event.DestinationAddress)
 		cfg.ComplianceConfig.RestrictedAddresses = []string{receiver}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(ob.appContext, event)
+		msg := ob.BuildInboundVoteMsgForZetaSentEvent(context.Background(), ob.appContext, event)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg if txOrigin is restricted", func(t *testing.T) {
 		txOrigin := event.SourceTxOriginAddress.Hex()
 		cfg.ComplianceConfig.RestrictedAddresses = []string{txOrigin}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(ob.appContext, event)
+		msg := ob.BuildInboundVoteMsgForZetaSentEvent(context.Background(), ob.appContext, event)
 		require.Nil(t, msg)
 	})
 }
@@ -337,26 +338,26 @@ func Test_BuildInboundVoteMsgForDepositedEvent(t *testing.T) {
 	}
 
 	t.Run("should return vote msg for archived Deposited event", func(t *testing.T) {
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg := ob.BuildInboundVoteMsgForDepositedEvent(context.Background(), event, sender)
 		require.NotNil(t, msg)
 		require.Equal(t, cctx.InboundParams.BallotIndex, msg.Digest())
 	})
 	t.Run("should return nil msg if sender is restricted", func(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{sender.Hex()}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg := ob.BuildInboundVoteMsgForDepositedEvent(context.Background(), event, sender)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg if receiver is restricted", func(t *testing.T) {
 		receiver := clienttypes.BytesToEthHex(event.Recipient)
 		cfg.ComplianceConfig.RestrictedAddresses = []string{receiver}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg := ob.BuildInboundVoteMsgForDepositedEvent(context.Background(), event, sender)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg on donation transaction", func(t *testing.T) {
 		event.Message = []byte(constant.DonationMessage)
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg := ob.BuildInboundVoteMsgForDepositedEvent(context.Background(), event, sender)
 		require.Nil(t, msg)
 	})
 }
@@ -391,6 +392,7 @@ func Test_BuildInboundVoteMsgForTokenSentToTSS(t *testing.T) {
 
 	t.Run("should return vote msg for archived gas token transfer to TSS", func(t *testing.T) {
 		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(
+			context.Background(),
 			tx,
 			ethcommon.HexToAddress(tx.From),
 			receipt.BlockNumber.Uint64(),
@@ -402,6 +404,7 @@ func Test_BuildInboundVoteMsgForTokenSentToTSS(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{tx.From}
 		config.LoadComplianceConfig(cfg)
 		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(
+			context.Background(),
 			tx,
 			ethcommon.HexToAddress(tx.From),
 			receipt.BlockNumber.Uint64(),
@@ -416,6 +419,7 @@ func Test_BuildInboundVoteMsgForTokenSentToTSS(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{testutils.OtherAddress1}
 		config.LoadComplianceConfig(cfg)
 		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(
+			context.Background(),
 			txCopy,
 			ethcommon.HexToAddress(txCopy.From),
 			receipt.BlockNumber.Uint64(),
@@ -423,7 +427,7 @@ func Test_BuildInboundVoteMsgForTokenSentToTSS(t *testing.T) {
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg on donation transaction", func(t *testing.T) {
-		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(txDonation,
+		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(context.Background(), txDonation,
 			ethcommon.HexToAddress(txDonation.From), receiptDonation.BlockNumber.Uint64())
 		require.Nil(t, msg)
 	})
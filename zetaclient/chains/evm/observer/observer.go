@@ -39,6 +39,15 @@ type Observer struct {
 
 	// outboundConfirmedTransactions is the map to index confirmed transactions by hash
 	outboundConfirmedTransactions map[string]*ethtypes.Transaction
+
+	// reorgCheckpoint is the last scanned block checked against reorgs by the next inbound observation
+	reorgCheckpoint blockCheckpoint
+}
+
+// blockCheckpoint is the number and hash of a block
+type blockCheckpoint struct {
+	number uint64
+	hash   ethcommon.Hash
 }
 
 // priorityFeeConfig is the configuration for priority fee
@@ -261,7 +270,7 @@ func (ob *Observer) LoadLastBlockScanned(ctx context.Context) error {
 }
 
 func (ob *Observer) CheckRPCStatus(ctx context.Context) error {
-	blockTime, err := ob.evmClient.HealthCheck(ctx)
+	blockTime, err := ob.CheckRPCHealth(ctx, ob.evmClient.HealthCheck)
 	if err != nil {
 		return errors.Wrap(err, "unable to check rpc health")
 	}
@@ -24,6 +24,8 @@ import (
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/compliance"
 	"github.com/zeta-chain/node/zetaclient/logs"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 	"github.com/zeta-chain/node/zetaclient/zetacore"
 )
 
@@ -146,6 +148,8 @@ func (ob *Observer) PostVoteOutbound(
 		logFields["vote"] = zetaTxHash
 		logFields["ballot"] = ballot
 		logger.Info().Fields(logFields).Msgf("PostVoteOutbound: posted vote for chain %d", chainID)
+		ob.RecordVote(ctx, clienttypes.ToOutboundVoteSQLType(msg, ballot, zetaTxHash))
+		metrics.OutboundConfirmed(ob.Chain().Name, msg.OutboundTssNonce)
 	}
 }
 
@@ -187,7 +191,7 @@ func (ob *Observer) VoteOutboundIfConfirmed(
 	cointype := cctx.InboundParams.CoinType
 
 	// compliance check, special handling the cancelled cctx
-	if compliance.IsCctxRestricted(cctx) {
+	if compliance.IsCctxRestricted(ctx, cctx) {
 		// use cctx's amount to bypass the amount check in zetacore
 		receiveValue = cctx.GetCurrentOutboundParam().Amount.BigInt()
 		receiveStatus := chains.ReceiveStatus_failed
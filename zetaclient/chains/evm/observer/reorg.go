@@ -0,0 +1,70 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/logs"
+)
+
+// CheckReorg checks that the block recorded by the previous call is still in the chain, then records the last
+// scanned block for the next call.
+//
+// The scanned blocks are confirmed, if one of them is replaced the reorg is deeper than the confirmations and
+// the inbounds voted from it might not exist anymore, so the observer votes to halt the chain.
+func (ob *Observer) CheckReorg(ctx context.Context) error {
+	logger := ob.Logger().Inbound.With().Str(logs.FieldMethod, "check_reorg").Logger()
+
+	// check the block recorded by the previous call
+	checkpoint := ob.reorgCheckpoint
+	if checkpoint.hash != (ethcommon.Hash{}) {
+		hash, err := ob.blockHash(ctx, checkpoint.number)
+		if err != nil {
+			return err
+		}
+
+		if hash != checkpoint.hash {
+			reason := fmt.Sprintf(
+				"reorg of confirmed block %d: hash %s replaced by %s",
+				checkpoint.number,
+				checkpoint.hash.Hex(),
+				hash.Hex(),
+			)
+			logger.Error().Uint64("block", checkpoint.number).Msg("reorg deeper than confirmations, voting to halt chain")
+
+			// record the new hash first to vote only once for this reorg
+			ob.reorgCheckpoint.hash = hash
+			if _, err := ob.ZetacoreClient().PostVoteHaltChain(ctx, ob.Chain().ChainId, reason); err != nil {
+				return errors.Wrap(err, "unable to post vote halt chain")
+			}
+		}
+	}
+
+	// record the last scanned block if it is confirmed
+	// the last scanned block is the latest block on a fresh start, it can still be reorged
+	lastScanned := ob.LastBlockScanned()
+	if lastScanned == ob.reorgCheckpoint.number || !ob.IsBlockConfirmedForInboundSafe(lastScanned) {
+		return nil
+	}
+
+	hash, err := ob.blockHash(ctx, lastScanned)
+	if err != nil {
+		return err
+	}
+	ob.reorgCheckpoint = blockCheckpoint{number: lastScanned, hash: hash}
+
+	return nil
+}
+
+// blockHash returns the hash of the header of the given block
+func (ob *Observer) blockHash(ctx context.Context, blockNumber uint64) (ethcommon.Hash, error) {
+	header, err := ob.evmClient.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return ethcommon.Hash{}, errors.Wrapf(err, "unable to get header of block %d", blockNumber)
+	}
+	return header.Hash(), nil
+}
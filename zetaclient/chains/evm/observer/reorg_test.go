@@ -0,0 +1,66 @@
+package observer_test
+
+import (
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckReorg(t *testing.T) {
+	// the headers of block 900 before and after a reorg
+	header := &ethtypes.Header{Number: big.NewInt(900)}
+	reorgedHeader := &ethtypes.Header{Number: big.NewInt(900), Extra: []byte("reorg")}
+
+	t.Run("should not vote if the recorded block is unchanged", func(t *testing.T) {
+		ob := newTestSuite(t)
+		ob.WithLastBlock(1000)
+		ob.WithLastBlockScanned(900)
+		ob.evmMock.On("HeaderByNumber", mock.Anything, big.NewInt(900)).Return(header, nil)
+
+		// record block 900 then check it
+		require.NoError(t, ob.CheckReorg(ob.ctx))
+		require.NoError(t, ob.CheckReorg(ob.ctx))
+
+		ob.zetacore.AssertNotCalled(t, "PostVoteHaltChain", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not record an unconfirmed last scanned block", func(t *testing.T) {
+		ob := newTestSuite(t)
+		ob.WithLastBlock(1000)
+		ob.WithLastBlockScanned(995)
+
+		// no header is queried
+		require.NoError(t, ob.CheckReorg(ob.ctx))
+	})
+
+	t.Run("should vote to halt the chain once if the recorded block is reorged", func(t *testing.T) {
+		ob := newTestSuite(t)
+		ob.WithLastBlock(1000)
+		ob.WithLastBlockScanned(900)
+		ob.evmMock.On("HeaderByNumber", mock.Anything, big.NewInt(900)).Return(header, nil).Once()
+		ob.evmMock.On("HeaderByNumber", mock.Anything, big.NewInt(900)).Return(reorgedHeader, nil)
+		ob.zetacore.On("PostVoteHaltChain", mock.Anything, ob.Chain().ChainId, mock.Anything).Return("", nil).Once()
+
+		// record block 900 then detect its reorg
+		require.NoError(t, ob.CheckReorg(ob.ctx))
+		require.NoError(t, ob.CheckReorg(ob.ctx))
+
+		// the reorg is voted only once
+		require.NoError(t, ob.CheckReorg(ob.ctx))
+		ob.zetacore.AssertNumberOfCalls(t, "PostVoteHaltChain", 1)
+	})
+
+	t.Run("should return error if the header can't be queried", func(t *testing.T) {
+		ob := newTestSuite(t)
+		ob.WithLastBlock(1000)
+		ob.WithLastBlockScanned(900)
+		ob.evmMock.On("HeaderByNumber", mock.Anything, big.NewInt(900)).Return(nil, errors.New("rpc error"))
+
+		err := ob.CheckReorg(ob.ctx)
+		require.ErrorContains(t, err, "unable to get header of block 900")
+	})
+}
@@ -18,19 +18,19 @@ import (
 	"github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/evm/common"
 	"github.com/zeta-chain/node/zetaclient/compliance"
-	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/logs"
 	"github.com/zeta-chain/node/zetaclient/zetacore"
 )
 
 // isEventProcessable checks if the event is processable
 func (ob *Observer) isEventProcessable(
+	ctx context.Context,
 	sender, receiver ethcommon.Address,
 	txHash ethcommon.Hash,
 	payload []byte,
 ) bool {
 	// compliance check
-	if config.ContainRestrictedAddress(sender.Hex(), receiver.Hex()) {
+	if compliance.ContainRestrictedAddress(ctx, sender.Hex(), receiver.Hex()) {
 		compliance.PrintComplianceLog(
 			ob.Logger().Inbound,
 			ob.Logger().Compliance,
@@ -84,7 +84,7 @@ func (ob *Observer) observeGatewayDeposit(
 		}
 
 		// check if the event is processable
-		if !ob.isEventProcessable(event.Sender, event.Receiver, event.Raw.TxHash, event.Payload) {
+		if !ob.isEventProcessable(ctx, event.Sender, event.Receiver, event.Raw.TxHash, event.Payload) {
 			continue
 		}
 
@@ -222,7 +222,7 @@ func (ob *Observer) observeGatewayCall(
 			lastScanned = event.Raw.BlockNumber
 		}
 
-		if !ob.isEventProcessable(event.Sender, event.Receiver, event.Raw.TxHash, event.Payload) {
+		if !ob.isEventProcessable(ctx, event.Sender, event.Receiver, event.Raw.TxHash, event.Payload) {
 			continue
 		}
 
@@ -342,7 +342,7 @@ func (ob *Observer) observeGatewayDepositAndCall(
 		}
 
 		// check if the event is processable
-		if !ob.isEventProcessable(event.Sender, event.Receiver, event.Raw.TxHash, event.Payload) {
+		if !ob.isEventProcessable(ctx, event.Sender, event.Receiver, event.Raw.TxHash, event.Payload) {
 			continue
 		}
 
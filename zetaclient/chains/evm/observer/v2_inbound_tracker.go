@@ -49,6 +49,7 @@ func (ob *Observer) ProcessInboundTrackerV2(
 		if err == nil {
 			// check if the event is processable
 			if !ob.isEventProcessable(
+				ctx,
 				eventDeposit.Sender,
 				eventDeposit.Receiver,
 				eventDeposit.Raw.TxHash,
@@ -66,6 +67,7 @@ func (ob *Observer) ProcessInboundTrackerV2(
 		if err == nil {
 			// check if the event is processable
 			if !ob.isEventProcessable(
+				ctx,
 				eventDepositAndCall.Sender,
 				eventDepositAndCall.Receiver,
 				eventDepositAndCall.Raw.TxHash,
@@ -83,6 +85,7 @@ func (ob *Observer) ProcessInboundTrackerV2(
 		if err == nil {
 			// check if the event is processable
 			if !ob.isEventProcessable(
+				ctx,
 				eventCall.Sender,
 				eventCall.Receiver,
 				eventCall.Raw.TxHash,
@@ -0,0 +1,283 @@
+package signer
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+)
+
+// maxKeysignBatchSize is the maximum number of outbound digests signed in a single TSS keysign ceremony.
+// NOTE: all the observer-signers must build identical batches, so this value must not be configurable.
+const maxKeysignBatchSize = 20
+
+// keysignBatchTimeout is the maximum time an outbound waits for the batch keysign
+// before falling back to its own keysign (e.g. if another outbound of the batch never submits nor leaves).
+const keysignBatchTimeout = time.Minute
+
+type keysignBatchCtxKey struct{}
+
+// keysignBatch collects the digests of the outbounds scheduled on the same zeta block
+// and signs them all at once with a single TSS keysign ceremony (SignBatch).
+//
+// Every outbound (identified by its nonce) joining the batch either submits its digest or leaves it.
+// Once all outbounds have done so, the digests are signed in the order of their nonces.
+// If the batch keysign fails or times out, every outbound falls back to its own keysign,
+// so failures and blame are still handled per nonce.
+type keysignBatch struct {
+	tss     interfaces.TSSSigner
+	chainID int64
+	height  uint64
+	logger  zerolog.Logger
+	timeout time.Duration
+
+	// onFailure is called once if the batch keysign fails or times out
+	onFailure func()
+	failOnce  sync.Once
+
+	mu       sync.Mutex
+	pending  map[uint64]struct{}
+	requests []*keysignRequest
+	timedOut bool
+
+	// done is closed once the batch keysign is completed
+	done chan struct{}
+	sigs map[uint64][65]byte
+	err  error
+}
+
+type keysignRequest struct {
+	nonce  uint64
+	digest []byte
+}
+
+func newKeysignBatch(
+	tss interfaces.TSSSigner,
+	chainID int64,
+	height uint64,
+	nonces []uint64,
+	logger zerolog.Logger,
+) *keysignBatch {
+	pending := make(map[uint64]struct{}, len(nonces))
+	for _, nonce := range nonces {
+		pending[nonce] = struct{}{}
+	}
+
+	return &keysignBatch{
+		tss:     tss,
+		chainID: chainID,
+		height:  height,
+		logger:  logger,
+		timeout: keysignBatchTimeout,
+		pending: pending,
+		done:    make(chan struct{}),
+	}
+}
+
+func withKeysignBatch(ctx context.Context, batch *keysignBatch) context.Context {
+	return context.WithValue(ctx, keysignBatchCtxKey{}, batch)
+}
+
+// keysignBatchFromContext returns the keysign batch from the context or nil.
+func keysignBatchFromContext(ctx context.Context) *keysignBatch {
+	batch, _ := ctx.Value(keysignBatchCtxKey{}).(*keysignBatch)
+	return batch
+}
+
+// sign submits the digest of the outbound to the batch and waits for the batch keysign.
+func (b *keysignBatch) sign(ctx context.Context, digest []byte, height, nonce uint64) ([65]byte, error) {
+	b.mu.Lock()
+	_, isPending := b.pending[nonce]
+	if !isPending || height != b.height || b.timedOut {
+		// not part of the batch (or already submitted or timed out), sign it separately
+		b.mu.Unlock()
+		return b.tss.Sign(ctx, digest, height, nonce, b.chainID)
+	}
+
+	delete(b.pending, nonce)
+	b.requests = append(b.requests, &keysignRequest{nonce: nonce, digest: digest})
+	ready := len(b.pending) == 0
+	b.mu.Unlock()
+
+	if ready {
+		b.signAll(ctx)
+	}
+
+	timer := time.NewTimer(b.timeout)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return [65]byte{}, ctx.Err()
+	case <-timer.C:
+		b.abort()
+		return b.tss.Sign(ctx, digest, height, nonce, b.chainID)
+	case <-b.done:
+	}
+
+	if sig, ok := b.sigs[nonce]; ok && b.err == nil {
+		return sig, nil
+	}
+
+	// fallback to a single keysign for this nonce
+	return b.tss.Sign(ctx, digest, height, nonce, b.chainID)
+}
+
+// leave removes the outbound from the batch if it didn't submit a digest (e.g. skipped or failed).
+func (b *keysignBatch) leave(ctx context.Context, nonce uint64) {
+	b.mu.Lock()
+	_, isPending := b.pending[nonce]
+	if isPending {
+		delete(b.pending, nonce)
+	}
+	ready := isPending && len(b.pending) == 0 && !b.timedOut
+	b.mu.Unlock()
+
+	if ready {
+		b.signAll(ctx)
+	}
+}
+
+// signAll performs the batch keysign once all the outbounds have submitted or left.
+// It's called exactly once, by the last outbound, so the requests are no longer modified.
+func (b *keysignBatch) signAll(ctx context.Context) {
+	defer close(b.done)
+
+	if len(b.requests) == 0 {
+		return
+	}
+
+	sort.Slice(b.requests, func(i, j int) bool {
+		return b.requests[i].nonce < b.requests[j].nonce
+	})
+
+	digests := make([][]byte, len(b.requests))
+	for i, req := range b.requests {
+		digests[i] = req.digest
+	}
+
+	// the lowest nonce identifies the batch (e.g. in blame index)
+	sigs, err := b.tss.SignBatch(ctx, digests, b.height, b.requests[0].nonce, b.chainID)
+	switch {
+	case err != nil:
+		b.err = errors.Wrap(err, "unable to sign batch")
+	case len(sigs) != len(digests):
+		b.err = errors.Errorf("signatures length mismatch (got %d, want %d)", len(sigs), len(digests))
+	}
+
+	if b.err != nil {
+		b.logger.Error().Err(b.err).
+			Int("keysign.batch_size", len(digests)).
+			Uint64("keysign.height", b.height).
+			Msg("Batch keysign failed, falling back to single keysigns")
+		b.fail()
+		return
+	}
+
+	b.sigs = make(map[uint64][65]byte, len(sigs))
+	for i, req := range b.requests {
+		b.sigs[req.nonce] = sigs[i]
+	}
+}
+
+// abort marks the batch as timed out, the outbounds still to submit are signed separately.
+func (b *keysignBatch) abort() {
+	b.mu.Lock()
+	alreadyTimedOut := b.timedOut
+	b.timedOut = true
+	b.mu.Unlock()
+
+	if !alreadyTimedOut {
+		b.logger.Warn().
+			Uint64("keysign.height", b.height).
+			Dur("keysign.timeout", b.timeout).
+			Msg("Batch keysign timed out, falling back to single keysigns")
+	}
+
+	b.fail()
+}
+
+// fail calls onFailure once, so the window of the batch is signed separately from now on.
+func (b *keysignBatch) fail() {
+	b.failOnce.Do(func() {
+		if b.onFailure != nil {
+			b.onFailure()
+		}
+	})
+}
+
+// keysignBatchWindow returns the fixed window of maxKeysignBatchSize nonces the nonce belongs to.
+func keysignBatchWindow(nonce uint64) uint64 {
+	return nonce / maxKeysignBatchSize
+}
+
+// planKeysignBatches groups the nonces scheduled at the given height into keysign batches.
+//
+// The batches must be identical on all the observer-signers, so they're not built from the outbounds
+// a signer happens to schedule (which depends on its local state) but from the state they share:
+// the nonces are batched by fixed windows of maxKeysignBatchSize nonces, and a window is batched only if
+// the signer scheduled exactly the pending nonces of the window due at this height
+// (nonce % interval == height % interval). The nonces of the other windows, and of the windows
+// whose batch keysign failed before, are returned as singles to be signed separately.
+func planKeysignBatches(
+	scheduled []uint64,
+	pending observertypes.PendingNonces,
+	height, interval uint64,
+	isFailed func(window uint64) bool,
+) (batches [][]uint64, singles []uint64) {
+	if interval == 0 {
+		interval = 1
+	}
+
+	// #nosec G115 non-negative
+	nonceLow, nonceHigh := uint64(pending.NonceLow), uint64(pending.NonceHigh)
+
+	windows := make(map[uint64][]uint64)
+	for _, nonce := range scheduled {
+		window := keysignBatchWindow(nonce)
+		windows[window] = append(windows[window], nonce)
+	}
+
+	for window, nonces := range windows {
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		// the pending nonces of the window due at this height
+		var expected []uint64
+		for nonce := window * maxKeysignBatchSize; nonce < (window+1)*maxKeysignBatchSize; nonce++ {
+			if nonce >= nonceLow && nonce < nonceHigh && nonce%interval == height%interval {
+				expected = append(expected, nonce)
+			}
+		}
+
+		if len(nonces) < 2 || !equalNonces(nonces, expected) || isFailed(window) {
+			singles = append(singles, nonces...)
+			continue
+		}
+
+		batches = append(batches, nonces)
+	}
+
+	// deterministic order
+	sort.Slice(batches, func(i, j int) bool { return batches[i][0] < batches[j][0] })
+	sort.Slice(singles, func(i, j int) bool { return singles[i] < singles[j] })
+
+	return batches, singles
+}
+
+func equalNonces(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
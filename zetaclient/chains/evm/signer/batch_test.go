@@ -0,0 +1,338 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+// countingTSS counts the keysign ceremonies and optionally fails the batch ones
+type countingTSS struct {
+	*mocks.TSS
+	failBatch bool
+
+	signs      atomic.Int32
+	batchSigns atomic.Int32
+
+	mu          sync.Mutex
+	batchSizes  []int
+	batchNonces []uint64
+}
+
+func (c *countingTSS) Sign(ctx context.Context, digest []byte, height, nonce uint64, chainID int64) ([65]byte, error) {
+	c.signs.Add(1)
+	return c.TSS.Sign(ctx, digest, height, nonce, chainID)
+}
+
+func (c *countingTSS) SignBatch(
+	ctx context.Context,
+	digests [][]byte,
+	height, nonce uint64,
+	chainID int64,
+) ([][65]byte, error) {
+	c.batchSigns.Add(1)
+
+	c.mu.Lock()
+	c.batchSizes = append(c.batchSizes, len(digests))
+	c.batchNonces = append(c.batchNonces, nonce)
+	c.mu.Unlock()
+
+	if c.failBatch {
+		return nil, errors.New("keysign failed")
+	}
+
+	return c.TSS.SignBatch(ctx, digests, height, nonce, chainID)
+}
+
+func Test_KeysignBatch(t *testing.T) {
+	const (
+		chainID = int64(56)
+		height  = uint64(100)
+	)
+
+	digestOf := func(nonce uint64) []byte {
+		return crypto.Keccak256([]byte{byte(nonce)})
+	}
+
+	// signAll submits the digests of the given nonces concurrently and returns the signatures and errors
+	signAll := func(batch *keysignBatch, nonces []uint64) (map[uint64][65]byte, map[uint64]error) {
+		var (
+			wg   sync.WaitGroup
+			mu   sync.Mutex
+			sigs = make(map[uint64][65]byte)
+			errs = make(map[uint64]error)
+		)
+
+		for _, nonce := range nonces {
+			wg.Add(1)
+			go func(nonce uint64) {
+				defer wg.Done()
+				sig, err := batch.sign(context.Background(), digestOf(nonce), height, nonce)
+
+				mu.Lock()
+				defer mu.Unlock()
+				sigs[nonce], errs[nonce] = sig, err
+			}(nonce)
+		}
+
+		wg.Wait()
+
+		return sigs, errs
+	}
+
+	verify := func(t *testing.T, tss *countingTSS, nonce uint64, sig [65]byte) {
+		pubKey, err := crypto.SigToPub(digestOf(nonce), sig[:])
+		require.NoError(t, err)
+		require.Equal(t, tss.PubKey().AddressEVM(), crypto.PubkeyToAddress(*pubKey))
+	}
+
+	t.Run("signs all digests in a single keysign", func(t *testing.T) {
+		// ARRANGE
+		tss := &countingTSS{TSS: mocks.NewTSS(t)}
+		nonces := []uint64{12, 10, 11}
+		batch := newKeysignBatch(tss, chainID, height, nonces, zerolog.Nop())
+
+		// ACT
+		sigs, errs := signAll(batch, nonces)
+
+		// ASSERT
+		require.EqualValues(t, 1, tss.batchSigns.Load())
+		require.EqualValues(t, 0, tss.signs.Load())
+		require.Equal(t, []int{3}, tss.batchSizes)
+		require.Equal(t, []uint64{10}, tss.batchNonces)
+
+		for _, nonce := range nonces {
+			require.NoError(t, errs[nonce])
+			verify(t, tss, nonce, sigs[nonce])
+		}
+	})
+
+	t.Run("signs remaining digests when an outbound leaves", func(t *testing.T) {
+		// ARRANGE
+		tss := &countingTSS{TSS: mocks.NewTSS(t)}
+		batch := newKeysignBatch(tss, chainID, height, []uint64{1, 2, 3}, zerolog.Nop())
+
+		// ACT
+		batch.leave(context.Background(), 2)
+		sigs, errs := signAll(batch, []uint64{1, 3})
+
+		// ASSERT
+		require.EqualValues(t, 1, tss.batchSigns.Load())
+		require.Equal(t, []int{2}, tss.batchSizes)
+
+		for _, nonce := range []uint64{1, 3} {
+			require.NoError(t, errs[nonce])
+			verify(t, tss, nonce, sigs[nonce])
+		}
+	})
+
+	t.Run("no keysign when all outbounds leave", func(t *testing.T) {
+		// ARRANGE
+		tss := &countingTSS{TSS: mocks.NewTSS(t)}
+		batch := newKeysignBatch(tss, chainID, height, []uint64{1, 2}, zerolog.Nop())
+
+		// ACT
+		batch.leave(context.Background(), 1)
+		batch.leave(context.Background(), 2)
+		batch.leave(context.Background(), 2)
+
+		// ASSERT
+		require.EqualValues(t, 0, tss.batchSigns.Load())
+		require.EqualValues(t, 0, tss.signs.Load())
+	})
+
+	t.Run("falls back to single keysigns when batch keysign fails", func(t *testing.T) {
+		// ARRANGE
+		tss := &countingTSS{TSS: mocks.NewTSS(t), failBatch: true}
+		nonces := []uint64{5, 6}
+		batch := newKeysignBatch(tss, chainID, height, nonces, zerolog.Nop())
+
+		var failures atomic.Int32
+		batch.onFailure = func() { failures.Add(1) }
+
+		// ACT
+		sigs, errs := signAll(batch, nonces)
+
+		// ASSERT
+		require.EqualValues(t, 1, tss.batchSigns.Load())
+		require.EqualValues(t, 2, tss.signs.Load())
+		require.EqualValues(t, 1, failures.Load())
+
+		for _, nonce := range nonces {
+			require.NoError(t, errs[nonce])
+			verify(t, tss, nonce, sigs[nonce])
+		}
+	})
+
+	t.Run("falls back to single keysigns when batch keysign times out", func(t *testing.T) {
+		// ARRANGE
+		tss := &countingTSS{TSS: mocks.NewTSS(t)}
+		batch := newKeysignBatch(tss, chainID, height, []uint64{1, 2}, zerolog.Nop())
+		batch.timeout = 10 * time.Millisecond
+
+		var failures atomic.Int32
+		batch.onFailure = func() { failures.Add(1) }
+
+		// ACT
+		// nonce 2 never shows up in time
+		sigs, errs := signAll(batch, []uint64{1})
+		sigLate, errLate := batch.sign(context.Background(), digestOf(2), height, 2)
+
+		// ASSERT
+		require.EqualValues(t, 0, tss.batchSigns.Load())
+		require.EqualValues(t, 2, tss.signs.Load())
+		require.EqualValues(t, 1, failures.Load())
+
+		require.NoError(t, errs[1])
+		verify(t, tss, 1, sigs[1])
+		require.NoError(t, errLate)
+		verify(t, tss, 2, sigLate)
+	})
+
+	t.Run("signs separately the outbounds not part of the batch", func(t *testing.T) {
+		// ARRANGE
+		tss := &countingTSS{TSS: mocks.NewTSS(t)}
+		batch := newKeysignBatch(tss, chainID, height, []uint64{1}, zerolog.Nop())
+
+		// ACT
+		sig, err := batch.sign(context.Background(), digestOf(7), height, 7)
+
+		// ASSERT
+		require.NoError(t, err)
+		verify(t, tss, 7, sig)
+		require.EqualValues(t, 1, tss.signs.Load())
+		require.EqualValues(t, 0, tss.batchSigns.Load())
+	})
+}
+
+func Test_PlanKeysignBatches(t *testing.T) {
+	noneFailed := func(uint64) bool { return false }
+
+	tests := []struct {
+		name            string
+		scheduled       []uint64
+		pending         observertypes.PendingNonces
+		height          uint64
+		interval        uint64
+		isFailed        func(uint64) bool
+		expectedBatches [][]uint64
+		expectedSingles []uint64
+	}{
+		{
+			name:            "batches the pending nonces of the window",
+			scheduled:       []uint64{12, 10, 11},
+			pending:         observertypes.PendingNonces{NonceLow: 10, NonceHigh: 13},
+			height:          100,
+			interval:        1,
+			isFailed:        noneFailed,
+			expectedBatches: [][]uint64{{10, 11, 12}},
+		},
+		{
+			name:            "splits the nonces by fixed windows",
+			scheduled:       []uint64{18, 19, 20, 21},
+			pending:         observertypes.PendingNonces{NonceLow: 18, NonceHigh: 22},
+			height:          100,
+			interval:        1,
+			isFailed:        noneFailed,
+			expectedBatches: [][]uint64{{18, 19}, {20, 21}},
+		},
+		{
+			name:            "signs separately a window missing a pending nonce",
+			scheduled:       []uint64{10, 12},
+			pending:         observertypes.PendingNonces{NonceLow: 10, NonceHigh: 13},
+			height:          100,
+			interval:        1,
+			isFailed:        noneFailed,
+			expectedSingles: []uint64{10, 12},
+		},
+		{
+			name:            "expects only the nonces due at the height",
+			scheduled:       []uint64{10, 12, 14},
+			pending:         observertypes.PendingNonces{NonceLow: 10, NonceHigh: 15},
+			height:          100,
+			interval:        2,
+			isFailed:        noneFailed,
+			expectedBatches: [][]uint64{{10, 12, 14}},
+		},
+		{
+			name:            "signs separately a single nonce",
+			scheduled:       []uint64{10},
+			pending:         observertypes.PendingNonces{NonceLow: 10, NonceHigh: 11},
+			height:          100,
+			interval:        1,
+			isFailed:        noneFailed,
+			expectedSingles: []uint64{10},
+		},
+		{
+			name:            "signs separately a window whose batch failed",
+			scheduled:       []uint64{10, 11, 20, 21},
+			pending:         observertypes.PendingNonces{NonceLow: 10, NonceHigh: 22},
+			height:          100,
+			interval:        1,
+			isFailed:        func(window uint64) bool { return window == 0 },
+			expectedBatches: [][]uint64{{20, 21}},
+			expectedSingles: []uint64{10, 11},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batches, singles := planKeysignBatches(tt.scheduled, tt.pending, tt.height, tt.interval, tt.isFailed)
+			require.Equal(t, tt.expectedBatches, batches)
+			require.Equal(t, tt.expectedSingles, singles)
+		})
+	}
+}
+
+func TestSigner_SignWithKeysignBatch(t *testing.T) {
+	// ARRANGE
+	ctx := makeCtx(t)
+	evmSigner := newTestSuite(t)
+
+	nonces := []uint64{20, 21}
+	batch := newKeysignBatch(evmSigner.TSS(), evmSigner.Chain().ChainId, 123, nonces, zerolog.Nop())
+	ctx = withKeysignBatch(ctx, batch)
+
+	txDatas := make([]*OutboundData, len(nonces))
+	for i, nonce := range nonces {
+		txData, skip, err := NewOutboundData(ctx, getCCTX(t), 123, zerolog.Nop())
+		require.NoError(t, err)
+		require.False(t, skip)
+
+		txData.nonce = nonce
+		txDatas[i] = txData
+	}
+
+	// ACT
+	var (
+		wg   sync.WaitGroup
+		txs  = make([]*ethtypes.Transaction, len(nonces))
+		errs = make([]error, len(nonces))
+	)
+	for i := range nonces {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			txs[i], errs[i] = evmSigner.SignGasWithdraw(ctx, txDatas[i])
+		}(i)
+	}
+	wg.Wait()
+
+	// ASSERT
+	for i, nonce := range nonces {
+		require.NoError(t, errs[i])
+		verifyTxSender(t, txs[i], evmSigner.tss.PubKey().AddressEVM(), evmSigner.EvmSigner())
+		verifyTxBodyBasics(t, txs[i], txDatas[i].to, nonce, txDatas[i].amount)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +26,7 @@ import (
 	"github.com/zeta-chain/node/zetaclient/compliance"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
 	"github.com/zeta-chain/node/zetaclient/logs"
+	"github.com/zeta-chain/node/zetaclient/metrics"
 	"github.com/zeta-chain/node/zetaclient/zetacore"
 )
 
@@ -56,6 +58,11 @@ type Signer struct {
 
 	// gatewayAddress is the address of the Gateway contract
 	gatewayAddress ethcommon.Address
+
+	// failedKeysignBatches are the nonce windows whose batch keysign failed,
+	// their outbounds are signed separately until the window is processed
+	failedKeysignBatches   map[uint64]struct{}
+	failedKeysignBatchesMu sync.Mutex
 }
 
 // New Signer constructor
@@ -72,6 +79,7 @@ func New(
 		zetaConnectorAddress: zetaConnectorAddress,
 		er20CustodyAddress:   erc20CustodyAddress,
 		gatewayAddress:       gatewayAddress,
+		failedKeysignBatches: make(map[uint64]struct{}),
 	}, nil
 }
 
@@ -166,7 +174,7 @@ func (signer *Signer) Sign(
 
 	hashBytes := signer.client.Hash(tx).Bytes()
 
-	sig, err := signer.TSS().Sign(ctx, hashBytes, height, nonce, signer.Chain().ChainId)
+	sig, err := signer.signDigest(ctx, hashBytes, height, nonce)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -187,6 +195,27 @@ func (signer *Signer) Sign(
 	return signedTX, sig[:], hashBytes[:], nil
 }
 
+// signDigest signs the digest using TSS.
+// If the outbound is part of a keysign batch, the digest is signed along with the other outbounds of the batch.
+func (signer *Signer) signDigest(ctx context.Context, digest []byte, height, nonce uint64) ([65]byte, error) {
+	var (
+		sig [65]byte
+		err error
+	)
+
+	if batch := keysignBatchFromContext(ctx); batch != nil {
+		sig, err = batch.sign(ctx, digest, height, nonce)
+	} else {
+		sig, err = signer.TSS().Sign(ctx, digest, height, nonce, signer.Chain().ChainId)
+	}
+
+	if err == nil {
+		metrics.OutboundSigned(signer.Chain().Name, nonce)
+	}
+
+	return sig, err
+}
+
 func newTx(
 	_ *big.Int,
 	data []byte,
@@ -230,6 +259,90 @@ func (signer *Signer) broadcast(ctx context.Context, tx *ethtypes.Transaction) e
 	return signer.client.SendTransaction(ctx, tx)
 }
 
+// TryProcessOutbounds processes the given outbounds concurrently.
+// Instead of one TSS keysign ceremony per outbound, their digests are signed together
+// in batches of at most maxKeysignBatchSize outbounds, ordered by nonce (see planKeysignBatches).
+func (signer *Signer) TryProcessOutbounds(
+	ctx context.Context,
+	cctxs []*crosschaintypes.CrossChainTx,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
+	scheduleInterval uint64,
+) {
+	if len(cctxs) == 0 {
+		return
+	}
+
+	chainID := signer.Chain().ChainId
+	byNonce := make(map[uint64]*crosschaintypes.CrossChainTx, len(cctxs))
+	nonces := make([]uint64, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		nonce := cctx.GetCurrentOutboundParam().TssNonce
+		byNonce[nonce] = cctx
+		nonces = append(nonces, nonce)
+	}
+
+	// the pending nonces delimit the batches, sign all outbounds separately without them
+	pending, err := zetacoreClient.GetPendingNoncesByChain(ctx, chainID)
+	if err != nil {
+		signer.Logger().Std.Error().Err(err).Msg("unable to get pending nonces, keysign batching disabled")
+		for _, cctx := range cctxs {
+			go signer.TryProcessOutbound(ctx, cctx, zetacoreClient, height)
+		}
+		return
+	}
+
+	batches, singles := planKeysignBatches(nonces, pending, height, scheduleInterval, signer.isKeysignBatchFailed)
+
+	for _, batchNonces := range batches {
+		window := keysignBatchWindow(batchNonces[0])
+		keysignBatch := newKeysignBatch(signer.TSS(), chainID, height, batchNonces, signer.Logger().Std)
+		keysignBatch.onFailure = func() { signer.setKeysignBatchFailed(window) }
+		batchCtx := withKeysignBatch(ctx, keysignBatch)
+
+		for _, nonce := range batchNonces {
+			go signer.TryProcessOutbound(batchCtx, byNonce[nonce], zetacoreClient, height)
+		}
+	}
+
+	for _, nonce := range singles {
+		go signer.TryProcessOutbound(ctx, byNonce[nonce], zetacoreClient, height)
+	}
+
+	// forget the failed windows that are fully processed
+	// #nosec G115 non-negative
+	signer.pruneFailedKeysignBatches(keysignBatchWindow(uint64(pending.NonceLow)))
+}
+
+// setKeysignBatchFailed marks the batch keysign of the nonce window as failed
+func (signer *Signer) setKeysignBatchFailed(window uint64) {
+	signer.failedKeysignBatchesMu.Lock()
+	defer signer.failedKeysignBatchesMu.Unlock()
+
+	signer.failedKeysignBatches[window] = struct{}{}
+}
+
+// isKeysignBatchFailed returns true if the batch keysign of the nonce window failed before
+func (signer *Signer) isKeysignBatchFailed(window uint64) bool {
+	signer.failedKeysignBatchesMu.Lock()
+	defer signer.failedKeysignBatchesMu.Unlock()
+
+	_, failed := signer.failedKeysignBatches[window]
+	return failed
+}
+
+// pruneFailedKeysignBatches forgets the failed nonce windows below the given window
+func (signer *Signer) pruneFailedKeysignBatches(lowestWindow uint64) {
+	signer.failedKeysignBatchesMu.Lock()
+	defer signer.failedKeysignBatchesMu.Unlock()
+
+	for window := range signer.failedKeysignBatches {
+		if window < lowestWindow {
+			delete(signer.failedKeysignBatches, window)
+		}
+	}
+}
+
 // TryProcessOutbound - signer interface implementation
 // This function will attempt to build and sign an evm transaction using the TSS signer.
 // It will then broadcast the signed transaction to the outbound chain.
@@ -249,6 +362,11 @@ func (signer *Signer) TryProcessOutbound(
 		if r := recover(); r != nil {
 			signer.Logger().Std.Error().Msgf("TryProcessOutbound: %s, caught panic error: %v", cctx.Index, r)
 		}
+
+		// let the other outbounds of the keysign batch proceed if this one didn't sign
+		if batch := keysignBatchFromContext(ctx); batch != nil {
+			batch.leave(ctx, cctx.GetCurrentOutboundParam().TssNonce)
+		}
 	}()
 
 	// prepare logger and a few local variables
@@ -326,7 +444,7 @@ func (signer *Signer) SignOutboundFromCCTX(
 	zetacoreClient interfaces.ZetacoreClient,
 	toChain zctx.Chain,
 ) (*ethtypes.Transaction, error) {
-	if compliance.IsCctxRestricted(cctx) {
+	if compliance.IsCctxRestricted(ctx, cctx) {
 		// restricted cctx
 		compliance.PrintComplianceLog(
 			logger,
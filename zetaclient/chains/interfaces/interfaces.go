@@ -49,6 +49,7 @@ type ZetacoreVoter interface {
 		msg *crosschaintypes.MsgVoteOutbound,
 	) (string, string, error)
 	PostVoteBlameData(ctx context.Context, blame *blame.Blame, chainID int64, index string) (string, error)
+	PostVoteHaltChain(ctx context.Context, chainID int64, reason string) (string, error)
 }
 
 // ZetacoreClient is the client interface to interact with zetacore
@@ -120,10 +120,13 @@ func (ob *Observer) FilterInboundEventsAndVote(ctx context.Context, txResult *rp
 	if err != nil {
 		return errors.Wrapf(err, "error FilterInboundEvent")
 	}
+	if txResult.BlockTime != nil {
+		ob.SetInboundBlockTime(txResult.Slot, txResult.BlockTime.Time())
+	}
 
 	// build inbound vote message from events and post to zetacore
 	for _, event := range events {
-		msg := ob.BuildInboundVoteMsgFromEvent(event)
+		msg := ob.BuildInboundVoteMsgFromEvent(ctx, event)
 		if msg != nil {
 			_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 			if err != nil {
@@ -236,9 +239,12 @@ func FilterInboundEvents(
 }
 
 // BuildInboundVoteMsgFromEvent builds a MsgVoteInbound from an inbound event
-func (ob *Observer) BuildInboundVoteMsgFromEvent(event *clienttypes.InboundEvent) *crosschaintypes.MsgVoteInbound {
+func (ob *Observer) BuildInboundVoteMsgFromEvent(
+	ctx context.Context,
+	event *clienttypes.InboundEvent,
+) *crosschaintypes.MsgVoteInbound {
 	// check if the event is processable
-	if !ob.IsEventProcessable(*event) {
+	if !ob.IsEventProcessable(ctx, *event) {
 		return nil
 	}
 
@@ -267,10 +273,10 @@ func (ob *Observer) BuildInboundVoteMsgFromEvent(event *clienttypes.InboundEvent
 }
 
 // IsEventProcessable checks if the inbound event is processable
-func (ob *Observer) IsEventProcessable(event clienttypes.InboundEvent) bool {
+func (ob *Observer) IsEventProcessable(ctx context.Context, event clienttypes.InboundEvent) bool {
 	logFields := map[string]any{logs.FieldTx: event.TxHash}
 
-	switch category := event.Category(); category {
+	switch category := event.Category(ctx); category {
 	case clienttypes.InboundCategoryProcessable:
 		return true
 	case clienttypes.InboundCategoryDonation:
@@ -139,7 +139,7 @@ func Test_BuildInboundVoteMsgFromEvent(t *testing.T) {
 		message := sample.Bytes()
 		event := sample.InboundEvent(chain.ChainId, sender, receiver.Hex(), 1280, message)
 
-		msg := ob.BuildInboundVoteMsgFromEvent(event)
+		msg := ob.BuildInboundVoteMsgFromEvent(context.Background(), event)
 		require.NotNil(t, msg)
 		require.Equal(t, sender, msg.Sender)
 		require.Equal(t, receiver.Hex(), msg.Receiver)
@@ -155,7 +155,7 @@ func Test_BuildInboundVoteMsgFromEvent(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{sender}
 		config.LoadComplianceConfig(cfg)
 
-		msg := ob.BuildInboundVoteMsgFromEvent(event)
+		msg := ob.BuildInboundVoteMsgFromEvent(context.Background(), event)
 		require.Nil(t, msg)
 	})
 }
@@ -203,7 +203,7 @@ func Test_IsEventProcessable(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ob.IsEventProcessable(tt.event)
+			result := ob.IsEventProcessable(context.Background(), tt.event)
 			require.Equal(t, tt.result, result)
 		})
 	}
@@ -2,6 +2,7 @@ package observer
 
 import (
 	"context"
+	"time"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
@@ -89,7 +90,9 @@ func (ob *Observer) CheckRPCStatus(ctx context.Context) error {
 	privnet := ob.Chain().NetworkType == chains.NetworkType_privnet
 
 	// check the RPC status
-	blockTime, err := zetasolrpc.CheckRPCStatus(ctx, ob.solClient, privnet)
+	blockTime, err := ob.CheckRPCHealth(ctx, func(ctx context.Context) (time.Time, error) {
+		return zetasolrpc.CheckRPCStatus(ctx, ob.solClient, privnet)
+	})
 	if err != nil {
 		return errors.Wrap(err, "unable to check rpc status")
 	}
@@ -17,6 +17,8 @@ import (
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/compliance"
 	"github.com/zeta-chain/node/zetaclient/logs"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 	"github.com/zeta-chain/node/zetaclient/zetacore"
 )
 
@@ -114,7 +116,7 @@ func (ob *Observer) VoteOutboundIfConfirmed(ctx context.Context, cctx *crosschai
 	}
 
 	// compliance check, special handling the cancelled cctx
-	if compliance.IsCctxRestricted(cctx) {
+	if compliance.IsCctxRestricted(ctx, cctx) {
 		// use cctx's amount to bypass the amount check in zetacore
 		outboundAmount = cctx.GetCurrentOutboundParam().Amount.BigInt()
 	}
@@ -168,6 +170,8 @@ func (ob *Observer) PostVoteOutbound(
 		logFields["vote"] = zetaTxHash
 		logFields["ballot"] = ballot
 		ob.Logger().Outbound.Info().Fields(logFields).Msg("PostVoteOutbound: posted outbound vote successfully")
+		ob.RecordVote(ctx, clienttypes.ToOutboundVoteSQLType(msg, ballot, zetaTxHash))
+		metrics.OutboundConfirmed(ob.Chain().Name, msg.OutboundTssNonce)
 	}
 }
 
@@ -291,16 +295,26 @@ func ParseGatewayInstruction(
 		return nil, errors.Wrap(err, "error unmarshaling transaction")
 	}
 
-	// there should be only one single instruction ('withdraw' or 'withdraw_spl_token')
-	if len(tx.Message.Instructions) != 1 {
-		return nil, fmt.Errorf("want 1 instruction, got %d", len(tx.Message.Instructions))
+	// the outbound may carry compute budget instructions (compute unit limit and price)
+	// besides the single gateway instruction ('withdraw', 'withdraw_spl_token', etc.)
+	var (
+		instruction solana.CompiledInstruction
+		programID   solana.PublicKey
+		count       int
+	)
+	for _, inst := range tx.Message.Instructions {
+		pid, err := tx.Message.Program(inst.ProgramIDIndex)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting program ID")
+		}
+		if pid.Equals(solana.ComputeBudget) {
+			continue
+		}
+		instruction, programID = inst, pid
+		count++
 	}
-	instruction := tx.Message.Instructions[0]
-
-	// get the program ID
-	programID, err := tx.Message.Program(instruction.ProgramIDIndex)
-	if err != nil {
-		return nil, errors.Wrap(err, "error getting program ID")
+	if count != 1 {
+		return nil, fmt.Errorf("want 1 instruction, got %d", count)
 	}
 
 	// the instruction should be an invocation of the gateway program
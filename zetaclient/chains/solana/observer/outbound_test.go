@@ -2,10 +2,14 @@ package observer_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -223,6 +227,46 @@ func Test_ParseGatewayInstruction(t *testing.T) {
 		require.EqualValues(t, inst.TokenAmount(), txAmount)
 	})
 
+	t.Run("should parse gateway instruction in tx with compute budget instructions", func(t *testing.T) {
+		// ARRANGE
+		// rebuild the archived withdraw as the signer does when paying a priority fee
+		txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, txHash)
+		txResult = withComputeBudgetInstructions(t, txResult, 100_000, 1_000)
+
+		tx, err := txResult.Transaction.GetTransaction()
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 3)
+
+		// ACT
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_Gas)
+		require.NoError(t, err)
+
+		// ASSERT
+		sender, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, tssAddressTest, sender.String())
+		require.EqualValues(t, inst.GatewayNonce(), 0)
+		require.EqualValues(t, inst.TokenAmount(), txAmount)
+	})
+
+	t.Run("should return error on multiple gateway instructions", func(t *testing.T) {
+		// ARRANGE
+		// load and unmarshal archived transaction
+		txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, txHash)
+		tx, err := txResult.Transaction.GetTransaction()
+		require.NoError(t, err)
+
+		// duplicate the gateway instruction
+		tx.Message.Instructions = append(tx.Message.Instructions, tx.Message.Instructions[0])
+
+		// ACT
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_Gas)
+
+		// ASSERT
+		require.ErrorContains(t, err, "want 1 instruction, got 2")
+		require.Nil(t, inst)
+	})
+
 	t.Run("should return error on invalid number of instructions", func(t *testing.T) {
 		// ARRANGE
 		// load and unmarshal archived transaction
@@ -309,6 +353,52 @@ func Test_ParseGatewayInstruction(t *testing.T) {
 	})
 }
 
+// withComputeBudgetInstructions returns a tx result whose transaction carries the compute budget
+// instructions followed by the gateway instruction of the given tx result
+func withComputeBudgetInstructions(
+	t *testing.T,
+	txResult *rpc.GetTransactionResult,
+	computeUnitLimit uint32,
+	priorityFee uint64,
+) *rpc.GetTransactionResult {
+	tx, err := txResult.Transaction.GetTransaction()
+	require.NoError(t, err)
+	require.Len(t, tx.Message.Instructions, 1)
+
+	// rebuild the gateway instruction
+	compiled := tx.Message.Instructions[0]
+	programID, err := tx.Message.Program(compiled.ProgramIDIndex)
+	require.NoError(t, err)
+	accounts, err := compiled.ResolveInstructionAccounts(&tx.Message)
+	require.NoError(t, err)
+	gatewayInst := solana.NewInstruction(programID, accounts, compiled.Data)
+
+	newTx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			computebudget.NewSetComputeUnitLimitInstruction(computeUnitLimit).Build(),
+			computebudget.NewSetComputeUnitPriceInstruction(priorityFee).Build(),
+			gatewayInst,
+		},
+		tx.Message.RecentBlockhash,
+		solana.TransactionPayer(tx.Message.AccountKeys[0]),
+	)
+	require.NoError(t, err)
+	newTx.Signatures = tx.Signatures
+
+	txBytes, err := newTx.MarshalBinary()
+	require.NoError(t, err)
+
+	// wrap the binary transaction the way RPC returns it in base64 encoding
+	envelope := &rpc.TransactionResultEnvelope{}
+	envelopeJSON := fmt.Sprintf(`["%s","base64"]`, base64.StdEncoding.EncodeToString(txBytes))
+	require.NoError(t, envelope.UnmarshalJSON([]byte(envelopeJSON)))
+
+	result := *txResult
+	result.Transaction = envelope
+
+	return &result
+}
+
 func Test_ParseInstructionWithdraw(t *testing.T) {
 	// the test chain and transaction hash
 	chain := chains.SolanaDevnet
@@ -7,9 +7,11 @@ import (
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
 	"github.com/pkg/errors"
 
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/node/zetaclient/rpcpool"
 )
 
 const (
@@ -27,6 +29,15 @@ const (
 // ErrUnsupportedTxVersion is returned when the transaction version is not supported by zetaclient
 var ErrUnsupportedTxVersion = errors.New("unsupported tx version")
 
+// QuorumMethods are the methods that must be agreed on by the quorum of endpoints
+var QuorumMethods = []string{"getTransaction", "getBlock"}
+
+// NewFromPool creates a Solana RPC client sending the requests through a pool of endpoints
+func NewFromPool(pool *rpcpool.Pool) *rpc.Client {
+	rpcClient := jsonrpc.NewClientWithOpts(pool.URL(), &jsonrpc.RPCClientOpts{HTTPClient: pool.HTTPClient()})
+	return rpc.NewWithCustomRPCClient(rpcClient)
+}
+
 // GetFirstSignatureForAddress searches the first signature for the given address.
 // Note: make sure that the rpc provider used has enough transaction history.
 func GetFirstSignatureForAddress(
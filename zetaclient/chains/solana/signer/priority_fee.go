@@ -0,0 +1,171 @@
+package signer
+
+import (
+	"context"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/node/zetaclient/logs"
+)
+
+const (
+	// defaultPriorityFeeRetryInterval is the default interval a pending outbound waits before being re-signed.
+	// It's longer than the lifetime of a blockhash (150 blocks, 60 ~90 secs), so the previous tx has expired.
+	defaultPriorityFeeRetryInterval = 90 * time.Second
+
+	// priorityFeeRetries is the maximum number of times a pending outbound is re-signed with the priority fee
+	// of the cctx. The outbound will be picked up by the next keysign schedule once the retries are exhausted.
+	priorityFeeRetries = 3
+)
+
+// Opt is a Signer option
+type Opt func(signer *Signer)
+
+// WithPriorityFeeRetryInterval sets the interval a pending outbound waits before being re-signed.
+// A zero interval keeps the default one.
+func WithPriorityFeeRetryInterval(interval time.Duration) Opt {
+	return func(signer *Signer) {
+		if interval > 0 {
+			signer.priorityFeeRetryInterval = interval
+		}
+	}
+}
+
+// pendingOutbound is an outbound instruction broadcasted by the signer and not yet processed by the gateway
+type pendingOutbound struct {
+	inst          *solana.GenericInstruction
+	priorityFee   uint64
+	broadcastedAt time.Time
+	retries       int
+}
+
+// addPendingOutbound records the instruction broadcasted for the outbound nonce, so it can be re-signed
+// on a later schedule if it stays pending
+func (signer *Signer) addPendingOutbound(nonce uint64, inst *solana.GenericInstruction, priorityFee uint64) {
+	signer.pendingMu.Lock()
+	defer signer.pendingMu.Unlock()
+
+	signer.pendingOutbounds[nonce] = &pendingOutbound{
+		inst:          inst,
+		priorityFee:   priorityFee,
+		broadcastedAt: time.Now(),
+	}
+}
+
+// PendingOutboundRetry returns whether the outbound nonce has a broadcasted instruction waiting to be processed,
+// and whether it is due to be re-signed (its retry interval has elapsed)
+func (signer *Signer) PendingOutboundRetry(nonce uint64) (due bool, found bool) {
+	signer.pendingMu.Lock()
+	defer signer.pendingMu.Unlock()
+
+	pending, found := signer.pendingOutbounds[nonce]
+	if !found {
+		return false, false
+	}
+
+	return time.Since(pending.broadcastedAt) >= signer.priorityFeeRetryInterval, true
+}
+
+// PrunePendingOutbounds forgets the broadcasted instructions of the nonces below the lowest pending nonce
+func (signer *Signer) PrunePendingOutbounds(lowestPendingNonce uint64) {
+	signer.pendingMu.Lock()
+	defer signer.pendingMu.Unlock()
+
+	for nonce := range signer.pendingOutbounds {
+		if nonce < lowestPendingNonce {
+			delete(signer.pendingOutbounds, nonce)
+		}
+	}
+}
+
+// RetryPendingOutbound re-signs the broadcasted instruction of the outbound with a fresh blockhash
+// and the current priority fee of the cctx, which is increased by zetacore while the cctx stays pending.
+// It's called by the keysign schedule once the retry interval of the outbound has elapsed.
+func (signer *Signer) RetryPendingOutbound(
+	ctx context.Context,
+	cctx *types.CrossChainTx,
+	zetacoreClient interfaces.ZetacoreClient,
+) {
+	outboundID := base.OutboundIDFromCCTX(cctx)
+	signer.MarkOutbound(outboundID, true)
+	defer signer.MarkOutbound(outboundID, false)
+
+	var (
+		params  = cctx.GetCurrentOutboundParam()
+		nonce   = params.TssNonce
+		chainID = signer.Chain().ChainId
+		logger  = signer.Logger().Std.With().
+			Str(logs.FieldMethod, "RetryPendingOutbound").
+			Int64(logs.FieldChain, chainID).
+			Uint64(logs.FieldNonce, nonce).
+			Str(logs.FieldCctx, cctx.Index).
+			Logger()
+	)
+
+	signer.pendingMu.Lock()
+	pending, found := signer.pendingOutbounds[nonce]
+	if found {
+		pending.retries++
+		pending.broadcastedAt = time.Now()
+
+		// the outbound goes back to the keysign schedule once the retries are exhausted
+		if pending.retries >= priorityFeeRetries {
+			delete(signer.pendingOutbounds, nonce)
+		}
+	}
+	signer.pendingMu.Unlock()
+
+	if !found {
+		return
+	}
+
+	// stop retrying once the outbound is processed by the gateway
+	pdaNonce, err := signer.getPDANonce(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to get PDA nonce")
+		return
+	}
+	if pdaNonce > nonce {
+		signer.PrunePendingOutbounds(pdaNonce)
+		return
+	}
+
+	// the priority fee of the cctx is increased by zetacore, keep the previous one if it's higher
+	priorityFee, err := params.GetGasPriorityFeeUInt64()
+	if err != nil {
+		logger.Error().Err(err).Msgf("invalid priority fee %s", params.GasPriorityFee)
+		return
+	}
+	priorityFee = max(priorityFee, pending.priorityFee)
+
+	tx, err := signer.signTx(ctx, pending.inst, priorityFee)
+	if err != nil {
+		logger.Error().Err(err).Uint64("priority_fee", priorityFee).Msg("unable to re-sign outbound")
+		return
+	}
+
+	txSig, err := signer.client.SendTransactionWithOpts(
+		ctx,
+		tx,
+		rpc.TransactionOpts{PreflightCommitment: rpc.CommitmentProcessed},
+	)
+	if err != nil {
+		logger.Warn().Err(err).Uint64("priority_fee", priorityFee).Msg("SendTransactionWithOpts failed")
+		return
+	}
+	logger.Info().
+		Str(logs.FieldTx, txSig.String()).
+		Uint64("priority_fee", priorityFee).
+		Msg("re-broadcasted pending Solana outbound with the priority fee of the cctx")
+
+	signer.pendingMu.Lock()
+	pending.priorityFee = priorityFee
+	signer.pendingMu.Unlock()
+
+	signer.reportToOutboundTracker(ctx, zetacoreClient, chainID, nonce, txSig, logger)
+}
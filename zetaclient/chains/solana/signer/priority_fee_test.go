@@ -0,0 +1,206 @@
+package signer
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/keys"
+	"github.com/zeta-chain/node/zetaclient/testutils"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_SignTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should sign tx without compute budget instructions if priority fee is zero", func(t *testing.T) {
+		s, client := newTestSigner(t)
+		mockLatestBlockhash(client)
+
+		tx, err := s.signTx(ctx, sampleInstruction(), 0)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 1)
+		require.Len(t, tx.Signatures, 1)
+	})
+
+	t.Run("should sign tx with compute budget instructions", func(t *testing.T) {
+		s, client := newTestSigner(t)
+		mockLatestBlockhash(client)
+
+		tx, err := s.signTx(ctx, sampleInstruction(), 25_000)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 3)
+		require.EqualValues(t, 25_000, computeUnitPrice(t, tx))
+	})
+
+	t.Run("should fail if unable to get latest blockhash", func(t *testing.T) {
+		s, client := newTestSigner(t)
+		client.On("GetLatestBlockhash", mock.Anything, mock.Anything).Return(nil, errors.New("rpc error"))
+
+		tx, err := s.signTx(ctx, sampleInstruction(), 25_000)
+		require.ErrorContains(t, err, "getLatestBlockhash error")
+		require.Nil(t, tx)
+	})
+}
+
+func Test_RetryPendingOutbound(t *testing.T) {
+	ctx := context.Background()
+	nonce := uint64(10)
+
+	// pendingCCTX returns a pending cctx of the nonce with the given priority fee
+	pendingCCTX := func(priorityFee string) *crosschaintypes.CrossChainTx {
+		cctx := sample.CrossChainTx(t, "0x1")
+		cctx.GetCurrentOutboundParam().TssNonce = nonce
+		cctx.GetCurrentOutboundParam().GasPriorityFee = priorityFee
+		return cctx
+	}
+
+	// recordPriorityFees records the priority fees of the re-broadcasted txs
+	recordPriorityFees := func(client *mocks.SolanaRPCClient) *[]uint64 {
+		priorityFees := []uint64{}
+		client.On("SendTransactionWithOpts", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				tx := args.Get(1).(*solana.Transaction)
+				priorityFees = append(priorityFees, computeUnitPrice(t, tx))
+			}).
+			Return(solana.Signature{}, nil)
+		client.On("GetTransaction", mock.Anything, mock.Anything, mock.Anything).
+			Maybe().
+			Return(nil, errors.New("not found"))
+		return &priorityFees
+	}
+
+	t.Run("should be due once the retry interval has elapsed", func(t *testing.T) {
+		s, _ := newTestSigner(t)
+		s.priorityFeeRetryInterval = time.Hour
+
+		due, found := s.PendingOutboundRetry(nonce)
+		require.False(t, found)
+		require.False(t, due)
+
+		s.addPendingOutbound(nonce, sampleInstruction(), 10_000)
+		due, found = s.PendingOutboundRetry(nonce)
+		require.True(t, found)
+		require.False(t, due)
+
+		s.priorityFeeRetryInterval = time.Nanosecond
+		due, found = s.PendingOutboundRetry(nonce)
+		require.True(t, found)
+		require.True(t, due)
+
+		s.PrunePendingOutbounds(nonce + 1)
+		_, found = s.PendingOutboundRetry(nonce)
+		require.False(t, found)
+	})
+
+	t.Run("should not retry if the outbound is processed", func(t *testing.T) {
+		s, client := newTestSigner(t)
+		mockPDANonce(t, client, nonce+1)
+		s.addPendingOutbound(nonce, sampleInstruction(), 10_000)
+
+		s.RetryPendingOutbound(ctx, pendingCCTX("10000"), nil)
+		client.AssertNotCalled(t, "SendTransactionWithOpts", mock.Anything, mock.Anything, mock.Anything)
+
+		_, found := s.PendingOutboundRetry(nonce)
+		require.False(t, found)
+	})
+
+	t.Run("should re-sign pending outbound with the priority fee of the cctx", func(t *testing.T) {
+		s, client := newTestSigner(t)
+		mockPDANonce(t, client, nonce)
+		mockLatestBlockhash(client)
+		priorityFees := recordPriorityFees(client)
+		s.addPendingOutbound(nonce, sampleInstruction(), 10_000)
+
+		// the priority fee of the cctx is increased by zetacore between the retries
+		for _, priorityFee := range []string{"10000", "15000", "22500"} {
+			s.RetryPendingOutbound(ctx, pendingCCTX(priorityFee), nil)
+		}
+		require.Equal(t, []uint64{10_000, 15_000, 22_500}, *priorityFees)
+
+		// the outbound goes back to the keysign schedule once the retries are exhausted
+		_, found := s.PendingOutboundRetry(nonce)
+		require.False(t, found)
+	})
+
+	t.Run("should keep the broadcasted priority fee if the cctx one is lower", func(t *testing.T) {
+		s, client := newTestSigner(t)
+		mockPDANonce(t, client, nonce)
+		mockLatestBlockhash(client)
+		priorityFees := recordPriorityFees(client)
+		s.addPendingOutbound(nonce, sampleInstruction(), 20_000)
+
+		s.RetryPendingOutbound(ctx, pendingCCTX("10000"), nil)
+		require.Equal(t, []uint64{20_000}, *priorityFees)
+	})
+}
+
+// newTestSigner creates a signer with relayer key and a short priority fee retry interval
+func newTestSigner(t *testing.T) (*Signer, *mocks.SolanaRPCClient) {
+	chain := chains.SolanaDevnet
+	relayerKey := &keys.RelayerKey{
+		PrivateKey: "3EMjCcCJg53fMEGVj13UPQpo6py9AKKyLE2qroR4yL1SvAN2tUznBvDKRYjntw7m6Jof1R2CSqjTddL27rEb6sFQ",
+	}
+
+	client := mocks.NewSolanaRPCClient(t)
+	baseSigner := base.NewSigner(chain, nil, base.DefaultLogger())
+	s, err := New(
+		baseSigner,
+		client,
+		testutils.GatewayAddresses[chain.ChainId],
+		relayerKey,
+		WithPriorityFeeRetryInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	return s, client
+}
+
+func sampleInstruction() *solana.GenericInstruction {
+	return solana.NewInstruction(solana.SystemProgramID, solana.AccountMetaSlice{}, []byte{0x01})
+}
+
+func mockLatestBlockhash(client *mocks.SolanaRPCClient) {
+	client.On("GetLatestBlockhash", mock.Anything, mock.Anything).Return(&rpc.GetLatestBlockhashResult{
+		Value: &rpc.LatestBlockhashResult{Blockhash: solana.Hash{0x01}},
+	}, nil)
+}
+
+func mockPDANonce(t *testing.T, client *mocks.SolanaRPCClient, nonce uint64) {
+	data, err := borsh.Serialize(contracts.PdaInfo{Nonce: nonce})
+	require.NoError(t, err)
+
+	client.On("GetAccountInfo", mock.Anything, mock.Anything).Return(&rpc.GetAccountInfoResult{
+		Value: &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(data)},
+	}, nil)
+}
+
+// computeUnitPrice decodes the compute unit price from the compute budget instruction of the tx
+func computeUnitPrice(t *testing.T, tx *solana.Transaction) uint64 {
+	for _, inst := range tx.Message.Instructions {
+		programID, err := tx.Message.Program(inst.ProgramIDIndex)
+		require.NoError(t, err)
+
+		// SetComputeUnitPrice instruction: 1 byte discriminator followed by u64 micro-lamports
+		if programID.Equals(computebudget.ProgramID) && inst.Data[0] == computebudget.Instruction_SetComputeUnitPrice {
+			return binary.LittleEndian.Uint64(inst.Data[1:])
+		}
+	}
+
+	t.Fatal("compute unit price instruction not found")
+	return 0
+}
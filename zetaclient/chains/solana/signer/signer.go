@@ -5,16 +5,19 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"cosmossdk.io/errors"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
 	"github.com/gagliardetto/solana-go/programs/token"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/rs/zerolog"
 
 	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/constant"
 	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
@@ -54,6 +57,15 @@ type Signer struct {
 
 	// pda is the program derived address of the gateway program
 	pda solana.PublicKey
+
+	// priorityFeeRetryInterval is the interval a pending outbound waits before being re-signed with higher priority fee
+	priorityFeeRetryInterval time.Duration
+
+	// pendingOutbounds are the broadcasted outbound instructions by nonce, re-signed while they stay pending
+	pendingOutbounds map[uint64]*pendingOutbound
+
+	// pendingMu protects pendingOutbounds from concurrent access
+	pendingMu sync.Mutex
 }
 
 // New Signer constructor.
@@ -62,6 +74,7 @@ func New(
 	solClient interfaces.SolanaRPCClient,
 	gatewayAddress string,
 	relayerKey *keys.RelayerKey,
+	opts ...Opt,
 ) (*Signer, error) {
 	// parse gateway ID and PDA
 	gatewayID, pda, err := contracts.ParseGatewayWithPDA(gatewayAddress)
@@ -83,13 +96,21 @@ func New(
 		baseSigner.Logger().Std.Info().Msg("Solana relayer key is not provided")
 	}
 
-	return &Signer{
-		Signer:     baseSigner,
-		client:     solClient,
-		gatewayID:  gatewayID,
-		relayerKey: rk,
-		pda:        pda,
-	}, nil
+	signer := &Signer{
+		Signer:                   baseSigner,
+		client:                   solClient,
+		gatewayID:                gatewayID,
+		relayerKey:               rk,
+		pda:                      pda,
+		priorityFeeRetryInterval: defaultPriorityFeeRetryInterval,
+		pendingOutbounds:         make(map[uint64]*pendingOutbound),
+	}
+
+	for _, opt := range opts {
+		opt(signer)
+	}
+
+	return signer, nil
 }
 
 // HasRelayerKey returns true if the signer has a relayer key
@@ -131,68 +152,68 @@ func (signer *Signer) TryProcessOutbound(
 	nonce := params.TssNonce
 	coinType := cctx.InboundParams.CoinType
 
-	var tx *solana.Transaction
-	var fallbackTx *solana.Transaction
+	var inst *solana.GenericInstruction
+	var fallbackInst *solana.GenericInstruction
 
 	switch coinType {
 	case coin.CoinType_Cmd:
-		whitelistTx, err := signer.prepareWhitelistTx(ctx, cctx, height)
+		whitelistInst, err := signer.prepareWhitelistInstruction(ctx, cctx, height)
 		if err != nil {
 			logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign whitelist outbound")
 			return
 		}
 
-		tx = whitelistTx
+		inst = whitelistInst
 
 	case coin.CoinType_Gas:
 		if cctx.InboundParams.IsCrossChainCall && IsPendingOutboundFromZetaChain(cctx, zetacoreClient) {
-			executeTx, err := signer.prepareExecuteTx(ctx, cctx, height, logger)
+			executeInst, err := signer.prepareExecuteInstruction(ctx, cctx, height, logger)
 			if err != nil {
 				logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign execute outbound")
 				return
 			}
-			incrementNonceTx, err := signer.prepareIncrementNonceTx(ctx, cctx, height, logger)
+			incrementNonceInst, err := signer.prepareIncrementNonceInstruction(ctx, cctx, height, logger)
 			if err != nil {
 				logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign increment_nonce outbound")
 				return
 			}
 
-			tx = executeTx
-			fallbackTx = incrementNonceTx
+			inst = executeInst
+			fallbackInst = incrementNonceInst
 		} else {
-			withdrawTx, err := signer.prepareWithdrawTx(ctx, cctx, height, logger)
+			withdrawInst, err := signer.prepareWithdrawInstruction(ctx, cctx, height, logger)
 			if err != nil {
 				logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign withdraw outbound")
 				return
 			}
 
-			tx = withdrawTx
+			inst = withdrawInst
 		}
 
 	case coin.CoinType_ERC20:
 		if cctx.InboundParams.IsCrossChainCall && IsPendingOutboundFromZetaChain(cctx, zetacoreClient) {
-			executeSPLTx, err := signer.prepareExecuteSPLTx(ctx, cctx, height, logger)
+			executeSPLInst, err := signer.prepareExecuteSPLInstruction(ctx, cctx, height, logger)
 			if err != nil {
 				logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign execute spl outbound")
 				return
 			}
 
-			incrementNonceTx, err := signer.prepareIncrementNonceTx(ctx, cctx, height, logger)
+			incrementNonceInst, err := signer.prepareIncrementNonceInstruction(ctx, cctx, height, logger)
 			if err != nil {
 				logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign increment_nonce outbound")
 				return
 			}
 
-			tx = executeSPLTx
-			fallbackTx = incrementNonceTx
+			inst = executeSPLInst
+			fallbackInst = incrementNonceInst
 		} else {
-			withdrawSPLTx, err := signer.prepareWithdrawSPLTx(ctx, cctx, height, logger)
+			withdrawSPLInst, err := signer.prepareWithdrawSPLInstruction(ctx, cctx, height, logger)
 			if err != nil {
 				logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign withdraw spl outbound")
 				return
 			}
 
-			tx = withdrawSPLTx
+			inst = withdrawSPLInst
 		}
 	default:
 		logger.Error().
@@ -209,26 +230,70 @@ func (signer *Signer) TryProcessOutbound(
 	// set relayer balance metrics
 	signer.SetRelayerBalanceMetrics(ctx)
 
+	// sign the transactions by relayer key using the priority fee of the outbound
+	priorityFee, err := params.GetGasPriorityFeeUInt64()
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: invalid priority fee %s", params.GasPriorityFee)
+		return
+	}
+
+	tx, err := signer.signTx(ctx, inst, priorityFee)
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign outbound tx")
+		return
+	}
+
+	var fallbackTx *solana.Transaction
+	if fallbackInst != nil {
+		fallbackTx, err = signer.signTx(ctx, fallbackInst, priorityFee)
+		if err != nil {
+			logger.Error().Err(err).Msgf("TryProcessOutbound: Fail to sign fallback outbound tx")
+			return
+		}
+	}
+	metrics.OutboundSigned(signer.Chain().Name, nonce)
+
 	// broadcast the signed tx to the Solana network
-	signer.broadcastOutbound(ctx, tx, fallbackTx, chainID, nonce, logger, zetacoreClient)
+	broadcastedTx := signer.broadcastOutbound(ctx, tx, fallbackTx, chainID, nonce, logger, zetacoreClient)
+	if broadcastedTx == nil {
+		return
+	}
+
+	// the fallback instruction is retried if the original tx was replaced by the fallback tx
+	if broadcastedTx == fallbackTx {
+		inst = fallbackInst
+	}
+
+	// the keysign schedule re-signs the outbound with the priority fee of the cctx if it stays pending
+	signer.addPendingOutbound(nonce, inst, priorityFee)
 }
 
 // signTx creates and signs solana tx containing provided instruction with relayer key.
-func (signer *Signer) signTx(ctx context.Context, inst *solana.GenericInstruction) (*solana.Transaction, error) {
+// The compute unit price is set to the given priority fee (in micro-lamports) if it's not zero.
+func (signer *Signer) signTx(
+	ctx context.Context,
+	inst *solana.GenericInstruction,
+	priorityFee uint64,
+) (*solana.Transaction, error) {
 	// get a recent blockhash
 	recent, err := signer.client.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
 	if err != nil {
 		return nil, errors.Wrap(err, "getLatestBlockhash error")
 	}
 
-	// create a transaction that wraps the instruction
+	// prepend compute budget instructions to pay priority fee on top of the 5K lamports base fee
+	instructions := []solana.Instruction{inst}
+	if priorityFee > 0 {
+		instructions = []solana.Instruction{
+			computebudget.NewSetComputeUnitLimitInstruction(constant.SolanaComputeUnitLimit).Build(),
+			computebudget.NewSetComputeUnitPriceInstruction(priorityFee).Build(),
+			inst,
+		}
+	}
+
+	// create a transaction that wraps the instructions
 	tx, err := solana.NewTransaction(
-		[]solana.Instruction{
-			// TODO: outbound now uses 5K lamports as the fixed fee, we could explore priority fee and compute budget
-			// https://github.com/zeta-chain/node/issues/2599
-			// programs.ComputeBudgetSetComputeUnitLimit(computeUnitLimit),
-			// programs.ComputeBudgetSetComputeUnitPrice(computeUnitPrice),
-			inst},
+		instructions,
 		recent.Value.Blockhash,
 		solana.TransactionPayer(signer.relayerKey.PublicKey()),
 	)
@@ -251,6 +316,7 @@ func (signer *Signer) signTx(ctx context.Context, inst *solana.GenericInstructio
 }
 
 // broadcastOutbound sends the signed transaction to the Solana network
+// It returns the transaction that was broadcasted successfully, or nil if there is none.
 func (signer *Signer) broadcastOutbound(
 	ctx context.Context,
 	tx *solana.Transaction,
@@ -259,7 +325,7 @@ func (signer *Signer) broadcastOutbound(
 	nonce uint64,
 	logger zerolog.Logger,
 	zetacoreClient interfaces.ZetacoreClient,
-) {
+) *solana.Transaction {
 	// prepare logger fields
 	lf := map[string]any{
 		logs.FieldMethod: "broadcastOutbound",
@@ -274,17 +340,12 @@ func (signer *Signer) broadcastOutbound(
 		time.Sleep(backOff)
 
 		// PDA nonce may already be increased by other relayer, no need to retry
-		pdaInfo, err := signer.client.GetAccountInfo(ctx, signer.pda)
+		pdaNonce, err := signer.getPDANonce(ctx)
 		if err != nil {
-			logger.Error().Err(err).Fields(lf).Msgf("unable to get PDA account info")
-		} else {
-			pda, err := contracts.DeserializePdaInfo(pdaInfo)
-			if err != nil {
-				logger.Error().Err(err).Fields(lf).Msgf("unable to deserialize PDA info")
-			} else if pda.Nonce > nonce {
-				logger.Info().Err(err).Fields(lf).Msgf("PDA nonce %d is greater than outbound nonce, stop retrying", pda.Nonce)
-				break
-			}
+			logger.Error().Err(err).Fields(lf).Msgf("unable to get PDA nonce")
+		} else if pdaNonce > nonce {
+			logger.Info().Fields(lf).Msgf("PDA nonce %d is greater than outbound nonce, stop retrying", pdaNonce)
+			return nil
 		}
 
 		// broadcast the signed tx to the Solana network with preflight check
@@ -312,19 +373,36 @@ func (signer *Signer) broadcastOutbound(
 
 		// successful broadcast; report to the outbound tracker
 		signer.reportToOutboundTracker(ctx, zetacoreClient, chainID, nonce, txSig, logger)
-		break
+		return tx
 	}
+
+	return nil
 }
 
-func (signer *Signer) prepareIncrementNonceTx(
+// getPDANonce returns the current nonce of the gateway PDA
+func (signer *Signer) getPDANonce(ctx context.Context) (uint64, error) {
+	pdaInfo, err := signer.client.GetAccountInfo(ctx, signer.pda)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get PDA account info")
+	}
+
+	pda, err := contracts.DeserializePdaInfo(pdaInfo)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to deserialize PDA info")
+	}
+
+	return pda.Nonce, nil
+}
+
+func (signer *Signer) prepareIncrementNonceInstruction(
 	ctx context.Context,
 	cctx *types.CrossChainTx,
 	height uint64,
 	logger zerolog.Logger,
-) (*solana.Transaction, error) {
+) (*solana.GenericInstruction, error) {
 	params := cctx.GetCurrentOutboundParam()
 	// compliance check
-	cancelTx := compliance.IsCctxRestricted(cctx)
+	cancelTx := compliance.IsCctxRestricted(ctx, cctx)
 	if cancelTx {
 		compliance.PrintComplianceLog(
 			logger,
@@ -344,24 +422,24 @@ func (signer *Signer) prepareIncrementNonceTx(
 		return nil, err
 	}
 
-	// sign the increment_nonce transaction by relayer key
+	// create the increment_nonce instruction
 	inst, err := signer.createIncrementNonceInstruction(*msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating increment nonce instruction")
 	}
 
-	return signer.signTx(ctx, inst)
+	return inst, nil
 }
 
-func (signer *Signer) prepareWithdrawTx(
+func (signer *Signer) prepareWithdrawInstruction(
 	ctx context.Context,
 	cctx *types.CrossChainTx,
 	height uint64,
 	logger zerolog.Logger,
-) (*solana.Transaction, error) {
+) (*solana.GenericInstruction, error) {
 	params := cctx.GetCurrentOutboundParam()
 	// compliance check
-	cancelTx := compliance.IsCctxRestricted(cctx)
+	cancelTx := compliance.IsCctxRestricted(ctx, cctx)
 	if cancelTx {
 		compliance.PrintComplianceLog(
 			logger,
@@ -381,24 +459,24 @@ func (signer *Signer) prepareWithdrawTx(
 		return nil, errors.Wrap(err, "createAndSignMsgWithdraw error")
 	}
 
-	// sign the withdraw transaction by relayer key
+	// create the withdraw instruction
 	inst, err := signer.createWithdrawInstruction(*msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating withdraw instruction")
 	}
 
-	return signer.signTx(ctx, inst)
+	return inst, nil
 }
 
-func (signer *Signer) prepareExecuteTx(
+func (signer *Signer) prepareExecuteInstruction(
 	ctx context.Context,
 	cctx *types.CrossChainTx,
 	height uint64,
 	logger zerolog.Logger,
-) (*solana.Transaction, error) {
+) (*solana.GenericInstruction, error) {
 	params := cctx.GetCurrentOutboundParam()
 	// compliance check
-	cancelTx := compliance.IsCctxRestricted(cctx)
+	cancelTx := compliance.IsCctxRestricted(ctx, cctx)
 	if cancelTx {
 		compliance.PrintComplianceLog(
 			logger,
@@ -444,24 +522,24 @@ func (signer *Signer) prepareExecuteTx(
 		return nil, errors.Wrap(err, "createAndSignMsgExecute error")
 	}
 
-	// sign the execute transaction by relayer key
+	// create the execute instruction
 	inst, err := signer.createExecuteInstruction(*msgExecute)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating execute instruction")
 	}
 
-	return signer.signTx(ctx, inst)
+	return inst, nil
 }
 
-func (signer *Signer) prepareWithdrawSPLTx(
+func (signer *Signer) prepareWithdrawSPLInstruction(
 	ctx context.Context,
 	cctx *types.CrossChainTx,
 	height uint64,
 	logger zerolog.Logger,
-) (*solana.Transaction, error) {
+) (*solana.GenericInstruction, error) {
 	params := cctx.GetCurrentOutboundParam()
 	// compliance check
-	cancelTx := compliance.IsCctxRestricted(cctx)
+	cancelTx := compliance.IsCctxRestricted(ctx, cctx)
 	if cancelTx {
 		compliance.PrintComplianceLog(
 			logger,
@@ -494,24 +572,24 @@ func (signer *Signer) prepareWithdrawSPLTx(
 		return nil, errors.Wrap(err, "createAndSignMsgWithdrawSPL error")
 	}
 
-	// sign the withdraw transaction by relayer key
+	// create the withdraw spl instruction
 	inst, err := signer.createWithdrawSPLInstruction(*msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating withdraw SPL instruction")
 	}
 
-	return signer.signTx(ctx, inst)
+	return inst, nil
 }
 
-func (signer *Signer) prepareExecuteSPLTx(
+func (signer *Signer) prepareExecuteSPLInstruction(
 	ctx context.Context,
 	cctx *types.CrossChainTx,
 	height uint64,
 	logger zerolog.Logger,
-) (*solana.Transaction, error) {
+) (*solana.GenericInstruction, error) {
 	params := cctx.GetCurrentOutboundParam()
 	// compliance check
-	cancelTx := compliance.IsCctxRestricted(cctx)
+	cancelTx := compliance.IsCctxRestricted(ctx, cctx)
 	if cancelTx {
 		compliance.PrintComplianceLog(
 			logger,
@@ -566,20 +644,20 @@ func (signer *Signer) prepareExecuteSPLTx(
 		return nil, err
 	}
 
-	// sign the execute spl transaction by relayer key
+	// create the execute spl instruction
 	inst, err := signer.createExecuteSPLInstruction(*msgExecuteSpl)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating execute SPL instruction")
 	}
 
-	return signer.signTx(ctx, inst)
+	return inst, nil
 }
 
-func (signer *Signer) prepareWhitelistTx(
+func (signer *Signer) prepareWhitelistInstruction(
 	ctx context.Context,
 	cctx *types.CrossChainTx,
 	height uint64,
-) (*solana.Transaction, error) {
+) (*solana.GenericInstruction, error) {
 	params := cctx.GetCurrentOutboundParam()
 	relayedMsg := strings.Split(cctx.RelayedMessage, ":")
 	if len(relayedMsg) != 2 {
@@ -603,13 +681,13 @@ func (signer *Signer) prepareWhitelistTx(
 		return nil, errors.Wrap(err, "createAndSignMsgWhitelist error")
 	}
 
-	// sign the whitelist transaction by relayer key
+	// create the whitelist instruction
 	inst, err := signer.createWhitelistInstruction(msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating whitelist instruction")
 	}
 
-	return signer.signTx(ctx, inst)
+	return inst, nil
 }
 
 func (signer *Signer) decodeMintAccountDetails(ctx context.Context, asset string) (token.Mint, error) {
@@ -3,6 +3,7 @@ package solana
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/pkg/errors"
@@ -76,11 +77,11 @@ func (s *Solana) Start(ctx context.Context) error {
 	})
 
 	optInboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsInboundObservationEnabled()
+		return !app.IsInboundObservationEnabled() || app.IsChainHalted(s.observer.Chain().ChainId)
 	})
 
 	optOutboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsOutboundObservationEnabled()
+		return !app.IsOutboundObservationEnabled() || app.IsChainHalted(s.observer.Chain().ChainId)
 	})
 
 	optGenericSkipper := scheduler.Skipper(func() bool {
@@ -153,6 +154,13 @@ func (s *Solana) scheduleCCTX(ctx context.Context) error {
 		return errors.Wrap(err, "unable to list pending cctx")
 	}
 
+	// forget the broadcasted outbounds that are no longer pending
+	lowestPendingNonce := uint64(math.MaxUint64)
+	if len(cctxList) > 0 {
+		lowestPendingNonce = cctxList[0].GetCurrentOutboundParam().TssNonce
+	}
+	s.signer.PrunePendingOutbounds(lowestPendingNonce)
+
 	// schedule keysign for each pending cctx
 	for _, cctx := range cctxList {
 		var (
@@ -200,6 +208,15 @@ func (s *Solana) scheduleCCTX(ctx context.Context) error {
 			continue
 		}
 
+		// re-sign the broadcasted outbound with the priority fee of the cctx once its blockhash has expired,
+		// no new keysign is scheduled while it's retried
+		if due, found := s.signer.PendingOutboundRetry(nonce); found {
+			if due {
+				go s.signer.RetryPendingOutbound(ctx, cctx, s.observer.ZetacoreClient())
+			}
+			continue
+		}
+
 		shouldScheduleProcess := nonce%interval == zetaHeight%interval
 
 		// schedule a TSS keysign
@@ -10,6 +10,8 @@ import (
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/sui"
 	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/rpcpool"
 )
 
 // Client Sui client.
@@ -19,6 +21,9 @@ type Client struct {
 
 const DefaultEventsLimit = 100
 
+// QuorumMethods are the methods that must be agreed on by the quorum of endpoints
+var QuorumMethods = []string{"sui_getTransactionBlock", "sui_getCheckpoint"}
+
 const filterMoveEventModule = "MoveEventModule"
 
 // NewFromEndpoint Client constructor based on endpoint string.
@@ -26,6 +31,11 @@ func NewFromEndpoint(endpoint string) *Client {
 	return New(sui.NewSuiClient(endpoint))
 }
 
+// NewFromPool Client constructor sending the requests through a pool of endpoints.
+func NewFromPool(pool *rpcpool.Pool) *Client {
+	return New(sui.NewSuiClientWithCustomClient(pool.URL(), pool.HTTPClient()))
+}
+
 // New Client constructor.
 func New(client sui.ISuiAPI) *Client {
 	return &Client{ISuiAPI: client}
@@ -59,6 +69,33 @@ func (c *Client) GetLatestCheckpoint(ctx context.Context) (models.CheckpointResp
 	})
 }
 
+// GetOwnedObjectID returns the object ID of the single object of the given struct type owned by the address.
+// Returns an error if there are no such objects or more than one.
+func (c *Client) GetOwnedObjectID(ctx context.Context, ownerAddress, structType string) (string, error) {
+	res, err := c.SuiXGetOwnedObjects(ctx, models.SuiXGetOwnedObjectsRequest{
+		Address: ownerAddress,
+		Query: models.SuiObjectResponseQuery{
+			Filter: map[string]any{
+				"StructType": structType,
+			},
+		},
+		Limit: 1,
+	})
+
+	switch {
+	case err != nil:
+		return "", errors.Wrap(err, "unable to get owned objects")
+	case len(res.Data) == 0:
+		return "", errors.Errorf("no objects of type %s owned by %s", structType, ownerAddress)
+	case res.HasNextPage:
+		return "", errors.Errorf("multiple objects of type %s owned by %s", structType, ownerAddress)
+	case res.Data[0].Data == nil:
+		return "", errors.New("object data is empty")
+	}
+
+	return res.Data[0].Data.ObjectId, nil
+}
+
 // EventQuery represents pagination options
 type EventQuery struct {
 	PackageID string
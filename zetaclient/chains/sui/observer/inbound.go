@@ -3,6 +3,8 @@ package observer
 import (
 	"context"
 	"encoding/hex"
+	"strconv"
+	"time"
 
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/pkg/errors"
@@ -103,8 +105,17 @@ func (ob *Observer) processInboundEvent(
 		return nil
 	case err != nil:
 		return errors.Wrap(err, "unable to parse event")
+	case event.IsOutbound():
+		// Gateway emits outbound events as well. Let's make sure they are tracked
+		// even if the signer failed to report them (noop otherwise)
+		if err := ob.addOutboundTracker(ctx, event); err != nil {
+			return errors.Wrap(err, "unable to add outbound tracker")
+		}
+
+		return nil
 	case !event.IsInbound():
 		ob.Logger().Inbound.Info().Msg("Not an inbound event. Skipping")
+		return nil
 	case event.EventIndex != 0:
 		// Is it possible to have multiple events per tx?
 		// e.g. contract "A" calls Gateway multiple times in a single tx (deposit to multiple accounts)
@@ -127,6 +138,10 @@ func (ob *Observer) processInboundEvent(
 		return errors.Wrap(err, "unable to construct inbound vote")
 	}
 
+	if timestampMs, err := strconv.ParseInt(tx.TimestampMs, 10, 64); err == nil {
+		ob.SetInboundBlockTime(msg.InboundBlockHeight, time.UnixMilli(timestampMs))
+	}
+
 	_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 	if err != nil {
 		return errors.Wrap(err, "unable to post vote inbound")
@@ -160,6 +175,24 @@ func (ob *Observer) processInboundTracker(ctx context.Context, tracker cctypes.I
 func (ob *Observer) constructInboundVote(
 	event sui.Event,
 	tx models.SuiTransactionBlockResponse,
+) (*cctypes.MsgVoteInbound, error) {
+	return BuildInboundVoteMsg(
+		event,
+		tx,
+		ob.Chain().ChainId,
+		ob.ZetacoreClient().Chain().ChainId,
+		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
+	)
+}
+
+// BuildInboundVoteMsg builds the inbound vote of a gateway deposit event emitted by the tx.
+// It's also used by zetatool to reconstruct the ballot of an inbound, so it must not depend on the observer state.
+func BuildInboundVoteMsg(
+	event sui.Event,
+	tx models.SuiTransactionBlockResponse,
+	chainID int64,
+	zetaChainID int64,
+	signer string,
 ) (*cctypes.MsgVoteInbound, error) {
 	inbound, err := event.Inbound()
 	if err != nil {
@@ -168,6 +201,12 @@ func (ob *Observer) constructInboundVote(
 
 	coinType := coin.CoinType_Gas
 	if !inbound.IsGasDeposit() {
+		// the deposit of a coin type that is not whitelisted is voted and aborted by zetacore,
+		// but a malformed coin type is invalid event data
+		if err := sui.ValidateCoinType(string(inbound.CoinType)); err != nil {
+			return nil, errors.Wrap(err, "invalid deposit coin type")
+		}
+
 		coinType = coin.CoinType_ERC20
 	}
 
@@ -184,12 +223,12 @@ func (ob *Observer) constructInboundVote(
 	}
 
 	return cctypes.NewMsgVoteInbound(
-		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
+		signer,
 		inbound.Sender,
-		ob.Chain().ChainId,
+		chainID,
 		inbound.Sender,
 		inbound.Receiver.String(),
-		ob.ZetacoreClient().Chain().ChainId,
+		zetaChainID,
 		inbound.Amount,
 		hex.EncodeToString(inbound.Payload),
 		event.TxHash,
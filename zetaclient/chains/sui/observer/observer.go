@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/block-vision/sui-go-sdk/models"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 
 	"github.com/zeta-chain/node/pkg/contracts/sui"
@@ -19,8 +20,13 @@ type Observer struct {
 	*base.Observer
 	client  RPC
 	gateway *sui.Gateway
+
+	// outbounds stores finalized outbound txs by nonce
+	outbounds *lru.Cache
 }
 
+const outboundsCacheSize = 1024
+
 // RPC represents subset of Sui RPC methods.
 type RPC interface {
 	HealthCheck(ctx context.Context) (time.Time, error)
@@ -37,16 +43,19 @@ type RPC interface {
 
 // New Observer constructor.
 func New(baseObserver *base.Observer, client RPC, gateway *sui.Gateway) *Observer {
+	outbounds, _ := lru.New(outboundsCacheSize)
+
 	return &Observer{
-		Observer: baseObserver,
-		client:   client,
-		gateway:  gateway,
+		Observer:  baseObserver,
+		client:    client,
+		gateway:   gateway,
+		outbounds: outbounds,
 	}
 }
 
 // CheckRPCStatus checks the RPC status of the chain.
 func (ob *Observer) CheckRPCStatus(ctx context.Context) error {
-	blockTime, err := ob.client.HealthCheck(ctx)
+	blockTime, err := ob.CheckRPCHealth(ctx, ob.client.HealthCheck)
 	if err != nil {
 		return errors.Wrap(err, "unable to check rpc health")
 	}
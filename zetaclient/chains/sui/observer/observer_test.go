@@ -62,6 +62,7 @@ func TestObserver(t *testing.T) {
 		evmAlice := sample.EthAddress()
 
 		const usdc = "0x5d4b302506645c37ff133b98c4b50a5ae14841659738d6d733d59d0d217a93bf::coin::COIN"
+		const notWhitelisted = "0x8d97f1cd6ac663735be08d1d2b6d02a159e711586461306ce60a2b7a6a565a9e::coin::COIN"
 
 		// Given gateway object from RPC (for "ensuring" the initial scroll cursor)
 		gatewayRequest := models.SuiGetObjectRequest{
@@ -90,7 +91,8 @@ func TestObserver(t *testing.T) {
 			Limit:     client.DefaultEventsLimit,
 		}
 
-		// ...two of which are valid (1 & 3)
+		// ...three of which are voted (1, 3 & 5), the deposit of an invalid coin type is skipped
+		// while the deposit of a coin type that is not whitelisted is voted, zetacore aborts it
 		events := []models.SuiEventResponse{
 			ts.SampleEvent("TX_1_ok", string(sui.Deposit), map[string]any{
 				"coin_type": string(sui.SUI),
@@ -112,7 +114,19 @@ func TestObserver(t *testing.T) {
 				"receiver":  evmAlice.String(),
 				"payload":   []any{float64(1), float64(2), float64(3)},
 			}),
-			ts.SampleEvent("TX_4_invalid_data", string(sui.Deposit), map[string]any{
+			ts.SampleEvent("TX_4_invalid_coin_type", string(sui.Deposit), map[string]any{
+				"coin_type": "0x2::coin::FAKE",
+				"amount":    "400",
+				"sender":    "SUI_BOB",
+				"receiver":  evmBob.String(),
+			}),
+			ts.SampleEvent("TX_5_not_whitelisted_coin_type", string(sui.Deposit), map[string]any{
+				"coin_type": notWhitelisted,
+				"amount":    "500",
+				"sender":    "SUI_BOB",
+				"receiver":  evmBob.String(),
+			}),
+			ts.SampleEvent("TX_6_invalid_data", string(sui.Deposit), map[string]any{
 				"coin_type": string(sui.SUI),
 				"amount":    "hello",
 				"sender":    "SUI_BOB",
@@ -122,9 +136,11 @@ func TestObserver(t *testing.T) {
 
 		ts.suiMock.On("QueryModuleEvents", mock.Anything, expectedQuery).Return(events, "", nil)
 
-		// Given 2 transaction blocks
+		// Given 4 transaction blocks
 		ts.OnGetTx("TX_1_ok", "10000", false, nil)
 		ts.OnGetTx("TX_3_ok", "20000", false, nil)
+		ts.OnGetTx("TX_4_invalid_coin_type", "30000", false, nil)
+		ts.OnGetTx("TX_5_not_whitelisted_coin_type", "40000", false, nil)
 
 		// Given inbound votes catches so we can assert them later
 		ts.CatchInboundVotes()
@@ -136,10 +152,10 @@ func TestObserver(t *testing.T) {
 		require.NoError(t, err)
 
 		// Check that final cursor is on INVALID event, that's expected
-		assert.Equal(t, "TX_4_invalid_data#0", ts.LastTxScanned())
+		assert.Equal(t, "TX_6_invalid_data#0", ts.LastTxScanned())
 
 		// Check for transactions
-		assert.Equal(t, 2, len(ts.inboundVotesBag))
+		assert.Equal(t, 3, len(ts.inboundVotesBag))
 
 		vote1 := ts.inboundVotesBag[0]
 		assert.Equal(t, "TX_1_ok", vote1.InboundHash)
@@ -160,7 +176,31 @@ func TestObserver(t *testing.T) {
 		assert.Equal(t, evmAlice.String(), vote3.Receiver)
 		assert.Equal(t, "010203", vote3.Message)
 
-		// Check that other 2 txs are skipped
+		vote5 := ts.inboundVotesBag[2]
+		assert.Equal(t, "TX_5_not_whitelisted_coin_type", vote5.InboundHash)
+		assert.Equal(t, coin.CoinType_ERC20, vote5.CoinType)
+		assert.Equal(t, notWhitelisted, vote5.Asset)
+		assert.Equal(t, math.NewUint(500), vote5.Amount)
+
+		// Check that the ballots can be reconstructed from the txs (e.g. by zetatool)
+		for i, vote := range []*cctypes.MsgVoteInbound{vote1, vote3} {
+			raw := events[2*i]
+			event, err := ts.gateway.ParseEvent(raw)
+			require.NoError(t, err)
+
+			tx := models.SuiTransactionBlockResponse{
+				Digest:     raw.Id.TxDigest,
+				Checkpoint: fmt.Sprintf("%d", vote.InboundBlockHeight),
+				Events:     []models.SuiEventResponse{raw},
+			}
+
+			msg, err := BuildInboundVoteMsg(event, tx, ts.Chain().ChainId, vote.ReceiverChain, "")
+			require.NoError(t, err)
+			assert.Equal(t, vote.Digest(), msg.Digest())
+		}
+
+		// Check that other 3 txs are skipped
+		assert.Contains(t, ts.log.String(), `invalid deposit coin type`)
 		assert.Contains(
 			t,
 			ts.log.String(),
@@ -221,6 +261,125 @@ func TestObserver(t *testing.T) {
 		assert.Equal(t, math.NewUint(1000), vote.Amount)
 		assert.Equal(t, evmAlice.String(), vote.Receiver)
 	})
+
+	t.Run("ProcessOutboundTrackers", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		const nonce = 42
+
+		tssAddress := sui.AddressFromPubKeyECDSA(ts.TSS().PubKey().AsECDSA())
+		receiver := "0xd4e9f5e3e3e5b7e1b41b0e9f5e3e3e5b7e1b41b0e9f5e3e3e5b7e1b41b0e9f5e"
+
+		// Given outbound tracker with two hashes, the first of which is failed
+		ts.zetaMock.
+			On("GetAllOutboundTrackerByChain", mock.Anything, ts.Chain().ChainId, mock.Anything).
+			Return([]cctypes.OutboundTracker{{
+				ChainId: ts.Chain().ChainId,
+				Nonce:   nonce,
+				HashList: []*cctypes.TxHash{
+					{TxHash: "TX_FAILED"},
+					{TxHash: "TX_OK"},
+				},
+			}}, nil)
+
+		failedTx := ts.SampleOutboundTx("TX_FAILED", tssAddress, nil)
+		failedTx.Effects.Status = models.ExecutionStatus{Status: "failure", Error: "oops"}
+		ts.OnGetOutboundTx(failedTx)
+
+		ts.OnGetOutboundTx(ts.SampleOutboundTx("TX_OK", tssAddress, []models.SuiEventResponse{
+			ts.SampleEvent("TX_OK", string(sui.Withdraw), map[string]any{
+				"coin_type": string(sui.SUI),
+				"amount":    "1000",
+				"sender":    tssAddress,
+				"receiver":  receiver,
+				"nonce":     "42",
+			}),
+		}))
+
+		// Given cctx
+		cctx := sample.CrossChainTx(t, "0x123")
+		cctx.InboundParams.CoinType = coin.CoinType_Gas
+		cctx.GetCurrentOutboundParam().TssNonce = nonce
+		cctx.GetCurrentOutboundParam().ReceiverChainId = ts.Chain().ChainId
+
+		// Given votes catcher
+		ts.CatchOutboundVotes()
+
+		// ACT (1)
+		err := ts.ProcessOutboundTrackers(ts.ctx)
+
+		// ASSERT (1)
+		require.NoError(t, err)
+		assert.Contains(t, ts.log.String(), "tx failed with status")
+
+		// ACT (2)
+		continueKeysign, err := ts.VoteOutboundIfConfirmed(ts.ctx, cctx)
+
+		// ASSERT (2)
+		require.NoError(t, err)
+		require.False(t, continueKeysign)
+		require.Len(t, ts.outboundVotesBag, 1)
+
+		vote := ts.outboundVotesBag[0]
+		assert.Equal(t, cctx.Index, vote.CctxHash)
+		assert.Equal(t, "TX_OK", vote.ObservedOutboundHash)
+		assert.Equal(t, uint64(nonce), vote.OutboundTssNonce)
+		assert.Equal(t, uint64(9999), vote.ObservedOutboundBlockHeight)
+		assert.Equal(t, uint64(1500), vote.ObservedOutboundGasUsed)
+		assert.Equal(t, uint64(2_000_000), vote.ObservedOutboundEffectiveGasLimit)
+		assert.Equal(t, math.NewUint(1000), vote.ValueReceived)
+		assert.Equal(t, chains.ReceiveStatus_success, vote.Status)
+
+		// ACT (3)
+		// Unknown nonce should continue keysign
+		cctx.GetCurrentOutboundParam().TssNonce = nonce + 1
+		continueKeysign, err = ts.VoteOutboundIfConfirmed(ts.ctx, cctx)
+
+		// ASSERT (3)
+		require.NoError(t, err)
+		require.True(t, continueKeysign)
+	})
+
+	t.Run("ObserveInbound adds outbound tracker", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		// Given cursor
+		ts.WithLastTxScanned("ABC123#0")
+
+		expectedQuery := client.EventQuery{
+			PackageID: ts.gateway.PackageID(),
+			Module:    ts.gateway.Module(),
+			Cursor:    "ABC123#0",
+			Limit:     client.DefaultEventsLimit,
+		}
+
+		// Given withdrawal event
+		events := []models.SuiEventResponse{
+			ts.SampleEvent("TX_WITHDRAW", string(sui.Withdraw), map[string]any{
+				"coin_type": string(sui.SUI),
+				"amount":    "1000",
+				"sender":    "SUI_TSS",
+				"receiver":  "SUI_BOB",
+				"nonce":     "7",
+			}),
+		}
+
+		ts.suiMock.On("QueryModuleEvents", mock.Anything, expectedQuery).Return(events, "", nil)
+
+		ts.zetaMock.
+			On("PostOutboundTracker", mock.Anything, ts.Chain().ChainId, uint64(7), "TX_WITHDRAW").
+			Return("", nil).
+			Once()
+
+		// ACT
+		err := ts.ObserveInbound(ts.ctx)
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.Equal(t, "TX_WITHDRAW#0", ts.LastTxScanned())
+	})
 }
 
 type testSuite struct {
@@ -232,7 +391,8 @@ type testSuite struct {
 	log      *testlog.Log
 	gateway  *sui.Gateway
 
-	inboundVotesBag []*cctypes.MsgVoteInbound
+	inboundVotesBag  []*cctypes.MsgVoteInbound
+	outboundVotesBag []*cctypes.MsgVoteOutbound
 
 	*Observer
 }
@@ -268,7 +428,8 @@ func newTestSuite(t *testing.T) *testSuite {
 
 	suiMock := mocks.NewSuiClient(t)
 
-	gw := sui.NewGateway(chainParams.GatewayAddress)
+	gw, err := sui.NewGatewayFromPairID(chainParams.GatewayAddress)
+	require.NoError(t, err)
 
 	observer := New(baseObserver, suiMock, gw)
 
@@ -326,3 +487,57 @@ func (ts *testSuite) CatchInboundVotes() {
 		Return(callback).
 		Maybe()
 }
+
+func (ts *testSuite) SampleOutboundTx(
+	digest, sender string,
+	events []models.SuiEventResponse,
+) models.SuiTransactionBlockResponse {
+	return models.SuiTransactionBlockResponse{
+		Digest:     digest,
+		Checkpoint: "9999",
+		Events:     events,
+		Transaction: models.SuiTransactionBlock{
+			Data: models.SuiTransactionBlockData{
+				Sender: sender,
+				GasData: models.SuiGasData{
+					Owner:  sender,
+					Price:  "1000",
+					Budget: "2000000",
+				},
+			},
+		},
+		Effects: models.SuiEffects{
+			Status: models.ExecutionStatus{Status: "success"},
+			GasUsed: models.GasCostSummary{
+				ComputationCost: "1000",
+				StorageCost:     "1000",
+				StorageRebate:   "500",
+			},
+		},
+	}
+}
+
+func (ts *testSuite) OnGetOutboundTx(tx models.SuiTransactionBlockResponse) {
+	req := models.SuiGetTransactionBlockRequest{
+		Digest: tx.Digest,
+		Options: models.SuiTransactionBlockOptions{
+			ShowInput:   true,
+			ShowEffects: true,
+			ShowEvents:  true,
+		},
+	}
+
+	ts.suiMock.On("SuiGetTransactionBlock", mock.Anything, req).Return(tx, nil).Once()
+}
+
+func (ts *testSuite) CatchOutboundVotes() {
+	callback := func(_ context.Context, _, _ uint64, msg *cctypes.MsgVoteOutbound) (string, string, error) {
+		ts.outboundVotesBag = append(ts.outboundVotesBag, msg)
+		return "", "", nil
+	}
+
+	ts.zetaMock.
+		On("PostVoteOutbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(callback).
+		Maybe()
+}
@@ -0,0 +1,290 @@
+package observer
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/contracts/sui"
+	cctypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/node/zetaclient/compliance"
+	"github.com/zeta-chain/node/zetaclient/logs"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
+	"github.com/zeta-chain/node/zetaclient/zetacore"
+)
+
+// statusSuccess is the status of successfully executed Sui tx
+const statusSuccess = "success"
+
+// outbound represents a finalized Sui outbound tx that is waiting to be voted on.
+type outbound struct {
+	tx    models.SuiTransactionBlockResponse
+	event sui.Outbound
+	nonce uint64
+}
+
+// VoteOutboundIfConfirmed checks outbound status and returns (continueKeysign, error)
+func (ob *Observer) VoteOutboundIfConfirmed(ctx context.Context, cctx *cctypes.CrossChainTx) (bool, error) {
+	nonce := cctx.GetCurrentOutboundParam().TssNonce
+
+	out, ok := ob.getOutboundByNonce(nonce)
+	if !ok {
+		return true, nil
+	}
+
+	if err := ob.postVoteOutbound(ctx, cctx, out); err != nil {
+		return false, errors.Wrap(err, "unable to post vote outbound")
+	}
+
+	return false, nil
+}
+
+// ProcessOutboundTrackers pulls outbounds trackers from zetacore,
+// fetches txs from Sui and stores them in memory for further use.
+func (ob *Observer) ProcessOutboundTrackers(ctx context.Context) error {
+	chainID := ob.Chain().ChainId
+
+	trackers, err := ob.ZetacoreClient().GetAllOutboundTrackerByChain(ctx, chainID, interfaces.Ascending)
+	if err != nil {
+		return errors.Wrap(err, "unable to get outbound trackers")
+	}
+
+	for _, tracker := range trackers {
+		nonce := tracker.Nonce
+
+		// already processed
+		if _, ok := ob.getOutboundByNonce(nonce); ok {
+			continue
+		}
+
+		for _, hash := range tracker.HashList {
+			if err := ob.processOutboundTracker(ctx, nonce, hash.TxHash); err != nil {
+				ob.Logger().Outbound.Error().Err(err).
+					Uint64(logs.FieldNonce, nonce).
+					Str(logs.FieldTx, hash.TxHash).
+					Msg("Unable to process outbound tracker")
+				continue
+			}
+
+			// one valid tx per nonce is enough
+			break
+		}
+	}
+
+	return nil
+}
+
+// processOutboundTracker fetches Sui tx by digest, validates it,
+// and stores it in memory for further processing by VoteOutboundIfConfirmed.
+func (ob *Observer) processOutboundTracker(ctx context.Context, nonce uint64, digest string) error {
+	req := models.SuiGetTransactionBlockRequest{
+		Digest: digest,
+		Options: models.SuiTransactionBlockOptions{
+			ShowInput:   true,
+			ShowEffects: true,
+			ShowEvents:  true,
+		},
+	}
+
+	tx, err := ob.client.SuiGetTransactionBlock(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "unable to get transaction block")
+	}
+
+	if err := ob.validateOutbound(tx); err != nil {
+		return errors.Wrap(err, "invalid outbound tx")
+	}
+
+	event, err := ob.findOutboundEvent(tx, nonce)
+	if err != nil {
+		return err
+	}
+
+	ob.setOutboundByNonce(outbound{tx: tx, event: event, nonce: nonce})
+
+	return nil
+}
+
+// validateOutbound checks that tx is successful and signed by TSS.
+// Note that failed Sui txs do not increment gateway nonce, hence they are not valid outbounds.
+func (ob *Observer) validateOutbound(tx models.SuiTransactionBlockResponse) error {
+	tssAddress := sui.AddressFromPubKeyECDSA(ob.TSS().PubKey().AsECDSA())
+
+	switch {
+	case tx.Checkpoint == "":
+		return errors.New("tx is not checkpointed yet")
+	case tx.Effects.Status.Status != statusSuccess:
+		return errors.Errorf("tx failed with status %q: %s", tx.Effects.Status.Status, tx.Effects.Status.Error)
+	case !strings.EqualFold(tx.Transaction.Data.Sender, tssAddress):
+		return errors.Errorf("tx sender %s is not TSS %s", tx.Transaction.Data.Sender, tssAddress)
+	default:
+		return nil
+	}
+}
+
+// findOutboundEvent finds gateway outbound event with the given nonce in tx events.
+func (ob *Observer) findOutboundEvent(tx models.SuiTransactionBlockResponse, nonce uint64) (sui.Outbound, error) {
+	for _, raw := range tx.Events {
+		event, err := ob.gateway.ParseEvent(raw)
+		if err != nil || !event.IsOutbound() {
+			continue
+		}
+
+		out, err := event.Outbound()
+		if err != nil {
+			return sui.Outbound{}, errors.Wrap(err, "unable to get outbound")
+		}
+
+		if out.Nonce == nonce {
+			return out, nil
+		}
+	}
+
+	return sui.Outbound{}, errors.Errorf("no outbound event with nonce %d found in tx %s", nonce, tx.Digest)
+}
+
+// addOutboundTracker publishes outbound tracker to zetacore based on gateway's outbound event.
+// In most cases will be a noop because the tracker is already published by the signer.
+func (ob *Observer) addOutboundTracker(ctx context.Context, event sui.Event) error {
+	out, err := event.Outbound()
+	if err != nil {
+		return errors.Wrap(err, "unable to get outbound")
+	}
+
+	// note it has a check for noop
+	_, err = ob.ZetacoreClient().PostOutboundTracker(ctx, ob.Chain().ChainId, out.Nonce, event.TxHash)
+
+	return err
+}
+
+func (ob *Observer) postVoteOutbound(ctx context.Context, cctx *cctypes.CrossChainTx, out outbound) error {
+	var (
+		chainID = ob.Chain().ChainId
+		nonce   = out.nonce
+		gasData = out.tx.Transaction.Data.GasData
+	)
+
+	checkpoint, err := uint64FromStr(out.tx.Checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse checkpoint")
+	}
+
+	gasPrice, err := math.ParseUint(gasData.Price)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse gas price")
+	}
+
+	gasBudget, err := uint64FromStr(gasData.Budget)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse gas budget")
+	}
+
+	gasUsed, err := gasUsedFromEffects(out.tx.Effects)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse gas used")
+	}
+
+	// cancelled outbound only increments gateway nonce
+	status := chains.ReceiveStatus_success
+	if out.event.IsCancelled() {
+		status = chains.ReceiveStatus_failed
+	}
+
+	amount := out.event.Amount
+
+	// compliance check, special handling the cancelled cctx
+	if compliance.IsCctxRestricted(ctx, cctx) {
+		// use cctx's amount to bypass the amount check in zetacore
+		amount = cctx.GetCurrentOutboundParam().Amount
+	}
+
+	msg := cctypes.NewMsgVoteOutbound(
+		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
+		cctx.Index,
+		out.tx.Digest,
+		checkpoint,
+		gasUsed,
+		math.NewIntFromBigInt(gasPrice.BigInt()),
+		gasBudget,
+		amount,
+		status,
+		chainID,
+		nonce,
+		cctx.InboundParams.CoinType,
+		cctypes.ConfirmationMode_SAFE,
+	)
+
+	var retryGasLimit uint64
+	if msg.Status == chains.ReceiveStatus_failed {
+		retryGasLimit = zetacore.PostVoteOutboundRevertGasLimit
+	}
+
+	zetaTxHash, ballot, err := ob.ZetacoreClient().PostVoteOutbound(
+		ctx,
+		zetacore.PostVoteOutboundGasLimit,
+		retryGasLimit,
+		msg,
+	)
+	if err != nil {
+		return errors.Wrap(err, "unable to post vote outbound")
+	}
+
+	if zetaTxHash != "" {
+		ob.Logger().Outbound.Info().
+			Uint64(logs.FieldNonce, nonce).
+			Str(logs.FieldTx, out.tx.Digest).
+			Str(logs.FieldZetaTx, zetaTxHash).
+			Str(logs.FieldBallot, ballot).
+			Msg("Posted outbound vote")
+		ob.RecordVote(ctx, clienttypes.ToOutboundVoteSQLType(msg, ballot, zetaTxHash))
+		metrics.OutboundConfirmed(ob.Chain().Name, msg.OutboundTssNonce)
+	}
+
+	return nil
+}
+
+// gasUsedFromEffects calculates gas used (in MIST) as computation + storage - rebate.
+// See https://docs.sui.io/concepts/tokenomics/gas-in-sui
+func gasUsedFromEffects(effects models.SuiEffects) (uint64, error) {
+	computation, err := uint64FromStr(effects.GasUsed.ComputationCost)
+	if err != nil {
+		return 0, err
+	}
+
+	storage, err := uint64FromStr(effects.GasUsed.StorageCost)
+	if err != nil {
+		return 0, err
+	}
+
+	rebate, err := uint64FromStr(effects.GasUsed.StorageRebate)
+	if err != nil {
+		return 0, err
+	}
+
+	if rebate > computation+storage {
+		return 0, nil
+	}
+
+	return computation + storage - rebate, nil
+}
+
+// getOutboundByNonce returns outbound by nonce
+func (ob *Observer) getOutboundByNonce(nonce uint64) (outbound, bool) {
+	v, ok := ob.outbounds.Get(nonce)
+	if !ok {
+		return outbound{}, false
+	}
+
+	return v.(outbound), true
+}
+
+// setOutboundByNonce stores outbound by nonce
+func (ob *Observer) setOutboundByNonce(o outbound) {
+	ob.outbounds.Add(o.nonce, o)
+}
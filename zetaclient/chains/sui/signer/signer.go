@@ -1,13 +1,134 @@
 package signer
 
-import "github.com/zeta-chain/node/zetaclient/chains/base"
+import (
+	"context"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/contracts/sui"
+	cctypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/node/zetaclient/logs"
+)
 
 // Signer Sui outbound transaction signer.
 type Signer struct {
 	*base.Signer
+	client   RPC
+	gateway  *sui.Gateway
+	zetacore interfaces.ZetacoreClient
+}
+
+// RPC represents Sui rpc.
+type RPC interface {
+	GetOwnedObjectID(ctx context.Context, ownerAddress, structType string) (string, error)
+
+	MoveCall(ctx context.Context, req models.MoveCallRequest) (models.TxnMetaData, error)
+	SuiExecuteTransactionBlock(
+		ctx context.Context,
+		req models.SuiExecuteTransactionBlockRequest,
+	) (models.SuiTransactionBlockResponse, error)
 }
 
 // New Signer constructor.
-func New(baseSigner *base.Signer) *Signer {
-	return &Signer{Signer: baseSigner}
+func New(
+	baseSigner *base.Signer,
+	client RPC,
+	gateway *sui.Gateway,
+	zetacore interfaces.ZetacoreClient,
+) *Signer {
+	return &Signer{
+		Signer:   baseSigner,
+		client:   client,
+		gateway:  gateway,
+		zetacore: zetacore,
+	}
+}
+
+// ProcessCCTX schedules outbound cross-chain transaction.
+// Build --> Sign --> Broadcast --(async)--> Wait for execution --> PostOutboundTracker
+func (s *Signer) ProcessCCTX(ctx context.Context, cctx *cctypes.CrossChainTx, zetaHeight uint64) error {
+	var (
+		outboundID = base.OutboundIDFromCCTX(cctx)
+		nonce      = cctx.GetCurrentOutboundParam().TssNonce
+	)
+
+	// the gateway address of the chain params might still be the package ID only
+	if s.gateway.ObjectID() == "" {
+		return errors.New("gateway object ID is not set")
+	}
+
+	s.MarkOutbound(outboundID, true)
+	defer func() { s.MarkOutbound(outboundID, false) }()
+
+	tx, err := s.buildOutbound(ctx, cctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to build outbound tx")
+	}
+
+	signature, err := s.signTx(ctx, tx, zetaHeight, nonce)
+	if err != nil {
+		return errors.Wrap(err, "unable to sign tx")
+	}
+
+	req := models.SuiExecuteTransactionBlockRequest{
+		TxBytes:   tx.TxBytes,
+		Signature: []string{signature},
+		Options: models.SuiTransactionBlockOptions{
+			ShowEffects: true,
+			ShowEvents:  true,
+		},
+		// Sui tx is final once it's executed, so we can report the tracker right away
+		RequestType: "WaitForLocalExecution",
+	}
+
+	res, err := s.client.SuiExecuteTransactionBlock(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "unable to execute tx block")
+	}
+
+	logger := s.Logger().Std.With().
+		Str(logs.FieldMethod, "ProcessCCTX").
+		Str(logs.FieldCctx, cctx.Index).
+		Uint64(logs.FieldNonce, nonce).
+		Str(logs.FieldTx, res.Digest).
+		Logger()
+
+	// Unlike EVM, a failed Sui tx does not consume the gateway nonce,
+	// so it's not a valid candidate for the outbound tracker.
+	if res.Effects.Status.Status != statusSuccess {
+		return errors.Errorf("tx %s failed: %s", res.Digest, res.Effects.Status.Error)
+	}
+
+	logger.Info().Msg("Executed outbound tx")
+
+	return s.reportOutboundTracker(ctx, nonce, res.Digest)
+}
+
+// reportOutboundTracker reports outbound tx digest to zetacore.
+// Note that this method has a check for noop.
+func (s *Signer) reportOutboundTracker(ctx context.Context, nonce uint64, digest string) error {
+	// set being reported flag to avoid duplicate reporting
+	if alreadySet := s.SetBeingReportedFlag(digest); alreadySet {
+		return nil
+	}
+
+	defer s.ClearBeingReportedFlag(digest)
+
+	zetaHash, err := s.zetacore.PostOutboundTracker(ctx, s.Chain().ChainId, nonce, digest)
+	if err != nil {
+		return errors.Wrap(err, "unable to post outbound tracker")
+	}
+
+	if zetaHash != "" {
+		s.Logger().Std.Info().
+			Uint64(logs.FieldNonce, nonce).
+			Str(logs.FieldTx, digest).
+			Str(logs.FieldZetaTx, zetaHash).
+			Msg("Added outbound to tracker")
+	}
+
+	return nil
 }
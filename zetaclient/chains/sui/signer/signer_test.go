@@ -0,0 +1,322 @@
+package signer
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/constant"
+	"github.com/zeta-chain/node/pkg/contracts/sui"
+	"github.com/zeta-chain/node/testutil/sample"
+	cctypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func TestSigner(t *testing.T) {
+	t.Run("ProcessCCTX withdraw", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		const (
+			zetaHeight = 1000
+			nonce      = 12
+		)
+
+		receiver := "0xd4e9f5e3e3e5b7e1b41b0e9f5e3e3e5b7e1b41b0e9f5e3e3e5b7e1b41b0e9f5e"
+
+		// Given cctx
+		cctx := ts.SampleCCTX(nonce, receiver, coin.CoinType_Gas)
+
+		// Given withdraw cap owned by TSS
+		ts.suiMock.
+			On("GetOwnedObjectID", mock.Anything, ts.TSSAddress(), ts.gateway.WithdrawCapType()).
+			Return("0xWithdrawCap", nil)
+
+		// Given unsigned tx built by RPC
+		txBytes := base64.StdEncoding.EncodeToString([]byte("raw_tx_bytes"))
+
+		ts.suiMock.
+			On("MoveCall", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				req := args.Get(1).(models.MoveCallRequest)
+
+				assert.Equal(t, ts.TSSAddress(), req.Signer)
+				assert.Equal(t, ts.gateway.PackageID(), req.PackageObjectId)
+				assert.Equal(t, sui.FuncWithdraw, req.Function)
+				assert.Equal(t, []any{string(sui.SUI)}, req.TypeArguments)
+				assert.Equal(t, []any{
+					ts.gateway.ObjectID(),
+					"1000",
+					"12",
+					receiver,
+					"2000000",
+					"0xWithdrawCap",
+				}, req.Arguments)
+				assert.Equal(t, "2000000", req.GasBudget)
+			}).
+			Return(models.TxnMetaData{TxBytes: txBytes}, nil)
+
+		// Given successful execution
+		ts.suiMock.
+			On("SuiExecuteTransactionBlock", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				req := args.Get(1).(models.SuiExecuteTransactionBlockRequest)
+
+				require.Equal(t, txBytes, req.TxBytes)
+				require.Len(t, req.Signature, 1)
+
+				// check that signature is valid and made by TSS
+				raw, err := base64.StdEncoding.DecodeString(req.Signature[0])
+				require.NoError(t, err)
+
+				digest, err := sui.Digest(txBytes)
+				require.NoError(t, err)
+
+				pubKey := crypto.CompressPubkey(ts.tss.PubKey().AsECDSA())
+				assert.Equal(t, pubKey, raw[65:])
+				assert.True(t, crypto.VerifySignature(pubKey, digest[:], raw[1:65]))
+			}).
+			Return(models.SuiTransactionBlockResponse{
+				Digest:  "TX_DIGEST",
+				Effects: models.SuiEffects{Status: models.ExecutionStatus{Status: "success"}},
+			}, nil)
+
+		// Given outbound tracker
+		ts.zetaMock.
+			On("PostOutboundTracker", mock.Anything, ts.chain.ChainId, uint64(nonce), "TX_DIGEST").
+			Return("ZETA_TX", nil)
+
+		// ACT
+		err := ts.Signer.ProcessCCTX(ts.ctx, cctx, zetaHeight)
+
+		// ASSERT
+		require.NoError(t, err)
+	})
+
+	t.Run("ProcessCCTX failed tx is not tracked", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		cctx := ts.SampleCCTX(5, "0xabc", coin.CoinType_Gas)
+
+		ts.suiMock.On("GetOwnedObjectID", mock.Anything, mock.Anything, mock.Anything).Return("0xWithdrawCap", nil)
+
+		ts.suiMock.
+			On("MoveCall", mock.Anything, mock.Anything).
+			Return(models.TxnMetaData{TxBytes: base64.StdEncoding.EncodeToString([]byte("tx"))}, nil)
+
+		ts.suiMock.
+			On("SuiExecuteTransactionBlock", mock.Anything, mock.Anything).
+			Return(models.SuiTransactionBlockResponse{
+				Digest: "TX_DIGEST",
+				Effects: models.SuiEffects{
+					Status: models.ExecutionStatus{Status: "failure", Error: "MoveAbort"},
+				},
+			}, nil)
+
+		// ACT
+		err := ts.Signer.ProcessCCTX(ts.ctx, cctx, 1)
+
+		// ASSERT
+		require.ErrorContains(t, err, "MoveAbort")
+		ts.zetaMock.AssertNotCalled(t, "PostOutboundTracker", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ProcessCCTX whitelist", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		const (
+			nonce    = 7
+			coinType = "a1ec7fc00a6f40db9693ad1415d0c193ad3906494428cf252621037bd7117e29::usdc::USDC"
+		)
+
+		// Given whitelist cmd cctx
+		cctx := ts.SampleCCTX(nonce, "", coin.CoinType_Cmd)
+		cctx.RelayedMessage = fmt.Sprintf("%s:%s", constant.CmdWhitelistERC20, coinType)
+
+		// Given whitelist cap owned by TSS
+		ts.suiMock.
+			On("GetOwnedObjectID", mock.Anything, ts.TSSAddress(), ts.gateway.WhitelistCapType()).
+			Return("0xWhitelistCap", nil)
+
+		// Given unsigned tx built by RPC
+		ts.suiMock.
+			On("MoveCall", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				req := args.Get(1).(models.MoveCallRequest)
+
+				assert.Equal(t, sui.FuncWhitelist, req.Function)
+				assert.Equal(t, []any{coinType}, req.TypeArguments)
+				assert.Equal(t, []any{ts.gateway.ObjectID(), "7", "0xWhitelistCap"}, req.Arguments)
+				assert.Equal(t, "2000000", req.GasBudget)
+			}).
+			Return(models.TxnMetaData{TxBytes: base64.StdEncoding.EncodeToString([]byte("tx"))}, nil)
+
+		// Given successful execution
+		ts.suiMock.
+			On("SuiExecuteTransactionBlock", mock.Anything, mock.Anything).
+			Return(models.SuiTransactionBlockResponse{
+				Digest:  "TX_DIGEST",
+				Effects: models.SuiEffects{Status: models.ExecutionStatus{Status: "success"}},
+			}, nil)
+
+		// Given outbound tracker
+		ts.zetaMock.
+			On("PostOutboundTracker", mock.Anything, ts.chain.ChainId, uint64(nonce), "TX_DIGEST").
+			Return("ZETA_TX", nil)
+
+		// ACT
+		err := ts.Signer.ProcessCCTX(ts.ctx, cctx, 1)
+
+		// ASSERT
+		require.NoError(t, err)
+	})
+
+	t.Run("ProcessCCTX whitelist invalid coin type", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		cctx := ts.SampleCCTX(7, "", coin.CoinType_Cmd)
+		cctx.RelayedMessage = fmt.Sprintf("%s:%s", constant.CmdWhitelistERC20, "0x2::sui::SUI")
+
+		// ACT
+		err := ts.Signer.ProcessCCTX(ts.ctx, cctx, 1)
+
+		// ASSERT
+		require.ErrorContains(t, err, "unable to whitelist coin type")
+	})
+
+	t.Run("ProcessCCTX gateway object ID not set", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		// Given a gateway address of the previous format
+		gw, err := sui.NewGatewayFromAddress("0xA")
+		require.NoError(t, err)
+		ts.Signer.gateway = gw
+
+		cctx := ts.SampleCCTX(6, "0xabc", coin.CoinType_Gas)
+
+		// ACT
+		err = ts.Signer.ProcessCCTX(ts.ctx, cctx, 1)
+
+		// ASSERT
+		require.ErrorContains(t, err, "gateway object ID is not set")
+	})
+
+	t.Run("ProcessCCTX unsupported coin type", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		cctx := ts.SampleCCTX(5, "0xabc", coin.CoinType_Zeta)
+
+		// ACT
+		err := ts.Signer.ProcessCCTX(ts.ctx, cctx, 1)
+
+		// ASSERT
+		require.ErrorContains(t, err, "unsupported coin type")
+	})
+}
+
+type testSuite struct {
+	t        *testing.T
+	ctx      context.Context
+	chain    chains.Chain
+	tss      *mocks.TSS
+	zetaMock *mocks.ZetacoreClient
+	suiMock  *mocks.SuiClient
+	gateway  *sui.Gateway
+
+	*Signer
+}
+
+func newTestSuite(t *testing.T) *testSuite {
+	var (
+		ctx   = context.Background()
+		chain = chains.SuiMainnet
+
+		tss      = mocks.NewTSS(t)
+		zetacore = mocks.NewZetacoreClient(t)
+		suiMock  = mocks.NewSuiClient(t)
+
+		testLogger = zerolog.New(zerolog.NewTestWriter(t))
+		logger     = base.Logger{Std: testLogger, Compliance: testLogger}
+
+		gw = sui.NewGateway("0xA", "0xB")
+	)
+
+	baseSigner := base.NewSigner(chain, tss, logger)
+
+	return &testSuite{
+		t:        t,
+		ctx:      ctx,
+		chain:    chain,
+		tss:      tss,
+		zetaMock: zetacore,
+		suiMock:  suiMock,
+		gateway:  gw,
+		Signer:   New(baseSigner, suiMock, gw, zetacore),
+	}
+}
+
+func (ts *testSuite) TSSAddress() string {
+	return sui.AddressFromPubKeyECDSA(ts.tss.PubKey().AsECDSA())
+}
+
+func (ts *testSuite) SampleCCTX(nonce uint64, receiver string, coinType coin.CoinType) *cctypes.CrossChainTx {
+	cctx := sample.CrossChainTx(ts.t, "0x123")
+	cctx.InboundParams.CoinType = coinType
+	cctx.InboundParams.IsCrossChainCall = false
+	cctx.OutboundParams = []*cctypes.OutboundParams{{
+		Receiver:        receiver,
+		ReceiverChainId: ts.chain.ChainId,
+		CoinType:        coinType,
+		Amount:          math.NewUint(1000),
+		TssNonce:        nonce,
+		GasPrice:        "1000",
+		CallOptions:     &cctypes.CallOptions{GasLimit: 2000},
+	}}
+
+	return cctx
+}
+
+func TestGasBudgetFromParams(t *testing.T) {
+	newParams := func(gasPrice string, gasLimit uint64) *cctypes.OutboundParams {
+		return &cctypes.OutboundParams{
+			GasPrice:    gasPrice,
+			CallOptions: &cctypes.CallOptions{GasLimit: gasLimit},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		budget, err := gasBudgetFromParams(newParams("1000", 50))
+		require.NoError(t, err)
+		require.EqualValues(t, 50_000, budget)
+	})
+
+	t.Run("invalid gas price", func(t *testing.T) {
+		_, err := gasBudgetFromParams(newParams("abc", 50))
+		require.ErrorContains(t, err, "unable to parse gas price")
+
+		_, err = gasBudgetFromParams(newParams("0", 50))
+		require.ErrorContains(t, err, "invalid gas price")
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		_, err := gasBudgetFromParams(newParams("18446744073709551615", 2))
+		require.ErrorContains(t, err, "gas budget overflow")
+	})
+}
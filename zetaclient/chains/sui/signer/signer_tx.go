@@ -0,0 +1,247 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/constant"
+	"github.com/zeta-chain/node/pkg/contracts/sui"
+	cctypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/compliance"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+)
+
+// statusSuccess is the status of successfully executed Sui tx
+const statusSuccess = "success"
+
+// buildOutbound builds an unsigned outbound tx for the cctx:
+// - withdraw for regular withdrawals
+// - withdraw_and_call for cross-chain calls
+// - increase_nonce for cancelled (e.g. restricted) cctxs
+// - whitelist for admin commands whitelisting a coin type
+func (s *Signer) buildOutbound(ctx context.Context, cctx *cctypes.CrossChainTx) (models.TxnMetaData, error) {
+	params := cctx.GetCurrentOutboundParam()
+
+	if params.ReceiverChainId != s.Chain().ChainId {
+		return models.TxnMetaData{}, errors.Errorf("invalid receiver chain id %d", params.ReceiverChainId)
+	}
+
+	if cctx.InboundParams.CoinType == coin.CoinType_Cmd {
+		return s.buildWhitelist(ctx, cctx)
+	}
+
+	coinType, err := resolveCoinType(cctx)
+	if err != nil {
+		return models.TxnMetaData{}, err
+	}
+
+	gasBudget, err := gasBudgetFromParams(params)
+	if err != nil {
+		return models.TxnMetaData{}, errors.Wrap(err, "unable to get gas budget")
+	}
+
+	signerAddress := sui.AddressFromPubKeyECDSA(s.TSS().PubKey().AsECDSA())
+
+	withdrawCapID, err := s.client.GetOwnedObjectID(ctx, signerAddress, s.gateway.WithdrawCapType())
+	if err != nil {
+		return models.TxnMetaData{}, errors.Wrap(err, "unable to get withdraw cap id")
+	}
+
+	var (
+		nonce     = strconv.FormatUint(params.TssNonce, 10)
+		budget    = strconv.FormatUint(gasBudget, 10)
+		function  string
+		typeArgs  []any
+		arguments []any
+	)
+
+	switch {
+	case compliance.IsCctxRestricted(ctx, cctx):
+		compliance.PrintComplianceLog(
+			s.Logger().Std,
+			s.Logger().Compliance,
+			true,
+			s.Chain().ChainId,
+			cctx.Index,
+			cctx.InboundParams.Sender,
+			params.Receiver,
+			string(coinType),
+		)
+
+		function = sui.FuncIncreaseNonce
+		arguments = []any{s.gateway.ObjectID(), nonce, withdrawCapID}
+	case cctx.InboundParams.IsCrossChainCall:
+		payload, err := payloadArg(cctx.RelayedMessage)
+		if err != nil {
+			return models.TxnMetaData{}, errors.Wrap(err, "unable to decode payload")
+		}
+
+		function = sui.FuncWithdrawAndCall
+		typeArgs = []any{string(coinType)}
+		arguments = []any{
+			s.gateway.ObjectID(),
+			params.Amount.String(),
+			nonce,
+			params.Receiver,
+			budget,
+			payload,
+			withdrawCapID,
+		}
+	default:
+		function = sui.FuncWithdraw
+		typeArgs = []any{string(coinType)}
+		arguments = []any{
+			s.gateway.ObjectID(),
+			params.Amount.String(),
+			nonce,
+			params.Receiver,
+			budget,
+			withdrawCapID,
+		}
+	}
+
+	req := models.MoveCallRequest{
+		Signer:          signerAddress,
+		PackageObjectId: s.gateway.PackageID(),
+		Module:          s.gateway.Module(),
+		Function:        function,
+		TypeArguments:   typeArgs,
+		Arguments:       arguments,
+		GasBudget:       budget,
+	}
+
+	tx, err := s.client.MoveCall(ctx, req)
+	if err != nil {
+		return models.TxnMetaData{}, errors.Wrapf(err, "unable to build %s tx", function)
+	}
+
+	return tx, nil
+}
+
+// buildWhitelist builds an unsigned whitelist tx for the admin command cctx.
+// The relayed message is in the format "cmd_whitelist_erc20:$coinType".
+// Once whitelisted, the gateway accepts deposits and withdrawals of the coin type.
+func (s *Signer) buildWhitelist(ctx context.Context, cctx *cctypes.CrossChainTx) (models.TxnMetaData, error) {
+	params := cctx.GetCurrentOutboundParam()
+
+	// note that Move coin type contains "::", so only the first colon is a separator
+	cmd, coinType, found := strings.Cut(cctx.RelayedMessage, ":")
+	switch {
+	case !found:
+		return models.TxnMetaData{}, errors.Errorf("invalid relayed message %q", cctx.RelayedMessage)
+	case cmd != constant.CmdWhitelistERC20:
+		return models.TxnMetaData{}, errors.Errorf("unsupported command %q", cmd)
+	}
+
+	if err := sui.ValidateCoinType(coinType); err != nil {
+		return models.TxnMetaData{}, errors.Wrap(err, "unable to whitelist coin type")
+	}
+
+	gasBudget, err := gasBudgetFromParams(params)
+	if err != nil {
+		return models.TxnMetaData{}, errors.Wrap(err, "unable to get gas budget")
+	}
+
+	signerAddress := sui.AddressFromPubKeyECDSA(s.TSS().PubKey().AsECDSA())
+
+	whitelistCapID, err := s.client.GetOwnedObjectID(ctx, signerAddress, s.gateway.WhitelistCapType())
+	if err != nil {
+		return models.TxnMetaData{}, errors.Wrap(err, "unable to get whitelist cap id")
+	}
+
+	req := models.MoveCallRequest{
+		Signer:          signerAddress,
+		PackageObjectId: s.gateway.PackageID(),
+		Module:          s.gateway.Module(),
+		Function:        sui.FuncWhitelist,
+		TypeArguments:   []any{coinType},
+		Arguments: []any{
+			s.gateway.ObjectID(),
+			strconv.FormatUint(params.TssNonce, 10),
+			whitelistCapID,
+		},
+		GasBudget: strconv.FormatUint(gasBudget, 10),
+	}
+
+	tx, err := s.client.MoveCall(ctx, req)
+	if err != nil {
+		return models.TxnMetaData{}, errors.Wrapf(err, "unable to build %s tx", sui.FuncWhitelist)
+	}
+
+	return tx, nil
+}
+
+// signTx signs tx digest with TSS and serializes the signature into Sui format.
+func (s *Signer) signTx(ctx context.Context, tx models.TxnMetaData, zetaHeight, nonce uint64) (string, error) {
+	digest, err := sui.Digest(tx.TxBytes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get digest")
+	}
+
+	sig, err := s.TSS().Sign(ctx, digest[:], zetaHeight, nonce, s.Chain().ChainId)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to sign digest")
+	}
+	metrics.OutboundSigned(s.Chain().Name, nonce)
+
+	return sui.SerializeSignatureECDSA(sig, s.TSS().PubKey().AsECDSA())
+}
+
+// resolveCoinType returns Move coin type of the withdrawn asset.
+// Note that *InboundParams* are used on purpose due to legacy reasons.
+func resolveCoinType(cctx *cctypes.CrossChainTx) (sui.CoinType, error) {
+	switch cctx.InboundParams.CoinType {
+	case coin.CoinType_Gas:
+		return sui.SUI, nil
+	case coin.CoinType_ERC20:
+		if cctx.InboundParams.Asset == "" {
+			return "", errors.New("empty asset for ERC20 coin type")
+		}
+
+		return sui.CoinType(cctx.InboundParams.Asset), nil
+	default:
+		return "", errors.Errorf("unsupported coin type %q", cctx.InboundParams.CoinType.String())
+	}
+}
+
+// gasBudgetFromParams returns gas budget (in MIST) as gasPrice * gasLimit
+func gasBudgetFromParams(params *cctypes.OutboundParams) (uint64, error) {
+	gasPrice, err := strconv.ParseUint(params.GasPrice, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to parse gas price %q", params.GasPrice)
+	}
+
+	gasLimit := params.CallOptions.GetGasLimit()
+	if gasPrice == 0 || gasLimit == 0 {
+		return 0, errors.Errorf("invalid gas price %d or gas limit %d", gasPrice, gasLimit)
+	}
+
+	overflow, gasBudget := bits.Mul64(gasPrice, gasLimit)
+	if overflow != 0 {
+		return 0, errors.Errorf("gas budget overflow: gas price %d, gas limit %d", gasPrice, gasLimit)
+	}
+
+	return gasBudget, nil
+}
+
+// payloadArg represents hex-encoded message as Sui JSON vector<u8> argument
+func payloadArg(hexPayload string) ([]any, error) {
+	payload, err := hex.DecodeString(hexPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := make([]any, len(payload))
+	for i, b := range payload {
+		arg[i] = b
+	}
+
+	return arg, nil
+}
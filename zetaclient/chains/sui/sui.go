@@ -6,10 +6,14 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
 
+	"github.com/zeta-chain/node/pkg/bg"
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/scheduler"
 	"github.com/zeta-chain/node/pkg/ticker"
+	cctypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/sui/observer"
 	"github.com/zeta-chain/node/zetaclient/chains/sui/signer"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
@@ -49,15 +53,9 @@ func (s *Sui) Start(ctx context.Context) error {
 	}
 
 	optOutboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsOutboundObservationEnabled()
+		return !app.IsOutboundObservationEnabled() || app.IsChainHalted(s.observer.Chain().ChainId)
 	})
 
-	// todo
-	//   - [ ] ObserveInbound
-	//   - [ ] ProcessInboundTrackers
-	//   - [ ] ProcessOutboundTrackers
-	//   - [ ] ScheduleCCTX
-
 	register := func(exec scheduler.Executable, name string, opts ...scheduler.Opt) {
 		opts = append([]scheduler.Opt{
 			scheduler.GroupName(s.group()),
@@ -75,8 +73,12 @@ func (s *Sui) Start(ctx context.Context) error {
 		return ticker.DurationFromUint64Seconds(s.observer.ChainParams().GasPriceTicker)
 	})
 
+	optOutboundInterval := scheduler.IntervalUpdater(func() time.Duration {
+		return ticker.DurationFromUint64Seconds(s.observer.ChainParams().OutboundTicker)
+	})
+
 	optInboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsInboundObservationEnabled()
+		return !app.IsInboundObservationEnabled() || app.IsChainHalted(s.observer.Chain().ChainId)
 	})
 
 	optGenericSkipper := scheduler.Skipper(func() bool {
@@ -87,6 +89,7 @@ func (s *Sui) Start(ctx context.Context) error {
 	register(s.observer.ProcessInboundTrackers, "process_inbound_trackers", optInboundInterval, optInboundSkipper)
 	register(s.observer.CheckRPCStatus, "check_rpc_status")
 	register(s.observer.PostGasPrice, "post_gas_price", optGasInterval, optGenericSkipper)
+	register(s.observer.ProcessOutboundTrackers, "process_outbound_trackers", optOutboundInterval, optOutboundSkipper)
 
 	// CCTX scheduler (every zetachain block)
 	register(s.scheduleCCTX, "schedule_cctx", scheduler.BlockTicker(newBlockChan), optOutboundSkipper)
@@ -105,7 +108,87 @@ func (s *Sui) group() scheduler.Group {
 }
 
 // scheduleCCTX schedules outbound cross-chain transactions.
-func (s *Sui) scheduleCCTX(_ context.Context) error {
-	// todo
+// It loads pending cctx from zetacore, then tries to sign and broadcast them.
+func (s *Sui) scheduleCCTX(ctx context.Context) error {
+	if err := s.updateChainParams(ctx); err != nil {
+		return errors.Wrap(err, "unable to update chain params")
+	}
+
+	zetaBlock, delay, err := scheduler.BlockFromContextWithDelay(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get zeta block from context")
+	}
+
+	time.Sleep(delay)
+
+	// #nosec G115 always in range
+	zetaHeight := uint64(zetaBlock.Block.Height)
+
+	cctxList, _, err := s.observer.ZetacoreClient().ListPendingCCTX(ctx, s.observer.Chain())
+	if err != nil {
+		return errors.Wrap(err, "unable to list pending cctx")
+	}
+
+	for i := range cctxList {
+		cctx := cctxList[i]
+		outboundID := base.OutboundIDFromCCTX(cctx)
+
+		if err := s.processCCTX(ctx, outboundID, cctx, zetaHeight); err != nil {
+			s.outboundLogger(outboundID).Error().Err(err).Msg("Schedule CCTX failed")
+		}
+	}
+
 	return nil
 }
+
+func (s *Sui) processCCTX(ctx context.Context, outboundID string, cctx *cctypes.CrossChainTx, zetaHeight uint64) error {
+	switch {
+	case s.signer.IsOutboundActive(outboundID):
+		//noop
+		return nil
+	case cctx.GetCurrentOutboundParam().ReceiverChainId != s.observer.Chain().ChainId:
+		return errors.New("chain id mismatch")
+	}
+
+	// vote outbound if it's already confirmed
+	continueKeysign, err := s.observer.VoteOutboundIfConfirmed(ctx, cctx)
+	switch {
+	case err != nil:
+		return errors.Wrap(err, "failed to VoteOutboundIfConfirmed")
+	case !continueKeysign:
+		s.outboundLogger(outboundID).Info().Msg("Schedule CCTX: outbound already processed")
+		return nil
+	}
+
+	bg.Work(ctx, func(ctx context.Context) error {
+		if err := s.signer.ProcessCCTX(ctx, cctx, zetaHeight); err != nil {
+			s.outboundLogger(outboundID).Error().Err(err).Msg("ProcessCCTX failed")
+		}
+
+		return nil
+	}, bg.WithName("sui_process_cctx"), bg.WithLogger(*s.outboundLogger(outboundID)))
+
+	return nil
+}
+
+func (s *Sui) updateChainParams(ctx context.Context) error {
+	app, err := zctx.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	chain, err := app.GetChain(s.observer.Chain().ChainId)
+	if err != nil {
+		return err
+	}
+
+	s.observer.SetChainParams(*chain.Params())
+
+	return nil
+}
+
+func (s *Sui) outboundLogger(id string) *zerolog.Logger {
+	l := s.observer.Logger().Outbound.With().Str("outbound.id", id).Logger()
+
+	return &l
+}
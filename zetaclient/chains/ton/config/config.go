@@ -66,6 +66,38 @@ func FromSource(ctx context.Context, urlOrPath string) (*GlobalConfigurationFile
 	return FromPath(urlOrPath)
 }
 
+// FromSources returns the lite servers of the configuration files from URLs or file paths.
+// Sources that can't be loaded are skipped as long as one source is loaded.
+func FromSources(ctx context.Context, urlsOrPaths []string) (*GlobalConfigurationFile, error) {
+	var (
+		merged  GlobalConfigurationFile
+		seen    = make(map[config.LiteServer]bool)
+		lastErr = errors.New("no sources")
+	)
+
+	for i, urlOrPath := range urlsOrPaths {
+		cfg, err := FromSource(ctx, urlOrPath)
+		if err != nil {
+			lastErr = errors.Wrapf(err, "unable to load config from source #%d", i)
+			continue
+		}
+
+		for _, server := range cfg.LiteServers {
+			if seen[server] {
+				continue
+			}
+			seen[server] = true
+			merged.LiteServers = append(merged.LiteServers, server)
+		}
+	}
+
+	if len(merged.LiteServers) == 0 {
+		return nil, lastErr
+	}
+
+	return &merged, nil
+}
+
 // FetchGasConfig fetches gas price from the config.
 func FetchGasConfig(ctx context.Context, getter Getter) (tlb.GasLimitsPrices, error) {
 	// https://docs.ton.org/develop/howto/blockchain-configs
@@ -39,7 +39,13 @@ func New(client *liteapi.Client) *Client {
 
 // NewFromSource creates a new client from a URL or a file path.
 func NewFromSource(ctx context.Context, urlOrPath string) (*Client, error) {
-	cfg, err := config.FromSource(ctx, urlOrPath)
+	return NewFromSources(ctx, []string{urlOrPath})
+}
+
+// NewFromSources creates a new client from the lite servers of several URLs or file paths.
+// The lite client fails over between the lite servers.
+func NewFromSources(ctx context.Context, urlsOrPaths []string) (*Client, error) {
+	cfg, err := config.FromSources(ctx, urlsOrPaths)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get config")
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/pkg/errors"
@@ -202,14 +203,19 @@ func (ob *Observer) voteInbound(ctx context.Context, tx *toncontracts.Transactio
 		return "", errors.Wrapf(err, "unable to get block header %s", tx.BlockID.String())
 	}
 
-	inboundData, err := extractInboundData(tx)
+	msg, err := BuildInboundVoteMsg(
+		tx,
+		blockHeader.MinRefMcSeqno,
+		ob.Chain().ChainId,
+		ob.ZetacoreClient().Chain().ChainId,
+		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
+	)
 	if err != nil {
 		return "", err
 	}
+	ob.SetInboundBlockTime(msg.InboundBlockHeight, time.Unix(int64(tx.Now), 0))
 
-	seqno := blockHeader.MinRefMcSeqno
-
-	return ob.voteDeposit(ctx, tx, inboundData, seqno)
+	return ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 }
 
 // extractInboundData parses Gateway tx into deposit (TON sender, amount, memo)
@@ -244,33 +250,37 @@ func extractInboundData(tx *toncontracts.Transaction) (inboundData, error) {
 	}
 }
 
-func (ob *Observer) voteDeposit(
-	ctx context.Context,
+// BuildInboundVoteMsg builds the inbound vote of a gateway deposit.
+// seqno is the seqno of the masterchain block referenced by the block of the tx (used as the inbound block height).
+// It's also used by zetatool to reconstruct the ballot of an inbound, so it must not depend on the observer state.
+func BuildInboundVoteMsg(
 	tx *toncontracts.Transaction,
-	inboundData inboundData,
 	seqno uint32,
-) (string, error) {
+	chainID int64,
+	zetaChainID int64,
+	signer string,
+) (*types.MsgVoteInbound, error) {
 	const (
-		eventIndex    = 0 // not a smart contract call
-		coinType      = coin.CoinType_Gas
-		asset         = "" // empty for gas coin
-		gasLimit      = 0
-		retryGasLimit = zetacore.PostVoteInboundExecutionGasLimit
+		eventIndex = 0 // not a smart contract call
+		coinType   = coin.CoinType_Gas
+		asset      = "" // empty for gas coin
+		gasLimit   = 0
 	)
 
-	var (
-		operatorAddress = ob.ZetacoreClient().GetKeys().GetOperatorAddress()
-		inboundHash     = liteapi.TransactionHashToString(tx.Lt, ton.Bits256(tx.Hash()))
-	)
+	inboundData, err := extractInboundData(tx)
+	if err != nil {
+		return nil, err
+	}
 
-	// create the inbound message
-	msg := types.NewMsgVoteInbound(
-		operatorAddress.String(),
+	inboundHash := liteapi.TransactionHashToString(tx.Lt, ton.Bits256(tx.Hash()))
+
+	return types.NewMsgVoteInbound(
+		signer,
 		inboundData.sender,
-		ob.Chain().ChainId,
+		chainID,
 		inboundData.sender,
 		inboundData.receiver,
-		ob.ZetacoreClient().Chain().ChainId,
+		zetaChainID,
 		inboundData.amount,
 		hex.EncodeToString(inboundData.message),
 		inboundHash,
@@ -284,9 +294,7 @@ func (ob *Observer) voteDeposit(
 		types.InboundStatus_SUCCESS,
 		types.ConfirmationMode_SAFE,
 		types.WithCrossChainCall(inboundData.isContractCall),
-	)
-
-	return ob.PostVoteInbound(ctx, msg, retryGasLimit)
+	), nil
 }
 
 func (ob *Observer) ensureLastScannedTX(ctx context.Context) error {
@@ -174,6 +174,14 @@ func TestInbound(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, uint64(blockInfo.MinRefMcSeqno), cctx.InboundBlockHeight)
+
+		// Check that the ballot can be reconstructed from the tx (e.g. by zetatool)
+		parsedTX, err := ts.gateway.ParseTransaction(depositTX)
+		require.NoError(t, err)
+
+		msg, err := BuildInboundVoteMsg(parsedTX, blockInfo.MinRefMcSeqno, ts.chain.ChainId, cctx.ReceiverChain, "")
+		require.NoError(t, err)
+		assert.Equal(t, cctx.Digest(), msg.Digest())
 	})
 
 	t.Run("Deposit and call", func(t *testing.T) {
@@ -13,6 +13,8 @@ import (
 	cc "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/chains/ton/liteapi"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 	gasconst "github.com/zeta-chain/node/zetaclient/zetacore"
 )
 
@@ -275,6 +277,8 @@ func (ob *Observer) postVoteOutbound(
 			Str("outbound.vote_tx_hash", zetaTxHash).
 			Str("outbound.ballot_id", ballot).
 			Msg("PostVoteOutbound: posted vote")
+		ob.RecordVote(ctx, clienttypes.ToOutboundVoteSQLType(msg, ballot, zetaTxHash))
+		metrics.OutboundConfirmed(ob.Chain().Name, msg.OutboundTssNonce)
 	}
 
 	return nil
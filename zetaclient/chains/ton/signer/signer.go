@@ -17,6 +17,7 @@ import (
 	cc "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/node/zetaclient/metrics"
 )
 
 // LiteClient represents a TON client
@@ -182,6 +183,7 @@ func (s *Signer) SignMessage(ctx context.Context, msg Signable, zetaHeight, nonc
 	if err != nil {
 		return errors.Wrap(err, "unable to sign the message")
 	}
+	metrics.OutboundSigned(s.Chain().Name, nonce)
 
 	msg.SetSignature(sig)
 	s.setSignature(hash, sig)
@@ -69,11 +69,11 @@ func (t *TON) Start(ctx context.Context) error {
 	})
 
 	optInboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsInboundObservationEnabled()
+		return !app.IsInboundObservationEnabled() || app.IsChainHalted(t.observer.Chain().ChainId)
 	})
 
 	optOutboundSkipper := scheduler.Skipper(func() bool {
-		return !app.IsOutboundObservationEnabled()
+		return !app.IsOutboundObservationEnabled() || app.IsChainHalted(t.observer.Chain().ChainId)
 	})
 
 	optGenericSkipper := scheduler.Skipper(func() bool {
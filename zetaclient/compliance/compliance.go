@@ -2,18 +2,22 @@
 package compliance
 
 import (
+	"context"
+
 	"github.com/rs/zerolog"
 
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
-	"github.com/zeta-chain/node/zetaclient/config"
 )
 
 // IsCctxRestricted returns true if the cctx involves restricted addresses
-func IsCctxRestricted(cctx *crosschaintypes.CrossChainTx) bool {
+// The sender, the current receiver and the revert/abort addresses of the cctx are checked
+func IsCctxRestricted(ctx context.Context, cctx *crosschaintypes.CrossChainTx) bool {
 	sender := cctx.InboundParams.Sender
 	receiver := cctx.GetCurrentOutboundParam().Receiver
+	revertAddress := cctx.RevertOptions.RevertAddress
+	abortAddress := cctx.RevertOptions.AbortAddress
 
-	return config.ContainRestrictedAddress(sender, receiver)
+	return ContainRestrictedAddress(ctx, sender, receiver, revertAddress, abortAddress)
 }
 
 // PrintComplianceLog prints compliance log with fields [chain, cctx/inbound, chain, sender, receiver, token]
@@ -1,12 +1,15 @@
-package compliance
+package compliance_test
 
 import (
+	"context"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/zetaclient/compliance"
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/testutils"
 )
@@ -20,33 +23,67 @@ func TestCctxRestricted(t *testing.T) {
 	cfg := config.Config{
 		ComplianceConfig: config.ComplianceConfig{},
 	}
+	ctx := context.Background()
 
 	t.Run("should return true if sender is restricted", func(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{cctx.InboundParams.Sender}
 		config.LoadComplianceConfig(cfg)
-		require.True(t, IsCctxRestricted(cctx))
+		require.True(t, compliance.IsCctxRestricted(ctx, cctx))
 	})
 	t.Run("should return true if receiver is restricted", func(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{cctx.GetCurrentOutboundParam().Receiver}
 		config.LoadComplianceConfig(cfg)
-		require.True(t, IsCctxRestricted(cctx))
+		require.True(t, compliance.IsCctxRestricted(ctx, cctx))
+	})
+	t.Run("should return true if revert address is restricted", func(t *testing.T) {
+		revertAddress := "0x8531a5aB847ff5B22D855633C25ED1DA3255247e"
+		cfg.ComplianceConfig.RestrictedAddresses = []string{revertAddress}
+		config.LoadComplianceConfig(cfg)
+
+		cctxCopy := *cctx
+		cctxCopy.RevertOptions.RevertAddress = revertAddress
+		require.True(t, compliance.IsCctxRestricted(ctx, &cctxCopy))
+	})
+	t.Run("should return true if abort address is restricted", func(t *testing.T) {
+		abortAddress := "0x7c125C1d515b8945841b3d5144a060115C58725F"
+		cfg.ComplianceConfig.RestrictedAddresses = []string{abortAddress}
+		config.LoadComplianceConfig(cfg)
+
+		cctxCopy := *cctx
+		cctxCopy.RevertOptions.AbortAddress = abortAddress
+		require.True(t, compliance.IsCctxRestricted(ctx, &cctxCopy))
+	})
+	t.Run("should return true if screener restricts an address", func(t *testing.T) {
+		cfg.ComplianceConfig.RestrictedAddresses = nil
+		config.LoadComplianceConfig(cfg)
+		screener := mapScreener{cctx.InboundParams.Sender: true}
+		ctx := compliance.WithScreener(ctx, screener, false, zerolog.Nop())
+
+		require.True(t, compliance.IsCctxRestricted(ctx, cctx))
 	})
 	t.Run("should return false if sender and receiver are not restricted", func(t *testing.T) {
 		// restrict other address
 		cfg.ComplianceConfig.RestrictedAddresses = []string{"0x27104b8dB4aEdDb054fCed87c346C0758Ff5dFB1"}
 		config.LoadComplianceConfig(cfg)
-		require.False(t, IsCctxRestricted(cctx))
+		require.False(t, compliance.IsCctxRestricted(ctx, cctx))
 	})
 	t.Run("should be able to restrict coinbase address", func(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{ethcommon.Address{}.String()}
 		config.LoadComplianceConfig(cfg)
 		cctx.InboundParams.Sender = ethcommon.Address{}.String()
-		require.True(t, IsCctxRestricted(cctx))
+		require.True(t, compliance.IsCctxRestricted(ctx, cctx))
 	})
 	t.Run("should ignore empty address", func(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{""}
 		config.LoadComplianceConfig(cfg)
 		cctx.InboundParams.Sender = ""
-		require.False(t, IsCctxRestricted(cctx))
+		require.False(t, compliance.IsCctxRestricted(ctx, cctx))
 	})
 }
+
+// mapScreener is a Screener that restricts the addresses of the map
+type mapScreener map[string]bool
+
+func (ms mapScreener) IsRestricted(_ context.Context, address string) (bool, error) {
+	return ms[address], nil
+}
@@ -0,0 +1,151 @@
+package compliance
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+// defaultScreeningCacheTTL is the default time-to-live of cached screening results
+const defaultScreeningCacheTTL = 10 * time.Minute
+
+// Screener screens addresses against a list of restricted (e.g. sanctioned) addresses
+type Screener interface {
+	// IsRestricted returns true if the address is restricted
+	IsRestricted(ctx context.Context, address string) (bool, error)
+}
+
+type screenerCtxKey struct{}
+
+// screening is the screener injected in the context and how its errors are handled
+type screening struct {
+	screener Screener
+
+	// failClosed restricts the addresses that can't be screened
+	failClosed bool
+
+	// logger logs the errors of the screener
+	logger zerolog.Logger
+}
+
+// WithScreener returns a copy of the context with the screener used by ContainRestrictedAddress,
+// a nil screener disables screening.
+// If failClosed is true, an address that can't be screened is considered restricted.
+func WithScreener(ctx context.Context, s Screener, failClosed bool, logger zerolog.Logger) context.Context {
+	return context.WithValue(ctx, screenerCtxKey{}, screening{
+		screener:   s,
+		failClosed: failClosed,
+		logger:     logger,
+	})
+}
+
+// screeningFromContext returns the screening of the context, if any.
+func screeningFromContext(ctx context.Context) (screening, bool) {
+	sc, ok := ctx.Value(screenerCtxKey{}).(screening)
+	return sc, ok && sc.screener != nil
+}
+
+// NewScreener creates the screener described by the compliance config.
+// It returns nil if neither restricted addresses file nor screening service is configured.
+func NewScreener(ctx context.Context, cfg config.ComplianceConfig, logger zerolog.Logger) (Screener, error) {
+	var screeners multiScreener
+
+	if cfg.RestrictedAddressesFile != "" {
+		fileScreener, err := NewFileScreener(cfg.RestrictedAddressesFile, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create file screener")
+		}
+		fileScreener.Watch(ctx)
+		screeners = append(screeners, fileScreener)
+	}
+
+	if cfg.ScreeningURL != "" {
+		ttl := defaultScreeningCacheTTL
+		if cfg.ScreeningCacheTTL > 0 {
+			ttl = time.Duration(cfg.ScreeningCacheTTL) * time.Second
+		}
+		cachedScreener, err := NewCachedScreener(NewHTTPScreener(cfg.ScreeningURL), ttl)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create cached screener")
+		}
+		screeners = append(screeners, cachedScreener)
+	}
+
+	switch len(screeners) {
+	case 0:
+		return nil, nil
+	case 1:
+		return screeners[0], nil
+	default:
+		return screeners, nil
+	}
+}
+
+// ContainRestrictedAddress returns true if any one of the addresses is restricted,
+// either by the restricted addresses of the config or by the screener of the context.
+// The screening requests are bound to the given context.
+//
+// Note: a screening error is logged and, unless the screener fails closed, the address is considered
+// not restricted, because restricting a legit address (e.g. cancelling its outbound) can't be undone.
+func ContainRestrictedAddress(ctx context.Context, addrs ...string) bool {
+	if config.ContainRestrictedAddress(addrs...) {
+		return true
+	}
+
+	sc, ok := screeningFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	for _, addr := range addrs {
+		if addr == "" {
+			continue
+		}
+
+		restricted, err := sc.screener.IsRestricted(ctx, addr)
+		if err != nil {
+			sc.logger.Error().
+				Err(err).
+				Str("address", addr).
+				Bool("fail_closed", sc.failClosed).
+				Msg("unable to screen address")
+			if sc.failClosed {
+				return true
+			}
+			continue
+		}
+		if restricted {
+			return true
+		}
+	}
+
+	return false
+}
+
+// multiScreener restricts an address if any one of the screeners restricts it
+type multiScreener []Screener
+
+// IsRestricted implements Screener
+func (ms multiScreener) IsRestricted(ctx context.Context, address string) (bool, error) {
+	var errs []string
+	for _, s := range ms {
+		restricted, err := s.IsRestricted(ctx, address)
+		switch {
+		case err != nil:
+			errs = append(errs, err.Error())
+		case restricted:
+			return true, nil
+		}
+	}
+
+	if len(errs) > 0 {
+		return false, errors.New(strings.Join(errs, "; "))
+	}
+
+	return false, nil
+}
@@ -0,0 +1,78 @@
+package compliance
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+)
+
+// screeningCacheSize is the maximum number of addresses whose screening result is cached,
+// the least recently used ones are evicted first
+const screeningCacheSize = 10_000
+
+// CachedScreener caches the screening results of the underlying screener for a TTL.
+//
+// If the underlying screener fails, the last known (possibly expired) result of the address is returned,
+// as long as it's not evicted from the cache.
+type CachedScreener struct {
+	screener Screener
+	ttl      time.Duration
+
+	// results maps the lowercase addresses to their screeningResult
+	results *lru.Cache
+
+	// now is overridable for tests
+	now func() time.Time
+}
+
+// screeningResult is a cached screening result
+type screeningResult struct {
+	restricted bool
+	expiresAt  time.Time
+}
+
+// NewCachedScreener creates a new CachedScreener
+func NewCachedScreener(screener Screener, ttl time.Duration) (*CachedScreener, error) {
+	results, err := lru.New(screeningCacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create screening cache")
+	}
+
+	return &CachedScreener{
+		screener: screener,
+		ttl:      ttl,
+		results:  results,
+		now:      time.Now,
+	}, nil
+}
+
+// IsRestricted implements Screener
+func (cs *CachedScreener) IsRestricted(ctx context.Context, address string) (bool, error) {
+	key := strings.ToLower(address)
+	now := cs.now()
+
+	var cached screeningResult
+	value, found := cs.results.Get(key)
+	if found {
+		cached = value.(screeningResult)
+	}
+
+	if found && now.Before(cached.expiresAt) {
+		return cached.restricted, nil
+	}
+
+	restricted, err := cs.screener.IsRestricted(ctx, address)
+	if err != nil {
+		if found {
+			return cached.restricted, nil
+		}
+		return false, err
+	}
+
+	cs.results.Add(key, screeningResult{restricted: restricted, expiresAt: now.Add(cs.ttl)})
+
+	return restricted, nil
+}
@@ -0,0 +1,76 @@
+package compliance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CachedScreener(t *testing.T) {
+	ttl := time.Minute
+
+	t.Run("should cache screening result until TTL expires", func(t *testing.T) {
+		stub := &stubScreener{restricted: map[string]bool{"0xabc": true}}
+		cs, err := NewCachedScreener(stub, ttl)
+		require.NoError(t, err)
+		now := time.Now()
+		cs.now = func() time.Time { return now }
+
+		requireRestricted(t, cs, "0xabc", true)
+		requireRestricted(t, cs, "0xABC", true)
+		require.Equal(t, 1, stub.calls)
+
+		// address is no longer restricted but the result is still cached
+		stub.restricted = map[string]bool{}
+		requireRestricted(t, cs, "0xabc", true)
+		require.Equal(t, 1, stub.calls)
+
+		// result expired
+		now = now.Add(ttl)
+		requireRestricted(t, cs, "0xabc", false)
+		require.Equal(t, 2, stub.calls)
+	})
+
+	t.Run("should return expired result if screener fails", func(t *testing.T) {
+		stub := &stubScreener{restricted: map[string]bool{"0xabc": true}}
+		cs, err := NewCachedScreener(stub, ttl)
+		require.NoError(t, err)
+		now := time.Now()
+		cs.now = func() time.Time { return now }
+
+		requireRestricted(t, cs, "0xabc", true)
+
+		now = now.Add(2 * ttl)
+		stub.err = errors.New("screener error")
+		requireRestricted(t, cs, "0xabc", true)
+	})
+
+	t.Run("should return error if screener fails without cached result", func(t *testing.T) {
+		cs, err := NewCachedScreener(&stubScreener{err: errors.New("screener error")}, ttl)
+		require.NoError(t, err)
+
+		_, err = cs.IsRestricted(context.Background(), "0xabc")
+		require.ErrorContains(t, err, "screener error")
+	})
+
+	t.Run("should evict least recently used results", func(t *testing.T) {
+		stub := &stubScreener{restricted: map[string]bool{"0xabc": true}}
+		cs, err := NewCachedScreener(stub, ttl)
+		require.NoError(t, err)
+
+		requireRestricted(t, cs, "0xabc", true)
+		for i := 0; i < screeningCacheSize; i++ {
+			requireRestricted(t, cs, fmt.Sprintf("0x%d", i), false)
+		}
+		require.Equal(t, screeningCacheSize, cs.results.Len())
+
+		// the result of the first address is evicted, so it's screened again
+		stub.err = errors.New("screener error")
+		_, err = cs.IsRestricted(context.Background(), "0xabc")
+		require.ErrorContains(t, err, "screener error")
+	})
+}
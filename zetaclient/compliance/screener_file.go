@@ -0,0 +1,172 @@
+package compliance
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/node/pkg/bg"
+)
+
+// fileScreenerPollInterval is the interval at which the restricted addresses file is checked for changes
+const fileScreenerPollInterval = 10 * time.Second
+
+// FileScreener screens addresses against a restricted addresses file.
+// The file is either a JSON array of addresses or a CSV file with the address in the first column.
+type FileScreener struct {
+	path         string
+	pollInterval time.Duration
+	logger       zerolog.Logger
+
+	mu          sync.RWMutex
+	addressBook map[string]bool
+	modTime     time.Time
+	size        int64
+}
+
+// NewFileScreener creates a new FileScreener and loads the restricted addresses file
+func NewFileScreener(path string, logger zerolog.Logger) (*FileScreener, error) {
+	fs := &FileScreener{
+		path:         path,
+		pollInterval: fileScreenerPollInterval,
+		logger:       logger.With().Str("module", "file_screener").Str("path", path).Logger(),
+	}
+
+	if _, err := fs.reloadIfChanged(); err != nil {
+		return nil, err
+	}
+
+	return fs, nil
+}
+
+// IsRestricted implements Screener
+func (fs *FileScreener) IsRestricted(_ context.Context, address string) (bool, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	return fs.addressBook[strings.ToLower(address)], nil
+}
+
+// Watch reloads the restricted addresses file in the background whenever it changes
+func (fs *FileScreener) Watch(ctx context.Context) {
+	bg.Work(ctx, fs.watch, bg.WithName("FileScreener"), bg.WithLogger(fs.logger))
+}
+
+func (fs *FileScreener) watch(ctx context.Context) error {
+	ticker := time.NewTicker(fs.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			reloaded, err := fs.reloadIfChanged()
+			switch {
+			case err != nil:
+				// keep the previous restricted addresses if the file is temporarily invalid
+				fs.logger.Error().Err(err).Msg("unable to reload restricted addresses file")
+			case reloaded:
+				fs.logger.Info().Int("addresses", fs.count()).Msg("reloaded restricted addresses file")
+			}
+		}
+	}
+}
+
+// reloadIfChanged reloads the restricted addresses file if its modification time or size changed
+func (fs *FileScreener) reloadIfChanged() (bool, error) {
+	info, err := os.Stat(fs.path)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to stat file %s", fs.path)
+	}
+
+	fs.mu.RLock()
+	unchanged := fs.addressBook != nil && info.ModTime().Equal(fs.modTime) && info.Size() == fs.size
+	fs.mu.RUnlock()
+
+	if unchanged {
+		return false, nil
+	}
+
+	addressBook, err := readRestrictedAddresses(fs.path)
+	if err != nil {
+		return false, err
+	}
+
+	fs.mu.Lock()
+	fs.addressBook = addressBook
+	fs.modTime = info.ModTime()
+	fs.size = info.Size()
+	fs.mu.Unlock()
+
+	return true, nil
+}
+
+func (fs *FileScreener) count() int {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	return len(fs.addressBook)
+}
+
+// readRestrictedAddresses reads the restricted addresses from a JSON or CSV file
+func readRestrictedAddresses(path string) (map[string]bool, error) {
+	// #nosec G304 -- the file path is provided by the operator config
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open file %s", path)
+	}
+	defer file.Close()
+
+	var addresses []string
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.NewDecoder(file).Decode(&addresses); err != nil {
+			return nil, errors.Wrapf(err, "unable to decode JSON file %s", path)
+		}
+	} else {
+		addresses, err = readCSVAddresses(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read CSV file %s", path)
+		}
+	}
+
+	addressBook := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		address = strings.TrimSpace(address)
+		if address != "" {
+			addressBook[strings.ToLower(address)] = true
+		}
+	}
+
+	return addressBook, nil
+}
+
+// readCSVAddresses reads the first column of each CSV record, lines starting with '#' are ignored
+func readCSVAddresses(r io.Reader) ([]string, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var addresses []string
+	for {
+		record, err := reader.Read()
+		switch {
+		case errors.Is(err, io.EOF):
+			return addresses, nil
+		case err != nil:
+			return nil, err
+		case len(record) > 0:
+			addresses = append(addresses, record[0])
+		}
+	}
+}
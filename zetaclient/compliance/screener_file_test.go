@@ -0,0 +1,92 @@
+package compliance
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FileScreener(t *testing.T) {
+	t.Run("should load JSON file", func(t *testing.T) {
+		path := writeFile(t, "restricted.json", `["0xAbC", " 0xdef ", ""]`)
+
+		fs, err := NewFileScreener(path, zerolog.Nop())
+		require.NoError(t, err)
+
+		requireRestricted(t, fs, "0xabc", true)
+		requireRestricted(t, fs, "0xDEF", true)
+		requireRestricted(t, fs, "0x123", false)
+	})
+
+	t.Run("should load CSV file", func(t *testing.T) {
+		content := "# sanctioned addresses\n0xAbC,OFAC\nbc1qxyz, manual\n\n"
+		path := writeFile(t, "restricted.csv", content)
+
+		fs, err := NewFileScreener(path, zerolog.Nop())
+		require.NoError(t, err)
+
+		requireRestricted(t, fs, "0xabc", true)
+		requireRestricted(t, fs, "BC1QXYZ", true)
+		requireRestricted(t, fs, "OFAC", false)
+	})
+
+	t.Run("should fail on invalid JSON file", func(t *testing.T) {
+		path := writeFile(t, "restricted.json", `{"address": "0xabc"}`)
+
+		_, err := NewFileScreener(path, zerolog.Nop())
+		require.ErrorContains(t, err, "unable to decode JSON file")
+	})
+
+	t.Run("should reload file on change", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		path := writeFile(t, "restricted.json", `["0xabc"]`)
+
+		fs, err := NewFileScreener(path, zerolog.Nop())
+		require.NoError(t, err)
+		fs.pollInterval = 10 * time.Millisecond
+		fs.Watch(ctx)
+
+		requireRestricted(t, fs, "0xabc", true)
+
+		// update the file
+		require.NoError(t, os.WriteFile(path, []byte(`["0xdef", "0x123"]`), 0o600))
+
+		require.Eventually(t, func() bool {
+			restricted, _ := fs.IsRestricted(context.Background(), "0xdef")
+			return restricted
+		}, time.Second, 10*time.Millisecond)
+		requireRestricted(t, fs, "0xabc", false)
+	})
+
+	t.Run("should keep previous addresses if updated file is invalid", func(t *testing.T) {
+		path := writeFile(t, "restricted.json", `["0xabc"]`)
+
+		fs, err := NewFileScreener(path, zerolog.Nop())
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(path, []byte(`invalid json`), 0o600))
+		_, err = fs.reloadIfChanged()
+		require.Error(t, err)
+
+		requireRestricted(t, fs, "0xabc", true)
+	})
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func requireRestricted(t *testing.T, s Screener, address string, expected bool) {
+	restricted, err := s.IsRestricted(context.Background(), address)
+	require.NoError(t, err)
+	require.Equal(t, expected, restricted, "address %s", address)
+}
@@ -0,0 +1,66 @@
+package compliance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// httpScreenerTimeout is the timeout of a request to the screening service
+const httpScreenerTimeout = 5 * time.Second
+
+// HTTPScreener screens addresses by querying an HTTP screening service.
+//
+// The service is queried with `GET {baseURL}/{address}` and responds with `{"restricted": true|false}`.
+type HTTPScreener struct {
+	baseURL string
+	client  *http.Client
+}
+
+// screeningResponse is the response of the screening service
+type screeningResponse struct {
+	Restricted bool `json:"restricted"`
+}
+
+// NewHTTPScreener creates a new HTTPScreener
+func NewHTTPScreener(baseURL string) *HTTPScreener {
+	return &HTTPScreener{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: httpScreenerTimeout},
+	}
+}
+
+// IsRestricted implements Screener
+func (hs *HTTPScreener) IsRestricted(ctx context.Context, address string) (bool, error) {
+	endpoint := fmt.Sprintf("%s/%s", hs.baseURL, url.PathEscape(address))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to create screening request")
+	}
+
+	res, err := hs.client.Do(req)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to query screening service for address %s", address)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return false, fmt.Errorf("screening service returned status %d: %s", res.StatusCode, string(body))
+	}
+
+	var screening screeningResponse
+	if err := json.NewDecoder(res.Body).Decode(&screening); err != nil {
+		return false, errors.Wrap(err, "unable to decode screening response")
+	}
+
+	return screening.Restricted, nil
+}
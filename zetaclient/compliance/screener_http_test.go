@@ -0,0 +1,62 @@
+package compliance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HTTPScreener(t *testing.T) {
+	// stub screening service restricting a single address
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		address := strings.TrimPrefix(r.URL.Path, "/screen/")
+		switch address {
+		case "0xabc":
+			_, _ = w.Write([]byte(`{"restricted": true}`))
+		case "invalid":
+			_, _ = w.Write([]byte(`not json`))
+		case "error":
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		default:
+			_, _ = w.Write([]byte(`{"restricted": false}`))
+		}
+	}))
+	defer server.Close()
+
+	hs := NewHTTPScreener(server.URL + "/screen/")
+
+	t.Run("should return restricted address", func(t *testing.T) {
+		requireRestricted(t, hs, "0xabc", true)
+	})
+
+	t.Run("should return non-restricted address", func(t *testing.T) {
+		requireRestricted(t, hs, "0xdef", false)
+	})
+
+	t.Run("should fail on non-200 status", func(t *testing.T) {
+		_, err := hs.IsRestricted(context.Background(), "error")
+		require.ErrorContains(t, err, "status 500")
+	})
+
+	t.Run("should fail on invalid response", func(t *testing.T) {
+		_, err := hs.IsRestricted(context.Background(), "invalid")
+		require.ErrorContains(t, err, "unable to decode screening response")
+	})
+
+	t.Run("should fail if service is unreachable", func(t *testing.T) {
+		_, err := NewHTTPScreener("http://127.0.0.1:0").IsRestricted(context.Background(), "0xabc")
+		require.ErrorContains(t, err, "unable to query screening service")
+	})
+
+	t.Run("should fail if context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := hs.IsRestricted(ctx, "0xabc")
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
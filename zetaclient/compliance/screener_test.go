@@ -0,0 +1,145 @@
+package compliance
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+// stubScreener is a Screener that restricts a fixed set of addresses
+type stubScreener struct {
+	restricted map[string]bool
+	err        error
+	calls      int
+}
+
+func (s *stubScreener) IsRestricted(_ context.Context, address string) (bool, error) {
+	s.calls++
+	if s.err != nil {
+		return false, s.err
+	}
+	return s.restricted[address], nil
+}
+
+func Test_ContainRestrictedAddress(t *testing.T) {
+	restricted := "0x8531a5aB847ff5B22D855633C25ED1DA3255247e"
+	other := "0x7c125C1d515b8945841b3d5144a060115C58725F"
+
+	t.Run("should check restricted addresses of the config without screener", func(t *testing.T) {
+		config.LoadComplianceConfig(config.Config{
+			ComplianceConfig: config.ComplianceConfig{RestrictedAddresses: []string{restricted}},
+		})
+		t.Cleanup(func() { config.LoadComplianceConfig(config.Config{}) })
+		ctx := context.Background()
+
+		require.True(t, ContainRestrictedAddress(ctx, other, restricted))
+		require.False(t, ContainRestrictedAddress(ctx, other))
+	})
+
+	t.Run("should check addresses with the screener", func(t *testing.T) {
+		s := &stubScreener{restricted: map[string]bool{restricted: true}}
+		ctx := WithScreener(context.Background(), s, false, zerolog.Nop())
+
+		require.True(t, ContainRestrictedAddress(ctx, other, restricted))
+		require.False(t, ContainRestrictedAddress(ctx, other, ""))
+	})
+
+	t.Run("should not restrict addresses if the screener fails", func(t *testing.T) {
+		ctx := WithScreener(context.Background(), &stubScreener{err: errors.New("screener error")}, false, zerolog.Nop())
+
+		require.False(t, ContainRestrictedAddress(ctx, restricted))
+	})
+
+	t.Run("should restrict addresses if the screener fails closed", func(t *testing.T) {
+		ctx := WithScreener(context.Background(), &stubScreener{err: errors.New("screener error")}, true, zerolog.Nop())
+
+		require.True(t, ContainRestrictedAddress(ctx, other))
+		require.False(t, ContainRestrictedAddress(ctx, ""))
+	})
+
+	t.Run("should not screen empty address", func(t *testing.T) {
+		s := &stubScreener{}
+		ctx := WithScreener(context.Background(), s, false, zerolog.Nop())
+
+		require.False(t, ContainRestrictedAddress(ctx, ""))
+		require.Zero(t, s.calls)
+	})
+}
+
+func Test_MultiScreener(t *testing.T) {
+	address := "0x8531a5aB847ff5B22D855633C25ED1DA3255247e"
+
+	t.Run("should restrict address if any screener restricts it", func(t *testing.T) {
+		ms := multiScreener{
+			&stubScreener{err: errors.New("screener error")},
+			&stubScreener{restricted: map[string]bool{address: true}},
+		}
+
+		restricted, err := ms.IsRestricted(context.Background(), address)
+		require.NoError(t, err)
+		require.True(t, restricted)
+	})
+
+	t.Run("should return error if a screener fails and no screener restricts the address", func(t *testing.T) {
+		ms := multiScreener{
+			&stubScreener{err: errors.New("screener error")},
+			&stubScreener{},
+		}
+
+		restricted, err := ms.IsRestricted(context.Background(), address)
+		require.ErrorContains(t, err, "screener error")
+		require.False(t, restricted)
+	})
+}
+
+func Test_NewScreener(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("should return nil screener if nothing is configured", func(t *testing.T) {
+		s, err := NewScreener(ctx, config.ComplianceConfig{}, zerolog.Nop())
+		require.NoError(t, err)
+		require.Nil(t, s)
+	})
+
+	t.Run("should create file screener", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "restricted.json")
+		require.NoError(t, os.WriteFile(path, []byte(`["0xabc"]`), 0o600))
+
+		s, err := NewScreener(ctx, config.ComplianceConfig{RestrictedAddressesFile: path}, zerolog.Nop())
+		require.NoError(t, err)
+		require.IsType(t, &FileScreener{}, s)
+	})
+
+	t.Run("should create cached HTTP screener", func(t *testing.T) {
+		s, err := NewScreener(ctx, config.ComplianceConfig{ScreeningURL: "http://localhost:8080"}, zerolog.Nop())
+		require.NoError(t, err)
+		require.IsType(t, &CachedScreener{}, s)
+	})
+
+	t.Run("should combine file and HTTP screeners", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "restricted.csv")
+		require.NoError(t, os.WriteFile(path, []byte("0xabc\n"), 0o600))
+
+		s, err := NewScreener(ctx, config.ComplianceConfig{
+			RestrictedAddressesFile: path,
+			ScreeningURL:            "http://localhost:8080",
+		}, zerolog.Nop())
+		require.NoError(t, err)
+		require.Len(t, s, 2)
+	})
+
+	t.Run("should fail if restricted addresses file does not exist", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing.json")
+
+		_, err := NewScreener(ctx, config.ComplianceConfig{RestrictedAddressesFile: path}, zerolog.Nop())
+		require.ErrorContains(t, err, "unable to create file screener")
+	})
+}
@@ -69,10 +69,20 @@ func Load(path string) (Config, error) {
 	if cfg.KeyringBackend == KeyringBackendUndefined {
 		cfg.KeyringBackend = KeyringBackendTest
 	}
-	if cfg.KeyringBackend != KeyringBackendFile && cfg.KeyringBackend != KeyringBackendTest {
+	switch cfg.KeyringBackend {
+	case KeyringBackendFile, KeyringBackendTest:
+	case KeyringBackendRemote:
+		if err := cfg.RemoteSigner.Validate(); err != nil {
+			return Config{}, errors.Wrap(err, "invalid remote signer config")
+		}
+	default:
 		return Config{}, fmt.Errorf("invalid keyring backend %s", cfg.KeyringBackend)
 	}
 
+	if err := cfg.DatabaseConfig.Validate(); err != nil {
+		return Config{}, errors.Wrap(err, "invalid database config")
+	}
+
 	// fields sanitization
 	cfg.TssPath = GetPath(cfg.TssPath)
 	cfg.PreParamsPath = GetPath(cfg.PreParamsPath)
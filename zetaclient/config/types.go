@@ -2,9 +2,13 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/showa-93/go-mask"
 )
 
@@ -21,10 +25,22 @@ const (
 	// KeyringBackendFile is the file Cosmos keyring backend
 	KeyringBackendFile KeyringBackend = "file"
 
+	// KeyringBackendRemote signs with a remote signer, the hotkey is not stored locally
+	KeyringBackendRemote KeyringBackend = "remote"
+
 	DefaultRelayerDir = "relayer-keys"
 
 	// DefaultRelayerKeyPath is the default path that relayer keys are stored
 	DefaultRelayerKeyPath = "~/.zetacored/" + DefaultRelayerDir
+
+	// DefaultVoteHistoryRetention is the default number of days the posted votes are kept in the database
+	DefaultVoteHistoryRetention = 30
+
+	// DatabaseDriverSqlite is the SQLite database driver, one database file per chain
+	DatabaseDriverSqlite = "sqlite"
+
+	// DatabaseDriverPostgres is the Postgres database driver, one schema per chain
+	DatabaseDriverPostgres = "postgres"
 )
 
 // ClientConfiguration is a subset of zetaclient config that is used by zetacore client
@@ -39,6 +55,12 @@ type ClientConfiguration struct {
 // EVMConfig is the config for EVM chain
 type EVMConfig struct {
 	Endpoint string `mask:"filled"`
+
+	// Endpoints are additional endpoints the client fails over to
+	Endpoints []string `mask:"filled"`
+
+	// RPCQuorum is the number of endpoints that must agree on security-critical reads (disabled if below 2)
+	RPCQuorum int
 }
 
 // BTCConfig is the config for Bitcoin chain
@@ -48,28 +70,105 @@ type BTCConfig struct {
 	RPCPassword string `mask:"filled"`
 	RPCHost     string `mask:"filled"`
 	RPCParams   string // "regtest", "mainnet", "testnet3" , "signet", "testnet4"
+
+	// RPCHosts are additional hosts the client fails over to, they share the same credentials
+	RPCHosts []string `mask:"filled"`
+
+	// RPCQuorum is the number of hosts that must agree on security-critical reads (disabled if below 2)
+	RPCQuorum int
 }
 
 // SolanaConfig is the config for Solana chain
 type SolanaConfig struct {
 	Endpoint string `mask:"filled"`
+
+	// Endpoints are additional endpoints the client fails over to
+	Endpoints []string `mask:"filled"`
+
+	// RPCQuorum is the number of endpoints that must agree on security-critical reads (disabled if below 2)
+	RPCQuorum int
+
+	// PriorityFeeRetryInterval is the interval (in seconds) a pending outbound waits before
+	// being re-signed with the priority fee of the cctx; the default interval is used if not set
+	PriorityFeeRetryInterval uint64
 }
 
 // SuiConfig is the config for Sui chain
 type SuiConfig struct {
 	Endpoint string `mask:"filled"`
+
+	// Endpoints are additional endpoints the client fails over to
+	Endpoints []string `mask:"filled"`
+
+	// RPCQuorum is the number of endpoints that must agree on security-critical reads (disabled if below 2)
+	RPCQuorum int
 }
 
 // TONConfig is the config for TON chain
 type TONConfig struct {
 	// Can be either URL of local file path
 	LiteClientConfigURL string `json:"liteClientConfigURL"`
+
+	// LiteClientConfigURLs are additional lite client configs,
+	// the lite servers of all configs are used by the lite client
+	LiteClientConfigURLs []string `json:"liteClientConfigURLs"`
 }
 
 // ComplianceConfig is the config for compliance
 type ComplianceConfig struct {
 	LogPath             string   `json:"LogPath"`
 	RestrictedAddresses []string `json:"RestrictedAddresses" mask:"zero"`
+
+	// RestrictedAddressesFile is the path to a JSON or CSV file of restricted addresses.
+	// The file is watched and reloaded on change without restarting zetaclientd
+	RestrictedAddressesFile string `json:"RestrictedAddressesFile"`
+
+	// ScreeningURL is the base URL of an HTTP address screening service
+	ScreeningURL string `json:"ScreeningURL" mask:"filled"`
+
+	// ScreeningCacheTTL is the time-to-live (in seconds) of cached screening results
+	ScreeningCacheTTL uint64 `json:"ScreeningCacheTTL"`
+
+	// ScreeningFailClosed restricts an address when it can't be screened (e.g. screening service down).
+	// By default (fail-open), an address that can't be screened is not restricted.
+	ScreeningFailClosed bool `json:"ScreeningFailClosed"`
+}
+
+// DatabaseConfig is the config for the chain observer databases
+type DatabaseConfig struct {
+	// Driver is either DatabaseDriverSqlite (default) or DatabaseDriverPostgres
+	Driver string `json:"Driver"`
+
+	// PostgresDSN is the data source name of the Postgres database
+	PostgresDSN string `json:"PostgresDSN" mask:"filled"`
+
+	// MaxOpenConns is the maximum number of open connections of each chain observer (0 means unlimited)
+	MaxOpenConns int `json:"MaxOpenConns"`
+
+	// MaxIdleConns is the maximum number of idle connections of each chain observer
+	MaxIdleConns int `json:"MaxIdleConns"`
+
+	// ConnMaxLifetime is the maximum lifetime (in seconds) of a connection (0 means unlimited)
+	ConnMaxLifetime uint64 `json:"ConnMaxLifetime"`
+}
+
+// RemoteSignerConfig is the config of the remote signer holding the hotkey
+type RemoteSignerConfig struct {
+	// Endpoint is the URL of the remote signer
+	Endpoint string `json:"Endpoint" mask:"filled"`
+
+	// KeyID identifies the hotkey in the remote signer
+	KeyID string `json:"KeyID"`
+
+	// AuthToken is the bearer token sent to the remote signer
+	AuthToken string `json:"AuthToken" mask:"filled"`
+
+	// Timeout is the timeout (in seconds) of a signing request
+	Timeout uint64 `json:"Timeout"`
+
+	// LocalKeyringBackend is the backend of the local keyring holding the TSS p2p key (test by default).
+	// The TSS p2p key is the key named AuthzHotkey, its public key is the grantee pubkey of the node account.
+	LocalKeyringBackend KeyringBackend `json:"LocalKeyringBackend"`
 }
 
 // Config is the config for ZetaClient
@@ -95,6 +194,9 @@ type Config struct {
 	KeyringBackend      KeyringBackend `json:"KeyringBackend"`
 	RelayerKeyPath      string         `json:"RelayerKeyPath"`
 
+	// RemoteSigner is the remote signer config, used with the remote keyring backend
+	RemoteSigner RemoteSignerConfig `json:"RemoteSigner"`
+
 	// chain configs
 	EVMChainConfigs map[int64]EVMConfig `json:"EVMChainConfigs"`
 	BTCChainConfigs map[int64]BTCConfig `json:"BTCChainConfigs"`
@@ -107,6 +209,12 @@ type Config struct {
 	// compliance config
 	ComplianceConfig ComplianceConfig `json:"ComplianceConfig"`
 
+	// VoteHistoryRetention is the number of days the posted votes are kept in the database
+	VoteHistoryRetention uint64 `json:"VoteHistoryRetention"`
+
+	// database config
+	DatabaseConfig DatabaseConfig `json:"DatabaseConfig"`
+
 	mu *sync.RWMutex
 }
 
@@ -127,6 +235,7 @@ func (c Config) GetAllEVMConfigs() map[int64]EVMConfig {
 	// deep copy evm configs
 	copied := make(map[int64]EVMConfig, len(c.EVMChainConfigs))
 	for chainID, evmConfig := range c.EVMChainConfigs {
+		evmConfig.Endpoints = slices.Clone(evmConfig.Endpoints)
 		copied[chainID] = evmConfig
 	}
 	return copied
@@ -152,7 +261,7 @@ func (c Config) GetSolanaConfig() (SolanaConfig, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	return c.SolanaConfig, c.SolanaConfig != (SolanaConfig{})
+	return c.SolanaConfig, !c.SolanaConfig.Empty()
 }
 
 // GetSuiConfig returns the Sui config
@@ -160,7 +269,7 @@ func (c Config) GetSuiConfig() (SuiConfig, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	return c.SuiConfig, c.SuiConfig != (SuiConfig{})
+	return c.SuiConfig, !c.SuiConfig.Empty()
 }
 
 // GetTONConfig returns the TONConfig and a bool indicating if it's present.
@@ -168,7 +277,7 @@ func (c Config) GetTONConfig() (TONConfig, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	return c.TONConfig, c.TONConfig != TONConfig{}
+	return c.TONConfig, !c.TONConfig.Empty()
 }
 
 // StringMasked returns the string representation of the config with sensitive fields masked.
@@ -223,10 +332,121 @@ func (c Config) GetRelayerKeyPath() string {
 	return c.RelayerKeyPath
 }
 
+// GetVoteHistoryRetention returns the retention period of the posted votes in the database
+func (c Config) GetVoteHistoryRetention() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// use default retention if not configured
+	days := c.VoteHistoryRetention
+	if days == 0 {
+		days = DefaultVoteHistoryRetention
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// GetDatabaseConfig returns the database config
+func (c Config) GetDatabaseConfig() DatabaseConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.DatabaseConfig
+}
+
+// IsPostgres returns true if the chain observer databases are stored in Postgres
+func (c DatabaseConfig) IsPostgres() bool {
+	return c.Driver == DatabaseDriverPostgres
+}
+
+// Validate validates the remote signer config
+func (c RemoteSignerConfig) Validate() error {
+	switch {
+	case c.Endpoint == "":
+		return errors.New("remote signer endpoint is required")
+	case !strings.HasPrefix(c.Endpoint, "http://") && !strings.HasPrefix(c.Endpoint, "https://"):
+		return fmt.Errorf("unsupported remote signer endpoint %q", c.Endpoint)
+	case c.KeyID == "":
+		return errors.New("remote signer key id is required")
+	}
+
+	switch c.LocalKeyringBackend {
+	case KeyringBackendUndefined, KeyringBackendTest, KeyringBackendFile:
+		return nil
+	default:
+		return fmt.Errorf("invalid local keyring backend %s", c.LocalKeyringBackend)
+	}
+}
+
+// Validate validates the database config
+func (c DatabaseConfig) Validate() error {
+	switch c.Driver {
+	case "", DatabaseDriverSqlite:
+		return nil
+	case DatabaseDriverPostgres:
+		if c.PostgresDSN == "" {
+			return errors.New("postgres DSN is required")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported database driver %q", c.Driver)
+	}
+}
+
 func (c EVMConfig) Empty() bool {
-	return c.Endpoint == ""
+	return len(c.AllEndpoints()) == 0
+}
+
+// AllEndpoints returns the endpoints of the chain, the primary endpoint first
+func (c EVMConfig) AllEndpoints() []string {
+	return mergeEndpoints(c.Endpoint, c.Endpoints)
 }
 
 func (c BTCConfig) Empty() bool {
-	return c.RPCHost == ""
+	return len(c.AllRPCHosts()) == 0
+}
+
+// AllRPCHosts returns the RPC hosts of the chain, the primary host first
+func (c BTCConfig) AllRPCHosts() []string {
+	return mergeEndpoints(c.RPCHost, c.RPCHosts)
+}
+
+func (c SolanaConfig) Empty() bool {
+	return len(c.AllEndpoints()) == 0
+}
+
+// AllEndpoints returns the endpoints of the chain, the primary endpoint first
+func (c SolanaConfig) AllEndpoints() []string {
+	return mergeEndpoints(c.Endpoint, c.Endpoints)
+}
+
+func (c SuiConfig) Empty() bool {
+	return len(c.AllEndpoints()) == 0
+}
+
+// AllEndpoints returns the endpoints of the chain, the primary endpoint first
+func (c SuiConfig) AllEndpoints() []string {
+	return mergeEndpoints(c.Endpoint, c.Endpoints)
+}
+
+func (c TONConfig) Empty() bool {
+	return len(c.AllLiteClientConfigURLs()) == 0
+}
+
+// AllLiteClientConfigURLs returns the lite client configs of the chain, the primary config first
+func (c TONConfig) AllLiteClientConfigURLs() []string {
+	return mergeEndpoints(c.LiteClientConfigURL, c.LiteClientConfigURLs)
+}
+
+// mergeEndpoints returns the primary endpoint followed by the other endpoints, without empty and duplicate entries
+func mergeEndpoints(primary string, others []string) []string {
+	endpoints := make([]string, 0, len(others)+1)
+	seen := make(map[string]bool, len(others)+1)
+	for _, endpoint := range append([]string{primary}, others...) {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" || seen[endpoint] {
+			continue
+		}
+		seen[endpoint] = true
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
 }
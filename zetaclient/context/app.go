@@ -91,6 +91,12 @@ func (a *AppContext) IsInboundObservationEnabled() bool {
 	return a.GetCrossChainFlags().IsInboundEnabled
 }
 
+// IsChainHalted returns true if the inbounds and outbounds of the chain are halted by the observers
+func (a *AppContext) IsChainHalted(chainID int64) bool {
+	flags := a.GetCrossChainFlags()
+	return flags.IsChainHalted(chainID)
+}
+
 // GetCrossChainFlags returns crosschain flags
 func (a *AppContext) GetCrossChainFlags() observertypes.CrosschainFlags {
 	a.mu.RLock()
@@ -24,6 +24,7 @@ func TestAppContext(t *testing.T) {
 			IsInboundEnabled:      true,
 			IsOutboundEnabled:     true,
 			GasPriceIncreaseFlags: nil,
+			HaltedChains:          []int64{123},
 		}
 
 		opFlags = types.OperationalFlags{
@@ -68,6 +69,7 @@ func TestAppContext(t *testing.T) {
 		require.Empty(t, appContext.GetCrossChainFlags())
 		require.False(t, appContext.IsInboundObservationEnabled())
 		require.False(t, appContext.IsOutboundObservationEnabled())
+		require.False(t, appContext.IsChainHalted(123))
 
 		// Given some data that is supposed to come from ZetaCore RPC
 		newChains := []chains.Chain{
@@ -98,6 +100,8 @@ func TestAppContext(t *testing.T) {
 		assert.Equal(t, ccFlags, appContext.GetCrossChainFlags())
 		assert.True(t, appContext.IsInboundObservationEnabled())
 		assert.True(t, appContext.IsOutboundObservationEnabled())
+		assert.True(t, appContext.IsChainHalted(fancyL2.ChainId))
+		assert.False(t, appContext.IsChainHalted(chains.Ethereum.ChainId))
 
 		// Check ETH Chain
 		ethChain, err := appContext.GetChain(1)
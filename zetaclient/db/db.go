@@ -10,8 +10,6 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
-
-	"github.com/zeta-chain/node/zetaclient/types"
 )
 
 // SqliteInMemory is a special string to use in-memory database.
@@ -22,19 +20,9 @@ const SqliteInMemory = ":memory:"
 // read/write for group
 const dirCreationMode = 0o750
 
-var (
-	defaultGormConfig = &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Silent),
-	}
-	migrationEntities = []any{
-		&types.LastBlockSQLType{},
-		&types.TransactionSQLType{},
-		&types.ReceiptSQLType{},
-		&types.TransactionResultSQLType{},
-		&types.OutboundHashSQLType{},
-		&types.LastTransactionSQLType{},
-	}
-)
+var defaultGormConfig = &gorm.Config{
+	Logger: logger.Default.LogMode(logger.Silent),
+}
 
 // DB database.
 type DB struct {
@@ -58,14 +46,18 @@ func NewFromSqliteInMemory(migrate bool) (*DB, error) {
 
 // New creates a new instance of DB.
 func New(dial gorm.Dialector, migrate bool) (*DB, error) {
+	return newDB(dial, defaultGormConfig, migrate)
+}
+
+func newDB(dial gorm.Dialector, gormConfig *gorm.Config, migrate bool) (*DB, error) {
 	// open db
-	db, err := gorm.Open(dial, defaultGormConfig)
+	db, err := gorm.Open(dial, gormConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to open gorm database")
 	}
 
 	if migrate {
-		if err := db.AutoMigrate(migrationEntities...); err != nil {
+		if err := runMigrations(db); err != nil {
 			return nil, errors.Wrap(err, "unable to migrate database")
 		}
 	}
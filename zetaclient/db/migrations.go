@@ -0,0 +1,131 @@
+package db
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/zeta-chain/node/zetaclient/types"
+)
+
+// SchemaMigrationSQLType is a model for storing the applied schema migrations
+type SchemaMigrationSQLType struct {
+	Version   uint `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// TableName overrides the default table name, keeping the table prefix (e.g. Postgres schema) of the namer
+func (SchemaMigrationSQLType) TableName(namer schema.Namer) string {
+	return namer.JoinTableName("schema_migrations")
+}
+
+// migration is a versioned schema migration.
+// Migrations are applied in order and exactly once, each in its own transaction.
+// Applied migrations must never be modified, schema changes are added as new migrations.
+type migration struct {
+	version uint
+	name    string
+	migrate func(tx *gorm.DB) error
+}
+
+// migrations is the ordered list of schema migrations
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "initial schema",
+		// databases created before versioned migrations already have (some of) these tables,
+		// possibly without the columns added since, so they're bootstrapped once with AutoMigrate
+		migrate: autoMigrateTables(
+			&types.LastBlockSQLType{},
+			&types.TransactionSQLType{},
+			&types.ReceiptSQLType{},
+			&types.TransactionResultSQLType{},
+			&types.OutboundHashSQLType{},
+			&types.LastTransactionSQLType{},
+		),
+	},
+	{
+		version: 2,
+		name:    "vote history",
+		migrate: createTables(&types.VoteSQLType{}),
+	},
+}
+
+// SchemaVersion returns the latest schema version applied to the database
+func (db *DB) SchemaVersion() (uint, error) {
+	return schemaVersion(db.db)
+}
+
+// runMigrations applies the pending migrations to the database
+func runMigrations(db *gorm.DB) error {
+	if err := createTables(&SchemaMigrationSQLType{})(db); err != nil {
+		return errors.Wrap(err, "unable to create schema migrations table")
+	}
+
+	current, err := schemaVersion(db)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := m.migrate(tx); err != nil {
+				return err
+			}
+
+			return tx.Create(&SchemaMigrationSQLType{
+				Version:   m.version,
+				Name:      m.name,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to apply migration %d (%s)", m.version, m.name)
+		}
+	}
+
+	return nil
+}
+
+func schemaVersion(db *gorm.DB) (uint, error) {
+	var version uint
+	err := db.Model(&SchemaMigrationSQLType{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get schema version")
+	}
+
+	return version, nil
+}
+
+// createTables returns a migration step creating the tables of the models that don't exist yet
+func createTables(models ...any) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, model := range models {
+			if tx.Migrator().HasTable(model) {
+				continue
+			}
+			if err := tx.Migrator().CreateTable(model); err != nil {
+				return errors.Wrapf(err, "unable to create table for %T", model)
+			}
+		}
+		return nil
+	}
+}
+
+// autoMigrateTables returns a migration step creating the missing tables and columns of the models.
+// AutoMigrate never drops tables nor columns, so the data of the existing tables is kept.
+func autoMigrateTables(models ...any) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(models...); err != nil {
+			return errors.Wrap(err, "unable to auto migrate tables")
+		}
+		return nil
+	}
+}
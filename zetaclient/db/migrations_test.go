@@ -0,0 +1,149 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/zeta-chain/node/zetaclient/types"
+)
+
+func TestMigrations(t *testing.T) {
+	latestVersion := migrations[len(migrations)-1].version
+
+	t.Run("migrations are ordered by version", func(t *testing.T) {
+		for i, m := range migrations {
+			require.EqualValues(t, i+1, m.version, "migration %s", m.name)
+		}
+	})
+
+	t.Run("new database is migrated to latest version", func(t *testing.T) {
+		// ARRANGE
+		directory := t.TempDir()
+
+		// ACT
+		db, err := NewFromSqlite(directory, "test.db", true)
+		require.NoError(t, err)
+		defer db.Close()
+
+		// ASSERT
+		version, err := db.SchemaVersion()
+		require.NoError(t, err)
+		require.Equal(t, latestVersion, version)
+		require.True(t, db.HasVoteHistory())
+	})
+
+	t.Run("migrations are applied once", func(t *testing.T) {
+		// ARRANGE
+		directory := t.TempDir()
+		db, err := NewFromSqlite(directory, "test.db", true)
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+
+		// ACT
+		// reopen the database
+		db, err = NewFromSqlite(directory, "test.db", true)
+		require.NoError(t, err)
+		defer db.Close()
+
+		// ASSERT
+		var applied []SchemaMigrationSQLType
+		require.NoError(t, db.Client().Find(&applied).Error)
+		require.Len(t, applied, len(migrations))
+	})
+
+	t.Run("legacy database is adopted without losing data", func(t *testing.T) {
+		// ARRANGE
+		// Given a database created by AutoMigrate before versioned migrations
+		directory := t.TempDir()
+		legacy, err := gorm.Open(sqlite.Open(directory+"/legacy.db"), defaultGormConfig)
+		require.NoError(t, err)
+		require.NoError(t, legacy.AutoMigrate(&types.LastBlockSQLType{}, &types.LastTransactionSQLType{}))
+		require.NoError(t, legacy.Save(types.ToLastBlockSQLType(123)).Error)
+
+		sqlDB, err := legacy.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+
+		// ACT
+		db, err := NewFromSqlite(directory, "legacy.db", true)
+		require.NoError(t, err)
+		defer db.Close()
+
+		// ASSERT
+		version, err := db.SchemaVersion()
+		require.NoError(t, err)
+		require.Equal(t, latestVersion, version)
+
+		var lastBlock types.LastBlockSQLType
+		require.NoError(t, db.Client().First(&lastBlock, types.LastBlockNumID).Error)
+		require.EqualValues(t, 123, lastBlock.Num)
+	})
+
+	t.Run("legacy database is bootstrapped with the missing columns", func(t *testing.T) {
+		// ARRANGE
+		// Given a legacy table created before a column was added to the model
+		type legacyLastBlockSQLType struct {
+			gorm.Model
+		}
+
+		directory := t.TempDir()
+		legacy, err := gorm.Open(sqlite.Open(directory+"/legacy.db"), defaultGormConfig)
+		require.NoError(t, err)
+		require.NoError(t, legacy.Table("last_block_sql_types").AutoMigrate(&legacyLastBlockSQLType{}))
+		require.False(t, legacy.Migrator().HasColumn(&types.LastBlockSQLType{}, "Num"))
+
+		sqlDB, err := legacy.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+
+		// ACT
+		db, err := NewFromSqlite(directory, "legacy.db", true)
+		require.NoError(t, err)
+		defer db.Close()
+
+		// ASSERT
+		require.True(t, db.Client().Migrator().HasColumn(&types.LastBlockSQLType{}, "Num"))
+		require.NoError(t, db.Client().Save(types.ToLastBlockSQLType(123)).Error)
+	})
+
+	t.Run("failed migration is rolled back", func(t *testing.T) {
+		// ARRANGE
+		// Given a failing migration creating a table before failing
+		type brokenSQLType struct {
+			gorm.Model
+		}
+
+		original := migrations
+		t.Cleanup(func() { migrations = original })
+		migrations = append(append([]migration{}, original...), migration{
+			version: latestVersion + 1,
+			name:    "broken",
+			migrate: func(tx *gorm.DB) error {
+				if err := createTables(&brokenSQLType{})(tx); err != nil {
+					return err
+				}
+				return errors.New("broken migration")
+			},
+		})
+
+		// ACT
+		directory := t.TempDir()
+		_, err := NewFromSqlite(directory, "test.db", true)
+
+		// ASSERT
+		require.ErrorContains(t, err, "broken migration")
+
+		db, err := NewFromSqlite(directory, "test.db", false)
+		require.NoError(t, err)
+		defer db.Close()
+
+		version, err := db.SchemaVersion()
+		require.NoError(t, err)
+		require.Equal(t, latestVersion, version)
+		require.False(t, db.Client().Migrator().HasTable(&brokenSQLType{}))
+	})
+}
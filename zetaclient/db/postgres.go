@@ -0,0 +1,101 @@
+package db
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm/schema"
+)
+
+// PostgresConfig is the config of a Postgres database.
+type PostgresConfig struct {
+	// DSN is the data source name, either a URL or a keyword/value connection string
+	DSN string
+
+	// Schema is the Postgres schema holding the tables, it's created on migration if it doesn't exist
+	Schema string
+
+	// MaxOpenConns is the maximum number of open connections (0 means unlimited)
+	MaxOpenConns int
+
+	// MaxIdleConns is the maximum number of idle connections
+	MaxIdleConns int
+
+	// ConnMaxLifetime is the maximum lifetime of a connection (0 means unlimited)
+	ConnMaxLifetime time.Duration
+}
+
+// invalidSchemaChars matches the characters not allowed in a schema name
+var invalidSchemaChars = regexp.MustCompile(`[^a-z0-9_]`)
+
+// SchemaName converts a database name (e.g. chain name) into a valid Postgres schema name.
+func SchemaName(dbName string) string {
+	name := invalidSchemaChars.ReplaceAllString(strings.ToLower(dbName), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// NewFromPostgres creates a new instance of DB based on Postgres database.
+// The tables are stored in the schema of the config, so multiple chain observers can share a database.
+func NewFromPostgres(cfg PostgresConfig, migrate bool) (*DB, error) {
+	if cfg.DSN == "" {
+		return nil, errors.New("postgres DSN is empty")
+	}
+
+	schemaName := SchemaName(cfg.Schema)
+	gormConfig := *defaultGormConfig
+	gormConfig.NamingStrategy = schema.NamingStrategy{TablePrefix: schemaName + "."}
+
+	db, err := newDB(postgres.Open(cfg.DSN), &gormConfig, false)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.db.DB()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get underlying sql.DB")
+	}
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	if migrate {
+		// schema name is sanitized, so it's safe to use it in the statement
+		if err := db.db.Exec(fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS "%s"`, schemaName)).Error; err != nil {
+			return nil, errors.Wrapf(err, "unable to create schema %s", schemaName)
+		}
+
+		if err := runMigrations(db.db); err != nil {
+			return nil, errors.Wrap(err, "unable to migrate database")
+		}
+	}
+
+	return db, nil
+}
+
+// ListPostgresSchemas returns the schemas of the Postgres database containing the vote history.
+func ListPostgresSchemas(dsn string) ([]string, error) {
+	db, err := New(postgres.Open(dsn), false)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var schemas []string
+	err = db.db.Raw(
+		"SELECT table_schema FROM information_schema.tables WHERE table_name = ? ORDER BY table_schema",
+		db.db.NamingStrategy.TableName("VoteSQLType"),
+	).Scan(&schemas).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list schemas")
+	}
+
+	return schemas, nil
+}
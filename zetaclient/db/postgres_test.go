@@ -0,0 +1,119 @@
+package db
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/types"
+)
+
+// envPostgresDSN is the environment variable of the Postgres database used by tests,
+// e.g. a local stand-in started with `docker run -e POSTGRES_PASSWORD=postgres -p 5432:5432 postgres`.
+// If it's not set, the tests run against an embedded Postgres server.
+const envPostgresDSN = "ZETACLIENT_TEST_POSTGRES_DSN"
+
+// testPostgresDSN returns the DSN of the Postgres database used by tests.
+// It starts an embedded Postgres server (stopped when the test ends) unless envPostgresDSN is set.
+func testPostgresDSN(t *testing.T) string {
+	if dsn := os.Getenv(envPostgresDSN); dsn != "" {
+		return dsn
+	}
+
+	// initdb refuses to run as root
+	if os.Geteuid() == 0 {
+		t.Skipf("embedded Postgres can't run as root, set %s to run the tests", envPostgresDSN)
+	}
+
+	// pick a free port
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	dir := t.TempDir()
+	cfg := embeddedpostgres.DefaultConfig().
+		Port(uint32(port)).
+		RuntimePath(filepath.Join(dir, "runtime")).
+		StartTimeout(time.Minute).
+		Logger(io.Discard)
+
+	server := embeddedpostgres.NewDatabase(cfg)
+	require.NoError(t, server.Start())
+	t.Cleanup(func() { require.NoError(t, server.Stop()) })
+
+	return cfg.GetConnectionURL() + "?sslmode=disable"
+}
+
+func TestSchemaName(t *testing.T) {
+	require.Equal(t, "btc_mainnet", SchemaName("btc_mainnet"))
+	require.Equal(t, "eth_mainnet", SchemaName("ETH-Mainnet"))
+	require.Equal(t, "_8332", SchemaName("8332"))
+	require.Equal(t, "_", SchemaName(""))
+	require.Equal(t, "a__b", SchemaName(`a";b`))
+}
+
+func TestNewFromPostgres(t *testing.T) {
+	t.Run("empty DSN", func(t *testing.T) {
+		_, err := NewFromPostgres(PostgresConfig{}, true)
+		require.ErrorContains(t, err, "DSN is empty")
+	})
+
+	dsn := testPostgresDSN(t)
+
+	newTestDB := func(t *testing.T, schema string) *DB {
+		db, err := NewFromPostgres(PostgresConfig{
+			DSN:             dsn,
+			Schema:          schema,
+			MaxOpenConns:    4,
+			MaxIdleConns:    2,
+			ConnMaxLifetime: time.Minute,
+		}, true)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			db.Client().Exec(`DROP SCHEMA IF EXISTS "` + SchemaName(schema) + `" CASCADE`)
+			require.NoError(t, db.Close())
+		})
+
+		return db
+	}
+
+	t.Run("chain databases are isolated by schema", func(t *testing.T) {
+		// ARRANGE
+		btc := newTestDB(t, "test_btc_mainnet")
+		eth := newTestDB(t, "test_eth_mainnet")
+
+		// ACT
+		require.NoError(t, btc.Client().Save(types.ToLastBlockSQLType(100)).Error)
+		require.NoError(t, eth.Client().Save(types.ToLastBlockSQLType(200)).Error)
+		require.NoError(t, eth.SaveVote(&types.VoteSQLType{Type: types.VoteTypeInbound, ChainID: 1}))
+
+		// ASSERT
+		var lastBlock types.LastBlockSQLType
+		require.NoError(t, btc.Client().First(&lastBlock, types.LastBlockNumID).Error)
+		require.EqualValues(t, 100, lastBlock.Num)
+
+		btcVotes, err := btc.QueryVotes(VoteFilter{})
+		require.NoError(t, err)
+		require.Empty(t, btcVotes)
+
+		schemas, err := ListPostgresSchemas(dsn)
+		require.NoError(t, err)
+		require.Contains(t, schemas, "test_btc_mainnet")
+		require.Contains(t, schemas, "test_eth_mainnet")
+	})
+
+	t.Run("schema is migrated to latest version", func(t *testing.T) {
+		db := newTestDB(t, "test_migrations")
+
+		version, err := db.SchemaVersion()
+		require.NoError(t, err)
+		require.Equal(t, migrations[len(migrations)-1].version, version)
+	})
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/types"
+)
+
+// VoteFilter filters the vote history, zero fields are ignored
+type VoteFilter struct {
+	ChainID     int64
+	Nonce       *uint64
+	BallotIndex string
+	TxHash      string
+
+	// Limit is the maximum number of votes to return
+	Limit int
+}
+
+// SaveVote saves a vote posted to zetacore into the vote history.
+func (db *DB) SaveVote(vote *types.VoteSQLType) error {
+	if err := db.db.Create(vote).Error; err != nil {
+		return errors.Wrap(err, "unable to save vote")
+	}
+
+	return nil
+}
+
+// QueryVotes returns the votes matching the filter, most recent first.
+func (db *DB) QueryVotes(filter VoteFilter) ([]types.VoteSQLType, error) {
+	query := db.db.Model(&types.VoteSQLType{}).Order("created_at desc, id desc")
+
+	if filter.ChainID != 0 {
+		query = query.Where("chain_id = ?", filter.ChainID)
+	}
+	if filter.Nonce != nil {
+		query = query.Where("type = ? AND nonce = ?", types.VoteTypeOutbound, *filter.Nonce)
+	}
+	if filter.BallotIndex != "" {
+		query = query.Where("ballot_index = ?", filter.BallotIndex)
+	}
+	if filter.TxHash != "" {
+		query = query.Where("tx_hash = ?", filter.TxHash)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var votes []types.VoteSQLType
+	if err := query.Find(&votes).Error; err != nil {
+		return nil, errors.Wrap(err, "unable to query votes")
+	}
+
+	return votes, nil
+}
+
+// PruneVotes permanently deletes the votes created before the given time.
+// It returns the number of deleted votes.
+func (db *DB) PruneVotes(before time.Time) (int64, error) {
+	res := db.db.Unscoped().Where("created_at < ?", before).Delete(&types.VoteSQLType{})
+	if res.Error != nil {
+		return 0, errors.Wrap(res.Error, "unable to prune votes")
+	}
+
+	return res.RowsAffected, nil
+}
+
+// HasVoteHistory returns true if the database contains the vote history table.
+func (db *DB) HasVoteHistory() bool {
+	return db.db.Migrator().HasTable(&types.VoteSQLType{})
+}
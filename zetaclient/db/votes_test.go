@@ -0,0 +1,107 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/types"
+)
+
+func TestVotes(t *testing.T) {
+	// ARRANGE
+	// Given a database with inbound and outbound votes
+	newVoteDB := func(t *testing.T) *DB {
+		db, err := NewFromSqliteInMemory(true)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+		votes := []*types.VoteSQLType{
+			{Type: types.VoteTypeInbound, ChainID: 8332, BallotIndex: "ballot-1", TxHash: "tx-1"},
+			{Type: types.VoteTypeOutbound, ChainID: 8332, Nonce: 1234, BallotIndex: "ballot-2", TxHash: "tx-2"},
+			{Type: types.VoteTypeOutbound, ChainID: 8332, Nonce: 1235, BallotIndex: "ballot-3", TxHash: "tx-3"},
+			{Type: types.VoteTypeOutbound, ChainID: 1, Nonce: 1234, BallotIndex: "ballot-4", TxHash: "tx-4"},
+		}
+		for _, vote := range votes {
+			require.NoError(t, db.SaveVote(vote))
+		}
+
+		return db
+	}
+
+	t.Run("query all votes most recent first", func(t *testing.T) {
+		db := newVoteDB(t)
+
+		// ACT
+		votes, err := db.QueryVotes(VoteFilter{})
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Len(t, votes, 4)
+		require.Equal(t, "ballot-4", votes[0].BallotIndex)
+		require.Equal(t, "ballot-1", votes[3].BallotIndex)
+	})
+
+	t.Run("query votes by chain and nonce", func(t *testing.T) {
+		db := newVoteDB(t)
+		nonce := uint64(1234)
+
+		// ACT
+		votes, err := db.QueryVotes(VoteFilter{ChainID: 8332, Nonce: &nonce})
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Len(t, votes, 1)
+		require.Equal(t, "ballot-2", votes[0].BallotIndex)
+	})
+
+	t.Run("query votes by ballot, tx hash and limit", func(t *testing.T) {
+		db := newVoteDB(t)
+
+		// ACT
+		byBallot, err := db.QueryVotes(VoteFilter{BallotIndex: "ballot-3"})
+		require.NoError(t, err)
+		byTxHash, err := db.QueryVotes(VoteFilter{TxHash: "tx-1"})
+		require.NoError(t, err)
+		limited, err := db.QueryVotes(VoteFilter{ChainID: 8332, Limit: 2})
+		require.NoError(t, err)
+
+		// ASSERT
+		require.Len(t, byBallot, 1)
+		require.Equal(t, "tx-3", byBallot[0].TxHash)
+		require.Len(t, byTxHash, 1)
+		require.Equal(t, types.VoteTypeInbound, byTxHash[0].Type)
+		require.Len(t, limited, 2)
+	})
+
+	t.Run("prune votes", func(t *testing.T) {
+		db := newVoteDB(t)
+
+		// ACT
+		// prune nothing
+		pruned, err := db.PruneVotes(time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, pruned)
+
+		// prune everything
+		pruned, err = db.PruneVotes(time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		// ASSERT
+		require.EqualValues(t, 4, pruned)
+		votes, err := db.QueryVotes(VoteFilter{})
+		require.NoError(t, err)
+		require.Empty(t, votes)
+	})
+
+	t.Run("has vote history", func(t *testing.T) {
+		migrated, err := NewFromSqliteInMemory(true)
+		require.NoError(t, err)
+		notMigrated, err := NewFromSqliteInMemory(false)
+		require.NoError(t, err)
+
+		require.True(t, migrated.HasVoteHistory())
+		require.False(t, notMigrated.HasVoteHistory())
+	})
+}
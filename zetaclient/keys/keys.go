@@ -2,6 +2,7 @@ package keys
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -60,7 +61,9 @@ func GetGranteeKeyName(signerName string) string {
 	return signerName
 }
 
-// GetKeyringKeybase return keyring and key info
+// GetKeyringKeybase return keyring and key info.
+// With the remote keyring backend, the hotkey is held by the remote signer and the returned
+// pubkey is the one of the TSS p2p key of the local keyring.
 func GetKeyringKeybase(cfg config.Config, hotkeyPassword string) (ckeys.Keyring, string, error) {
 	granteeName := cfg.AuthzHotkey
 	chainHomeFolder := cfg.ZetaCoreHome
@@ -69,16 +72,22 @@ func GetKeyringKeybase(cfg config.Config, hotkeyPassword string) (ckeys.Keyring,
 		return nil, "", fmt.Errorf("signer name is empty")
 	}
 
+	// the keys of the remote keyring backend other than the hotkey are in the local keyring
+	keyringBackend := cfg.KeyringBackend
+	if keyringBackend == config.KeyringBackendRemote {
+		keyringBackend = cfg.RemoteSigner.LocalKeyringBackend
+	}
+
 	// read password from env if using keyring backend file
 	buf := bytes.NewBufferString("")
-	if cfg.KeyringBackend == config.KeyringBackendFile {
+	if keyringBackend == config.KeyringBackendFile {
 		buf.WriteString(hotkeyPassword)
 		buf.WriteByte('\n') // the library used by keyring is using ReadLine , which expect a new line
 		buf.WriteString(hotkeyPassword)
 		buf.WriteByte('\n')
 	}
 
-	kb, err := getKeybase(chainHomeFolder, buf, cfg.KeyringBackend)
+	kb, err := getKeybase(chainHomeFolder, buf, keyringBackend)
 	if err != nil {
 		return nil, "", fmt.Errorf("fail to get keybase,err:%w", err)
 	}
@@ -101,6 +110,24 @@ func GetKeyringKeybase(cfg config.Config, hotkeyPassword string) (ckeys.Keyring,
 		return nil, "", fmt.Errorf("fail to get pubkey from record,err:%w", err)
 	}
 
+	if cfg.KeyringBackend == config.KeyringBackendRemote {
+		signer, err := NewRemoteSigner(cfg.RemoteSigner)
+		if err != nil {
+			return nil, "", err
+		}
+
+		remote, err := newRemoteKeyring(context.Background(), kb, granteeName, signer)
+		if err != nil {
+			return nil, "", fmt.Errorf("fail to get hotkey from remote signer,err:%w", err)
+		}
+
+		logger.Info().
+			Str("remote_signer.key_id", signer.KeyID()).
+			Msg("Using the hotkey of the remote signer")
+
+		return remote, pubkeyBech32, nil
+	}
+
 	return kb, pubkeyBech32, nil
 }
 
@@ -0,0 +1,108 @@
+package keys
+
+import (
+	"context"
+
+	ckeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/pkg/errors"
+)
+
+// BackendRemote is the backend of the remote keyring
+const BackendRemote = "remote"
+
+var _ ckeys.Keyring = &remoteKeyring{}
+
+// remoteKeyring is a keyring holding the hotkey in a remote signer.
+// The hotkey is available under its name as an offline key, signing requests are sent to the remote signer.
+// Any other operation, such as exporting the TSS p2p key, is served by the local keyring.
+type remoteKeyring struct {
+	ckeys.Keyring
+
+	signer *RemoteSigner
+	record *ckeys.Record
+	pubKey cryptotypes.PubKey
+	addr   sdk.AccAddress
+}
+
+// newRemoteKeyring creates a keyring with the hotkey of the remote signer under the given name
+func newRemoteKeyring(
+	ctx context.Context,
+	local ckeys.Keyring,
+	name string,
+	signer *RemoteSigner,
+) (*remoteKeyring, error) {
+	pubKey, err := signer.PubKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := ckeys.NewOfflineRecord(name, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create hotkey record")
+	}
+
+	return &remoteKeyring{
+		Keyring: local,
+		signer:  signer,
+		record:  record,
+		pubKey:  pubKey,
+		addr:    sdk.AccAddress(pubKey.Address()),
+	}, nil
+}
+
+// Backend returns the backend of the remote keyring
+func (kr *remoteKeyring) Backend() string {
+	return BackendRemote
+}
+
+// List returns the hotkey
+func (kr *remoteKeyring) List() ([]*ckeys.Record, error) {
+	return []*ckeys.Record{kr.record}, nil
+}
+
+// Key returns the hotkey if uid is its name
+func (kr *remoteKeyring) Key(uid string) (*ckeys.Record, error) {
+	if uid != kr.record.Name {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, uid)
+	}
+	return kr.record, nil
+}
+
+// KeyByAddress returns the hotkey if address is its address
+func (kr *remoteKeyring) KeyByAddress(address sdk.Address) (*ckeys.Record, error) {
+	if !kr.addr.Equals(address) {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, address.String())
+	}
+	return kr.record, nil
+}
+
+// Sign signs msg with the hotkey of the remote signer
+func (kr *remoteKeyring) Sign(uid string, msg []byte, _ signing.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	if _, err := kr.Key(uid); err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := kr.signer.Sign(context.Background(), msg, kr.pubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, kr.pubKey, nil
+}
+
+// SignByAddress signs msg with the hotkey of the remote signer
+func (kr *remoteKeyring) SignByAddress(
+	address sdk.Address,
+	msg []byte,
+	signMode signing.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	record, err := kr.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kr.Sign(record.Name, msg, signMode)
+}
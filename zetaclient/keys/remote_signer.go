@@ -0,0 +1,180 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+const (
+	// RemoteSignerPubKeyPath is the path of the remote signer public key requests
+	RemoteSignerPubKeyPath = "/pubkey"
+
+	// RemoteSignerSignPath is the path of the remote signer signing requests
+	RemoteSignerSignPath = "/sign"
+
+	// defaultRemoteSignerTimeout is the timeout of a remote signer request if not configured
+	defaultRemoteSignerTimeout = 10 * time.Second
+)
+
+// RemoteSignerError is an error returned by the remote signer
+type RemoteSignerError struct {
+	Code        int    `json:"code"`
+	Description string `json:"description"`
+}
+
+func (e *RemoteSignerError) Error() string {
+	return fmt.Sprintf("remote signer error %d: %s", e.Code, e.Description)
+}
+
+// PubKeyRequest requests the public key of a key of the remote signer
+type PubKeyRequest struct {
+	KeyID string `json:"key_id"`
+}
+
+// PubKeyResponse is the compressed secp256k1 public key of the requested key
+type PubKeyResponse struct {
+	PubKey []byte             `json:"pub_key"`
+	Error  *RemoteSignerError `json:"error,omitempty"`
+}
+
+// SignRequest requests the signature of the sign bytes with a key of the remote signer
+type SignRequest struct {
+	KeyID     string `json:"key_id"`
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+// SignResponse is the secp256k1 signature of the sign bytes
+type SignResponse struct {
+	Signature []byte             `json:"signature"`
+	Error     *RemoteSignerError `json:"error,omitempty"`
+}
+
+// RemoteSigner is the client of a remote signer holding the hotkey, e.g. a signing service fronting an HSM.
+//
+// The protocol is a JSON API specific to zetaclient (bytes are base64 encoded), exposed with the /pubkey and /sign
+// POST endpoints: each request names the key, the signer returns either the public key or the signature
+// of the sign bytes, or an error with a code.
+// As for any Cosmos secp256k1 key, the signer signs the SHA-256 digest of the sign bytes
+// and returns the 64 bytes r || s signature with a low S.
+type RemoteSigner struct {
+	endpoint  string
+	keyID     string
+	authToken string
+	client    *http.Client
+}
+
+// NewRemoteSigner creates a new remote signer client
+func NewRemoteSigner(cfg config.RemoteSignerConfig) (*RemoteSigner, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer config")
+	}
+
+	timeout := defaultRemoteSignerTimeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+
+	return &RemoteSigner{
+		endpoint:  strings.TrimSuffix(cfg.Endpoint, "/"),
+		keyID:     cfg.KeyID,
+		authToken: cfg.AuthToken,
+		client:    &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// KeyID returns the id of the key in the remote signer
+func (s *RemoteSigner) KeyID() string {
+	return s.keyID
+}
+
+// PubKey returns the public key of the remote key
+func (s *RemoteSigner) PubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	var res PubKeyResponse
+	if err := s.call(ctx, RemoteSignerPubKeyPath, PubKeyRequest{KeyID: s.keyID}, &res); err != nil {
+		return nil, errors.Wrap(err, "unable to get public key")
+	}
+
+	switch {
+	case res.Error != nil:
+		return nil, errors.Wrap(res.Error, "unable to get public key")
+	case len(res.PubKey) != secp256k1.PubKeySize:
+		return nil, fmt.Errorf("invalid public key size %d", len(res.PubKey))
+	}
+
+	return &secp256k1.PubKey{Key: res.PubKey}, nil
+}
+
+// Sign signs the sign bytes with the remote key, the signature is verified against the public key
+func (s *RemoteSigner) Sign(ctx context.Context, signBytes []byte, pubKey cryptotypes.PubKey) ([]byte, error) {
+	var res SignResponse
+	if err := s.call(ctx, RemoteSignerSignPath, SignRequest{KeyID: s.keyID, SignBytes: signBytes}, &res); err != nil {
+		return nil, errors.Wrap(err, "unable to sign")
+	}
+
+	switch {
+	case res.Error != nil:
+		return nil, errors.Wrap(res.Error, "unable to sign")
+	case !pubKey.VerifySignature(signBytes, res.Signature):
+		// a signature rejected by zetacore would only be noticed after the broadcast
+		return nil, errors.New("invalid signature returned by the remote signer")
+	}
+
+	return res.Signature, nil
+}
+
+func (s *RemoteSigner) call(ctx context.Context, path string, req, res any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal request")
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "unable to create request")
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	if s.authToken != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+s.authToken)
+	}
+
+	httpRes, err := s.client.Do(httpReq)
+	if err != nil {
+		return errors.Wrap(err, "request failed")
+	}
+	defer httpRes.Body.Close()
+
+	resBody, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		return errors.Wrap(err, "unable to read response")
+	}
+
+	if httpRes.StatusCode != http.StatusOK {
+		// the signer may describe the error in the response
+		var errRes struct {
+			Error *RemoteSignerError `json:"error"`
+		}
+		if json.Unmarshal(resBody, &errRes) == nil && errRes.Error != nil {
+			return errRes.Error
+		}
+		return fmt.Errorf("unexpected status %d", httpRes.StatusCode)
+	}
+
+	if err = json.Unmarshal(resBody, res); err != nil {
+		return errors.Wrap(err, "unable to unmarshal response")
+	}
+
+	return nil
+}
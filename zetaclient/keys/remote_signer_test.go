@@ -0,0 +1,225 @@
+package keys
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	zetacrypto "github.com/zeta-chain/node/pkg/crypto"
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+// stubSigner is an in-process remote signer
+type stubSigner struct {
+	*httptest.Server
+	keys      map[string]*secp256k1.PrivKey
+	authToken string
+	signed    atomic.Int64
+
+	// badSignature makes the signer return signatures of other bytes
+	badSignature bool
+}
+
+func newStubSigner(t *testing.T, keyIDs ...string) *stubSigner {
+	s := &stubSigner{
+		keys:      make(map[string]*secp256k1.PrivKey),
+		authToken: "token",
+	}
+	for _, keyID := range keyIDs {
+		s.keys[keyID] = secp256k1.GenPrivKey()
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *stubSigner) config(keyID string) config.RemoteSignerConfig {
+	return config.RemoteSignerConfig{
+		Endpoint:  s.URL,
+		KeyID:     keyID,
+		AuthToken: s.authToken,
+	}
+}
+
+func (s *stubSigner) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+s.authToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(SignResponse{Error: &RemoteSignerError{Code: 1, Description: "unauthorized"}})
+		return
+	}
+
+	var req SignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		_ = json.NewEncoder(w).Encode(SignResponse{Error: &RemoteSignerError{Code: 2, Description: "unknown key"}})
+		return
+	}
+
+	switch r.URL.Path {
+	case RemoteSignerPubKeyPath:
+		_ = json.NewEncoder(w).Encode(PubKeyResponse{PubKey: key.PubKey().Bytes()})
+	case RemoteSignerSignPath:
+		signBytes := req.SignBytes
+		if s.badSignature {
+			signBytes = append(signBytes, 0)
+		}
+		sig, err := key.Sign(signBytes)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.signed.Add(1)
+		_ = json.NewEncoder(w).Encode(SignResponse{Signature: sig})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	signBytes := []byte("sign bytes")
+
+	t.Run("signs with the remote key", func(t *testing.T) {
+		stub := newStubSigner(t, "hotkey")
+
+		signer, err := NewRemoteSigner(stub.config("hotkey"))
+		require.NoError(t, err)
+
+		pubKey, err := signer.PubKey(ctx)
+		require.NoError(t, err)
+		require.True(t, stub.keys["hotkey"].PubKey().Equals(pubKey))
+
+		sig, err := signer.Sign(ctx, signBytes, pubKey)
+		require.NoError(t, err)
+		require.True(t, pubKey.VerifySignature(signBytes, sig))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		stub := newStubSigner(t, "hotkey")
+
+		signer, err := NewRemoteSigner(stub.config("other"))
+		require.NoError(t, err)
+
+		_, err = signer.PubKey(ctx)
+		require.ErrorContains(t, err, "remote signer error 2: unknown key")
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		stub := newStubSigner(t, "hotkey")
+		cfg := stub.config("hotkey")
+		cfg.AuthToken = "wrong"
+
+		signer, err := NewRemoteSigner(cfg)
+		require.NoError(t, err)
+
+		_, err = signer.PubKey(ctx)
+		require.ErrorContains(t, err, "remote signer error 1: unauthorized")
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		stub := newStubSigner(t, "hotkey")
+		stub.badSignature = true
+
+		signer, err := NewRemoteSigner(stub.config("hotkey"))
+		require.NoError(t, err)
+
+		pubKey, err := signer.PubKey(ctx)
+		require.NoError(t, err)
+
+		_, err = signer.Sign(ctx, signBytes, pubKey)
+		require.ErrorContains(t, err, "invalid signature")
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewRemoteSigner(config.RemoteSignerConfig{Endpoint: "http://signer"})
+		require.ErrorContains(t, err, "key id is required")
+	})
+}
+
+func TestGetKeyringKeybase_Remote(t *testing.T) {
+	const hotkeyName = "hotkey"
+
+	// the local keyring holds the TSS p2p key
+	home := filepath.Join(t.TempDir(), ".zetacored")
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	local, err := cKeys.New(sdk.KeyringServiceName(), cKeys.BackendTest, home, nil, codec.NewProtoCodec(registry))
+	require.NoError(t, err)
+	_, _, err = local.NewMnemonic(hotkeyName, cKeys.English, zetaChainHDPath, password, hd.Secp256k1)
+	require.NoError(t, err)
+
+	stub := newStubSigner(t, "hsm-key")
+	remotePubKey := stub.keys["hsm-key"].PubKey()
+
+	cfg := config.Config{
+		AuthzHotkey:    hotkeyName,
+		ZetaCoreHome:   home,
+		KeyringBackend: config.KeyringBackendRemote,
+		RemoteSigner:   stub.config("hsm-key"),
+	}
+
+	kb, granteePubKey, err := GetKeyringKeybase(cfg, "")
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kb.Backend())
+
+	// the grantee pubkey is the one of the local p2p key
+	p2pRecord, err := local.Key(hotkeyName)
+	require.NoError(t, err)
+	p2pPubKey, err := p2pRecord.GetPubKey()
+	require.NoError(t, err)
+	p2pPubKeyBech32, err := zetacrypto.GetPubkeyBech32FromRecord(p2pRecord)
+	require.NoError(t, err)
+	require.Equal(t, p2pPubKeyBech32, granteePubKey)
+
+	k := NewKeysWithKeybase(kb, sdk.AccAddress("granter"), hotkeyName, "")
+
+	// the hotkey address is the one of the remote key
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(remotePubKey.Address()), addr)
+
+	record, err := kb.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, hotkeyName, record.Name)
+
+	_, err = kb.Key("other")
+	require.Error(t, err)
+
+	// votes are signed by the remote signer
+	msg := []byte("vote")
+	sig, pubKey, err := kb.Sign(hotkeyName, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, remotePubKey.Equals(pubKey))
+	require.True(t, remotePubKey.VerifySignature(msg, sig))
+	require.EqualValues(t, 1, stub.signed.Load())
+
+	_, _, err = kb.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, stub.signed.Load())
+
+	// the private key is the local p2p key
+	privKey, err := k.GetPrivateKey("")
+	require.NoError(t, err)
+	require.True(t, p2pPubKey.Equals(privKey.PubKey()))
+}
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// vote types of the CCTX metrics
+const (
+	VoteTypeInbound  = "inbound"
+	VoteTypeOutbound = "outbound"
+)
+
+// outcomes of the ballot of a vote
+const (
+	// VoteOutcomeWon means the ballot was finalized with the vote of the observer
+	VoteOutcomeWon = "won"
+
+	// VoteOutcomeLost means the ballot was finalized against the vote of the observer
+	VoteOutcomeLost = "lost"
+
+	// VoteOutcomeUnfinalized means the ballot was not finalized in time
+	VoteOutcomeUnfinalized = "unfinalized"
+)
+
+// outboundSignedTTL is the duration after which a signed outbound is no longer tracked
+const outboundSignedTTL = 24 * time.Hour
+
+// outboundKey identifies an outbound of a chain
+type outboundKey struct {
+	chain string
+	nonce uint64
+}
+
+// outboundTimer tracks the time outbounds are signed to measure their signed-to-confirmed latency
+type outboundTimer struct {
+	mu       sync.Mutex
+	signedAt map[outboundKey]time.Time
+}
+
+var outbounds = &outboundTimer{signedAt: make(map[outboundKey]time.Time)}
+
+// OutboundSigned records the time the outbound of the given chain and nonce is signed.
+// Only the first signature of an outbound is recorded.
+func OutboundSigned(chain string, nonce uint64) {
+	outbounds.signed(chain, nonce, time.Now())
+}
+
+// OutboundConfirmed observes the signed-to-confirmed latency of the outbound of the given chain and nonce.
+// It's a noop if the outbound was not signed by this observer.
+func OutboundConfirmed(chain string, nonce uint64) {
+	if latency, ok := outbounds.confirmed(chain, nonce, time.Now()); ok {
+		OutboundConfirmationLatency.WithLabelValues(chain).Observe(latency.Seconds())
+	}
+}
+
+func (t *outboundTimer) signed(chain string, nonce uint64, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := outboundKey{chain: chain, nonce: nonce}
+	if _, ok := t.signedAt[key]; ok {
+		return
+	}
+
+	// forget the outbounds that were never confirmed
+	for k, signedAt := range t.signedAt {
+		if now.Sub(signedAt) > outboundSignedTTL {
+			delete(t.signedAt, k)
+		}
+	}
+
+	t.signedAt[key] = now
+}
+
+func (t *outboundTimer) confirmed(chain string, nonce uint64, now time.Time) (time.Duration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := outboundKey{chain: chain, nonce: nonce}
+	signedAt, ok := t.signedAt[key]
+	if !ok {
+		return 0, false
+	}
+	delete(t.signedAt, key)
+
+	return now.Sub(signedAt), true
+}
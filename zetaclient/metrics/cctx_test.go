@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboundTimer(t *testing.T) {
+	now := time.Now()
+
+	t.Run("measures the signed-to-confirmed latency", func(t *testing.T) {
+		timer := &outboundTimer{signedAt: make(map[outboundKey]time.Time)}
+
+		timer.signed("chain", 1, now)
+		// signing again doesn't reset the time
+		timer.signed("chain", 1, now.Add(time.Minute))
+
+		// other chains are tracked separately
+		_, ok := timer.confirmed("other", 1, now)
+		require.False(t, ok)
+
+		latency, ok := timer.confirmed("chain", 1, now.Add(2*time.Minute))
+		require.True(t, ok)
+		require.Equal(t, 2*time.Minute, latency)
+
+		// confirmed outbounds are no longer tracked
+		_, ok = timer.confirmed("chain", 1, now.Add(3*time.Minute))
+		require.False(t, ok)
+	})
+
+	t.Run("forgets outbounds never confirmed", func(t *testing.T) {
+		timer := &outboundTimer{signedAt: make(map[outboundKey]time.Time)}
+
+		timer.signed("chain", 1, now)
+		timer.signed("chain", 2, now.Add(outboundSignedTTL+time.Second))
+
+		_, ok := timer.confirmed("chain", 1, now.Add(outboundSignedTTL+time.Minute))
+		require.False(t, ok)
+		require.Len(t, timer.signedAt, 1)
+	})
+
+	t.Run("observes the latency of the chain", func(t *testing.T) {
+		OutboundSigned("test_chain", 42)
+		OutboundConfirmed("test_chain", 42)
+		OutboundConfirmed("test_chain", 43)
+
+		require.Equal(t, 1, testutil.CollectAndCount(OutboundConfirmationLatency))
+	})
+}
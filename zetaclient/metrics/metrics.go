@@ -199,13 +199,56 @@ var (
 		[]string{"status", "task_group", "task_name"},
 	)
 
+	// InboundVoteLatency is a histogram of the latency between the inbound block time and the inbound vote
+	InboundVoteLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: ZetaClientNamespace,
+			Name:      "inbound_vote_latency_seconds",
+			Help:      "Histogram of the latency between the inbound block time and the inbound vote in seconds",
+			Buckets:   []float64{5, 15, 30, 60, 120, 300, 600, 1200, 3600, 7200}, // 5s to 2h
+		},
+		[]string{"chain"},
+	)
+
+	// VoteFinalizationLatency is a histogram of the latency between a vote and the finalization of its ballot
+	VoteFinalizationLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: ZetaClientNamespace,
+			Name:      "vote_finalization_latency_seconds",
+			Help:      "Histogram of the latency between a vote and the finalization of its ballot in seconds",
+			Buckets:   []float64{5, 10, 20, 30, 60, 120, 300, 600}, // 5s to 10m
+		},
+		[]string{"chain", "vote_type"},
+	)
+
+	// BallotVotes is a counter of the votes of the observer by outcome of their ballot
+	BallotVotes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: ZetaClientNamespace,
+			Name:      "ballot_votes_total",
+			Help:      "Total number of votes by outcome of their ballot",
+		},
+		[]string{"chain", "vote_type", "outcome"},
+	)
+
+	// OutboundConfirmationLatency is a histogram of the latency between the signing of an outbound and its confirmation
+	OutboundConfirmationLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: ZetaClientNamespace,
+			Name:      "outbound_confirmation_latency_seconds",
+			Help:      "Histogram of the latency between the signing of an outbound and its confirmation in seconds",
+			Buckets:   []float64{10, 30, 60, 120, 300, 600, 1200, 3600, 7200}, // 10s to 2h
+		},
+		[]string{"chain"},
+	)
+
 	RPCClientCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: ZetaClientNamespace,
 			Name:      "rpc_client_calls_total",
 			Help:      "Total number of rpc calls",
 		},
-		[]string{"status", "client", "method"},
+		[]string{"status", "client", "method", "endpoint"},
 	)
 
 	RPCClientDuration = promauto.NewHistogramVec(
@@ -215,7 +258,7 @@ var (
 			Help:      "Histogram of rpc client calls durations in seconds",
 			Buckets:   []float64{0.05, 0.1, 0.2, 0.3, 0.5, 1, 1.5, 2, 3, 5, 7.5, 10, 15}, // 50ms to 15s
 		},
-		[]string{"client"},
+		[]string{"client", "endpoint"},
 	)
 )
 
@@ -263,6 +306,18 @@ func (m *Metrics) Stop() {
 
 // GetInstrumentedHTTPClient sets up a http client that emits prometheus metrics
 func GetInstrumentedHTTPClient(endpoint string) (*http.Client, error) {
+	transport, err := InstrumentedTransport(endpoint, http.DefaultTransport)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{
+		Transport: transport,
+	}, nil
+}
+
+// InstrumentedTransport wraps the transport of an endpoint with prometheus metrics
+func InstrumentedTransport(endpoint string, transport http.RoundTripper) (http.RoundTripper, error) {
 	host := endpoint
 	// try to parse as url (so that we do not expose auth uuid in metrics)
 	endpointURL, err := url.Parse(endpoint)
@@ -279,12 +334,9 @@ func GetInstrumentedHTTPClient(endpoint string) (*http.Client, error) {
 		return nil, err
 	}
 
-	transport := http.DefaultTransport
 	transport = promhttp.InstrumentRoundTripperDuration(rpcLatencyMetric, transport)
 	transport = promhttp.InstrumentRoundTripperCounter(rpcCounterMetric, transport)
 	transport = promhttp.InstrumentRoundTripperInFlight(RPCInProgress.With(labels), transport)
 
-	return &http.Client{
-		Transport: transport,
-	}, nil
+	return transport, nil
 }
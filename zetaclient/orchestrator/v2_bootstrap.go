@@ -3,6 +3,7 @@ package orchestrator
 import (
 	"context"
 	"fmt"
+	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	solrpc "github.com/gagliardetto/solana-go/rpc"
@@ -23,6 +24,7 @@ import (
 	evmsigner "github.com/zeta-chain/node/zetaclient/chains/evm/signer"
 	"github.com/zeta-chain/node/zetaclient/chains/solana"
 	solbserver "github.com/zeta-chain/node/zetaclient/chains/solana/observer"
+	zetasolrpc "github.com/zeta-chain/node/zetaclient/chains/solana/rpc"
 	solanasigner "github.com/zeta-chain/node/zetaclient/chains/solana/signer"
 	"github.com/zeta-chain/node/zetaclient/chains/sui"
 	suiclient "github.com/zeta-chain/node/zetaclient/chains/sui/client"
@@ -35,6 +37,7 @@ import (
 	zctx "github.com/zeta-chain/node/zetaclient/context"
 	"github.com/zeta-chain/node/zetaclient/db"
 	"github.com/zeta-chain/node/zetaclient/keys"
+	"github.com/zeta-chain/node/zetaclient/rpcpool"
 )
 
 const btcBlocksPerDay = 144
@@ -70,6 +73,8 @@ func (oc *V2) bootstrapBitcoin(ctx context.Context, chain zctx.Chain) (*bitcoin.
 		return nil, errors.Wrap(err, "unable to create base observer")
 	}
 
+	baseObserver.WithRPCPool(rpcClient.Pool())
+
 	observer, err := btcobserver.New(*rawChain, baseObserver, rpcClient)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create observer")
@@ -97,9 +102,21 @@ func (oc *V2) bootstrapEVM(ctx context.Context, chain zctx.Chain) (*evm.EVM, err
 		return nil, errors.Wrap(errSkipChain, "unable to find evm config")
 	}
 
-	evmClient, err := evmclient.NewFromEndpoint(ctx, cfg.Endpoint)
+	endpoints := cfg.AllEndpoints()
+
+	pool, err := newRPCPool(chain, endpoints, cfg.RPCQuorum, evmclient.QuorumMethods)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create rpc pool")
+	}
+
+	var evmClient *evmclient.Client
+	if pool != nil {
+		evmClient, err = evmclient.NewFromPool(ctx, pool)
+	} else {
+		evmClient, err = evmclient.NewFromEndpoint(ctx, endpoints[0])
+	}
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to create evm client (%s)", cfg.Endpoint)
+		return nil, errors.Wrapf(err, "unable to create evm client (%s)", endpoints[0])
 	}
 
 	baseObserver, err := oc.newBaseObserver(chain, chain.Name())
@@ -107,6 +124,8 @@ func (oc *V2) bootstrapEVM(ctx context.Context, chain zctx.Chain) (*evm.EVM, err
 		return nil, errors.Wrap(err, "unable to create base observer")
 	}
 
+	baseObserver.WithRPCPool(pool)
+
 	observer, err := evmobserver.New(baseObserver, evmClient)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create observer")
@@ -155,7 +174,20 @@ func (oc *V2) bootstrapSolana(ctx context.Context, chain zctx.Chain) (*solana.So
 
 	gwAddress := chain.Params().GatewayAddress
 
-	rpcClient := solrpc.New(cfg.Endpoint)
+	endpoints := cfg.AllEndpoints()
+
+	pool, err := newRPCPool(chain, endpoints, cfg.RPCQuorum, zetasolrpc.QuorumMethods)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create rpc pool")
+	}
+
+	var rpcClient *solrpc.Client
+	if pool != nil {
+		rpcClient = zetasolrpc.NewFromPool(pool)
+		baseObserver.WithRPCPool(pool)
+	} else {
+		rpcClient = solrpc.New(endpoints[0])
+	}
 	if rpcClient == nil {
 		return nil, errors.New("unable to create rpc client")
 	}
@@ -176,7 +208,14 @@ func (oc *V2) bootstrapSolana(ctx context.Context, chain zctx.Chain) (*solana.So
 	baseSigner := oc.newBaseSigner(chain)
 
 	// create Solana signer
-	signer, err := solanasigner.New(baseSigner, rpcClient, gwAddress, relayerKey)
+	retryInterval := time.Duration(cfg.PriorityFeeRetryInterval) * time.Second
+	signer, err := solanasigner.New(
+		baseSigner,
+		rpcClient,
+		gwAddress,
+		relayerKey,
+		solanasigner.WithPriorityFeeRetryInterval(retryInterval),
+	)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create signer")
 	}
@@ -205,13 +244,34 @@ func (oc *V2) bootstrapSui(ctx context.Context, chain zctx.Chain) (*sui.Sui, err
 		return nil, errors.Wrap(err, "unable to create base observer")
 	}
 
-	suiClient := suiclient.NewFromEndpoint(cfg.Endpoint)
+	endpoints := cfg.AllEndpoints()
 
-	gateway := suigateway.NewGateway(chain.Params().GatewayAddress)
+	pool, err := newRPCPool(chain, endpoints, cfg.RPCQuorum, suiclient.QuorumMethods)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create rpc pool")
+	}
+
+	var suiClient *suiclient.Client
+	if pool != nil {
+		suiClient = suiclient.NewFromPool(pool)
+		baseObserver.WithRPCPool(pool)
+	} else {
+		suiClient = suiclient.NewFromEndpoint(endpoints[0])
+	}
+
+	gateway, err := suigateway.NewGatewayFromAddress(chain.Params().GatewayAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create gateway")
+	}
+	if gateway.ObjectID() == "" {
+		oc.logger.Warn().
+			Fields(chain.LogFields()).
+			Msg("Sui gateway object ID is not set, outbounds are not processed until it is set in chain params")
+	}
 
 	observer := suiobserver.New(baseObserver, suiClient, gateway)
 
-	signer := suisigner.New(oc.newBaseSigner(chain))
+	signer := suisigner.New(oc.newBaseSigner(chain), suiClient, gateway, oc.deps.Zetacore)
 
 	return sui.New(oc.scheduler, observer, signer), nil
 }
@@ -239,7 +299,7 @@ func (oc *V2) bootstrapTON(ctx context.Context, chain zctx.Chain) (*ton.TON, err
 
 	gw := toncontracts.NewGateway(gatewayID)
 
-	lightClient, err := liteapi.NewFromSource(ctx, cfg.LiteClientConfigURL)
+	lightClient, err := liteapi.NewFromSources(ctx, cfg.AllLiteClientConfigURLs())
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create TON liteapi")
 	}
@@ -265,7 +325,7 @@ func (oc *V2) newBaseObserver(chain zctx.Chain, dbName string) (*base.Observer,
 		rawChainParams = chain.Params()
 	)
 
-	database, err := db.NewFromSqlite(oc.deps.DBPath, dbName, true)
+	database, err := oc.openDatabase(dbName)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to open database %s", dbName)
 	}
@@ -287,10 +347,35 @@ func (oc *V2) newBaseObserver(chain zctx.Chain, dbName string) (*base.Observer,
 	)
 }
 
+// newRPCPool creates a pool of the RPC endpoints of a chain, the pool is nil if a single endpoint is configured
+func newRPCPool(chain zctx.Chain, endpoints []string, quorum int, quorumMethods []string) (*rpcpool.Pool, error) {
+	if len(endpoints) < 2 {
+		return nil, nil
+	}
+
+	return rpcpool.New(chain.Name(), endpoints, rpcpool.WithQuorum(quorum, quorumMethods...))
+}
+
 func (oc *V2) newBaseSigner(chain zctx.Chain) *base.Signer {
 	return base.NewSigner(*chain.RawChain(), oc.deps.TSS, oc.logger.base)
 }
 
+// openDatabase opens the chain observer database, either a SQLite file or a Postgres schema named after dbName
+func (oc *V2) openDatabase(dbName string) (*db.DB, error) {
+	cfg := oc.deps.DBConfig
+	if !cfg.IsPostgres() {
+		return db.NewFromSqlite(oc.deps.DBPath, dbName, true)
+	}
+
+	return db.NewFromPostgres(db.PostgresConfig{
+		DSN:             cfg.PostgresDSN,
+		Schema:          dbName,
+		MaxOpenConns:    cfg.MaxOpenConns,
+		MaxIdleConns:    cfg.MaxIdleConns,
+		ConnMaxLifetime: time.Duration(cfg.ConnMaxLifetime) * time.Second,
+	}, true)
+}
+
 func btcDatabaseFileName(chain chains.Chain) string {
 	// legacyBTCDatabaseFilename is the Bitcoin database file name now used in mainnet and testnet3
 	// so we keep using it here for backward compatibility
@@ -16,6 +16,7 @@ import (
 	"github.com/zeta-chain/node/pkg/ticker"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/node/zetaclient/config"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
 	"github.com/zeta-chain/node/zetaclient/logs"
 	"github.com/zeta-chain/node/zetaclient/metrics"
@@ -53,6 +54,7 @@ type Dependencies struct {
 	Zetacore  interfaces.ZetacoreClient
 	TSS       interfaces.TSSSigner
 	DBPath    string
+	DBConfig  config.DatabaseConfig
 	Telemetry *metrics.TelemetryServer
 }
 
@@ -26,6 +26,21 @@ type Input struct {
 
 	// the lowest height of the pending (not missed) cctxs across all chains
 	LowestPendingCctxHeight int64
+
+	// the usage of the per-chain and per-asset rate limits
+	Buckets []BucketInput
+}
+
+// BucketInput is the input data of a per-chain or per-asset rate limit
+type BucketInput struct {
+	// the bucket as reported by zetacore
+	Bucket crosschaintypes.RateLimitBucket
+
+	// the total value of the past cctxs of the bucket within its window
+	PastCctxsValue sdkmath.Int
+
+	// the total value of the pending cctxs of the bucket
+	PendingCctxsValue sdkmath.Int
 }
 
 // Output is the output data for the rate limiter
@@ -41,6 +56,9 @@ type Output struct {
 
 	// wehther the current withdraw rate exceeds the given rate limit or not
 	RateLimitExceeded bool
+
+	// the per-chain and per-asset rate limits exceeded, their pending cctxs are not scheduled
+	BucketsExceeded []crosschaintypes.RateLimitBucket
 }
 
 // NewInput creates a rate limiter input from gRPC response
@@ -57,6 +75,26 @@ func NewInput(resp crosschaintypes.QueryRateLimiterInputResponse) (*Input, bool)
 		return nil, false
 	}
 
+	// parse the usage of the buckets
+	var buckets []BucketInput
+	for _, bucket := range resp.Buckets {
+		bucketPastValue, ok := sdkmath.NewIntFromString(bucket.PastCctxsValue)
+		if !ok {
+			return nil, false
+		}
+
+		bucketPendingValue, ok := sdkmath.NewIntFromString(bucket.PendingCctxsValue)
+		if !ok {
+			return nil, false
+		}
+
+		buckets = append(buckets, BucketInput{
+			Bucket:            bucket,
+			PastCctxsValue:    bucketPastValue,
+			PendingCctxsValue: bucketPendingValue,
+		})
+	}
+
 	return &Input{
 		Height:                  resp.Height,
 		CctxsMissed:             resp.CctxsMissed,
@@ -64,50 +102,61 @@ func NewInput(resp crosschaintypes.QueryRateLimiterInputResponse) (*Input, bool)
 		PastCctxsValue:          pastCctxsValue,
 		PendingCctxsValue:       pendingCctxsValue,
 		LowestPendingCctxHeight: resp.LowestPendingCctxHeight,
+		Buckets:                 buckets,
 	}, true
 }
 
-// IsRateLimiterUsable checks if the rate limiter is usable or not
+// IsRateLimiterUsable checks if the rate limiter is usable or not,
+// it is if either the global rate limit or a per-chain or per-asset rate limit is set
 func IsRateLimiterUsable(rateLimiterFlags crosschaintypes.RateLimiterFlags) bool {
 	if !rateLimiterFlags.Enabled {
 		return false
 	}
-	if rateLimiterFlags.Window <= 0 {
-		return false
-	}
-	if rateLimiterFlags.Rate.IsNil() {
-		return false
-	}
-	if rateLimiterFlags.Rate.IsZero() {
-		return false
-	}
-	return true
+	return rateLimiterFlags.IsGlobalRateLimitSet() ||
+		len(rateLimiterFlags.ChainRateLimits) > 0 ||
+		len(rateLimiterFlags.AssetRateLimits) > 0
 }
 
-// ApplyRateLimiter applies the rate limiter to the input and produces output
+// ApplyRateLimiter applies the rate limiter to the input and produces output,
+// the global rate limit is not applied if its window or rate is zero
 func ApplyRateLimiter(input *Input, window int64, rate sdkmath.Uint) *Output {
-	// block limit and the window limit in azeta
-	blockLimitInAzeta := sdkmath.NewIntFromBigInt(rate.BigInt())
-	windowLimitInAzeta := blockLimitInAzeta.Mul(sdkmath.NewInt(window))
+	// limit exceeded or not
+	totalWithdrawInAzeta := input.PastCctxsValue.Add(input.PendingCctxsValue)
+	withdrawWindow, limitExceeded := window, false
+	if window > 0 && !rate.IsNil() && !rate.IsZero() {
+		withdrawWindow, limitExceeded = withdrawLimitExceeded(
+			input.Height,
+			input.LowestPendingCctxHeight,
+			window,
+			rate,
+			totalWithdrawInAzeta,
+		)
+	}
 
-	// invariant: for period of time >= `window`, the zetaclient-side average withdraw rate should be <= `blockLimitInZeta`
-	// otherwise, zetaclient should wait for the average rate to drop below `blockLimitInZeta`
-	withdrawWindow := window
-	withdrawLimitInAzeta := windowLimitInAzeta
-	if input.LowestPendingCctxHeight != 0 {
-		// If [input.LowestPendingCctxHeight, input.Height] is wider than the given `window`, we should:
-		// 1. use the wider window to calculate the average withdraw rate
-		// 2. adjust the limit proportionally to fit the wider window
-		pendingCctxWindow := input.Height - input.LowestPendingCctxHeight + 1
-		if pendingCctxWindow > window {
-			withdrawWindow = pendingCctxWindow
-			withdrawLimitInAzeta = blockLimitInAzeta.Mul(sdkmath.NewInt(pendingCctxWindow))
+	// per-chain and per-asset limits exceeded
+	var bucketsExceeded []crosschaintypes.RateLimitBucket
+	for _, bucket := range input.Buckets {
+		_, exceeded := withdrawLimitExceeded(
+			input.Height,
+			bucket.Bucket.LowestPendingCctxHeight,
+			bucket.Bucket.Window,
+			bucket.Bucket.Rate,
+			bucket.PastCctxsValue.Add(bucket.PendingCctxsValue),
+		)
+		if exceeded {
+			bucketsExceeded = append(bucketsExceeded, bucket.Bucket)
 		}
 	}
 
-	// limit exceeded or not
-	totalWithdrawInAzeta := input.PastCctxsValue.Add(input.PendingCctxsValue)
-	limitExceeded := totalWithdrawInAzeta.GT(withdrawLimitInAzeta)
+	// if a cctx is counted in an exceeded bucket
+	inExceededBucket := func(cctx *crosschaintypes.CrossChainTx) bool {
+		for _, bucket := range bucketsExceeded {
+			if bucket.Contains(cctx) {
+				return true
+			}
+		}
+		return false
+	}
 
 	// define the result cctx map to be scheduled
 	cctxMap := make(map[int64][]*crosschaintypes.CrossChainTx)
@@ -126,15 +175,80 @@ func ApplyRateLimiter(input *Input, window int64, rate sdkmath.Uint) *Output {
 	// schedule missed cctxs regardless of the `limitExceeded` flag
 	addCctxsToMap(input.CctxsMissed)
 
-	// schedule pending cctxs only if `limitExceeded == false`
+	// schedule pending cctxs only if `limitExceeded == false` and their buckets are not exceeded
 	if !limitExceeded {
-		addCctxsToMap(input.CctxsPending)
+		addCctxsToMap(filterHeldCctxs(input.CctxsPending, inExceededBucket))
+	}
+
+	// the current withdraw rate is only reported for the global rate limit
+	currentWithdrawRate := sdkmath.NewInt(0)
+	if withdrawWindow > 0 {
+		currentWithdrawRate = totalWithdrawInAzeta.Quo(sdkmath.NewInt(withdrawWindow))
 	}
 
 	return &Output{
 		CctxsMap:              cctxMap,
 		CurrentWithdrawWindow: withdrawWindow,
-		CurrentWithdrawRate:   totalWithdrawInAzeta.Quo(sdkmath.NewInt(withdrawWindow)),
+		CurrentWithdrawRate:   currentWithdrawRate,
 		RateLimitExceeded:     limitExceeded,
+		BucketsExceeded:       bucketsExceeded,
+	}
+}
+
+// filterHeldCctxs removes the cctxs held by the rate limiter from the given cctxs.
+// A chain stops at its lowest held nonce, the cctxs after it are not returned to not leave a nonce gap.
+func filterHeldCctxs(
+	cctxs []*crosschaintypes.CrossChainTx,
+	isHeld func(*crosschaintypes.CrossChainTx) bool,
+) []*crosschaintypes.CrossChainTx {
+	// find the lowest held nonce of each chain
+	lowestHeldNonce := make(map[int64]uint64)
+	for _, cctx := range cctxs {
+		if !isHeld(cctx) {
+			continue
+		}
+		chainID, nonce := cctx.GetCurrentOutboundParam().ReceiverChainId, cctx.GetCurrentOutboundParam().TssNonce
+		if held, found := lowestHeldNonce[chainID]; !found || nonce < held {
+			lowestHeldNonce[chainID] = nonce
+		}
+	}
+
+	result := make([]*crosschaintypes.CrossChainTx, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		held, found := lowestHeldNonce[cctx.GetCurrentOutboundParam().ReceiverChainId]
+		if found && cctx.GetCurrentOutboundParam().TssNonce >= held {
+			continue
+		}
+		result = append(result, cctx)
 	}
+	return result
+}
+
+// withdrawLimitExceeded returns the current withdraw window and whether the total withdraw exceeds the rate limit
+func withdrawLimitExceeded(
+	height int64,
+	lowestPendingCctxHeight int64,
+	window int64,
+	rate sdkmath.Uint,
+	totalWithdrawInAzeta sdkmath.Int,
+) (int64, bool) {
+	// block limit and the window limit in azeta
+	blockLimitInAzeta := sdkmath.NewIntFromBigInt(rate.BigInt())
+	withdrawLimitInAzeta := blockLimitInAzeta.Mul(sdkmath.NewInt(window))
+
+	// invariant: for period of time >= `window`, the zetaclient-side average withdraw rate should be <= `blockLimitInZeta`
+	// otherwise, zetaclient should wait for the average rate to drop below `blockLimitInZeta`
+	withdrawWindow := window
+	if lowestPendingCctxHeight != 0 {
+		// If [lowestPendingCctxHeight, height] is wider than the given `window`, we should:
+		// 1. use the wider window to calculate the average withdraw rate
+		// 2. adjust the limit proportionally to fit the wider window
+		pendingCctxWindow := height - lowestPendingCctxHeight + 1
+		if pendingCctxWindow > window {
+			withdrawWindow = pendingCctxWindow
+			withdrawLimitInAzeta = blockLimitInAzeta.Mul(sdkmath.NewInt(pendingCctxWindow))
+		}
+	}
+
+	return withdrawWindow, totalWithdrawInAzeta.GT(withdrawLimitInAzeta)
 }
@@ -24,6 +24,16 @@ func Test_NewInput(t *testing.T) {
 		PastCctxsValue:          sdkmath.NewInt(12345678).Mul(sdkmath.NewInt(1e18)).String(),
 		PendingCctxsValue:       sdkmath.NewInt(4321).Mul(sdkmath.NewInt(1e18)).String(),
 		LowestPendingCctxHeight: 2,
+		Buckets: []crosschaintypes.RateLimitBucket{
+			{
+				ChainId:                 chains.Ethereum.ChainId,
+				Window:                  100,
+				Rate:                    sdkmath.NewUint(1e18),
+				PastCctxsValue:          sdkmath.NewInt(123).Mul(sdkmath.NewInt(1e18)).String(),
+				PendingCctxsValue:       sdkmath.NewInt(45).Mul(sdkmath.NewInt(1e18)).String(),
+				LowestPendingCctxHeight: 3,
+			},
+		},
 	}
 
 	t.Run("should create a input from gRPC response", func(t *testing.T) {
@@ -35,6 +45,10 @@ func Test_NewInput(t *testing.T) {
 		require.Equal(t, response.PastCctxsValue, filterInput.PastCctxsValue.String())
 		require.Equal(t, response.PendingCctxsValue, filterInput.PendingCctxsValue.String())
 		require.Equal(t, response.LowestPendingCctxHeight, filterInput.LowestPendingCctxHeight)
+		require.Len(t, filterInput.Buckets, 1)
+		require.Equal(t, response.Buckets[0], filterInput.Buckets[0].Bucket)
+		require.Equal(t, response.Buckets[0].PastCctxsValue, filterInput.Buckets[0].PastCctxsValue.String())
+		require.Equal(t, response.Buckets[0].PendingCctxsValue, filterInput.Buckets[0].PendingCctxsValue.String())
 	})
 	t.Run("should return false if past cctxs value is invalid", func(t *testing.T) {
 		invalidResp := response
@@ -50,6 +64,14 @@ func Test_NewInput(t *testing.T) {
 		require.False(t, ok)
 		require.Nil(t, filterInput)
 	})
+	t.Run("should return false if bucket value is invalid", func(t *testing.T) {
+		invalidResp := response
+		invalidResp.Buckets = []crosschaintypes.RateLimitBucket{response.Buckets[0]}
+		invalidResp.Buckets[0].PendingCctxsValue = "invalid"
+		filterInput, ok := ratelimiter.NewInput(invalidResp)
+		require.False(t, ok)
+		require.Nil(t, filterInput)
+	})
 }
 
 func Test_IsRateLimiterUsable(t *testing.T) {
@@ -100,6 +122,37 @@ func Test_IsRateLimiterUsable(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "rate limiter is enabled with zero rate and a chain rate limit",
+			flags: crosschaintypes.RateLimiterFlags{
+				Enabled: true,
+				Rate:    sdkmath.NewUint(0),
+				ChainRateLimits: []crosschaintypes.ChainRateLimit{
+					{ChainId: 1, Window: 100, Rate: sdkmath.NewUint(1e18)},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "rate limiter is enabled with an asset rate limit only",
+			flags: crosschaintypes.RateLimiterFlags{
+				Enabled: true,
+				AssetRateLimits: []crosschaintypes.AssetRateLimit{
+					{Zrc20: sample.EthAddress().Hex(), Window: 100, Rate: sdkmath.NewUint(1e18)},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "rate limiter is disabled with an asset rate limit",
+			flags: crosschaintypes.RateLimiterFlags{
+				Enabled: false,
+				AssetRateLimits: []crosschaintypes.AssetRateLimit{
+					{Zrc20: sample.EthAddress().Hex(), Window: 100, Rate: sdkmath.NewUint(1e18)},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -174,6 +227,26 @@ func Test_ApplyRateLimiter(t *testing.T) {
 	allCctxsPending := crosschainkeeper.SortCctxsByHeightAndChainID(
 		append(append([]*crosschaintypes.CrossChainTx{}, ethCctxsPending...), btcCctxsPending...))
 
+	// create 90 pending cctxs for eth chain with 10 erc20 withdrawals in the middle
+	ethErc20Asset := sample.EthAddress().Hex()
+	ethErc20Zrc20 := sample.EthAddress().Hex()
+	ethCctxsMixed := append(append(append([]*crosschaintypes.CrossChainTx{},
+		ethCctxsPending[:40]...),
+		sample.CustomCctxsInBlockRange(
+			t,
+			51,
+			60,
+			zetaChainID,
+			ethChainID,
+			coin.CoinType_ERC20,
+			ethErc20Asset,
+			uint64(2e14),
+			crosschaintypes.CctxStatus_PendingOutbound,
+		)...),
+		ethCctxsPending[50:]...)
+	allCctxsMixed := crosschainkeeper.SortCctxsByHeightAndChainID(
+		append(append([]*crosschaintypes.CrossChainTx{}, ethCctxsMixed...), btcCctxsPending...))
+
 	// define test cases
 	tests := []struct {
 		name   string
@@ -312,6 +385,137 @@ func Test_ApplyRateLimiter(t *testing.T) {
 				RateLimitExceeded: false,
 			},
 		},
+		{
+			name:   "should not schedule pending cctxs of an exceeded chain rate limit",
+			window: 100,
+			rate:   sdkmath.NewUint(1e18), // 1 ZETA/block
+			input: ratelimiter.Input{
+				Height:                  100,
+				CctxsMissed:             allCctxsMissed,
+				CctxsPending:            allCctxsPending,
+				PastCctxsValue:          sdkmath.NewInt(10).Mul(sdkmath.NewInt(1e18)), // 10 * 1 ZETA
+				PendingCctxsValue:       sdkmath.NewInt(90).Mul(sdkmath.NewInt(1e18)), // 90 * 1 ZETA
+				LowestPendingCctxHeight: 11,
+				Buckets: []ratelimiter.BucketInput{
+					{
+						// (5 + 45) / 100 = 0.5 ZETA/block (does not exceed 0.5 ZETA/block)
+						Bucket: crosschaintypes.RateLimitBucket{
+							ChainId: ethChainID,
+							Window:  100,
+							Rate:    sdkmath.NewUint(5e17),
+						},
+						PastCctxsValue:    sdkmath.NewInt(5).Mul(sdkmath.NewInt(1e18)),
+						PendingCctxsValue: sdkmath.NewInt(45).Mul(sdkmath.NewInt(1e18)),
+					},
+					{
+						// (5 + 45) / 100 = 0.5 ZETA/block (exceeds 0.4 ZETA/block)
+						Bucket: crosschaintypes.RateLimitBucket{
+							ChainId: btcChainID,
+							Window:  100,
+							Rate:    sdkmath.NewUint(4e17),
+						},
+						PastCctxsValue:    sdkmath.NewInt(5).Mul(sdkmath.NewInt(1e18)),
+						PendingCctxsValue: sdkmath.NewInt(45).Mul(sdkmath.NewInt(1e18)),
+					},
+				},
+			},
+			output: ratelimiter.Output{ // should return missed btc cctxs only
+				CctxsMap: map[int64][]*crosschaintypes.CrossChainTx{
+					ethChainID: ethCctxsAll,
+					btcChainID: btcCctxsMissed,
+				},
+				CurrentWithdrawWindow: 100,                  // height [1, 100]
+				CurrentWithdrawRate:   sdkmath.NewInt(1e18), // (10 + 90) / 100
+				RateLimitExceeded:     false,
+				BucketsExceeded: []crosschaintypes.RateLimitBucket{
+					{ChainId: btcChainID, Window: 100, Rate: sdkmath.NewUint(4e17)},
+				},
+			},
+		},
+		{
+			name:   "should apply an exceeded chain rate limit without global rate limit",
+			window: 0,
+			rate:   sdkmath.NewUint(0),
+			input: ratelimiter.Input{
+				Height:                  100,
+				CctxsMissed:             allCctxsMissed,
+				CctxsPending:            allCctxsPending,
+				PastCctxsValue:          sdkmath.NewInt(10).Mul(sdkmath.NewInt(1e18)),
+				PendingCctxsValue:       sdkmath.NewInt(90).Mul(sdkmath.NewInt(1e18)),
+				LowestPendingCctxHeight: 11,
+				Buckets: []ratelimiter.BucketInput{
+					{
+						// (5 + 45) / 100 = 0.5 ZETA/block (exceeds 0.4 ZETA/block)
+						Bucket: crosschaintypes.RateLimitBucket{
+							ChainId: btcChainID,
+							Window:  100,
+							Rate:    sdkmath.NewUint(4e17),
+						},
+						PastCctxsValue:    sdkmath.NewInt(5).Mul(sdkmath.NewInt(1e18)),
+						PendingCctxsValue: sdkmath.NewInt(45).Mul(sdkmath.NewInt(1e18)),
+					},
+				},
+			},
+			output: ratelimiter.Output{ // should return missed btc cctxs only
+				CctxsMap: map[int64][]*crosschaintypes.CrossChainTx{
+					ethChainID: ethCctxsAll,
+					btcChainID: btcCctxsMissed,
+				},
+				CurrentWithdrawWindow: 0, // no global rate limit
+				CurrentWithdrawRate:   sdkmath.NewInt(0),
+				RateLimitExceeded:     false,
+				BucketsExceeded: []crosschaintypes.RateLimitBucket{
+					{ChainId: btcChainID, Window: 100, Rate: sdkmath.NewUint(4e17)},
+				},
+			},
+		},
+		{
+			name:   "should not schedule pending cctxs after the first cctx of an exceeded asset rate limit",
+			window: 0,
+			rate:   sdkmath.NewUint(0),
+			input: ratelimiter.Input{
+				Height:                  100,
+				CctxsMissed:             allCctxsMissed,
+				CctxsPending:            allCctxsMixed,
+				PastCctxsValue:          sdkmath.NewInt(10).Mul(sdkmath.NewInt(1e18)),
+				PendingCctxsValue:       sdkmath.NewInt(90).Mul(sdkmath.NewInt(1e18)),
+				LowestPendingCctxHeight: 11,
+				Buckets: []ratelimiter.BucketInput{
+					{
+						// (1 + 5) / 100 = 0.06 ZETA/block (exceeds 0.05 ZETA/block)
+						Bucket: crosschaintypes.RateLimitBucket{
+							ChainId:  ethChainID,
+							Zrc20:    ethErc20Zrc20,
+							CoinType: coin.CoinType_ERC20,
+							Asset:    ethErc20Asset,
+							Window:   100,
+							Rate:     sdkmath.NewUint(5e16),
+						},
+						PastCctxsValue:    sdkmath.NewInt(1).Mul(sdkmath.NewInt(1e18)),
+						PendingCctxsValue: sdkmath.NewInt(5).Mul(sdkmath.NewInt(1e18)),
+					},
+				},
+			},
+			output: ratelimiter.Output{ // eth cctxs stop at the first erc20 cctx (nonce 50), later gas cctxs are held too
+				CctxsMap: map[int64][]*crosschaintypes.CrossChainTx{
+					ethChainID: append(append([]*crosschaintypes.CrossChainTx{}, ethCctxsMissed...), ethCctxsPending[:40]...),
+					btcChainID: btcCctxsAll,
+				},
+				CurrentWithdrawWindow: 0, // no global rate limit
+				CurrentWithdrawRate:   sdkmath.NewInt(0),
+				RateLimitExceeded:     false,
+				BucketsExceeded: []crosschaintypes.RateLimitBucket{
+					{
+						ChainId:  ethChainID,
+						Zrc20:    ethErc20Zrc20,
+						CoinType: coin.CoinType_ERC20,
+						Asset:    ethErc20Asset,
+						Window:   100,
+						Rate:     sdkmath.NewUint(5e16),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -321,6 +525,7 @@ func Test_ApplyRateLimiter(t *testing.T) {
 			require.Equal(t, tt.output.CurrentWithdrawWindow, output.CurrentWithdrawWindow)
 			require.Equal(t, tt.output.CurrentWithdrawRate, output.CurrentWithdrawRate)
 			require.Equal(t, tt.output.RateLimitExceeded, output.RateLimitExceeded)
+			require.Equal(t, tt.output.BucketsExceeded, output.BucketsExceeded)
 		})
 	}
 }
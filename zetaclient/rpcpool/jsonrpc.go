@@ -0,0 +1,170 @@
+package rpcpool
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+)
+
+// movingTags are the block tags resolved differently by each endpoint,
+// reads of such blocks can't be compared between endpoints
+var movingTags = map[string]bool{
+	"latest":    true,
+	"pending":   true,
+	"safe":      true,
+	"finalized": true,
+}
+
+// request is a JSON-RPC request
+type request struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// parseRequests parses a single or a batch JSON-RPC request, nil is returned if the body isn't JSON-RPC
+func parseRequests(body []byte) []request {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil
+	}
+
+	if body[0] == '[' {
+		var batch []request
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return nil
+		}
+		return batch
+	}
+
+	var single request
+	if err := json.Unmarshal(body, &single); err != nil {
+		return nil
+	}
+
+	return []request{single}
+}
+
+// isQuorumRead returns true if every request is a quorum method reading a fixed block or transaction
+func isQuorumRead(requests []request, methods map[string]bool) bool {
+	if len(requests) == 0 {
+		return false
+	}
+
+	for _, req := range requests {
+		if !methods[req.Method] || hasMovingTag(req.Params) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasMovingTag(params json.RawMessage) bool {
+	var values []any
+	if err := json.Unmarshal(params, &values); err != nil {
+		return false
+	}
+
+	for _, value := range values {
+		if tag, ok := value.(string); ok && movingTags[tag] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// methodLabel returns the method used in the metrics
+func methodLabel(requests []request) string {
+	switch len(requests) {
+	case 0:
+		return "unknown"
+	case 1:
+		return requests[0].Method
+	default:
+		return "batch"
+	}
+}
+
+// hasRPCError returns true if a JSON-RPC response contains an error
+func hasRPCError(body []byte) bool {
+	type response struct {
+		Error json.RawMessage `json:"error"`
+	}
+
+	isError := func(res response) bool {
+		return len(res.Error) > 0 && string(res.Error) != "null"
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var batch []response
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return true
+		}
+		for _, res := range batch {
+			if isError(res) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var single response
+	if err := json.Unmarshal(body, &single); err != nil {
+		return true
+	}
+
+	return isError(single)
+}
+
+// responseKey returns the canonical form of a response used to compare the responses of the endpoints.
+// The request id and the protocol version are ignored, the responses of a batch are sorted by id.
+func responseKey(res *http.Response) (string, error) {
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		delete(v, "id")
+		delete(v, "jsonrpc")
+	case []any:
+		sort.SliceStable(v, func(i, j int) bool {
+			return fmt.Sprint(idOf(v[i])) < fmt.Sprint(idOf(v[j]))
+		})
+		for _, item := range v {
+			if m, ok := item.(map[string]any); ok {
+				delete(m, "id")
+				delete(m, "jsonrpc")
+			}
+		}
+	}
+
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d:%s", res.StatusCode, canonical), nil
+}
+
+func idOf(item any) any {
+	if m, ok := item.(map[string]any); ok {
+		return m["id"]
+	}
+	return nil
+}
@@ -0,0 +1,493 @@
+// Package rpcpool provides a pool of JSON-RPC over HTTP endpoints of a chain.
+// The pool is used as the transport of the chain clients, it fails over between the endpoints
+// and can require several endpoints to agree on security-critical reads.
+package rpcpool
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/metrics"
+)
+
+const (
+	// maxConsecutiveFailures is the number of consecutive failures after which an endpoint is unhealthy
+	maxConsecutiveFailures = 3
+
+	// retryUnhealthyAfter is the duration after which an unhealthy endpoint is tried again
+	retryUnhealthyAfter = 30 * time.Second
+
+	// DefaultMaxBlockLag is the default lag of the latest block of an endpoint,
+	// behind the latest block of the best endpoint, after which the endpoint is stale
+	DefaultMaxBlockLag = time.Minute
+)
+
+var (
+	// ErrNoQuorum is returned when not enough endpoints agree on a read
+	ErrNoQuorum = errors.New("no quorum")
+
+	// ErrAllEndpointsFailed is returned when a request failed on every endpoint
+	ErrAllEndpointsFailed = errors.New("all endpoints failed")
+)
+
+// endpoint is an endpoint of the pool and its health
+type endpoint struct {
+	url       *url.URL
+	label     string
+	transport http.RoundTripper
+
+	// the following fields are guarded by the pool mutex
+	failures    int
+	lastFailure time.Time
+	blockTime   time.Time
+}
+
+// Pool is a pool of endpoints of a chain, it implements http.RoundTripper
+type Pool struct {
+	name      string
+	endpoints []*endpoint
+
+	quorum        int
+	quorumMethods map[string]bool
+	maxBlockLag   time.Duration
+	transport     http.RoundTripper
+
+	mu sync.RWMutex
+}
+
+// Status is the health status of an endpoint
+type Status struct {
+	// Endpoint is the host of the endpoint
+	Endpoint string
+
+	// Healthy is false if the latest requests to the endpoint failed
+	Healthy bool
+
+	// Stale is true if the latest block of the endpoint lags behind the other endpoints
+	Stale bool
+
+	// Failures is the number of consecutive failures
+	Failures int
+
+	// BlockTime is the latest block time reported by the health check
+	BlockTime time.Time
+}
+
+// Opt is a pool option
+type Opt func(p *Pool)
+
+// WithQuorum requires the given number of endpoints to agree on the responses of the given methods
+func WithQuorum(quorum int, methods ...string) Opt {
+	return func(p *Pool) {
+		p.quorum = quorum
+		for _, method := range methods {
+			p.quorumMethods[method] = true
+		}
+	}
+}
+
+// WithMaxBlockLag sets the lag after which an endpoint is stale
+func WithMaxBlockLag(maxBlockLag time.Duration) Opt {
+	return func(p *Pool) { p.maxBlockLag = maxBlockLag }
+}
+
+// WithTransport sets the underlying transport of the endpoints
+func WithTransport(transport http.RoundTripper) Opt {
+	return func(p *Pool) { p.transport = transport }
+}
+
+// New creates a pool of the given endpoints, the first endpoint is preferred while it's healthy.
+// The name identifies the pool in the metrics.
+func New(name string, endpoints []string, opts ...Opt) (*Pool, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no endpoints")
+	}
+
+	p := &Pool{
+		name:          name,
+		quorumMethods: make(map[string]bool),
+		maxBlockLag:   DefaultMaxBlockLag,
+		transport:     http.DefaultTransport,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.quorum > len(endpoints) {
+		return nil, errors.Errorf("quorum %d is greater than the number of endpoints %d", p.quorum, len(endpoints))
+	}
+
+	for _, raw := range endpoints {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid endpoint")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, errors.Errorf("unsupported endpoint scheme %q", u.Scheme)
+		}
+
+		// the endpoints are labeled with the host to not expose the auth tokens in the metrics
+		transport, err := metrics.InstrumentedTransport(raw, p.transport)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to instrument transport of %s", u.Host)
+		}
+
+		p.endpoints = append(p.endpoints, &endpoint{
+			url:       u,
+			label:     u.Host,
+			transport: transport,
+		})
+	}
+
+	return p, nil
+}
+
+// URL returns the URL of the first endpoint, to be used by the clients built on top of the pool
+func (p *Pool) URL() string {
+	return p.endpoints[0].url.String()
+}
+
+// HTTPClient returns a http client sending the requests through the pool
+func (p *Pool) HTTPClient() *http.Client {
+	return &http.Client{Transport: p}
+}
+
+// QuorumEnabled returns true if the pool requires several endpoints to agree on reads
+func (p *Pool) QuorumEnabled() bool {
+	return p.quorum > 1
+}
+
+// RoundTrip sends the request to the endpoints of the pool.
+// Quorum reads are sent to every endpoint and succeed if enough endpoints agree on the response,
+// other requests are sent to the healthiest endpoint and fail over to the next ones.
+func (p *Pool) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read request body")
+	}
+
+	rpcRequests := parseRequests(body)
+
+	if ep := p.pinnedEndpoint(req.Context()); ep != nil {
+		return p.send(req, body, rpcRequests, ep)
+	}
+
+	if p.QuorumEnabled() && isQuorumRead(rpcRequests, p.quorumMethods) {
+		return p.quorumRoundTrip(req, body, rpcRequests)
+	}
+
+	return p.failoverRoundTrip(req, body, rpcRequests)
+}
+
+// failoverRoundTrip sends the request to the endpoints, from the healthiest, until one succeeds
+func (p *Pool) failoverRoundTrip(req *http.Request, body []byte, rpcRequests []request) (*http.Response, error) {
+	var lastErr error
+	for _, ep := range p.ordered() {
+		res, err := p.send(req, body, rpcRequests, ep)
+		if err == nil {
+			return res, nil
+		}
+		if req.Context().Err() != nil {
+			return nil, err
+		}
+		lastErr = err
+	}
+
+	return nil, errors.Wrapf(ErrAllEndpointsFailed, "%s: %s", p.name, lastErr)
+}
+
+// quorumRoundTrip sends the request to every endpoint and returns the response of the quorum
+func (p *Pool) quorumRoundTrip(req *http.Request, body []byte, rpcRequests []request) (*http.Response, error) {
+	type result struct {
+		res *http.Response
+		key string
+		err error
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+
+	endpoints := p.ordered()
+	results := make(chan result, len(endpoints))
+	for _, ep := range endpoints {
+		go func(ep *endpoint) {
+			res, err := p.send(req.WithContext(ctx), body, rpcRequests, ep)
+			if err != nil {
+				results <- result{err: err}
+				return
+			}
+			key, err := responseKey(res)
+			results <- result{res: res, key: key, err: err}
+		}(ep)
+	}
+
+	var (
+		votes  = make(map[string]int)
+		failed int
+	)
+	for range endpoints {
+		r := <-results
+		if r.err != nil {
+			failed++
+			continue
+		}
+		votes[r.key]++
+		if votes[r.key] >= p.quorum {
+			return r.res, nil
+		}
+	}
+
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, errors.Wrapf(
+		ErrNoQuorum,
+		"%s: %d distinct responses and %d failures from %d endpoints, %d required to agree",
+		p.name,
+		len(votes),
+		failed,
+		len(endpoints),
+		p.quorum,
+	)
+}
+
+// send sends the request to the given endpoint, the response body is buffered
+func (p *Pool) send(req *http.Request, body []byte, rpcRequests []request, ep *endpoint) (res *http.Response, err error) {
+	var (
+		start  = time.Now()
+		method = methodLabel(rpcRequests)
+		rpcErr bool
+	)
+
+	defer func() {
+		status := "ok"
+		if err != nil || rpcErr {
+			status = "failed"
+		}
+		metrics.RPCClientCounter.WithLabelValues(status, p.name, method, ep.label).Inc()
+		metrics.RPCClientDuration.WithLabelValues(p.name, ep.label).Observe(time.Since(start).Seconds())
+
+		// requests canceled by the caller don't affect the health of the endpoint
+		if req.Context().Err() == nil {
+			p.recordResult(ep, err)
+		}
+	}()
+
+	res, err = ep.transport.RoundTrip(rewrite(req, body, ep))
+	if err != nil {
+		return nil, errors.Wrapf(err, "request to %s failed", ep.label)
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read response of %s", ep.label)
+	}
+
+	// rate limiting and server errors are endpoint failures, other statuses are returned to the client
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		return nil, errors.Errorf("unexpected status code %d from %s", res.StatusCode, ep.label)
+	}
+
+	rpcErr = hasRPCError(resBody)
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	res.ContentLength = int64(len(resBody))
+
+	return res, nil
+}
+
+// CheckHealth runs the health check against every endpoint.
+// The health check returns the latest block time of the endpoint, the latest of all block times is returned.
+func (p *Pool) CheckHealth(
+	ctx context.Context,
+	check func(ctx context.Context) (time.Time, error),
+) (time.Time, error) {
+	type result struct {
+		blockTime time.Time
+		err       error
+	}
+
+	results := make([]result, len(p.endpoints))
+
+	var wg sync.WaitGroup
+	for i, ep := range p.endpoints {
+		wg.Add(1)
+		go func(i int, ep *endpoint) {
+			defer wg.Done()
+			blockTime, err := check(withEndpoint(ctx, p, ep))
+			results[i] = result{blockTime: blockTime, err: err}
+		}(i, ep)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var (
+		latest  time.Time
+		lastErr error
+	)
+	for i, ep := range p.endpoints {
+		if results[i].err != nil {
+			p.recordFailure(ep)
+			lastErr = errors.Wrapf(results[i].err, "health check of %s failed", ep.label)
+			continue
+		}
+		ep.failures = 0
+		ep.blockTime = results[i].blockTime
+		if ep.blockTime.After(latest) {
+			latest = ep.blockTime
+		}
+	}
+
+	if latest.IsZero() {
+		return time.Time{}, lastErr
+	}
+
+	return latest, nil
+}
+
+// Status returns the health status of the endpoints, in the order of preference
+func (p *Pool) Status() []Status {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var (
+		now    = time.Now()
+		latest = p.latestBlockTime()
+		status = make([]Status, 0, len(p.endpoints))
+	)
+	for _, ep := range p.orderedLocked(now, latest) {
+		status = append(status, Status{
+			Endpoint:  ep.label,
+			Healthy:   ep.healthy(now),
+			Stale:     p.stale(ep, latest),
+			Failures:  ep.failures,
+			BlockTime: ep.blockTime,
+		})
+	}
+
+	return status
+}
+
+// ordered returns the endpoints in the order of preference:
+// healthy endpoints first, then endpoints that are not stale, then the configured order
+func (p *Pool) ordered() []*endpoint {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.orderedLocked(time.Now(), p.latestBlockTime())
+}
+
+func (p *Pool) orderedLocked(now, latest time.Time) []*endpoint {
+	ordered := make([]*endpoint, len(p.endpoints))
+	copy(ordered, p.endpoints)
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		hi, hj := ordered[i].healthy(now), ordered[j].healthy(now)
+		if hi != hj {
+			return hi
+		}
+		si, sj := p.stale(ordered[i], latest), p.stale(ordered[j], latest)
+		if si != sj {
+			return !si
+		}
+		return false
+	})
+
+	return ordered
+}
+
+func (p *Pool) recordResult(ep *endpoint, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err != nil {
+		p.recordFailure(ep)
+		return
+	}
+	ep.failures = 0
+}
+
+func (p *Pool) recordFailure(ep *endpoint) {
+	ep.failures++
+	ep.lastFailure = time.Now()
+}
+
+func (p *Pool) latestBlockTime() time.Time {
+	var latest time.Time
+	for _, ep := range p.endpoints {
+		if ep.blockTime.After(latest) {
+			latest = ep.blockTime
+		}
+	}
+	return latest
+}
+
+// stale returns true if the latest block of the endpoint lags behind the latest block of the pool
+func (p *Pool) stale(ep *endpoint, latest time.Time) bool {
+	return !ep.blockTime.IsZero() && latest.Sub(ep.blockTime) > p.maxBlockLag
+}
+
+// healthy returns true if the endpoint didn't fail repeatedly, or if it can be tried again
+func (ep *endpoint) healthy(now time.Time) bool {
+	return ep.failures < maxConsecutiveFailures || now.Sub(ep.lastFailure) > retryUnhealthyAfter
+}
+
+type pinnedEndpointKey struct{}
+
+type pinnedEndpoint struct {
+	pool     *Pool
+	endpoint *endpoint
+}
+
+// withEndpoint returns a context sending the requests of the pool to the given endpoint only
+func withEndpoint(ctx context.Context, p *Pool, ep *endpoint) context.Context {
+	return context.WithValue(ctx, pinnedEndpointKey{}, pinnedEndpoint{pool: p, endpoint: ep})
+}
+
+func (p *Pool) pinnedEndpoint(ctx context.Context) *endpoint {
+	pinned, ok := ctx.Value(pinnedEndpointKey{}).(pinnedEndpoint)
+	if !ok || pinned.pool != p {
+		return nil
+	}
+	return pinned.endpoint
+}
+
+// rewrite returns a copy of the request sent to the given endpoint
+func rewrite(req *http.Request, body []byte, ep *endpoint) *http.Request {
+	out := req.Clone(req.Context())
+
+	u := *ep.url
+	u.User = nil
+	out.URL = &u
+	out.Host = ""
+
+	if ep.url.User != nil {
+		password, _ := ep.url.User.Password()
+		out.SetBasicAuth(ep.url.User.Username(), password)
+	}
+
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	out.ContentLength = int64(len(body))
+
+	return out
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
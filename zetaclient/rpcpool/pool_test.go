@@ -0,0 +1,316 @@
+package rpcpool
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer is a JSON-RPC server returning a fixed result
+type testServer struct {
+	*httptest.Server
+	result   atomic.Value
+	status   atomic.Int64
+	requests atomic.Int64
+	username string
+	password string
+}
+
+func newTestServer(t *testing.T, result string) *testServer {
+	ts := &testServer{}
+	ts.result.Store(result)
+	ts.status.Store(http.StatusOK)
+
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ts.requests.Add(1)
+		ts.username, ts.password, _ = r.BasicAuth()
+
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &req)
+
+		w.WriteHeader(int(ts.status.Load()))
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + ts.result.Load().(string) + `}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+func call(t *testing.T, p *Pool, method string, params ...any) (string, error) {
+	return callContext(context.Background(), t, p, method, params...)
+}
+
+func callContext(ctx context.Context, t *testing.T, p *Pool, method string, params ...any) (string, error) {
+	body, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL(), bytes.NewReader(body))
+	require.NoError(t, err)
+
+	res, err := p.HTTPClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	var out struct {
+		Result json.RawMessage `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+	return string(out.Result), nil
+}
+
+func Test_New(t *testing.T) {
+	t.Run("no endpoints", func(t *testing.T) {
+		_, err := New("test", nil)
+		require.ErrorContains(t, err, "no endpoints")
+	})
+
+	t.Run("quorum greater than endpoints", func(t *testing.T) {
+		_, err := New("test", []string{"http://a", "http://b"}, WithQuorum(3))
+		require.ErrorContains(t, err, "quorum 3 is greater than the number of endpoints 2")
+	})
+
+	t.Run("unsupported scheme", func(t *testing.T) {
+		_, err := New("test", []string{"http://a", "ws://b"})
+		require.ErrorContains(t, err, "unsupported endpoint scheme")
+	})
+
+	t.Run("quorum disabled below 2", func(t *testing.T) {
+		p, err := New("test", []string{"http://a", "http://b"}, WithQuorum(1, "eth_getBlockByHash"))
+		require.NoError(t, err)
+		require.False(t, p.QuorumEnabled())
+	})
+}
+
+func TestPool_Failover(t *testing.T) {
+	t.Run("uses the first endpoint while healthy", func(t *testing.T) {
+		a := newTestServer(t, `"a"`)
+		b := newTestServer(t, `"b"`)
+
+		p, err := New("test", []string{a.URL, b.URL})
+		require.NoError(t, err)
+
+		result, err := call(t, p, "eth_blockNumber")
+		require.NoError(t, err)
+		require.Equal(t, `"a"`, result)
+		require.EqualValues(t, 0, b.requests.Load())
+	})
+
+	t.Run("fails over on server errors", func(t *testing.T) {
+		a := newTestServer(t, `"a"`)
+		b := newTestServer(t, `"b"`)
+		a.status.Store(http.StatusBadGateway)
+
+		p, err := New("test", []string{a.URL, b.URL})
+		require.NoError(t, err)
+
+		result, err := call(t, p, "eth_blockNumber")
+		require.NoError(t, err)
+		require.Equal(t, `"b"`, result)
+	})
+
+	t.Run("fails over on rate limiting", func(t *testing.T) {
+		a := newTestServer(t, `"a"`)
+		b := newTestServer(t, `"b"`)
+		a.status.Store(http.StatusTooManyRequests)
+
+		p, err := New("test", []string{a.URL, b.URL})
+		require.NoError(t, err)
+
+		result, err := call(t, p, "eth_blockNumber")
+		require.NoError(t, err)
+		require.Equal(t, `"b"`, result)
+	})
+
+	t.Run("fails over on unreachable endpoint", func(t *testing.T) {
+		a := newTestServer(t, `"a"`)
+		a.Close()
+		b := newTestServer(t, `"b"`)
+
+		p, err := New("test", []string{a.URL, b.URL})
+		require.NoError(t, err)
+
+		result, err := call(t, p, "eth_blockNumber")
+		require.NoError(t, err)
+		require.Equal(t, `"b"`, result)
+	})
+
+	t.Run("skips unhealthy endpoint", func(t *testing.T) {
+		a := newTestServer(t, `"a"`)
+		b := newTestServer(t, `"b"`)
+		a.status.Store(http.StatusInternalServerError)
+
+		p, err := New("test", []string{a.URL, b.URL})
+		require.NoError(t, err)
+
+		for i := 0; i < maxConsecutiveFailures; i++ {
+			_, err = call(t, p, "eth_blockNumber")
+			require.NoError(t, err)
+		}
+		require.EqualValues(t, maxConsecutiveFailures, a.requests.Load())
+
+		_, err = call(t, p, "eth_blockNumber")
+		require.NoError(t, err)
+		require.EqualValues(t, maxConsecutiveFailures, a.requests.Load())
+
+		status := p.Status()
+		require.Equal(t, b.Listener.Addr().String(), status[0].Endpoint)
+		require.False(t, status[1].Healthy)
+	})
+
+	t.Run("all endpoints failed", func(t *testing.T) {
+		a := newTestServer(t, `"a"`)
+		b := newTestServer(t, `"b"`)
+		a.status.Store(http.StatusInternalServerError)
+		b.status.Store(http.StatusInternalServerError)
+
+		p, err := New("test", []string{a.URL, b.URL})
+		require.NoError(t, err)
+
+		_, err = call(t, p, "eth_blockNumber")
+		require.ErrorIs(t, err, ErrAllEndpointsFailed)
+	})
+
+	t.Run("uses credentials of the endpoint", func(t *testing.T) {
+		a := newTestServer(t, `"a"`)
+
+		p, err := New("test", []string{"http://user:pass@" + a.Listener.Addr().String(), a.URL})
+		require.NoError(t, err)
+
+		_, err = call(t, p, "eth_blockNumber")
+		require.NoError(t, err)
+		require.Equal(t, "user", a.username)
+		require.Equal(t, "pass", a.password)
+	})
+}
+
+func TestPool_Quorum(t *testing.T) {
+	const method = "eth_getTransactionReceipt"
+
+	t.Run("endpoints agree", func(t *testing.T) {
+		a := newTestServer(t, `{"status":"0x1"}`)
+		b := newTestServer(t, `{"status":"0x1"}`)
+		c := newTestServer(t, `{"status":"0x0"}`)
+
+		p, err := New("test", []string{a.URL, b.URL, c.URL}, WithQuorum(2, method))
+		require.NoError(t, err)
+
+		result, err := call(t, p, method, "0xabc")
+		require.NoError(t, err)
+		require.JSONEq(t, `{"status":"0x1"}`, result)
+	})
+
+	t.Run("endpoints disagree", func(t *testing.T) {
+		a := newTestServer(t, `{"status":"0x1"}`)
+		b := newTestServer(t, `{"status":"0x0"}`)
+
+		p, err := New("test", []string{a.URL, b.URL}, WithQuorum(2, method))
+		require.NoError(t, err)
+
+		_, err = call(t, p, method, "0xabc")
+		require.ErrorIs(t, err, ErrNoQuorum)
+	})
+
+	t.Run("not enough endpoints available", func(t *testing.T) {
+		a := newTestServer(t, `{"status":"0x1"}`)
+		b := newTestServer(t, `{"status":"0x1"}`)
+		b.status.Store(http.StatusServiceUnavailable)
+
+		p, err := New("test", []string{a.URL, b.URL}, WithQuorum(2, method))
+		require.NoError(t, err)
+
+		_, err = call(t, p, method, "0xabc")
+		require.ErrorIs(t, err, ErrNoQuorum)
+	})
+
+	t.Run("other methods fail over", func(t *testing.T) {
+		a := newTestServer(t, `"a"`)
+		b := newTestServer(t, `"b"`)
+
+		p, err := New("test", []string{a.URL, b.URL}, WithQuorum(2, method))
+		require.NoError(t, err)
+
+		result, err := call(t, p, "eth_blockNumber")
+		require.NoError(t, err)
+		require.Equal(t, `"a"`, result)
+		require.EqualValues(t, 0, b.requests.Load())
+	})
+
+	t.Run("moving block tags fail over", func(t *testing.T) {
+		a := newTestServer(t, `{"number":"0x2"}`)
+		b := newTestServer(t, `{"number":"0x1"}`)
+
+		p, err := New("test", []string{a.URL, b.URL}, WithQuorum(2, "eth_getBlockByNumber"))
+		require.NoError(t, err)
+
+		result, err := call(t, p, "eth_getBlockByNumber", "latest", false)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"number":"0x2"}`, result)
+	})
+}
+
+func TestPool_CheckHealth(t *testing.T) {
+	a := newTestServer(t, `"a"`)
+	b := newTestServer(t, `"b"`)
+	c := newTestServer(t, `"c"`)
+
+	p, err := New("test", []string{a.URL, b.URL, c.URL})
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Second)
+	blockTimes := map[string]time.Time{
+		`"a"`: now.Add(-2 * DefaultMaxBlockLag),
+		`"b"`: now,
+	}
+
+	// every endpoint is checked, the request of the check is sent to the checked endpoint
+	check := func(ctx context.Context) (time.Time, error) {
+		result, err := callContext(ctx, t, p, "eth_getBlockByNumber", "latest", false)
+		if err != nil {
+			return time.Time{}, err
+		}
+		blockTime, ok := blockTimes[result]
+		if !ok {
+			return time.Time{}, assert.AnError
+		}
+		return blockTime, nil
+	}
+
+	blockTime, err := p.CheckHealth(context.Background(), check)
+	require.NoError(t, err)
+	require.Equal(t, now, blockTime)
+
+	// the stale endpoint is last
+	status := p.Status()
+	require.Len(t, status, 3)
+	require.Equal(t, b.Listener.Addr().String(), status[0].Endpoint)
+	require.Equal(t, c.Listener.Addr().String(), status[1].Endpoint)
+	require.Equal(t, 1, status[1].Failures)
+	require.Equal(t, a.Listener.Addr().String(), status[2].Endpoint)
+	require.True(t, status[2].Stale)
+
+	result, err := call(t, p, "eth_blockNumber")
+	require.NoError(t, err)
+	require.Equal(t, `"b"`, result)
+
+	t.Run("all endpoints failed", func(t *testing.T) {
+		_, err := p.CheckHealth(context.Background(), func(context.Context) (time.Time, error) {
+			return time.Time{}, assert.AnError
+		})
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -47,6 +47,34 @@ func (_m *SuiClient) GetLatestCheckpoint(ctx context.Context) (models.Checkpoint
 	return r0, r1
 }
 
+// GetOwnedObjectID provides a mock function with given fields: ctx, ownerAddress, structType
+func (_m *SuiClient) GetOwnedObjectID(ctx context.Context, ownerAddress string, structType string) (string, error) {
+	ret := _m.Called(ctx, ownerAddress, structType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwnedObjectID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, ownerAddress, structType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, ownerAddress, structType)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, ownerAddress, structType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HealthCheck provides a mock function with given fields: ctx
 func (_m *SuiClient) HealthCheck(ctx context.Context) (time.Time, error) {
 	ret := _m.Called(ctx)
@@ -75,6 +103,34 @@ func (_m *SuiClient) HealthCheck(ctx context.Context) (time.Time, error) {
 	return r0, r1
 }
 
+// MoveCall provides a mock function with given fields: ctx, req
+func (_m *SuiClient) MoveCall(ctx context.Context, req models.MoveCallRequest) (models.TxnMetaData, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCall")
+	}
+
+	var r0 models.TxnMetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.MoveCallRequest) (models.TxnMetaData, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.MoveCallRequest) models.TxnMetaData); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(models.TxnMetaData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.MoveCallRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // QueryModuleEvents provides a mock function with given fields: ctx, q
 func (_m *SuiClient) QueryModuleEvents(ctx context.Context, q client.EventQuery) ([]models.SuiEventResponse, string, error) {
 	ret := _m.Called(ctx, q)
@@ -112,6 +168,34 @@ func (_m *SuiClient) QueryModuleEvents(ctx context.Context, q client.EventQuery)
 	return r0, r1, r2
 }
 
+// SuiExecuteTransactionBlock provides a mock function with given fields: ctx, req
+func (_m *SuiClient) SuiExecuteTransactionBlock(ctx context.Context, req models.SuiExecuteTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuiExecuteTransactionBlock")
+	}
+
+	var r0 models.SuiTransactionBlockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SuiExecuteTransactionBlockRequest) (models.SuiTransactionBlockResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SuiExecuteTransactionBlockRequest) models.SuiTransactionBlockResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(models.SuiTransactionBlockResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SuiExecuteTransactionBlockRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SuiGetObject provides a mock function with given fields: ctx, req
 func (_m *SuiClient) SuiGetObject(ctx context.Context, req models.SuiGetObjectRequest) (models.SuiObjectResponse, error) {
 	ret := _m.Called(ctx, req)
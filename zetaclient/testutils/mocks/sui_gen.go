@@ -26,4 +26,11 @@ type suiClient interface {
 		ctx context.Context,
 		req models.SuiGetTransactionBlockRequest,
 	) (models.SuiTransactionBlockResponse, error)
+
+	GetOwnedObjectID(ctx context.Context, ownerAddress, structType string) (string, error)
+	MoveCall(ctx context.Context, req models.MoveCallRequest) (models.TxnMetaData, error)
+	SuiExecuteTransactionBlock(
+		ctx context.Context,
+		req models.SuiExecuteTransactionBlockRequest,
+	) (models.SuiTransactionBlockResponse, error)
 }
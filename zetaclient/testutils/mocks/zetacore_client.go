@@ -831,6 +831,34 @@ func (_m *ZetacoreClient) PostVoteGasPrice(ctx context.Context, chain chains.Cha
 	return r0, r1
 }
 
+// PostVoteHaltChain provides a mock function with given fields: ctx, chainID, reason
+func (_m *ZetacoreClient) PostVoteHaltChain(ctx context.Context, chainID int64, reason string) (string, error) {
+	ret := _m.Called(ctx, chainID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostVoteHaltChain")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (string, error)); ok {
+		return rf(ctx, chainID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) string); ok {
+		r0 = rf(ctx, chainID, reason)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, chainID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PostVoteInbound provides a mock function with given fields: ctx, gasLimit, retryGasLimit, msg
 func (_m *ZetacoreClient) PostVoteInbound(ctx context.Context, gasLimit uint64, retryGasLimit uint64, msg *types.MsgVoteInbound) (string, string, error) {
 	ret := _m.Called(ctx, gasLimit, retryGasLimit, msg)
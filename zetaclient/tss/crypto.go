@@ -131,6 +131,11 @@ func (k PubKey) AddressEVM() eth.Address {
 	return crypto.PubkeyToAddress(*k.ecdsaPubKey)
 }
 
+// AsECDSA returns the ECDSA public key.
+func (k PubKey) AsECDSA() *ecdsa.PublicKey {
+	return k.ecdsaPubKey
+}
+
 // VerifySignature checks that keysign.Signature is valid and origins from expected TSS public key.
 // Also returns signature as [65]byte (R, S, V)
 func VerifySignature(sig keysign.Signature, pk PubKey, hash []byte) ([65]byte, error) {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -11,7 +12,7 @@ import (
 	"github.com/zeta-chain/node/pkg/constant"
 	"github.com/zeta-chain/node/pkg/crypto"
 	"github.com/zeta-chain/node/pkg/memo"
-	"github.com/zeta-chain/node/zetaclient/config"
+	"github.com/zeta-chain/node/zetaclient/compliance"
 )
 
 // InboundCategory is an enum representing the category of an inbound event
@@ -95,7 +96,7 @@ func (event *InboundEvent) DecodeMemo() error {
 }
 
 // Category returns the category of the inbound event
-func (event *InboundEvent) Category() InboundCategory {
+func (event *InboundEvent) Category(ctx context.Context) InboundCategory {
 	// parse memo-specified receiver
 	receiver := ""
 	parsedAddress, _, err := memo.DecodeLegacyMemoHex(hex.EncodeToString(event.Memo))
@@ -104,7 +105,7 @@ func (event *InboundEvent) Category() InboundCategory {
 	}
 
 	// check restricted addresses
-	if config.ContainRestrictedAddress(event.Sender, event.Receiver, event.TxOrigin, receiver) {
+	if compliance.ContainRestrictedAddress(ctx, event.Sender, event.Receiver, event.TxOrigin, receiver) {
 		return InboundCategoryRestricted
 	}
 
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"context"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -117,7 +118,7 @@ func Test_Catetory(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := tt.event.Category()
+			result := tt.event.Category(context.Background())
 			require.Equal(t, tt.expected, result)
 		})
 	}
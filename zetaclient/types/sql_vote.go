@@ -0,0 +1,84 @@
+package types
+
+import (
+	"gorm.io/gorm"
+
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+const (
+	// VoteTypeInbound is the type of inbound votes in the vote history
+	VoteTypeInbound = "inbound"
+
+	// VoteTypeOutbound is the type of outbound votes in the vote history
+	VoteTypeOutbound = "outbound"
+)
+
+// VoteSQLType is a model for storing the history of the votes posted to zetacore
+type VoteSQLType struct {
+	gorm.Model
+
+	// Type is either VoteTypeInbound or VoteTypeOutbound
+	Type string `gorm:"index"`
+
+	// ChainID is the chain the voted tx was observed on
+	ChainID int64 `gorm:"index:idx_vote_chain_nonce"`
+
+	// Nonce is the TSS nonce of the outbound, it's always 0 for inbound votes
+	Nonce uint64 `gorm:"index:idx_vote_chain_nonce"`
+
+	// BlockHeight is the block height of the observed tx
+	BlockHeight uint64
+
+	// BallotIndex is the index of the ballot voted on
+	BallotIndex string `gorm:"index"`
+
+	// CctxIndex is the index of the cctx of the outbound, it's empty for inbound votes
+	CctxIndex string
+
+	// TxHash is the hash of the observed tx
+	TxHash string `gorm:"index"`
+
+	// CoinType is the coin type of the observed tx
+	CoinType string
+
+	// Amount is the observed amount
+	Amount string
+
+	// Status is the observed receive status of the outbound, it's empty for inbound votes
+	Status string
+
+	// ZetaTxHash is the hash of the zetacore tx carrying the vote
+	ZetaTxHash string
+}
+
+// ToInboundVoteSQLType converts an inbound vote to a VoteSQLType
+func ToInboundVoteSQLType(msg *crosschaintypes.MsgVoteInbound, ballot, zetaTxHash string) *VoteSQLType {
+	return &VoteSQLType{
+		Type:        VoteTypeInbound,
+		ChainID:     msg.SenderChainId,
+		BlockHeight: msg.InboundBlockHeight,
+		BallotIndex: ballot,
+		TxHash:      msg.InboundHash,
+		CoinType:    msg.CoinType.String(),
+		Amount:      msg.Amount.String(),
+		ZetaTxHash:  zetaTxHash,
+	}
+}
+
+// ToOutboundVoteSQLType converts an outbound vote to a VoteSQLType
+func ToOutboundVoteSQLType(msg *crosschaintypes.MsgVoteOutbound, ballot, zetaTxHash string) *VoteSQLType {
+	return &VoteSQLType{
+		Type:        VoteTypeOutbound,
+		ChainID:     msg.OutboundChain,
+		Nonce:       msg.OutboundTssNonce,
+		BlockHeight: msg.ObservedOutboundBlockHeight,
+		BallotIndex: ballot,
+		CctxIndex:   msg.CctxHash,
+		TxHash:      msg.ObservedOutboundHash,
+		CoinType:    msg.CoinType.String(),
+		Amount:      msg.ValueReceived.String(),
+		Status:      msg.Status.String(),
+		ZetaTxHash:  zetaTxHash,
+	}
+}
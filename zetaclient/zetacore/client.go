@@ -52,6 +52,9 @@ type Client struct {
 	// blocksFanout that receives new block events from Zetacore via websockets
 	blocksFanout *fanout.FanOut[ctypes.EventDataNewBlock]
 
+	// ballotMonitor checks the ballots of the votes posted by the observer
+	ballotMonitor ballotMonitor
+
 	mu sync.RWMutex
 }
 
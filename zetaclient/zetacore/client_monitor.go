@@ -2,7 +2,10 @@ package zetacore
 
 import (
 	"context"
+	"maps"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -10,6 +13,9 @@ import (
 
 	"github.com/zeta-chain/node/pkg/retry"
 	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	zctx "github.com/zeta-chain/node/zetaclient/context"
+	"github.com/zeta-chain/node/zetaclient/metrics"
 )
 
 // MonitorVoteOutboundResult monitors the result of a vote outbound tx
@@ -30,8 +36,9 @@ func (c *Client) MonitorVoteOutboundResult(
 		}
 	}()
 
+	votedAt := time.Now()
 	call := func() error {
-		return retry.Retry(c.monitorVoteOutboundResult(ctx, zetaTxHash, retryGasLimit, msg))
+		return retry.Retry(c.monitorVoteOutboundResult(ctx, zetaTxHash, retryGasLimit, msg, votedAt))
 	}
 
 	err := retryWithBackoff(call, monitorRetryCount, monitorInterval/2, monitorInterval)
@@ -51,6 +58,7 @@ func (c *Client) monitorVoteOutboundResult(
 	zetaTxHash string,
 	retryGasLimit uint64,
 	msg *types.MsgVoteOutbound,
+	votedAt time.Time,
 ) error {
 	// query tx result from ZetaChain
 	txResult, err := c.QueryTxResult(zetaTxHash)
@@ -82,6 +90,14 @@ func (c *Client) monitorVoteOutboundResult(
 		}
 	default:
 		c.logger.Debug().Fields(logFields).Msg("monitorVoteOutboundResult: successful")
+
+		c.monitorBallot(ctx, msg.Digest(), monitoredBallot{
+			chainID:  msg.OutboundChain,
+			voteType: metrics.VoteTypeOutbound,
+			voter:    msg.Creator,
+			vote:     observertypes.ConvertReceiveStatusToVoteType(msg.Status),
+			votedAt:  votedAt,
+		})
 	}
 
 	return nil
@@ -105,8 +121,9 @@ func (c *Client) MonitorVoteInboundResult(
 		}
 	}()
 
+	votedAt := time.Now()
 	call := func() error {
-		return retry.Retry(c.monitorVoteInboundResult(ctx, zetaTxHash, retryGasLimit, msg))
+		return retry.Retry(c.monitorVoteInboundResult(ctx, zetaTxHash, retryGasLimit, msg, votedAt))
 	}
 
 	err := retryWithBackoff(call, monitorRetryCount, monitorInterval/2, monitorInterval)
@@ -126,6 +143,7 @@ func (c *Client) monitorVoteInboundResult(
 	zetaTxHash string,
 	retryGasLimit uint64,
 	msg *types.MsgVoteInbound,
+	votedAt time.Time,
 ) error {
 	// query tx result from ZetaChain
 	txResult, err := c.QueryTxResult(zetaTxHash)
@@ -160,11 +178,224 @@ func (c *Client) monitorVoteInboundResult(
 
 	default:
 		c.logger.Debug().Fields(logFields).Msgf("monitorVoteInboundResult: successful")
+
+		c.monitorBallot(ctx, msg.Digest(), monitoredBallot{
+			chainID:     msg.SenderChainId,
+			voteType:    metrics.VoteTypeInbound,
+			voter:       msg.Creator,
+			vote:        observertypes.VoteType_SuccessObservation,
+			votedAt:     votedAt,
+			inboundHash: msg.InboundHash,
+		})
 	}
 
 	return nil
 }
 
+// monitoredBallot is the vote of the observer on a ballot checked by the ballot monitor
+type monitoredBallot struct {
+	chainID  int64
+	voteType string
+	voter    string
+	vote     observertypes.VoteType
+	votedAt  time.Time
+
+	// inboundHash is set for inbound votes, it allows to find the ballot that finalized the inbound
+	// if the vote of the observer diverged from the other observers
+	inboundHash string
+}
+
+// ballotMonitor holds the ballots checked by a single worker until they are finalized or expire
+type ballotMonitor struct {
+	mu      sync.Mutex
+	ballots map[string]monitoredBallot
+	running bool
+}
+
+// monitorBallot adds the ballot of a vote to the ballots checked by the ballot monitor worker
+// the worker is started if not running, the ballot is not monitored if maxMonitoredBallots is reached
+func (c *Client) monitorBallot(ctx context.Context, ballotIndex string, ballot monitoredBallot) {
+	c.ballotMonitor.mu.Lock()
+	defer c.ballotMonitor.mu.Unlock()
+
+	if c.ballotMonitor.ballots == nil {
+		c.ballotMonitor.ballots = make(map[string]monitoredBallot)
+	}
+
+	if _, found := c.ballotMonitor.ballots[ballotIndex]; found {
+		return
+	}
+
+	if len(c.ballotMonitor.ballots) >= maxMonitoredBallots {
+		c.logger.Debug().Str("ballot", ballotIndex).Msg("monitorBallot: too many monitored ballots")
+		return
+	}
+
+	c.ballotMonitor.ballots[ballotIndex] = ballot
+
+	if !c.ballotMonitor.running {
+		c.ballotMonitor.running = true
+		go c.runBallotMonitor(ctx)
+	}
+}
+
+// runBallotMonitor checks the monitored ballots every monitorInterval until none is left
+func (c *Client) runBallotMonitor(ctx context.Context) {
+	ticker := time.NewTicker(monitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.ballotMonitor.mu.Lock()
+			c.ballotMonitor.ballots = nil
+			c.ballotMonitor.running = false
+			c.ballotMonitor.mu.Unlock()
+			return
+		case <-ticker.C:
+		}
+
+		c.ballotMonitor.mu.Lock()
+		ballots := maps.Clone(c.ballotMonitor.ballots)
+		c.ballotMonitor.mu.Unlock()
+
+		// the ballots are checked without holding the lock, new votes can be added meanwhile
+		resolved := make([]string, 0, len(ballots))
+		for ballotIndex, ballot := range ballots {
+			if c.checkBallot(ctx, ballotIndex, ballot) {
+				resolved = append(resolved, ballotIndex)
+			}
+		}
+
+		c.ballotMonitor.mu.Lock()
+		for _, ballotIndex := range resolved {
+			delete(c.ballotMonitor.ballots, ballotIndex)
+		}
+		if len(c.ballotMonitor.ballots) == 0 {
+			c.ballotMonitor.running = false
+			c.ballotMonitor.mu.Unlock()
+			return
+		}
+		c.ballotMonitor.mu.Unlock()
+	}
+}
+
+// checkBallot records the vote-to-finalization latency and whether the vote of the observer ended up
+// on the winning or losing side of the ballot
+// it returns true if the ballot no longer needs to be monitored
+func (c *Client) checkBallot(ctx context.Context, ballotIndex string, ballot monitoredBallot) bool {
+	chain := chainName(ctx, ballot.chainID)
+	logger := c.logger.With().
+		Str("ballot", ballotIndex).
+		Str("chain", chain).
+		Str("vote_type", ballot.voteType).
+		Logger()
+
+	res, err := c.GetBallotByID(ctx, ballotIndex)
+	switch {
+	case err != nil:
+		logger.Debug().Err(err).Msg("checkBallot: unable to get ballot")
+	case res.BallotStatus != observertypes.BallotStatus_BallotInProgress:
+		outcome := voteOutcome(res, ballot.voter, ballot.vote)
+		metrics.VoteFinalizationLatency.WithLabelValues(chain, ballot.voteType).
+			Observe(time.Since(ballot.votedAt).Seconds())
+		metrics.BallotVotes.WithLabelValues(chain, ballot.voteType, outcome).Inc()
+
+		if outcome == metrics.VoteOutcomeLost {
+			logger.Warn().Str("ballot_status", res.BallotStatus.String()).Msg("checkBallot: vote lost")
+		}
+
+		return true
+	}
+
+	if time.Since(ballot.votedAt) < ballotMonitorTimeout {
+		return false
+	}
+
+	// the ballot of an inbound vote diverging from the other observers is never finalized,
+	// the vote is lost if the inbound has been finalized by another ballot
+	if ballot.inboundHash != "" && c.inboundFinalizedByOtherBallot(ctx, ballot.inboundHash, ballotIndex) {
+		metrics.BallotVotes.WithLabelValues(chain, ballot.voteType, metrics.VoteOutcomeLost).Inc()
+		logger.Warn().Str("inbound.hash", ballot.inboundHash).Msg("checkBallot: vote diverged from finalized ballot")
+		return true
+	}
+
+	metrics.BallotVotes.WithLabelValues(chain, ballot.voteType, metrics.VoteOutcomeUnfinalized).Inc()
+	logger.Warn().Msg("checkBallot: ballot not finalized")
+
+	return true
+}
+
+// inboundFinalizedByOtherBallot returns true if the cctxs of the inbound hash have been created
+// by ballots other than the given ballot
+func (c *Client) inboundFinalizedByOtherBallot(ctx context.Context, inboundHash, ballotIndex string) bool {
+	res, err := c.Crosschain.InboundHashToCctxData(ctx, &types.QueryInboundHashToCctxDataRequest{
+		InboundHash: inboundHash,
+	})
+	if err != nil {
+		return false
+	}
+
+	return finalizedByOtherBallot(res.CrossChainTxs, ballotIndex)
+}
+
+// finalizedByOtherBallot returns true if none of the cctxs has been created by the given ballot
+// the inbound ballots of a transaction with several events are all finalized once the timeout is reached,
+// so any cctx of the inbound created by another ballot shows the vote diverged
+func finalizedByOtherBallot(cctxs []types.CrossChainTx, ballotIndex string) bool {
+	if len(cctxs) == 0 {
+		return false
+	}
+
+	for _, cctx := range cctxs {
+		if cctx.GetInboundParams().GetBallotIndex() == ballotIndex {
+			return false
+		}
+	}
+
+	return true
+}
+
+// voteOutcome returns whether the vote of the voter is on the winning or losing side of the finalized ballot
+func voteOutcome(
+	ballot *observertypes.QueryBallotByIdentifierResponse,
+	voter string,
+	vote observertypes.VoteType,
+) string {
+	// use the vote recorded in the ballot if any
+	for _, v := range ballot.Voters {
+		if v.VoterAddress == voter && v.VoteType != observertypes.VoteType_NotYetVoted {
+			vote = v.VoteType
+			break
+		}
+	}
+
+	switch {
+	case vote == observertypes.VoteType_SuccessObservation &&
+		ballot.BallotStatus == observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+		vote == observertypes.VoteType_FailureObservation &&
+			ballot.BallotStatus == observertypes.BallotStatus_BallotFinalized_FailureObservation:
+		return metrics.VoteOutcomeWon
+	default:
+		return metrics.VoteOutcomeLost
+	}
+}
+
+// chainName returns the name of the chain used in metrics labels
+func chainName(ctx context.Context, chainID int64) string {
+	app, err := zctx.FromContext(ctx)
+	if err != nil {
+		return strconv.FormatInt(chainID, 10)
+	}
+
+	chain, err := app.GetChain(chainID)
+	if err != nil {
+		return strconv.FormatInt(chainID, 10)
+	}
+
+	return chain.Name()
+}
+
 func retryWithBackoff(call func() error, attempts int, minInternal, maxInterval time.Duration) error {
 	if attempts < 1 {
 		return errors.New("attempts must be positive")
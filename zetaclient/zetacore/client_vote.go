@@ -103,6 +103,27 @@ func (c *Client) PostVoteBlameData(
 	return zetaTxHash, nil
 }
 
+// PostVoteHaltChain posts a vote to halt the given chain in emergency. Returns txHash and error.
+func (c *Client) PostVoteHaltChain(ctx context.Context, chainID int64, reason string) (string, error) {
+	signerAddress := c.keys.GetOperatorAddress().String()
+	msg := observertypes.NewMsgVoteHaltChain(signerAddress, chainID, reason)
+
+	authzMsg, authzSigner, err := WrapMessageWithAuthz(msg)
+	if err != nil {
+		return "", err
+	}
+
+	zetaTxHash, err := retry.DoTypedWithRetry(func() (string, error) {
+		return c.Broadcast(ctx, PostVoteHaltChainGasLimit, authzMsg, authzSigner)
+	})
+
+	if err != nil {
+		return "", errors.Wrap(err, "unable to broadcast vote halt chain")
+	}
+
+	return zetaTxHash, nil
+}
+
 // PostVoteOutbound posts a vote on an observed outbound tx from a MsgVoteOutbound.
 // Returns tx hash, ballotIndex, and error.
 func (c *Client) PostVoteOutbound(
@@ -33,6 +33,9 @@ const (
 	// PostBlameDataGasLimit is the gas limit for voting on blames
 	PostBlameDataGasLimit = 200_000
 
+	// PostVoteHaltChainGasLimit is the gas limit for voting on the emergency halt of a chain
+	PostVoteHaltChainGasLimit = 200_000
+
 	// PostVoteOutboundGasLimit is the gas limit for voting on observed outbound tx (for zetachain itself)
 	PostVoteOutboundGasLimit = 500_000
 
@@ -45,4 +48,10 @@ const (
 const (
 	monitorInterval   = 5 * time.Second
 	monitorRetryCount = 20
+
+	// ballotMonitorTimeout is the duration after which a ballot not finalized is no longer monitored
+	ballotMonitorTimeout = 10 * time.Minute
+
+	// maxMonitoredBallots is the maximum number of ballots checked by the ballot monitor at once
+	maxMonitoredBallots = 1000
 )
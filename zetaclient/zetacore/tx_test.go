@@ -2,11 +2,14 @@ package zetacore
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/node/pkg/chains"
@@ -15,6 +18,7 @@ import (
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/keys"
+	"github.com/zeta-chain/node/zetaclient/metrics"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
 	"gitlab.com/thorchain/tss/go-tss/blame"
 )
@@ -216,6 +220,25 @@ func TestZetacore_PostBlameData(t *testing.T) {
 	})
 }
 
+func TestZetacore_PostVoteHaltChain(t *testing.T) {
+	ctx := context.Background()
+
+	extraGRPC := withDummyServer(100)
+	setupMockServer(t, observertypes.RegisterQueryServer, skipMethod, nil, nil, extraGRPC...)
+
+	client := setupZetacoreClient(t,
+		withDefaultObserverKeys(),
+		withAccountRetriever(t, 100, 100),
+		withTendermint(mocks.NewSDKClientWithErr(t, nil, 0).SetBroadcastTxHash(sampleHash)),
+	)
+
+	t.Run("post vote halt chain success", func(t *testing.T) {
+		hash, err := client.PostVoteHaltChain(ctx, chains.BscMainnet.ChainId, "reorg of confirmed block 100")
+		assert.NoError(t, err)
+		assert.Equal(t, sampleHash, hash)
+	})
+}
+
 func TestZetacore_PostVoteInbound(t *testing.T) {
 	ctx := context.Background()
 
@@ -355,3 +378,105 @@ func TestZetacore_MonitorVoteOutboundResult(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestVoteOutcome(t *testing.T) {
+	const voter = "voter"
+
+	ballot := func(
+		status observertypes.BallotStatus,
+		voters ...*observertypes.VoterList,
+	) *observertypes.QueryBallotByIdentifierResponse {
+		return &observertypes.QueryBallotByIdentifierResponse{BallotStatus: status, Voters: voters}
+	}
+
+	tt := []struct {
+		name    string
+		ballot  *observertypes.QueryBallotByIdentifierResponse
+		vote    observertypes.VoteType
+		outcome string
+	}{
+		{
+			name:    "success vote on success ballot",
+			ballot:  ballot(observertypes.BallotStatus_BallotFinalized_SuccessObservation),
+			vote:    observertypes.VoteType_SuccessObservation,
+			outcome: metrics.VoteOutcomeWon,
+		},
+		{
+			name:    "failure vote on failure ballot",
+			ballot:  ballot(observertypes.BallotStatus_BallotFinalized_FailureObservation),
+			vote:    observertypes.VoteType_FailureObservation,
+			outcome: metrics.VoteOutcomeWon,
+		},
+		{
+			name:    "success vote on failure ballot",
+			ballot:  ballot(observertypes.BallotStatus_BallotFinalized_FailureObservation),
+			vote:    observertypes.VoteType_SuccessObservation,
+			outcome: metrics.VoteOutcomeLost,
+		},
+		{
+			name:    "failure vote on success ballot",
+			ballot:  ballot(observertypes.BallotStatus_BallotFinalized_SuccessObservation),
+			vote:    observertypes.VoteType_FailureObservation,
+			outcome: metrics.VoteOutcomeLost,
+		},
+		{
+			name: "vote recorded in the ballot is used",
+			ballot: ballot(
+				observertypes.BallotStatus_BallotFinalized_FailureObservation,
+				&observertypes.VoterList{VoterAddress: "other", VoteType: observertypes.VoteType_SuccessObservation},
+				&observertypes.VoterList{VoterAddress: voter, VoteType: observertypes.VoteType_FailureObservation},
+			),
+			vote:    observertypes.VoteType_SuccessObservation,
+			outcome: metrics.VoteOutcomeWon,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.outcome, voteOutcome(tc.ballot, voter, tc.vote))
+		})
+	}
+}
+
+func TestMonitorBallot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &Client{logger: zerolog.Nop()}
+	ballot := monitoredBallot{
+		chainID:  chains.Ethereum.ChainId,
+		voteType: metrics.VoteTypeInbound,
+		vote:     observertypes.VoteType_SuccessObservation,
+		votedAt:  time.Now(),
+	}
+
+	// the ballots are checked by a single worker up to the limit
+	for i := 0; i <= maxMonitoredBallots; i++ {
+		client.monitorBallot(ctx, fmt.Sprintf("ballot-%d", i), ballot)
+	}
+	client.monitorBallot(ctx, "ballot-0", ballot)
+
+	client.ballotMonitor.mu.Lock()
+	require.Len(t, client.ballotMonitor.ballots, maxMonitoredBallots)
+	require.NotContains(t, client.ballotMonitor.ballots, fmt.Sprintf("ballot-%d", maxMonitoredBallots))
+	require.True(t, client.ballotMonitor.running)
+	client.ballotMonitor.mu.Unlock()
+
+	// the worker stops with the context
+	cancel()
+	require.Eventually(t, func() bool {
+		client.ballotMonitor.mu.Lock()
+		defer client.ballotMonitor.mu.Unlock()
+		return !client.ballotMonitor.running && len(client.ballotMonitor.ballots) == 0
+	}, 2*monitorInterval, 10*time.Millisecond)
+}
+
+func TestFinalizedByOtherBallot(t *testing.T) {
+	cctx := func(ballotIndex string) crosschaintypes.CrossChainTx {
+		return crosschaintypes.CrossChainTx{InboundParams: &crosschaintypes.InboundParams{BallotIndex: ballotIndex}}
+	}
+
+	require.False(t, finalizedByOtherBallot(nil, "ballot"))
+	require.False(t, finalizedByOtherBallot([]crosschaintypes.CrossChainTx{cctx("other"), cctx("ballot")}, "ballot"))
+	require.True(t, finalizedByOtherBallot([]crosschaintypes.CrossChainTx{cctx("other")}, "ballot"))
+}